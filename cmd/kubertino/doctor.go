@@ -0,0 +1,328 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/charmbracelet/lipgloss"
+	"github.com/maratkarimov/kubertino/internal/changelog"
+	"github.com/maratkarimov/kubertino/internal/config"
+	"github.com/maratkarimov/kubertino/internal/history"
+	"github.com/maratkarimov/kubertino/internal/k8s"
+	"github.com/maratkarimov/kubertino/internal/logging"
+	"github.com/maratkarimov/kubertino/internal/state"
+	"github.com/maratkarimov/kubertino/internal/tui"
+)
+
+// doctorKubectlTimeout bounds kubectl version, which does not contact a cluster
+const doctorKubectlTimeout = 10 * time.Second
+
+// Results of a doctor check
+const (
+	checkOK   = "OK"
+	checkWarn = "WARN"
+	checkFail = "FAIL"
+)
+
+// doctorCheck is a line of the doctor report
+type doctorCheck struct {
+	name   string
+	status string // One of the check constants
+	detail string
+	hint   string // How to fix a warning or failure
+}
+
+// runDoctor checks the setup Kubertino needs and prints a report with a hint for every
+// problem. It fails when a check fails, so scripts can tell; warnings do not.
+func runDoctor(configPath string, args []string, out io.Writer) error {
+	if len(args) > 0 {
+		return fmt.Errorf("unexpected argument %q", args[0])
+	}
+
+	configCheck, cfg := checkConfig(configPath)
+	checks := []doctorCheck{
+		configCheck,
+		checkKeys(cfg),
+		checkKubeconfig(cfg),
+		checkKubectl(cfg),
+		checkContexts(cfg),
+		checkTerminal(os.Getenv, isTerminal(os.Stdout)),
+	}
+	checks = append(checks, checkWritableDirs(cfg)...)
+
+	failed := 0
+	for _, check := range checks {
+		if check.status == checkFail {
+			failed++
+		}
+	}
+	slog.Info("doctor finished", "checks", len(checks), "failed", failed)
+
+	printDoctorReport(out, checks)
+	if failed > 0 {
+		return fmt.Errorf("doctor found %d problem(s)", failed)
+	}
+	return nil
+}
+
+// checkConfig loads and validates the configuration the TUI would start with. cfg is nil
+// when it could not be loaded; the other checks then use the defaults.
+func checkConfig(configPath string) (check doctorCheck, cfg *config.Config) {
+	check = doctorCheck{name: "config"}
+	source := configSource(configPath)
+	cfg, projectPath, err := loadLayeredConfig(configPath, strings.NewReader(""), io.Discard)
+	if err != nil {
+		check.status, check.detail = checkFail, err.Error()
+		check.hint = "fix the YAML of " + source + "; kubertino config show prints what was read"
+		return check, nil
+	}
+	loadPlugins(cfg)
+	if err := config.Validate(cfg); err != nil {
+		check.status, check.detail = checkFail, "validation failed: "+err.Error()
+		check.hint = "fix " + source + "; examples/kubertino.yml.example lists every setting"
+		return check, nil
+	}
+
+	check.status = checkOK
+	check.detail = fmt.Sprintf("%s: %d context(s), %d action(s)", source, len(cfg.Contexts), len(cfg.Actions))
+	if projectPath != "" {
+		check.detail += ", project config " + projectPath
+	}
+	// A missing user config is bootstrapped from kubeconfig in memory
+	if exists, err := config.Exists(configPath); source == configPath && err == nil && !exists {
+		check.status = checkWarn
+		check.detail = fmt.Sprintf("%s does not exist; using %d context(s) from kubeconfig", source, len(cfg.Contexts))
+		check.hint = "run kubertino config init to pick your contexts and actions"
+	}
+	return check, cfg
+}
+
+// checkKeys checks that no action shortcut hides a key binding of the TUI
+func checkKeys(cfg *config.Config) doctorCheck {
+	check := doctorCheck{name: "keys"}
+	if cfg == nil {
+		check.status, check.detail = checkWarn, "skipped: the configuration did not load"
+		return check
+	}
+
+	shadowed := tui.ShadowedKeys(cfg)
+	if len(shadowed) > 0 {
+		check.status = checkWarn
+		check.detail = strings.Join(shadowed, "; ")
+		check.hint = "give the actions other shortcuts, or move the keys in the keymap section"
+		return check
+	}
+	check.status = checkOK
+	check.detail = "no action shortcut hides a key binding"
+	return check
+}
+
+// checkKubeconfig checks that the kubeconfig files kubectl reads exist and have contexts,
+// including those configured for single contexts
+func checkKubeconfig(cfg *config.Config) doctorCheck {
+	check := doctorCheck{name: "kubeconfig"}
+	paths := config.KubeconfigPaths()
+	if cfg != nil && cfg.Kubeconfig != "" {
+		paths = []string{cfg.Kubeconfig}
+	}
+
+	contexts, err := config.DiscoverContexts(paths)
+	if err != nil {
+		check.status, check.detail = checkFail, err.Error()
+		check.hint = "create a kubeconfig with your cluster provider's CLI, or point KUBECONFIG or kubeconfig in the config at it"
+		return check
+	}
+	check.status = checkOK
+	check.detail = fmt.Sprintf("%d context(s) in %s", len(contexts), strings.Join(paths, string(os.PathListSeparator)))
+
+	if cfg == nil {
+		return check
+	}
+	for _, ctx := range cfg.Contexts {
+		if ctx.Kubeconfig == "" {
+			continue
+		}
+		if _, err := config.DiscoverContexts([]string{ctx.Kubeconfig}); err != nil {
+			check.status = checkFail
+			check.detail = fmt.Sprintf("kubeconfig of context %s: %v", ctx.Name, err)
+			check.hint = "fix the kubeconfig path of the context in the config"
+			return check
+		}
+	}
+	return check
+}
+
+// checkKubectl checks that kubectl is found and recent enough
+func checkKubectl(cfg *config.Config) doctorCheck {
+	check := doctorCheck{name: "kubectl"}
+	adapter := k8s.NewKubectlAdapter("")
+	if cfg != nil {
+		adapter = newAdapter(cfg)
+	}
+
+	version, err := adapter.ClientVersion(doctorKubectlTimeout)
+	if err != nil {
+		check.status, check.detail = checkFail, err.Error()
+		check.hint = "install kubectl (https://kubernetes.io/docs/tasks/tools/) or set kubectl_path in the config"
+		if !errors.Is(err, k8s.ErrKubectlNotFound) {
+			check.hint = "run kubectl version --client to see why kubectl fails"
+		}
+		return check
+	}
+	check.detail = version.GitVersion
+	if !version.Supported() {
+		check.status = checkWarn
+		check.detail += fmt.Sprintf(" is older than 1.%d; the node shell (kubectl debug) will not work", k8s.MinKubectlMinor)
+		check.hint = "upgrade kubectl, ideally to within one minor version of your clusters"
+		return check
+	}
+	check.status = checkOK
+	return check
+}
+
+// checkContexts checks that the configured contexts are in their kubeconfig
+func checkContexts(cfg *config.Config) doctorCheck {
+	check := doctorCheck{name: "contexts"}
+	if cfg == nil {
+		check.status, check.detail = checkWarn, "skipped: the configuration did not load"
+		return check
+	}
+
+	matched, missing, err := k8s.MatchContexts(cfg, cfg.Kubeconfig)
+	switch {
+	case err != nil:
+		check.status, check.detail = checkFail, err.Error()
+		check.hint = "see the kubeconfig check above"
+	case len(matched) == 0:
+		check.status = checkFail
+		check.detail = "none of the configured contexts are in kubeconfig: " + strings.Join(missing, ", ")
+		check.hint = "compare the context names with kubectl config get-contexts -o name"
+	case len(missing) > 0:
+		check.status = checkWarn
+		check.detail = fmt.Sprintf("%d found; not in kubeconfig: %s", len(matched), strings.Join(missing, ", "))
+		check.hint = "remove them from the config, or rename them after kubectl config get-contexts -o name"
+	default:
+		check.status = checkOK
+		check.detail = fmt.Sprintf("all %d found in kubeconfig", len(matched))
+	}
+	return check
+}
+
+// checkTerminal reports how the TUI will draw on the terminal described by the environment
+// read with getenv. tty says whether standard output is a terminal.
+func checkTerminal(getenv func(string) string, tty bool) doctorCheck {
+	check := doctorCheck{name: "terminal", status: checkOK}
+	mode := tui.DetectRenderMode(getenv)
+	term := getenv("TERM")
+	if term == "" {
+		term = "unset"
+	}
+
+	var drawing []string
+	if mode.NoColor {
+		drawing = append(drawing, "no colors")
+	}
+	if mode.ASCII {
+		drawing = append(drawing, "ASCII only")
+	}
+	if len(drawing) == 0 {
+		drawing = append(drawing, "colors and Unicode")
+	}
+	check.detail = fmt.Sprintf("TERM=%s, drawn with %s", term, strings.Join(drawing, ", "))
+	if !tty {
+		check.detail += "; output is not a terminal"
+	}
+
+	if mode.ASCII {
+		check.status = checkWarn
+		check.hint = "if your terminal can do better, set TERM, e.g. TERM=xterm-256color"
+	}
+	return check
+}
+
+// isTerminal reports whether f is a character device, such as a terminal
+func isTerminal(f *os.File) bool {
+	info, err := f.Stat()
+	return err == nil && info.Mode()&os.ModeCharDevice != 0
+}
+
+// checkWritableDirs checks that the directories of the state file, the action history and the
+// log file can be written
+func checkWritableDirs(cfg *config.Config) []doctorCheck {
+	logPath, err := logging.DefaultPath()
+	if cfg != nil && err == nil {
+		if configured, cfgErr := cfg.LogPath(); cfgErr != nil {
+			err = cfgErr
+		} else if configured != "" {
+			logPath = configured
+		}
+	}
+	statePath, stateErr := state.DefaultPath()
+	historyPath, historyErr := history.DefaultPath()
+
+	return []doctorCheck{
+		checkWritableDir("state dir", statePath, stateErr, "session state is not kept"),
+		checkWritableDir("history dir", historyPath, historyErr, "the action history is not kept"),
+		checkWritableDir("log dir", logPath, err, "Kubertino cannot start without a log file"),
+	}
+}
+
+// checkWritableDir checks that the directory of path exists or can be created, and that a
+// file can be written in it. lost says what does not work otherwise.
+func checkWritableDir(name, path string, pathErr error, lost string) doctorCheck {
+	check := doctorCheck{name: name, status: checkFail}
+	if pathErr != nil {
+		check.detail = pathErr.Error()
+		check.hint = "set HOME; " + lost
+		return check
+	}
+
+	dir := filepath.Dir(path)
+	check.detail = dir
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		check.detail = err.Error()
+		check.hint = "create " + dir + " or fix its permissions; " + lost
+		return check
+	}
+	probe, err := os.CreateTemp(dir, ".kubertino-doctor-*")
+	if err != nil {
+		check.detail = err.Error()
+		check.hint = "fix the permissions of " + dir + "; " + lost
+		return check
+	}
+	probe.Close()
+	os.Remove(probe.Name())
+	check.status = checkOK
+	return check
+}
+
+// printDoctorReport prints checks, colored when out is a terminal and NO_COLOR is unset
+func printDoctorReport(out io.Writer, checks []doctorCheck) {
+	renderer := lipgloss.NewRenderer(out)
+	statusStyles := map[string]lipgloss.Style{
+		checkOK:   renderer.NewStyle().Foreground(lipgloss.Color("2")),
+		checkWarn: renderer.NewStyle().Foreground(lipgloss.Color("3")).Bold(true),
+		checkFail: renderer.NewStyle().Foreground(lipgloss.Color("1")).Bold(true),
+	}
+	hintStyle := renderer.NewStyle().Faint(true)
+
+	nameWidth := 0
+	for _, check := range checks {
+		nameWidth = max(nameWidth, len(check.name))
+	}
+
+	fmt.Fprintf(out, "Kubertino %s doctor\n\n", changelog.Current())
+	for _, check := range checks {
+		status := statusStyles[check.status].Render(fmt.Sprintf("%-4s", check.status))
+		fmt.Fprintf(out, "  %s  %-*s  %s\n", status, nameWidth, check.name, check.detail)
+		if check.hint != "" {
+			fmt.Fprintf(out, "  %4s  %-*s  %s\n", "", nameWidth, "", hintStyle.Render("hint: "+check.hint))
+		}
+	}
+}
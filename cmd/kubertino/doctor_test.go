@@ -0,0 +1,112 @@
+package main
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// setupDoctor writes a config with the given contexts, a kubeconfig with prod and staging and
+// a stub kubectl reporting minor version minor, and isolates HOME
+func setupDoctor(t *testing.T, contexts, minor string) string {
+	t.Helper()
+	dir := t.TempDir()
+	t.Setenv("HOME", dir)
+	t.Setenv("XDG_CONFIG_HOME", "")
+	t.Setenv("XDG_STATE_HOME", "")
+	t.Setenv("KUBECONFIG", "")
+	t.Chdir(dir)
+
+	require.NoError(t, os.MkdirAll(filepath.Join(dir, ".kube"), 0o755))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, ".kube", "config"), []byte(`contexts:
+  - name: prod
+  - name: staging
+`), 0o600))
+
+	bin := filepath.Join(dir, "bin")
+	require.NoError(t, os.MkdirAll(bin, 0o755))
+	script := `#!/bin/sh
+echo '{"clientVersion": {"major": "1", "minor": "` + minor + `", "gitVersion": "v1.` + minor + `.3"}}'
+`
+	require.NoError(t, os.WriteFile(filepath.Join(bin, "kubectl"), []byte(script), 0o755))
+	t.Setenv("PATH", bin)
+
+	configPath := filepath.Join(dir, "kubertino.yml")
+	require.NoError(t, os.WriteFile(configPath, []byte("version: \"1.0\"\ncontexts:\n"+contexts), 0o600))
+	return configPath
+}
+
+func TestDoctor(t *testing.T) {
+	t.Run("healthy", func(t *testing.T) {
+		configPath := setupDoctor(t, "  - name: prod\n  - name: staging\n", "29")
+
+		var out bytes.Buffer
+		require.NoError(t, runCommand(configPath, []string{"doctor"}, &out))
+		report := out.String()
+		assert.Regexp(t, `OK\s+config\s+\S+kubertino.yml: 2 context\(s\)`, report)
+		assert.Regexp(t, `OK\s+kubeconfig\s+2 context\(s\) in ~/.kube/config`, report)
+		assert.Regexp(t, `OK\s+kubectl\s+v1.29.3`, report)
+		assert.Regexp(t, `OK\s+contexts\s+all 2 found`, report)
+		assert.Regexp(t, `OK\s+keys\s+no action shortcut hides a key binding`, report)
+		assert.Regexp(t, `OK\s+state dir\s+\S+/.local/state/kubertino`, report)
+		assert.Regexp(t, `OK\s+log dir\s+\S+/.kubertino`, report)
+		assert.NotContains(t, report, "FAIL")
+		// Not a terminal: no color codes
+		assert.NotContains(t, report, "\x1b[")
+	})
+
+	t.Run("warnings", func(t *testing.T) {
+		configPath := setupDoctor(t, "  - name: prod\n    actions:\n      - name: Inspect\n        shortcut: i\n        command: kubectl describe pod {{.pod}}\n  - name: dev\n", "19")
+
+		var out bytes.Buffer
+		require.NoError(t, runCommand(configPath, []string{"doctor"}, &out))
+		report := out.String()
+		assert.Regexp(t, `WARN\s+kubectl\s+v1.19.3 is older than 1.20`, report)
+		assert.Regexp(t, `WARN\s+contexts\s+1 found; not in kubeconfig: dev\n\s+hint: `, report)
+		assert.Regexp(t, `WARN\s+keys\s+action "Inspect" of context prod on i hides the Network key`, report)
+	})
+
+	t.Run("failures", func(t *testing.T) {
+		configPath := setupDoctor(t, "  - name: dev\n", "29")
+		t.Setenv("PATH", t.TempDir())
+
+		var out bytes.Buffer
+		err := runCommand(configPath, []string{"doctor"}, &out)
+		assert.EqualError(t, err, "doctor found 2 problem(s)")
+		report := out.String()
+		assert.Regexp(t, `FAIL\s+kubectl\s+kubectl not found in PATH.*\n\s+hint: install kubectl`, report)
+		assert.Regexp(t, `FAIL\s+contexts\s+none of the configured contexts are in kubeconfig: dev`, report)
+	})
+
+	t.Run("invalid config", func(t *testing.T) {
+		configPath := setupDoctor(t, "  - name: prod\nactions:\n  - name: Broken\n    shortcut: b\n", "29")
+
+		var out bytes.Buffer
+		assert.Error(t, runCommand(configPath, []string{"doctor"}, &out))
+		assert.Regexp(t, `FAIL\s+config\s+validation failed`, out.String())
+		assert.Regexp(t, `WARN\s+contexts\s+skipped`, out.String())
+	})
+}
+
+func TestCheckTerminal(t *testing.T) {
+	env := func(vars map[string]string) func(string) string {
+		return func(key string) string { return vars[key] }
+	}
+
+	check := checkTerminal(env(map[string]string{"TERM": "xterm-256color"}), true)
+	assert.Equal(t, checkOK, check.status)
+	assert.Equal(t, "TERM=xterm-256color, drawn with colors and Unicode", check.detail)
+
+	check = checkTerminal(env(map[string]string{"TERM": "xterm-256color", "NO_COLOR": "1"}), false)
+	assert.Equal(t, checkOK, check.status)
+	assert.Equal(t, "TERM=xterm-256color, drawn with no colors; output is not a terminal", check.detail)
+
+	check = checkTerminal(env(map[string]string{}), true)
+	assert.Equal(t, checkWarn, check.status)
+	assert.Equal(t, "TERM=unset, drawn with no colors, ASCII only", check.detail)
+	assert.NotEmpty(t, check.hint)
+}
@@ -0,0 +1,389 @@
+package main
+
+import (
+	"encoding/json"
+	"flag"
+	"fmt"
+	"io"
+	"log/slog"
+	"os"
+	"path/filepath"
+	"regexp"
+	"slices"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"github.com/maratkarimov/kubertino/internal/audit"
+	"github.com/maratkarimov/kubertino/internal/config"
+	"github.com/maratkarimov/kubertino/internal/executor"
+	"github.com/maratkarimov/kubertino/internal/k8s"
+)
+
+// podOutput is a pod as printed by list pods -o json
+type podOutput struct {
+	Name       string            `json:"name"`
+	Status     string            `json:"status"`
+	Ready      string            `json:"ready,omitempty"`
+	Restarts   int               `json:"restarts"`
+	CreatedAt  *time.Time        `json:"created_at,omitempty"`
+	Node       string            `json:"node,omitempty"`
+	Containers []string          `json:"containers,omitempty"`
+	Labels     map[string]string `json:"labels,omitempty"`
+}
+
+// loadHeadlessConfig loads and validates the layered configuration, with plugin actions,
+// without prompting
+func loadHeadlessConfig(configPath string) (*config.Config, error) {
+	// A missing user config is bootstrapped in memory only
+	cfg, _, err := loadLayeredConfig(configPath, strings.NewReader(""), io.Discard)
+	if err != nil {
+		return nil, err
+	}
+	loadPlugins(cfg)
+	if err := config.Validate(cfg); err != nil {
+		return nil, fmt.Errorf("configuration validation failed: %w\n\nCheck %s", err, configSource(configPath))
+	}
+	return cfg, nil
+}
+
+// newAdapter creates the kubectl adapter for cfg
+func newAdapter(cfg *config.Config) *k8s.KubectlAdapter {
+	adapter := k8s.NewKubectlAdapter(cfg.Kubeconfig)
+	adapter.SetConcurrencyLimit(cfg.KubectlLimit)
+	if cfg.Throttled() {
+		adapter.SetRateLimit(cfg.KubectlRate)
+	}
+
+	timeout, err := cfg.KubectlTimeoutDuration()
+	if err != nil {
+		slog.Warn("invalid kubectl_timeout, using default", "error", err)
+		timeout = config.DefaultKubectlTimeout
+	}
+	backoff, err := cfg.RetryBackoffDuration()
+	if err != nil {
+		slog.Warn("invalid retry_backoff, using default", "error", err)
+		backoff = config.DefaultRetryBackoff
+	}
+	adapter.SetRetryPolicy(timeout, cfg.Retries, backoff)
+	adapter.SetNamespaceFallback(cfg.NamespaceFallback)
+	adapter.SetPodSelector(cfg.PodSelector)
+	if err := adapter.SetCustomPodColumns(cfg.CustomPodColumns); err != nil {
+		slog.Warn("invalid custom_pod_columns, not shown", "error", err)
+	}
+	adapter.SetContextKubeconfig(cfg.KubeconfigPath)
+	adapter.SetKubectlPath(cfg.KubectlPath)
+	exposeKubectl(cfg.KubectlPath)
+	return adapter
+}
+
+// exposeKubectl puts the directory of a configured kubectl first in PATH, so actions and
+// port-forwards, which run kubectl by name, use the same binary as kubertino
+func exposeKubectl(kubectlPath string) {
+	if kubectlPath == "" || filepath.Base(kubectlPath) != "kubectl" {
+		return
+	}
+	path, err := k8s.LookKubectl(kubectlPath)
+	if err != nil {
+		return // Reported by the startup check
+	}
+	dir := filepath.Dir(path)
+	os.Setenv("PATH", dir+string(os.PathListSeparator)+os.Getenv("PATH"))
+	slog.Debug("configured kubectl put first in PATH", "dir", dir)
+}
+
+// findContext returns the configured context called name. The name may be omitted when
+// exactly one context is configured.
+func findContext(cfg *config.Config, name string) (config.Context, error) {
+	if name == "" {
+		if len(cfg.Contexts) == 1 {
+			return cfg.Contexts[0], nil
+		}
+		return config.Context{}, fmt.Errorf("--context is required when several contexts are configured (%s)", contextNames(cfg))
+	}
+	for _, ctx := range cfg.Contexts {
+		if ctx.Name == name {
+			return ctx, nil
+		}
+	}
+	return config.Context{}, fmt.Errorf("context %q is not configured (configured: %s)", name, contextNames(cfg))
+}
+
+// contextNames lists the configured context names for error messages
+func contextNames(cfg *config.Config) string {
+	names := make([]string, len(cfg.Contexts))
+	for i, ctx := range cfg.Contexts {
+		names[i] = ctx.Name
+	}
+	return strings.Join(names, ", ")
+}
+
+// findAction returns the action of ctx whose name (case-insensitive) or shortcut is name.
+// Actions in a group are only found by name.
+func findAction(cfg *config.Config, ctx config.Context, name string) (config.Action, error) {
+	actions := config.MergeActions(cfg.Actions, ctx.Actions)
+	for _, action := range actions {
+		if strings.EqualFold(action.Name, name) || (action.Shortcut == name && action.Group == "") {
+			return action, nil
+		}
+	}
+
+	names := make([]string, len(actions))
+	for i, action := range actions {
+		names[i] = action.Name
+	}
+	return config.Action{}, fmt.Errorf("action %q is not configured for context %s (available: %s)", name, ctx.Name, strings.Join(names, ", "))
+}
+
+// listPods prints the pods of a namespace: kubertino list pods --context X [--namespace Y] [-o table|json|name]
+func listPods(configPath string, args []string, out io.Writer) error {
+	flags := flag.NewFlagSet("list pods", flag.ContinueOnError)
+	contextName := flags.String("context", "", "configured context to list pods from (optional with a single context)")
+	namespace := flags.String("namespace", "default", "namespace to list pods from")
+	output := flags.String("o", "table", "output format: table, json or name")
+	if err := flags.Parse(args); err != nil {
+		return err
+	}
+	if flags.NArg() > 0 {
+		return fmt.Errorf("unexpected arguments: %s", strings.Join(flags.Args(), " "))
+	}
+	if *output != "table" && *output != "json" && *output != "name" {
+		return fmt.Errorf("unknown output format %q (use table, json or name)", *output)
+	}
+
+	cfg, err := loadHeadlessConfig(configPath)
+	if err != nil {
+		return err
+	}
+	ctx, err := findContext(cfg, *contextName)
+	if err != nil {
+		return err
+	}
+
+	slog.Info("listing pods", "context", ctx.Name, "namespace", *namespace)
+	pods, err := newAdapter(cfg).GetPods(ctx.Name, *namespace)
+	if err != nil {
+		return err
+	}
+
+	switch *output {
+	case "json":
+		return writePodsJSON(pods, out)
+	case "name":
+		for _, pod := range pods {
+			fmt.Fprintln(out, pod.Name)
+		}
+		return nil
+	default:
+		return writePodsTable(pods, time.Now(), out)
+	}
+}
+
+// writePodsJSON prints pods as a JSON array
+func writePodsJSON(pods []k8s.Pod, out io.Writer) error {
+	result := make([]podOutput, len(pods))
+	for i, pod := range pods {
+		result[i] = podOutput{
+			Name:       pod.Name,
+			Status:     pod.Status,
+			Ready:      pod.Ready,
+			Restarts:   pod.Restarts,
+			Node:       pod.Node,
+			Containers: pod.Containers,
+			Labels:     pod.Labels,
+		}
+		if !pod.CreatedAt.IsZero() {
+			created := pod.CreatedAt
+			result[i].CreatedAt = &created
+		}
+	}
+
+	encoder := json.NewEncoder(out)
+	encoder.SetIndent("", "  ")
+	return encoder.Encode(result)
+}
+
+// writePodsTable prints pods in kubectl's column layout
+func writePodsTable(pods []k8s.Pod, now time.Time, out io.Writer) error {
+	w := tabwriter.NewWriter(out, 0, 0, 3, ' ', 0)
+	fmt.Fprintln(w, "NAME\tSTATUS\tREADY\tRESTARTS\tAGE\tNODE")
+	for _, pod := range pods {
+		age := "-"
+		if !pod.CreatedAt.IsZero() {
+			age = k8s.FormatAge(pod.Age(now))
+		}
+		fmt.Fprintf(w, "%s\t%s\t%s\t%d\t%s\t%s\n", pod.Name, pod.Status, orDash(pod.Ready), pod.Restarts, age, orDash(pod.Node))
+	}
+	return w.Flush()
+}
+
+// orDash returns value, or "-" when it is empty
+func orDash(value string) string {
+	if value == "" {
+		return "-"
+	}
+	return value
+}
+
+// execAction runs an action against a pod without the TUI:
+// kubertino exec --context X --namespace Y --pod-pattern Z --action console.
+// Actions scoped to the namespace or context run without a pod. The command's output and
+// exit code are passed through.
+func execAction(configPath string, args []string) error {
+	flags := flag.NewFlagSet("exec", flag.ContinueOnError)
+	contextName := flags.String("context", "", "configured context to run in (optional with a single context)")
+	namespace := flags.String("namespace", "default", "namespace of the pod")
+	podName := flags.String("pod", "", "exact name of the pod to run against")
+	podPattern := flags.String("pod-pattern", "", "regex selecting the pod to run against (first match)")
+	actionName := flags.String("action", "", "name (case-insensitive) or shortcut of the action to run")
+	yes := flags.Bool("yes", false, "run destructive actions without confirmation")
+	params := map[string]string{}
+	flags.Func("param", "value of an action param as name=value (repeatable; defaults apply otherwise)", func(value string) error {
+		name, value, ok := strings.Cut(value, "=")
+		if !ok || name == "" {
+			return fmt.Errorf("expected name=value")
+		}
+		params[name] = value
+		return nil
+	})
+	if err := flags.Parse(args); err != nil {
+		return err
+	}
+	if flags.NArg() > 0 {
+		return fmt.Errorf("unexpected arguments: %s", strings.Join(flags.Args(), " "))
+	}
+	if *actionName == "" {
+		return fmt.Errorf("--action is required")
+	}
+	cfg, err := loadHeadlessConfig(configPath)
+	if err != nil {
+		return err
+	}
+	ctx, err := findContext(cfg, *contextName)
+	if err != nil {
+		return err
+	}
+	action, err := findAction(cfg, ctx, *actionName)
+	if err != nil {
+		return err
+	}
+	needsPod := action.TargetScope() == config.ActionScopePod
+	if needsPod && (*podName == "") == (*podPattern == "") {
+		return fmt.Errorf("exactly one of --pod or --pod-pattern is required")
+	}
+	if !needsPod && (*podName != "" || *podPattern != "") {
+		return fmt.Errorf("action %q has %s scope and runs without a pod; remove --pod and --pod-pattern", action.Name, action.TargetScope())
+	}
+	if cfg.ReadOnlyBlocks(ctx, action) {
+		return fmt.Errorf("action %q is disabled: context %s is read-only", action.Name, ctx.Name)
+	}
+	for name := range params {
+		if !slices.ContainsFunc(action.Params, func(param config.ActionParam) bool { return param.Name == name }) {
+			return fmt.Errorf("action %q has no param %q", action.Name, name)
+		}
+	}
+	if err := action.CheckParams(params); err != nil {
+		return fmt.Errorf("action %q: %w (pass --param name=value)", action.Name, err)
+	}
+	if action.Destructive && !*yes {
+		return fmt.Errorf("action %q is destructive; pass --yes to run it in namespace %s of context %s", action.Name, *namespace, ctx.Name)
+	}
+
+	var pod k8s.Pod
+	var resource k8s.Resource
+	if needsPod {
+		pods, err := newAdapter(cfg).GetPods(ctx.Name, *namespace)
+		if err != nil {
+			return err
+		}
+		pod, err = selectPod(pods, *podName, *podPattern)
+		if err != nil {
+			return fmt.Errorf("%w in namespace %s of context %s", err, *namespace, ctx.Name)
+		}
+		if !action.AppliesToTarget(pod.Name) {
+			return fmt.Errorf("action %q does not apply to %s (it only runs on names matching %s)", action.Name, pod.Name, action.AppliesTo)
+		}
+		resource = k8s.PodResource(pod)
+	}
+
+	// Render once so the audit record shows exactly what ran
+	exec := executor.NewExecutor()
+	command, err := exec.Command(action, ctx, *namespace, resource, pod, cfg.KubeconfigPath(ctx.Name), params)
+	if err != nil {
+		return err
+	}
+	cmd := exec.PrepareBatchRendered(command, cfg.KubeconfigPath(ctx.Name))
+
+	auditLog, err := audit.FromConfig(cfg.Audit)
+	if err != nil {
+		return err
+	}
+	defer auditLog.Close()
+
+	record := audit.NewRecord(audit.SourceExec)
+	record.Context, record.Namespace = ctx.Name, *namespace
+	record.Kind, record.Target = string(resource.Kind), resource.Name
+	record.Action, record.Destructive = action.Name, action.Destructive
+	record.Command = command
+
+	slog.Info("running action", "action", action.Name, "context", ctx.Name, "namespace", *namespace, "pod", pod.Name)
+	err = cmd.Run()
+	auditLog.Log(record.Finish(err))
+	if err != nil {
+		return fmt.Errorf("action %q failed: %w", action.Name, err)
+	}
+	return nil
+}
+
+// testActions renders the actions of every context for a sample pod and checks the commands
+// with sh -n, without running them: kubertino config test-actions. Returns an error when an
+// action fails, so broken commands can be caught in CI.
+func testActions(configPath string, out io.Writer) error {
+	cfg, err := loadHeadlessConfig(configPath)
+	if err != nil {
+		return err
+	}
+
+	exec := executor.NewExecutor()
+	checked, failed := 0, 0
+	for _, ctx := range cfg.Contexts {
+		fmt.Fprintf(out, "%s\n", ctx.Name)
+		for _, action := range config.MergeActions(cfg.Actions, ctx.Actions) {
+			checked++
+			if _, err := exec.CheckCommand(action, ctx, cfg.KubeconfigPath(ctx.Name)); err != nil {
+				failed++
+				fmt.Fprintf(out, "  FAIL  %s [%s]: %v\n", action.Name, action.Shortcut, err)
+				continue
+			}
+			fmt.Fprintf(out, "  ok    %s [%s]\n", action.Name, action.Shortcut)
+		}
+	}
+
+	if failed > 0 {
+		return fmt.Errorf("%d of %d action commands failed", failed, checked)
+	}
+	fmt.Fprintf(out, "%d action commands ok\n", checked)
+	return nil
+}
+
+// selectPod returns the pod called name, or the first pod matching pattern
+func selectPod(pods []k8s.Pod, name, pattern string) (k8s.Pod, error) {
+	if name != "" {
+		for _, pod := range pods {
+			if pod.Name == name {
+				return pod, nil
+			}
+		}
+		return k8s.Pod{}, fmt.Errorf("pod %q not found", name)
+	}
+
+	re, err := regexp.Compile(pattern)
+	if err != nil {
+		return k8s.Pod{}, fmt.Errorf("invalid --pod-pattern: %w", err)
+	}
+	index, ok := k8s.MatchDefaultPod(pods, re)
+	if !ok {
+		return k8s.Pod{}, fmt.Errorf("no pod matches %q", pattern)
+	}
+	return pods[index], nil
+}
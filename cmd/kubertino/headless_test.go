@@ -0,0 +1,250 @@
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// headlessPods is the kubectl get pods output served by the stub kubectl
+const headlessPods = `{"items": [
+	{"metadata": {"name": "web-7f9c-abcde", "creationTimestamp": "2020-01-01T00:00:00Z", "labels": {"app": "web"}},
+	 "spec": {"nodeName": "node-a", "containers": [{"name": "web"}]},
+	 "status": {"phase": "Running", "containerStatuses": [{"ready": true, "restartCount": 2}]}},
+	{"metadata": {"name": "worker-1"}, "status": {"phase": "Pending"}}
+]}`
+
+// setupHeadless writes a config and a stub kubectl that serves headlessPods, and isolates HOME
+func setupHeadless(t *testing.T) string {
+	t.Helper()
+	dir := t.TempDir()
+	t.Setenv("HOME", dir)
+	t.Setenv("XDG_CONFIG_HOME", "")
+	t.Chdir(dir)
+
+	bin := filepath.Join(dir, "bin")
+	require.NoError(t, os.MkdirAll(bin, 0o755))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "pods.json"), []byte(headlessPods), 0o600))
+	script := "#!/bin/sh\ncat '" + filepath.Join(dir, "pods.json") + "'\n"
+	require.NoError(t, os.WriteFile(filepath.Join(bin, "kubectl"), []byte(script), 0o755))
+	t.Setenv("PATH", bin+string(os.PathListSeparator)+os.Getenv("PATH"))
+
+	configPath := filepath.Join(dir, "kubertino.yml")
+	require.NoError(t, os.WriteFile(configPath, []byte(`version: "1.0"
+actions:
+  - name: Console
+    shortcut: c
+    command: echo "{{.context}}/{{.namespace}}/{{.pod}}/{{.container}}" > `+filepath.Join(dir, "ran")+`
+    applies_to: "^web-"
+  - name: Fail
+    shortcut: f
+    command: exit 3
+  - name: Drop DB
+    shortcut: D
+    command: echo dropped > `+filepath.Join(dir, "ran")+`
+    destructive: true
+  - name: Tail
+    shortcut: t
+    command: echo "{{.pod}} {{.params.lines}}" > `+filepath.Join(dir, "ran")+`
+    params:
+      - name: lines
+        required: true
+        pattern: "^[0-9]+$"
+contexts:
+  - name: prod
+  - name: staging
+    read_only: true
+`), 0o600))
+	return configPath
+}
+
+func TestListPods(t *testing.T) {
+	configPath := setupHeadless(t)
+
+	t.Run("json", func(t *testing.T) {
+		var out bytes.Buffer
+		require.NoError(t, runCommand(configPath, []string{"list", "pods", "--context", "prod", "-o", "json"}, &out))
+
+		var pods []podOutput
+		require.NoError(t, json.Unmarshal(out.Bytes(), &pods))
+		require.Len(t, pods, 2)
+		assert.Equal(t, "web-7f9c-abcde", pods[0].Name)
+		assert.Equal(t, "Running", pods[0].Status)
+		assert.Equal(t, "1/1", pods[0].Ready)
+		assert.Equal(t, 2, pods[0].Restarts)
+		assert.Equal(t, "node-a", pods[0].Node)
+		assert.Equal(t, map[string]string{"app": "web"}, pods[0].Labels)
+		require.NotNil(t, pods[0].CreatedAt)
+		assert.Nil(t, pods[1].CreatedAt)
+	})
+
+	t.Run("table", func(t *testing.T) {
+		var out bytes.Buffer
+		require.NoError(t, runCommand(configPath, []string{"list", "pods", "--context", "prod"}, &out))
+
+		lines := strings.Split(strings.TrimSpace(out.String()), "\n")
+		require.Len(t, lines, 3)
+		assert.Regexp(t, `^NAME\s+STATUS\s+READY\s+RESTARTS\s+AGE\s+NODE$`, lines[0])
+		assert.Regexp(t, `^web-7f9c-abcde\s+Running\s+1/1\s+2\s+\d+d\s+node-a$`, lines[1])
+		assert.Regexp(t, `^worker-1\s+Pending\s+-\s+0\s+-\s+-$`, lines[2])
+	})
+
+	t.Run("names", func(t *testing.T) {
+		var out bytes.Buffer
+		require.NoError(t, runCommand(configPath, []string{"list", "pods", "--context", "prod", "-o", "name"}, &out))
+		assert.Equal(t, "web-7f9c-abcde\nworker-1\n", out.String())
+	})
+
+	t.Run("errors", func(t *testing.T) {
+		err := runCommand(configPath, []string{"list", "pods"}, &bytes.Buffer{})
+		assert.ErrorContains(t, err, "--context is required")
+
+		err = runCommand(configPath, []string{"list", "pods", "--context", "dev"}, &bytes.Buffer{})
+		assert.ErrorContains(t, err, `context "dev" is not configured (configured: prod, staging)`)
+
+		err = runCommand(configPath, []string{"list", "pods", "--context", "prod", "-o", "yaml"}, &bytes.Buffer{})
+		assert.ErrorContains(t, err, "unknown output format")
+	})
+}
+
+func TestExecAction(t *testing.T) {
+	configPath := setupHeadless(t)
+	ran := filepath.Join(filepath.Dir(configPath), "ran")
+
+	t.Run("pod pattern and action name", func(t *testing.T) {
+		require.NoError(t, runCommand(configPath, []string{"exec", "--context", "prod", "--namespace", "shop", "--pod-pattern", "^web", "--action", "console"}, &bytes.Buffer{}))
+
+		data, err := os.ReadFile(ran)
+		require.NoError(t, err)
+		assert.Equal(t, "prod/shop/web-7f9c-abcde/web\n", string(data))
+	})
+
+	t.Run("exit code is passed through", func(t *testing.T) {
+		err := runCommand(configPath, []string{"exec", "--context", "prod", "--pod", "worker-1", "--action", "f"}, &bytes.Buffer{})
+		var exitErr *exec.ExitError
+		require.ErrorAs(t, err, &exitErr)
+		assert.Equal(t, 3, exitErr.ExitCode())
+	})
+
+	t.Run("destructive actions need --yes", func(t *testing.T) {
+		require.NoError(t, os.Remove(ran))
+		args := []string{"exec", "--context", "prod", "--pod", "worker-1", "--action", "Drop DB"}
+		err := runCommand(configPath, args, &bytes.Buffer{})
+		assert.ErrorContains(t, err, "pass --yes")
+		assert.NoFileExists(t, ran)
+
+		require.NoError(t, runCommand(configPath, append(args, "--yes"), &bytes.Buffer{}))
+		assert.FileExists(t, ran)
+	})
+
+	t.Run("params", func(t *testing.T) {
+		args := []string{"exec", "--context", "prod", "--pod", "worker-1", "--action", "Tail"}
+		require.NoError(t, runCommand(configPath, append(args, "--param", "lines=20"), &bytes.Buffer{}))
+
+		data, err := os.ReadFile(ran)
+		require.NoError(t, err)
+		assert.Equal(t, "worker-1 20\n", string(data))
+	})
+
+	t.Run("errors", func(t *testing.T) {
+		tests := []struct {
+			args []string
+			want string
+		}{
+			{[]string{"--context", "prod", "--pod", "web-7f9c-abcde"}, "--action is required"},
+			{[]string{"--context", "prod", "--action", "c"}, "exactly one of --pod or --pod-pattern"},
+			{[]string{"--context", "prod", "--pod", "web-7f9c-abcde", "--action", "nope"}, `action "nope" is not configured for context prod (available: Console, Fail, Drop DB, Tail)`},
+			{[]string{"--context", "prod", "--pod-pattern", "^api", "--action", "c"}, `no pod matches "^api" in namespace default of context prod`},
+			{[]string{"--context", "prod", "--pod", "worker-1", "--action", "c"}, `does not apply to worker-1`},
+			{[]string{"--context", "staging", "--pod", "worker-1", "--action", "Drop DB", "--yes"}, `action "Drop DB" is disabled: context staging is read-only`},
+			{[]string{"--context", "prod", "--pod", "worker-1", "--action", "Tail"}, `action "Tail": lines is required (pass --param name=value)`},
+			{[]string{"--context", "prod", "--pod", "worker-1", "--action", "Tail", "--param", "lines=all"}, `action "Tail": lines must match ^[0-9]+$`},
+			{[]string{"--context", "prod", "--pod", "worker-1", "--action", "Tail", "--param", "lines=5", "--param", "grep=x"}, `action "Tail" has no param "grep"`},
+			{[]string{"--context", "prod", "--pod", "worker-1", "--action", "Tail", "--param", "lines"}, `name=value`},
+		}
+		for _, tt := range tests {
+			err := runCommand(configPath, append([]string{"exec"}, tt.args...), &bytes.Buffer{})
+			assert.ErrorContains(t, err, tt.want, "args %v", tt.args)
+		}
+	})
+}
+
+func TestExecAction_PluginAction(t *testing.T) {
+	configPath := setupHeadless(t)
+	dir := filepath.Dir(configPath)
+	ran := filepath.Join(dir, "ran")
+
+	pluginDir := filepath.Join(dir, ".config", "kubertino", "plugins")
+	require.NoError(t, os.MkdirAll(pluginDir, 0o755))
+	definitions := `[{"name": "Trace", "shortcut": "T", "command": "echo traced {{.pod}} > ` + ran + `"},` +
+		`{"name": "Shadow", "shortcut": "c", "command": "echo shadowed > ` + ran + `"}]`
+	require.NoError(t, os.WriteFile(filepath.Join(pluginDir, "tracing"), []byte("#!/bin/sh\necho '"+definitions+"'\n"), 0o755))
+
+	require.NoError(t, runCommand(configPath, []string{"exec", "--context", "prod", "--pod", "worker-1", "--action", "Trace"}, &bytes.Buffer{}))
+	data, err := os.ReadFile(ran)
+	require.NoError(t, err)
+	assert.Equal(t, "traced worker-1\n", string(data))
+
+	// The configured Console action keeps its shortcut
+	err = runCommand(configPath, []string{"exec", "--context", "prod", "--pod", "worker-1", "--action", "Shadow"}, &bytes.Buffer{})
+	assert.ErrorContains(t, err, `action "Shadow" is not configured`)
+}
+
+func TestTestActions(t *testing.T) {
+	configPath := setupHeadless(t)
+
+	var out bytes.Buffer
+	require.NoError(t, runCommand(configPath, []string{"config", "test-actions"}, &out))
+	assert.Contains(t, out.String(), "prod\n  ok    Console [c]\n")
+	assert.Contains(t, out.String(), "staging\n")
+	assert.Contains(t, out.String(), "8 action commands ok")
+
+	broken := filepath.Join(t.TempDir(), "broken.yml")
+	require.NoError(t, os.WriteFile(broken, []byte(`version: "1.0"
+actions:
+  - name: Logs
+    shortcut: l
+    command: kubectl logs {{.pods}}
+contexts:
+  - name: prod
+    actions:
+      - name: Grep
+        shortcut: g
+        command: kubectl logs {{.pod}} | grep "ERROR
+`), 0o600))
+
+	out.Reset()
+	err := runCommand(broken, []string{"config", "test-actions"}, &out)
+	assert.EqualError(t, err, "2 of 2 action commands failed")
+	assert.Contains(t, out.String(), `  FAIL  Logs [l]: template execution failed`)
+	assert.Contains(t, out.String(), `  FAIL  Grep [g]: shell syntax error`)
+}
+
+func TestExecAction_NamespaceScope(t *testing.T) {
+	configPath := setupHeadless(t)
+	ran := filepath.Join(filepath.Dir(configPath), "ran")
+	require.NoError(t, os.WriteFile(configPath, []byte(`version: "1.0"
+actions:
+  - name: Get All
+    shortcut: g
+    command: echo "{{.context}}/{{.namespace}}/{{.pod}}" > `+ran+`
+    scope: namespace
+contexts:
+  - name: prod
+`), 0o600))
+
+	require.NoError(t, runCommand(configPath, []string{"exec", "--namespace", "shop", "--action", "Get All"}, &bytes.Buffer{}))
+	data, err := os.ReadFile(ran)
+	require.NoError(t, err)
+	assert.Equal(t, "prod/shop/\n", string(data))
+
+	err = runCommand(configPath, []string{"exec", "--pod", "worker-1", "--action", "Get All"}, &bytes.Buffer{})
+	assert.ErrorContains(t, err, `action "Get All" has namespace scope and runs without a pod`)
+}
@@ -0,0 +1,544 @@
+package main
+
+import (
+	"bufio"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"log/slog"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/maratkarimov/kubertino/internal/audit"
+	"github.com/maratkarimov/kubertino/internal/changelog"
+	"github.com/maratkarimov/kubertino/internal/config"
+	"github.com/maratkarimov/kubertino/internal/history"
+	"github.com/maratkarimov/kubertino/internal/k8s"
+	"github.com/maratkarimov/kubertino/internal/logging"
+	"github.com/maratkarimov/kubertino/internal/plugins"
+	"github.com/maratkarimov/kubertino/internal/state"
+	"github.com/maratkarimov/kubertino/internal/tui"
+	"gopkg.in/yaml.v3"
+)
+
+func main() {
+	configPath := flag.String("config", config.DefaultConfigPath,
+		fmt.Sprintf("path to kubertino configuration file (%q reads stdin; %s overrides)", config.StdinConfigPath, config.ConfigEnvVar))
+	debug := flag.Bool("debug", false, "log at debug level and show a live debug overlay in the TUI")
+	noColor := flag.Bool("no-color", false, "draw the TUI without colors (also set by NO_COLOR or TERM=dumb)")
+	ascii := flag.Bool("ascii", false, "draw borders, spinners and symbols with ASCII and use 8-color-safe styles\n(also set for TERM=vt100, linux and other consoles without box drawing)")
+	record := flag.String("record", "", "record the session to `FILE` for kubertino replay (describe output and\npod annotations are redacted)")
+	flag.Usage = usage
+	flag.Parse()
+
+	renderMode := tui.DetectRenderMode(os.Getenv)
+	renderMode.NoColor = renderMode.NoColor || *noColor
+	renderMode.ASCII = renderMode.ASCII || *ascii
+
+	var err error
+	if args := flag.Args(); len(args) > 0 {
+		err = runCommand(*configPath, args, os.Stdout)
+	} else {
+		err = run(*configPath, *debug, renderMode, *record)
+	}
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+		// kubertino exec passes the exit code of the action through
+		var exitErr *exec.ExitError
+		if errors.As(err, &exitErr) && exitErr.ExitCode() > 0 {
+			os.Exit(exitErr.ExitCode())
+		}
+		os.Exit(1)
+	}
+}
+
+// usage prints the command line help
+func usage() {
+	out := flag.CommandLine.Output()
+	fmt.Fprintf(out, "Usage:\n")
+	fmt.Fprintf(out, "  kubertino [flags]              start the TUI\n")
+	fmt.Fprintf(out, "  kubertino [flags] config show [--effective]\n")
+	fmt.Fprintf(out, "                                 print the merged configuration (--effective: validated,\n")
+	fmt.Fprintf(out, "                                 with defaults filled in and actions merged per context)\n")
+	fmt.Fprintf(out, "  kubertino [flags] config init [--force]\n")
+	fmt.Fprintf(out, "                                 pick contexts, favorite namespaces and actions in a wizard\n")
+	fmt.Fprintf(out, "                                 and write them to the config file\n")
+	fmt.Fprintf(out, "  kubertino [flags] config import --from k9s|aliases FILE\n")
+	fmt.Fprintf(out, "                                 translate k9s plugins or kubectl shell aliases into actions\n")
+	fmt.Fprintf(out, "                                 and print them, with the entries that could not be translated\n")
+	fmt.Fprintf(out, "  kubertino [flags] config test-actions\n")
+	fmt.Fprintf(out, "                                 render every action of every context for a sample pod and\n")
+	fmt.Fprintf(out, "                                 check the commands with sh -n, without running them\n")
+	fmt.Fprintf(out, "  kubertino [flags] list pods [--context NAME] [--namespace NS] [-o table|json|name]\n")
+	fmt.Fprintf(out, "                                 print the pods of a namespace\n")
+	fmt.Fprintf(out, "  kubertino [flags] exec [--context NAME] [--namespace NS] (--pod NAME | --pod-pattern REGEX)\n")
+	fmt.Fprintf(out, "                         --action NAME [--yes]\n")
+	fmt.Fprintf(out, "                                 run an action against a pod without the TUI; the action's\n")
+	fmt.Fprintf(out, "                                 output and exit code are passed through\n")
+	fmt.Fprintf(out, "  kubertino [flags] doctor       check the config, kubeconfig, kubectl, terminal and the\n")
+	fmt.Fprintf(out, "                                 state and log directories, with hints for every problem\n")
+	fmt.Fprintf(out, "  kubertino replay [--frames] [--golden FILE [--update]] RECORDING\n")
+	fmt.Fprintf(out, "                                 replay a session recorded with --record and print the final\n")
+	fmt.Fprintf(out, "                                 screen, or compare it with a golden file\n\n")
+	fmt.Fprintf(out, "Flags:\n")
+	flag.PrintDefaults()
+}
+
+// run loads the configuration and starts the TUI. debug logs at debug level and shows the
+// latest log lines in a debug overlay; renderMode says how the TUI is drawn. recordPath, if
+// set, is the file the session is recorded to.
+func run(configPath string, debug bool, renderMode tui.RenderMode, recordPath string) error {
+	var recent *logging.Recent
+	if debug {
+		recent = logging.NewRecent(debugLogLines)
+	}
+	logFile, _, err := setupLogging(nil, debug, recent)
+	if err != nil {
+		return err
+	}
+	defer func() { logFile.Close() }()
+
+	cfg, projectPath, err := loadLayeredConfig(configPath, os.Stdin, os.Stdout)
+	if err != nil {
+		return err
+	}
+	loadPlugins(cfg)
+
+	if err := config.Validate(cfg); err != nil {
+		return fmt.Errorf("configuration validation failed: %w\n\nCheck %s", err, configSource(configPath))
+	}
+
+	// Switch to the log file and level of the configuration
+	configuredLog, logPath, err := setupLogging(cfg, debug, recent)
+	if err != nil {
+		return err
+	}
+	logFile.Close()
+	logFile = configuredLog
+	for _, shadowed := range tui.ShadowedKeys(cfg) {
+		slog.Warn("key binding shadowed by an action shortcut", "detail", shadowed)
+	}
+
+	auditLog, err := audit.FromConfig(cfg.Audit)
+	if err != nil {
+		return err
+	}
+	defer auditLog.Close()
+
+	model := tui.NewAppModel(cfg, newAdapter(cfg))
+	model.SetAuditLogger(auditLog)
+	model.SetRenderMode(renderMode, os.Getenv)
+	// Crash reports go next to the log file
+	model.SetCrashDir(filepath.Dir(logPath))
+	if recent != nil {
+		model.SetDebugLog(recent)
+	}
+	// Looking up kubectl and reading kubeconfig can be slow (networked home directories,
+	// auth plugins), so they run in the background once the TUI is up
+	model.SetStartupCheck(func() error {
+		return k8s.Preflight(cfg, cfg.Kubeconfig)
+	})
+	options := []tea.ProgramOption{tea.WithAltScreen(), tea.WithMouseCellMotion()}
+	switch configSource(configPath) {
+	case configPath:
+		// Settings changes are only persisted for file-backed configs, and not while a
+		// project config is merged in (its values would leak into the user file). A
+		// bootstrapped config the user chose not to write stays in memory.
+		if exists, err := config.Exists(configPath); projectPath == "" && err == nil && exists {
+			model.SetConfigPath(configPath)
+		}
+	case "stdin":
+		// Stdin carried the config, so read keys from the terminal instead
+		options = append(options, tea.WithInputTTY())
+	}
+	// The reload key re-reads the configuration; stdin cannot be read a second time
+	if configSource(configPath) != "stdin" {
+		model.SetConfigReload(func() (*config.Config, error) {
+			return loadHeadlessConfig(configPath)
+		})
+	}
+
+	// Restore the last context, namespace and pod from the previous session. Recorded sessions
+	// start fresh, as a replay does.
+	if statePath, err := state.DefaultPath(); err != nil {
+		slog.Warn("session state disabled", "error", err)
+	} else if recordPath != "" {
+		model.SetState(state.New(), statePath)
+	} else {
+		st, err := state.Load(statePath)
+		if err != nil {
+			slog.Warn("failed to load session state, starting fresh", "path", statePath, "error", err)
+			st = state.New()
+		}
+		model.SetState(st, statePath)
+	}
+
+	// Executed actions are kept for the history view
+	if historyPath, err := history.DefaultPath(); err != nil {
+		slog.Warn("action history disabled", "error", err)
+	} else if store, err := history.Open(historyPath); err != nil {
+		slog.Warn("action history disabled", "path", historyPath, "error", err)
+	} else {
+		model.SetHistory(store)
+	}
+
+	if recordPath != "" {
+		recording, err := os.OpenFile(recordPath, os.O_WRONLY|os.O_CREATE|os.O_TRUNC, 0600)
+		if err != nil {
+			return fmt.Errorf("failed to create recording: %w", err)
+		}
+		defer recording.Close()
+		recorder, err := tui.NewRecorder(recording, cfg, renderMode)
+		if err != nil {
+			return err
+		}
+		options = append(options, tea.WithFilter(recorder.Filter))
+		slog.Info("recording session", "path", recordPath)
+	}
+
+	slog.Info("starting kubertino", "version", changelog.Current(), "config", configSource(configPath), "project_config", projectPath, "contexts", len(cfg.Contexts))
+	finalModel, err := tea.NewProgram(model, options...).Run()
+	app, ok := finalModel.(tui.AppModel)
+	if ok {
+		// Stop background port-forwards so they do not outlive the TUI
+		app.Close()
+	}
+	if err != nil {
+		return fmt.Errorf("TUI failed: %w", err)
+	}
+	if ok {
+		// A failed startup check is reported once the TUI has closed
+		return app.Err()
+	}
+
+	return nil
+}
+
+// configSource describes where the configuration is read from: the KUBERTINO_CONFIG_B64
+// environment variable, stdin, or the config file path
+func configSource(configPath string) string {
+	if os.Getenv(config.ConfigEnvVar) != "" {
+		return "$" + config.ConfigEnvVar
+	}
+	if configPath == config.StdinConfigPath {
+		return "stdin"
+	}
+	return configPath
+}
+
+// loadLayeredConfig loads the base configuration with loadConfig and, for file-backed
+// configs, overlays the nearest project-local .kubertino.yml found from the working directory.
+// Precedence (highest first): KUBERTINO_CONFIG_B64 or stdin (no overlay), then the project
+// config, then the user config file. Returns the project config path ("" when none applies).
+func loadLayeredConfig(configPath string, in io.Reader, out io.Writer) (*config.Config, string, error) {
+	cfg, err := loadConfig(configPath, in, out)
+	if err != nil {
+		return nil, "", err
+	}
+	if configSource(configPath) != configPath {
+		return cfg, "", nil
+	}
+
+	cwd, err := os.Getwd()
+	if err != nil {
+		return nil, "", fmt.Errorf("failed to get working directory: %w", err)
+	}
+	projectPath, err := config.FindProjectConfig(cwd, configPath)
+	if err != nil || projectPath == "" {
+		return cfg, "", err
+	}
+
+	project, err := config.ParseProject(projectPath)
+	if err != nil {
+		return nil, "", fmt.Errorf("project config %s: %w", projectPath, err)
+	}
+	slog.Info("applying project config", "path", projectPath)
+	return config.Overlay(cfg, project), projectPath, nil
+}
+
+// runCommand runs a non-interactive subcommand
+func runCommand(configPath string, args []string, out io.Writer) error {
+	logFile, _, err := setupLogging(nil, false, nil)
+	if err != nil {
+		return err
+	}
+	defer logFile.Close()
+
+	switch {
+	case len(args) >= 2 && args[0] == "config" && args[1] == "show":
+		flags := flag.NewFlagSet("config show", flag.ContinueOnError)
+		effective := flags.Bool("effective", false, "validate and print the configuration with defaults and merged actions filled in")
+		if err := flags.Parse(args[2:]); err != nil {
+			return err
+		}
+		if flags.NArg() == 0 {
+			return showConfig(configPath, *effective, out)
+		}
+	case len(args) >= 2 && args[0] == "config" && args[1] == "init":
+		return initConfig(configPath, args[2:], out)
+	case len(args) >= 2 && args[0] == "config" && args[1] == "import":
+		return importActions(configPath, args[2:], out)
+	case len(args) == 2 && args[0] == "config" && args[1] == "test-actions":
+		return testActions(configPath, out)
+	case len(args) >= 2 && args[0] == "list" && args[1] == "pods":
+		return listPods(configPath, args[2:], out)
+	case args[0] == "exec":
+		return execAction(configPath, args[1:])
+	case args[0] == "doctor":
+		return runDoctor(configPath, args[1:], out)
+	case args[0] == "replay":
+		return replaySession(args[1:], out)
+	}
+	return fmt.Errorf("unknown command %q (see kubertino -h)", strings.Join(args, " "))
+}
+
+// showConfig prints the merged configuration as YAML, preceded by the files it came from.
+// With effective set, the configuration is validated and printed the way the TUI sees it:
+// every key binding listed, global actions merged into each context, favorites per context.
+func showConfig(configPath string, effective bool, out io.Writer) error {
+	// Never prompt: a missing user config is bootstrapped in memory only
+	cfg, projectPath, err := loadLayeredConfig(configPath, strings.NewReader(""), io.Discard)
+	if err != nil {
+		return err
+	}
+
+	if effective {
+		loadPlugins(cfg)
+		if err := config.Validate(cfg); err != nil {
+			return fmt.Errorf("configuration validation failed: %w", err)
+		}
+		if cfg, err = config.Effective(cfg); err != nil {
+			return err
+		}
+		cfg.Keymap = tui.KeyMapFromConfig(cfg.Keymap).ConfigKeymap()
+	}
+
+	fmt.Fprintf(out, "# user config: %s\n", configSource(configPath))
+	if projectPath != "" {
+		fmt.Fprintf(out, "# project config: %s\n", projectPath)
+	}
+	if effective {
+		fmt.Fprintf(out, "# effective: validated, defaults filled in, plugin and global actions merged into contexts\n")
+	}
+
+	data, err := yaml.Marshal(cfg)
+	if err != nil {
+		return fmt.Errorf("failed to serialize config: %w", err)
+	}
+	_, err = out.Write(data)
+	return err
+}
+
+// initConfig runs the config init wizard over the contexts of kubeconfig and writes the
+// result, with comments, to configPath. An existing file is only replaced with --force.
+func initConfig(configPath string, args []string, out io.Writer) error {
+	flags := flag.NewFlagSet("config init", flag.ContinueOnError)
+	force := flags.Bool("force", false, "replace an existing config file (a backup is kept)")
+	if err := flags.Parse(args); err != nil {
+		return err
+	}
+	if flags.NArg() > 0 {
+		return fmt.Errorf("unexpected argument %q", flags.Arg(0))
+	}
+	if source := configSource(configPath); source != configPath {
+		return fmt.Errorf("config init writes a config file, but the config is read from %s", source)
+	}
+
+	exists, err := config.Exists(configPath)
+	if err != nil {
+		return err
+	}
+	if exists && !*force {
+		return fmt.Errorf("config file %s already exists (pass --force to replace it)", configPath)
+	}
+
+	contexts, err := config.DiscoverContexts(config.KubeconfigPaths())
+	if err != nil {
+		return fmt.Errorf("config init needs kubeconfig contexts: %w", err)
+	}
+
+	wizard := tui.NewInitWizard(contexts, k8s.NewKubectlAdapter(""), configPath)
+	final, err := tea.NewProgram(wizard, tea.WithAltScreen()).Run()
+	if err != nil {
+		return fmt.Errorf("config init failed: %w", err)
+	}
+	wizard, ok := final.(tui.InitWizard)
+	if !ok || !wizard.Confirmed() {
+		fmt.Fprintf(out, "Cancelled; nothing was written.\n")
+		return nil
+	}
+
+	cfg := wizard.Config()
+	if err := config.Validate(cfg); err != nil {
+		return fmt.Errorf("configuration validation failed: %w", err)
+	}
+	if err := config.SaveInit(cfg, configPath); err != nil {
+		return err
+	}
+	slog.Info("config written by config init", "path", configPath, "contexts", len(cfg.Contexts), "actions", len(cfg.Actions))
+	fmt.Fprintf(out, "Configuration written to %s\n", configPath)
+	return nil
+}
+
+// importActions translates a k9s plugin file or a shell alias file into actions and prints
+// them as YAML for the actions section of the config file, preceded by comments naming the
+// entries that could not be translated. Shortcuts avoid those of the configured global
+// actions and the key bindings.
+func importActions(configPath string, args []string, out io.Writer) error {
+	flags := flag.NewFlagSet("config import", flag.ContinueOnError)
+	from := flags.String("from", "", "format of FILE: k9s (plugins.yaml) or aliases (shell alias file)")
+	if err := flags.Parse(args); err != nil {
+		return err
+	}
+	if flags.NArg() != 1 {
+		return fmt.Errorf("config import needs exactly one file to read")
+	}
+	if *from == "" {
+		return fmt.Errorf("--from is required (%s or %s)", config.ImportK9s, config.ImportAliases)
+	}
+
+	path := flags.Arg(0)
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return fmt.Errorf("failed to read %s: %w", path, err)
+	}
+
+	var taken []string
+	cfg, err := loadHeadlessConfig(configPath)
+	if err != nil {
+		// Importing should not depend on a working config; assume a fresh one
+		slog.Warn("config import: using the default actions and key bindings", "error", err)
+		cfg = &config.Config{Actions: config.DefaultActions()}
+	}
+	for _, action := range cfg.Actions {
+		if action.Group == "" {
+			taken = append(taken, action.Shortcut)
+		}
+	}
+	for _, group := range cfg.ActionGroups {
+		taken = append(taken, group.Shortcut)
+	}
+	taken = append(taken, tui.KeyMapFromConfig(cfg.Keymap).Keys()...)
+
+	result, err := config.Import(*from, data, taken)
+	if err != nil {
+		return err
+	}
+	slog.Info("actions imported", "from", *from, "path", path, "actions", len(result.Actions), "skipped", len(result.Skipped))
+
+	fmt.Fprintf(out, "# %d action(s) imported from %s; add them to the actions section of %s\n", len(result.Actions), path, configSource(configPath))
+	if len(result.Skipped) > 0 {
+		fmt.Fprintf(out, "# Not imported:\n")
+		for _, skipped := range result.Skipped {
+			fmt.Fprintf(out, "#   %s: %s\n", skipped.Name, skipped.Reason)
+		}
+	}
+	if len(result.Actions) == 0 {
+		return nil
+	}
+
+	encoded, err := yaml.Marshal(struct {
+		Actions []config.Action `yaml:"actions"`
+	}{result.Actions})
+	if err != nil {
+		return fmt.Errorf("failed to serialize actions: %w", err)
+	}
+	_, err = out.Write(encoded)
+	return err
+}
+
+// loadPlugins merges the actions of the plugins in the plugins directory into the global
+// actions of cfg
+func loadPlugins(cfg *config.Config) {
+	dir, err := plugins.DefaultDir()
+	if err != nil {
+		slog.Warn("plugins disabled", "error", err)
+		return
+	}
+	plugins.Load(cfg, dir)
+}
+
+// loadConfig loads the configuration from KUBERTINO_CONFIG_B64, stdin (configPath "-")
+// or the file at configPath, in that order of precedence.
+// When the file does not exist, a configuration is synthesized from kubeconfig and
+// the user is offered to write it to configPath.
+func loadConfig(configPath string, in io.Reader, out io.Writer) (*config.Config, error) {
+	if encoded := os.Getenv(config.ConfigEnvVar); encoded != "" {
+		slog.Info("loading config from environment", "variable", config.ConfigEnvVar)
+		return config.ParseBase64(encoded)
+	}
+	if configPath == config.StdinConfigPath {
+		slog.Info("loading config from stdin")
+		return config.ParseReader(in)
+	}
+
+	cfg, err := config.Parse(configPath)
+	if err == nil {
+		return cfg, nil
+	}
+	if !errors.Is(err, os.ErrNotExist) {
+		return nil, err
+	}
+
+	slog.Info("config file not found, bootstrapping from kubeconfig", "path", configPath)
+	cfg, err = config.Bootstrap()
+	if err != nil {
+		return nil, fmt.Errorf("no config file at %s and kubeconfig auto-discovery failed: %w", configPath, err)
+	}
+
+	fmt.Fprintf(out, "No config file found at %s.\n", configPath)
+	fmt.Fprintf(out, "Discovered %d context(s) from kubeconfig with a default action set.\n", len(cfg.Contexts))
+	fmt.Fprintf(out, "Write this configuration to %s? [y/N] ", configPath)
+
+	answer, _ := bufio.NewReader(in).ReadString('\n')
+	answer = strings.ToLower(strings.TrimSpace(answer))
+	if answer == "y" || answer == "yes" {
+		if err := config.Save(cfg, configPath); err != nil {
+			return nil, err
+		}
+		fmt.Fprintf(out, "Configuration written to %s\n", configPath)
+		slog.Info("bootstrapped config written", "path", configPath)
+	}
+
+	return cfg, nil
+}
+
+// debugLogLines is how many log lines --debug keeps in memory for the debug overlay
+const debugLogLines = 100
+
+// setupLogging directs slog output to a size-rotated log file so it does not interfere with
+// the TUI: the log_file and log_level of cfg, or ~/.kubertino/kubertino.log at info level
+// while cfg is nil. debug forces the debug level; recent, when set, also receives the records.
+// Returns the log file, to be closed on exit, and its path.
+func setupLogging(cfg *config.Config, debug bool, recent *logging.Recent) (io.Closer, string, error) {
+	path, err := logging.DefaultPath()
+	if err != nil {
+		return nil, "", err
+	}
+	level := slog.LevelInfo
+	if cfg != nil {
+		configured, err := cfg.LogPath()
+		if err != nil {
+			return nil, "", err
+		}
+		if configured != "" {
+			path = configured
+		}
+		if level, err = cfg.SlogLevel(); err != nil {
+			return nil, "", err
+		}
+	}
+	if debug {
+		level = slog.LevelDebug
+	}
+
+	logFile, err := logging.Setup(path, level, recent)
+	if err != nil {
+		return nil, "", err
+	}
+	return logFile, path, nil
+}
@@ -0,0 +1,245 @@
+package main
+
+import (
+	"bytes"
+	"encoding/base64"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/maratkarimov/kubertino/internal/config"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLoadConfig_Bootstrap(t *testing.T) {
+	dir := t.TempDir()
+	kubeconfig := filepath.Join(dir, "kubeconfig")
+	require.NoError(t, os.WriteFile(kubeconfig, []byte("contexts:\n  - name: kind-dev\n"), 0600))
+	t.Setenv("KUBECONFIG", kubeconfig)
+
+	t.Run("declined write keeps config in memory only", func(t *testing.T) {
+		configPath := filepath.Join(dir, "declined.yml")
+		var out bytes.Buffer
+
+		cfg, err := loadConfig(configPath, strings.NewReader("n\n"), &out)
+		require.NoError(t, err)
+		assert.Equal(t, "kind-dev", cfg.Contexts[0].Name)
+		assert.Contains(t, out.String(), "Discovered 1 context(s)")
+		assert.NoFileExists(t, configPath)
+	})
+
+	t.Run("accepted write persists config", func(t *testing.T) {
+		configPath := filepath.Join(dir, "accepted.yml")
+		var out bytes.Buffer
+
+		_, err := loadConfig(configPath, strings.NewReader("y\n"), &out)
+		require.NoError(t, err)
+
+		saved, err := config.Parse(configPath)
+		require.NoError(t, err)
+		assert.Equal(t, "kind-dev", saved.Contexts[0].Name)
+		assert.Len(t, saved.Actions, len(config.DefaultActions()))
+	})
+}
+
+func TestLoadConfig_ExistingFile(t *testing.T) {
+	configPath := filepath.Join(t.TempDir(), "kubertino.yml")
+	require.NoError(t, os.WriteFile(configPath, []byte("version: \"1.0\"\ncontexts:\n  - name: prod\n"), 0600))
+
+	var out bytes.Buffer
+	cfg, err := loadConfig(configPath, strings.NewReader(""), &out)
+	require.NoError(t, err)
+	assert.Equal(t, "prod", cfg.Contexts[0].Name)
+	assert.Empty(t, out.String(), "no prompt when config exists")
+}
+
+func TestLoadConfig_SideChannels(t *testing.T) {
+	yamlConfig := "version: \"1.0\"\ncontexts:\n  - name: ci\n"
+	missingPath := filepath.Join(t.TempDir(), "missing.yml")
+
+	t.Run("environment variable takes precedence over file and stdin", func(t *testing.T) {
+		t.Setenv(config.ConfigEnvVar, base64.StdEncoding.EncodeToString([]byte(yamlConfig)))
+		var out bytes.Buffer
+
+		cfg, err := loadConfig(config.StdinConfigPath, strings.NewReader("contexts: []\n"), &out)
+		require.NoError(t, err)
+		assert.Equal(t, "ci", cfg.Contexts[0].Name)
+		assert.Equal(t, "$"+config.ConfigEnvVar, configSource(missingPath))
+		assert.Empty(t, out.String(), "no bootstrap prompt")
+	})
+
+	t.Run("stdin", func(t *testing.T) {
+		var out bytes.Buffer
+
+		cfg, err := loadConfig(config.StdinConfigPath, strings.NewReader(yamlConfig), &out)
+		require.NoError(t, err)
+		assert.Equal(t, "ci", cfg.Contexts[0].Name)
+		assert.Equal(t, "stdin", configSource(config.StdinConfigPath))
+		assert.NoFileExists(t, config.StdinConfigPath)
+	})
+
+	t.Run("empty stdin", func(t *testing.T) {
+		_, err := loadConfig(config.StdinConfigPath, strings.NewReader(""), &bytes.Buffer{})
+		assert.ErrorIs(t, err, config.ErrEmptyConfig)
+	})
+}
+
+func TestLoadLayeredConfig_ProjectOverlay(t *testing.T) {
+	dir := t.TempDir()
+	userPath := filepath.Join(dir, "user.yml")
+	require.NoError(t, os.WriteFile(userPath, []byte("version: \"1.0\"\ncontexts:\n  - name: prod\n"), 0600))
+
+	repo := filepath.Join(dir, "repo")
+	nested := filepath.Join(repo, "svc")
+	require.NoError(t, os.MkdirAll(nested, 0755))
+	projectPath := filepath.Join(repo, config.ProjectConfigName)
+	require.NoError(t, os.WriteFile(projectPath, []byte("contexts:\n  - name: review\n    actions:\n      - name: Seed\n        shortcut: x\n        command: make seed\n"), 0600))
+	t.Chdir(nested)
+
+	t.Run("project config overlays user config", func(t *testing.T) {
+		cfg, found, err := loadLayeredConfig(userPath, strings.NewReader(""), &bytes.Buffer{})
+		require.NoError(t, err)
+		assert.Equal(t, projectPath, found)
+		require.Len(t, cfg.Contexts, 2)
+		assert.Equal(t, "review", cfg.Contexts[1].Name)
+	})
+
+	t.Run("side-channel configs are not overlaid", func(t *testing.T) {
+		cfg, found, err := loadLayeredConfig(config.StdinConfigPath, strings.NewReader("contexts:\n  - name: ci\n"), &bytes.Buffer{})
+		require.NoError(t, err)
+		assert.Empty(t, found)
+		assert.Len(t, cfg.Contexts, 1)
+	})
+
+	t.Run("config show prints merged yaml with sources", func(t *testing.T) {
+		t.Setenv("HOME", dir)
+		var out bytes.Buffer
+		require.NoError(t, runCommand(userPath, []string{"config", "show"}, &out))
+		assert.Contains(t, out.String(), "# user config: "+userPath)
+		assert.Contains(t, out.String(), "# project config: "+projectPath)
+		assert.Contains(t, out.String(), "command: make seed")
+	})
+
+	t.Run("unknown command", func(t *testing.T) {
+		t.Setenv("HOME", dir)
+		err := runCommand(userPath, []string{"config", "edit"}, &bytes.Buffer{})
+		assert.ErrorContains(t, err, "unknown command")
+	})
+}
+
+func TestShowConfig_Effective(t *testing.T) {
+	dir := t.TempDir()
+	t.Setenv("HOME", dir)
+	t.Chdir(dir)
+	userPath := filepath.Join(dir, "user.yml")
+	require.NoError(t, os.WriteFile(userPath, []byte(`version: "1.0"
+favorites: [default]
+keymap:
+  up: ["w"]
+actions:
+  - name: Logs
+    shortcut: l
+    command: kubectl logs {{.pod}}
+contexts:
+  - name: prod
+    actions:
+      - name: Shell
+        shortcut: s
+        command: kubectl exec -it {{.pod}} -- sh
+`), 0600))
+
+	var out bytes.Buffer
+	require.NoError(t, runCommand(userPath, []string{"config", "show", "--effective"}, &out))
+
+	effective, err := config.ParseBytes(out.Bytes())
+	require.NoError(t, err)
+	assert.Empty(t, effective.Actions, "global actions are merged into contexts")
+	require.Len(t, effective.Contexts[0].Actions, 2)
+	assert.Equal(t, "Logs", effective.Contexts[0].Actions[0].Name)
+	assert.Equal(t, []string{"w"}, effective.Keymap.Up)
+	assert.Equal(t, []string{"down", "j"}, effective.Keymap.Down, "defaults are spelled out")
+	favorites, err := config.GetFavorites(effective, "prod")
+	require.NoError(t, err)
+	assert.Equal(t, []string{"default"}, favorites)
+
+	t.Run("invalid config is rejected", func(t *testing.T) {
+		badPath := filepath.Join(dir, "bad.yml")
+		require.NoError(t, os.WriteFile(badPath, []byte("version: \"1.0\"\ncontexts: []\n"), 0600))
+		err := runCommand(badPath, []string{"config", "show", "--effective"}, &bytes.Buffer{})
+		assert.ErrorContains(t, err, "validation failed")
+
+		// Without --effective the raw merge is still printed
+		assert.NoError(t, runCommand(badPath, []string{"config", "show"}, &bytes.Buffer{}))
+	})
+}
+
+func TestInitConfig_Errors(t *testing.T) {
+	dir := t.TempDir()
+	t.Setenv("HOME", dir)
+	existing := filepath.Join(dir, "existing.yml")
+	require.NoError(t, os.WriteFile(existing, []byte("version: \"1.0\"\n"), 0600))
+
+	tests := []struct {
+		name       string
+		configPath string
+		args       []string
+		kubeconfig string
+		want       string
+	}{
+		{"existing file", existing, nil, "", "already exists (pass --force to replace it)"},
+		{"stdin config", config.StdinConfigPath, nil, "", "the config is read from stdin"},
+		{"no kubeconfig contexts", existing, []string{"--force"}, filepath.Join(dir, "missing"), "config init needs kubeconfig contexts"},
+		{"extra argument", existing, []string{"now"}, "", `unexpected argument "now"`},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Setenv("KUBECONFIG", tt.kubeconfig)
+			err := runCommand(tt.configPath, append([]string{"config", "init"}, tt.args...), &bytes.Buffer{})
+			assert.ErrorContains(t, err, tt.want)
+		})
+	}
+
+	data, err := os.ReadFile(existing)
+	require.NoError(t, err)
+	assert.Equal(t, "version: \"1.0\"\n", string(data), "left untouched")
+}
+
+func TestImportActions(t *testing.T) {
+	dir := t.TempDir()
+	t.Setenv("HOME", dir)
+	t.Chdir(dir)
+	configPath := filepath.Join(dir, "kubertino.yml")
+	require.NoError(t, os.WriteFile(configPath, []byte(`version: "1.0"
+actions:
+  - name: Logs
+    shortcut: l
+    command: kubectl logs {{.pod}}
+contexts:
+  - name: prod
+`), 0600))
+	aliases := filepath.Join(dir, "aliases")
+	require.NoError(t, os.WriteFile(aliases, []byte("alias kl='kubectl logs -f'\nalias kaf='kubectl apply -f'\n"), 0600))
+
+	var out bytes.Buffer
+	require.NoError(t, runCommand(configPath, []string{"config", "import", "--from", "aliases", aliases}, &out))
+	assert.Contains(t, out.String(), "# 1 action(s) imported from "+aliases)
+	assert.Contains(t, out.String(), "#   kaf: kubectl apply does not act on a selected pod\n")
+	// l is taken by Logs, k by the Up binding
+	assert.Contains(t, out.String(), "actions:\n    - name: kl\n      shortcut: b\n      command: kubectl logs -f -n {{.namespace}} {{.pod}}\n")
+
+	tests := []struct {
+		args []string
+		want string
+	}{
+		{[]string{aliases}, "--from is required (k9s or aliases)"},
+		{[]string{"--from", "aliases"}, "needs exactly one file"},
+		{[]string{"--from", "fish", aliases}, `unknown import format "fish"`},
+		{[]string{"--from", "k9s", filepath.Join(dir, "missing.yaml")}, "failed to read"},
+	}
+	for _, tt := range tests {
+		err := runCommand(configPath, append([]string{"config", "import"}, tt.args...), &bytes.Buffer{})
+		assert.ErrorContains(t, err, tt.want, "args %v", tt.args)
+	}
+}
@@ -0,0 +1,98 @@
+package main
+
+import (
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	"github.com/charmbracelet/lipgloss"
+	"github.com/maratkarimov/kubertino/internal/tui"
+	"github.com/muesli/termenv"
+)
+
+// replaySession replays a session recorded with --record without a cluster and prints the
+// final screen, every screen with --frames, or compares the final screen with a golden file.
+// --update writes the golden file instead.
+func replaySession(args []string, out io.Writer) error {
+	flags := flag.NewFlagSet("replay", flag.ContinueOnError)
+	frames := flags.Bool("frames", false, "print the screen after every recorded message")
+	golden := flags.String("golden", "", "compare the final screen with `FILE`")
+	update := flags.Bool("update", false, "write the final screen to the --golden file")
+	if err := flags.Parse(args); err != nil {
+		return err
+	}
+	if flags.NArg() != 1 {
+		return errors.New("replay needs the recording file")
+	}
+	if *update && *golden == "" {
+		return errors.New("--update needs --golden")
+	}
+
+	recording, err := os.Open(flags.Arg(0))
+	if err != nil {
+		return fmt.Errorf("failed to open recording: %w", err)
+	}
+	defer recording.Close()
+
+	// Screens are compared and printed as plain text
+	lipgloss.SetColorProfile(termenv.Ascii)
+	var final string
+	n := 0
+	err = tui.Replay(recording, func(msgType, view string) {
+		n++
+		final = view
+		if *frames {
+			fmt.Fprintf(out, "--- frame %d: %s\n%s\n", n, msgType, view)
+		}
+	})
+	if err != nil {
+		return err
+	}
+
+	switch {
+	case *update:
+		if err := os.WriteFile(*golden, []byte(final+"\n"), 0644); err != nil {
+			return fmt.Errorf("failed to write golden file: %w", err)
+		}
+		fmt.Fprintf(out, "wrote the final screen of %d message(s) to %s\n", n, *golden)
+	case *golden != "":
+		want, err := os.ReadFile(*golden)
+		if err != nil {
+			return fmt.Errorf("failed to read golden file: %w", err)
+		}
+		if line, ok := firstDifference(strings.TrimSuffix(string(want), "\n"), final); ok {
+			return fmt.Errorf("final screen differs from %s at line %d:\n  want: %q\n  got:  %q", *golden, line.number, line.want, line.got)
+		}
+		fmt.Fprintf(out, "final screen of %d message(s) matches %s\n", n, *golden)
+	case !*frames:
+		fmt.Fprintln(out, final)
+	}
+	return nil
+}
+
+// screenDifference is the first line two screens differ at
+type screenDifference struct {
+	number    int // 1-based
+	want, got string
+}
+
+// firstDifference returns the first line want and got differ at; ok is false when they match
+func firstDifference(want, got string) (diff screenDifference, ok bool) {
+	wantLines, gotLines := strings.Split(want, "\n"), strings.Split(got, "\n")
+	for i := range max(len(wantLines), len(gotLines)) {
+		var w, g string
+		if i < len(wantLines) {
+			w = wantLines[i]
+		}
+		if i < len(gotLines) {
+			g = gotLines[i]
+		}
+		if w != g {
+			return screenDifference{number: i + 1, want: w, got: g}, true
+		}
+	}
+	return screenDifference{}, false
+}
@@ -0,0 +1,74 @@
+package main
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/maratkarimov/kubertino/internal/config"
+	"github.com/maratkarimov/kubertino/internal/tui"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// writeRecording records a session moving to the second of two contexts and returns its file
+func writeRecording(t *testing.T) string {
+	t.Helper()
+	cfg := &config.Config{Version: "1.0", Contexts: []config.Context{{Name: "prod"}, {Name: "staging"}}}
+	var recording bytes.Buffer
+	recorder, err := tui.NewRecorder(&recording, cfg, tui.RenderMode{})
+	require.NoError(t, err)
+	model := tui.NewAppModel(cfg, nil)
+	for _, msg := range []tea.Msg{tea.WindowSizeMsg{Width: 80, Height: 20}, tea.KeyMsg{Type: tea.KeyDown}} {
+		recorder.Filter(model, msg)
+	}
+
+	path := filepath.Join(t.TempDir(), "session.jsonl")
+	require.NoError(t, os.WriteFile(path, recording.Bytes(), 0o600))
+	return path
+}
+
+func TestReplay(t *testing.T) {
+	t.Setenv("HOME", t.TempDir())
+	recording := writeRecording(t)
+	golden := filepath.Join(t.TempDir(), "session.golden")
+
+	t.Run("prints the final screen", func(t *testing.T) {
+		var out bytes.Buffer
+		require.NoError(t, runCommand("", []string{"replay", recording}, &out))
+		assert.Contains(t, out.String(), "staging")
+		assert.NotContains(t, out.String(), "\x1b[", "screens are plain text")
+	})
+
+	t.Run("frames", func(t *testing.T) {
+		var out bytes.Buffer
+		require.NoError(t, runCommand("", []string{"replay", "--frames", recording}, &out))
+		assert.Contains(t, out.String(), "--- frame 1: window\n")
+		assert.Contains(t, out.String(), "--- frame 2: key\n")
+	})
+
+	t.Run("golden files", func(t *testing.T) {
+		var out bytes.Buffer
+		require.NoError(t, runCommand("", []string{"replay", "--golden", golden, "--update", recording}, &out))
+		assert.Contains(t, out.String(), "wrote the final screen of 2 message(s)")
+
+		out.Reset()
+		require.NoError(t, runCommand("", []string{"replay", "--golden", golden, recording}, &out))
+		assert.Contains(t, out.String(), "matches")
+
+		screen, err := os.ReadFile(golden)
+		require.NoError(t, err)
+		require.NoError(t, os.WriteFile(golden, []byte(strings.Replace(string(screen), "staging", "stating", 1)), 0o600))
+		err = runCommand("", []string{"replay", "--golden", golden, recording}, &out)
+		assert.ErrorContains(t, err, "final screen differs from "+golden+" at line")
+		assert.ErrorContains(t, err, `want: "`)
+	})
+
+	t.Run("usage errors", func(t *testing.T) {
+		assert.EqualError(t, runCommand("", []string{"replay"}, &bytes.Buffer{}), "replay needs the recording file")
+		assert.EqualError(t, runCommand("", []string{"replay", "--update", recording}, &bytes.Buffer{}), "--update needs --golden")
+	})
+}
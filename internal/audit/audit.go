@@ -0,0 +1,175 @@
+// Package audit records executed actions and exports the records to configured sinks
+// (JSON Lines file, syslog, HTTP endpoint, shell history)
+package audit
+
+import (
+	"errors"
+	"fmt"
+	"log/slog"
+	"os"
+	"os/exec"
+	"os/user"
+	"sync"
+	"time"
+
+	"github.com/maratkarimov/kubertino/internal/config"
+)
+
+// Record sources
+const (
+	SourceTUI  = "tui"  // Action run from the TUI
+	SourceExec = "exec" // Action run with kubertino exec
+)
+
+// Record describes one executed action
+type Record struct {
+	Time        time.Time `json:"time"` // When the action started
+	User        string    `json:"user,omitempty"`
+	Host        string    `json:"host,omitempty"`
+	Source      string    `json:"source"`
+	Context     string    `json:"context"`
+	Namespace   string    `json:"namespace"`
+	Kind        string    `json:"kind"`   // Target kind, e.g. pod or deployment
+	Target      string    `json:"target"` // Target name
+	Action      string    `json:"action"`
+	Command     string    `json:"command"` // Rendered command
+	Destructive bool      `json:"destructive,omitempty"`
+	ExitCode    int       `json:"exit_code"` // -1 when the command could not be run
+	Error       string    `json:"error,omitempty"`
+	DurationMS  int64     `json:"duration_ms"`
+}
+
+// NewRecord starts a record for an action run from source, filling in time, user and host
+func NewRecord(source string) Record {
+	record := Record{Time: time.Now(), Source: source}
+	if u, err := user.Current(); err == nil {
+		record.User = u.Username
+	} else {
+		record.User = os.Getenv("USER")
+	}
+	record.Host, _ = os.Hostname()
+	return record
+}
+
+// Finish completes the record with the outcome of the command
+func (r Record) Finish(err error) Record {
+	r.DurationMS = time.Since(r.Time).Milliseconds()
+	if err == nil {
+		return r
+	}
+
+	r.Error = err.Error()
+	r.ExitCode = -1
+	var exitErr *exec.ExitError
+	if errors.As(err, &exitErr) {
+		r.ExitCode = exitErr.ExitCode()
+	}
+	return r
+}
+
+// Sink receives audit records
+type Sink interface {
+	Write(record Record) error
+	Close() error
+}
+
+// queueSize bounds the records waiting for slow sinks; further records are dropped
+const queueSize = 64
+
+// Logger sends records to its sinks in the background so slow sinks never block the TUI.
+// A nil Logger discards records.
+type Logger struct {
+	sinks   []Sink
+	records chan Record
+	wg      sync.WaitGroup
+}
+
+// New creates a logger writing to sinks
+func New(sinks ...Sink) *Logger {
+	l := &Logger{sinks: sinks, records: make(chan Record, queueSize)}
+	l.wg.Add(1)
+	go l.run()
+	return l
+}
+
+// FromConfig creates a logger for the configured sinks. Returns nil when none are configured.
+func FromConfig(cfg *config.Audit) (*Logger, error) {
+	if cfg == nil || len(cfg.Sinks) == 0 {
+		return nil, nil
+	}
+
+	var sinks []Sink
+	for i, sc := range cfg.Sinks {
+		sink, err := newSink(sc)
+		if err != nil {
+			for _, opened := range sinks {
+				opened.Close()
+			}
+			return nil, fmt.Errorf("audit sink %d (%s): %w", i, sc.Type, err)
+		}
+		sinks = append(sinks, sink)
+	}
+	return New(sinks...), nil
+}
+
+// newSink opens the sink described by sc
+func newSink(sc config.AuditSink) (Sink, error) {
+	switch sc.Type {
+	case config.AuditSinkFile:
+		path, err := sc.FilePath()
+		if err != nil {
+			return nil, err
+		}
+		return NewFileSink(path)
+	case config.AuditSinkSyslog:
+		return NewSyslogSink(sc.Address, sc.Tag)
+	case config.AuditSinkHTTP:
+		return NewHTTPSink(sc.URL, sc.Headers), nil
+	case config.AuditSinkShell:
+		path, err := sc.FilePath()
+		if err != nil {
+			return nil, err
+		}
+		return NewShellSink(path, sc.ShellHistory)
+	default:
+		return nil, fmt.Errorf("unknown sink type %q", sc.Type)
+	}
+}
+
+// Log queues record for all sinks. Records are dropped, with a warning, while the queue is full.
+func (l *Logger) Log(record Record) {
+	if l == nil {
+		return
+	}
+	select {
+	case l.records <- record:
+	default:
+		slog.Warn("audit queue full, record dropped", "action", record.Action, "target", record.Target)
+	}
+}
+
+// Close writes the queued records and closes the sinks
+func (l *Logger) Close() {
+	if l == nil {
+		return
+	}
+	close(l.records)
+	l.wg.Wait()
+	for _, sink := range l.sinks {
+		if err := sink.Close(); err != nil {
+			slog.Warn("failed to close audit sink", "error", err)
+		}
+	}
+}
+
+// run writes queued records to every sink until the logger is closed
+func (l *Logger) run() {
+	defer l.wg.Done()
+	for record := range l.records {
+		for _, sink := range l.sinks {
+			if err := sink.Write(record); err != nil {
+				slog.Warn("audit sink write failed", "action", record.Action, "error", err)
+			}
+		}
+	}
+}
@@ -0,0 +1,143 @@
+package audit
+
+import (
+	"bufio"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"testing"
+
+	"github.com/maratkarimov/kubertino/internal/config"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// readRecords reads the JSON Lines audit file at path
+func readRecords(t *testing.T, path string) []Record {
+	t.Helper()
+	file, err := os.Open(path)
+	require.NoError(t, err)
+	defer file.Close()
+
+	var records []Record
+	scanner := bufio.NewScanner(file)
+	for scanner.Scan() {
+		var record Record
+		require.NoError(t, json.Unmarshal(scanner.Bytes(), &record))
+		records = append(records, record)
+	}
+	require.NoError(t, scanner.Err())
+	return records
+}
+
+func TestRecordFinish(t *testing.T) {
+	record := NewRecord(SourceTUI)
+	assert.Equal(t, SourceTUI, record.Source)
+	assert.False(t, record.Time.IsZero())
+
+	t.Run("success", func(t *testing.T) {
+		finished := record.Finish(nil)
+		assert.Equal(t, 0, finished.ExitCode)
+		assert.Empty(t, finished.Error)
+	})
+
+	t.Run("exit code", func(t *testing.T) {
+		err := exec.Command("sh", "-c", "exit 3").Run()
+		finished := record.Finish(err)
+		assert.Equal(t, 3, finished.ExitCode)
+		assert.Equal(t, "exit status 3", finished.Error)
+	})
+
+	t.Run("command not run", func(t *testing.T) {
+		finished := record.Finish(errors.New("kubectl not found"))
+		assert.Equal(t, -1, finished.ExitCode)
+		assert.Equal(t, "kubectl not found", finished.Error)
+	})
+}
+
+func TestFileSink(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "nested", "audit.jsonl")
+	sink, err := NewFileSink(path)
+	require.NoError(t, err)
+
+	logger := New(sink)
+	logger.Log(Record{Source: SourceTUI, Context: "prod", Namespace: "shop", Kind: "pod", Target: "web-1", Action: "Console"})
+	logger.Log(Record{Source: SourceExec, Action: "Logs", ExitCode: 1})
+	logger.Close()
+
+	records := readRecords(t, path)
+	require.Len(t, records, 2)
+	assert.Equal(t, "web-1", records[0].Target)
+	assert.Equal(t, "Logs", records[1].Action)
+	assert.Equal(t, 1, records[1].ExitCode)
+
+	info, err := os.Stat(path)
+	require.NoError(t, err)
+	assert.Equal(t, os.FileMode(0600), info.Mode().Perm())
+}
+
+func TestHTTPSink(t *testing.T) {
+	t.Setenv("AUDIT_TOKEN", "secret")
+
+	var got Record
+	var auth string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		auth = r.Header.Get("Authorization")
+		assert.Equal(t, "application/json", r.Header.Get("Content-Type"))
+		assert.NoError(t, json.NewDecoder(r.Body).Decode(&got))
+	}))
+	defer server.Close()
+
+	sink := NewHTTPSink(server.URL, map[string]string{"Authorization": "Bearer ${AUDIT_TOKEN}"})
+	require.NoError(t, sink.Write(Record{Action: "Console", Target: "web-1"}))
+	assert.Equal(t, "Bearer secret", auth)
+	assert.Equal(t, "Console", got.Action)
+	assert.Equal(t, "web-1", got.Target)
+}
+
+func TestHTTPSink_ErrorStatus(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	defer server.Close()
+
+	err := NewHTTPSink(server.URL, nil).Write(Record{Action: "Console"})
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "503")
+}
+
+func TestFromConfig(t *testing.T) {
+	t.Run("no sinks", func(t *testing.T) {
+		logger, err := FromConfig(nil)
+		require.NoError(t, err)
+		assert.Nil(t, logger)
+
+		// A nil logger discards records
+		logger.Log(Record{Action: "Console"})
+		logger.Close()
+	})
+
+	t.Run("file sink", func(t *testing.T) {
+		path := filepath.Join(t.TempDir(), "audit.jsonl")
+		logger, err := FromConfig(&config.Audit{Sinks: []config.AuditSink{{Type: config.AuditSinkFile, Path: path}}})
+		require.NoError(t, err)
+		logger.Log(Record{Action: "Console"})
+		logger.Close()
+
+		assert.Len(t, readRecords(t, path), 1)
+	})
+
+	t.Run("unopenable sink", func(t *testing.T) {
+		dir := t.TempDir()
+		blocker := filepath.Join(dir, "file")
+		require.NoError(t, os.WriteFile(blocker, nil, 0644))
+
+		_, err := FromConfig(&config.Audit{Sinks: []config.AuditSink{{Type: config.AuditSinkFile, Path: filepath.Join(blocker, "audit.jsonl")}}})
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "audit sink 0 (file)")
+	})
+}
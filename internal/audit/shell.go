@@ -0,0 +1,137 @@
+package audit
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+)
+
+// Shell history formats
+const (
+	shellBash = "bash"
+	shellZsh  = "zsh"
+	shellFish = "fish"
+)
+
+// ShellSink appends the rendered command of each record to a history file in bash format:
+// a "#<epoch>" timestamp line, then the command. bash loads it with `history -r <file>`, so
+// commands run from the TUI can be recalled and re-run outside it. Optionally the commands
+// are also appended to the user's own shell history.
+type ShellSink struct {
+	mu   sync.Mutex
+	file *os.File
+	user *shellHistory // nil unless the user's shell history is written too
+}
+
+// shellHistory is the history file of the user's shell
+type shellHistory struct {
+	path   string
+	format string // shellBash, shellZsh or shellFish
+}
+
+// NewShellSink opens path for appending, creating it and its directory if needed. With
+// userHistory, commands are also appended to the history of the shell named by $SHELL.
+func NewShellSink(path string, userHistory bool) (*ShellSink, error) {
+	sink := &ShellSink{}
+	if userHistory {
+		history, err := userShellHistory(os.Getenv("SHELL"))
+		if err != nil {
+			return nil, err
+		}
+		sink.user = history
+	}
+
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		return nil, fmt.Errorf("failed to create history directory: %w", err)
+	}
+	file, err := os.OpenFile(path, os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0600)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open history file: %w", err)
+	}
+	sink.file = file
+	return sink, nil
+}
+
+// userShellHistory returns the history file of shell (a path such as /bin/zsh). $HISTFILE
+// is honored for bash and zsh when it is exported.
+func userShellHistory(shell string) (*shellHistory, error) {
+	homeDir, err := os.UserHomeDir()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get home directory: %w", err)
+	}
+
+	name := filepath.Base(shell)
+	switch name {
+	case shellBash, shellZsh:
+		path := os.Getenv("HISTFILE")
+		if path == "" {
+			dir := homeDir
+			if zdotdir := os.Getenv("ZDOTDIR"); name == shellZsh && zdotdir != "" {
+				dir = zdotdir
+			}
+			path = filepath.Join(dir, "."+name+"_history")
+		}
+		return &shellHistory{path: path, format: name}, nil
+	case shellFish:
+		dataDir := os.Getenv("XDG_DATA_HOME")
+		if dataDir == "" {
+			dataDir = filepath.Join(homeDir, ".local", "share")
+		}
+		return &shellHistory{path: filepath.Join(dataDir, "fish", "fish_history"), format: shellFish}, nil
+	}
+	return nil, fmt.Errorf("shell_history: unsupported shell %q (use bash, zsh or fish)", shell)
+}
+
+// Write appends the command of record; records without a command are skipped
+func (s *ShellSink) Write(record Record) error {
+	if record.Command == "" {
+		return nil
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if _, err := s.file.WriteString(historyEntry(shellBash, record)); err != nil {
+		return fmt.Errorf("failed to write history file: %w", err)
+	}
+	if s.user != nil {
+		if err := s.user.append(record); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Close closes the history file
+func (s *ShellSink) Close() error {
+	return s.file.Close()
+}
+
+// append adds the command of record to the shell's history file. The file is opened for
+// each record, as shells rewrite it when they exit.
+func (h *shellHistory) append(record Record) error {
+	file, err := os.OpenFile(h.path, os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0600)
+	if err != nil {
+		return fmt.Errorf("failed to open shell history: %w", err)
+	}
+	defer file.Close()
+	if _, err := file.WriteString(historyEntry(h.format, record)); err != nil {
+		return fmt.Errorf("failed to write shell history: %w", err)
+	}
+	return nil
+}
+
+// historyEntry formats the command of record as a history entry of the given shell
+func historyEntry(format string, record Record) string {
+	epoch := record.Time.Unix()
+	switch format {
+	case shellZsh:
+		// Extended history: ": <start>:<elapsed seconds>;<command>", newlines escaped
+		return fmt.Sprintf(": %d:%d;%s\n", epoch, record.DurationMS/1000, strings.ReplaceAll(record.Command, "\n", "\\\n"))
+	case shellFish:
+		command := strings.NewReplacer(`\`, `\\`, "\n", `\n`).Replace(record.Command)
+		return fmt.Sprintf("- cmd: %s\n  when: %d\n", command, epoch)
+	}
+	return fmt.Sprintf("#%d\n%s\n", epoch, record.Command)
+}
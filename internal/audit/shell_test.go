@@ -0,0 +1,86 @@
+package audit
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/maratkarimov/kubertino/internal/config"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestShellSink_WritesBashHistory(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "history", ".kubertino_history")
+	sink, err := NewShellSink(path, false)
+	require.NoError(t, err)
+
+	started := time.Unix(1700000000, 0)
+	require.NoError(t, sink.Write(Record{Time: started, Command: "kubectl logs -n app web-1"}))
+	require.NoError(t, sink.Write(Record{Time: started, Command: ""}), "records without a command are skipped")
+	require.NoError(t, sink.Close())
+
+	data, err := os.ReadFile(path)
+	require.NoError(t, err)
+	assert.Equal(t, "#1700000000\nkubectl logs -n app web-1\n", string(data))
+}
+
+func TestShellSink_AppendsToUserHistory(t *testing.T) {
+	home := t.TempDir()
+	t.Setenv("HOME", home)
+	t.Setenv("HISTFILE", "")
+	t.Setenv("ZDOTDIR", "")
+	t.Setenv("SHELL", "/bin/zsh")
+
+	sink, err := NewShellSink(filepath.Join(home, ".kubertino_history"), true)
+	require.NoError(t, err)
+	require.NoError(t, sink.Write(Record{Time: time.Unix(1700000000, 0), DurationMS: 2500, Command: "echo a\necho b"}))
+	require.NoError(t, sink.Close())
+
+	data, err := os.ReadFile(filepath.Join(home, ".zsh_history"))
+	require.NoError(t, err)
+	assert.Equal(t, ": 1700000000:2;echo a\\\necho b\n", string(data))
+}
+
+func TestUserShellHistory(t *testing.T) {
+	home := t.TempDir()
+	t.Setenv("HOME", home)
+	t.Setenv("HISTFILE", "")
+	t.Setenv("XDG_DATA_HOME", "")
+
+	bash, err := userShellHistory("/usr/bin/bash")
+	require.NoError(t, err)
+	assert.Equal(t, &shellHistory{path: filepath.Join(home, ".bash_history"), format: shellBash}, bash)
+
+	fish, err := userShellHistory("/usr/local/bin/fish")
+	require.NoError(t, err)
+	assert.Equal(t, filepath.Join(home, ".local", "share", "fish", "fish_history"), fish.path)
+
+	t.Setenv("HISTFILE", "/tmp/custom_history")
+	bash, err = userShellHistory("/bin/bash")
+	require.NoError(t, err)
+	assert.Equal(t, "/tmp/custom_history", bash.path)
+
+	_, err = userShellHistory("/bin/tcsh")
+	assert.ErrorContains(t, err, "unsupported shell")
+}
+
+func TestHistoryEntry_Fish(t *testing.T) {
+	record := Record{Time: time.Unix(1700000000, 0), Command: `printf 'a\n'` + "\necho b"}
+	assert.Equal(t, "- cmd: printf 'a\\\\n'\\necho b\n  when: 1700000000\n", historyEntry(shellFish, record))
+}
+
+func TestFromConfig_ShellSinkDefaultPath(t *testing.T) {
+	home := t.TempDir()
+	t.Setenv("HOME", home)
+
+	logger, err := FromConfig(&config.Audit{Sinks: []config.AuditSink{{Type: config.AuditSinkShell}}})
+	require.NoError(t, err)
+	logger.Log(Record{Time: time.Unix(1700000000, 0), Command: "kubectl get pods"})
+	logger.Close()
+
+	data, err := os.ReadFile(filepath.Join(home, ".kubertino_history"))
+	require.NoError(t, err)
+	assert.Contains(t, string(data), "kubectl get pods")
+}
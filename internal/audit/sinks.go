@@ -0,0 +1,105 @@
+package audit
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+)
+
+// FileSink appends records as JSON Lines to a file
+type FileSink struct {
+	mu   sync.Mutex
+	file *os.File
+}
+
+// NewFileSink opens path for appending, creating it and its directory if needed
+func NewFileSink(path string) (*FileSink, error) {
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		return nil, fmt.Errorf("failed to create audit directory: %w", err)
+	}
+	file, err := os.OpenFile(path, os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0600)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open audit file: %w", err)
+	}
+	return &FileSink{file: file}, nil
+}
+
+// Write appends record as one JSON line
+func (s *FileSink) Write(record Record) error {
+	data, err := json.Marshal(record)
+	if err != nil {
+		return fmt.Errorf("failed to serialize audit record: %w", err)
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if _, err := s.file.Write(append(data, '\n')); err != nil {
+		return fmt.Errorf("failed to write audit file: %w", err)
+	}
+	return nil
+}
+
+// Close closes the file
+func (s *FileSink) Close() error {
+	return s.file.Close()
+}
+
+// httpTimeout bounds each POST to an HTTP sink
+const httpTimeout = 5 * time.Second
+
+// HTTPSink POSTs each record as a JSON object to an endpoint
+type HTTPSink struct {
+	url     string
+	headers map[string]string
+	client  *http.Client
+}
+
+// NewHTTPSink creates a sink posting to url. $VAR and ${VAR} in header values are expanded
+// from the environment, so tokens need not be stored in the config file.
+func NewHTTPSink(url string, headers map[string]string) *HTTPSink {
+	expanded := make(map[string]string, len(headers))
+	for name, value := range headers {
+		expanded[name] = os.ExpandEnv(value)
+	}
+	return &HTTPSink{url: url, headers: expanded, client: &http.Client{Timeout: httpTimeout}}
+}
+
+// Write POSTs record; any status other than 2xx is an error
+func (s *HTTPSink) Write(record Record) error {
+	data, err := json.Marshal(record)
+	if err != nil {
+		return fmt.Errorf("failed to serialize audit record: %w", err)
+	}
+
+	req, err := http.NewRequest(http.MethodPost, s.url, bytes.NewReader(data))
+	if err != nil {
+		return fmt.Errorf("failed to create audit request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+	for name, value := range s.headers {
+		req.Header.Set(name, value)
+	}
+
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return fmt.Errorf("failed to send audit record: %w", err)
+	}
+	defer resp.Body.Close()
+	io.Copy(io.Discard, resp.Body)
+
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return fmt.Errorf("audit endpoint returned %s", resp.Status)
+	}
+	return nil
+}
+
+// Close does nothing; records are sent synchronously
+func (s *HTTPSink) Close() error {
+	return nil
+}
@@ -0,0 +1,55 @@
+//go:build !windows && !plan9
+
+package audit
+
+import (
+	"encoding/json"
+	"fmt"
+	"log/syslog"
+	"net/url"
+)
+
+// defaultSyslogTag is the program name records are logged under
+const defaultSyslogTag = "kubertino"
+
+// SyslogSink writes each record as a JSON message at notice level to syslog
+type SyslogSink struct {
+	writer *syslog.Writer
+}
+
+// NewSyslogSink connects to the syslog server at address (udp://host:port or tcp://host:port),
+// or to the local syslog daemon when address is empty
+func NewSyslogSink(address, tag string) (*SyslogSink, error) {
+	if tag == "" {
+		tag = defaultSyslogTag
+	}
+
+	var network, raddr string
+	if address != "" {
+		u, err := url.Parse(address)
+		if err != nil {
+			return nil, fmt.Errorf("invalid syslog address: %w", err)
+		}
+		network, raddr = u.Scheme, u.Host
+	}
+
+	writer, err := syslog.Dial(network, raddr, syslog.LOG_NOTICE|syslog.LOG_USER, tag)
+	if err != nil {
+		return nil, fmt.Errorf("failed to connect to syslog: %w", err)
+	}
+	return &SyslogSink{writer: writer}, nil
+}
+
+// Write sends record as a JSON message
+func (s *SyslogSink) Write(record Record) error {
+	data, err := json.Marshal(record)
+	if err != nil {
+		return fmt.Errorf("failed to serialize audit record: %w", err)
+	}
+	return s.writer.Notice(string(data))
+}
+
+// Close closes the syslog connection
+func (s *SyslogSink) Close() error {
+	return s.writer.Close()
+}
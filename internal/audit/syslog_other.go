@@ -0,0 +1,23 @@
+//go:build windows || plan9
+
+package audit
+
+import "fmt"
+
+// SyslogSink is not available on this platform
+type SyslogSink struct{}
+
+// NewSyslogSink reports that syslog is not supported on this platform
+func NewSyslogSink(address, tag string) (*SyslogSink, error) {
+	return nil, fmt.Errorf("syslog is not supported on this platform")
+}
+
+// Write does nothing
+func (s *SyslogSink) Write(record Record) error {
+	return nil
+}
+
+// Close does nothing
+func (s *SyslogSink) Close() error {
+	return nil
+}
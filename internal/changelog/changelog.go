@@ -0,0 +1,58 @@
+// Package changelog exposes the embedded release notes shown after an upgrade
+package changelog
+
+import (
+	_ "embed"
+	"strings"
+)
+
+//go:embed CHANGELOG.md
+var source string
+
+// Release is one version's entry in the changelog
+type Release struct {
+	Version string
+	Notes   []string // One line per bullet, without the leading "- "
+}
+
+// Releases returns the changelog entries, newest first
+func Releases() []Release {
+	return parse(source)
+}
+
+// Current returns the version of the newest changelog entry, which is the running version
+func Current() string {
+	releases := Releases()
+	if len(releases) == 0 {
+		return ""
+	}
+	return releases[0].Version
+}
+
+// Since returns the releases newer than version, newest first. An unknown version (e.g. from a
+// build that was never released) yields only the current release.
+func Since(version string) []Release {
+	releases := Releases()
+	for i, release := range releases {
+		if release.Version == version {
+			return releases[:i]
+		}
+	}
+	return releases[:min(1, len(releases))]
+}
+
+// parse reads "## <version>" headings and their "- " bullets; other lines are ignored
+func parse(text string) []Release {
+	var releases []Release
+	for _, line := range strings.Split(text, "\n") {
+		line = strings.TrimSpace(line)
+		switch {
+		case strings.HasPrefix(line, "## "):
+			releases = append(releases, Release{Version: strings.TrimSpace(strings.TrimPrefix(line, "## "))})
+		case strings.HasPrefix(line, "- ") && len(releases) > 0:
+			last := &releases[len(releases)-1]
+			last.Notes = append(last.Notes, strings.TrimPrefix(line, "- "))
+		}
+	}
+	return releases
+}
@@ -0,0 +1,46 @@
+package changelog
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParse(t *testing.T) {
+	releases := parse(`# Changelog
+
+Intro text is ignored.
+
+## 1.1.0
+
+- New thing
+- Other thing
+
+## 1.0.0
+
+- First release
+`)
+
+	require.Len(t, releases, 2)
+	assert.Equal(t, Release{Version: "1.1.0", Notes: []string{"New thing", "Other thing"}}, releases[0])
+	assert.Equal(t, Release{Version: "1.0.0", Notes: []string{"First release"}}, releases[1])
+}
+
+func TestEmbeddedChangelog(t *testing.T) {
+	releases := Releases()
+	require.NotEmpty(t, releases)
+	assert.Equal(t, releases[0].Version, Current())
+	for _, release := range releases {
+		assert.NotEmpty(t, release.Notes, "release %s has no notes", release.Version)
+	}
+}
+
+func TestSince(t *testing.T) {
+	releases := Releases()
+	require.GreaterOrEqual(t, len(releases), 3)
+
+	assert.Empty(t, Since(Current()), "nothing new on the same version")
+	assert.Equal(t, releases[:2], Since(releases[2].Version))
+	assert.Equal(t, releases[:1], Since("0.0.0-dev"), "unknown versions show the current release")
+}
@@ -0,0 +1,87 @@
+package clipboard
+
+import (
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"os/exec"
+	"strings"
+)
+
+// ErrUnavailable is returned when neither a clipboard tool nor the terminal can be used
+var ErrUnavailable = errors.New("no clipboard available (install pbcopy, wl-copy, xclip or xsel)")
+
+// tools lists clipboard commands in order of preference
+var tools = [][]string{
+	{"pbcopy"},
+	{"wl-copy"},
+	{"xclip", "-selection", "clipboard"},
+	{"xsel", "--clipboard", "--input"},
+}
+
+// openTerminal opens the controlling terminal for OSC 52 sequences (replaced in tests)
+var openTerminal = func() (io.WriteCloser, error) {
+	return os.OpenFile("/dev/tty", os.O_WRONLY, 0)
+}
+
+// Copy writes text to the system clipboard using the first available clipboard tool. Over
+// SSH, or when no tool is installed, it asks the terminal to set its clipboard with an
+// OSC 52 escape sequence instead, which reaches the local machine's clipboard.
+func Copy(text string) error {
+	if !remoteSession() {
+		err := copyWithTool(text)
+		if !errors.Is(err, ErrUnavailable) {
+			return err
+		}
+	}
+	return copyWithOSC52(text)
+}
+
+// remoteSession reports whether kubertino runs in an SSH session, where the clipboard
+// tools would copy to the remote host
+func remoteSession() bool {
+	return os.Getenv("SSH_TTY") != "" || os.Getenv("SSH_CONNECTION") != ""
+}
+
+func copyWithTool(text string) error {
+	for _, tool := range tools {
+		path, err := exec.LookPath(tool[0])
+		if err != nil {
+			continue
+		}
+
+		cmd := exec.Command(path, tool[1:]...)
+		cmd.Stdin = strings.NewReader(text)
+		if output, err := cmd.CombinedOutput(); err != nil {
+			return fmt.Errorf("%s failed: %w: %s", tool[0], err, strings.TrimSpace(string(output)))
+		}
+		return nil
+	}
+
+	return ErrUnavailable
+}
+
+func copyWithOSC52(text string) error {
+	tty, err := openTerminal()
+	if err != nil {
+		return ErrUnavailable
+	}
+	defer tty.Close()
+
+	if _, err := io.WriteString(tty, OSC52(text, os.Getenv("TMUX") != "")); err != nil {
+		return fmt.Errorf("failed to write OSC 52 sequence: %w", err)
+	}
+	return nil
+}
+
+// OSC52 returns the escape sequence asking the terminal to put text on the clipboard. Inside
+// tmux the sequence is wrapped in a passthrough so it reaches the outer terminal.
+func OSC52(text string, tmux bool) string {
+	seq := "\x1b]52;c;" + base64.StdEncoding.EncodeToString([]byte(text)) + "\a"
+	if tmux {
+		return "\x1bPtmux;" + strings.ReplaceAll(seq, "\x1b", "\x1b\x1b") + "\x1b\\"
+	}
+	return seq
+}
@@ -0,0 +1,105 @@
+package clipboard
+
+import (
+	"bytes"
+	"errors"
+	"io"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCopy(t *testing.T) {
+	t.Run("no tool and no terminal", func(t *testing.T) {
+		localSession(t)
+		stubTerminal(t, nil)
+		t.Setenv("PATH", t.TempDir())
+		assert.ErrorIs(t, Copy("text"), ErrUnavailable)
+	})
+
+	t.Run("no tool falls back to OSC 52", func(t *testing.T) {
+		localSession(t)
+		tty := stubTerminal(t, &bytes.Buffer{})
+		t.Setenv("PATH", t.TempDir())
+
+		require.NoError(t, Copy("web-1"))
+		assert.Equal(t, "\x1b]52;c;d2ViLTE=\a", tty.String())
+	})
+
+	t.Run("first available tool receives text", func(t *testing.T) {
+		localSession(t)
+		tty := stubTerminal(t, &bytes.Buffer{})
+		out := fakeXclip(t)
+
+		require.NoError(t, Copy("https://example.com/commit/abc"))
+		data, err := os.ReadFile(out)
+		require.NoError(t, err)
+		assert.Equal(t, "https://example.com/commit/abc", string(data))
+		assert.Empty(t, tty.String())
+	})
+
+	t.Run("SSH session uses OSC 52 over tools", func(t *testing.T) {
+		localSession(t)
+		t.Setenv("SSH_TTY", "/dev/pts/3")
+		tty := stubTerminal(t, &bytes.Buffer{})
+		out := fakeXclip(t)
+
+		require.NoError(t, Copy("web-1"))
+		assert.Equal(t, "\x1b]52;c;d2ViLTE=\a", tty.String())
+		assert.NoFileExists(t, out)
+	})
+}
+
+func TestOSC52(t *testing.T) {
+	assert.Equal(t, "\x1b]52;c;aGk=\a", OSC52("hi", false))
+	assert.Equal(t, "\x1bPtmux;\x1b\x1b]52;c;aGk=\a\x1b\\", OSC52("hi", true))
+}
+
+// localSession clears the environment variables that mark an SSH or tmux session
+func localSession(t *testing.T) {
+	t.Helper()
+	t.Setenv("SSH_TTY", "")
+	t.Setenv("SSH_CONNECTION", "")
+	t.Setenv("TMUX", "")
+}
+
+// stubTerminal makes OSC 52 sequences go to buf, or fail to open the terminal when buf is nil
+func stubTerminal(t *testing.T, buf *bytes.Buffer) *bytes.Buffer {
+	t.Helper()
+	orig := openTerminal
+	t.Cleanup(func() { openTerminal = orig })
+	openTerminal = func() (io.WriteCloser, error) {
+		if buf == nil {
+			return nil, errors.New("no tty")
+		}
+		return nopCloser{buf}, nil
+	}
+	return buf
+}
+
+type nopCloser struct{ io.Writer }
+
+func (nopCloser) Close() error { return nil }
+
+// fakeXclip puts an xclip on PATH that writes its input to the returned file
+func fakeXclip(t *testing.T) string {
+	t.Helper()
+	dir := t.TempDir()
+	out := filepath.Join(dir, "copied")
+	script := "#!/bin/sh\nexec " + catPath(t) + " > " + out + "\n"
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "xclip"), []byte(script), 0755))
+	t.Setenv("PATH", dir)
+	return out
+}
+
+// catPath resolves cat before PATH is replaced by the test
+func catPath(t *testing.T) string {
+	t.Helper()
+	path, err := exec.LookPath("cat")
+	require.NoError(t, err)
+	return path
+}
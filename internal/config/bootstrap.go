@@ -0,0 +1,130 @@
+package config
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+// DefaultConfigPath is the location of the user configuration file
+const DefaultConfigPath = "~/.kubertino.yml"
+
+// DefaultKubeconfigPath is used when the KUBECONFIG environment variable is not set
+const DefaultKubeconfigPath = "~/.kube/config"
+
+// ErrNoContextsDiscovered indicates that no kubeconfig file yielded any context
+var ErrNoContextsDiscovered = errors.New("no contexts found in kubeconfig")
+
+// kubeconfigContexts is the minimal kubeconfig structure needed for context discovery
+type kubeconfigContexts struct {
+	Contexts []struct {
+		Name string `yaml:"name"`
+	} `yaml:"contexts"`
+}
+
+// KubeconfigPaths returns the kubeconfig files kubectl would merge:
+// every entry of the KUBECONFIG environment variable, or ~/.kube/config when unset
+func KubeconfigPaths() []string {
+	env := os.Getenv("KUBECONFIG")
+	if env == "" {
+		return []string{DefaultKubeconfigPath}
+	}
+
+	var paths []string
+	for _, p := range filepath.SplitList(env) {
+		if p = strings.TrimSpace(p); p != "" {
+			paths = append(paths, p)
+		}
+	}
+	return paths
+}
+
+// DiscoverContexts reads context names from the given kubeconfig files.
+// Files are merged in order like kubectl does: the first occurrence of a name wins
+// and missing files are skipped.
+func DiscoverContexts(paths []string) ([]string, error) {
+	seen := make(map[string]bool)
+	var contexts []string
+	found := false
+
+	for _, p := range paths {
+		filename, err := expandHome(p)
+		if err != nil {
+			return nil, err
+		}
+
+		data, err := os.ReadFile(filename)
+		if err != nil {
+			if os.IsNotExist(err) {
+				continue
+			}
+			return nil, fmt.Errorf("failed to read kubeconfig %s: %w", filename, err)
+		}
+		found = true
+
+		var kc kubeconfigContexts
+		if err := yaml.Unmarshal(data, &kc); err != nil {
+			return nil, fmt.Errorf("failed to parse kubeconfig %s: %w", filename, err)
+		}
+
+		for _, ctx := range kc.Contexts {
+			if ctx.Name == "" || seen[ctx.Name] {
+				continue
+			}
+			seen[ctx.Name] = true
+			contexts = append(contexts, ctx.Name)
+		}
+	}
+
+	if !found {
+		return nil, fmt.Errorf("%w: none of %v exist", ErrNoContextsDiscovered, paths)
+	}
+	if len(contexts) == 0 {
+		return nil, ErrNoContextsDiscovered
+	}
+
+	return contexts, nil
+}
+
+// DefaultActions returns the action set used when no configuration file exists.
+// Describing a pod is built in (d), so it is not part of the set.
+func DefaultActions() []Action {
+	return []Action{
+		{Name: "Logs", Shortcut: "l", Command: "kubectl logs -n {{.namespace}} {{.pod}} -f --tail=100"},
+		{Name: "Shell", Shortcut: "s", Command: "kubectl exec -n {{.namespace}} {{.pod}} -it -- /bin/sh"},
+		{Name: "Previous Logs", Shortcut: "p", Command: "kubectl logs -n {{.namespace}} {{.pod}} --previous --tail=200", WaitOnExit: true},
+	}
+}
+
+// ActionTemplates returns the library of common actions offered by kubertino config init,
+// starting with the default action set. Like there, describing a pod is left to the built-in key.
+func ActionTemplates() []Action {
+	return append(DefaultActions(),
+		Action{Name: "Rails Console", Shortcut: "c", Command: "kubectl exec -n {{.namespace}} {{.pod}} -c {{.container}} -it -- bundle exec rails console"},
+		Action{Name: "psql", Shortcut: "P", Command: `kubectl exec -n {{.namespace}} {{.pod}} -c {{.container}} -it -- sh -c 'exec psql "$DATABASE_URL"'`},
+	)
+}
+
+// Bootstrap synthesizes a configuration from the kubeconfig files kubectl would use.
+// Every discovered context is included and the default action set is configured globally.
+// The kubeconfig path is left empty so kubectl performs its own KUBECONFIG merge.
+func Bootstrap() (*Config, error) {
+	names, err := DiscoverContexts(KubeconfigPaths())
+	if err != nil {
+		return nil, err
+	}
+
+	cfg := &Config{
+		Version: "1.0",
+		Actions: DefaultActions(),
+	}
+	for _, name := range names {
+		cfg.Contexts = append(cfg.Contexts, Context{Name: name})
+	}
+
+	return cfg, nil
+}
@@ -0,0 +1,72 @@
+package config
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func writeKubeconfig(t *testing.T, dir, name string, contexts ...string) string {
+	t.Helper()
+	content := "apiVersion: v1\nkind: Config\ncontexts:\n"
+	for _, ctx := range contexts {
+		content += "  - name: " + ctx + "\n    context:\n      cluster: " + ctx + "\n"
+	}
+	path := filepath.Join(dir, name)
+	require.NoError(t, os.WriteFile(path, []byte(content), 0600))
+	return path
+}
+
+func TestKubeconfigPaths(t *testing.T) {
+	t.Run("defaults to ~/.kube/config", func(t *testing.T) {
+		t.Setenv("KUBECONFIG", "")
+		assert.Equal(t, []string{DefaultKubeconfigPath}, KubeconfigPaths())
+	})
+
+	t.Run("splits KUBECONFIG list", func(t *testing.T) {
+		t.Setenv("KUBECONFIG", "/a/config"+string(os.PathListSeparator)+"/b/config")
+		assert.Equal(t, []string{"/a/config", "/b/config"}, KubeconfigPaths())
+	})
+}
+
+func TestDiscoverContexts(t *testing.T) {
+	dir := t.TempDir()
+	first := writeKubeconfig(t, dir, "first", "dev", "staging")
+	second := writeKubeconfig(t, dir, "second", "staging", "production")
+
+	t.Run("merges files in order without duplicates", func(t *testing.T) {
+		contexts, err := DiscoverContexts([]string{first, filepath.Join(dir, "missing"), second})
+		require.NoError(t, err)
+		assert.Equal(t, []string{"dev", "staging", "production"}, contexts)
+	})
+
+	t.Run("no existing files", func(t *testing.T) {
+		_, err := DiscoverContexts([]string{filepath.Join(dir, "missing")})
+		assert.ErrorIs(t, err, ErrNoContextsDiscovered)
+	})
+
+	t.Run("file without contexts", func(t *testing.T) {
+		empty := writeKubeconfig(t, dir, "empty")
+		_, err := DiscoverContexts([]string{empty})
+		assert.ErrorIs(t, err, ErrNoContextsDiscovered)
+	})
+}
+
+func TestBootstrap(t *testing.T) {
+	dir := t.TempDir()
+	path := writeKubeconfig(t, dir, "config", "minikube", "production")
+	t.Setenv("KUBECONFIG", path)
+
+	cfg, err := Bootstrap()
+	require.NoError(t, err)
+
+	assert.Equal(t, "1.0", cfg.Version)
+	assert.Empty(t, cfg.Kubeconfig, "kubeconfig should be left to kubectl's own merge")
+	require.Len(t, cfg.Contexts, 2)
+	assert.Equal(t, "minikube", cfg.Contexts[0].Name)
+	assert.Equal(t, DefaultActions(), cfg.Actions)
+	assert.NoError(t, Validate(cfg), "synthesized config must pass validation")
+}
@@ -1,25 +1,773 @@
 package config
 
+import (
+	"fmt"
+	"log/slog"
+	"math"
+	"regexp"
+	"slices"
+	"strings"
+	"time"
+)
+
 // Config represents the complete user configuration from ~/.kubertino.yml
 type Config struct {
-	Version    string      `yaml:"version"`
-	Kubeconfig string      `yaml:"kubeconfig,omitempty"` // Optional kubeconfig path override
-	Actions    []Action    `yaml:"actions,omitempty"`    // Global actions for all contexts
-	Favorites  interface{} `yaml:"favorites,omitempty"`  // map[string][]string OR []string
-	Contexts   []Context   `yaml:"contexts"`
+	Version                 string            `yaml:"version"`
+	Kubeconfig              string            `yaml:"kubeconfig,omitempty"`                // Optional kubeconfig path override
+	KubectlPath             string            `yaml:"kubectl_path,omitempty"`              // kubectl binary to run (default kubectl in PATH)
+	Actions                 []Action          `yaml:"actions,omitempty"`                   // Global actions for all contexts
+	ActionGroups            []ActionGroup     `yaml:"action_groups,omitempty"`             // Submenus of the actions panel, reached by their shortcut
+	DestructivePatterns     []string          `yaml:"destructive_patterns,omitempty"`      // Regexes of the commands read_only contexts refuse to run
+	Favorites               interface{}       `yaml:"favorites,omitempty"`                 // map[string][]string OR []string
+	Keymap                  *Keymap           `yaml:"keymap,omitempty"`                    // Optional navigation key overrides
+	PodColumns              []string          `yaml:"pod_columns,omitempty"`               // Pod list columns shown before the name
+	CustomPodColumns        []CustomPodColumn `yaml:"custom_pod_columns,omitempty"`        // Pod list columns picked from the pod JSON with JSONPath
+	MetricsInterval         string            `yaml:"metrics_interval,omitempty"`          // How often the cpu and memory columns are refreshed, e.g. 15s
+	CacheTTL                string            `yaml:"cache_ttl,omitempty"`                 // How long fetched namespaces and pods are fresh, e.g. 30s ("0" disables)
+	Prefetch                bool              `yaml:"prefetch_namespaces,omitempty"`       // Fetch namespaces of all contexts at startup
+	PrefetchFavorites       bool              `yaml:"prefetch_favorites,omitempty"`        // Fetch pods of the favorite namespaces when a context is selected
+	HealthTimeout           string            `yaml:"health_timeout,omitempty"`            // How long the context list waits for each context to answer, e.g. 5s ("0" disables)
+	NodeShellImage          string            `yaml:"node_shell_image,omitempty"`          // Image of the debug pod a node shell runs in (default busybox)
+	AllowNamespaceMutations bool              `yaml:"allow_namespace_mutations,omitempty"` // Allow creating and deleting namespaces from the namespaces panel
+	NamespaceGrouping       string            `yaml:"namespace_grouping,omitempty"`        // prefix: collapse namespaces sharing a prefix into groups (default off)
+	NamespaceGroupDelimiter string            `yaml:"namespace_group_delimiter,omitempty"` // Ends the prefix namespaces are grouped by (default -)
+	KubectlConcurrency      int               `yaml:"kubectl_concurrency,omitempty"`       // Max simultaneous kubectl processes per context (default 4)
+	KubectlQPS              float64           `yaml:"kubectl_qps,omitempty"`               // Max kubectl processes started per second per context (default unlimited)
+	KubectlBurst            int               `yaml:"kubectl_burst,omitempty"`             // Processes that may start at once before kubectl_qps applies
+	KubectlTimeout          string            `yaml:"kubectl_timeout,omitempty"`           // How long one kubectl call may take, e.g. 10s
+	Retries                 int               `yaml:"retries,omitempty"`                   // How often a timed out or transiently failing kubectl call is retried
+	RetryBackoff            string            `yaml:"retry_backoff,omitempty"`             // Delay before the first retry, doubled for each further one
+	PodPageSize             int               `yaml:"pod_page_size,omitempty"`             // Pods fetched per page, more are loaded on demand (default all at once)
+	Layout                  *Layout           `yaml:"layout,omitempty"`                    // Optional panel placement
+	Audit                   *Audit            `yaml:"audit,omitempty"`                     // Optional export of executed action records
+	Appearance              *Appearance       `yaml:"appearance,omitempty"`                // Optional cursor, selection and favorite markers
+	ShowHeader              bool              `yaml:"show_header,omitempty"`               // Show a context ▸ namespace ▸ pod header line with status and clock
+	LogLevel                string            `yaml:"log_level,omitempty"`                 // debug, info (default), warn or error
+	LogFile                 string            `yaml:"log_file,omitempty"`                  // Log file path (default ~/.kubertino/kubertino.log)
+	Contexts                []Context         `yaml:"contexts"`
+}
+
+// DefaultCacheTTL is used when cache_ttl is not set
+const DefaultCacheTTL = 30 * time.Second
+
+// CacheDuration returns the parsed cache_ttl, or DefaultCacheTTL when it is not set
+func (c *Config) CacheDuration() (time.Duration, error) {
+	return parseDuration(c.CacheTTL, DefaultCacheTTL)
+}
+
+// Defaults used when kubectl_timeout and retry_backoff are not set
+const (
+	DefaultKubectlTimeout = 10 * time.Second
+	DefaultRetryBackoff   = 500 * time.Millisecond
+)
+
+// KubectlTimeoutDuration returns the parsed kubectl_timeout, or DefaultKubectlTimeout when
+// it is not set
+func (c *Config) KubectlTimeoutDuration() (time.Duration, error) {
+	timeout, err := parseDuration(c.KubectlTimeout, DefaultKubectlTimeout)
+	if err == nil && timeout == 0 {
+		return 0, fmt.Errorf("duration %q must be positive", c.KubectlTimeout)
+	}
+	return timeout, err
+}
+
+// RetryBackoffDuration returns the parsed retry_backoff, or DefaultRetryBackoff when it is
+// not set
+func (c *Config) RetryBackoffDuration() (time.Duration, error) {
+	return parseDuration(c.RetryBackoff, DefaultRetryBackoff)
+}
+
+// DefaultHealthTimeout is used when health_timeout is not set
+const DefaultHealthTimeout = 5 * time.Second
+
+// HealthTimeoutDuration returns the parsed health_timeout, or DefaultHealthTimeout when it is
+// not set. Zero turns the context health probes off.
+func (c *Config) HealthTimeoutDuration() (time.Duration, error) {
+	return parseDuration(c.HealthTimeout, DefaultHealthTimeout)
+}
+
+// DefaultNodeShellImage is the image of node shells when node_shell_image is not set
+const DefaultNodeShellImage = "busybox"
+
+// NodeDebugImage returns the node_shell_image, or DefaultNodeShellImage when it is not set
+func (c *Config) NodeDebugImage() string {
+	if c.NodeShellImage == "" {
+		return DefaultNodeShellImage
+	}
+	return c.NodeShellImage
+}
+
+// NamespaceGroupingPrefix groups the namespaces panel by the prefix of namespace names
+const NamespaceGroupingPrefix = "prefix"
+
+// DefaultNamespaceGroupDelimiter ends the group prefix when namespace_group_delimiter is not set
+const DefaultNamespaceGroupDelimiter = "-"
+
+// NamespaceGroupPrefix returns the prefix namespace is grouped by: its name up to the first
+// delimiter. ok is false when namespace grouping is off or the name has no delimiter.
+func (c *Config) NamespaceGroupPrefix(namespace string) (prefix string, ok bool) {
+	if c == nil || c.NamespaceGrouping != NamespaceGroupingPrefix {
+		return "", false
+	}
+	delimiter := c.NamespaceGroupDelimiter
+	if delimiter == "" {
+		delimiter = DefaultNamespaceGroupDelimiter
+	}
+	prefix, _, ok = strings.Cut(namespace, delimiter)
+	return prefix, ok && prefix != ""
+}
+
+// DefaultMetricsInterval is used when metrics_interval is not set
+const DefaultMetricsInterval = 15 * time.Second
+
+// MetricsIntervalDuration returns the parsed metrics_interval, or DefaultMetricsInterval when
+// it is not set
+func (c *Config) MetricsIntervalDuration() (time.Duration, error) {
+	interval, err := parseDuration(c.MetricsInterval, DefaultMetricsInterval)
+	if err == nil && interval == 0 {
+		return 0, fmt.Errorf("duration %q must be positive", c.MetricsInterval)
+	}
+	return interval, err
+}
+
+// parseDuration parses a non-negative duration setting, returning def when it is not set
+func parseDuration(value string, def time.Duration) (time.Duration, error) {
+	if value == "" {
+		return def, nil
+	}
+	d, err := time.ParseDuration(value)
+	if err != nil {
+		return 0, fmt.Errorf("invalid duration %q: %w", value, err)
+	}
+	if d < 0 {
+		return 0, fmt.Errorf("duration %q must not be negative", value)
+	}
+	return d, nil
+}
+
+// Log levels that can be set in log_level
+const (
+	LogLevelDebug = "debug"
+	LogLevelInfo  = "info"
+	LogLevelWarn  = "warn"
+	LogLevelError = "error"
+)
+
+// SlogLevel returns the level of log_level, slog.LevelInfo when it is not set
+func (c *Config) SlogLevel() (slog.Level, error) {
+	switch c.LogLevel {
+	case "", LogLevelInfo:
+		return slog.LevelInfo, nil
+	case LogLevelDebug:
+		return slog.LevelDebug, nil
+	case LogLevelWarn:
+		return slog.LevelWarn, nil
+	case LogLevelError:
+		return slog.LevelError, nil
+	}
+	return 0, fmt.Errorf("unknown level %q (use %s, %s, %s or %s)", c.LogLevel, LogLevelDebug, LogLevelInfo, LogLevelWarn, LogLevelError)
+}
+
+// LogPath returns log_file with ~ expanded, or "" when it is not set
+func (c *Config) LogPath() (string, error) {
+	if c.LogFile == "" {
+		return "", nil
+	}
+	return expandHome(c.LogFile)
+}
+
+// DefaultKubectlConcurrency is used when kubectl_concurrency is not set
+const DefaultKubectlConcurrency = 4
+
+// KubectlLimit returns how many kubectl processes may run at once for contextName:
+// the context's kubectl_concurrency, else the global one, else DefaultKubectlConcurrency
+func (c *Config) KubectlLimit(contextName string) int {
+	for _, ctx := range c.Contexts {
+		if ctx.Name == contextName && ctx.KubectlConcurrency > 0 {
+			return ctx.KubectlConcurrency
+		}
+	}
+	if c.KubectlConcurrency > 0 {
+		return c.KubectlConcurrency
+	}
+	return DefaultKubectlConcurrency
+}
+
+// KubectlRate returns how many kubectl processes may start per second for contextName and
+// how many may start at once: the context's kubectl_qps and kubectl_burst, else the global
+// ones. A qps of 0 means unthrottled; the burst defaults to the qps rounded up.
+func (c *Config) KubectlRate(contextName string) (qps float64, burst int) {
+	qps, burst = c.KubectlQPS, c.KubectlBurst
+	for _, ctx := range c.Contexts {
+		if ctx.Name != contextName {
+			continue
+		}
+		if ctx.KubectlQPS > 0 {
+			qps = ctx.KubectlQPS
+		}
+		if ctx.KubectlBurst > 0 {
+			burst = ctx.KubectlBurst
+		}
+	}
+	if qps <= 0 {
+		return 0, 0
+	}
+	if burst <= 0 {
+		burst = int(math.Ceil(qps))
+	}
+	return qps, burst
+}
+
+// PodPageLimit returns how many pods are fetched per page for contextName: the context's
+// pod_page_size, else the global one. 0 means all pods are fetched at once.
+func (c *Config) PodPageLimit(contextName string) int {
+	for _, ctx := range c.Contexts {
+		if ctx.Name == contextName && ctx.PodPageSize > 0 {
+			return ctx.PodPageSize
+		}
+	}
+	return c.PodPageSize
+}
+
+// KubeconfigPath returns the kubeconfig file of contextName: its own kubeconfig, or else the
+// global one. "" leaves the choice to kubectl ($KUBECONFIG or ~/.kube/config).
+func (c *Config) KubeconfigPath(contextName string) string {
+	for _, ctx := range c.Contexts {
+		if ctx.Name == contextName && ctx.Kubeconfig != "" {
+			return ctx.Kubeconfig
+		}
+	}
+	return c.Kubeconfig
+}
+
+// PodSelector returns the label selector pods of contextName are listed with, or "" to
+// list every pod
+func (c *Config) PodSelector(contextName string) string {
+	for _, ctx := range c.Contexts {
+		if ctx.Name == contextName {
+			return ctx.PodSelector
+		}
+	}
+	return ""
+}
+
+// NamespaceFallback returns the namespaces to show for contextName when listing namespaces
+// is forbidden: the context's namespaces list, or else its favorite namespaces as candidates
+// to keep only once access to them has been checked
+func (c *Config) NamespaceFallback(contextName string) (namespaces, candidates []string) {
+	for _, ctx := range c.Contexts {
+		if ctx.Name == contextName && len(ctx.Namespaces) > 0 {
+			return ctx.Namespaces, nil
+		}
+	}
+	favorites, err := GetFavorites(c, contextName)
+	if err != nil {
+		return nil, nil
+	}
+	return nil, favorites
+}
+
+// Throttled reports whether kubectl_qps is set globally or on any context
+func (c *Config) Throttled() bool {
+	if c.KubectlQPS > 0 {
+		return true
+	}
+	for _, ctx := range c.Contexts {
+		if ctx.KubectlQPS > 0 {
+			return true
+		}
+	}
+	return false
+}
+
+// Pod list columns that can be listed in pod_columns
+const (
+	PodColumnStatus   = "status"
+	PodColumnReady    = "ready"
+	PodColumnRestarts = "restarts"
+	PodColumnAge      = "age"
+	PodColumnNode     = "node"
+	PodColumnCPU      = "cpu"    // Usage from metrics-server
+	PodColumnMemory   = "memory" // Usage from metrics-server
+)
+
+// DefaultPodColumns are shown when pod_columns is not set
+var DefaultPodColumns = []string{PodColumnStatus, PodColumnReady, PodColumnRestarts, PodColumnAge}
+
+// CustomPodColumn is a pod list column showing what a JSONPath expression selects in the
+// JSON of each pod, e.g. {name: image, jsonpath: .spec.containers[0].image}. Custom columns
+// follow the pod_columns unless pod_columns lists them by name.
+type CustomPodColumn struct {
+	Name     string `yaml:"name"` // Shown upper-cased as the column header
+	JSONPath string `yaml:"jsonpath"`
+}
+
+// Actions panel placements that can be set in layout.actions
+const (
+	ActionsBottom = "bottom" // Full-width bar under the namespace and pod panels
+	ActionsRight  = "right"  // Column right of the pod panel
+	ActionsHidden = "hidden" // Not shown; shortcuts keep working
+)
+
+// Layout presets that can be set in layout.preset
+const (
+	PresetWideNamespaces = "wide-namespaces" // Namespace panel takes about two thirds of the width
+	PresetWidePods       = "wide-pods"       // Pod panel takes about two thirds of the width
+	PresetPodsOnly       = "pods-only"       // Namespace panel hidden once a namespace is open
+)
+
+// LayoutPresets lists the layout presets in the order they are cycled through
+var LayoutPresets = []string{PresetWideNamespaces, PresetWidePods, PresetPodsOnly}
+
+// Layout configures where panels are placed. Unset fields keep the default layout, which
+// shows the actions panel under the pod panel.
+type Layout struct {
+	Actions string `yaml:"actions,omitempty"` // bottom, right or hidden
+	Preset  string `yaml:"preset,omitempty"`  // wide-namespaces, wide-pods or pods-only (default: even split)
+}
+
+// ActionsPlacement returns the configured layout.actions, or "" for the default placement
+func (c *Config) ActionsPlacement() string {
+	if c == nil || c.Layout == nil {
+		return ""
+	}
+	return c.Layout.Actions
+}
+
+// LayoutPreset returns the configured layout.preset, or "" for the even split
+func (c *Config) LayoutPreset() string {
+	if c == nil || c.Layout == nil {
+		return ""
+	}
+	return c.Layout.Preset
+}
+
+// Selection renderings that can be set in appearance.selection
+const (
+	SelectionBackground = "background" // Colored background (default)
+	SelectionBold       = "bold"       // Bold text only
+	SelectionUnderline  = "underline"  // Bold, underlined text
+	SelectionNone       = "none"       // Only the cursor marker
+)
+
+// DefaultCursor marks the selected line when appearance.cursor is not set
+const DefaultCursor = "> "
+
+// Appearance configures how the selected line and favorite namespaces are marked, for
+// terminal themes where the default background color is hard to see
+type Appearance struct {
+	Cursor          string            `yaml:"cursor,omitempty"`           // Marker before the selected line, e.g. "▶ " (default "> ")
+	Selection       string            `yaml:"selection,omitempty"`        // background, bold, underline or none
+	Favorite        string            `yaml:"favorite,omitempty"`         // Marker before favorite namespaces, e.g. "★ " (default: color only)
+	Spinner         string            `yaml:"spinner,omitempty"`          // dots (default), line or custom
+	SpinnerFrames   []string          `yaml:"spinner_frames,omitempty"`   // Frames of the custom spinner
+	SpinnerInterval string            `yaml:"spinner_interval,omitempty"` // Time between spinner frames, e.g. 150ms (default 100ms)
+	LoadingMessages map[string]string `yaml:"loading_messages,omitempty"` // Spinner text by operation (LoadingNamespaces, LoadingPods, LoadingResources)
+}
+
+// Spinners that can be set in appearance.spinner
+const (
+	SpinnerDots   = "dots"   // Braille dots (default)
+	SpinnerLine   = "line"   // A turning line, ASCII only
+	SpinnerCustom = "custom" // The frames of appearance.spinner_frames
+)
+
+// spinnerPresets are the frames of the spinners that are not custom
+var spinnerPresets = map[string][]string{
+	SpinnerDots: {"⠋", "⠙", "⠹", "⠸", "⠼", "⠴", "⠦", "⠧", "⠇", "⠏"},
+	SpinnerLine: {"|", "/", "-", "\\"},
+}
+
+// DefaultSpinnerInterval is the time between spinner frames when appearance.spinner_interval
+// is not set
+const DefaultSpinnerInterval = 100 * time.Millisecond
+
+// Operations whose spinner text can be set in appearance.loading_messages
+const (
+	LoadingNamespaces = "namespaces"
+	LoadingPods       = "pods"
+	LoadingResources  = "resources" // Deployments, statefulsets and the other resource kinds
+)
+
+// CursorMarker returns appearance.cursor, or DefaultCursor when it is not set
+func (c *Config) CursorMarker() string {
+	if c == nil || c.Appearance == nil || c.Appearance.Cursor == "" {
+		return DefaultCursor
+	}
+	return c.Appearance.Cursor
+}
+
+// SelectionRendering returns appearance.selection, or SelectionBackground when it is not set
+func (c *Config) SelectionRendering() string {
+	if c == nil || c.Appearance == nil || c.Appearance.Selection == "" {
+		return SelectionBackground
+	}
+	return c.Appearance.Selection
+}
+
+// FavoriteMarker returns appearance.favorite, or "" when favorites are only colored
+func (c *Config) FavoriteMarker() string {
+	if c == nil || c.Appearance == nil {
+		return ""
+	}
+	return c.Appearance.Favorite
+}
+
+// SpinnerFrames returns the frames of appearance.spinner, the dots when it is not set
+func (c *Config) SpinnerFrames() []string {
+	if c == nil || c.Appearance == nil || c.Appearance.Spinner == "" {
+		return spinnerPresets[SpinnerDots]
+	}
+	if c.Appearance.Spinner == SpinnerCustom {
+		return c.Appearance.SpinnerFrames
+	}
+	return spinnerPresets[c.Appearance.Spinner]
+}
+
+// SpinnerIntervalDuration returns the parsed appearance.spinner_interval, or
+// DefaultSpinnerInterval when it is not set
+func (c *Config) SpinnerIntervalDuration() (time.Duration, error) {
+	if c == nil || c.Appearance == nil {
+		return DefaultSpinnerInterval, nil
+	}
+	return parseDuration(c.Appearance.SpinnerInterval, DefaultSpinnerInterval)
+}
+
+// LoadingMessage returns the spinner text of operation set in appearance.loading_messages,
+// or def when it is not set
+func (c *Config) LoadingMessage(operation, def string) string {
+	if c == nil || c.Appearance == nil || c.Appearance.LoadingMessages[operation] == "" {
+		return def
+	}
+	return c.Appearance.LoadingMessages[operation]
+}
+
+// Audit sink types that can be set in audit.sinks[].type
+const (
+	AuditSinkFile   = "file"   // JSON Lines appended to path
+	AuditSinkSyslog = "syslog" // Local syslog, or a remote one at address
+	AuditSinkHTTP   = "http"   // Each record POSTed as JSON to url
+	AuditSinkShell  = "shell"  // Rendered commands appended in shell history format to path
+)
+
+// DefaultShellHistoryPath is written by a shell sink without a path
+const DefaultShellHistoryPath = "~/.kubertino_history"
+
+// Audit configures where records of executed actions are sent
+type Audit struct {
+	Sinks []AuditSink `yaml:"sinks,omitempty"`
+}
+
+// AuditSink is one destination for audit records
+type AuditSink struct {
+	Type         string            `yaml:"type"`                    // file, syslog, http or shell
+	Path         string            `yaml:"path,omitempty"`          // file: JSONL file; shell: history file (default ~/.kubertino_history). A leading ~/ is expanded
+	Address      string            `yaml:"address,omitempty"`       // syslog: remote server, e.g. udp://logs.internal:514 (default: local syslog)
+	Tag          string            `yaml:"tag,omitempty"`           // syslog: program tag (default: kubertino)
+	URL          string            `yaml:"url,omitempty"`           // http: endpoint receiving the records
+	Headers      map[string]string `yaml:"headers,omitempty"`       // http: extra headers; $VAR and ${VAR} are expanded from the environment
+	ShellHistory bool              `yaml:"shell_history,omitempty"` // shell: also append to the history of $SHELL (bash, zsh or fish)
+}
+
+// FilePath returns the file or shell sink path with a leading ~/ expanded. Shell sinks
+// without a path write DefaultShellHistoryPath.
+func (s AuditSink) FilePath() (string, error) {
+	if s.Type == AuditSinkShell && s.Path == "" {
+		return expandHome(DefaultShellHistoryPath)
+	}
+	return expandHome(s.Path)
+}
+
+// Keymap overrides the default navigation key bindings.
+// Empty lists keep the built-in defaults for that binding.
+type Keymap struct {
+	Quit            []string `yaml:"quit,omitempty"`
+	Up              []string `yaml:"up,omitempty"`
+	Down            []string `yaml:"down,omitempty"`
+	Enter           []string `yaml:"enter,omitempty"`
+	Tab             []string `yaml:"tab,omitempty"`
+	ShiftTab        []string `yaml:"shift_tab,omitempty"`
+	Search          []string `yaml:"search,omitempty"`
+	Settings        []string `yaml:"settings,omitempty"`
+	PortForward     []string `yaml:"port_forward,omitempty"`
+	StopForward     []string `yaml:"stop_forward,omitempty"`
+	ResourceType    []string `yaml:"resource_type,omitempty"`
+	GitOps          []string `yaml:"gitops,omitempty"`
+	Network         []string `yaml:"network,omitempty"`
+	Describe        []string `yaml:"describe,omitempty"`
+	YAML            []string `yaml:"yaml,omitempty"`
+	NodeShell       []string `yaml:"node_shell,omitempty"`
+	Shell           []string `yaml:"shell,omitempty"`
+	Favorite        []string `yaml:"favorite,omitempty"`
+	ActionFilter    []string `yaml:"action_filter,omitempty"`
+	ActionPicker    []string `yaml:"action_picker,omitempty"`
+	PodSort         []string `yaml:"pod_sort,omitempty"`
+	PinPod          []string `yaml:"pin_pod,omitempty"`
+	PodFilter       []string `yaml:"pod_filter,omitempty"`
+	CreateNamespace []string `yaml:"create_namespace,omitempty"`
+	DeleteNamespace []string `yaml:"delete_namespace,omitempty"`
+	LabelSelector   []string `yaml:"label_selector,omitempty"`
+	ClearSelector   []string `yaml:"clear_selector,omitempty"`
+	Palette         []string `yaml:"palette,omitempty"`
+	Help            []string `yaml:"help,omitempty"`
+	SwitchContext   []string `yaml:"switch_context,omitempty"`
+	Refresh         []string `yaml:"refresh,omitempty"`
+	CopyName        []string `yaml:"copy_name,omitempty"`
+	CopyCommand     []string `yaml:"copy_command,omitempty"`
+	History         []string `yaml:"history,omitempty"`
+	LastStderr      []string `yaml:"last_stderr,omitempty"`
+	Jobs            []string `yaml:"jobs,omitempty"`
+	Reload          []string `yaml:"reload,omitempty"`
+	NewTab          []string `yaml:"new_tab,omitempty"`
+	CloseTab        []string `yaml:"close_tab,omitempty"`
+	SwitchTab       []string `yaml:"switch_tab,omitempty"` // The nth key switches to tab n
+	LayoutPreset    []string `yaml:"layout_preset,omitempty"`
+	ResizeLeft      []string `yaml:"resize_left,omitempty"`
+	ResizeRight     []string `yaml:"resize_right,omitempty"`
+	ResizeUp        []string `yaml:"resize_up,omitempty"`
+	ResizeDown      []string `yaml:"resize_down,omitempty"`
 }
 
 // Context represents a Kubernetes context with its settings
 type Context struct {
-	Name    string   `yaml:"name"`
-	Actions []Action `yaml:"actions,omitempty"` // Per-context actions (extend/override global)
+	Name               string   `yaml:"name"`
+	Kubeconfig         string   `yaml:"kubeconfig,omitempty"`          // Kubeconfig file of the context; overrides the global kubeconfig
+	Actions            []Action `yaml:"actions,omitempty"`             // Per-context actions (extend/override global)
+	KubectlConcurrency int      `yaml:"kubectl_concurrency,omitempty"` // Overrides the global kubectl_concurrency
+	KubectlQPS         float64  `yaml:"kubectl_qps,omitempty"`         // Overrides the global kubectl_qps
+	KubectlBurst       int      `yaml:"kubectl_burst,omitempty"`       // Overrides the global kubectl_burst
+	OnEnter            string   `yaml:"on_enter,omitempty"`            // Command run when the context is selected ({{.context}}, {{.kubeconfig}})
+	OnExit             string   `yaml:"on_exit,omitempty"`             // Command run when another context is selected or kubertino exits
+	Namespaces         []string `yaml:"namespaces,omitempty"`          // Namespaces shown when listing namespaces is forbidden
+	Group              string   `yaml:"group,omitempty"`               // Header the context is listed under on the context selection screen
+	Env                string   `yaml:"env,omitempty"`                 // EnvProd, EnvStaging or EnvDev, shown as a colored badge
+	ReadOnly           bool     `yaml:"read_only,omitempty"`           // Refuse to run destructive actions (see Config.ReadOnlyBlocks)
+	PodSelector        string   `yaml:"pod_selector,omitempty"`        // Label selector pods are listed with, e.g. app=web
+	PodPageSize        int      `yaml:"pod_page_size,omitempty"`       // Overrides the global pod_page_size
+}
+
+// Environments that can be set in a context's env
+const (
+	EnvProd    = "prod"
+	EnvStaging = "staging"
+	EnvDev     = "dev"
+)
+
+// Envs lists the valid context environments
+var Envs = []string{EnvProd, EnvStaging, EnvDev}
+
+// GroupContexts returns contexts ordered for display: ungrouped contexts first, then each
+// group in the order it first appears. The order within a group is kept.
+func GroupContexts(contexts []Context) []Context {
+	var groups []string
+	for _, ctx := range contexts {
+		if ctx.Group != "" && !slices.Contains(groups, ctx.Group) {
+			groups = append(groups, ctx.Group)
+		}
+	}
+	ordered := make([]Context, 0, len(contexts))
+	for _, group := range append([]string{""}, groups...) {
+		for _, ctx := range contexts {
+			if ctx.Group == group {
+				ordered = append(ordered, ctx)
+			}
+		}
+	}
+	return ordered
+}
+
+// DefaultDestructivePatterns classify action commands as destructive when
+// destructive_patterns is not set
+var DefaultDestructivePatterns = []string{`\bdelete\b`, `\bscale\b`, `\brollout\s+restart\b`, `\bexec\b`}
+
+// destructivePatterns returns destructive_patterns, or DefaultDestructivePatterns when it is
+// not set
+func (c *Config) destructivePatterns() []string {
+	if len(c.DestructivePatterns) == 0 {
+		return DefaultDestructivePatterns
+	}
+	return c.DestructivePatterns
+}
+
+// DestructiveCommand reports whether command (an action's command template) matches one of
+// the destructive patterns. Invalid patterns (rejected by Validate) match nothing.
+func (c *Config) DestructiveCommand(command string) bool {
+	for _, expr := range c.destructivePatterns() {
+		pattern, err := regexp.Compile(expr)
+		if err == nil && pattern.MatchString(command) {
+			return true
+		}
+	}
+	return false
+}
+
+// ReadOnlyBlocks reports whether ctx refuses to run action: a read_only context refuses
+// actions marked destructive and actions whose command is classified as destructive
+func (c *Config) ReadOnlyBlocks(ctx Context, action Action) bool {
+	return ctx.ReadOnly && (action.Destructive || c.DestructiveCommand(action.Command))
 }
 
 // Action represents a configurable action with a shortcut
 type Action struct {
-	Name        string `yaml:"name"`
-	Shortcut    string `yaml:"shortcut"`
-	Command     string `yaml:"command"`                // Template with {{.context}}, {{.namespace}}, {{.pod}}
-	Destructive bool   `yaml:"destructive,omitempty"`  // Requires confirmation (optional)
-	WaitOnExit  bool   `yaml:"wait_on_exit,omitempty"` // Wait for Ctrl+D before returning to TUI (optional, default: false)
+	Name        string        `yaml:"name"`
+	Shortcut    string        `yaml:"shortcut"`
+	Command     string        `yaml:"command"`                // Template with {{.context}}, {{.namespace}}, {{.pod}}, {{.container}}, ...
+	Description string        `yaml:"description,omitempty"`  // What the action does, shown in the help overlay (optional)
+	Destructive bool          `yaml:"destructive,omitempty"`  // Requires confirmation (optional)
+	WaitOnExit  bool          `yaml:"wait_on_exit,omitempty"` // Wait for Ctrl+D before returning to TUI (optional, default: false)
+	Tags        []string      `yaml:"tags,omitempty"`         // Labels for filtering the actions panel, e.g. db, logs, deploy (optional)
+	Group       string        `yaml:"group,omitempty"`        // Name of the action group the action is in; its shortcut follows the group's (optional)
+	AppliesTo   string        `yaml:"applies_to,omitempty"`   // Regex the selected pod name must match, e.g. ^web- (optional)
+	Scope       string        `yaml:"scope,omitempty"`        // What the action needs: ActionScopePod (default), ActionScopeNamespace or ActionScopeContext (optional)
+	Output      string        `yaml:"output,omitempty"`       // Where output goes: the terminal (default) or ActionOutputCapture (optional)
+	Background  bool          `yaml:"background,omitempty"`   // Run detached from the terminal, tracked in the jobs list (optional)
+	OnSuccess   string        `yaml:"on_success,omitempty"`   // Name or shortcut of the action to follow up with after exit code 0 (optional)
+	OnFailure   string        `yaml:"on_failure,omitempty"`   // Name or shortcut of the action to follow up with after a failure (optional)
+	FollowUp    string        `yaml:"follow_up,omitempty"`    // FollowUpAsk (default) offers the follow-up, FollowUpRun runs it (optional)
+	Params      []ActionParam `yaml:"params,omitempty"`       // Values asked for before the action runs, used as {{.params.<name>}} (optional)
+	CaptureVar  string        `yaml:"capture_var,omitempty"`  // Session variable set to the trimmed stdout of a successful run, used by later actions as {{.vars.<name>}} (optional)
+	Plugin      string        `yaml:"-"`                      // Plugin that provided the action, "" for configured actions
+}
+
+// ActionGroup is a submenu of the actions panel: its actions are run by pressing the group's
+// shortcut and then theirs, so a group of database actions can use letters other actions use
+type ActionGroup struct {
+	Name     string `yaml:"name"`     // Referred to by the group of actions, shown as the header of the group
+	Shortcut string `yaml:"shortcut"` // Single character opening the group
+}
+
+// FindActionGroup returns the group of groups named name
+func FindActionGroup(groups []ActionGroup, name string) (ActionGroup, bool) {
+	for _, group := range groups {
+		if group.Name == name {
+			return group, true
+		}
+	}
+	return ActionGroup{}, false
+}
+
+// SameKey reports whether a and b are run by the same keys: the same shortcut in the same group
+func (a Action) SameKey(b Action) bool {
+	return a.Shortcut == b.Shortcut && a.Group == b.Group
+}
+
+// ActionParam is a value the user is asked for before an action runs, such as a number of
+// log lines or a SQL query. The command uses it as {{.params.<name>}}.
+type ActionParam struct {
+	Name     string `yaml:"name"`               // Name in the template, e.g. lines for {{.params.lines}}
+	Prompt   string `yaml:"prompt,omitempty"`   // Label of the input (default: the name)
+	Default  string `yaml:"default,omitempty"`  // Value the input starts with, and the value when not asked (kubertino exec)
+	Required bool   `yaml:"required,omitempty"` // An empty value is refused
+	Pattern  string `yaml:"pattern,omitempty"`  // Regex a non-empty value must match, e.g. ^[0-9]+$
+}
+
+// Label returns the prompt of the param, or its name when no prompt is set
+func (p ActionParam) Label() string {
+	if p.Prompt != "" {
+		return p.Prompt
+	}
+	return p.Name
+}
+
+// Check returns why value is not accepted for the param, or nil. An invalid pattern
+// (rejected by Validate) accepts nothing.
+func (p ActionParam) Check(value string) error {
+	if value == "" {
+		if p.Required {
+			return fmt.Errorf("%s is required", p.Label())
+		}
+		return nil
+	}
+	if p.Pattern == "" {
+		return nil
+	}
+	pattern, err := regexp.Compile(p.Pattern)
+	if err != nil || !pattern.MatchString(value) {
+		return fmt.Errorf("%s must match %s", p.Label(), p.Pattern)
+	}
+	return nil
+}
+
+// ParamValues returns the value of every param of the action for {{.params}}: the one in
+// values when given, else the param's default
+func (a Action) ParamValues(values map[string]string) map[string]string {
+	result := make(map[string]string, len(a.Params))
+	for _, param := range a.Params {
+		value, ok := values[param.Name]
+		if !ok {
+			value = param.Default
+		}
+		result[param.Name] = value
+	}
+	return result
+}
+
+// CheckParams returns the first param value of values (see ParamValues) that is not accepted
+func (a Action) CheckParams(values map[string]string) error {
+	values = a.ParamValues(values)
+	for _, param := range a.Params {
+		if err := param.Check(values[param.Name]); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// How a follow-up action (on_success, on_failure) is started
+const (
+	FollowUpAsk = "ask" // Offer the follow-up, running it once confirmed
+	FollowUpRun = "run" // Run the follow-up right away
+)
+
+// FollowUpFor returns the on_failure reference of the action when failed is set, else its
+// on_success reference. Empty when none is declared.
+func (a Action) FollowUpFor(failed bool) string {
+	if failed {
+		return a.OnFailure
+	}
+	return a.OnSuccess
+}
+
+// FindAction returns the action of actions whose name or shortcut is ref. Actions in a group
+// are only found by name, as their shortcut is only unique within the group.
+func FindAction(actions []Action, ref string) (Action, bool) {
+	for _, action := range actions {
+		if action.Name == ref || (action.Shortcut == ref && action.Group == "") {
+			return action, true
+		}
+	}
+	return Action{}, false
+}
+
+// ActionOutputCapture runs an action in the background and shows its output in a pager
+// inside the TUI instead of handing the terminal to the command
+const ActionOutputCapture = "capture"
+
+// What an action runs against (scope)
+const (
+	ActionScopePod       = "pod"       // The selected pod or resource
+	ActionScopeNamespace = "namespace" // The open namespace; no pod needs to be selected
+	ActionScopeContext   = "context"   // The open context; no namespace needs to be open
+)
+
+// TargetScope returns the scope of the action, ActionScopePod when none is set
+func (a Action) TargetScope() string {
+	if a.Scope == "" {
+		return ActionScopePod
+	}
+	return a.Scope
+}
+
+// AppliesToTarget reports whether the action can run against the pod or resource named name.
+// Actions without applies_to apply to everything; an invalid pattern (rejected by Validate)
+// matches nothing.
+func (a Action) AppliesToTarget(name string) bool {
+	if a.AppliesTo == "" {
+		return true
+	}
+	pattern, err := regexp.Compile(a.AppliesTo)
+	if err != nil {
+		return false
+	}
+	return pattern.MatchString(name)
 }
@@ -4,6 +4,7 @@ import (
 	"os"
 	"path/filepath"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -258,6 +259,81 @@ func TestGetFavorites(t *testing.T) {
 	}
 }
 
+func TestToggleFavorite(t *testing.T) {
+	tests := []struct {
+		name      string
+		favorites interface{}
+		namespace string
+		wantAdded bool
+		want      interface{}
+	}{
+		{
+			name:      "no favorites creates per-context entry",
+			namespace: "default",
+			wantAdded: true,
+			want:      map[string]interface{}{"prod": []interface{}{"default"}},
+		},
+		{
+			name:      "per-context add keeps other contexts",
+			favorites: map[string]interface{}{"prod": []interface{}{"ns1"}, "dev": []interface{}{"x"}},
+			namespace: "ns2",
+			wantAdded: true,
+			want:      map[string]interface{}{"prod": []interface{}{"ns1", "ns2"}, "dev": []interface{}{"x"}},
+		},
+		{
+			name:      "per-context remove drops empty list",
+			favorites: map[string]interface{}{"prod": []interface{}{"ns1"}, "dev": []interface{}{"x"}},
+			namespace: "ns1",
+			wantAdded: false,
+			want:      map[string]interface{}{"dev": []interface{}{"x"}},
+		},
+		{
+			name:      "global list edited in place",
+			favorites: []interface{}{"ns1", "ns2"},
+			namespace: "ns1",
+			wantAdded: false,
+			want:      []interface{}{"ns2"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg := &Config{Version: "1.0", Favorites: tt.favorites}
+			added, err := ToggleFavorite(cfg, "prod", tt.namespace)
+			require.NoError(t, err)
+			assert.Equal(t, tt.wantAdded, added)
+			assert.Equal(t, tt.want, cfg.Favorites)
+		})
+	}
+}
+
+func TestEffective(t *testing.T) {
+	cfg := &Config{
+		Version:   "1.0",
+		Actions:   []Action{{Name: "Logs", Shortcut: "l"}, {Name: "Shell", Shortcut: "s"}},
+		Favorites: map[string]interface{}{"prod": []interface{}{"payments"}},
+		Contexts: []Context{
+			{Name: "prod", Actions: []Action{{Name: "Prod shell", Shortcut: "s"}}},
+			{Name: "dev"},
+		},
+	}
+
+	effective, err := Effective(cfg)
+	require.NoError(t, err)
+
+	assert.Nil(t, effective.Actions)
+	assert.Equal(t, []Action{{Name: "Logs", Shortcut: "l"}, {Name: "Prod shell", Shortcut: "s"}}, effective.Contexts[0].Actions)
+	assert.Equal(t, cfg.Actions, effective.Contexts[1].Actions)
+	assert.Equal(t, map[string]interface{}{"prod": []interface{}{"payments"}}, effective.Favorites)
+	assert.Len(t, cfg.Actions, 2, "input is not modified")
+
+	// Global favorites are expanded to every context
+	cfg.Favorites = []interface{}{"default"}
+	effective, err = Effective(cfg)
+	require.NoError(t, err)
+	assert.Equal(t, map[string]interface{}{"prod": []interface{}{"default"}, "dev": []interface{}{"default"}}, effective.Favorites)
+}
+
 // TestMergeActions tests the action merging logic
 func TestMergeActions(t *testing.T) {
 	tests := []struct {
@@ -323,6 +399,20 @@ func TestMergeActions(t *testing.T) {
 				{Name: "Exec", Shortcut: "e", Command: "kubectl exec {{.pod}}"},
 			},
 		},
+		{
+			name: "override within a group",
+			globalActions: []Action{
+				{Name: "Logs", Shortcut: "l", Command: "kubectl logs {{.pod}}"},
+				{Name: "DB Logs", Shortcut: "l", Group: "db", Command: "kubectl logs {{.pod}} -c postgres"},
+			},
+			contextActions: []Action{
+				{Name: "Replica Logs", Shortcut: "l", Group: "db", Command: "kubectl logs {{.pod}} -c replica"},
+			},
+			want: []Action{
+				{Name: "Logs", Shortcut: "l", Command: "kubectl logs {{.pod}}"},
+				{Name: "Replica Logs", Shortcut: "l", Group: "db", Command: "kubectl logs {{.pod}} -c replica"},
+			},
+		},
 	}
 
 	for _, tt := range tests {
@@ -332,3 +422,234 @@ func TestMergeActions(t *testing.T) {
 		})
 	}
 }
+
+// TestKubectlLimit tests the per-context kubectl concurrency resolution
+func TestKubectlLimit(t *testing.T) {
+	cfg := &Config{Contexts: []Context{{Name: "prod", KubectlConcurrency: 1}, {Name: "dev"}}}
+	assert.Equal(t, 1, cfg.KubectlLimit("prod"))
+	assert.Equal(t, DefaultKubectlConcurrency, cfg.KubectlLimit("dev"))
+
+	cfg.KubectlConcurrency = 8
+	assert.Equal(t, 1, cfg.KubectlLimit("prod"), "context setting wins")
+	assert.Equal(t, 8, cfg.KubectlLimit("dev"))
+	assert.Equal(t, 8, cfg.KubectlLimit("unknown"))
+}
+
+// TestPodPaging tests the per-context pod page size and selector resolution
+func TestPodPaging(t *testing.T) {
+	cfg := &Config{Contexts: []Context{{Name: "prod", PodPageSize: 100, PodSelector: "app=web"}, {Name: "dev"}}}
+	assert.Equal(t, 100, cfg.PodPageLimit("prod"))
+	assert.Zero(t, cfg.PodPageLimit("dev"), "paging is off by default")
+
+	cfg.PodPageSize = 500
+	assert.Equal(t, 100, cfg.PodPageLimit("prod"), "context setting wins")
+	assert.Equal(t, 500, cfg.PodPageLimit("dev"))
+
+	assert.Equal(t, "app=web", cfg.PodSelector("prod"))
+	assert.Empty(t, cfg.PodSelector("dev"))
+	assert.Empty(t, cfg.PodSelector("unknown"))
+}
+
+func TestNamespaceGroupPrefix(t *testing.T) {
+	cfg := &Config{}
+	_, ok := cfg.NamespaceGroupPrefix("team-a-api")
+	assert.False(t, ok, "grouping is off by default")
+
+	cfg.NamespaceGrouping = NamespaceGroupingPrefix
+	prefix, ok := cfg.NamespaceGroupPrefix("team-a-api")
+	assert.True(t, ok)
+	assert.Equal(t, "team", prefix)
+	_, ok = cfg.NamespaceGroupPrefix("default")
+	assert.False(t, ok)
+	_, ok = cfg.NamespaceGroupPrefix("-leading")
+	assert.False(t, ok)
+
+	cfg.NamespaceGroupDelimiter = "--"
+	prefix, ok = cfg.NamespaceGroupPrefix("team-a--api")
+	assert.True(t, ok)
+	assert.Equal(t, "team-a", prefix)
+}
+
+func TestSpinnerSettings(t *testing.T) {
+	var cfg *Config
+	assert.Equal(t, spinnerPresets[SpinnerDots], cfg.SpinnerFrames())
+	interval, err := cfg.SpinnerIntervalDuration()
+	require.NoError(t, err)
+	assert.Equal(t, DefaultSpinnerInterval, interval)
+	assert.Equal(t, "Loading pods...", cfg.LoadingMessage(LoadingPods, "Loading pods..."))
+
+	cfg = &Config{Appearance: &Appearance{
+		Spinner:         SpinnerLine,
+		SpinnerInterval: "250ms",
+		LoadingMessages: map[string]string{LoadingPods: "Asking the API server..."},
+	}}
+	assert.Equal(t, []string{"|", "/", "-", "\\"}, cfg.SpinnerFrames())
+	interval, err = cfg.SpinnerIntervalDuration()
+	require.NoError(t, err)
+	assert.Equal(t, 250*time.Millisecond, interval)
+	assert.Equal(t, "Asking the API server...", cfg.LoadingMessage(LoadingPods, "Loading pods..."))
+	assert.Equal(t, "Loading namespaces...", cfg.LoadingMessage(LoadingNamespaces, "Loading namespaces..."))
+
+	cfg.Appearance.Spinner = SpinnerCustom
+	cfg.Appearance.SpinnerFrames = []string{"◐", "◓"}
+	assert.Equal(t, []string{"◐", "◓"}, cfg.SpinnerFrames())
+}
+
+// TestKubeconfigPath tests the per-context kubeconfig resolution
+func TestKubeconfigPath(t *testing.T) {
+	cfg := &Config{Contexts: []Context{{Name: "prod", Kubeconfig: "~/.kube/prod.yaml"}, {Name: "dev"}}}
+	assert.Equal(t, "~/.kube/prod.yaml", cfg.KubeconfigPath("prod"))
+	assert.Empty(t, cfg.KubeconfigPath("dev"), "kubectl picks the file")
+
+	cfg.Kubeconfig = "~/.kube/config"
+	assert.Equal(t, "~/.kube/prod.yaml", cfg.KubeconfigPath("prod"), "context setting wins")
+	assert.Equal(t, "~/.kube/config", cfg.KubeconfigPath("dev"))
+	assert.Equal(t, "~/.kube/config", cfg.KubeconfigPath("unknown"))
+}
+
+// TestKubectlRetryPolicy tests the kubectl_timeout and retry_backoff defaults and parsing
+func TestKubectlRetryPolicy(t *testing.T) {
+	cfg := &Config{}
+	timeout, err := cfg.KubectlTimeoutDuration()
+	require.NoError(t, err)
+	assert.Equal(t, DefaultKubectlTimeout, timeout)
+	backoff, err := cfg.RetryBackoffDuration()
+	require.NoError(t, err)
+	assert.Equal(t, DefaultRetryBackoff, backoff)
+
+	cfg = &Config{KubectlTimeout: "30s", RetryBackoff: "2s"}
+	timeout, err = cfg.KubectlTimeoutDuration()
+	require.NoError(t, err)
+	assert.Equal(t, 30*time.Second, timeout)
+	backoff, err = cfg.RetryBackoffDuration()
+	require.NoError(t, err)
+	assert.Equal(t, 2*time.Second, backoff)
+
+	_, err = (&Config{KubectlTimeout: "0"}).KubectlTimeoutDuration()
+	assert.ErrorContains(t, err, "must be positive")
+	_, err = (&Config{RetryBackoff: "-1s"}).RetryBackoffDuration()
+	assert.ErrorContains(t, err, "must not be negative")
+}
+
+// TestNamespaceFallback tests the namespaces shown when listing namespaces is forbidden
+func TestNamespaceFallback(t *testing.T) {
+	cfg := &Config{
+		Favorites: map[string]interface{}{"dev": []interface{}{"team-a"}},
+		Contexts:  []Context{{Name: "prod", Namespaces: []string{"billing"}}, {Name: "dev"}},
+	}
+
+	namespaces, candidates := cfg.NamespaceFallback("prod")
+	assert.Equal(t, []string{"billing"}, namespaces)
+	assert.Empty(t, candidates, "favorites are not probed when namespaces are listed")
+
+	namespaces, candidates = cfg.NamespaceFallback("dev")
+	assert.Empty(t, namespaces)
+	assert.Equal(t, []string{"team-a"}, candidates)
+}
+
+// TestGroupContexts tests the display order of grouped contexts
+func TestGroupContexts(t *testing.T) {
+	contexts := []Context{
+		{Name: "prod-eu", Group: "Production"},
+		{Name: "minikube"},
+		{Name: "stage", Group: "Staging"},
+		{Name: "prod-us", Group: "Production"},
+		{Name: "kind"},
+	}
+
+	var names []string
+	for _, ctx := range GroupContexts(contexts) {
+		names = append(names, ctx.Name)
+	}
+	assert.Equal(t, []string{"minikube", "kind", "prod-eu", "prod-us", "stage"}, names)
+	assert.Equal(t, "prod-eu", contexts[0].Name, "the configured order is kept")
+}
+
+// TestReadOnlyBlocks tests the classification of actions in read-only contexts
+func TestReadOnlyBlocks(t *testing.T) {
+	readOnly := Context{Name: "prod", ReadOnly: true}
+	cfg := &Config{}
+
+	tests := []struct {
+		action Action
+		want   bool
+	}{
+		{Action{Command: "kubectl logs -f {{.pod}}"}, false},
+		{Action{Command: "kubectl delete pod {{.pod}}"}, true},
+		{Action{Command: "kubectl scale deploy/web --replicas=0"}, true},
+		{Action{Command: "kubectl rollout  restart deploy/web"}, true},
+		{Action{Command: "kubectl rollout status deploy/web"}, false},
+		{Action{Command: "kubectl exec -it {{.pod}} -- sh"}, true},
+		{Action{Command: "./deleted-pods.sh"}, false},
+		{Action{Command: "echo hi", Destructive: true}, true},
+	}
+	for _, tt := range tests {
+		assert.Equal(t, tt.want, cfg.ReadOnlyBlocks(readOnly, tt.action), tt.action.Command)
+		assert.False(t, cfg.ReadOnlyBlocks(Context{Name: "dev"}, tt.action), "writable context: %s", tt.action.Command)
+	}
+
+	cfg.DestructivePatterns = []string{`^helm (upgrade|uninstall)`}
+	assert.True(t, cfg.ReadOnlyBlocks(readOnly, Action{Command: "helm upgrade web ./chart"}))
+	assert.False(t, cfg.ReadOnlyBlocks(readOnly, Action{Command: "kubectl delete pod {{.pod}}"}), "patterns replace the defaults")
+}
+
+// TestKubectlRate tests the per-context kubectl throttling resolution
+func TestKubectlRate(t *testing.T) {
+	cfg := &Config{Contexts: []Context{{Name: "prod", KubectlQPS: 2, KubectlBurst: 5}, {Name: "dev"}}}
+	assert.True(t, cfg.Throttled())
+
+	qps, burst := cfg.KubectlRate("prod")
+	assert.Equal(t, 2.0, qps)
+	assert.Equal(t, 5, burst)
+
+	qps, burst = cfg.KubectlRate("dev")
+	assert.Zero(t, qps, "unthrottled by default")
+	assert.Zero(t, burst)
+
+	cfg.KubectlQPS = 2.5
+	qps, burst = cfg.KubectlRate("dev")
+	assert.Equal(t, 2.5, qps)
+	assert.Equal(t, 3, burst, "burst defaults to the qps rounded up")
+
+	cfg.KubectlBurst = 10
+	_, burst = cfg.KubectlRate("prod")
+	assert.Equal(t, 5, burst, "context setting wins")
+
+	assert.False(t, (&Config{Contexts: []Context{{Name: "dev"}}}).Throttled())
+}
+
+// TestAction_AppliesToTarget tests matching of the applies_to pattern
+func TestAction_AppliesToTarget(t *testing.T) {
+	assert.True(t, Action{}.AppliesToTarget("anything"))
+
+	console := Action{AppliesTo: "^web-"}
+	assert.True(t, console.AppliesToTarget("web-5d8f-abc"))
+	assert.False(t, console.AppliesToTarget("worker-1"))
+
+	assert.False(t, Action{AppliesTo: "web-(["}.AppliesToTarget("web-1"), "invalid patterns match nothing")
+}
+
+func TestActionParam_Check(t *testing.T) {
+	lines := ActionParam{Name: "lines", Prompt: "Lines to show", Required: true, Pattern: "^[0-9]+$"}
+	assert.Equal(t, "Lines to show", lines.Label())
+	assert.Equal(t, "grep", ActionParam{Name: "grep"}.Label(), "falls back to the name")
+
+	assert.NoError(t, lines.Check("200"))
+	assert.EqualError(t, lines.Check(""), "Lines to show is required")
+	assert.EqualError(t, lines.Check("all"), "Lines to show must match ^[0-9]+$")
+	assert.NoError(t, ActionParam{Name: "grep", Pattern: "^[a-z]+$"}.Check(""), "optional params may stay empty")
+}
+
+func TestAction_ParamValues(t *testing.T) {
+	action := Action{Name: "Tail", Params: []ActionParam{
+		{Name: "lines", Default: "100", Required: true},
+		{Name: "grep"},
+	}}
+
+	assert.Equal(t, map[string]string{"lines": "100", "grep": ""}, action.ParamValues(nil))
+	assert.Equal(t, map[string]string{"lines": "5", "grep": "ERROR"}, action.ParamValues(map[string]string{"lines": "5", "grep": "ERROR"}))
+	assert.NoError(t, action.CheckParams(nil), "the default satisfies required")
+
+	action.Params[0].Default = ""
+	assert.EqualError(t, action.CheckParams(nil), "lines is required")
+}
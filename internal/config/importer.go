@@ -0,0 +1,394 @@
+package config
+
+import (
+	"bufio"
+	"bytes"
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+// Formats accepted by Import
+const (
+	ImportK9s     = "k9s"     // k9s plugin file (plugins.yaml or the older plugin.yml)
+	ImportAliases = "aliases" // Shell alias file, e.g. ~/.bash_aliases
+)
+
+// ImportSkip is an imported entry that could not be translated into an action
+type ImportSkip struct {
+	Name   string
+	Reason string
+}
+
+// ImportResult holds the actions translated from an imported file and the entries that could
+// not be translated
+type ImportResult struct {
+	Actions []Action
+	Skipped []ImportSkip
+}
+
+// skip records an entry that could not be translated
+func (r *ImportResult) skip(name, format string, args ...any) {
+	r.Skipped = append(r.Skipped, ImportSkip{Name: name, Reason: fmt.Sprintf(format, args...)})
+}
+
+// Import translates the entries of a k9s plugin file or a shell alias file into actions.
+// Shortcuts are chosen so that they do not collide with taken or with each other.
+func Import(format string, data []byte, taken []string) (ImportResult, error) {
+	shortcuts := newShortcutPicker(taken)
+	switch format {
+	case ImportK9s:
+		return importK9s(data, shortcuts)
+	case ImportAliases:
+		return importAliases(data, shortcuts), nil
+	}
+	return ImportResult{}, fmt.Errorf("unknown import format %q (%s or %s)", format, ImportK9s, ImportAliases)
+}
+
+// k9sPlugin is one entry of a k9s plugin file
+type k9sPlugin struct {
+	ShortCut    string   `yaml:"shortCut"`
+	Description string   `yaml:"description"`
+	Scopes      []string `yaml:"scopes"`
+	Command     string   `yaml:"command"`
+	Args        []string `yaml:"args"`
+	Background  bool     `yaml:"background"`
+	Confirm     bool     `yaml:"confirm"`
+	Dangerous   bool     `yaml:"dangerous"`
+}
+
+// k9sPluginFile is a k9s plugin file; k9s before 0.29 named the section plugin
+type k9sPluginFile struct {
+	Plugins map[string]k9sPlugin `yaml:"plugins"`
+	Plugin  map[string]k9sPlugin `yaml:"plugin"`
+}
+
+// k9sScopes maps k9s view names to the name variable they provide: the selected pod or the
+// selected resource of the resource browser. Containers views name the container.
+var k9sScopes = map[string]string{
+	"all":          "{{.resource}}",
+	"pods":         "{{.pod}}",
+	"pod":          "{{.pod}}",
+	"po":           "{{.pod}}",
+	"containers":   "{{.container}}",
+	"deployments":  "{{.resource}}",
+	"deploy":       "{{.resource}}",
+	"dp":           "{{.resource}}",
+	"statefulsets": "{{.resource}}",
+	"sts":          "{{.resource}}",
+	"jobs":         "{{.resource}}",
+	"job":          "{{.resource}}",
+	"configmaps":   "{{.resource}}",
+	"cm":           "{{.resource}}",
+	"secrets":      "{{.resource}}",
+	"sec":          "{{.resource}}",
+}
+
+// k9sVariables maps the environment variables k9s sets for plugins to action variables.
+// $NAME depends on the scope and is added per plugin.
+var k9sVariables = map[string]string{
+	"NAMESPACE":     "{{.namespace}}",
+	"CONTEXT":       "{{.context}}",
+	"CONTAINER":     "{{.container}}",
+	"POD":           "{{.pod}}",
+	"KUBECONFIG":    "{{.kubeconfig}}",
+	"RESOURCE_NAME": "{{.kind}}",
+}
+
+// k9sOnlyVariables are variables set by k9s that have no action equivalent
+var k9sOnlyVariables = map[string]bool{
+	"CLUSTER":          true,
+	"USER":             true,
+	"GROUPS":           true,
+	"FILTER":           true,
+	"RESOURCE_GROUP":   true,
+	"RESOURCE_VERSION": true,
+}
+
+// variablePattern matches $VAR and ${VAR}, including the $COL-<column> variables of k9s
+var variablePattern = regexp.MustCompile(`\$\{?([A-Za-z_][A-Za-z0-9_]*(?:-[A-Za-z0-9_]+)?)\}?`)
+
+// importK9s translates k9s plugins, in name order, into actions
+func importK9s(data []byte, shortcuts *shortcutPicker) (ImportResult, error) {
+	var file k9sPluginFile
+	if err := yaml.Unmarshal(data, &file); err != nil {
+		return ImportResult{}, fmt.Errorf("failed to parse k9s plugin file: %w", err)
+	}
+	plugins := file.Plugins
+	if len(plugins) == 0 {
+		plugins = file.Plugin
+	}
+
+	names := make([]string, 0, len(plugins))
+	for name := range plugins {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	var result ImportResult
+	for _, name := range names {
+		plugin := plugins[name]
+		if plugin.Command == "" {
+			result.skip(name, "no command")
+			continue
+		}
+
+		target := ""
+		for _, scope := range plugin.Scopes {
+			variable, ok := k9sScopes[strings.ToLower(scope)]
+			// Pods win over other views, since they need no resource browser
+			if ok && (target == "" || variable == "{{.pod}}") {
+				target = variable
+			}
+		}
+		if target == "" {
+			result.skip(name, "scopes %s are not pods or a resource kind kubertino browses", strings.Join(plugin.Scopes, ", "))
+			continue
+		}
+
+		words := append([]string{plugin.Command}, plugin.Args...)
+		for i, word := range words {
+			translated, err := translateK9sVariables(word, target)
+			if err != nil {
+				result.skip(name, "%v", err)
+				words = nil
+				break
+			}
+			words[i] = shellQuote(translated)
+		}
+		if words == nil {
+			continue
+		}
+
+		actionName := plugin.Description
+		if actionName == "" {
+			actionName = name
+		}
+		shortcut := shortcuts.pick(k9sShortcut(plugin.ShortCut), actionName)
+		if shortcut == "" {
+			result.skip(name, "no free shortcut")
+			continue
+		}
+
+		result.Actions = append(result.Actions, Action{
+			Name:        actionName,
+			Shortcut:    shortcut,
+			Command:     strings.Join(words, " "),
+			Destructive: plugin.Confirm || plugin.Dangerous,
+			Background:  plugin.Background,
+		})
+	}
+	return result, nil
+}
+
+// translateK9sVariables replaces the k9s variables of word with action variables. target
+// replaces $NAME. Other environment variables are left to the shell.
+func translateK9sVariables(word, target string) (string, error) {
+	var err error
+	translated := variablePattern.ReplaceAllStringFunc(word, func(match string) string {
+		name := variablePattern.FindStringSubmatch(match)[1]
+		switch {
+		case name == "NAME":
+			return target
+		case k9sVariables[name] != "":
+			return k9sVariables[name]
+		case k9sOnlyVariables[name] || strings.HasPrefix(name, "COL-"):
+			if err == nil {
+				err = fmt.Errorf("$%s has no kubertino equivalent", name)
+			}
+		}
+		return match
+	})
+	return translated, err
+}
+
+// k9sShortcut converts a k9s shortcut (e.g. Ctrl-L, Shift-L, Alt-L) to an action shortcut:
+// Shift keeps the upper case letter, other modifiers are dropped
+func k9sShortcut(key string) string {
+	modifier, letter, found := strings.Cut(key, "-")
+	if !found {
+		return key
+	}
+	if strings.EqualFold(modifier, "shift") {
+		return strings.ToUpper(letter)
+	}
+	return strings.ToLower(letter)
+}
+
+// shellUnsafe matches words that must be quoted for sh
+var shellUnsafe = regexp.MustCompile(`[^A-Za-z0-9_./:=@%+,{}-]`)
+
+// shellQuote double-quotes word for sh unless it only has characters sh takes literally.
+// Environment variables keep being expanded, like k9s expands them in plugin args.
+func shellQuote(word string) string {
+	if word != "" && !shellUnsafe.MatchString(word) {
+		return word
+	}
+	escaped := strings.NewReplacer(`\`, `\\`, `"`, `\"`, "`", "\\`").Replace(word)
+	return `"` + escaped + `"`
+}
+
+// aliasPattern matches a shell alias definition: alias name='value'
+var aliasPattern = regexp.MustCompile(`^\s*alias\s+([A-Za-z0-9_.-]+)=(.*)$`)
+
+// valueFlags are the common kubectl flags whose value is the next word
+var valueFlags = map[string]bool{
+	"-c": true, "--container": true, "--context": true, "--kubeconfig": true,
+	"-l": true, "--selector": true, "-o": true, "--output": true,
+	"--tail": true, "--since": true,
+}
+
+// podTypes are the kubectl resource type names of pods
+var podTypes = map[string]bool{"po": true, "pod": true, "pods": true}
+
+// importAliases translates the kubectl aliases of a shell alias file into actions. Lines that
+// are not alias definitions are ignored; aliases that are not kubectl commands acting on a
+// pod are reported as skipped.
+func importAliases(data []byte, shortcuts *shortcutPicker) ImportResult {
+	var result ImportResult
+	kubectl := map[string]bool{"kubectl": true} // Aliases of kubectl itself, e.g. k
+	scanner := bufio.NewScanner(bytes.NewReader(data))
+	for scanner.Scan() {
+		match := aliasPattern.FindStringSubmatch(scanner.Text())
+		if match == nil {
+			continue
+		}
+		name, value := match[1], unquoteAlias(match[2])
+
+		words := strings.Fields(value)
+		if len(words) == 1 && kubectl[words[0]] {
+			kubectl[name] = true
+			continue
+		}
+		if len(words) == 0 || !kubectl[words[0]] {
+			result.skip(name, "not a kubectl command")
+			continue
+		}
+		if strings.ContainsAny(value, "|;&<>`$") {
+			result.skip(name, "uses shell operators or variables")
+			continue
+		}
+		words[0] = "kubectl"
+
+		action, reason := aliasAction(name, words)
+		if reason != "" {
+			result.skip(name, "%s", reason)
+			continue
+		}
+		if action.Shortcut = shortcuts.pick("", name); action.Shortcut == "" {
+			result.skip(name, "no free shortcut")
+			continue
+		}
+		result.Actions = append(result.Actions, action)
+	}
+	return result
+}
+
+// aliasAction builds the action for a kubectl alias split into words, appending the
+// namespace and the selected pod. Returns why it cannot be translated otherwise.
+func aliasAction(name string, words []string) (Action, string) {
+	var positional []string
+	namespaced, flagValue := false, false
+	for _, word := range words[1:] {
+		switch {
+		case flagValue:
+			flagValue = false
+		case word == "-n" || word == "--namespace" || strings.HasPrefix(word, "--namespace="):
+			namespaced = true
+			flagValue = !strings.Contains(word, "=")
+		case word == "-A" || word == "--all-namespaces":
+			return Action{}, "spans all namespaces"
+		case word == "--":
+			return Action{}, "has a command after --"
+		case valueFlags[word]:
+			flagValue = true
+		case !strings.HasPrefix(word, "-"):
+			positional = append(positional, word)
+		}
+	}
+	if len(positional) == 0 {
+		return Action{}, "no kubectl command"
+	}
+
+	var target []string
+	switch verb := positional[0]; verb {
+	case "logs", "attach", "exec":
+		if len(positional) > 1 {
+			return Action{}, "already names a pod"
+		}
+		target = []string{"{{.pod}}"}
+		if verb == "exec" {
+			target = append(target, "--", "/bin/sh")
+		}
+	case "describe", "get", "edit", "delete", "top":
+		switch {
+		case len(positional) == 1:
+			target = []string{"pod", "{{.pod}}"}
+		case !podTypes[positional[1]]:
+			return Action{}, fmt.Sprintf("acts on %s, not on a pod", positional[1])
+		case len(positional) > 2:
+			return Action{}, "already names a pod"
+		default:
+			target = []string{"{{.pod}}"}
+		}
+	default:
+		return Action{}, fmt.Sprintf("kubectl %s does not act on a selected pod", verb)
+	}
+
+	if !namespaced {
+		words = append(words, "-n", "{{.namespace}}")
+	}
+	return Action{
+		Name:        name,
+		Command:     strings.Join(append(words, target...), " "),
+		Destructive: positional[0] == "delete",
+	}, ""
+}
+
+// unquoteAlias strips the quotes around an alias value
+func unquoteAlias(value string) string {
+	value = strings.TrimSpace(value)
+	if len(value) >= 2 && (value[0] == '\'' || value[0] == '"') && value[len(value)-1] == value[0] {
+		return value[1 : len(value)-1]
+	}
+	return value
+}
+
+// shortcutPicker hands out action shortcuts that are not taken yet
+type shortcutPicker struct {
+	taken map[string]bool
+}
+
+// newShortcutPicker creates a picker avoiding the taken shortcuts
+func newShortcutPicker(taken []string) *shortcutPicker {
+	p := &shortcutPicker{taken: make(map[string]bool, len(taken))}
+	for _, key := range taken {
+		p.taken[key] = true
+	}
+	return p
+}
+
+// pick returns preferred if it is a free single character, otherwise the first free letter
+// of name, then any free letter or digit. Returns "" when every candidate is taken.
+func (p *shortcutPicker) pick(preferred, name string) string {
+	candidates := []string{preferred}
+	for _, r := range strings.ToLower(name) + "abcdefghijklmnopqrstuvwxyz" + strings.ToUpper(name) + "ABCDEFGHIJKLMNOPQRSTUVWXYZ0123456789" {
+		candidates = append(candidates, string(r))
+	}
+	for _, key := range candidates {
+		if len(key) != 1 || !isShortcutChar(rune(key[0])) || p.taken[key] {
+			continue
+		}
+		p.taken[key] = true
+		return key
+	}
+	return ""
+}
+
+// isShortcutChar reports whether r may be picked as a shortcut: an ASCII letter or digit
+func isShortcutChar(r rune) bool {
+	return (r >= 'a' && r <= 'z') || (r >= 'A' && r <= 'Z') || (r >= '0' && r <= '9')
+}
@@ -0,0 +1,119 @@
+package config
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestImport_K9s(t *testing.T) {
+	data := []byte(`plugins:
+  stern:
+    shortCut: Ctrl-L
+    description: Logs <Stern>
+    scopes: [pods]
+    command: stern
+    args: [--tail, 50, $FILTER, -n, $NAMESPACE]
+  debug:
+    shortCut: Shift-D
+    description: Debug container
+    scopes: [containers]
+    command: kubectl
+    args: [debug, -it, -n, $NAMESPACE, $POD, --target=$NAME, --image=busybox, --context, $CONTEXT]
+    background: false
+  restart:
+    shortCut: r
+    confirm: true
+    scopes: [deployments, po]
+    command: bash
+    args: [-c, "kubectl rollout restart deploy/$NAME -n $NAMESPACE"]
+  nodes:
+    shortCut: Shift-N
+    scopes: [nodes]
+    command: kubectl
+    args: [cordon, $NAME]
+  blame:
+    shortCut: b
+    scopes: [all]
+    command: kubectl
+    args: [blame, $RESOURCE_NAME, $NAME, -n, $NAMESPACE]
+    background: true
+`)
+
+	result, err := Import(ImportK9s, data, []string{"l", "r"})
+	require.NoError(t, err)
+
+	assert.Equal(t, []Action{
+		{Name: "blame", Shortcut: "b", Command: "kubectl blame {{.kind}} {{.resource}} -n {{.namespace}}", Background: true},
+		{Name: "Debug container", Shortcut: "D", Command: "kubectl debug -it -n {{.namespace}} {{.pod}} --target={{.container}} --image=busybox --context {{.context}}"},
+		{Name: "restart", Shortcut: "e", Command: `bash -c "kubectl rollout restart deploy/{{.pod}} -n {{.namespace}}"`, Destructive: true},
+	}, result.Actions, "pods win over other scopes; taken shortcuts are replaced")
+	assert.Equal(t, []ImportSkip{
+		{Name: "nodes", Reason: "scopes nodes are not pods or a resource kind kubertino browses"},
+		{Name: "stern", Reason: "$FILTER has no kubertino equivalent"},
+	}, result.Skipped)
+}
+
+func TestImport_K9sLegacySection(t *testing.T) {
+	result, err := Import(ImportK9s, []byte("plugin:\n  top:\n    shortCut: t\n    scopes: [pods]\n    command: kubectl\n    args: [top, pod, $NAME]\n"), nil)
+	require.NoError(t, err)
+	require.Len(t, result.Actions, 1)
+	assert.Equal(t, "kubectl top pod {{.pod}}", result.Actions[0].Command)
+
+	_, err = Import(ImportK9s, []byte("plugins: [oops"), nil)
+	assert.ErrorContains(t, err, "failed to parse k9s plugin file")
+}
+
+func TestImport_Aliases(t *testing.T) {
+	data := []byte(`# kubectl aliases
+alias k=kubectl
+alias kl='k logs -f --tail=100'
+alias kex="kubectl exec -it -c app"
+alias kls='kubectl -n kube-system logs'
+
+alias kdp='kubectl describe pod'
+alias kd='kubectl describe'
+alias krm='kubectl delete po --wait=false'
+alias kgs='kubectl get svc'
+alias kga='kubectl get pods -A'
+alias kaf='kubectl apply -f'
+alias kgw='kubectl get pods | grep web'
+alias ll='ls -la'
+export PATH=$PATH:~/bin
+`)
+
+	result, err := Import(ImportAliases, data, []string{"k"})
+	require.NoError(t, err)
+
+	assert.Equal(t, []Action{
+		{Name: "kl", Shortcut: "l", Command: "kubectl logs -f --tail=100 -n {{.namespace}} {{.pod}}"},
+		{Name: "kex", Shortcut: "e", Command: "kubectl exec -it -c app -n {{.namespace}} {{.pod}} -- /bin/sh"},
+		{Name: "kls", Shortcut: "s", Command: "kubectl -n kube-system logs {{.pod}}"},
+		{Name: "kdp", Shortcut: "d", Command: "kubectl describe pod -n {{.namespace}} {{.pod}}"},
+		{Name: "kd", Shortcut: "a", Command: "kubectl describe -n {{.namespace}} pod {{.pod}}"},
+		{Name: "krm", Shortcut: "r", Command: "kubectl delete po --wait=false -n {{.namespace}} {{.pod}}", Destructive: true},
+	}, result.Actions)
+	assert.Equal(t, []ImportSkip{
+		{Name: "kgs", Reason: "acts on svc, not on a pod"},
+		{Name: "kga", Reason: "spans all namespaces"},
+		{Name: "kaf", Reason: "kubectl apply does not act on a selected pod"},
+		{Name: "kgw", Reason: "uses shell operators or variables"},
+		{Name: "ll", Reason: "not a kubectl command"},
+	}, result.Skipped)
+}
+
+func TestImport_Errors(t *testing.T) {
+	_, err := Import("fish", nil, nil)
+	assert.EqualError(t, err, `unknown import format "fish" (k9s or aliases)`)
+
+	// Every letter and digit taken
+	var taken []string
+	for _, r := range "abcdefghijklmnopqrstuvwxyzABCDEFGHIJKLMNOPQRSTUVWXYZ0123456789" {
+		taken = append(taken, string(r))
+	}
+	result, err := Import(ImportAliases, []byte("alias kl='kubectl logs'\n"), taken)
+	require.NoError(t, err)
+	assert.Empty(t, result.Actions)
+	assert.Equal(t, []ImportSkip{{Name: "kl", Reason: "no free shortcut"}}, result.Skipped)
+}
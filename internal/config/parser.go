@@ -1,6 +1,7 @@
 package config
 
 import (
+	"errors"
 	"fmt"
 	"os"
 	"path/filepath"
@@ -11,12 +12,9 @@ import (
 // Parse loads and parses a YAML configuration file
 func Parse(filename string) (*Config, error) {
 	// Expand tilde in path
-	if len(filename) >= 2 && filename[:2] == "~/" {
-		homeDir, err := os.UserHomeDir()
-		if err != nil {
-			return nil, fmt.Errorf("failed to get home directory: %w", err)
-		}
-		filename = filepath.Join(homeDir, filename[2:])
+	filename, err := expandHome(filename)
+	if err != nil {
+		return nil, err
 	}
 
 	// Read file
@@ -25,6 +23,12 @@ func Parse(filename string) (*Config, error) {
 		return nil, fmt.Errorf("failed to read config file %s: %w", filename, err)
 	}
 
+	return ParseBytes(data)
+}
+
+// ParseBytes parses YAML configuration content that did not come from a file
+// (e.g. the KUBERTINO_CONFIG_B64 environment variable or stdin)
+func ParseBytes(data []byte) (*Config, error) {
 	// Story 6.2: First parse into a generic structure to detect deprecated fields
 	var rawConfig map[string]interface{}
 	if err := yaml.Unmarshal(data, &rawConfig); err != nil {
@@ -54,6 +58,36 @@ func Parse(filename string) (*Config, error) {
 	return &config, nil
 }
 
+// expandHome expands a leading "~/" in filename to the user's home directory
+func expandHome(filename string) (string, error) {
+	if len(filename) < 2 || filename[:2] != "~/" {
+		return filename, nil
+	}
+
+	homeDir, err := os.UserHomeDir()
+	if err != nil {
+		return "", fmt.Errorf("failed to get home directory: %w", err)
+	}
+	return filepath.Join(homeDir, filename[2:]), nil
+}
+
+// Exists reports whether a file exists at filename, expanding a leading "~/"
+func Exists(filename string) (bool, error) {
+	filename, err := expandHome(filename)
+	if err != nil {
+		return false, err
+	}
+
+	_, err = os.Stat(filename)
+	if errors.Is(err, os.ErrNotExist) {
+		return false, nil
+	}
+	if err != nil {
+		return false, fmt.Errorf("failed to check config file %s: %w", filename, err)
+	}
+	return true, nil
+}
+
 // FavoritesFormat represents the format of favorites configuration
 type FavoritesFormat string
 
@@ -139,8 +173,60 @@ func GetFavorites(config *Config, contextName string) ([]string, error) {
 	}
 }
 
+// ToggleFavorite adds the namespace to the favorites of a context, or removes it when it is
+// already a favorite. Returns the new favorite state. Global favorite lists are edited in place;
+// otherwise favorites are stored per context.
+func ToggleFavorite(config *Config, contextName, namespace string) (bool, error) {
+	parsed, err := parseFavorites(config.Favorites)
+	if err != nil {
+		return false, err
+	}
+
+	if parsed.Format == FavoritesFormatGlobal && config.Favorites != nil {
+		list, added := toggleNamespace(parsed.GlobalList, namespace)
+		config.Favorites = toInterfaceList(list)
+		return added, nil
+	}
+
+	if parsed.PerContext == nil {
+		parsed.PerContext = make(map[string][]string)
+	}
+	list, added := toggleNamespace(parsed.PerContext[contextName], namespace)
+
+	perContext := make(map[string]interface{}, len(parsed.PerContext))
+	for name, namespaces := range parsed.PerContext {
+		perContext[name] = toInterfaceList(namespaces)
+	}
+	if len(list) > 0 {
+		perContext[contextName] = toInterfaceList(list)
+	} else {
+		delete(perContext, contextName)
+	}
+	config.Favorites = perContext
+	return added, nil
+}
+
+// toggleNamespace removes namespace from list if present, otherwise appends it
+func toggleNamespace(list []string, namespace string) ([]string, bool) {
+	for i, ns := range list {
+		if ns == namespace {
+			return append(list[:i:i], list[i+1:]...), false
+		}
+	}
+	return append(list, namespace), true
+}
+
+// toInterfaceList converts a string list to the representation produced by YAML decoding
+func toInterfaceList(list []string) []interface{} {
+	items := make([]interface{}, len(list))
+	for i, item := range list {
+		items[i] = item
+	}
+	return items
+}
+
 // MergeActions combines global actions with per-context actions
-// Per-context actions override global actions with the same shortcut
+// Per-context actions override global actions with the same shortcut in the same group
 func MergeActions(globalActions, contextActions []Action) []Action {
 	// Start with all global actions
 	result := make([]Action, len(globalActions))
@@ -151,7 +237,7 @@ func MergeActions(globalActions, contextActions []Action) []Action {
 		// Check if this shortcut exists in global actions
 		overrideIndex := -1
 		for i, action := range result {
-			if action.Shortcut == contextAction.Shortcut {
+			if action.SameKey(contextAction) {
 				overrideIndex = i
 				break
 			}
@@ -169,6 +255,33 @@ func MergeActions(globalActions, contextActions []Action) []Action {
 	return result
 }
 
+// Effective returns a copy of cfg with implied values spelled out: global actions are merged
+// into every context (and the global list cleared) and favorites are listed per context.
+func Effective(cfg *Config) (*Config, error) {
+	effective := *cfg
+	effective.Actions = nil
+	effective.Contexts = make([]Context, len(cfg.Contexts))
+	favorites := make(map[string]interface{}, len(cfg.Contexts))
+
+	for i, ctx := range cfg.Contexts {
+		effective.Contexts[i] = Context{Name: ctx.Name, Actions: MergeActions(cfg.Actions, ctx.Actions)}
+
+		namespaces, err := GetFavorites(cfg, ctx.Name)
+		if err != nil {
+			return nil, err
+		}
+		if len(namespaces) > 0 {
+			favorites[ctx.Name] = toInterfaceList(namespaces)
+		}
+	}
+
+	effective.Favorites = nil
+	if len(favorites) > 0 {
+		effective.Favorites = favorites
+	}
+	return &effective, nil
+}
+
 // checkDeprecatedFields detects and rejects deprecated fields from Story 6.2
 func checkDeprecatedFields(rawConfig map[string]interface{}) error {
 	deprecatedFields := map[string]string{
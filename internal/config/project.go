@@ -0,0 +1,317 @@
+package config
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"slices"
+)
+
+// ProjectConfigName is the file name of a project-local configuration. It is looked up in
+// the working directory and its parents and overlays the user configuration.
+const ProjectConfigName = ".kubertino.yml"
+
+// FindProjectConfig walks up from dir looking for a project-local configuration file.
+// The user configuration at userPath is never returned (e.g. when started from $HOME).
+// Returns "" when no project configuration exists.
+func FindProjectConfig(dir, userPath string) (string, error) {
+	dir, err := filepath.Abs(dir)
+	if err != nil {
+		return "", fmt.Errorf("failed to resolve directory %s: %w", dir, err)
+	}
+
+	userPath, err = expandHome(userPath)
+	if err != nil {
+		return "", err
+	}
+	userInfo, _ := os.Stat(userPath)
+
+	for {
+		candidate := filepath.Join(dir, ProjectConfigName)
+		if info, err := os.Stat(candidate); err == nil && !info.IsDir() {
+			if userInfo == nil || !os.SameFile(info, userInfo) {
+				return candidate, nil
+			}
+		}
+
+		parent := filepath.Dir(dir)
+		if parent == dir {
+			return "", nil
+		}
+		dir = parent
+	}
+}
+
+// ParseProject parses a project-local configuration. Relative kubeconfig paths, global or of
+// a context, are resolved against the directory of the project file so repos can ship their
+// own kubeconfig; so are relative audit file paths.
+func ParseProject(path string) (*Config, error) {
+	cfg, err := Parse(path)
+	if err != nil {
+		return nil, err
+	}
+
+	cfg.Kubeconfig = resolveProjectPath(path, cfg.Kubeconfig)
+	for i := range cfg.Contexts {
+		cfg.Contexts[i].Kubeconfig = resolveProjectPath(path, cfg.Contexts[i].Kubeconfig)
+	}
+	if cfg.Audit != nil {
+		for i, sink := range cfg.Audit.Sinks {
+			cfg.Audit.Sinks[i].Path = resolveProjectPath(path, sink.Path)
+		}
+	}
+	return cfg, nil
+}
+
+// resolveProjectPath resolves file, relative to the directory of the project file at
+// projectPath. Absolute paths, paths under ~ and "" are kept.
+func resolveProjectPath(projectPath, file string) string {
+	if file == "" || filepath.IsAbs(file) || file[0] == '~' {
+		return file
+	}
+	return filepath.Join(filepath.Dir(projectPath), file)
+}
+
+// Overlay returns a copy of base with the project configuration applied on top.
+// Precedence (highest first): project values, then user values. Scalars, favorites and pod
+// columns set in the project replace the user's; keymap entries are replaced per binding; actions are
+// merged by shortcut like per-context actions; audit sinks are added to the user's; contexts are
+// merged by name, and contexts only present in the project are appended. kubectl_path,
+// node_shell_image and allow_namespace_mutations are never taken from the project, so a
+// checked-out repository cannot choose the binary run, the image run on nodes or turn on
+// deleting namespaces.
+func Overlay(base, project *Config) *Config {
+	merged := *base
+	if project == nil {
+		return &merged
+	}
+
+	if project.Version != "" {
+		merged.Version = project.Version
+	}
+	if project.Kubeconfig != "" {
+		merged.Kubeconfig = project.Kubeconfig
+	}
+	if project.Favorites != nil {
+		merged.Favorites = project.Favorites
+	}
+	if len(project.PodColumns) > 0 {
+		merged.PodColumns = project.PodColumns
+	}
+	if len(project.CustomPodColumns) > 0 {
+		merged.CustomPodColumns = project.CustomPodColumns
+	}
+	if len(project.DestructivePatterns) > 0 {
+		merged.DestructivePatterns = project.DestructivePatterns
+	}
+	if project.MetricsInterval != "" {
+		merged.MetricsInterval = project.MetricsInterval
+	}
+	if project.CacheTTL != "" {
+		merged.CacheTTL = project.CacheTTL
+	}
+	if project.Prefetch {
+		merged.Prefetch = true
+	}
+	if project.PrefetchFavorites {
+		merged.PrefetchFavorites = true
+	}
+	if project.HealthTimeout != "" {
+		merged.HealthTimeout = project.HealthTimeout
+	}
+	if project.ShowHeader {
+		merged.ShowHeader = true
+	}
+	if project.NamespaceGrouping != "" {
+		merged.NamespaceGrouping = project.NamespaceGrouping
+	}
+	if project.NamespaceGroupDelimiter != "" {
+		merged.NamespaceGroupDelimiter = project.NamespaceGroupDelimiter
+	}
+	if project.KubectlTimeout != "" {
+		merged.KubectlTimeout = project.KubectlTimeout
+	}
+	if project.Retries > 0 {
+		merged.Retries = project.Retries
+	}
+	if project.RetryBackoff != "" {
+		merged.RetryBackoff = project.RetryBackoff
+	}
+	if project.ActionsPlacement() != "" || project.LayoutPreset() != "" {
+		layout := Layout{}
+		if merged.Layout != nil {
+			layout = *merged.Layout
+		}
+		if project.ActionsPlacement() != "" {
+			layout.Actions = project.Layout.Actions
+		}
+		if project.LayoutPreset() != "" {
+			layout.Preset = project.Layout.Preset
+		}
+		merged.Layout = &layout
+	}
+	if project.KubectlConcurrency > 0 {
+		merged.KubectlConcurrency = project.KubectlConcurrency
+	}
+	if project.KubectlQPS > 0 {
+		merged.KubectlQPS = project.KubectlQPS
+	}
+	if project.KubectlBurst > 0 {
+		merged.KubectlBurst = project.KubectlBurst
+	}
+	if project.PodPageSize > 0 {
+		merged.PodPageSize = project.PodPageSize
+	}
+	if project.Audit != nil && len(project.Audit.Sinks) > 0 {
+		// Project sinks are added: a repository cannot turn off the user's audit trail
+		audit := &Audit{}
+		if base.Audit != nil {
+			audit.Sinks = append(audit.Sinks, base.Audit.Sinks...)
+		}
+		audit.Sinks = append(audit.Sinks, project.Audit.Sinks...)
+		merged.Audit = audit
+	}
+	merged.Actions = MergeActions(base.Actions, project.Actions)
+	merged.ActionGroups = mergeActionGroups(base.ActionGroups, project.ActionGroups)
+	merged.Keymap = overlayKeymap(base.Keymap, project.Keymap)
+
+	merged.Contexts = make([]Context, 0, len(base.Contexts)+len(project.Contexts))
+	merged.Contexts = append(merged.Contexts, base.Contexts...)
+	for _, ctx := range project.Contexts {
+		found := false
+		for i := range merged.Contexts {
+			if merged.Contexts[i].Name == ctx.Name {
+				merged.Contexts[i].Actions = MergeActions(merged.Contexts[i].Actions, ctx.Actions)
+				if ctx.Kubeconfig != "" {
+					merged.Contexts[i].Kubeconfig = ctx.Kubeconfig
+				}
+				if ctx.KubectlConcurrency > 0 {
+					merged.Contexts[i].KubectlConcurrency = ctx.KubectlConcurrency
+				}
+				if ctx.KubectlQPS > 0 {
+					merged.Contexts[i].KubectlQPS = ctx.KubectlQPS
+				}
+				if ctx.KubectlBurst > 0 {
+					merged.Contexts[i].KubectlBurst = ctx.KubectlBurst
+				}
+				if ctx.PodPageSize > 0 {
+					merged.Contexts[i].PodPageSize = ctx.PodPageSize
+				}
+				if ctx.PodSelector != "" {
+					merged.Contexts[i].PodSelector = ctx.PodSelector
+				}
+				if len(ctx.Namespaces) > 0 {
+					merged.Contexts[i].Namespaces = ctx.Namespaces
+				}
+				if ctx.OnEnter != "" {
+					merged.Contexts[i].OnEnter = ctx.OnEnter
+				}
+				if ctx.OnExit != "" {
+					merged.Contexts[i].OnExit = ctx.OnExit
+				}
+				if ctx.Group != "" {
+					merged.Contexts[i].Group = ctx.Group
+				}
+				if ctx.Env != "" {
+					merged.Contexts[i].Env = ctx.Env
+				}
+				if ctx.ReadOnly {
+					merged.Contexts[i].ReadOnly = true
+				}
+				found = true
+				break
+			}
+		}
+		if !found {
+			merged.Contexts = append(merged.Contexts, ctx)
+		}
+	}
+
+	return &merged
+}
+
+// overlayKeymap replaces the bindings of base that are set in project
+func overlayKeymap(base, project *Keymap) *Keymap {
+	if project == nil {
+		return base
+	}
+	if base == nil {
+		km := *project
+		return &km
+	}
+
+	km := *base
+	overrides := []struct {
+		dst *[]string
+		src []string
+	}{
+		{&km.Quit, project.Quit},
+		{&km.Up, project.Up},
+		{&km.Down, project.Down},
+		{&km.Enter, project.Enter},
+		{&km.Tab, project.Tab},
+		{&km.ShiftTab, project.ShiftTab},
+		{&km.Search, project.Search},
+		{&km.Settings, project.Settings},
+		{&km.PortForward, project.PortForward},
+		{&km.StopForward, project.StopForward},
+		{&km.ResourceType, project.ResourceType},
+		{&km.GitOps, project.GitOps},
+		{&km.Network, project.Network},
+		{&km.Describe, project.Describe},
+		{&km.YAML, project.YAML},
+		{&km.NodeShell, project.NodeShell},
+		{&km.Shell, project.Shell},
+		{&km.Favorite, project.Favorite},
+		{&km.ActionFilter, project.ActionFilter},
+		{&km.ActionPicker, project.ActionPicker},
+		{&km.PodSort, project.PodSort},
+		{&km.PinPod, project.PinPod},
+		{&km.PodFilter, project.PodFilter},
+		{&km.CreateNamespace, project.CreateNamespace},
+		{&km.DeleteNamespace, project.DeleteNamespace},
+		{&km.LabelSelector, project.LabelSelector},
+		{&km.ClearSelector, project.ClearSelector},
+		{&km.Palette, project.Palette},
+		{&km.Help, project.Help},
+		{&km.SwitchContext, project.SwitchContext},
+		{&km.Refresh, project.Refresh},
+		{&km.CopyName, project.CopyName},
+		{&km.CopyCommand, project.CopyCommand},
+		{&km.History, project.History},
+		{&km.LastStderr, project.LastStderr},
+		{&km.Jobs, project.Jobs},
+		{&km.Reload, project.Reload},
+		{&km.NewTab, project.NewTab},
+		{&km.CloseTab, project.CloseTab},
+		{&km.SwitchTab, project.SwitchTab},
+		{&km.LayoutPreset, project.LayoutPreset},
+		{&km.ResizeLeft, project.ResizeLeft},
+		{&km.ResizeRight, project.ResizeRight},
+		{&km.ResizeUp, project.ResizeUp},
+		{&km.ResizeDown, project.ResizeDown},
+	}
+	for _, o := range overrides {
+		if len(o.src) > 0 {
+			*o.dst = o.src
+		}
+	}
+	return &km
+}
+
+// mergeActionGroups returns the groups of base with those of project replacing groups of the
+// same name, followed by the groups only the project has
+func mergeActionGroups(base, project []ActionGroup) []ActionGroup {
+	if len(project) == 0 {
+		return base
+	}
+	merged := slices.Clone(base)
+	for _, group := range project {
+		if i := slices.IndexFunc(merged, func(g ActionGroup) bool { return g.Name == group.Name }); i >= 0 {
+			merged[i] = group
+		} else {
+			merged = append(merged, group)
+		}
+	}
+	return merged
+}
@@ -0,0 +1,172 @@
+package config
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFindProjectConfig(t *testing.T) {
+	root := t.TempDir()
+	repo := filepath.Join(root, "repo")
+	nested := filepath.Join(repo, "services", "api")
+	require.NoError(t, os.MkdirAll(nested, 0755))
+	userPath := filepath.Join(root, "user.yml")
+	require.NoError(t, os.WriteFile(userPath, []byte("contexts: []\n"), 0644))
+
+	t.Run("not found", func(t *testing.T) {
+		path, err := FindProjectConfig(nested, userPath)
+		require.NoError(t, err)
+		assert.Empty(t, path)
+	})
+
+	projectPath := filepath.Join(repo, ProjectConfigName)
+	require.NoError(t, os.WriteFile(projectPath, []byte("contexts: []\n"), 0644))
+
+	t.Run("found in parent", func(t *testing.T) {
+		path, err := FindProjectConfig(nested, userPath)
+		require.NoError(t, err)
+		assert.Equal(t, projectPath, path)
+	})
+
+	t.Run("user config is skipped", func(t *testing.T) {
+		path, err := FindProjectConfig(nested, projectPath)
+		require.NoError(t, err)
+		assert.Empty(t, path)
+	})
+}
+
+func TestOverlay(t *testing.T) {
+	base := &Config{
+		Version:   "1.0",
+		Actions:   []Action{{Name: "Logs", Shortcut: "l", Command: "kubectl logs {{.pod}}"}},
+		Favorites: []interface{}{"default"},
+		Keymap:    &Keymap{Up: []string{"w"}, Down: []string{"s"}},
+		Contexts: []Context{
+			{Name: "prod", Env: EnvProd, Actions: []Action{{Name: "Shell", Shortcut: "s", Command: "sh"}}},
+			{Name: "dev"},
+		},
+	}
+	project := &Config{
+		Kubeconfig: "./kubeconfig",
+		Actions: []Action{
+			{Name: "Repo logs", Shortcut: "l", Command: "stern {{.pod}}"},
+			{Name: "Migrate", Shortcut: "m", Command: "make migrate"},
+		},
+		Keymap:     &Keymap{Down: []string{"x"}},
+		PodColumns: []string{"status", "node"},
+		CacheTTL:   "2m",
+		Retries:    3,
+		Layout:     &Layout{Actions: ActionsHidden},
+		Contexts: []Context{
+			{Name: "prod", Kubeconfig: "/repo/prod.yaml", KubectlConcurrency: 1, PodSelector: "app=shop", Group: "Shop", Actions: []Action{{Name: "Console", Shortcut: "c", Command: "rails c"}}},
+			{Name: "review-app"},
+		},
+	}
+
+	merged := Overlay(base, project)
+
+	assert.Equal(t, "1.0", merged.Version)
+	assert.Equal(t, "./kubeconfig", merged.Kubeconfig)
+	assert.Equal(t, []interface{}{"default"}, merged.Favorites, "user favorites kept when project sets none")
+	assert.Equal(t, []Action{project.Actions[0], project.Actions[1]}, merged.Actions)
+	assert.Equal(t, &Keymap{Up: []string{"w"}, Down: []string{"x"}}, merged.Keymap)
+	assert.Equal(t, []string{"status", "node"}, merged.PodColumns)
+	assert.Equal(t, "2m", merged.CacheTTL)
+	assert.Equal(t, 3, merged.Retries)
+	assert.Equal(t, ActionsHidden, merged.ActionsPlacement())
+
+	require.Len(t, merged.Contexts, 3)
+	assert.Equal(t, []string{"s", "c"}, []string{merged.Contexts[0].Actions[0].Shortcut, merged.Contexts[0].Actions[1].Shortcut})
+	assert.Equal(t, "review-app", merged.Contexts[2].Name)
+	assert.Equal(t, 1, merged.Contexts[0].KubectlConcurrency)
+	assert.Equal(t, "Shop", merged.Contexts[0].Group)
+	assert.Equal(t, "app=shop", merged.Contexts[0].PodSelector)
+	assert.Equal(t, "/repo/prod.yaml", merged.Contexts[0].Kubeconfig)
+	assert.Equal(t, EnvProd, merged.Contexts[0].Env, "env kept when the project sets none")
+
+	// The user config is left untouched
+	assert.Len(t, base.Contexts[0].Actions, 1)
+	assert.Equal(t, []string{"s"}, base.Keymap.Down)
+	assert.Equal(t, "kubectl logs {{.pod}}", base.Actions[0].Command)
+}
+
+func TestOverlay_LayoutFieldsMergeSeparately(t *testing.T) {
+	base := &Config{Layout: &Layout{Actions: ActionsRight}}
+	project := &Config{Layout: &Layout{Preset: PresetWidePods}}
+
+	merged := Overlay(base, project)
+
+	assert.Equal(t, ActionsRight, merged.ActionsPlacement(), "user placement kept")
+	assert.Equal(t, PresetWidePods, merged.LayoutPreset())
+	assert.Empty(t, base.LayoutPreset(), "user config is left untouched")
+}
+
+func TestOverlay_ActionGroupsMergeByName(t *testing.T) {
+	base := &Config{ActionGroups: []ActionGroup{{Name: "db", Shortcut: "D"}, {Name: "debug", Shortcut: "B"}}}
+	project := &Config{ActionGroups: []ActionGroup{{Name: "db", Shortcut: "P"}, {Name: "deploy", Shortcut: "O"}}}
+
+	merged := Overlay(base, project)
+
+	assert.Equal(t, []ActionGroup{{Name: "db", Shortcut: "P"}, {Name: "debug", Shortcut: "B"}, {Name: "deploy", Shortcut: "O"}}, merged.ActionGroups)
+	assert.Equal(t, "D", base.ActionGroups[0].Shortcut, "user config is left untouched")
+}
+
+func TestOverlay_KubectlPathIsNotMerged(t *testing.T) {
+	// A checked-out repository must not choose the binary Kubertino runs
+	base := &Config{KubectlPath: "/usr/local/bin/kubectl"}
+	project := &Config{KubectlPath: "./bin/kubectl"}
+
+	assert.Equal(t, "/usr/local/bin/kubectl", Overlay(base, project).KubectlPath)
+}
+
+func TestOverlay_NamespaceMutationsAreNotMerged(t *testing.T) {
+	// Deleting namespaces is turned on by the user only
+	project := &Config{AllowNamespaceMutations: true}
+
+	assert.False(t, Overlay(&Config{}, project).AllowNamespaceMutations)
+}
+
+func TestOverlay_AuditSinksAreAdded(t *testing.T) {
+	base := &Config{Audit: &Audit{Sinks: []AuditSink{{Type: AuditSinkFile, Path: "/var/log/kubertino.jsonl"}}}}
+	project := &Config{Audit: &Audit{Sinks: []AuditSink{{Type: AuditSinkHTTP, URL: "https://logs.example.com"}}}}
+
+	merged := Overlay(base, project)
+
+	require.NotNil(t, merged.Audit)
+	assert.Equal(t, []AuditSink{base.Audit.Sinks[0], project.Audit.Sinks[0]}, merged.Audit.Sinks)
+	assert.Len(t, base.Audit.Sinks, 1, "user config is left untouched")
+
+	// A project without sinks keeps the user's
+	assert.Equal(t, base.Audit, Overlay(base, &Config{}).Audit)
+}
+
+func TestParseProject_ResolvesKubeconfig(t *testing.T) {
+	dir := t.TempDir()
+	path := filepath.Join(dir, ProjectConfigName)
+	content := "kubeconfig: deploy/kubeconfig\ncontexts:\n  - name: prod\n    kubeconfig: deploy/prod.yaml\n  - name: dev\n    kubeconfig: ~/.kube/dev.yaml\n  - name: kind\n"
+	require.NoError(t, os.WriteFile(path, []byte(content), 0644))
+
+	cfg, err := ParseProject(path)
+	require.NoError(t, err)
+	assert.Equal(t, filepath.Join(dir, "deploy", "kubeconfig"), cfg.Kubeconfig)
+	assert.Equal(t, filepath.Join(dir, "deploy", "prod.yaml"), cfg.Contexts[0].Kubeconfig)
+	assert.Equal(t, "~/.kube/dev.yaml", cfg.Contexts[1].Kubeconfig)
+	assert.Empty(t, cfg.Contexts[2].Kubeconfig)
+}
+
+func TestParseProject_ResolvesAuditPaths(t *testing.T) {
+	dir := t.TempDir()
+	path := filepath.Join(dir, ProjectConfigName)
+	content := "contexts: []\naudit:\n  sinks:\n    - type: file\n      path: log/audit.jsonl\n    - type: file\n      path: ~/audit.jsonl\n"
+	require.NoError(t, os.WriteFile(path, []byte(content), 0644))
+
+	cfg, err := ParseProject(path)
+	require.NoError(t, err)
+	require.Len(t, cfg.Audit.Sinks, 2)
+	assert.Equal(t, filepath.Join(dir, "log", "audit.jsonl"), cfg.Audit.Sinks[0].Path)
+	assert.Equal(t, "~/audit.jsonl", cfg.Audit.Sinks[1].Path)
+}
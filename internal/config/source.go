@@ -0,0 +1,54 @@
+package config
+
+import (
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+)
+
+const (
+	// ConfigEnvVar holds the entire configuration as base64-encoded YAML.
+	// It takes precedence over the config file so CI containers need no mounted file.
+	ConfigEnvVar = "KUBERTINO_CONFIG_B64"
+
+	// StdinConfigPath is the config path that reads the configuration from stdin
+	StdinConfigPath = "-"
+)
+
+// ErrEmptyConfig is returned when a side-channel configuration source is empty
+var ErrEmptyConfig = errors.New("configuration is empty")
+
+// ParseBase64 decodes base64-encoded YAML configuration.
+// Whitespace and line breaks (as produced by `base64` without -w0) are ignored.
+func ParseBase64(encoded string) (*Config, error) {
+	encoded = strings.Join(strings.Fields(encoded), "")
+	if encoded == "" {
+		return nil, fmt.Errorf("%s: %w", ConfigEnvVar, ErrEmptyConfig)
+	}
+
+	data, err := base64.StdEncoding.DecodeString(encoded)
+	if err != nil {
+		// Accept unpadded input as well
+		data, err = base64.RawStdEncoding.DecodeString(encoded)
+		if err != nil {
+			return nil, fmt.Errorf("failed to decode %s: %w", ConfigEnvVar, err)
+		}
+	}
+
+	return ParseBytes(data)
+}
+
+// ParseReader reads YAML configuration from r (used for stdin)
+func ParseReader(r io.Reader) (*Config, error) {
+	data, err := io.ReadAll(r)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read config from stdin: %w", err)
+	}
+	if strings.TrimSpace(string(data)) == "" {
+		return nil, fmt.Errorf("stdin: %w", ErrEmptyConfig)
+	}
+
+	return ParseBytes(data)
+}
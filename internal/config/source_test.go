@@ -0,0 +1,67 @@
+package config
+
+import (
+	"encoding/base64"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const sourceTestConfig = `version: "1.0"
+contexts:
+  - name: ci
+    actions:
+      - name: Logs
+        shortcut: l
+        command: kubectl logs {{.pod}}
+`
+
+func TestParseBase64(t *testing.T) {
+	padded := base64.StdEncoding.EncodeToString([]byte(sourceTestConfig))
+
+	tests := []struct {
+		name        string
+		encoded     string
+		wantErr     error
+		errContains string
+	}{
+		{name: "padded", encoded: padded},
+		{name: "unpadded", encoded: base64.RawStdEncoding.EncodeToString([]byte(sourceTestConfig))},
+		{name: "wrapped lines", encoded: padded[:20] + "\n" + padded[20:] + "\n"},
+		{name: "empty", encoded: "  \n", wantErr: ErrEmptyConfig},
+		{name: "invalid base64", encoded: "not base64!", errContains: ConfigEnvVar},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg, err := ParseBase64(tt.encoded)
+			if tt.errContains != "" {
+				assert.ErrorContains(t, err, tt.errContains)
+				return
+			}
+			if tt.wantErr != nil {
+				assert.True(t, errors.Is(err, tt.wantErr), "got %v", err)
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, "ci", cfg.Contexts[0].Name)
+		})
+	}
+}
+
+func TestParseReader(t *testing.T) {
+	cfg, err := ParseReader(strings.NewReader(sourceTestConfig))
+	require.NoError(t, err)
+	assert.Equal(t, "ci", cfg.Contexts[0].Name)
+
+	_, err = ParseReader(strings.NewReader(""))
+	assert.True(t, errors.Is(err, ErrEmptyConfig))
+
+	// Side-channel content gets the same deprecated-field checks as files
+	_, err = ParseReader(strings.NewReader("contexts:\n  - name: ci\n    pod_pattern: web\n"))
+	assert.ErrorContains(t, err, "deprecated field")
+}
@@ -3,9 +3,34 @@ package config
 import (
 	"bytes"
 	"fmt"
+	"net/url"
+	"regexp"
+	"slices"
+	"strings"
 	"text/template"
+	"time"
+	"unicode/utf8"
+
+	"github.com/maratkarimov/kubertino/internal/jsonpath"
 )
 
+// labelSelectorPattern matches the characters label selectors are made of, e.g.
+// "app=web,tier!=db" or "env in (prod,staging)"
+var labelSelectorPattern = regexp.MustCompile(`^[A-Za-z0-9_.\-/=!,() ]+$`)
+
+// namespaceDelimiterPattern matches the delimiters that can occur in namespace names, which
+// are made of lowercase letters, digits and dashes
+var namespaceDelimiterPattern = regexp.MustCompile(`^[a-z0-9-]+$`)
+
+// CheckLabelSelector reports whether selector is made of the characters label selectors
+// consist of; kubectl checks the syntax itself
+func CheckLabelSelector(selector string) error {
+	if !labelSelectorPattern.MatchString(selector) {
+		return fmt.Errorf("invalid label selector %q (use e.g. app=web,tier!=db)", selector)
+	}
+	return nil
+}
+
 // Validate validates the configuration and returns an error if invalid
 func Validate(cfg *Config) error {
 	if cfg == nil {
@@ -27,23 +52,102 @@ func Validate(cfg *Config) error {
 		}
 	}
 
+	// Validate keymap overrides if present
+	if cfg.Keymap != nil {
+		if err := validateKeymap(cfg.Keymap); err != nil {
+			return fmt.Errorf("invalid keymap: %w", err)
+		}
+	}
+
+	if err := validateCustomPodColumns(cfg.CustomPodColumns); err != nil {
+		return fmt.Errorf("invalid custom_pod_columns: %w", err)
+	}
+	if err := validatePodColumns(cfg.PodColumns, cfg.CustomPodColumns); err != nil {
+		return fmt.Errorf("invalid pod_columns: %w", err)
+	}
+
+	if _, err := cfg.MetricsIntervalDuration(); err != nil {
+		return fmt.Errorf("invalid metrics_interval: %w", err)
+	}
+
+	if _, err := cfg.CacheDuration(); err != nil {
+		return fmt.Errorf("invalid cache_ttl: %w", err)
+	}
+
+	if cfg.NodeShellImage != "" && (strings.HasPrefix(cfg.NodeShellImage, "-") || strings.ContainsAny(cfg.NodeShellImage, " \t\n")) {
+		return fmt.Errorf("invalid node_shell_image: %q is not an image reference", cfg.NodeShellImage)
+	}
+	if cfg.NamespaceGrouping != "" && cfg.NamespaceGrouping != NamespaceGroupingPrefix {
+		return fmt.Errorf("invalid namespace_grouping: %q (must be %s)", cfg.NamespaceGrouping, NamespaceGroupingPrefix)
+	}
+	if cfg.NamespaceGroupDelimiter != "" && !namespaceDelimiterPattern.MatchString(cfg.NamespaceGroupDelimiter) {
+		return fmt.Errorf("invalid namespace_group_delimiter: %q never occurs in a namespace name", cfg.NamespaceGroupDelimiter)
+	}
+	if _, err := cfg.HealthTimeoutDuration(); err != nil {
+		return fmt.Errorf("invalid health_timeout: %w", err)
+	}
+
+	if _, err := cfg.KubectlTimeoutDuration(); err != nil {
+		return fmt.Errorf("invalid kubectl_timeout: %w", err)
+	}
+	if _, err := cfg.RetryBackoffDuration(); err != nil {
+		return fmt.Errorf("invalid retry_backoff: %w", err)
+	}
+	if cfg.Retries < 0 {
+		return fmt.Errorf("invalid retries: %d must not be negative", cfg.Retries)
+	}
+
+	if _, err := cfg.SlogLevel(); err != nil {
+		return fmt.Errorf("invalid log_level: %w", err)
+	}
+
+	if err := validateLayout(cfg.Layout); err != nil {
+		return fmt.Errorf("invalid layout: %w", err)
+	}
+
+	if err := validateAppearance(cfg.Appearance); err != nil {
+		return fmt.Errorf("invalid appearance: %w", err)
+	}
+
+	for i, expr := range cfg.DestructivePatterns {
+		if _, err := regexp.Compile(expr); err != nil {
+			return fmt.Errorf("invalid destructive_patterns[%d]: %w", i, err)
+		}
+	}
+
+	if err := validateAudit(cfg.Audit); err != nil {
+		return fmt.Errorf("invalid audit: %w", err)
+	}
+
+	if cfg.KubectlConcurrency < 0 {
+		return fmt.Errorf("invalid kubectl_concurrency: %d must not be negative", cfg.KubectlConcurrency)
+	}
+	if cfg.KubectlQPS < 0 {
+		return fmt.Errorf("invalid kubectl_qps: %g must not be negative", cfg.KubectlQPS)
+	}
+	if cfg.KubectlBurst < 0 {
+		return fmt.Errorf("invalid kubectl_burst: %d must not be negative", cfg.KubectlBurst)
+	}
+	if cfg.PodPageSize < 0 {
+		return fmt.Errorf("invalid pod_page_size: %d must not be negative", cfg.PodPageSize)
+	}
+
+	if err := validateActionGroups(cfg.ActionGroups); err != nil {
+		return fmt.Errorf("invalid action_groups: %w", err)
+	}
+
 	// Validate global actions
-	if len(cfg.Actions) > 0 {
-		globalShortcuts := make(map[string]string)
-		for i, action := range cfg.Actions {
-			if err := validateAction(&action, i, "global"); err != nil {
-				return err
-			}
-			if existingAction, exists := globalShortcuts[action.Shortcut]; exists {
-				return fmt.Errorf("global actions: duplicate shortcut '%s' found in actions '%s' and '%s'",
-					action.Shortcut, existingAction, action.Name)
-			}
-			globalShortcuts[action.Shortcut] = action.Name
+	for i, action := range cfg.Actions {
+		if err := validateAction(&action, i, "global"); err != nil {
+			return err
 		}
 	}
+	if err := validateActionKeys(cfg.Actions, cfg.ActionGroups); err != nil {
+		return fmt.Errorf("global actions: %w", err)
+	}
 
 	for i, ctx := range cfg.Contexts {
-		if err := validateContext(&ctx, i, cfg.Actions); err != nil {
+		if err := validateContext(&ctx, i, cfg.Actions, cfg.ActionGroups); err != nil {
 			return err
 		}
 	}
@@ -52,7 +156,7 @@ func Validate(cfg *Config) error {
 }
 
 // validateContext validates a single context
-func validateContext(ctx *Context, index int, globalActions []Action) error {
+func validateContext(ctx *Context, index int, globalActions []Action, groups []ActionGroup) error {
 	// Validate required fields
 	if ctx.Name == "" {
 		return fmt.Errorf("context[%d]: name is required", index)
@@ -60,23 +164,78 @@ func validateContext(ctx *Context, index int, globalActions []Action) error {
 
 	// Story 6.2: default_pod_pattern removed - no validation needed
 
+	if ctx.KubectlConcurrency < 0 {
+		return fmt.Errorf("context[%d] (%s): kubectl_concurrency %d must not be negative", index, ctx.Name, ctx.KubectlConcurrency)
+	}
+	if ctx.KubectlQPS < 0 {
+		return fmt.Errorf("context[%d] (%s): kubectl_qps %g must not be negative", index, ctx.Name, ctx.KubectlQPS)
+	}
+	if ctx.KubectlBurst < 0 {
+		return fmt.Errorf("context[%d] (%s): kubectl_burst %d must not be negative", index, ctx.Name, ctx.KubectlBurst)
+	}
+	if ctx.PodPageSize < 0 {
+		return fmt.Errorf("context[%d] (%s): pod_page_size %d must not be negative", index, ctx.Name, ctx.PodPageSize)
+	}
+	if ctx.PodSelector != "" {
+		if err := CheckLabelSelector(ctx.PodSelector); err != nil {
+			return fmt.Errorf("context[%d] (%s): pod_selector: %w", index, ctx.Name, err)
+		}
+	}
+	for j, namespace := range ctx.Namespaces {
+		if strings.TrimSpace(namespace) == "" {
+			return fmt.Errorf("context[%d] (%s): namespaces[%d] cannot be empty", index, ctx.Name, j)
+		}
+	}
+	if ctx.Env != "" && !slices.Contains(Envs, ctx.Env) {
+		return fmt.Errorf("context[%d] (%s): unknown env %q (use %s, %s or %s)", index, ctx.Name, ctx.Env, EnvProd, EnvStaging, EnvDev)
+	}
+	if err := validateCommandTemplate(ctx.OnEnter); err != nil {
+		return fmt.Errorf("context[%d] (%s): invalid on_enter template: %w", index, ctx.Name, err)
+	}
+	if err := validateCommandTemplate(ctx.OnExit); err != nil {
+		return fmt.Errorf("context[%d] (%s): invalid on_exit template: %w", index, ctx.Name, err)
+	}
+
 	// Validate per-context actions
-	shortcuts := make(map[string]string)
 	for j, action := range ctx.Actions {
 		if err := validateAction(&action, j, ctx.Name); err != nil {
 			return err
 		}
-		// Check for duplicate shortcuts within per-context actions
-		if existingAction, exists := shortcuts[action.Shortcut]; exists {
-			return fmt.Errorf("context[%d] (%s): duplicate shortcut '%s' found in actions '%s' and '%s'",
-				index, ctx.Name, action.Shortcut, existingAction, action.Name)
+	}
+	if err := validateActionKeys(ctx.Actions, groups); err != nil {
+		return fmt.Errorf("context[%d] (%s): %w", index, ctx.Name, err)
+	}
+
+	// Follow-ups and variables may refer to global and per-context actions
+	actions := MergeActions(globalActions, ctx.Actions)
+	captured := make(map[string]bool)
+	for _, action := range actions {
+		if action.CaptureVar != "" {
+			captured[action.CaptureVar] = true
+		}
+	}
+	for _, action := range actions {
+		for _, followUp := range followUps(action) {
+			if _, ok := FindAction(actions, followUp.ref); !ok {
+				return fmt.Errorf("context[%d] (%s): action '%s': %s refers to unknown action '%s'", index, ctx.Name, action.Name, followUp.key, followUp.ref)
+			}
+		}
+		for _, name := range VarRefs(action.Command) {
+			if !captured[name] {
+				return fmt.Errorf("context[%d] (%s): action '%s': {{.vars.%s}} is not set by any action (declare capture_var: %s on the action producing it)", index, ctx.Name, action.Name, name, name)
+			}
 		}
-		shortcuts[action.Shortcut] = action.Name
 	}
 
 	return nil
 }
 
+// ValidateAction validates an action that does not come from the config file, e.g. one
+// provided by a plugin. source names where it came from in errors.
+func ValidateAction(action Action, index int, source string) error {
+	return validateAction(&action, index, source)
+}
+
 // validateAction validates a single action
 func validateAction(action *Action, index int, contextName string) error {
 	// Validate required fields
@@ -103,25 +262,144 @@ func validateAction(action *Action, index int, contextName string) error {
 			contextName, index, action.Name, err)
 	}
 
-	// Story 6.2: pod_pattern removed - no validation needed
+	for i, tag := range action.Tags {
+		if strings.TrimSpace(tag) == "" {
+			return fmt.Errorf("context (%s), action[%d] (%s): tag[%d] cannot be empty", contextName, index, action.Name, i)
+		}
+	}
+
+	switch action.Scope {
+	case "", ActionScopePod, ActionScopeNamespace, ActionScopeContext:
+	default:
+		return fmt.Errorf("context (%s), action[%d] (%s): unknown scope %q (use %s, %s or %s)", contextName, index, action.Name, action.Scope, ActionScopePod, ActionScopeNamespace, ActionScopeContext)
+	}
+	if action.AppliesTo != "" && action.TargetScope() != ActionScopePod {
+		return fmt.Errorf("context (%s), action[%d] (%s): applies_to only applies to actions with scope %s", contextName, index, action.Name, ActionScopePod)
+	}
+
+	if action.AppliesTo != "" {
+		if _, err := regexp.Compile(action.AppliesTo); err != nil {
+			return fmt.Errorf("context (%s), action[%d] (%s): invalid applies_to pattern: %w", contextName, index, action.Name, err)
+		}
+	}
+
+	names := make(map[string]bool, len(action.Params))
+	for i, param := range action.Params {
+		if !paramNamePattern.MatchString(param.Name) {
+			return fmt.Errorf("context (%s), action[%d] (%s): param[%d] name %q must be letters, digits and underscores, not starting with a digit", contextName, index, action.Name, i, param.Name)
+		}
+		if names[param.Name] {
+			return fmt.Errorf("context (%s), action[%d] (%s): duplicate param %q", contextName, index, action.Name, param.Name)
+		}
+		names[param.Name] = true
+		if param.Pattern != "" {
+			if _, err := regexp.Compile(param.Pattern); err != nil {
+				return fmt.Errorf("context (%s), action[%d] (%s): param %s: invalid pattern: %w", contextName, index, action.Name, param.Name, err)
+			}
+		}
+		if param.Default != "" {
+			if err := param.Check(param.Default); err != nil {
+				return fmt.Errorf("context (%s), action[%d] (%s): param %s: default %q is not accepted: %w", contextName, index, action.Name, param.Name, param.Default, err)
+			}
+		}
+	}
+
+	for _, followUp := range followUps(*action) {
+		if followUp.ref == action.Name || followUp.ref == action.Shortcut {
+			return fmt.Errorf("context (%s), action[%d] (%s): %s cannot refer to the action itself", contextName, index, action.Name, followUp.key)
+		}
+	}
+
+	if action.FollowUp != "" && action.FollowUp != FollowUpAsk && action.FollowUp != FollowUpRun {
+		return fmt.Errorf("context (%s), action[%d] (%s): unknown follow_up %q (use %s or %s)", contextName, index, action.Name, action.FollowUp, FollowUpAsk, FollowUpRun)
+	}
+
+	if action.CaptureVar != "" {
+		if !paramNamePattern.MatchString(action.CaptureVar) {
+			return fmt.Errorf("context (%s), action[%d] (%s): capture_var %q must be letters, digits and underscores, not starting with a digit", contextName, index, action.Name, action.CaptureVar)
+		}
+		if action.Background || action.Output != "" {
+			return fmt.Errorf("context (%s), action[%d] (%s): capture_var only applies to actions run in the terminal, not with background or output", contextName, index, action.Name)
+		}
+	}
+
+	if action.Output != "" && action.Output != ActionOutputCapture {
+		return fmt.Errorf("context (%s), action[%d] (%s): unknown output %q (use %s or leave it unset)", contextName, index, action.Name, action.Output, ActionOutputCapture)
+	}
+	if action.Background && (action.Output != "" || action.WaitOnExit || action.OnSuccess != "" || action.OnFailure != "") {
+		return fmt.Errorf("context (%s), action[%d] (%s): background actions cannot set output, wait_on_exit, on_success or on_failure", contextName, index, action.Name)
+	}
 
 	return nil
 }
 
+// followUpRef is a follow-up declared by an action, with the key declaring it
+type followUpRef struct {
+	key string
+	ref string
+}
+
+// followUps returns the on_success and on_failure follow-ups declared by action
+func followUps(action Action) []followUpRef {
+	var refs []followUpRef
+	if action.OnSuccess != "" {
+		refs = append(refs, followUpRef{key: "on_success", ref: action.OnSuccess})
+	}
+	if action.OnFailure != "" {
+		refs = append(refs, followUpRef{key: "on_failure", ref: action.OnFailure})
+	}
+	return refs
+}
+
+// templateFuncStubs stand in for the helper functions the executor provides to commands
+// ({{freePort}}, {{timestamp}}), so that commands using them validate
+var templateFuncStubs = template.FuncMap{
+	"freePort":  func() int { return 0 },
+	"timestamp": func(...string) string { return "" },
+}
+
+// paramNamePattern matches param and variable names usable as {{.params.<name>}} and
+// {{.vars.<name>}}
+var paramNamePattern = regexp.MustCompile(`^[A-Za-z_][A-Za-z0-9_]*$`)
+
+// varRefPattern finds {{.vars.<name>}} references
+var varRefPattern = regexp.MustCompile(`\.vars\.([A-Za-z_][A-Za-z0-9_]*)`)
+
+// VarRefs returns the names of the session variables command refers to as {{.vars.<name>}},
+// in order of first use
+func VarRefs(command string) []string {
+	var names []string
+	for _, match := range varRefPattern.FindAllStringSubmatch(command, -1) {
+		if !slices.Contains(names, match[1]) {
+			names = append(names, match[1])
+		}
+	}
+	return names
+}
+
 // validateCommandTemplate validates the Go template syntax in a command string
 func validateCommandTemplate(command string) error {
 	// Create a template with dummy data to validate syntax
-	tmpl, err := template.New("command").Parse(command)
+	tmpl, err := template.New("command").Funcs(templateFuncStubs).Parse(command)
 	if err != nil {
 		return fmt.Errorf("template parsing failed: %w", err)
 	}
 
 	// Try to execute the template with dummy variables to catch undefined function errors
 	// This validates that {{variable}} syntax works correctly
-	data := map[string]string{
-		"context":   "test-context",
-		"namespace": "test-namespace",
-		"pod":       "test-pod",
+	data := map[string]any{
+		"context":    "test-context",
+		"namespace":  "test-namespace",
+		"pod":        "test-pod",
+		"resource":   "test-pod",
+		"kind":       "pod",
+		"status":     "Running",
+		"container":  "test-container",
+		"kubeconfig": "/test/kubeconfig",
+		"node":       "test-node",
+		"labels":     map[string]string{},
+		"params":     map[string]string{},
+		"vars":       map[string]string{},
 	}
 
 	var buf bytes.Buffer
@@ -143,3 +421,303 @@ func validateFavorites(favorites interface{}) error {
 	_, err := parseFavorites(favorites)
 	return err
 }
+
+// validateLayout ensures layout only uses known placements and presets
+func validateLayout(layout *Layout) error {
+	if layout == nil {
+		return nil
+	}
+	switch layout.Actions {
+	case "", ActionsBottom, ActionsRight, ActionsHidden:
+	default:
+		return fmt.Errorf("unknown actions placement %q (use %s, %s or %s)", layout.Actions, ActionsBottom, ActionsRight, ActionsHidden)
+	}
+	if layout.Preset != "" && !slices.Contains(LayoutPresets, layout.Preset) {
+		return fmt.Errorf("unknown preset %q (use %s)", layout.Preset, strings.Join(LayoutPresets, ", "))
+	}
+	return nil
+}
+
+// maxMarkerWidth bounds appearance markers so they do not eat into the list columns
+const maxMarkerWidth = 4
+
+// validateAppearance ensures markers are short single-line strings and the selection
+// rendering is known
+func validateAppearance(appearance *Appearance) error {
+	if appearance == nil {
+		return nil
+	}
+	for _, marker := range []struct{ name, value string }{{"cursor", appearance.Cursor}, {"favorite", appearance.Favorite}} {
+		if strings.ContainsAny(marker.value, "\n\t") {
+			return fmt.Errorf("%s marker %q must be a single line", marker.name, marker.value)
+		}
+		if utf8.RuneCountInString(marker.value) > maxMarkerWidth {
+			return fmt.Errorf("%s marker %q is longer than %d characters", marker.name, marker.value, maxMarkerWidth)
+		}
+	}
+	switch appearance.Selection {
+	case "", SelectionBackground, SelectionBold, SelectionUnderline, SelectionNone:
+	default:
+		return fmt.Errorf("unknown selection %q (use %s, %s, %s or %s)", appearance.Selection, SelectionBackground, SelectionBold, SelectionUnderline, SelectionNone)
+	}
+	return validateSpinner(appearance)
+}
+
+// maxSpinnerInterval bounds appearance.spinner_interval: slower spinners look stuck
+const maxSpinnerInterval = 2 * time.Second
+
+// validateSpinner ensures the spinner is known, custom frames are single-line and of one
+// width, so the text after them does not jitter, and loading messages name known operations
+func validateSpinner(appearance *Appearance) error {
+	switch appearance.Spinner {
+	case "", SpinnerDots, SpinnerLine:
+		if len(appearance.SpinnerFrames) > 0 {
+			return fmt.Errorf("spinner_frames needs spinner: %s", SpinnerCustom)
+		}
+	case SpinnerCustom:
+		if len(appearance.SpinnerFrames) == 0 {
+			return fmt.Errorf("spinner %s needs spinner_frames", SpinnerCustom)
+		}
+		width := utf8.RuneCountInString(appearance.SpinnerFrames[0])
+		for i, frame := range appearance.SpinnerFrames {
+			if frame == "" || strings.ContainsAny(frame, "\n\t") {
+				return fmt.Errorf("spinner_frames[%d] %q must be a non-empty single line", i, frame)
+			}
+			if utf8.RuneCountInString(frame) != width || width > maxMarkerWidth {
+				return fmt.Errorf("spinner_frames[%d] %q: frames must all be as wide, at most %d characters", i, frame, maxMarkerWidth)
+			}
+		}
+	default:
+		return fmt.Errorf("unknown spinner %q (use %s, %s or %s)", appearance.Spinner, SpinnerDots, SpinnerLine, SpinnerCustom)
+	}
+
+	interval, err := parseDuration(appearance.SpinnerInterval, DefaultSpinnerInterval)
+	if err != nil {
+		return fmt.Errorf("invalid spinner_interval: %w", err)
+	}
+	if interval <= 0 || interval > maxSpinnerInterval {
+		return fmt.Errorf("invalid spinner_interval: %s must be above 0 and at most %s", appearance.SpinnerInterval, maxSpinnerInterval)
+	}
+
+	for operation, message := range appearance.LoadingMessages {
+		switch operation {
+		case LoadingNamespaces, LoadingPods, LoadingResources:
+		default:
+			return fmt.Errorf("unknown loading_messages operation %q (use %s, %s or %s)", operation, LoadingNamespaces, LoadingPods, LoadingResources)
+		}
+		if strings.ContainsAny(message, "\n\t") {
+			return fmt.Errorf("loading_messages.%s %q must be a single line", operation, message)
+		}
+	}
+	return nil
+}
+
+// validateAudit ensures every audit sink has a known type and the settings it needs
+func validateAudit(audit *Audit) error {
+	if audit == nil {
+		return nil
+	}
+	for i, sink := range audit.Sinks {
+		switch sink.Type {
+		case AuditSinkFile:
+			if sink.Path == "" {
+				return fmt.Errorf("sink %d: file sink requires path", i)
+			}
+		case AuditSinkSyslog:
+			if sink.Address != "" {
+				u, err := url.Parse(sink.Address)
+				if err != nil || (u.Scheme != "udp" && u.Scheme != "tcp") || u.Host == "" {
+					return fmt.Errorf("sink %d: syslog address %q must look like udp://host:514 or tcp://host:514", i, sink.Address)
+				}
+			}
+		case AuditSinkHTTP:
+			u, err := url.Parse(sink.URL)
+			if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+				return fmt.Errorf("sink %d: http sink requires an http(s) url, got %q", i, sink.URL)
+			}
+		case AuditSinkShell:
+		default:
+			return fmt.Errorf("sink %d: unknown type %q (use %s, %s, %s or %s)", i, sink.Type, AuditSinkFile, AuditSinkSyslog, AuditSinkHTTP, AuditSinkShell)
+		}
+		if sink.ShellHistory && sink.Type != AuditSinkShell {
+			return fmt.Errorf("sink %d: shell_history only applies to %s sinks", i, AuditSinkShell)
+		}
+	}
+	return nil
+}
+
+// validatePodColumns ensures pod_columns only lists known columns, built-in or custom, each
+// at most once
+func validatePodColumns(columns []string, custom []CustomPodColumn) error {
+	seen := make(map[string]bool)
+	for _, column := range columns {
+		switch {
+		case isBuiltinPodColumn(column):
+		case slices.ContainsFunc(custom, func(c CustomPodColumn) bool { return c.Name == column }):
+		default:
+			return fmt.Errorf("unknown column '%s' (valid: status, ready, restarts, age, node, cpu, memory, or a custom_pod_columns name)", column)
+		}
+		if seen[column] {
+			return fmt.Errorf("column '%s' listed twice", column)
+		}
+		seen[column] = true
+	}
+	return nil
+}
+
+// isBuiltinPodColumn reports whether name is a pod column that needs no custom_pod_columns entry
+func isBuiltinPodColumn(name string) bool {
+	switch name {
+	case PodColumnStatus, PodColumnReady, PodColumnRestarts, PodColumnAge, PodColumnNode, PodColumnCPU, PodColumnMemory:
+		return true
+	}
+	return false
+}
+
+// validateCustomPodColumns ensures each custom pod column has a unique name that is not one
+// of the built-in columns, and a JSONPath expression that parses
+func validateCustomPodColumns(columns []CustomPodColumn) error {
+	seen := make(map[string]bool)
+	for i, column := range columns {
+		switch {
+		case column.Name == "":
+			return fmt.Errorf("column %d: name is required", i)
+		case strings.ContainsAny(column.Name, " \t"):
+			return fmt.Errorf("column '%s': name cannot contain spaces", column.Name)
+		case isBuiltinPodColumn(strings.ToLower(column.Name)):
+			return fmt.Errorf("column '%s': name is taken by a built-in column", column.Name)
+		case seen[column.Name]:
+			return fmt.Errorf("column '%s' defined twice", column.Name)
+		case column.JSONPath == "":
+			return fmt.Errorf("column '%s': jsonpath is required", column.Name)
+		}
+		if _, err := jsonpath.Parse(column.JSONPath); err != nil {
+			return fmt.Errorf("column '%s': invalid jsonpath: %w", column.Name, err)
+		}
+		seen[column.Name] = true
+	}
+	return nil
+}
+
+// validateActionGroups ensures each action group has a unique name and a unique single
+// character shortcut
+func validateActionGroups(groups []ActionGroup) error {
+	names := make(map[string]bool)
+	shortcuts := make(map[string]string)
+	for i, group := range groups {
+		switch {
+		case group.Name == "":
+			return fmt.Errorf("group %d: name is required", i)
+		case names[group.Name]:
+			return fmt.Errorf("group '%s' defined twice", group.Name)
+		case len(group.Shortcut) != 1:
+			return fmt.Errorf("group '%s': shortcut must be single character, got '%s'", group.Name, group.Shortcut)
+		}
+		if existing, exists := shortcuts[group.Shortcut]; exists {
+			return fmt.Errorf("duplicate shortcut '%s' found in groups '%s' and '%s'", group.Shortcut, existing, group.Name)
+		}
+		names[group.Name] = true
+		shortcuts[group.Shortcut] = group.Name
+	}
+	return nil
+}
+
+// validateActionKeys ensures every action is run by its own keys: shortcuts are unique within
+// a group (and among actions without one), groups exist, and no action without a group has
+// the shortcut of a group, which would open the group instead
+func validateActionKeys(actions []Action, groups []ActionGroup) error {
+	type key struct{ group, shortcut string }
+	seen := make(map[key]string)
+	for _, action := range actions {
+		if action.Group != "" {
+			if _, ok := FindActionGroup(groups, action.Group); !ok {
+				return fmt.Errorf("action '%s' is in unknown group '%s'", action.Name, action.Group)
+			}
+		}
+		k := key{action.Group, action.Shortcut}
+		if existingAction, exists := seen[k]; exists {
+			if action.Group != "" {
+				return fmt.Errorf("duplicate shortcut '%s' in group '%s' found in actions '%s' and '%s'",
+					action.Shortcut, action.Group, existingAction, action.Name)
+			}
+			return fmt.Errorf("duplicate shortcut '%s' found in actions '%s' and '%s'",
+				action.Shortcut, existingAction, action.Name)
+		}
+		seen[k] = action.Name
+	}
+	for _, group := range groups {
+		if name, exists := seen[key{"", group.Shortcut}]; exists {
+			return fmt.Errorf("action '%s' has the shortcut '%s' of group '%s'", name, group.Shortcut, group.Name)
+		}
+	}
+	return nil
+}
+
+// validateKeymap ensures no key is bound to more than one navigation binding
+func validateKeymap(km *Keymap) error {
+	bindings := []struct {
+		name string
+		keys []string
+	}{
+		{"quit", km.Quit},
+		{"up", km.Up},
+		{"down", km.Down},
+		{"enter", km.Enter},
+		{"tab", km.Tab},
+		{"shift_tab", km.ShiftTab},
+		{"search", km.Search},
+		{"settings", km.Settings},
+		{"port_forward", km.PortForward},
+		{"stop_forward", km.StopForward},
+		{"resource_type", km.ResourceType},
+		{"gitops", km.GitOps},
+		{"network", km.Network},
+		{"describe", km.Describe},
+		{"yaml", km.YAML},
+		{"node_shell", km.NodeShell},
+		{"shell", km.Shell},
+		{"favorite", km.Favorite},
+		{"action_filter", km.ActionFilter},
+		{"action_picker", km.ActionPicker},
+		{"pod_sort", km.PodSort},
+		{"pin_pod", km.PinPod},
+		{"pod_filter", km.PodFilter},
+		{"create_namespace", km.CreateNamespace},
+		{"delete_namespace", km.DeleteNamespace},
+		{"label_selector", km.LabelSelector},
+		{"clear_selector", km.ClearSelector},
+		{"palette", km.Palette},
+		{"help", km.Help},
+		{"switch_context", km.SwitchContext},
+		{"refresh", km.Refresh},
+		{"copy_name", km.CopyName},
+		{"copy_command", km.CopyCommand},
+		{"history", km.History},
+		{"last_stderr", km.LastStderr},
+		{"jobs", km.Jobs},
+		{"reload", km.Reload},
+		{"new_tab", km.NewTab},
+		{"close_tab", km.CloseTab},
+		{"switch_tab", km.SwitchTab},
+		{"layout_preset", km.LayoutPreset},
+		{"resize_left", km.ResizeLeft},
+		{"resize_right", km.ResizeRight},
+		{"resize_up", km.ResizeUp},
+		{"resize_down", km.ResizeDown},
+	}
+
+	owners := make(map[string]string)
+	for _, b := range bindings {
+		for _, key := range b.keys {
+			if key == "" {
+				return fmt.Errorf("%s: key cannot be empty", b.name)
+			}
+			if owner, exists := owners[key]; exists {
+				return fmt.Errorf("key '%s' is bound to both '%s' and '%s'", key, owner, b.name)
+			}
+			owners[key] = b.name
+		}
+	}
+
+	return nil
+}
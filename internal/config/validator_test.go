@@ -54,6 +54,21 @@ func TestValidate(t *testing.T) {
 			wantErr:     true,
 			errContains: "version is required",
 		},
+		{
+			name: "keymap with duplicate key",
+			config: &Config{
+				Version: "1.0",
+				Keymap: &Keymap{
+					Up:   []string{"k"},
+					Down: []string{"k"},
+				},
+				Contexts: []Context{
+					{Name: "test"},
+				},
+			},
+			wantErr:     true,
+			errContains: "key 'k' is bound to both 'up' and 'down'",
+		},
 		{
 			name:        "nil config",
 			config:      nil,
@@ -186,6 +201,780 @@ func TestValidate(t *testing.T) {
 			wantErr:     true,
 			errContains: "command is required",
 		},
+		{
+			name: "empty action tag",
+			config: &Config{
+				Version: "1.0",
+				Contexts: []Context{
+					{
+						Name: "test",
+						Actions: []Action{
+							{Name: "console", Shortcut: "c", Command: "/bin/sh", Tags: []string{"db", " "}},
+						},
+					},
+				},
+			},
+			wantErr:     true,
+			errContains: "tag[1] cannot be empty",
+		},
+		{
+			name: "template helper functions",
+			config: &Config{
+				Version: "1.0",
+				Contexts: []Context{{
+					Name:    "test",
+					Actions: []Action{{Name: "Forward", Shortcut: "f", Command: `kubectl port-forward {{.pod}} {{freePort}}:80 > pf-{{timestamp "0102"}}.log`}},
+				}},
+			},
+			wantErr: false,
+		},
+		{
+			name: "valid pod columns",
+			config: &Config{
+				Version:    "1.0",
+				PodColumns: []string{"node", "status", "age", "cpu", "memory"},
+				Contexts:   []Context{{Name: "test"}},
+			},
+			wantErr: false,
+		},
+		{
+			name: "unknown pod column",
+			config: &Config{
+				Version:    "1.0",
+				PodColumns: []string{"status", "gpu"},
+				Contexts:   []Context{{Name: "test"}},
+			},
+			wantErr:     true,
+			errContains: "unknown column 'gpu'",
+		},
+		{
+			name: "duplicate pod column",
+			config: &Config{
+				Version:    "1.0",
+				PodColumns: []string{"age", "age"},
+				Contexts:   []Context{{Name: "test"}},
+			},
+			wantErr:     true,
+			errContains: "listed twice",
+		},
+		{
+			name: "custom pod columns",
+			config: &Config{
+				Version:          "1.0",
+				PodColumns:       []string{"status", "image"},
+				CustomPodColumns: []CustomPodColumn{{Name: "image", JSONPath: ".spec.containers[0].image"}, {Name: "qos", JSONPath: "{.status.qosClass}"}},
+				Contexts:         []Context{{Name: "test"}},
+			},
+			wantErr: false,
+		},
+		{
+			name: "grouped actions reuse shortcuts of other groups and ungrouped actions",
+			config: &Config{
+				Version:      "1.0",
+				ActionGroups: []ActionGroup{{Name: "db", Shortcut: "D"}, {Name: "debug", Shortcut: "B"}},
+				Actions: []Action{
+					{Name: "logs", Shortcut: "l", Command: "kubectl logs {{.pod}}"},
+					{Name: "db-logs", Shortcut: "l", Group: "db", Command: "kubectl logs {{.pod}} -c postgres"},
+					{Name: "debug-logs", Shortcut: "l", Group: "debug", Command: "kubectl logs {{.pod}} --previous"},
+				},
+				Contexts: []Context{{Name: "test", Actions: []Action{
+					{Name: "psql", Shortcut: "p", Group: "db", Command: "kubectl exec -it {{.pod}} -- psql"},
+				}}},
+			},
+			wantErr: false,
+		},
+		{
+			name: "duplicate shortcut in a group",
+			config: &Config{
+				Version:      "1.0",
+				ActionGroups: []ActionGroup{{Name: "db", Shortcut: "D"}},
+				Contexts: []Context{{Name: "test", Actions: []Action{
+					{Name: "psql", Shortcut: "p", Group: "db", Command: "psql"},
+					{Name: "pg_dump", Shortcut: "p", Group: "db", Command: "pg_dump"},
+				}}},
+			},
+			wantErr:     true,
+			errContains: "duplicate shortcut 'p' in group 'db' found in actions 'psql' and 'pg_dump'",
+		},
+		{
+			name: "action in unknown group",
+			config: &Config{
+				Version:  "1.0",
+				Actions:  []Action{{Name: "psql", Shortcut: "p", Group: "db", Command: "psql"}},
+				Contexts: []Context{{Name: "test"}},
+			},
+			wantErr:     true,
+			errContains: "global actions: action 'psql' is in unknown group 'db'",
+		},
+		{
+			name: "action with the shortcut of a group",
+			config: &Config{
+				Version:      "1.0",
+				ActionGroups: []ActionGroup{{Name: "db", Shortcut: "D"}},
+				Contexts: []Context{{Name: "test", Actions: []Action{
+					{Name: "describe", Shortcut: "D", Command: "kubectl describe pod {{.pod}}"},
+				}}},
+			},
+			wantErr:     true,
+			errContains: "context[0] (test): action 'describe' has the shortcut 'D' of group 'db'",
+		},
+		{
+			name: "action groups with the same shortcut",
+			config: &Config{
+				Version:      "1.0",
+				ActionGroups: []ActionGroup{{Name: "db", Shortcut: "D"}, {Name: "deploy", Shortcut: "D"}},
+				Contexts:     []Context{{Name: "test"}},
+			},
+			wantErr:     true,
+			errContains: "invalid action_groups: duplicate shortcut 'D' found in groups 'db' and 'deploy'",
+		},
+		{
+			name: "action group without shortcut",
+			config: &Config{
+				Version:      "1.0",
+				ActionGroups: []ActionGroup{{Name: "db"}},
+				Contexts:     []Context{{Name: "test"}},
+			},
+			wantErr:     true,
+			errContains: "group 'db': shortcut must be single character",
+		},
+		{
+			name: "node shell image that is a flag",
+			config: &Config{
+				Version:        "1.0",
+				NodeShellImage: "--privileged",
+				Contexts:       []Context{{Name: "test"}},
+			},
+			wantErr:     true,
+			errContains: "invalid node_shell_image",
+		},
+		{
+			name: "unknown namespace grouping",
+			config: &Config{
+				Version:           "1.0",
+				NamespaceGrouping: "label",
+				Contexts:          []Context{{Name: "test"}},
+			},
+			wantErr:     true,
+			errContains: "invalid namespace_grouping",
+		},
+		{
+			name: "namespace group delimiter not in namespace names",
+			config: &Config{
+				Version:                 "1.0",
+				NamespaceGrouping:       "prefix",
+				NamespaceGroupDelimiter: "_",
+				Contexts:                []Context{{Name: "test"}},
+			},
+			wantErr:     true,
+			errContains: "invalid namespace_group_delimiter",
+		},
+		{
+			name: "custom pod column with invalid jsonpath",
+			config: &Config{
+				Version:          "1.0",
+				CustomPodColumns: []CustomPodColumn{{Name: "image", JSONPath: ".spec.containers[0"}},
+				Contexts:         []Context{{Name: "test"}},
+			},
+			wantErr:     true,
+			errContains: "column 'image': invalid jsonpath",
+		},
+		{
+			name: "custom pod column named like a built-in one",
+			config: &Config{
+				Version:          "1.0",
+				CustomPodColumns: []CustomPodColumn{{Name: "Node", JSONPath: ".spec.nodeName"}},
+				Contexts:         []Context{{Name: "test"}},
+			},
+			wantErr:     true,
+			errContains: "taken by a built-in column",
+		},
+		{
+			name: "invalid metrics interval",
+			config: &Config{
+				Version:         "1.0",
+				MetricsInterval: "0s",
+				Contexts:        []Context{{Name: "test"}},
+			},
+			wantErr:     true,
+			errContains: "invalid metrics_interval",
+		},
+		{
+			name: "valid cache ttl",
+			config: &Config{
+				Version:  "1.0",
+				CacheTTL: "1m30s",
+				Contexts: []Context{{Name: "test"}},
+			},
+			wantErr: false,
+		},
+		{
+			name: "invalid cache ttl",
+			config: &Config{
+				Version:  "1.0",
+				CacheTTL: "soon",
+				Contexts: []Context{{Name: "test"}},
+			},
+			wantErr:     true,
+			errContains: "invalid cache_ttl",
+		},
+		{
+			name: "invalid health timeout",
+			config: &Config{
+				Version:       "1.0",
+				HealthTimeout: "5",
+				Contexts:      []Context{{Name: "test"}},
+			},
+			wantErr:     true,
+			errContains: "invalid health_timeout",
+		},
+		{
+			name: "negative cache ttl",
+			config: &Config{
+				Version:  "1.0",
+				CacheTTL: "-5s",
+				Contexts: []Context{{Name: "test"}},
+			},
+			wantErr:     true,
+			errContains: "must not be negative",
+		},
+		{
+			name: "negative kubectl concurrency",
+			config: &Config{
+				Version:            "1.0",
+				KubectlConcurrency: -1,
+				Contexts:           []Context{{Name: "test"}},
+			},
+			wantErr:     true,
+			errContains: "invalid kubectl_concurrency",
+		},
+		{
+			name: "negative per-context kubectl concurrency",
+			config: &Config{
+				Version:  "1.0",
+				Contexts: []Context{{Name: "test", KubectlConcurrency: -2}},
+			},
+			wantErr:     true,
+			errContains: "kubectl_concurrency -2 must not be negative",
+		},
+		{
+			name: "invalid applies_to pattern",
+			config: &Config{
+				Version:  "1.0",
+				Actions:  []Action{{Name: "Console", Shortcut: "c", Command: "rails c", AppliesTo: "web-(["}},
+				Contexts: []Context{{Name: "test"}},
+			},
+			wantErr:     true,
+			errContains: "invalid applies_to pattern",
+		},
+		{
+			name: "unknown scope",
+			config: &Config{
+				Version:  "1.0",
+				Actions:  []Action{{Name: "Get All", Shortcut: "g", Command: "kubectl get all", Scope: "cluster"}},
+				Contexts: []Context{{Name: "test"}},
+			},
+			wantErr:     true,
+			errContains: `unknown scope "cluster"`,
+		},
+		{
+			name: "applies_to on a namespace scoped action",
+			config: &Config{
+				Version:  "1.0",
+				Actions:  []Action{{Name: "Get All", Shortcut: "g", Command: "kubectl get all", Scope: ActionScopeNamespace, AppliesTo: "^web-"}},
+				Contexts: []Context{{Name: "test"}},
+			},
+			wantErr:     true,
+			errContains: "applies_to only applies to actions with scope pod",
+		},
+		{
+			name: "invalid param name",
+			config: &Config{
+				Version:  "1.0",
+				Actions:  []Action{{Name: "Tail", Shortcut: "t", Command: "tail", Params: []ActionParam{{Name: "2lines"}}}},
+				Contexts: []Context{{Name: "test"}},
+			},
+			wantErr:     true,
+			errContains: "param[0] name \"2lines\" must be letters",
+		},
+		{
+			name: "duplicate param",
+			config: &Config{
+				Version:  "1.0",
+				Actions:  []Action{{Name: "Tail", Shortcut: "t", Command: "tail", Params: []ActionParam{{Name: "lines"}, {Name: "lines"}}}},
+				Contexts: []Context{{Name: "test"}},
+			},
+			wantErr:     true,
+			errContains: "duplicate param \"lines\"",
+		},
+		{
+			name: "invalid param pattern",
+			config: &Config{
+				Version:  "1.0",
+				Actions:  []Action{{Name: "Tail", Shortcut: "t", Command: "tail", Params: []ActionParam{{Name: "lines", Pattern: "[0-9"}}}},
+				Contexts: []Context{{Name: "test"}},
+			},
+			wantErr:     true,
+			errContains: "param lines: invalid pattern",
+		},
+		{
+			name: "param default refused by its pattern",
+			config: &Config{
+				Version:  "1.0",
+				Actions:  []Action{{Name: "Tail", Shortcut: "t", Command: "tail", Params: []ActionParam{{Name: "lines", Default: "all", Pattern: "^[0-9]+$"}}}},
+				Contexts: []Context{{Name: "test"}},
+			},
+			wantErr:     true,
+			errContains: "param lines: default \"all\" is not accepted",
+		},
+		{
+			name: "action params",
+			config: &Config{
+				Version: "1.0",
+				Actions: []Action{{
+					Name:     "Tail",
+					Shortcut: "t",
+					Command:  "kubectl logs {{.pod}} --tail {{.params.lines}}",
+					Params:   []ActionParam{{Name: "lines", Default: "100", Required: true, Pattern: "^[0-9]+$"}},
+				}},
+				Contexts: []Context{{Name: "test"}},
+			},
+			wantErr: false,
+		},
+		{
+			name: "captured action output",
+			config: &Config{
+				Version:  "1.0",
+				Actions:  []Action{{Name: "Dump logs", Shortcut: "L", Command: "kubectl logs {{.pod}}", Output: ActionOutputCapture}},
+				Contexts: []Context{{Name: "test"}},
+			},
+			wantErr: false,
+		},
+		{
+			name: "unknown action output",
+			config: &Config{
+				Version:  "1.0",
+				Actions:  []Action{{Name: "Dump logs", Shortcut: "L", Command: "kubectl logs {{.pod}}", Output: "file"}},
+				Contexts: []Context{{Name: "test"}},
+			},
+			wantErr:     true,
+			errContains: `unknown output "file"`,
+		},
+		{
+			name: "valid follow-up to a context action",
+			config: &Config{
+				Version: "1.0",
+				Actions: []Action{{Name: "Health check", Shortcut: "h", Command: "curl {{.pod}}", OnFailure: "show-logs", FollowUp: FollowUpRun}},
+				Contexts: []Context{{Name: "test", Actions: []Action{
+					{Name: "show-logs", Shortcut: "l", Command: "kubectl logs {{.pod}}"},
+				}}},
+			},
+			wantErr: false,
+		},
+		{
+			name: "follow-up to unknown action",
+			config: &Config{
+				Version:  "1.0",
+				Actions:  []Action{{Name: "Health check", Shortcut: "h", Command: "curl {{.pod}}", OnFailure: "show-logs"}},
+				Contexts: []Context{{Name: "test"}},
+			},
+			wantErr:     true,
+			errContains: "context[0] (test): action 'Health check': on_failure refers to unknown action 'show-logs'",
+		},
+		{
+			name: "follow-up to itself",
+			config: &Config{
+				Version:  "1.0",
+				Actions:  []Action{{Name: "Health check", Shortcut: "h", Command: "curl {{.pod}}", OnFailure: "h"}},
+				Contexts: []Context{{Name: "test"}},
+			},
+			wantErr:     true,
+			errContains: "on_failure cannot refer to the action itself",
+		},
+		{
+			name: "unknown follow_up mode",
+			config: &Config{
+				Version:  "1.0",
+				Actions:  []Action{{Name: "Health check", Shortcut: "h", Command: "curl {{.pod}}", FollowUp: "always"}},
+				Contexts: []Context{{Name: "test"}},
+			},
+			wantErr:     true,
+			errContains: `unknown follow_up "always"`,
+		},
+		{
+			name: "valid actions placement",
+			config: &Config{
+				Version:  "1.0",
+				Layout:   &Layout{Actions: ActionsBottom},
+				Contexts: []Context{{Name: "test"}},
+			},
+			wantErr: false,
+		},
+		{
+			name: "unknown actions placement",
+			config: &Config{
+				Version:  "1.0",
+				Layout:   &Layout{Actions: "left"},
+				Contexts: []Context{{Name: "test"}},
+			},
+			wantErr:     true,
+			errContains: "invalid layout: unknown actions placement",
+		},
+		{
+			name: "unknown layout preset",
+			config: &Config{
+				Version:  "1.0",
+				Layout:   &Layout{Preset: "wide"},
+				Contexts: []Context{{Name: "test"}},
+			},
+			wantErr:     true,
+			errContains: "invalid layout: unknown preset",
+		},
+		{
+			name: "custom appearance",
+			config: &Config{
+				Version:    "1.0",
+				Appearance: &Appearance{Cursor: "▶ ", Selection: SelectionUnderline, Favorite: "★ "},
+				Contexts:   []Context{{Name: "test"}},
+			},
+			wantErr: false,
+		},
+		{
+			name: "unknown selection",
+			config: &Config{
+				Version:    "1.0",
+				Appearance: &Appearance{Selection: "inverse"},
+				Contexts:   []Context{{Name: "test"}},
+			},
+			wantErr:     true,
+			errContains: "invalid appearance: unknown selection",
+		},
+		{
+			name: "cursor too long",
+			config: &Config{
+				Version:    "1.0",
+				Appearance: &Appearance{Cursor: "-----> "},
+				Contexts:   []Context{{Name: "test"}},
+			},
+			wantErr:     true,
+			errContains: "cursor marker",
+		},
+		{
+			name: "custom spinner frames too wide",
+			config: &Config{
+				Version: "1.0",
+				Appearance: &Appearance{
+					Spinner:         SpinnerCustom,
+					SpinnerFrames:   []string{"[=  ]", "[ = ]", "[  =]"},
+					SpinnerInterval: "150ms",
+					LoadingMessages: map[string]string{LoadingPods: "Asking the API server..."},
+				},
+				Contexts: []Context{{Name: "test"}},
+			},
+			wantErr:     true,
+			errContains: "frames must all be as wide, at most 4 characters",
+		},
+		{
+			name: "custom spinner frames of one width",
+			config: &Config{
+				Version:    "1.0",
+				Appearance: &Appearance{Spinner: SpinnerCustom, SpinnerFrames: []string{"◐", "◓", "◑", "◒"}, SpinnerInterval: "150ms"},
+				Contexts:   []Context{{Name: "test"}},
+			},
+			wantErr: false,
+		},
+		{
+			name: "spinner frames without custom spinner",
+			config: &Config{
+				Version:    "1.0",
+				Appearance: &Appearance{Spinner: SpinnerLine, SpinnerFrames: []string{"."}},
+				Contexts:   []Context{{Name: "test"}},
+			},
+			wantErr:     true,
+			errContains: "spinner_frames needs spinner: custom",
+		},
+		{
+			name: "unknown spinner",
+			config: &Config{
+				Version:    "1.0",
+				Appearance: &Appearance{Spinner: "bounce"},
+				Contexts:   []Context{{Name: "test"}},
+			},
+			wantErr:     true,
+			errContains: `unknown spinner "bounce"`,
+		},
+		{
+			name: "spinner interval too slow",
+			config: &Config{
+				Version:    "1.0",
+				Appearance: &Appearance{SpinnerInterval: "5s"},
+				Contexts:   []Context{{Name: "test"}},
+			},
+			wantErr:     true,
+			errContains: "invalid spinner_interval: 5s must be above 0 and at most 2s",
+		},
+		{
+			name: "unknown loading message operation",
+			config: &Config{
+				Version:    "1.0",
+				Appearance: &Appearance{LoadingMessages: map[string]string{"logs": "Tailing..."}},
+				Contexts:   []Context{{Name: "test"}},
+			},
+			wantErr:     true,
+			errContains: `unknown loading_messages operation "logs"`,
+		},
+		{
+			name: "invalid on_enter template",
+			config: &Config{
+				Version:  "1.0",
+				Contexts: []Context{{Name: "test", OnEnter: "gcloud config set project {{.context"}},
+			},
+			wantErr:     true,
+			errContains: "context[0] (test): invalid on_enter template",
+		},
+		{
+			name: "invalid kubectl_timeout",
+			config: &Config{
+				Version:        "1.0",
+				KubectlTimeout: "forever",
+				Contexts:       []Context{{Name: "test"}},
+			},
+			wantErr:     true,
+			errContains: "invalid kubectl_timeout",
+		},
+		{
+			name: "negative retries",
+			config: &Config{
+				Version:  "1.0",
+				Retries:  -1,
+				Contexts: []Context{{Name: "test"}},
+			},
+			wantErr:     true,
+			errContains: "invalid retries: -1 must not be negative",
+		},
+		{
+			name: "unknown log_level",
+			config: &Config{
+				Version:  "1.0",
+				LogLevel: "verbose",
+				Contexts: []Context{{Name: "test"}},
+			},
+			wantErr:     true,
+			errContains: `invalid log_level: unknown level "verbose"`,
+		},
+		{
+			name: "empty fallback namespace",
+			config: &Config{
+				Version:  "1.0",
+				Contexts: []Context{{Name: "test", Namespaces: []string{"team-a", " "}}},
+			},
+			wantErr:     true,
+			errContains: "context[0] (test): namespaces[1] cannot be empty",
+		},
+		{
+			name: "negative kubectl_qps",
+			config: &Config{
+				Version:    "1.0",
+				KubectlQPS: -1,
+				Contexts:   []Context{{Name: "test"}},
+			},
+			wantErr:     true,
+			errContains: "invalid kubectl_qps",
+		},
+		{
+			name: "negative context kubectl_burst",
+			config: &Config{
+				Version:  "1.0",
+				Contexts: []Context{{Name: "test", KubectlQPS: 5, KubectlBurst: -2}},
+			},
+			wantErr:     true,
+			errContains: "context[0] (test): kubectl_burst -2 must not be negative",
+		},
+		{
+			name: "negative pod_page_size",
+			config: &Config{
+				Version:     "1.0",
+				PodPageSize: -1,
+				Contexts:    []Context{{Name: "test"}},
+			},
+			wantErr:     true,
+			errContains: "invalid pod_page_size",
+		},
+		{
+			name: "invalid context pod_selector",
+			config: &Config{
+				Version:  "1.0",
+				Contexts: []Context{{Name: "test", PodSelector: "app=web; rm -rf /"}},
+			},
+			wantErr:     true,
+			errContains: "context[0] (test): pod_selector: invalid label selector",
+		},
+		{
+			name: "context pod paging and selector",
+			config: &Config{
+				Version:     "1.0",
+				PodPageSize: 500,
+				Contexts:    []Context{{Name: "test", PodSelector: "app=web,tier in (api,worker),!canary", PodPageSize: 100}},
+			},
+			wantErr: false,
+		},
+		{
+			name: "context group and env",
+			config: &Config{
+				Version: "1.0",
+				Contexts: []Context{
+					{Name: "prod-eu", Group: "Production", Env: EnvProd},
+					{Name: "stage", Group: "Staging", Env: EnvStaging},
+					{Name: "minikube", Env: EnvDev},
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "unknown context env",
+			config: &Config{
+				Version:  "1.0",
+				Contexts: []Context{{Name: "test", Env: "production"}},
+			},
+			wantErr:     true,
+			errContains: `context[0] (test): unknown env "production"`,
+		},
+		{
+			name: "variable captured by a global action",
+			config: &Config{
+				Version: "1.0",
+				Actions: []Action{{Name: "Pod IP", Shortcut: "i", Command: "kubectl get pod {{.pod}} -o jsonpath='{.status.podIP}'", CaptureVar: "POD_IP"}},
+				Contexts: []Context{{Name: "test", Actions: []Action{
+					{Name: "Ping", Shortcut: "p", Command: "ping -c 3 {{.vars.POD_IP}}"},
+				}}},
+			},
+			wantErr: false,
+		},
+		{
+			name: "undefined variable",
+			config: &Config{
+				Version:  "1.0",
+				Actions:  []Action{{Name: "Ping", Shortcut: "p", Command: "ping -c 3 {{.vars.POD_IP}}"}},
+				Contexts: []Context{{Name: "test"}},
+			},
+			wantErr:     true,
+			errContains: "context[0] (test): action 'Ping': {{.vars.POD_IP}} is not set by any action",
+		},
+		{
+			name: "invalid capture_var",
+			config: &Config{
+				Version:  "1.0",
+				Actions:  []Action{{Name: "Pod IP", Shortcut: "i", Command: "hostname -i", CaptureVar: "POD-IP"}},
+				Contexts: []Context{{Name: "test"}},
+			},
+			wantErr:     true,
+			errContains: `capture_var "POD-IP" must be letters, digits and underscores`,
+		},
+		{
+			name: "capture_var with output capture",
+			config: &Config{
+				Version:  "1.0",
+				Actions:  []Action{{Name: "Pod IP", Shortcut: "i", Command: "hostname -i", CaptureVar: "POD_IP", Output: ActionOutputCapture}},
+				Contexts: []Context{{Name: "test"}},
+			},
+			wantErr:     true,
+			errContains: "capture_var only applies to actions run in the terminal",
+		},
+		{
+			name: "background action with output capture",
+			config: &Config{
+				Version: "1.0",
+				Actions: []Action{
+					{Name: "Seed", Shortcut: "s", Command: "make seed", Background: true},
+					{Name: "Dump", Shortcut: "d", Command: "pg_dump", Background: true, Output: ActionOutputCapture},
+				},
+				Contexts: []Context{{Name: "test"}},
+			},
+			wantErr:     true,
+			errContains: "action[1] (Dump): background actions cannot set output, wait_on_exit, on_success or on_failure",
+		},
+		{
+			name: "invalid destructive pattern",
+			config: &Config{
+				Version:             "1.0",
+				DestructivePatterns: []string{`\bdelete\b`, "rollout(restart"},
+				Contexts:            []Context{{Name: "test", ReadOnly: true}},
+			},
+			wantErr:     true,
+			errContains: "invalid destructive_patterns[1]",
+		},
+		{
+			name: "valid audit sinks",
+			config: &Config{
+				Version: "1.0",
+				Audit: &Audit{Sinks: []AuditSink{
+					{Type: AuditSinkFile, Path: "~/.kubertino/audit.jsonl"},
+					{Type: AuditSinkSyslog, Address: "udp://logs.example.com:514"},
+					{Type: AuditSinkHTTP, URL: "https://logs.example.com/ingest"},
+				}},
+				Contexts: []Context{{Name: "test"}},
+			},
+			wantErr: false,
+		},
+		{
+			name: "unknown audit sink type",
+			config: &Config{
+				Version:  "1.0",
+				Audit:    &Audit{Sinks: []AuditSink{{Type: "kafka"}}},
+				Contexts: []Context{{Name: "test"}},
+			},
+			wantErr:     true,
+			errContains: `invalid audit: sink 0: unknown type "kafka"`,
+		},
+		{
+			name: "audit file sink without path",
+			config: &Config{
+				Version:  "1.0",
+				Audit:    &Audit{Sinks: []AuditSink{{Type: AuditSinkFile}}},
+				Contexts: []Context{{Name: "test"}},
+			},
+			wantErr:     true,
+			errContains: "file sink requires path",
+		},
+		{
+			name: "audit shell sink",
+			config: &Config{
+				Version:  "1.0",
+				Audit:    &Audit{Sinks: []AuditSink{{Type: AuditSinkShell, ShellHistory: true}}},
+				Contexts: []Context{{Name: "test"}},
+			},
+			wantErr: false,
+		},
+		{
+			name: "shell_history on a file sink",
+			config: &Config{
+				Version:  "1.0",
+				Audit:    &Audit{Sinks: []AuditSink{{Type: AuditSinkFile, Path: "/tmp/audit.jsonl", ShellHistory: true}}},
+				Contexts: []Context{{Name: "test"}},
+			},
+			wantErr:     true,
+			errContains: "shell_history only applies to shell sinks",
+		},
+		{
+			name: "audit http sink without url",
+			config: &Config{
+				Version:  "1.0",
+				Audit:    &Audit{Sinks: []AuditSink{{Type: AuditSinkHTTP, URL: "logs.example.com"}}},
+				Contexts: []Context{{Name: "test"}},
+			},
+			wantErr:     true,
+			errContains: "http sink requires an http(s) url",
+		},
+		{
+			name: "audit syslog address without scheme",
+			config: &Config{
+				Version:  "1.0",
+				Audit:    &Audit{Sinks: []AuditSink{{Type: AuditSinkSyslog, Address: "logs.example.com:514"}}},
+				Contexts: []Context{{Name: "test"}},
+			},
+			wantErr:     true,
+			errContains: "syslog address",
+		},
 		{
 			name: "invalid template syntax",
 			config: &Config{
@@ -202,6 +991,21 @@ func TestValidate(t *testing.T) {
 			wantErr:     true,
 			errContains: "invalid command template",
 		},
+		{
+			name: "pod metadata template variables",
+			config: &Config{
+				Version: "1.0",
+				Contexts: []Context{
+					{
+						Name: "test",
+						Actions: []Action{
+							{Name: "test", Shortcut: "t", Command: `KUBECONFIG={{.kubeconfig}} kubectl logs {{.pod}} -c {{.container}} # {{.status}} {{.node}} {{.labels.app}} {{index .labels "app.kubernetes.io/name"}}`},
+						},
+					},
+				},
+			},
+			wantErr: false,
+		},
 	}
 
 	for _, tt := range tests {
@@ -0,0 +1,270 @@
+package config
+
+import (
+	"bytes"
+	"fmt"
+	"log/slog"
+	"os"
+
+	"github.com/maratkarimov/kubertino/internal/safefile"
+	"gopkg.in/yaml.v3"
+)
+
+// configBackups is how many timestamped backups of the config file are kept
+const configBackups = 5
+
+// writeConfigFile backs up the config file and replaces it atomically. A failed backup is
+// logged but does not stop the write, which is itself crash-safe.
+func writeConfigFile(filename string, data []byte) error {
+	if err := safefile.Backup(filename, configBackups); err != nil {
+		slog.Warn("failed to back up config file", "path", filename, "error", err)
+	}
+	if err := safefile.WriteFile(filename, data, 0644); err != nil {
+		return fmt.Errorf("failed to write config file %s: %w", filename, err)
+	}
+	return nil
+}
+
+// Save serializes the configuration back to a YAML file.
+// Comments and formatting of the original file are not preserved. The previous file is kept
+// as one of 5 timestamped backups next to it (<file>.bak.<time>).
+func Save(cfg *Config, filename string) error {
+	if cfg == nil {
+		return fmt.Errorf("config is nil")
+	}
+
+	filename, err := expandHome(filename)
+	if err != nil {
+		return err
+	}
+
+	data, err := yaml.Marshal(withoutPluginActions(cfg))
+	if err != nil {
+		return fmt.Errorf("failed to serialize config: %w", err)
+	}
+
+	return writeConfigFile(filename, data)
+}
+
+// initComments are the comments SaveInit puts above the top-level keys of a new config file
+var initComments = map[string]string{
+	"actions": "Actions run against the selected pod by their shortcut, in every context.\n" +
+		"{{.context}}, {{.namespace}}, {{.pod}} and {{.container}} are substituted;\n" +
+		"see examples/kubertino.yml.example for all variables and options.",
+	"favorites": "Favorite namespaces per context, listed first (f toggles them in the TUI)",
+	"contexts":  "kubeconfig contexts shown in the context list",
+}
+
+// SaveInit writes a new configuration file, as created by kubertino config init, with
+// comments explaining each section. An existing file is backed up like in Save.
+func SaveInit(cfg *Config, filename string) error {
+	if cfg == nil {
+		return fmt.Errorf("config is nil")
+	}
+
+	filename, err := expandHome(filename)
+	if err != nil {
+		return err
+	}
+
+	var mapping yaml.Node
+	if err := mapping.Encode(cfg); err != nil {
+		return fmt.Errorf("failed to serialize config: %w", err)
+	}
+	for i := 0; i+1 < len(mapping.Content); i += 2 {
+		key := mapping.Content[i]
+		if comment, ok := initComments[key.Value]; ok {
+			key.HeadComment = comment
+		}
+	}
+	doc := yaml.Node{
+		Kind:        yaml.DocumentNode,
+		HeadComment: "Kubertino configuration, created by kubertino config init",
+		Content:     []*yaml.Node{&mapping},
+	}
+
+	var buf bytes.Buffer
+	encoder := yaml.NewEncoder(&buf)
+	encoder.SetIndent(2)
+	if err := encoder.Encode(&doc); err != nil {
+		return fmt.Errorf("failed to serialize config: %w", err)
+	}
+	if err := encoder.Close(); err != nil {
+		return fmt.Errorf("failed to serialize config: %w", err)
+	}
+
+	return writeConfigFile(filename, buf.Bytes())
+}
+
+// withoutPluginActions returns cfg without the global actions provided by plugins, which
+// are discovered on every start and do not belong in the config file
+func withoutPluginActions(cfg *Config) *Config {
+	actions := make([]Action, 0, len(cfg.Actions))
+	for _, action := range cfg.Actions {
+		if action.Plugin == "" {
+			actions = append(actions, action)
+		}
+	}
+	if len(actions) == len(cfg.Actions) {
+		return cfg
+	}
+
+	stripped := *cfg
+	stripped.Actions = actions
+	return &stripped
+}
+
+// SaveFavorites writes the favorites of cfg to an existing YAML file, replacing only the
+// favorites section so comments and formatting elsewhere in the file are kept. The previous
+// file is backed up like in Save.
+func SaveFavorites(cfg *Config, filename string) error {
+	if cfg == nil {
+		return fmt.Errorf("config is nil")
+	}
+
+	return editConfigFile(filename, func(root *yaml.Node) error {
+		var value yaml.Node
+		if err := value.Encode(cfg.Favorites); err != nil {
+			return fmt.Errorf("failed to serialize favorites: %w", err)
+		}
+		setMappingValue(root, "favorites", &value)
+		return nil
+	})
+}
+
+// SaveKeymap writes the keymap overrides of cfg to an existing YAML file, replacing only the
+// keymap section like SaveFavorites. A nil keymap removes the section.
+func SaveKeymap(cfg *Config, filename string) error {
+	if cfg == nil {
+		return fmt.Errorf("config is nil")
+	}
+
+	return editConfigFile(filename, func(root *yaml.Node) error {
+		var value yaml.Node
+		if err := value.Encode(cfg.Keymap); err != nil {
+			return fmt.Errorf("failed to serialize keymap: %w", err)
+		}
+		setMappingValue(root, "keymap", &value)
+		return nil
+	})
+}
+
+// SaveActionShortcut changes the shortcut of the action run by the same keys as action in an
+// existing YAML file, leaving the rest of the file untouched like SaveFavorites. The action is
+// looked up in the actions of the named context, or among the global actions when context is
+// empty.
+func SaveActionShortcut(filename, context string, action Action, shortcut string) error {
+	return editConfigFile(filename, func(root *yaml.Node) error {
+		actions := mappingValue(root, "actions")
+		if context != "" {
+			actions = nil
+			for _, ctx := range sequenceItems(mappingValue(root, "contexts")) {
+				if scalarValue(ctx, "name") == context {
+					actions = mappingValue(ctx, "actions")
+				}
+			}
+		}
+
+		for _, node := range sequenceItems(actions) {
+			if scalarValue(node, "shortcut") == action.Shortcut && scalarValue(node, "group") == action.Group {
+				setMappingValue(node, "shortcut", &yaml.Node{Kind: yaml.ScalarNode, Tag: "!!str", Value: shortcut})
+				return nil
+			}
+		}
+		return fmt.Errorf("action %q is not defined in the config file", action.Name)
+	})
+}
+
+// editConfigFile applies edit to the top-level mapping of an existing YAML file and writes
+// it back with comments and formatting kept. The previous file is backed up like in Save.
+func editConfigFile(filename string, edit func(root *yaml.Node) error) error {
+	filename, err := expandHome(filename)
+	if err != nil {
+		return err
+	}
+
+	data, err := os.ReadFile(filename)
+	if err != nil {
+		return fmt.Errorf("failed to read config file %s: %w", filename, err)
+	}
+
+	var doc yaml.Node
+	if err := yaml.Unmarshal(data, &doc); err != nil {
+		return fmt.Errorf("failed to parse config file %s: %w", filename, err)
+	}
+	if doc.Kind != yaml.DocumentNode || len(doc.Content) == 0 || doc.Content[0].Kind != yaml.MappingNode {
+		return fmt.Errorf("config file %s is not a YAML mapping", filename)
+	}
+
+	if err := edit(doc.Content[0]); err != nil {
+		return err
+	}
+
+	var buf bytes.Buffer
+	encoder := yaml.NewEncoder(&buf)
+	encoder.SetIndent(2)
+	if err := encoder.Encode(&doc); err != nil {
+		return fmt.Errorf("failed to serialize config: %w", err)
+	}
+	if err := encoder.Close(); err != nil {
+		return fmt.Errorf("failed to serialize config: %w", err)
+	}
+
+	return writeConfigFile(filename, buf.Bytes())
+}
+
+// mappingValue returns the value of key in a mapping node, or nil when the node is not a
+// mapping or has no such key
+func mappingValue(mapping *yaml.Node, key string) *yaml.Node {
+	if mapping == nil || mapping.Kind != yaml.MappingNode {
+		return nil
+	}
+	for i := 0; i+1 < len(mapping.Content); i += 2 {
+		if mapping.Content[i].Value == key {
+			return mapping.Content[i+1]
+		}
+	}
+	return nil
+}
+
+// scalarValue returns the scalar value of key in a mapping node, or "" when it is missing
+func scalarValue(mapping *yaml.Node, key string) string {
+	if value := mappingValue(mapping, key); value != nil && value.Kind == yaml.ScalarNode {
+		return value.Value
+	}
+	return ""
+}
+
+// sequenceItems returns the items of a sequence node, or nil for any other node
+func sequenceItems(node *yaml.Node) []*yaml.Node {
+	if node == nil || node.Kind != yaml.SequenceNode {
+		return nil
+	}
+	return node.Content
+}
+
+// setMappingValue replaces the value of key in a mapping node, keeping comments attached to
+// the old value, or appends the key when it is missing. A nil-valued key is removed.
+func setMappingValue(mapping *yaml.Node, key string, value *yaml.Node) {
+	remove := value.Kind == yaml.ScalarNode && value.Tag == "!!null"
+
+	for i := 0; i+1 < len(mapping.Content); i += 2 {
+		if mapping.Content[i].Value != key {
+			continue
+		}
+		if remove {
+			mapping.Content = append(mapping.Content[:i], mapping.Content[i+2:]...)
+			return
+		}
+		old := mapping.Content[i+1]
+		value.HeadComment, value.LineComment, value.FootComment = old.HeadComment, old.LineComment, old.FootComment
+		mapping.Content[i+1] = value
+		return
+	}
+
+	if remove {
+		return
+	}
+	keyNode := &yaml.Node{Kind: yaml.ScalarNode, Tag: "!!str", Value: key}
+	mapping.Content = append(mapping.Content, keyNode, value)
+}
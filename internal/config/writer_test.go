@@ -0,0 +1,233 @@
+package config
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSave(t *testing.T) {
+	t.Run("round trip preserves keymap and actions", func(t *testing.T) {
+		path := filepath.Join(t.TempDir(), "kubertino.yml")
+		cfg := &Config{
+			Version: "1.0",
+			Keymap:  &Keymap{Up: []string{"w"}},
+			Contexts: []Context{
+				{
+					Name: "test",
+					Actions: []Action{
+						{Name: "logs", Shortcut: "l", Command: "kubectl logs {{.pod}}"},
+					},
+				},
+			},
+		}
+
+		require.NoError(t, Save(cfg, path))
+
+		parsed, err := Parse(path)
+		require.NoError(t, err)
+		require.NotNil(t, parsed.Keymap)
+		assert.Equal(t, []string{"w"}, parsed.Keymap.Up)
+		assert.Empty(t, parsed.Keymap.Down)
+		require.Len(t, parsed.Contexts, 1)
+		assert.Equal(t, "l", parsed.Contexts[0].Actions[0].Shortcut)
+	})
+
+	t.Run("plugin actions are not written", func(t *testing.T) {
+		path := filepath.Join(t.TempDir(), "kubertino.yml")
+		cfg := &Config{
+			Version: "1.0",
+			Actions: []Action{
+				{Name: "Shell", Shortcut: "s", Command: "sh"},
+				{Name: "Loki", Shortcut: "L", Command: "loki", Plugin: "logs"},
+			},
+			Contexts: []Context{{Name: "test"}},
+		}
+
+		require.NoError(t, Save(cfg, path))
+
+		parsed, err := Parse(path)
+		require.NoError(t, err)
+		require.Len(t, parsed.Actions, 1)
+		assert.Equal(t, "Shell", parsed.Actions[0].Name)
+		assert.Len(t, cfg.Actions, 2, "the running config keeps them")
+	})
+
+	t.Run("previous file is backed up", func(t *testing.T) {
+		path := filepath.Join(t.TempDir(), "kubertino.yml")
+		require.NoError(t, os.WriteFile(path, []byte("version: \"0.9\"\n"), 0600))
+
+		require.NoError(t, Save(&Config{Version: "1.0"}, path))
+
+		backups, err := filepath.Glob(path + ".bak.*")
+		require.NoError(t, err)
+		require.Len(t, backups, 1)
+		data, err := os.ReadFile(backups[0])
+		require.NoError(t, err)
+		assert.Equal(t, "version: \"0.9\"\n", string(data))
+
+		info, err := os.Stat(path)
+		require.NoError(t, err)
+		assert.Equal(t, os.FileMode(0600), info.Mode().Perm(), "permissions of the config are kept")
+	})
+
+	t.Run("nil config", func(t *testing.T) {
+		err := Save(nil, filepath.Join(t.TempDir(), "kubertino.yml"))
+		assert.Error(t, err)
+	})
+
+	t.Run("unwritable path", func(t *testing.T) {
+		err := Save(&Config{Version: "1.0"}, filepath.Join(t.TempDir(), "missing", "kubertino.yml"))
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "failed to write config file")
+	})
+}
+
+func TestSaveFavorites(t *testing.T) {
+	t.Run("keeps comments and other sections", func(t *testing.T) {
+		path := filepath.Join(t.TempDir(), "kubertino.yml")
+		original := `# Team config
+version: "1.0"
+favorites: # pinned namespaces
+  prod:
+    - payments
+contexts:
+  # Production cluster
+  - name: prod
+    actions:
+      - name: Logs
+        shortcut: l
+        command: kubectl logs {{.pod}}
+`
+		require.NoError(t, os.WriteFile(path, []byte(original), 0644))
+
+		cfg, err := Parse(path)
+		require.NoError(t, err)
+		_, err = ToggleFavorite(cfg, "prod", "orders")
+		require.NoError(t, err)
+		require.NoError(t, SaveFavorites(cfg, path))
+
+		data, err := os.ReadFile(path)
+		require.NoError(t, err)
+		content := string(data)
+		assert.Contains(t, content, "# Team config")
+		assert.Contains(t, content, "# Production cluster")
+		assert.Contains(t, content, "# pinned namespaces")
+		assert.Contains(t, content, "command: kubectl logs {{.pod}}")
+
+		parsed, err := Parse(path)
+		require.NoError(t, err)
+		favorites, err := GetFavorites(parsed, "prod")
+		require.NoError(t, err)
+		assert.Equal(t, []string{"payments", "orders"}, favorites)
+	})
+
+	t.Run("adds favorites section when missing", func(t *testing.T) {
+		path := filepath.Join(t.TempDir(), "kubertino.yml")
+		require.NoError(t, os.WriteFile(path, []byte("version: \"1.0\"\ncontexts:\n  - name: dev\n"), 0644))
+
+		cfg, err := Parse(path)
+		require.NoError(t, err)
+		_, err = ToggleFavorite(cfg, "dev", "default")
+		require.NoError(t, err)
+		require.NoError(t, SaveFavorites(cfg, path))
+
+		parsed, err := Parse(path)
+		require.NoError(t, err)
+		favorites, err := GetFavorites(parsed, "dev")
+		require.NoError(t, err)
+		assert.Equal(t, []string{"default"}, favorites)
+	})
+
+	t.Run("missing file", func(t *testing.T) {
+		err := SaveFavorites(&Config{}, filepath.Join(t.TempDir(), "missing.yml"))
+		assert.ErrorContains(t, err, "failed to read config file")
+	})
+}
+
+func TestSaveKeymap(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "kubertino.yml")
+	require.NoError(t, os.WriteFile(path, []byte("# Team config\nversion: \"1.0\"\ncontexts:\n  - name: dev\n"), 0644))
+
+	cfg, err := Parse(path)
+	require.NoError(t, err)
+	cfg.Keymap = &Keymap{PodSort: []string{"s"}}
+	require.NoError(t, SaveKeymap(cfg, path))
+
+	data, err := os.ReadFile(path)
+	require.NoError(t, err)
+	assert.Contains(t, string(data), "# Team config")
+	parsed, err := Parse(path)
+	require.NoError(t, err)
+	require.NotNil(t, parsed.Keymap)
+	assert.Equal(t, []string{"s"}, parsed.Keymap.PodSort)
+	assert.Empty(t, parsed.Keymap.Quit, "only overrides are written")
+}
+
+func TestSaveActionShortcut(t *testing.T) {
+	original := `version: "1.0"
+actions:
+  - name: Logs
+    shortcut: l # follow
+    command: kubectl logs {{.pod}}
+contexts:
+  - name: prod
+    actions:
+      - name: Shell
+        shortcut: s
+        command: kubectl exec -it {{.pod}} -- sh
+`
+	path := filepath.Join(t.TempDir(), "kubertino.yml")
+	require.NoError(t, os.WriteFile(path, []byte(original), 0644))
+
+	require.NoError(t, SaveActionShortcut(path, "", Action{Name: "Logs", Shortcut: "l"}, "L"))
+	require.NoError(t, SaveActionShortcut(path, "prod", Action{Name: "Shell", Shortcut: "s"}, "b"))
+
+	data, err := os.ReadFile(path)
+	require.NoError(t, err)
+	assert.Contains(t, string(data), "# follow")
+	parsed, err := Parse(path)
+	require.NoError(t, err)
+	assert.Equal(t, "L", parsed.Actions[0].Shortcut)
+	assert.Equal(t, "b", parsed.Contexts[0].Actions[0].Shortcut)
+
+	err = SaveActionShortcut(path, "staging", Action{Name: "Shell", Shortcut: "b"}, "x")
+	assert.ErrorContains(t, err, "not defined in the config file")
+}
+
+func TestSaveInit(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "kubertino.yml")
+	cfg := &Config{
+		Version:   "1.0",
+		Actions:   ActionTemplates(),
+		Favorites: map[string]interface{}{"prod": []interface{}{"payments"}},
+		Contexts:  []Context{{Name: "prod"}, {Name: "staging"}},
+	}
+	require.NoError(t, Validate(cfg), "the action templates are valid")
+
+	exists, err := Exists(path)
+	require.NoError(t, err)
+	assert.False(t, exists)
+
+	require.NoError(t, SaveInit(cfg, path))
+	exists, err = Exists(path)
+	require.NoError(t, err)
+	assert.True(t, exists)
+
+	data, err := os.ReadFile(path)
+	require.NoError(t, err)
+	assert.Contains(t, string(data), "# Kubertino configuration, created by kubertino config init")
+	assert.Contains(t, string(data), "# Favorite namespaces per context")
+	assert.Contains(t, string(data), "# kubeconfig contexts shown in the context list\ncontexts:")
+
+	saved, err := Parse(path)
+	require.NoError(t, err)
+	assert.Equal(t, cfg.Contexts, saved.Contexts)
+	assert.Equal(t, cfg.Actions, saved.Actions)
+	favorites, err := GetFavorites(saved, "prod")
+	require.NoError(t, err)
+	assert.Equal(t, []string{"payments"}, favorites)
+}
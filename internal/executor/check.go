@@ -0,0 +1,74 @@
+package executor
+
+import (
+	"bytes"
+	"fmt"
+	"os/exec"
+	"regexp"
+	"strings"
+	"text/template"
+
+	"github.com/maratkarimov/kubertino/internal/config"
+	"github.com/maratkarimov/kubertino/internal/k8s"
+)
+
+// Sample target the action templates are checked against
+const (
+	sampleNamespace = "default"
+	samplePod       = "sample-pod"
+)
+
+// labelRefPattern finds {{.labels.<key>}} references, whose keys depend on the pod
+var labelRefPattern = regexp.MustCompile(`\.labels\.([A-Za-z0-9_]+)`)
+
+// CheckCommand renders the command of action for a sample pod in context and checks the
+// result with sh -n, without running it. Unlike a real run, a variable missing from the
+// template data is an error, an undeclared param included. Labels referenced by the template
+// are given sample values, as they depend on the pod, and so are params without a default and
+// session variables.
+// Returns the rendered command.
+func (e *Executor) CheckCommand(action config.Action, context config.Context, kubeconfigPath string) (string, error) {
+	pod := k8s.Pod{
+		Name:       samplePod,
+		Status:     "Running",
+		Containers: []string{"app"},
+		Node:       "sample-node",
+		Labels:     map[string]string{},
+	}
+	for _, match := range labelRefPattern.FindAllStringSubmatch(action.Command, -1) {
+		pod.Labels[match[1]] = "sample-" + match[1]
+	}
+
+	params := make(map[string]string, len(action.Params))
+	for _, param := range action.Params {
+		if param.Default == "" {
+			params[param.Name] = "sample-" + param.Name
+		}
+	}
+
+	vars := make(map[string]string)
+	for _, name := range config.VarRefs(action.Command) {
+		vars[name] = "sample-" + name
+	}
+
+	tmpl, err := template.New("action").Option("missingkey=error").Funcs(templateFuncs()).Parse(action.Command)
+	if err != nil {
+		return "", fmt.Errorf("invalid command template: %w", err)
+	}
+	var buf bytes.Buffer
+	if err := tmpl.Execute(&buf, actionData(action, params, vars, context, sampleNamespace, k8s.PodResource(pod), pod, kubeconfigPath)); err != nil {
+		return "", fmt.Errorf("template execution failed: %w", err)
+	}
+	command := buf.String()
+
+	var stderr bytes.Buffer
+	cmd := exec.Command("sh", "-n", "-c", command)
+	cmd.Stderr = &stderr
+	if err := cmd.Run(); err != nil {
+		if _, ok := err.(*exec.ExitError); ok {
+			return command, fmt.Errorf("shell syntax error: %s", strings.TrimSpace(stderr.String()))
+		}
+		return command, fmt.Errorf("failed to run sh: %w", err)
+	}
+	return command, nil
+}
@@ -0,0 +1,75 @@
+package executor
+
+import (
+	"testing"
+
+	"github.com/maratkarimov/kubertino/internal/config"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCheckCommand(t *testing.T) {
+	ctx := config.Context{Name: "production"}
+	e := NewExecutor()
+
+	tests := []struct {
+		name    string
+		command string
+		want    string
+		wantErr string
+	}{
+		{
+			name:    "renders sample pod",
+			command: "kubectl --context {{.context}} -n {{.namespace}} exec -it {{.pod}} -c {{.container}} -- sh",
+			want:    "kubectl --context production -n default exec -it sample-pod -c app -- sh",
+		},
+		{
+			name:    "labels get sample values",
+			command: `echo "{{.labels.team}}" "{{ .labels.app }}"`,
+			want:    `echo "sample-team" "sample-app"`,
+		},
+		{
+			name:    "unknown variable",
+			command: "kubectl logs {{.pods}}",
+			wantErr: `map has no entry for key "pods"`,
+		},
+		{
+			name:    "shell syntax error",
+			command: `kubectl logs {{.pod}} | grep "ERROR`,
+			wantErr: "shell syntax error",
+		},
+		{
+			name:    "command is not run",
+			command: "exit 1",
+			want:    "exit 1",
+		},
+		{
+			name:    "params get their default or a sample value",
+			command: "kubectl logs {{.pod}} --tail {{.params.lines}} -c {{.params.container}}",
+			want:    "kubectl logs sample-pod --tail 100 -c sample-container",
+		},
+		{
+			name:    "variables get sample values",
+			command: "ping -c 1 {{.vars.POD_IP}}",
+			want:    "ping -c 1 sample-POD_IP",
+		},
+		{
+			name:    "undeclared param",
+			command: "kubectl logs {{.pod}} --tail {{.params.line}}",
+			wantErr: `map has no entry for key "line"`,
+		},
+	}
+
+	params := []config.ActionParam{{Name: "lines", Default: "100"}, {Name: "container"}}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			command, err := e.CheckCommand(config.Action{Name: "test", Shortcut: "t", Command: tt.command, Params: params}, ctx, "")
+			if tt.wantErr != "" {
+				assert.ErrorContains(t, err, tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, command)
+		})
+	}
+}
@@ -14,6 +14,12 @@ const (
 
 // renderContextBox creates a decorated box showing execution context
 func renderContextBox(context, namespace, pod, action, command string) string {
+	return renderContextBoxWithTarget(context, namespace, "Pod:       ", pod, action, command)
+}
+
+// renderContextBoxWithTarget creates the context box with a custom label for the target line.
+// The target line is left out without a target, as is the namespace line without a namespace.
+func renderContextBoxWithTarget(context, namespace, targetLabel, target, action, command string) string {
 	boxStyle := lipgloss.NewStyle().
 		Border(lipgloss.RoundedBorder()).
 		BorderForeground(lipgloss.Color(colorCyan)).
@@ -25,10 +31,15 @@ func renderContextBox(context, namespace, pod, action, command string) string {
 		Foreground(lipgloss.Color(colorGray))
 
 	// Build main content
-	mainContent := fmt.Sprintf(
-		"Context:   %s\nNamespace: %s\nPod:       %s\nAction:    %s\nCommand:   %s",
-		context, namespace, pod, action, command,
-	)
+	lines := []string{"Context:   " + context}
+	if namespace != "" {
+		lines = append(lines, "Namespace: "+namespace)
+	}
+	if target != "" {
+		lines = append(lines, targetLabel+target)
+	}
+	lines = append(lines, "Action:    "+action, "Command:   "+command)
+	mainContent := strings.Join(lines, "\n")
 
 	// Add help hint at the bottom
 	helpHint := dimStyle.Render("Press Ctrl+D to return")
@@ -37,6 +48,17 @@ func renderContextBox(context, namespace, pod, action, command string) string {
 	return boxStyle.Render(content)
 }
 
+// asciiBox replaces the rounded border drawn by renderContextBoxWithTarget with ASCII
+var asciiBox = strings.NewReplacer("╭", "+", "╮", "+", "╰", "+", "╯", "+", "─", "-", "│", "|")
+
+// box returns contextBox as the executor draws it: with an ASCII border when SetASCII is on
+func (e *Executor) box(contextBox string) string {
+	if e.ascii {
+		return asciiBox.Replace(contextBox)
+	}
+	return contextBox
+}
+
 // buildWaitPrompt creates a styled prompt message for wait-on-exit functionality
 func buildWaitPrompt() string {
 	dimStyle := lipgloss.NewStyle().
@@ -50,18 +72,30 @@ func buildWaitPrompt() string {
 // The context box is properly escaped for shell safety.
 // If waitOnExit is true, the command will wait for Ctrl+D (EOF) before returning.
 func buildCompoundCommand(contextBox, command string, waitOnExit bool) string {
+	return buildCompoundCommandTo(contextBox, command, waitOnExit, "")
+}
+
+// buildCapturedCommand is buildCompoundCommand for an action whose stdout is captured: the
+// context box and wait prompt are written to stderr
+func buildCapturedCommand(contextBox, command string, waitOnExit bool) string {
+	return buildCompoundCommandTo(contextBox, command, waitOnExit, " >&2")
+}
+
+// buildCompoundCommandTo builds the compound command, with redirect applied to the output of
+// the context box and the wait prompt
+func buildCompoundCommandTo(contextBox, command string, waitOnExit bool, redirect string) string {
 	// Escape single quotes for shell safety using POSIX shell escaping:
 	// Replace each ' with '\'' (end quote, escaped quote, start quote)
 	escapedBox := strings.ReplaceAll(contextBox, "'", "'\\''")
 
 	// Build base compound command: print box, then run actual command
-	compoundCommand := fmt.Sprintf("printf '%%s\\n\\n' '%s' && %s", escapedBox, command)
+	compoundCommand := fmt.Sprintf("printf '%%s\\n\\n' '%s'%s && %s", escapedBox, redirect, command)
 
 	// If wait-on-exit is enabled, append wait logic
 	if waitOnExit {
 		waitPrompt := buildWaitPrompt()
 		escapedPrompt := strings.ReplaceAll(waitPrompt, "'", "'\\''")
-		compoundCommand = fmt.Sprintf("%s && { printf '%%s\\n' '%s' && cat > /dev/null; }", compoundCommand, escapedPrompt)
+		compoundCommand = fmt.Sprintf("%s && { printf '%%s\\n' '%s'%s && cat > /dev/null; }", compoundCommand, escapedPrompt, redirect)
 	}
 
 	return compoundCommand
@@ -3,6 +3,8 @@ package executor
 import (
 	"strings"
 	"testing"
+
+	"github.com/maratkarimov/kubertino/internal/k8s"
 )
 
 func TestRenderContextBox_ShortCommand(t *testing.T) {
@@ -157,6 +159,18 @@ func TestRenderContextBox_EmptyValues(t *testing.T) {
 	}
 }
 
+func TestRenderTargetBox_NoTarget(t *testing.T) {
+	// Context scoped actions run without a namespace or resource
+	result := renderTargetBox("prod", "", k8s.Resource{}, "Nodes", "kubectl get nodes")
+
+	if strings.Contains(result, "Namespace:") || strings.Contains(result, "Pod:") || strings.Contains(result, "Resource:") {
+		t.Errorf("Expected no namespace or target line, got:\n%s", result)
+	}
+	if !strings.Contains(result, "Context:   prod") || !strings.Contains(result, "Action:    Nodes") {
+		t.Errorf("Expected context and action lines, got:\n%s", result)
+	}
+}
+
 func TestRenderContextBox_VeryLongCommand(t *testing.T) {
 	// Test with a very long command (200+ characters)
 	veryLongCommand := "kubectl exec -it my-pod-with-very-long-name-12345678 -n my-namespace-with-very-long-name-12345678 --context my-context-with-very-long-name-12345678 -- bash -c 'for i in {1..100}; do echo $i; done'"
@@ -3,6 +3,7 @@ package executor
 import (
 	"bytes"
 	"fmt"
+	"io"
 	"os"
 	"os/exec"
 	"path/filepath"
@@ -15,6 +16,8 @@ import (
 
 // Executor manages action execution
 type Executor struct {
+	ascii bool // Draw the context box with ASCII characters
+	vars  Vars // Variables captured in the session, for {{.vars.<name>}}
 }
 
 // NewExecutor creates a new Executor instance
@@ -22,47 +25,40 @@ func NewExecutor() *Executor {
 	return &Executor{}
 }
 
+// SetASCII draws the context box shown before a command with ASCII characters instead of
+// rounded box-drawing ones, for terminals that cannot show them
+func (e *Executor) SetASCII(ascii bool) {
+	e.ascii = ascii
+}
+
+// Vars returns the variables of the session, set from the stdout of actions with capture_var
+func (e *Executor) Vars() *Vars {
+	return &e.vars
+}
+
 // ExecuteLocal executes a local command action with template variable substitution
 func (e *Executor) ExecuteLocal(action config.Action, context config.Context, namespace string, pod k8s.Pod, kubeconfigPath string) error {
 	// 1. Parse command template
-	tmpl, err := template.New("action").Parse(action.Command)
+	tmpl, err := template.New("action").Option("missingkey=zero").Funcs(templateFuncs()).Parse(action.Command)
 	if err != nil {
 		return fmt.Errorf("invalid command template: %w", err)
 	}
 
 	// 2. Substitute template variables
-	data := map[string]string{
-		"context":   context.Name,
-		"namespace": namespace,
-		"pod":       pod.Name,
-	}
-
 	var buf bytes.Buffer
-	if err := tmpl.Execute(&buf, data); err != nil {
+	if err := tmpl.Execute(&buf, templateData(context, namespace, k8s.PodResource(pod), pod, kubeconfigPath)); err != nil {
 		return fmt.Errorf("template execution failed: %w", err)
 	}
 
 	command := buf.String()
 
 	// 3. Build context box and compound command
-	contextBox := renderContextBox(context.Name, namespace, pod.Name, action.Name, command)
+	contextBox := e.box(renderContextBox(context.Name, namespace, pod.Name, action.Name, command))
 	compoundCommand := buildCompoundCommand(contextBox, command, action.WaitOnExit)
 
 	// 4. Execute compound command with shell
 	cmd := exec.Command("sh", "-c", compoundCommand)
-	cmd.Env = os.Environ() // Preserve parent environment
-
-	// Expand kubeconfig path (tilde expansion)
-	if kubeconfigPath != "" {
-		expandedPath := kubeconfigPath
-		if strings.HasPrefix(kubeconfigPath, "~/") {
-			homeDir, err := os.UserHomeDir()
-			if err == nil {
-				expandedPath = filepath.Join(homeDir, kubeconfigPath[2:])
-			}
-		}
-		cmd.Env = append(cmd.Env, fmt.Sprintf("KUBECONFIG=%s", expandedPath))
-	}
+	cmd.Env = commandEnv(kubeconfigPath) // Preserve parent environment
 
 	cmd.Stdin = os.Stdin
 	cmd.Stdout = os.Stdout
@@ -78,49 +74,195 @@ func (e *Executor) ExecuteLocal(action config.Action, context config.Context, na
 // PrepareLocal prepares a local command without executing it (for TUI integration)
 // Returns the command ready to be executed by tea.ExecProcess
 func (e *Executor) PrepareLocal(action config.Action, context config.Context, namespace string, pod k8s.Pod, kubeconfigPath string) (*exec.Cmd, error) {
-	// 1. Parse command template
-	tmpl, err := template.New("action").Parse(action.Command)
-	if err != nil {
-		return nil, fmt.Errorf("invalid command template: %w", err)
-	}
+	return e.prepare(action, context, namespace, k8s.PodResource(pod), pod, kubeconfigPath)
+}
 
-	// 2. Substitute template variables
-	data := map[string]string{
-		"context":   context.Name,
-		"namespace": namespace,
-		"pod":       pod.Name,
-	}
+// PrepareResource prepares a local command targeting any browsable resource (pod, deployment, ...).
+// Returns the command ready to be executed by tea.ExecProcess
+func (e *Executor) PrepareResource(action config.Action, context config.Context, namespace string, resource k8s.Resource, kubeconfigPath string) (*exec.Cmd, error) {
+	return e.prepare(action, context, namespace, resource, k8s.Pod{}, kubeconfigPath)
+}
 
-	var buf bytes.Buffer
-	if err := tmpl.Execute(&buf, data); err != nil {
-		return nil, fmt.Errorf("template execution failed: %w", err)
+// prepare builds the command for resource. pod carries the pod metadata ({{.container}},
+// {{.node}}, {{.labels}}) and is empty when only the resource is known.
+func (e *Executor) prepare(action config.Action, context config.Context, namespace string, resource k8s.Resource, pod k8s.Pod, kubeconfigPath string) (*exec.Cmd, error) {
+	// 1-2. Parse the command template and substitute template variables
+	command, err := renderCommand(action.Command, actionData(action, nil, e.vars.Values(), context, namespace, resource, pod, kubeconfigPath))
+	if err != nil {
+		return nil, err
 	}
+	return e.PrepareRendered(action, command, context, namespace, resource, kubeconfigPath), nil
+}
 
-	command := buf.String()
-
+// PrepareRendered prepares an already rendered command, such as one re-run from the action
+// history, with the context box and wait-on-exit prompt of action. For actions with
+// capture_var, they are written to stderr, so stdout carries only the command's output.
+func (e *Executor) PrepareRendered(action config.Action, command string, context config.Context, namespace string, resource k8s.Resource, kubeconfigPath string) *exec.Cmd {
 	// 3. Build context box and compound command
-	contextBox := renderContextBox(context.Name, namespace, pod.Name, action.Name, command)
+	contextBox := e.box(renderTargetBox(context.Name, namespace, resource, action.Name, command))
 	compoundCommand := buildCompoundCommand(contextBox, command, action.WaitOnExit)
+	if action.CaptureVar != "" {
+		compoundCommand = buildCapturedCommand(contextBox, command, action.WaitOnExit)
+	}
 
 	// 4. Build command with shell
 	cmd := exec.Command("sh", "-c", compoundCommand)
-	cmd.Env = os.Environ() // Preserve parent environment
-
-	// Expand kubeconfig path (tilde expansion)
-	if kubeconfigPath != "" {
-		expandedPath := kubeconfigPath
-		if strings.HasPrefix(kubeconfigPath, "~/") {
-			homeDir, err := os.UserHomeDir()
-			if err == nil {
-				expandedPath = filepath.Join(homeDir, kubeconfigPath[2:])
-			}
-		}
-		cmd.Env = append(cmd.Env, fmt.Sprintf("KUBECONFIG=%s", expandedPath))
+	cmd.Env = commandEnv(kubeconfigPath) // Preserve parent environment
+
+	cmd.Stdin = os.Stdin
+	cmd.Stdout = os.Stdout
+	cmd.Stderr = os.Stderr
+
+	return cmd
+}
+
+// PrepareBatch prepares a pod action for non-interactive use (kubertino exec): the rendered
+// command runs on its own, without the context box or the wait-on-exit prompt, so its output
+// and exit code can be used by scripts
+func (e *Executor) PrepareBatch(action config.Action, context config.Context, namespace string, pod k8s.Pod, kubeconfigPath string) (*exec.Cmd, error) {
+	command, err := renderCommand(action.Command, actionData(action, nil, e.vars.Values(), context, namespace, k8s.PodResource(pod), pod, kubeconfigPath))
+	if err != nil {
+		return nil, err
 	}
+	return e.PrepareBatchRendered(command, kubeconfigPath), nil
+}
 
+// PrepareBatchRendered prepares an already rendered command for non-interactive use, like
+// PrepareBatch
+func (e *Executor) PrepareBatchRendered(command, kubeconfigPath string) *exec.Cmd {
+	cmd := exec.Command("sh", "-c", command)
+	cmd.Env = commandEnv(kubeconfigPath) // Preserve parent environment
 	cmd.Stdin = os.Stdin
 	cmd.Stdout = os.Stdout
 	cmd.Stderr = os.Stderr
+	return cmd
+}
 
-	return cmd, nil
+// PrepareCapture prepares an action whose output is captured (output: capture): the rendered
+// command runs without a terminal, with stdout and stderr written to output
+func (e *Executor) PrepareCapture(action config.Action, context config.Context, namespace string, resource k8s.Resource, pod k8s.Pod, kubeconfigPath string, output io.Writer) (*exec.Cmd, error) {
+	command, err := renderCommand(action.Command, actionData(action, nil, e.vars.Values(), context, namespace, resource, pod, kubeconfigPath))
+	if err != nil {
+		return nil, err
+	}
+	return e.PrepareCaptureRendered(command, kubeconfigPath, output), nil
+}
+
+// PrepareCaptureRendered prepares an already rendered command with its output captured, like
+// PrepareCapture
+func (e *Executor) PrepareCaptureRendered(command, kubeconfigPath string, output io.Writer) *exec.Cmd {
+	cmd := exec.Command("sh", "-c", command)
+	cmd.Env = commandEnv(kubeconfigPath) // Preserve parent environment
+	cmd.Stdout = output
+	cmd.Stderr = output
+	// Stopping the capture must also stop kubectl and whatever else the command started
+	setProcessGroup(cmd)
+	return cmd
+}
+
+// Command returns the rendered command of action for resource, as recorded in audit logs.
+// pod carries the pod metadata and is empty for other resource kinds; params holds the values
+// entered for the action's params, which otherwise render their default. Each call renders
+// {{freePort}} and {{timestamp}} anew, so run the returned command with the Prepare*Rendered
+// methods to record exactly what ran.
+func (e *Executor) Command(action config.Action, context config.Context, namespace string, resource k8s.Resource, pod k8s.Pod, kubeconfigPath string, params map[string]string) (string, error) {
+	return renderCommand(action.Command, actionData(action, params, e.vars.Values(), context, namespace, resource, pod, kubeconfigPath))
+}
+
+// renderCommand substitutes the template variables in a command template
+func renderCommand(command string, data map[string]any) (string, error) {
+	tmpl, err := template.New("action").Option("missingkey=zero").Funcs(templateFuncs()).Parse(command)
+	if err != nil {
+		return "", fmt.Errorf("invalid command template: %w", err)
+	}
+
+	var buf bytes.Buffer
+	if err := tmpl.Execute(&buf, data); err != nil {
+		return "", fmt.Errorf("template execution failed: %w", err)
+	}
+	return buf.String(), nil
+}
+
+// templateData returns the variables available to action command templates.
+// {{.pod}} is only set when the target is a pod; {{.resource}} and {{.kind}} are always set.
+// {{.container}}, {{.node}} and {{.labels.<key>}} come from pod and are empty for other targets.
+func templateData(context config.Context, namespace string, resource k8s.Resource, pod k8s.Pod, kubeconfigPath string) map[string]any {
+	labels := pod.Labels
+	if labels == nil {
+		labels = map[string]string{}
+	}
+	data := map[string]any{
+		"context":    context.Name,
+		"namespace":  namespace,
+		"pod":        "",
+		"resource":   resource.Name,
+		"kind":       string(resource.Kind),
+		"status":     resource.Status,
+		"container":  defaultContainer(pod),
+		"kubeconfig": expandKubeconfig(kubeconfigPath),
+		"node":       pod.Node,
+		"labels":     labels,
+	}
+	if resource.Kind == k8s.KindPod {
+		data["pod"] = resource.Name
+	}
+	return data
+}
+
+// actionData returns the template variables of action: those of templateData,
+// {{.params.<name>}}, the values in params or else the param defaults, and {{.vars.<name>}}
+func actionData(action config.Action, params, vars map[string]string, context config.Context, namespace string, resource k8s.Resource, pod k8s.Pod, kubeconfigPath string) map[string]any {
+	data := templateData(context, namespace, resource, pod, kubeconfigPath)
+	data["params"] = action.ParamValues(params)
+	data["vars"] = vars
+	return data
+}
+
+// defaultContainerAnnotation names the container kubectl targets when -c is omitted
+const defaultContainerAnnotation = "kubectl.kubernetes.io/default-container"
+
+// defaultContainer returns the container kubectl would pick for pod: the one named by the
+// default-container annotation, otherwise the first container
+func defaultContainer(pod k8s.Pod) string {
+	if name := pod.Annotations[defaultContainerAnnotation]; name != "" {
+		return name
+	}
+	if len(pod.Containers) > 0 {
+		return pod.Containers[0]
+	}
+	return ""
+}
+
+// renderTargetBox renders the context box for a resource, labelling non-pod targets by kind.
+// Actions scoped to a namespace or context run without a resource.
+func renderTargetBox(context, namespace string, resource k8s.Resource, action, command string) string {
+	if resource.Name == "" {
+		return renderContextBoxWithTarget(context, namespace, "", "", action, command)
+	}
+	if resource.Kind == k8s.KindPod {
+		return renderContextBox(context, namespace, resource.Name, action, command)
+	}
+	return renderContextBoxWithTarget(context, namespace, "Resource:  ", resource.Ref(), action, command)
+}
+
+// commandEnv returns the parent environment with KUBECONFIG set to kubeconfigPath (tilde-expanded).
+// The parent environment is returned unchanged when no kubeconfig path is configured.
+func commandEnv(kubeconfigPath string) []string {
+	env := os.Environ()
+	if kubeconfigPath == "" {
+		return env
+	}
+
+	return append(env, fmt.Sprintf("KUBECONFIG=%s", expandKubeconfig(kubeconfigPath)))
+}
+
+// expandKubeconfig expands a leading "~/" in kubeconfigPath to the user's home directory
+func expandKubeconfig(kubeconfigPath string) string {
+	if strings.HasPrefix(kubeconfigPath, "~/") {
+		homeDir, err := os.UserHomeDir()
+		if err == nil {
+			return filepath.Join(homeDir, kubeconfigPath[2:])
+		}
+	}
+	return kubeconfigPath
 }
@@ -1,7 +1,9 @@
 package executor
 
 import (
+	"bytes"
 	"fmt"
+	"strings"
 	"testing"
 
 	"github.com/maratkarimov/kubertino/internal/config"
@@ -147,6 +149,188 @@ func TestPrepareLocal_EnvironmentPreservation(t *testing.T) {
 	assert.Greater(t, len(cmd.Env), 0, "Environment should be preserved from parent")
 }
 
+// TestPrepareResource tests {{.resource}} and {{.kind}} substitution for non-pod resources
+func TestPrepareResource(t *testing.T) {
+	action := config.Action{
+		Name:    "Restart",
+		Command: "kubectl rollout restart {{.kind}}/{{.resource}} -n {{.namespace}}",
+	}
+	context := config.Context{Name: "production"}
+	resource := k8s.Resource{Kind: k8s.KindDeployment, Name: "web", Status: "2/2 ready"}
+
+	cmd, err := NewExecutor().PrepareResource(action, context, "app", resource, "")
+	require.NoError(t, err)
+
+	compound := cmd.Args[len(cmd.Args)-1]
+	assert.Contains(t, compound, "kubectl rollout restart deployment/web -n app")
+	assert.Contains(t, compound, "Resource:  deployment/web")
+}
+
+func TestPrepareRendered(t *testing.T) {
+	action := config.Action{Name: "Logs", WaitOnExit: true}
+	pod := k8s.PodResource(k8s.Pod{Name: "web-1"})
+
+	cmd := NewExecutor().PrepareRendered(action, "kubectl logs {{.pod}} -n app", config.Context{Name: "production"}, "app", pod, "")
+
+	compound := cmd.Args[len(cmd.Args)-1]
+	assert.Contains(t, compound, "kubectl logs {{.pod}} -n app", "the command is not rendered again")
+	assert.Contains(t, compound, "Pod:       web-1")
+	assert.Contains(t, compound, "cat > /dev/null", "wait-on-exit prompt of the action")
+}
+
+func TestPrepareRendered_ASCII(t *testing.T) {
+	executor := NewExecutor()
+	executor.SetASCII(true)
+
+	cmd := executor.PrepareRendered(config.Action{Name: "Logs"}, "kubectl logs web-1", config.Context{Name: "production"}, "app", k8s.PodResource(k8s.Pod{Name: "web-1"}), "")
+
+	compound := cmd.Args[len(cmd.Args)-1]
+	assert.Contains(t, compound, "+---")
+	assert.Contains(t, compound, "| ")
+	assert.NotContains(t, compound, "╭")
+	assert.NotContains(t, compound, "│")
+}
+
+func TestPrepareBatch(t *testing.T) {
+	action := config.Action{
+		Name:       "Console",
+		Command:    "kubectl exec -n {{.namespace}} {{.pod}} -- bin/rails console",
+		WaitOnExit: true,
+	}
+	context := config.Context{Name: "production"}
+
+	cmd, err := NewExecutor().PrepareBatch(action, context, "app", k8s.Pod{Name: "web-1"}, "")
+	require.NoError(t, err)
+
+	assert.Equal(t, []string{"sh", "-c", "kubectl exec -n app web-1 -- bin/rails console"}, cmd.Args,
+		"no context box or wait prompt around the command")
+
+	_, err = NewExecutor().PrepareBatch(config.Action{Command: "{{.pod"}, context, "app", k8s.Pod{}, "")
+	assert.ErrorContains(t, err, "invalid command template")
+}
+
+func TestPrepareCapture(t *testing.T) {
+	action := config.Action{Name: "Env", Command: "echo {{.kind}}/{{.resource}}; echo oops >&2"}
+	deployment := k8s.Resource{Kind: k8s.KindDeployment, Name: "web"}
+
+	var output bytes.Buffer
+	cmd, err := NewExecutor().PrepareCapture(action, config.Context{Name: "production"}, "app", deployment, k8s.Pod{}, "", &output)
+	require.NoError(t, err)
+	require.NoError(t, cmd.Run())
+
+	assert.Equal(t, "deployment/web\noops\n", output.String(), "stdout and stderr are captured without a context box")
+	assert.Nil(t, cmd.Stdin, "captured commands get no terminal input")
+}
+
+// TestTemplateData tests the variables exposed to action templates
+func TestTemplateData(t *testing.T) {
+	context := config.Context{Name: "production"}
+
+	pod := k8s.Pod{
+		Name:       "web-1",
+		Status:     "Running",
+		Containers: []string{"app", "proxy"},
+		Labels:     map[string]string{"app": "web"},
+		Node:       "node-a",
+	}
+	podData := templateData(context, "app", k8s.PodResource(pod), pod, "/etc/kubeconfig")
+	assert.Equal(t, "web-1", podData["pod"])
+	assert.Equal(t, "web-1", podData["resource"])
+	assert.Equal(t, "pod", podData["kind"])
+	assert.Equal(t, "Running", podData["status"])
+	assert.Equal(t, "app", podData["container"])
+	assert.Equal(t, "/etc/kubeconfig", podData["kubeconfig"])
+	assert.Equal(t, "node-a", podData["node"])
+	assert.Equal(t, map[string]string{"app": "web"}, podData["labels"])
+
+	job := k8s.Resource{Kind: k8s.KindJob, Name: "migrate", Status: "Complete"}
+	jobData := templateData(context, "app", job, k8s.Pod{}, "")
+	assert.Equal(t, "", jobData["pod"], "pod is empty for non-pod resources")
+	assert.Equal(t, "migrate", jobData["resource"])
+	assert.Equal(t, "job", jobData["kind"])
+	assert.Equal(t, "Complete", jobData["status"])
+	assert.Equal(t, "", jobData["container"])
+	assert.NotNil(t, jobData["labels"], "labels is an empty map so {{.labels.<key>}} renders empty")
+}
+
+// TestCommand_Params tests {{.params.<name>}} with entered values and defaults
+func TestCommand_Params(t *testing.T) {
+	action := config.Action{
+		Command: "kubectl logs {{.pod}} --tail {{.params.lines}} {{.params.grep}}",
+		Params:  []config.ActionParam{{Name: "lines", Default: "100"}, {Name: "grep"}},
+	}
+	pod := k8s.Pod{Name: "web-1"}
+	e := NewExecutor()
+
+	command, err := e.Command(action, config.Context{Name: "dev"}, "app", k8s.PodResource(pod), pod, "", map[string]string{"lines": "20", "grep": "| grep ERROR"})
+	require.NoError(t, err)
+	assert.Equal(t, "kubectl logs web-1 --tail 20 | grep ERROR", command)
+
+	command, err = e.Command(action, config.Context{Name: "dev"}, "app", k8s.PodResource(pod), pod, "", nil)
+	require.NoError(t, err)
+	assert.Equal(t, "kubectl logs web-1 --tail 100 ", command, "defaults when no values are given")
+}
+
+// TestCommand_Vars tests {{.vars.<name>}} with captured and unset variables
+func TestCommand_Vars(t *testing.T) {
+	action := config.Action{Command: "ping -c 1 {{.vars.POD_IP}}{{.vars.PORT}}"}
+	e := NewExecutor()
+
+	command, err := e.Command(action, config.Context{Name: "dev"}, "app", k8s.Resource{}, k8s.Pod{}, "", nil)
+	require.NoError(t, err)
+	assert.Equal(t, "ping -c 1 ", command, "unset variables render empty")
+
+	e.Vars().Set("POD_IP", "10.1.2.3")
+	command, err = e.Command(action, config.Context{Name: "dev"}, "app", k8s.Resource{}, k8s.Pod{}, "", nil)
+	require.NoError(t, err)
+	assert.Equal(t, "ping -c 1 10.1.2.3", command)
+}
+
+// TestPrepareRendered_CaptureVar tests that only the command's own output reaches stdout
+func TestPrepareRendered_CaptureVar(t *testing.T) {
+	action := config.Action{Name: "Pod IP", CaptureVar: "POD_IP", WaitOnExit: true}
+	cmd := NewExecutor().PrepareRendered(action, "echo 10.1.2.3", config.Context{Name: "dev"}, "app", k8s.PodResource(k8s.Pod{Name: "web-1"}), "")
+
+	var stdout, stderr bytes.Buffer
+	cmd.Stdin = strings.NewReader("")
+	cmd.Stdout, cmd.Stderr = &stdout, &stderr
+	require.NoError(t, cmd.Run())
+	assert.Equal(t, "10.1.2.3\n", stdout.String())
+	assert.Contains(t, stderr.String(), "web-1", "the context box goes to stderr")
+	assert.Contains(t, stderr.String(), "Press Ctrl+D", "and so does the wait prompt")
+}
+
+// TestDefaultContainer tests the container picked for {{.container}}
+func TestDefaultContainer(t *testing.T) {
+	assert.Equal(t, "", defaultContainer(k8s.Pod{}))
+	assert.Equal(t, "app", defaultContainer(k8s.Pod{Containers: []string{"app", "proxy"}}))
+	assert.Equal(t, "proxy", defaultContainer(k8s.Pod{
+		Containers:  []string{"istio-init", "proxy"},
+		Annotations: map[string]string{"kubectl.kubernetes.io/default-container": "proxy"},
+	}))
+}
+
+// TestPrepareLocal_PodMetadataVariables tests substitution of the pod metadata variables
+func TestPrepareLocal_PodMetadataVariables(t *testing.T) {
+	action := config.Action{
+		Name:    "Logs",
+		Command: `kubectl --kubeconfig {{.kubeconfig}} logs {{.pod}} -c {{.container}} # {{.status}} {{.node}} {{.labels.app}} {{index .labels "app.kubernetes.io/name"}} [{{.labels.missing}}]`,
+	}
+	pod := k8s.Pod{
+		Name:       "web-1",
+		Status:     "Running",
+		Containers: []string{"app"},
+		Labels:     map[string]string{"app": "web", "app.kubernetes.io/name": "storefront"},
+		Node:       "node-a",
+	}
+
+	cmd, err := NewExecutor().PrepareLocal(action, config.Context{Name: "production"}, "app", pod, "/etc/kubeconfig")
+	require.NoError(t, err)
+
+	compound := cmd.Args[len(cmd.Args)-1]
+	assert.Contains(t, compound, "kubectl --kubeconfig /etc/kubeconfig logs web-1 -c app # Running node-a web storefront []")
+}
+
 // TestExecuteLocal tests the ExecuteLocal method with various scenarios
 func TestExecuteLocal(t *testing.T) {
 	tests := []struct {
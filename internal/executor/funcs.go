@@ -0,0 +1,40 @@
+package executor
+
+import (
+	"fmt"
+	"text/template"
+	"time"
+)
+
+// timestampLayout formats {{timestamp}} when no layout is given; it sorts and is safe in
+// file names
+const timestampLayout = "20060102-150405"
+
+// templateFuncs returns the helper functions available to action command templates. A fresh
+// set is needed per rendered command: {{freePort}} and {{timestamp}} yield the same value
+// everywhere in one command, so a port-forward and the URL opened next to it agree.
+func templateFuncs() template.FuncMap {
+	var port int
+	now := time.Now()
+	return template.FuncMap{
+		"freePort": func() (int, error) {
+			if port == 0 {
+				free, err := freeLocalPort()
+				if err != nil {
+					return 0, fmt.Errorf("failed to find a free local port: %w", err)
+				}
+				port = free
+			}
+			return port, nil
+		},
+		"timestamp": func(layout ...string) (string, error) {
+			switch len(layout) {
+			case 0:
+				return now.Format(timestampLayout), nil
+			case 1:
+				return now.Format(layout[0]), nil
+			}
+			return "", fmt.Errorf("timestamp takes at most one layout, got %d", len(layout))
+		},
+	}
+}
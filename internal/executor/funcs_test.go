@@ -0,0 +1,56 @@
+package executor
+
+import (
+	"regexp"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/maratkarimov/kubertino/internal/config"
+	"github.com/maratkarimov/kubertino/internal/k8s"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTemplateFuncs_FreePort(t *testing.T) {
+	action := config.Action{Command: "kubectl port-forward {{.pod}} {{freePort}}:8080 & open http://localhost:{{freePort}}"}
+	pod := k8s.Pod{Name: "web-1"}
+
+	command, err := NewExecutor().Command(action, config.Context{Name: "dev"}, "app", k8s.PodResource(pod), pod, "", nil)
+	require.NoError(t, err)
+
+	ports := regexp.MustCompile(`(\d+):8080 .*localhost:(\d+)`).FindStringSubmatch(command)
+	require.Len(t, ports, 3, command)
+	assert.Equal(t, ports[1], ports[2], "one port per command")
+	port, err := strconv.Atoi(ports[1])
+	require.NoError(t, err)
+	assert.Positive(t, port)
+}
+
+func TestTemplateFuncs_Timestamp(t *testing.T) {
+	render := func(command string) string {
+		t.Helper()
+		rendered, err := renderCommand(command, map[string]any{})
+		require.NoError(t, err)
+		return rendered
+	}
+
+	stamp := render("dump-{{timestamp}}.sql")
+	assert.Regexp(t, `^dump-\d{8}-\d{6}\.sql$`, stamp)
+
+	date := render(`{{timestamp "2006-01-02"}}`)
+	_, err := time.Parse("2006-01-02", date)
+	assert.NoError(t, err)
+
+	_, err = renderCommand(`{{timestamp "a" "b"}}`, map[string]any{})
+	assert.ErrorContains(t, err, "at most one layout")
+}
+
+func TestCheckCommand_TemplateFuncs(t *testing.T) {
+	action := config.Action{Name: "Dump", Command: "pg_dump > dump-{{.pod}}-{{timestamp}}.sql; echo {{freePort}}"}
+
+	command, err := NewExecutor().CheckCommand(action, config.Context{Name: "dev"}, "")
+	require.NoError(t, err)
+	assert.True(t, strings.HasPrefix(command, "pg_dump > dump-sample-pod-"), command)
+}
@@ -0,0 +1,57 @@
+package executor
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os/exec"
+	"strings"
+	"time"
+
+	"github.com/maratkarimov/kubertino/internal/config"
+)
+
+// hookTimeout bounds how long an on_enter or on_exit hook may run
+var hookTimeout = 30 * time.Second
+
+// RunHook runs a context hook command (on_enter or on_exit) without a terminal and waits for
+// it, for at most 30 seconds. {{.context}} and {{.kubeconfig}} are substituted in hook.
+// A failure's error ends with the last line the hook printed.
+func (e *Executor) RunHook(hook string, ctx config.Context, kubeconfigPath string) error {
+	command, err := renderCommand(hook, map[string]any{
+		"context":    ctx.Name,
+		"kubeconfig": expandKubeconfig(kubeconfigPath),
+	})
+	if err != nil {
+		return err
+	}
+
+	timeout, cancel := context.WithTimeout(context.Background(), hookTimeout)
+	defer cancel()
+
+	cmd := exec.CommandContext(timeout, "sh", "-c", command)
+	cmd.Env = commandEnv(kubeconfigPath) // Preserve parent environment
+	// Background processes started by the hook must not keep it waiting for their output
+	cmd.WaitDelay = time.Second
+	output, err := cmd.CombinedOutput()
+	if errors.Is(timeout.Err(), context.DeadlineExceeded) {
+		return fmt.Errorf("timed out after %s", hookTimeout)
+	}
+	if errors.Is(err, exec.ErrWaitDelay) {
+		// The hook itself succeeded and left a background process running
+		err = nil
+	}
+	if err != nil {
+		if line := lastLine(string(output)); line != "" {
+			return fmt.Errorf("%w: %s", err, line)
+		}
+		return err
+	}
+	return nil
+}
+
+// lastLine returns the last non-empty line of output
+func lastLine(output string) string {
+	lines := strings.Split(strings.TrimSpace(output), "\n")
+	return strings.TrimSpace(lines[len(lines)-1])
+}
@@ -0,0 +1,45 @@
+package executor
+
+import (
+	"testing"
+	"time"
+
+	"github.com/maratkarimov/kubertino/internal/config"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRunHook(t *testing.T) {
+	ctx := config.Context{Name: "production"}
+	e := NewExecutor()
+
+	t.Run("success", func(t *testing.T) {
+		assert.NoError(t, e.RunHook(`test "{{.context}}" = production && test "$KUBECONFIG" = /etc/kubeconfig`, ctx, "/etc/kubeconfig"))
+	})
+
+	t.Run("background process", func(t *testing.T) {
+		start := time.Now()
+		assert.NoError(t, e.RunHook("sleep 5 &", ctx, ""))
+		assert.Less(t, time.Since(start), 3*time.Second, "background processes do not block the hook")
+	})
+
+	t.Run("failure reports last output line", func(t *testing.T) {
+		err := e.RunHook("echo checking VPN; echo 'VPN is down' >&2; exit 2", ctx, "")
+		require.Error(t, err)
+		assert.Equal(t, "exit status 2: VPN is down", err.Error())
+	})
+
+	t.Run("invalid template", func(t *testing.T) {
+		assert.ErrorContains(t, e.RunHook("echo {{.context", ctx, ""), "invalid command template")
+	})
+
+	t.Run("timeout", func(t *testing.T) {
+		defer func(timeout time.Duration) { hookTimeout = timeout }(hookTimeout)
+		hookTimeout = 50 * time.Millisecond
+
+		start := time.Now()
+		err := e.RunHook("sleep 5", ctx, "")
+		assert.ErrorContains(t, err, "timed out after 50ms")
+		assert.Less(t, time.Since(start), 3*time.Second)
+	})
+}
@@ -0,0 +1,237 @@
+package executor
+
+import (
+	"fmt"
+	"io"
+	"log/slog"
+	"os"
+	"os/exec"
+	"strings"
+	"sync"
+	"time"
+)
+
+// tailBytes is how much of the end of a job's output Tail reads
+const tailBytes = 16 << 10
+
+// Job is an action command running in the background (background: true): detached from the
+// terminal, with stdout and stderr written to a log file
+type Job struct {
+	ID        int
+	Action    string
+	Context   string
+	Namespace string
+	Target    string
+	Command   string // Rendered command
+	Started   time.Time
+	LogPath   string
+
+	cmd      *exec.Cmd
+	done     chan struct{}
+	err      error
+	finished time.Time
+}
+
+// Done returns a channel that is closed when the job's process exits
+func (j *Job) Done() <-chan struct{} {
+	return j.done
+}
+
+// Running reports whether the job's process is still alive
+func (j *Job) Running() bool {
+	select {
+	case <-j.done:
+		return false
+	default:
+		return true
+	}
+}
+
+// Err returns the exit error of the process once it has exited
+func (j *Job) Err() error {
+	if j.Running() {
+		return nil
+	}
+	return j.err
+}
+
+// Duration returns how long the job has been running, or ran once it has exited
+func (j *Job) Duration(now time.Time) time.Duration {
+	if j.Running() {
+		return now.Sub(j.Started)
+	}
+	return j.finished.Sub(j.Started)
+}
+
+// Tail returns up to n last lines of the job's output
+func (j *Job) Tail(n int) ([]string, error) {
+	file, err := os.Open(j.LogPath)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open job output: %w", err)
+	}
+	defer file.Close()
+
+	info, err := file.Stat()
+	if err != nil {
+		return nil, fmt.Errorf("failed to read job output: %w", err)
+	}
+	offset := max(info.Size()-tailBytes, 0)
+	if _, err := file.Seek(offset, io.SeekStart); err != nil {
+		return nil, fmt.Errorf("failed to read job output: %w", err)
+	}
+	data, err := io.ReadAll(file)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read job output: %w", err)
+	}
+
+	lines := strings.Split(strings.TrimRight(string(data), "\n"), "\n")
+	if offset > 0 {
+		lines = lines[1:] // Starts mid-line
+	}
+	if len(lines) == 1 && lines[0] == "" {
+		return nil, nil
+	}
+	return lines[max(len(lines)-n, 0):], nil
+}
+
+// JobManager starts, tracks and stops background jobs
+type JobManager struct {
+	mu     sync.Mutex
+	nextID int
+	jobs   []*Job
+}
+
+// NewJobManager creates a new JobManager
+func NewJobManager() *JobManager {
+	return &JobManager{nextID: 1}
+}
+
+// Start runs the rendered command of job in the background with its output written to a
+// temporary log file. job describes what runs; its ID, Started and LogPath are set here.
+func (m *JobManager) Start(job Job, kubeconfigPath string) (*Job, error) {
+	file, err := os.CreateTemp("", "kubertino-job-*.log")
+	if err != nil {
+		return nil, fmt.Errorf("failed to create job output file: %w", err)
+	}
+	// The command writes through its own copy of the descriptor
+	defer file.Close()
+
+	cmd := exec.Command("sh", "-c", job.Command)
+	cmd.Env = commandEnv(kubeconfigPath) // Preserve parent environment
+	cmd.Stdout = file
+	cmd.Stderr = file
+	// Killing the job must also stop kubectl and whatever else the command started
+	setProcessGroup(cmd)
+	if err := cmd.Start(); err != nil {
+		os.Remove(file.Name())
+		return nil, fmt.Errorf("failed to start job: %w", err)
+	}
+
+	m.mu.Lock()
+	j := &job
+	j.ID = m.nextID
+	j.Started = time.Now()
+	j.LogPath = file.Name()
+	j.cmd = cmd
+	j.done = make(chan struct{})
+	m.nextID++
+	m.jobs = append(m.jobs, j)
+	m.mu.Unlock()
+
+	slog.Info("job started", "id", j.ID, "action", j.Action, "target", j.Target, "path", j.LogPath)
+
+	go func() {
+		j.err = cmd.Wait()
+		j.finished = time.Now()
+		close(j.done)
+		slog.Info("job exited", "id", j.ID, "action", j.Action, "error", j.err)
+	}()
+
+	return j, nil
+}
+
+// List returns a snapshot of tracked jobs in start order
+func (m *JobManager) List() []*Job {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	result := make([]*Job, len(m.jobs))
+	copy(result, m.jobs)
+	return result
+}
+
+// Running returns the number of jobs whose process is still alive
+func (m *JobManager) Running() int {
+	count := 0
+	for _, job := range m.List() {
+		if job.Running() {
+			count++
+		}
+	}
+	return count
+}
+
+// get returns the tracked job with the given ID
+func (m *JobManager) get(id int) (*Job, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	for _, job := range m.jobs {
+		if job.ID == id {
+			return job, nil
+		}
+	}
+	return nil, fmt.Errorf("job %d not found", id)
+}
+
+// Kill stops the job with the given ID and the processes it started, and waits for it to
+// exit. The job stays listed with its output.
+func (m *JobManager) Kill(id int) error {
+	job, err := m.get(id)
+	if err != nil {
+		return err
+	}
+	if job.Running() {
+		StopProcess(job.cmd)
+		<-job.done
+		slog.Info("job killed", "id", id, "action", job.Action)
+	}
+	return nil
+}
+
+// Remove stops tracking the finished job with the given ID and deletes its output
+func (m *JobManager) Remove(id int) error {
+	job, err := m.get(id)
+	if err != nil {
+		return err
+	}
+	if job.Running() {
+		return fmt.Errorf("job %d is still running", id)
+	}
+
+	m.mu.Lock()
+	for i, j := range m.jobs {
+		if j.ID == id {
+			m.jobs = append(m.jobs[:i], m.jobs[i+1:]...)
+			break
+		}
+	}
+	m.mu.Unlock()
+
+	if err := os.Remove(job.LogPath); err != nil && !os.IsNotExist(err) {
+		slog.Warn("failed to remove job output", "path", job.LogPath, "error", err)
+	}
+	return nil
+}
+
+// StopAll kills every running job and removes all job output (used on application exit)
+func (m *JobManager) StopAll() {
+	for _, job := range m.List() {
+		if err := m.Kill(job.ID); err != nil {
+			slog.Warn("failed to kill job", "id", job.ID, "error", err)
+		}
+		if err := m.Remove(job.ID); err != nil {
+			slog.Warn("failed to remove job", "id", job.ID, "error", err)
+		}
+	}
+}
@@ -0,0 +1,82 @@
+package executor
+
+import (
+	"os"
+	"os/exec"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// waitJob waits for the job's process to exit
+func waitJob(t *testing.T, job *Job) {
+	t.Helper()
+	select {
+	case <-job.Done():
+	case <-time.After(5 * time.Second):
+		t.Fatal("job did not exit")
+	}
+}
+
+func TestJobManager_RunsInBackground(t *testing.T) {
+	m := NewJobManager()
+
+	job, err := m.Start(Job{Action: "Seed", Target: "web-1", Command: "for i in 1 2 3; do echo line $i; done; exit 3"}, "")
+	require.NoError(t, err)
+	assert.Equal(t, 1, job.ID)
+	assert.Equal(t, "Seed", job.Action)
+	assert.FileExists(t, job.LogPath)
+
+	waitJob(t, job)
+	assert.False(t, job.Running())
+	var exitErr *exec.ExitError
+	require.ErrorAs(t, job.Err(), &exitErr)
+	assert.Equal(t, 3, exitErr.ExitCode())
+	assert.Equal(t, 0, m.Running())
+
+	tail, err := job.Tail(2)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"line 2", "line 3"}, tail)
+
+	require.NoError(t, m.Remove(job.ID))
+	assert.Empty(t, m.List())
+	assert.NoFileExists(t, job.LogPath)
+}
+
+func TestJobManager_Kill(t *testing.T) {
+	m := NewJobManager()
+
+	job, err := m.Start(Job{Action: "Watch", Command: "echo started; sleep 30"}, "")
+	require.NoError(t, err)
+	assert.True(t, job.Running())
+	assert.Equal(t, 1, m.Running())
+	assert.ErrorContains(t, m.Remove(job.ID), "still running")
+
+	require.NoError(t, m.Kill(job.ID))
+	assert.False(t, job.Running())
+	assert.Error(t, job.Err())
+	assert.Len(t, m.List(), 1, "killed jobs stay listed")
+	assert.ErrorContains(t, m.Kill(42), "job 42 not found")
+
+	m.StopAll()
+	assert.Empty(t, m.List())
+	assert.NoFileExists(t, job.LogPath)
+}
+
+func TestJob_TailOfLargeOutput(t *testing.T) {
+	path := t.TempDir() + "/job.log"
+	long := strings.Repeat("x", tailBytes)
+	require.NoError(t, os.WriteFile(path, []byte(long+"\nlast\n"), 0o600))
+
+	tail, err := (&Job{LogPath: path}).Tail(5)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"last"}, tail, "the partial first line is dropped")
+
+	require.NoError(t, os.WriteFile(path, nil, 0o600))
+	tail, err = (&Job{LogPath: path}).Tail(5)
+	require.NoError(t, err)
+	assert.Empty(t, tail)
+}
@@ -0,0 +1,184 @@
+package executor
+
+import (
+	"errors"
+	"fmt"
+	"log/slog"
+	"net"
+	"os"
+	"os/exec"
+	"sync"
+)
+
+// PortForward is a kubectl port-forward process running in the background
+type PortForward struct {
+	ID         int
+	Context    string
+	Namespace  string
+	Pod        string
+	LocalPort  int
+	RemotePort int
+
+	cmd  *exec.Cmd
+	done chan struct{}
+	err  error
+}
+
+// Done returns a channel that is closed when the port-forward process exits
+func (p *PortForward) Done() <-chan struct{} {
+	return p.done
+}
+
+// Running reports whether the port-forward process is still alive
+func (p *PortForward) Running() bool {
+	select {
+	case <-p.done:
+		return false
+	default:
+		return true
+	}
+}
+
+// Err returns the exit error of the process once it has stopped
+func (p *PortForward) Err() error {
+	if p.Running() {
+		return nil
+	}
+	return p.err
+}
+
+// PortForwardManager starts, tracks and stops background port-forward processes
+type PortForwardManager struct {
+	mu       sync.Mutex
+	nextID   int
+	forwards []*PortForward
+
+	// newCommand builds the process for a port-forward (replaced in tests)
+	newCommand func(name string, args ...string) *exec.Cmd
+}
+
+// NewPortForwardManager creates a new PortForwardManager
+func NewPortForwardManager() *PortForwardManager {
+	return &PortForwardManager{
+		nextID:     1,
+		newCommand: exec.Command,
+	}
+}
+
+// NewPortForwardManagerWithCommand creates a PortForwardManager that builds processes with
+// newCommand instead of exec.Command (used by tests to avoid spawning kubectl)
+func NewPortForwardManagerWithCommand(newCommand func(name string, args ...string) *exec.Cmd) *PortForwardManager {
+	m := NewPortForwardManager()
+	m.newCommand = newCommand
+	return m
+}
+
+// Start launches kubectl port-forward for the given pod in the background.
+// A free local port is chosen automatically and forwarded to remotePort.
+func (m *PortForwardManager) Start(context, namespace, pod string, remotePort int, kubeconfigPath string) (*PortForward, error) {
+	if remotePort <= 0 || remotePort > 65535 {
+		return nil, fmt.Errorf("invalid remote port %d", remotePort)
+	}
+
+	localPort, err := freeLocalPort()
+	if err != nil {
+		return nil, fmt.Errorf("failed to find free local port: %w", err)
+	}
+
+	args := []string{
+		"--context", context,
+		"-n", namespace,
+		"port-forward",
+		"pod/" + pod,
+		fmt.Sprintf("%d:%d", localPort, remotePort),
+	}
+	cmd := m.newCommand("kubectl", args...)
+	cmd.Env = commandEnv(kubeconfigPath)
+
+	if err := cmd.Start(); err != nil {
+		return nil, fmt.Errorf("failed to start port-forward: %w", err)
+	}
+
+	m.mu.Lock()
+	pf := &PortForward{
+		ID:         m.nextID,
+		Context:    context,
+		Namespace:  namespace,
+		Pod:        pod,
+		LocalPort:  localPort,
+		RemotePort: remotePort,
+		cmd:        cmd,
+		done:       make(chan struct{}),
+	}
+	m.nextID++
+	m.forwards = append(m.forwards, pf)
+	m.mu.Unlock()
+
+	slog.Info("port-forward started", "id", pf.ID, "pod", pod, "namespace", namespace, "local", localPort, "remote", remotePort)
+
+	go func() {
+		pf.err = cmd.Wait()
+		close(pf.done)
+		slog.Info("port-forward exited", "id", pf.ID, "pod", pod, "error", pf.err)
+	}()
+
+	return pf, nil
+}
+
+// List returns a snapshot of tracked port-forwards in start order
+func (m *PortForwardManager) List() []*PortForward {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	result := make([]*PortForward, len(m.forwards))
+	copy(result, m.forwards)
+	return result
+}
+
+// Stop terminates the port-forward with the given ID and stops tracking it
+func (m *PortForwardManager) Stop(id int) error {
+	m.mu.Lock()
+	var pf *PortForward
+	for i, f := range m.forwards {
+		if f.ID == id {
+			pf = f
+			m.forwards = append(m.forwards[:i], m.forwards[i+1:]...)
+			break
+		}
+	}
+	m.mu.Unlock()
+
+	if pf == nil {
+		return fmt.Errorf("port-forward %d not found", id)
+	}
+
+	if pf.Running() {
+		if err := pf.cmd.Process.Kill(); err != nil && !errors.Is(err, os.ErrProcessDone) {
+			return fmt.Errorf("failed to stop port-forward %d: %w", id, err)
+		}
+		<-pf.done
+	}
+
+	slog.Info("port-forward stopped", "id", id, "pod", pf.Pod)
+	return nil
+}
+
+// StopAll terminates every tracked port-forward (used on application exit)
+func (m *PortForwardManager) StopAll() {
+	for _, pf := range m.List() {
+		if err := m.Stop(pf.ID); err != nil {
+			slog.Warn("failed to stop port-forward", "id", pf.ID, "error", err)
+		}
+	}
+}
+
+// freeLocalPort asks the OS for an unused TCP port on localhost
+func freeLocalPort() (int, error) {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		return 0, err
+	}
+	defer listener.Close()
+
+	return listener.Addr().(*net.TCPAddr).Port, nil
+}
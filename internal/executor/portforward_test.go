@@ -0,0 +1,76 @@
+package executor
+
+import (
+	"os/exec"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// newTestPortForwardManager returns a manager that runs the given command instead of kubectl
+func newTestPortForwardManager(name string, args ...string) *PortForwardManager {
+	return NewPortForwardManagerWithCommand(func(string, ...string) *exec.Cmd {
+		return exec.Command(name, args...)
+	})
+}
+
+func TestPortForwardManager_StartAndStop(t *testing.T) {
+	m := newTestPortForwardManager("sleep", "30")
+
+	pf, err := m.Start("dev", "default", "web-1", 8080, "")
+	require.NoError(t, err)
+
+	assert.Equal(t, 1, pf.ID)
+	assert.Equal(t, 8080, pf.RemotePort)
+	assert.Greater(t, pf.LocalPort, 0, "local port should be allocated")
+	assert.True(t, pf.Running())
+	assert.Len(t, m.List(), 1)
+
+	require.NoError(t, m.Stop(pf.ID))
+	assert.False(t, pf.Running(), "process should be terminated")
+	assert.Empty(t, m.List(), "stopped forward should no longer be tracked")
+}
+
+func TestPortForwardManager_ProcessExit(t *testing.T) {
+	m := newTestPortForwardManager("false")
+
+	pf, err := m.Start("dev", "default", "web-1", 8080, "")
+	require.NoError(t, err)
+
+	select {
+	case <-pf.Done():
+	case <-time.After(5 * time.Second):
+		t.Fatal("port-forward did not exit")
+	}
+
+	assert.False(t, pf.Running())
+	assert.Error(t, pf.Err(), "non-zero exit should be reported")
+	assert.Len(t, m.List(), 1, "exited forward stays listed until stopped")
+	assert.NoError(t, m.Stop(pf.ID))
+}
+
+func TestPortForwardManager_Errors(t *testing.T) {
+	m := newTestPortForwardManager("sleep", "30")
+
+	_, err := m.Start("dev", "default", "web-1", 0, "")
+	assert.Error(t, err, "invalid remote port should be rejected")
+
+	assert.Error(t, m.Stop(42), "unknown ID should return an error")
+}
+
+func TestPortForwardManager_StopAll(t *testing.T) {
+	m := newTestPortForwardManager("sleep", "30")
+
+	first, err := m.Start("dev", "default", "web-1", 8080, "")
+	require.NoError(t, err)
+	second, err := m.Start("dev", "default", "web-2", 9090, "")
+	require.NoError(t, err)
+	assert.Equal(t, first.ID+1, second.ID)
+
+	m.StopAll()
+	assert.Empty(t, m.List())
+	assert.False(t, first.Running())
+	assert.False(t, second.Running())
+}
@@ -0,0 +1,39 @@
+//go:build !windows && !plan9
+
+package executor
+
+import (
+	"os/exec"
+	"syscall"
+)
+
+// setProcessGroup starts cmd in a process group of its own, so that StopProcess also reaches
+// the processes it starts (e.g. kubectl under sh -c). Only for commands without a terminal:
+// a background process group cannot read from it.
+func setProcessGroup(cmd *exec.Cmd) {
+	cmd.SysProcAttr = &syscall.SysProcAttr{Setpgid: true}
+}
+
+// signalProcess sends sig to the process group of cmd when it has one of its own, otherwise
+// to the process. Signal 0 only checks that the processes still exist.
+func signalProcess(cmd *exec.Cmd, sig syscall.Signal) error {
+	if cmd.SysProcAttr != nil && cmd.SysProcAttr.Setpgid {
+		return syscall.Kill(-cmd.Process.Pid, sig)
+	}
+	return cmd.Process.Signal(sig)
+}
+
+// terminateProcess asks the processes of cmd to exit
+func terminateProcess(cmd *exec.Cmd) error {
+	return signalProcess(cmd, syscall.SIGTERM)
+}
+
+// processAlive reports whether the processes of cmd still exist
+func processAlive(cmd *exec.Cmd) bool {
+	return signalProcess(cmd, 0) == nil
+}
+
+// killProcess kills the processes of cmd
+func killProcess(cmd *exec.Cmd) error {
+	return signalProcess(cmd, syscall.SIGKILL)
+}
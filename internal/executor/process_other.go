@@ -0,0 +1,24 @@
+//go:build windows || plan9
+
+package executor
+
+import "os/exec"
+
+// setProcessGroup is a no-op: process groups are not available on this platform
+func setProcessGroup(cmd *exec.Cmd) {}
+
+// terminateProcess kills the process of cmd; there is no graceful termination signal on
+// this platform
+func terminateProcess(cmd *exec.Cmd) error {
+	return cmd.Process.Kill()
+}
+
+// processAlive reports false: terminateProcess has already killed the process
+func processAlive(cmd *exec.Cmd) bool {
+	return false
+}
+
+// killProcess kills the process of cmd
+func killProcess(cmd *exec.Cmd) error {
+	return cmd.Process.Kill()
+}
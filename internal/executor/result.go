@@ -0,0 +1,109 @@
+package executor
+
+import (
+	"errors"
+	"os/exec"
+	"strings"
+	"time"
+)
+
+// ExitCause is why a command that had the terminal ended
+type ExitCause int
+
+const (
+	ExitOK       ExitCause = iota // Exited 0
+	ExitFailed                    // Exited non-zero on its own, e.g. a command that failed
+	ExitDropped                   // The session was cut off: the connection was lost or the remote process killed
+	ExitSignaled                  // The local process was ended by a signal
+	ExitNotRun                    // Could not be started
+)
+
+// killedExitCode is the exit code kubectl exec passes on when the process in the container
+// was killed (128 + SIGKILL), e.g. because it ran out of memory or the pod was deleted
+const killedExitCode = 137
+
+// droppedPatterns are stderr messages of kubectl and its transports when the connection of
+// a session is lost, matched case-insensitively
+var droppedPatterns = []string{
+	"lost connection to pod",
+	"connection reset by peer",
+	"broken pipe",
+	"use of closed network connection",
+	"unexpected eof",
+	"i/o timeout",
+	"websocket: close",
+	"http2: client connection lost",
+	"error dialing backend",
+	"tls handshake timeout",
+	"no route to host",
+	"network is unreachable",
+}
+
+// Result is the outcome of a command that had the terminal
+type Result struct {
+	Cause           ExitCause
+	ExitCode        int    // -1 when the command was ended by a signal or not run
+	Reason          string // Why a session was dropped, or the signal that ended it
+	Err             error  // As returned by running the command; nil for ExitOK
+	Duration        time.Duration
+	Stderr          string // End of stderr, see Run.Stderr
+	StderrTruncated bool
+}
+
+// Result classifies err, returned by running the tracked command, using the end of its
+// stderr. It may be called on a nil Run, for commands that were not tracked.
+func (r *Run) Result(err error) Result {
+	result := Result{Err: err}
+	if r != nil {
+		result.Duration = r.Duration()
+		result.Stderr, result.StderrTruncated = r.Stderr()
+	}
+
+	var exitErr *exec.ExitError
+	switch {
+	case err == nil:
+		result.Cause = ExitOK
+	case !errors.As(err, &exitErr):
+		result.Cause, result.ExitCode = ExitNotRun, -1
+	case exitErr.ExitCode() < 0:
+		result.Cause, result.ExitCode, result.Reason = ExitSignaled, -1, exitErr.String()
+	default:
+		result.Cause, result.ExitCode = ExitFailed, exitErr.ExitCode()
+		if reason := droppedReason(result.ExitCode, result.Stderr); reason != "" {
+			result.Cause, result.Reason = ExitDropped, reason
+		}
+	}
+	return result
+}
+
+// droppedReason explains why a session that exited with code and stderr was cut off, or
+// returns "" when it ended on its own
+func droppedReason(code int, stderr string) string {
+	// Only the last lines: earlier ones may be output of the session itself
+	lines := strings.Split(stderr, "\n")
+	tail := strings.ToLower(strings.Join(lines[max(len(lines)-3, 0):], "\n"))
+	for _, pattern := range droppedPatterns {
+		if strings.Contains(tail, pattern) {
+			return "the connection was lost (" + pattern + ")"
+		}
+	}
+	if code == killedExitCode {
+		return "the process was killed, e.g. out of memory or its pod was deleted"
+	}
+	return ""
+}
+
+// Clone returns a command that runs the same as cmd, with its environment, directory and
+// standard streams, so it can be run again. Clone cmd before TrackRun wraps its stderr.
+func Clone(cmd *exec.Cmd) *exec.Cmd {
+	clone := exec.Command(cmd.Path, cmd.Args[1:]...)
+	clone.Args = append([]string(nil), cmd.Args...)
+	clone.Env = append([]string(nil), cmd.Env...)
+	clone.Dir = cmd.Dir
+	clone.Stdin, clone.Stdout, clone.Stderr = cmd.Stdin, cmd.Stdout, cmd.Stderr
+	if cmd.SysProcAttr != nil {
+		attr := *cmd.SysProcAttr
+		clone.SysProcAttr = &attr
+	}
+	return clone
+}
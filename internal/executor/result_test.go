@@ -0,0 +1,84 @@
+package executor
+
+import (
+	"bytes"
+	"errors"
+	"os/exec"
+	"syscall"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// runScript runs script as a tracked command and returns its result
+func runScript(t *testing.T, script string) Result {
+	t.Helper()
+	cmd := exec.Command("sh", "-c", script)
+	cmd.Stderr = &bytes.Buffer{}
+	run := TrackRun(cmd)
+	err := cmd.Run()
+	run.Finish()
+	return run.Result(err)
+}
+
+func TestRunResult(t *testing.T) {
+	tests := []struct {
+		name   string
+		script string
+		cause  ExitCause
+		code   int
+	}{
+		{"success", "exit 0", ExitOK, 0},
+		{"failed", "echo 'error: pods \"web-1\" not found' >&2; exit 1", ExitFailed, 1},
+		{"connection lost", "echo 'error: lost connection to pod' >&2; exit 1", ExitDropped, 1},
+		{"connection reset", "echo 'read tcp 10.0.0.2:51234->10.0.0.1:443: read: Connection reset by peer' >&2; exit 1", ExitDropped, 1},
+		{"killed in the container", "exit 137", ExitDropped, 137},
+		{"interrupted by the user", "exit 130", ExitFailed, 130},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := runScript(t, tt.script)
+			assert.Equal(t, tt.cause, result.Cause)
+			assert.Equal(t, tt.code, result.ExitCode)
+			assert.Equal(t, tt.cause == ExitDropped, result.Reason != "")
+		})
+	}
+}
+
+func TestRunResult_OnlyTheEndOfStderrCounts(t *testing.T) {
+	// A broken pipe reported early in the session is not why it ended
+	result := runScript(t, "echo 'cat: write error: Broken pipe' >&2; echo one >&2; echo two >&2; echo three >&2; exit 1")
+	assert.Equal(t, ExitFailed, result.Cause)
+}
+
+func TestRunResult_SignaledAndNotRun(t *testing.T) {
+	cmd := exec.Command("sh", "-c", "kill -KILL $$")
+	run := TrackRun(cmd)
+	err := cmd.Run()
+	run.Finish()
+	result := run.Result(err)
+	assert.Equal(t, ExitSignaled, result.Cause)
+	assert.Equal(t, -1, result.ExitCode)
+	assert.Equal(t, "signal: killed", result.Reason)
+
+	var nilRun *Run
+	result = nilRun.Result(errors.New("exec: \"kubectl\": executable file not found in $PATH"))
+	assert.Equal(t, ExitNotRun, result.Cause)
+	assert.Zero(t, result.Duration)
+}
+
+func TestClone(t *testing.T) {
+	var out bytes.Buffer
+	cmd := exec.Command("sh", "-c", "echo $GREETING from $(pwd)")
+	cmd.Env = []string{"GREETING=hello"}
+	cmd.Dir = t.TempDir()
+	cmd.Stdout = &out
+	cmd.SysProcAttr = &syscall.SysProcAttr{}
+
+	clone := Clone(cmd)
+	require.NoError(t, cmd.Run())
+	require.NoError(t, clone.Run(), "runs after the original")
+	assert.Equal(t, "hello from "+cmd.Dir+"\nhello from "+cmd.Dir+"\n", out.String())
+	assert.NotSame(t, cmd.SysProcAttr, clone.SysProcAttr)
+}
@@ -0,0 +1,82 @@
+package executor
+
+import (
+	"bytes"
+	"io"
+	"os/exec"
+	"sync"
+	"time"
+)
+
+// stderrBytes is how much of the end of a command's stderr a Run keeps
+const stderrBytes = 64 << 10
+
+// Run times a command that has the terminal and keeps the end of its stderr, which is still
+// written to the terminal as usual, so it can be viewed once the command has exited
+type Run struct {
+	Started time.Time
+
+	mu       sync.Mutex
+	stderr   []byte
+	dropped  bool // The start of stderr was dropped to stay within stderrBytes
+	finished time.Time
+}
+
+// TrackRun wraps cmd, right before it is started, so that its stderr is also written to the
+// returned Run. The command then writes stderr to a pipe instead of the terminal itself.
+func TrackRun(cmd *exec.Cmd) *Run {
+	run := &Run{Started: time.Now()}
+	if cmd.Stderr == nil {
+		cmd.Stderr = run
+	} else {
+		cmd.Stderr = io.MultiWriter(cmd.Stderr, run)
+	}
+	return run
+}
+
+// Write keeps p, dropping the oldest output beyond stderrBytes
+func (r *Run) Write(p []byte) (int, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.stderr = append(r.stderr, p...)
+	if extra := len(r.stderr) - stderrBytes; extra > 0 {
+		r.stderr = append(r.stderr[:0], r.stderr[extra:]...)
+		r.dropped = true
+	}
+	return len(p), nil
+}
+
+// Finish records that the command has exited
+func (r *Run) Finish() {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if r.finished.IsZero() {
+		r.finished = time.Now()
+	}
+}
+
+// Duration returns how long the command ran, or has been running until Finish
+func (r *Run) Duration() time.Duration {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if r.finished.IsZero() {
+		return time.Since(r.Started)
+	}
+	return r.finished.Sub(r.Started)
+}
+
+// Stderr returns the kept stderr without trailing newlines. When its start was dropped the
+// partial first line is left out too; truncated then reports true.
+func (r *Run) Stderr() (text string, truncated bool) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	data := r.stderr
+	if r.dropped {
+		if i := bytes.IndexByte(data, '\n'); i >= 0 {
+			data = data[i+1:]
+		}
+	}
+	return string(bytes.TrimRight(data, "\r\n")), r.dropped
+}
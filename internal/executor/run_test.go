@@ -0,0 +1,51 @@
+package executor
+
+import (
+	"bytes"
+	"os/exec"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTrackRun_TeesStderr(t *testing.T) {
+	var terminal bytes.Buffer
+	cmd := exec.Command("sh", "-c", "echo out; echo 'error: pod not found' >&2; exit 3")
+	cmd.Stderr = &terminal
+	run := TrackRun(cmd)
+
+	err := cmd.Run()
+	run.Finish()
+	var exitErr *exec.ExitError
+	require.ErrorAs(t, err, &exitErr)
+	assert.Equal(t, 3, exitErr.ExitCode())
+
+	assert.Equal(t, "error: pod not found\n", terminal.String(), "stderr still reaches the terminal")
+	stderr, truncated := run.Stderr()
+	assert.Equal(t, "error: pod not found", stderr, "stdout is not kept")
+	assert.False(t, truncated)
+
+	duration := run.Duration()
+	assert.Positive(t, duration)
+	assert.Equal(t, duration, run.Duration(), "the duration stops at Finish")
+}
+
+func TestRun_KeepsTheEndOfStderr(t *testing.T) {
+	run := TrackRun(exec.Command("true"))
+
+	line := strings.Repeat("x", 99) + "\n"
+	for range stderrBytes/len(line) + 10 {
+		_, err := run.Write([]byte(line))
+		require.NoError(t, err)
+	}
+	_, err := run.Write([]byte("last line\n"))
+	require.NoError(t, err)
+
+	stderr, truncated := run.Stderr()
+	assert.True(t, truncated)
+	assert.LessOrEqual(t, len(stderr), stderrBytes)
+	assert.True(t, strings.HasSuffix(stderr, "\nlast line"))
+	assert.True(t, strings.HasPrefix(stderr, strings.Repeat("x", 99)+"\n"), "starts at a whole line")
+}
@@ -0,0 +1,57 @@
+package executor
+
+import (
+	"fmt"
+	"os"
+	"os/exec"
+	"strings"
+
+	"github.com/maratkarimov/kubertino/internal/config"
+)
+
+// shellScript prints the notice in $1, then replaces itself with the shell in $0
+const shellScript = `printf '%s\n' "$1"; exec "$0"`
+
+// PrepareShell prepares an interactive shell ($SHELL, or sh) for ad-hoc commands against the
+// selection. KUBECONFIG, KUBE_CONTEXT, KUBE_NAMESPACE and KUBE_POD are exported into it; pod
+// may be empty. A notice naming them is printed before the shell starts.
+func (e *Executor) PrepareShell(context config.Context, namespace, pod, kubeconfigPath string) *exec.Cmd {
+	shell := os.Getenv("SHELL")
+	if shell == "" {
+		shell = "sh"
+	}
+
+	target := []string{"context " + context.Name}
+	if namespace != "" {
+		target = append(target, "namespace "+namespace)
+	}
+	if pod != "" {
+		target = append(target, "pod "+pod)
+	}
+	notice := fmt.Sprintf("Kubertino shell for %s. Exit to return.\n"+
+		`Target them with: kubectl --context "$KUBE_CONTEXT" -n "$KUBE_NAMESPACE" ...`, strings.Join(target, ", "))
+
+	cmd := exec.Command("sh", "-c", shellScript, shell, notice)
+	cmd.Env = append(os.Environ(),
+		"KUBECONFIG="+shellKubeconfig(kubeconfigPath),
+		"KUBE_CONTEXT="+context.Name,
+		"KUBE_NAMESPACE="+namespace,
+		"KUBE_POD="+pod,
+	)
+	cmd.Stdin = os.Stdin
+	cmd.Stdout = os.Stdout
+	cmd.Stderr = os.Stderr
+	return cmd
+}
+
+// shellKubeconfig returns the kubeconfig exported into a shell: kubeconfigPath, or else the
+// KUBECONFIG of kubertino or the default location, so that it is always set
+func shellKubeconfig(kubeconfigPath string) string {
+	if kubeconfigPath != "" {
+		return expandKubeconfig(kubeconfigPath)
+	}
+	if env := os.Getenv("KUBECONFIG"); env != "" {
+		return env
+	}
+	return expandKubeconfig(config.DefaultKubeconfigPath)
+}
@@ -0,0 +1,61 @@
+package executor
+
+import (
+	"bytes"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/maratkarimov/kubertino/internal/config"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// envOf returns the value of name in the environment of cmd; later entries win, as in exec
+func envOf(cmd *exec.Cmd, name string) string {
+	value := ""
+	for _, kv := range cmd.Env {
+		if v, ok := strings.CutPrefix(kv, name+"="); ok {
+			value = v
+		}
+	}
+	return value
+}
+
+func TestPrepareShell(t *testing.T) {
+	t.Setenv("SHELL", "/bin/sh")
+	t.Setenv("KUBE_POD", "stale")
+	cmd := NewExecutor().PrepareShell(config.Context{Name: "prod"}, "payments", "api-1", "/etc/kube/prod.yaml")
+
+	assert.Equal(t, "/etc/kube/prod.yaml", envOf(cmd, "KUBECONFIG"))
+	assert.Equal(t, "prod", envOf(cmd, "KUBE_CONTEXT"))
+	assert.Equal(t, "payments", envOf(cmd, "KUBE_NAMESPACE"))
+	assert.Equal(t, "api-1", envOf(cmd, "KUBE_POD"), "replaces the one kubertino was started with")
+
+	// Without a terminal, the shell reads its commands from stdin
+	var out bytes.Buffer
+	cmd.Stdin = strings.NewReader(`echo "$KUBE_CONTEXT/$KUBE_NAMESPACE/$KUBE_POD"` + "\n")
+	cmd.Stdout = &out
+	require.NoError(t, cmd.Run())
+	assert.Equal(t, "Kubertino shell for context prod, namespace payments, pod api-1. Exit to return.\n"+
+		`Target them with: kubectl --context "$KUBE_CONTEXT" -n "$KUBE_NAMESPACE" ...`+"\n"+
+		"prod/payments/api-1\n", out.String())
+}
+
+func TestPrepareShell_Kubeconfig(t *testing.T) {
+	home := t.TempDir()
+	t.Setenv("HOME", home)
+
+	t.Setenv("KUBECONFIG", "")
+	cmd := NewExecutor().PrepareShell(config.Context{Name: "dev"}, "", "", "")
+	assert.Equal(t, filepath.Join(home, ".kube", "config"), envOf(cmd, "KUBECONFIG"), "always exported")
+	assert.Empty(t, envOf(cmd, "KUBE_POD"))
+
+	t.Setenv("KUBECONFIG", "/a:/b")
+	cmd = NewExecutor().PrepareShell(config.Context{Name: "dev"}, "", "", "")
+	assert.Equal(t, "/a:/b", envOf(cmd, "KUBECONFIG"))
+
+	cmd = NewExecutor().PrepareShell(config.Context{Name: "dev"}, "", "", "~/kube/dev.yaml")
+	assert.Equal(t, filepath.Join(home, "kube", "dev.yaml"), envOf(cmd, "KUBECONFIG"))
+}
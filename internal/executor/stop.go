@@ -0,0 +1,35 @@
+package executor
+
+import (
+	"log/slog"
+	"os/exec"
+	"time"
+)
+
+// stopGrace is how long StopProcess waits after asking a command to exit before killing it
+var stopGrace = 2 * time.Second
+
+// StopProcess stops a started command and, where the platform allows, the processes it
+// started: they are asked to exit (SIGTERM) and killed if they are still running after 2
+// seconds. Commands that already exited are left alone. Waiting for cmd stays with its owner.
+func StopProcess(cmd *exec.Cmd) {
+	if cmd == nil || cmd.Process == nil {
+		return
+	}
+	if err := terminateProcess(cmd); err != nil {
+		return // Already gone
+	}
+
+	deadline := time.Now().Add(stopGrace)
+	for time.Now().Before(deadline) {
+		if !processAlive(cmd) {
+			return
+		}
+		time.Sleep(20 * time.Millisecond)
+	}
+
+	slog.Warn("command ignored termination, killing it", "pid", cmd.Process.Pid)
+	if err := killProcess(cmd); err != nil {
+		slog.Warn("failed to kill command", "pid", cmd.Process.Pid, "error", err)
+	}
+}
@@ -0,0 +1,60 @@
+package executor
+
+import (
+	"os"
+	"os/exec"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/maratkarimov/kubertino/internal/config"
+	"github.com/maratkarimov/kubertino/internal/k8s"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestStopProcess(t *testing.T) {
+	// start runs command and waits until it prints "ready"
+	start := func(t *testing.T, command string) (*exec.Cmd, chan error) {
+		t.Helper()
+		output, err := os.CreateTemp(t.TempDir(), "output")
+		require.NoError(t, err)
+		defer output.Close()
+		cmd, err := NewExecutor().PrepareCapture(config.Action{Name: "Test", Command: command + " & echo ready; wait"}, config.Context{Name: "dev"}, "default", k8s.Resource{}, k8s.Pod{}, "", output)
+		require.NoError(t, err)
+		require.NoError(t, cmd.Start())
+		exited := make(chan error, 1)
+		go func() { exited <- cmd.Wait() }()
+
+		require.Eventually(t, func() bool {
+			data, _ := os.ReadFile(output.Name())
+			return strings.Contains(string(data), "ready")
+		}, time.Second, 10*time.Millisecond)
+		return cmd, exited
+	}
+
+	t.Run("stops the processes the command started", func(t *testing.T) {
+		cmd, exited := start(t, "sleep 30")
+
+		StopProcess(cmd)
+		assert.Error(t, <-exited)
+		assert.Eventually(t, func() bool { return !processAlive(cmd) }, time.Second, 10*time.Millisecond, "background sleep is stopped too")
+	})
+
+	t.Run("kills commands ignoring termination", func(t *testing.T) {
+		defer func(grace time.Duration) { stopGrace = grace }(stopGrace)
+		stopGrace = 200 * time.Millisecond
+		cmd, exited := start(t, "trap '' TERM; sleep 30")
+
+		StopProcess(cmd)
+		assert.Error(t, <-exited)
+	})
+
+	t.Run("ignores exited commands", func(t *testing.T) {
+		cmd, exited := start(t, "true")
+		require.NoError(t, <-exited)
+
+		StopProcess(cmd)
+		StopProcess(nil)
+	})
+}
@@ -0,0 +1,85 @@
+package executor
+
+import (
+	"io"
+	"maps"
+	"os/exec"
+	"strings"
+	"sync"
+)
+
+// capturedBytes is how much of the stdout of an action with capture_var is kept
+const capturedBytes = 64 << 10
+
+// Vars are the variables of a session, set from the stdout of actions with capture_var and
+// used by later actions as {{.vars.<name>}}. The zero value is empty and ready to use.
+type Vars struct {
+	mu     sync.Mutex
+	values map[string]string
+}
+
+// Set sets the variable name to value
+func (v *Vars) Set(name, value string) {
+	v.mu.Lock()
+	defer v.mu.Unlock()
+	if v.values == nil {
+		v.values = make(map[string]string)
+	}
+	v.values[name] = value
+}
+
+// Values returns a copy of the variables, for the template data of a command
+func (v *Vars) Values() map[string]string {
+	v.mu.Lock()
+	defer v.mu.Unlock()
+	values := maps.Clone(v.values)
+	if values == nil {
+		values = map[string]string{}
+	}
+	return values
+}
+
+// Capture keeps the stdout of a command that has the terminal, for the variable named Var.
+// The output is still written to the terminal, but through a pipe, so the command does not
+// see a terminal on stdout.
+type Capture struct {
+	Var string
+
+	mu        sync.Mutex
+	stdout    []byte
+	truncated bool // Output beyond capturedBytes was dropped
+}
+
+// CaptureStdout wraps cmd, right before it is started, so that its stdout is also written to
+// the returned Capture for the variable name
+func CaptureStdout(cmd *exec.Cmd, name string) *Capture {
+	capture := &Capture{Var: name}
+	if cmd.Stdout == nil {
+		cmd.Stdout = capture
+	} else {
+		cmd.Stdout = io.MultiWriter(cmd.Stdout, capture)
+	}
+	return capture
+}
+
+// Write keeps p, dropping output beyond capturedBytes
+func (c *Capture) Write(p []byte) (int, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if room := capturedBytes - len(c.stdout); room < len(p) {
+		c.stdout = append(c.stdout, p[:max(room, 0)]...)
+		c.truncated = true
+	} else {
+		c.stdout = append(c.stdout, p...)
+	}
+	return len(p), nil
+}
+
+// Value returns the kept stdout without surrounding whitespace. truncated reports that
+// output beyond its first 64 KiB was dropped.
+func (c *Capture) Value() (value string, truncated bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return strings.TrimSpace(string(c.stdout)), c.truncated
+}
@@ -0,0 +1,49 @@
+package executor
+
+import (
+	"bytes"
+	"os/exec"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCaptureStdout_TeesStdout(t *testing.T) {
+	var terminal bytes.Buffer
+	cmd := exec.Command("sh", "-c", "echo '  10.1.2.3  '; echo warning >&2")
+	cmd.Stdout = &terminal
+	capture := CaptureStdout(cmd, "POD_IP")
+
+	require.NoError(t, cmd.Run())
+	assert.Equal(t, "  10.1.2.3  \n", terminal.String(), "stdout still reaches the terminal")
+	value, truncated := capture.Value()
+	assert.Equal(t, "10.1.2.3", value, "trimmed, without stderr")
+	assert.False(t, truncated)
+	assert.Equal(t, "POD_IP", capture.Var)
+}
+
+func TestCapture_KeepsTheStartOfStdout(t *testing.T) {
+	capture := CaptureStdout(exec.Command("true"), "DUMP")
+
+	_, err := capture.Write([]byte(strings.Repeat("x", capturedBytes-1)))
+	require.NoError(t, err)
+	n, err := capture.Write([]byte("yz"))
+	require.NoError(t, err)
+	assert.Equal(t, 2, n, "the command never sees a short write")
+
+	value, truncated := capture.Value()
+	assert.True(t, truncated)
+	assert.Equal(t, strings.Repeat("x", capturedBytes-1)+"y", value)
+}
+
+func TestVars(t *testing.T) {
+	var vars Vars
+	assert.Equal(t, map[string]string{}, vars.Values())
+
+	vars.Set("POD_IP", "10.1.2.3")
+	values := vars.Values()
+	values["POD_IP"] = "changed"
+	assert.Equal(t, map[string]string{"POD_IP": "10.1.2.3"}, vars.Values(), "values are a copy")
+}
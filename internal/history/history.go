@@ -0,0 +1,168 @@
+// Package history keeps a local record of the actions run from the TUI so they can be
+// browsed, searched and re-run
+package history
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log/slog"
+	"os"
+	"path/filepath"
+	"slices"
+	"time"
+
+	"github.com/maratkarimov/kubertino/internal/audit"
+	"github.com/maratkarimov/kubertino/internal/safefile"
+)
+
+// MaxEntries is the number of entries kept; older entries are dropped when the store is opened
+const MaxEntries = 1000
+
+// Entry describes one executed action
+type Entry struct {
+	Time        time.Time `json:"time"`
+	Context     string    `json:"context"`
+	Namespace   string    `json:"namespace"`
+	Kind        string    `json:"kind"`   // Target kind, e.g. pod or deployment
+	Target      string    `json:"target"` // Target name
+	Action      string    `json:"action"`
+	Command     string    `json:"command"` // Rendered command
+	Destructive bool      `json:"destructive,omitempty"`
+	ExitCode    int       `json:"exit_code"` // -1 when the command could not be run
+	Error       string    `json:"error,omitempty"`
+	DurationMS  int64     `json:"duration_ms"`
+}
+
+// FromRecord returns the history entry for a finished audit record
+func FromRecord(r audit.Record) Entry {
+	return Entry{
+		Time:        r.Time,
+		Context:     r.Context,
+		Namespace:   r.Namespace,
+		Kind:        r.Kind,
+		Target:      r.Target,
+		Action:      r.Action,
+		Command:     r.Command,
+		Destructive: r.Destructive,
+		ExitCode:    r.ExitCode,
+		Error:       r.Error,
+		DurationMS:  r.DurationMS,
+	}
+}
+
+// Failed reports whether the command exited non-zero or could not be run
+func (e Entry) Failed() bool {
+	return e.ExitCode != 0 || e.Error != ""
+}
+
+// Store is the history file, kept in memory once opened. A nil Store records nothing.
+type Store struct {
+	path    string
+	entries []Entry // Oldest first
+}
+
+// DefaultPath returns $XDG_STATE_HOME/kubertino/history.jsonl,
+// falling back to ~/.local/state/kubertino/history.jsonl
+func DefaultPath() (string, error) {
+	if dir := os.Getenv("XDG_STATE_HOME"); dir != "" {
+		return filepath.Join(dir, "kubertino", "history.jsonl"), nil
+	}
+
+	homeDir, err := os.UserHomeDir()
+	if err != nil {
+		return "", fmt.Errorf("failed to get home directory: %w", err)
+	}
+	return filepath.Join(homeDir, ".local", "state", "kubertino", "history.jsonl"), nil
+}
+
+// Open reads the history file at path (JSON Lines, one entry per line). A missing file yields
+// an empty history; malformed lines are skipped. Files grown past MaxEntries are trimmed.
+func Open(path string) (*Store, error) {
+	s := &Store{path: path}
+	data, err := os.ReadFile(path)
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return s, nil
+		}
+		return nil, fmt.Errorf("failed to read history file %s: %w", path, err)
+	}
+
+	scanner := bufio.NewScanner(bytes.NewReader(data))
+	scanner.Buffer(make([]byte, 0, 64*1024), 1024*1024)
+	skipped := 0
+	for scanner.Scan() {
+		if len(bytes.TrimSpace(scanner.Bytes())) == 0 {
+			continue
+		}
+		var entry Entry
+		if err := json.Unmarshal(scanner.Bytes(), &entry); err != nil {
+			skipped++
+			continue
+		}
+		s.entries = append(s.entries, entry)
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("failed to read history file %s: %w", path, err)
+	}
+	if skipped > 0 {
+		slog.Warn("skipped malformed history entries", "path", path, "count", skipped)
+	}
+
+	if len(s.entries) > MaxEntries {
+		s.entries = s.entries[len(s.entries)-MaxEntries:]
+		if err := s.rewrite(); err != nil {
+			slog.Warn("failed to trim history file", "path", path, "error", err)
+		}
+	}
+	return s, nil
+}
+
+// Append records entry in memory and at the end of the history file
+func (s *Store) Append(entry Entry) error {
+	if s == nil {
+		return nil
+	}
+	s.entries = append(s.entries, entry)
+
+	line, err := json.Marshal(entry)
+	if err != nil {
+		return fmt.Errorf("failed to encode history entry: %w", err)
+	}
+	if err := os.MkdirAll(filepath.Dir(s.path), 0700); err != nil {
+		return fmt.Errorf("failed to create history directory: %w", err)
+	}
+	file, err := os.OpenFile(s.path, os.O_CREATE|os.O_APPEND|os.O_WRONLY, 0600)
+	if err != nil {
+		return fmt.Errorf("failed to open history file %s: %w", s.path, err)
+	}
+	defer file.Close()
+	if _, err := file.Write(append(line, '\n')); err != nil {
+		return fmt.Errorf("failed to write history file %s: %w", s.path, err)
+	}
+	return nil
+}
+
+// Entries returns the recorded entries, newest first
+func (s *Store) Entries() []Entry {
+	if s == nil {
+		return nil
+	}
+	entries := slices.Clone(s.entries)
+	slices.Reverse(entries)
+	return entries
+}
+
+// rewrite replaces the history file with the entries kept in memory
+func (s *Store) rewrite() error {
+	var buf bytes.Buffer
+	encoder := json.NewEncoder(&buf)
+	for _, entry := range s.entries {
+		if err := encoder.Encode(entry); err != nil {
+			return fmt.Errorf("failed to encode history entry: %w", err)
+		}
+	}
+	return safefile.WriteFile(s.path, buf.Bytes(), 0600)
+}
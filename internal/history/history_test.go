@@ -0,0 +1,95 @@
+package history
+
+import (
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/maratkarimov/kubertino/internal/audit"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDefaultPath(t *testing.T) {
+	t.Setenv("XDG_STATE_HOME", "/tmp/state")
+	path, err := DefaultPath()
+	require.NoError(t, err)
+	assert.Equal(t, "/tmp/state/kubertino/history.jsonl", path)
+}
+
+func TestStore_AppendAndReopen(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "kubertino", "history.jsonl")
+	store, err := Open(path)
+	require.NoError(t, err)
+	assert.Empty(t, store.Entries())
+
+	first := Entry{Time: time.Unix(100, 0).UTC(), Context: "dev", Namespace: "app", Kind: "pod", Target: "web-1", Action: "Logs", Command: "kubectl logs web-1"}
+	second := Entry{Time: time.Unix(200, 0).UTC(), Context: "dev", Namespace: "app", Kind: "pod", Target: "web-1", Action: "Shell", Command: "kubectl exec -it web-1 -- sh", ExitCode: 130}
+	require.NoError(t, store.Append(first))
+	require.NoError(t, store.Append(second))
+	assert.Equal(t, []Entry{second, first}, store.Entries())
+
+	reopened, err := Open(path)
+	require.NoError(t, err)
+	assert.Equal(t, []Entry{second, first}, reopened.Entries())
+
+	info, err := os.Stat(path)
+	require.NoError(t, err)
+	assert.Equal(t, os.FileMode(0600), info.Mode().Perm())
+}
+
+func TestOpen_SkipsMalformedLines(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "history.jsonl")
+	data := `{"action":"Logs","target":"web-1"}` + "\nnot json\n\n" + `{"action":"Shell","target":"web-2"}` + "\n"
+	require.NoError(t, os.WriteFile(path, []byte(data), 0600))
+
+	store, err := Open(path)
+	require.NoError(t, err)
+	entries := store.Entries()
+	require.Len(t, entries, 2)
+	assert.Equal(t, "Shell", entries[0].Action)
+	assert.Equal(t, "Logs", entries[1].Action)
+}
+
+func TestOpen_TrimsToMaxEntries(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "history.jsonl")
+	var b strings.Builder
+	for range MaxEntries + 10 {
+		b.WriteString(`{"action":"old"}` + "\n")
+	}
+	b.WriteString(`{"action":"newest"}` + "\n")
+	require.NoError(t, os.WriteFile(path, []byte(b.String()), 0600))
+
+	store, err := Open(path)
+	require.NoError(t, err)
+	entries := store.Entries()
+	assert.Len(t, entries, MaxEntries)
+	assert.Equal(t, "newest", entries[0].Action)
+
+	data, err := os.ReadFile(path)
+	require.NoError(t, err)
+	assert.Equal(t, MaxEntries, strings.Count(string(data), "\n"))
+}
+
+func TestNilStore(t *testing.T) {
+	var store *Store
+	assert.NoError(t, store.Append(Entry{Action: "Logs"}))
+	assert.Nil(t, store.Entries())
+}
+
+func TestFromRecord(t *testing.T) {
+	err := exec.Command("sh", "-c", "exit 3").Run()
+	record := audit.Record{Context: "dev", Namespace: "app", Kind: "pod", Target: "web-1", Action: "Migrate", Command: "rake db:migrate", Destructive: true}.Finish(err)
+
+	entry := FromRecord(record)
+	assert.Equal(t, "Migrate", entry.Action)
+	assert.Equal(t, "rake db:migrate", entry.Command)
+	assert.True(t, entry.Destructive)
+	assert.Equal(t, 3, entry.ExitCode)
+	assert.True(t, entry.Failed())
+	assert.False(t, FromRecord(audit.Record{}.Finish(nil)).Failed())
+	assert.True(t, Entry{ExitCode: -1, Error: "exec: not found"}.Failed())
+}
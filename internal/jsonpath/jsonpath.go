@@ -0,0 +1,334 @@
+// Package jsonpath evaluates the JSONPath expressions of custom pod columns, a subset of the
+// syntax kubectl -o jsonpath accepts, on JSON decoded into Go values
+package jsonpath
+
+import (
+	"encoding/json"
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+)
+
+// Path is a parsed JSONPath expression, e.g. .spec.containers[0].image
+type Path struct {
+	expr  string
+	steps []step
+}
+
+// stepKind is what a step of a path selects
+type stepKind int
+
+const (
+	stepField    stepKind = iota // .name or ['name']: a field of an object
+	stepIndex                    // [n]: an element of an array, counted from the end when negative
+	stepWildcard                 // [*] or .*: every element of an array or field of an object
+	stepFilter                   // [?(@.type=="Ready")]: the array elements matching a condition
+)
+
+// step is one selection of a path
+type step struct {
+	kind   stepKind
+	name   string
+	index  int
+	filter *filter
+}
+
+// filter is the condition of a filter step: a path relative to the element (@) that must
+// exist or, with an operator, compare to a literal
+type filter struct {
+	path  *Path
+	op    string // "==", "!=" or "" for existence
+	value any    // string, float64 or bool
+}
+
+// Parse parses expr. The braces and leading $ kubectl expects are optional: {.metadata.name},
+// $.metadata.name and .metadata.name are the same path. Field names containing dots are
+// written in brackets (.metadata.labels['app.kubernetes.io/name']) or with escaped dots.
+func Parse(expr string) (*Path, error) {
+	text := strings.TrimSpace(expr)
+	if strings.HasPrefix(text, "{") && strings.HasSuffix(text, "}") {
+		text = strings.TrimSpace(text[1 : len(text)-1])
+	}
+	text = strings.TrimPrefix(text, "$")
+	if text == "" {
+		return nil, fmt.Errorf("empty expression")
+	}
+
+	steps, err := parseSteps(text)
+	if err != nil {
+		return nil, fmt.Errorf("%q: %w", expr, err)
+	}
+	return &Path{expr: expr, steps: steps}, nil
+}
+
+// String returns the expression the path was parsed from
+func (p *Path) String() string {
+	return p.expr
+}
+
+// parseSteps parses the steps of a path after its $ or @
+func parseSteps(text string) ([]step, error) {
+	var steps []step
+	for i := 0; i < len(text); {
+		switch text[i] {
+		case '.':
+			i++
+			if i < len(text) && text[i] == '.' {
+				return nil, fmt.Errorf("recursive descent (..) is not supported")
+			}
+			if i < len(text) && text[i] == '*' {
+				steps = append(steps, step{kind: stepWildcard})
+				i++
+				continue
+			}
+			name, n := fieldName(text[i:])
+			if name == "" {
+				return nil, fmt.Errorf("missing field name at %d", i)
+			}
+			steps = append(steps, step{kind: stepField, name: name})
+			i += n
+		case '[':
+			end := closingBracket(text, i)
+			if end < 0 {
+				return nil, fmt.Errorf("unclosed [ at %d", i)
+			}
+			s, err := parseBracket(text[i+1 : end])
+			if err != nil {
+				return nil, err
+			}
+			steps = append(steps, s)
+			i = end + 1
+		default:
+			if len(steps) > 0 {
+				return nil, fmt.Errorf("unexpected %q at %d", text[i], i)
+			}
+			// A path may start with a field name without its dot, as in kubectl
+			text = "." + text
+		}
+	}
+	return steps, nil
+}
+
+// fieldName reads a field name up to the next unescaped . or [, returning it with \. escapes
+// resolved and the number of bytes read
+func fieldName(text string) (string, int) {
+	var b strings.Builder
+	i := 0
+	for i < len(text) && text[i] != '.' && text[i] != '[' {
+		if text[i] == '\\' && i+1 < len(text) {
+			i++
+		}
+		b.WriteByte(text[i])
+		i++
+	}
+	return b.String(), i
+}
+
+// closingBracket returns the index of the ] closing the [ at start, skipping brackets and
+// quotes inside filters and quoted names, or -1
+func closingBracket(text string, start int) int {
+	depth := 0
+	var quote byte
+	for i := start; i < len(text); i++ {
+		c := text[i]
+		switch {
+		case quote != 0:
+			if c == quote {
+				quote = 0
+			}
+		case c == '\'' || c == '"':
+			quote = c
+		case c == '[':
+			depth++
+		case c == ']':
+			depth--
+			if depth == 0 {
+				return i
+			}
+		}
+	}
+	return -1
+}
+
+// parseBracket parses what is between [ and ]
+func parseBracket(inner string) (step, error) {
+	inner = strings.TrimSpace(inner)
+	switch {
+	case inner == "*":
+		return step{kind: stepWildcard}, nil
+	case strings.HasPrefix(inner, "?(") && strings.HasSuffix(inner, ")"):
+		f, err := parseFilter(strings.TrimSpace(inner[2 : len(inner)-1]))
+		if err != nil {
+			return step{}, err
+		}
+		return step{kind: stepFilter, filter: f}, nil
+	}
+	if name, ok := unquote(inner); ok {
+		return step{kind: stepField, name: name}, nil
+	}
+	if strings.Contains(inner, ":") || strings.Contains(inner, ",") {
+		return step{}, fmt.Errorf("slices and unions ([%s]) are not supported", inner)
+	}
+	index, err := strconv.Atoi(inner)
+	if err != nil {
+		return step{}, fmt.Errorf("invalid index [%s]", inner)
+	}
+	return step{kind: stepIndex, index: index}, nil
+}
+
+// parseFilter parses the condition of a filter, e.g. @.type=="Ready" or @.ready
+func parseFilter(condition string) (*filter, error) {
+	left, op, right := condition, "", ""
+	for _, candidate := range []string{"==", "!="} {
+		if l, r, ok := strings.Cut(condition, candidate); ok {
+			left, op, right = strings.TrimSpace(l), candidate, strings.TrimSpace(r)
+			break
+		}
+	}
+	if !strings.HasPrefix(left, "@") {
+		return nil, fmt.Errorf("filter %q must test a path starting with @", condition)
+	}
+
+	f := &filter{op: op, path: &Path{expr: left}}
+	if rest := left[1:]; rest != "" {
+		steps, err := parseSteps(rest)
+		if err != nil {
+			return nil, fmt.Errorf("filter %q: %w", condition, err)
+		}
+		f.path.steps = steps
+	}
+	if op == "" {
+		return f, nil
+	}
+
+	if value, ok := unquote(right); ok {
+		f.value = value
+	} else if value, err := strconv.ParseBool(right); err == nil {
+		f.value = value
+	} else if value, err := strconv.ParseFloat(right, 64); err == nil {
+		f.value = value
+	} else {
+		return nil, fmt.Errorf("filter %q compares to %q, which is not a quoted string, number or boolean", condition, right)
+	}
+	return f, nil
+}
+
+// unquote returns s without its single or double quotes, if it is quoted
+func unquote(s string) (string, bool) {
+	if len(s) >= 2 && (s[0] == '\'' || s[0] == '"') && s[len(s)-1] == s[0] {
+		return s[1 : len(s)-1], true
+	}
+	return "", false
+}
+
+// Values returns what the path selects in data, JSON decoded with encoding/json (into
+// map[string]any, []any and scalars). A path that selects nothing returns nil.
+func (p *Path) Values(data any) []any {
+	current := []any{data}
+	for _, s := range p.steps {
+		var next []any
+		for _, value := range current {
+			next = append(next, s.apply(value)...)
+		}
+		current = next
+	}
+	return current
+}
+
+// apply returns what s selects in value
+func (s step) apply(value any) []any {
+	switch s.kind {
+	case stepField:
+		if object, ok := value.(map[string]any); ok {
+			if field, ok := object[s.name]; ok {
+				return []any{field}
+			}
+		}
+	case stepIndex:
+		if array, ok := value.([]any); ok {
+			index := s.index
+			if index < 0 {
+				index += len(array)
+			}
+			if index >= 0 && index < len(array) {
+				return []any{array[index]}
+			}
+		}
+	case stepWildcard:
+		return elements(value)
+	case stepFilter:
+		var matching []any
+		for _, element := range elements(value) {
+			if s.filter.matches(element) {
+				matching = append(matching, element)
+			}
+		}
+		return matching
+	}
+	return nil
+}
+
+// elements returns the elements of an array, or the fields of an object ordered by name
+func elements(value any) []any {
+	switch v := value.(type) {
+	case []any:
+		return v
+	case map[string]any:
+		keys := make([]string, 0, len(v))
+		for key := range v {
+			keys = append(keys, key)
+		}
+		slices.Sort(keys)
+		fields := make([]any, len(keys))
+		for i, key := range keys {
+			fields[i] = v[key]
+		}
+		return fields
+	}
+	return nil
+}
+
+// matches reports whether element passes the filter. With != the tested path must exist.
+func (f *filter) matches(element any) bool {
+	values := f.path.Values(element)
+	if f.op == "" {
+		return len(values) > 0
+	}
+	equal := slices.ContainsFunc(values, func(value any) bool { return Format(value) == Format(f.value) })
+	if f.op == "==" {
+		return equal
+	}
+	return len(values) > 0 && !equal
+}
+
+// Text returns the values the path selects in data formatted with Format and separated by
+// commas, or "" when it selects nothing
+func (p *Path) Text(data any) string {
+	values := p.Values(data)
+	texts := make([]string, len(values))
+	for i, value := range values {
+		texts[i] = Format(value)
+	}
+	return strings.Join(texts, ",")
+}
+
+// Format returns value as kubectl prints it: strings unquoted, numbers without exponent,
+// objects and arrays as compact JSON and null as ""
+func Format(value any) string {
+	switch v := value.(type) {
+	case nil:
+		return ""
+	case string:
+		return v
+	case float64:
+		return strconv.FormatFloat(v, 'f', -1, 64)
+	case bool, json.Number:
+		return fmt.Sprint(v)
+	}
+	data, err := json.Marshal(value)
+	if err != nil {
+		return fmt.Sprint(value)
+	}
+	return string(data)
+}
@@ -0,0 +1,81 @@
+package jsonpath
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const podJSON = `{
+  "metadata": {
+    "name": "web-1",
+    "labels": {"app.kubernetes.io/name": "web", "tier": "frontend"}
+  },
+  "spec": {
+    "containers": [
+      {"name": "app", "image": "web:1.2"},
+      {"name": "proxy", "image": "envoy:1.30"}
+    ],
+    "priority": 1000
+  },
+  "status": {
+    "conditions": [
+      {"type": "Ready", "status": "False"},
+      {"type": "PodScheduled", "status": "True"}
+    ],
+    "hostIP": null
+  }
+}`
+
+func TestPath_Text(t *testing.T) {
+	var pod any
+	require.NoError(t, json.Unmarshal([]byte(podJSON), &pod))
+
+	tests := []struct {
+		expr string
+		want string
+	}{
+		{".metadata.name", "web-1"},
+		{"{.metadata.name}", "web-1"},
+		{"$.metadata.name", "web-1"},
+		{"metadata.name", "web-1"},
+		{".spec.containers[0].image", "web:1.2"},
+		{".spec.containers[-1].name", "proxy"},
+		{".spec.containers[*].image", "web:1.2,envoy:1.30"},
+		{".spec.containers.*.name", "app,proxy"},
+		{".spec.priority", "1000"},
+		{".metadata.labels['app.kubernetes.io/name']", "web"},
+		{`.metadata.labels.app\.kubernetes\.io/name`, "web"},
+		{".metadata.labels", `{"app.kubernetes.io/name":"web","tier":"frontend"}`},
+		{`.status.conditions[?(@.type=="Ready")].status`, "False"},
+		{`.status.conditions[?(@.type != "Ready")].type`, "PodScheduled"},
+		{`.spec.containers[?(@.image)].name`, "app,proxy"},
+		{".status.hostIP", ""},
+		{".status.missing", ""},
+		{".spec.containers[5].image", ""},
+	}
+	for _, tt := range tests {
+		path, err := Parse(tt.expr)
+		require.NoError(t, err, tt.expr)
+		assert.Equal(t, tt.want, path.Text(pod), tt.expr)
+	}
+}
+
+func TestParse_Errors(t *testing.T) {
+	for _, expr := range []string{
+		"",
+		"{}",
+		"..name",
+		".spec.containers[0",
+		".spec.containers[0:2]",
+		".spec.containers[x]",
+		".spec.containers[?(.name==\"app\")]",
+		".spec.containers[?(@.name==app)]",
+		".metadata.",
+	} {
+		_, err := Parse(expr)
+		assert.Error(t, err, expr)
+	}
+}
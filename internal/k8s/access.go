@@ -0,0 +1,108 @@
+package k8s
+
+import (
+	"errors"
+	"fmt"
+	"log/slog"
+	"os/exec"
+	"strings"
+	"sync"
+)
+
+// fallbackTracker records which contexts show namespaces from the namespace fallback
+type fallbackTracker struct {
+	mu       sync.Mutex
+	contexts map[string]bool
+}
+
+// set records whether the namespaces of ctxName came from the fallback
+func (t *fallbackTracker) set(ctxName string, fallback bool) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	if t.contexts == nil {
+		t.contexts = make(map[string]bool)
+	}
+	t.contexts[ctxName] = fallback
+}
+
+// get reports whether the namespaces of ctxName came from the fallback
+func (t *fallbackTracker) get(ctxName string) bool {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	return t.contexts[ctxName]
+}
+
+// SetNamespaceFallback sets the namespaces GetNamespaces returns when listing namespaces of
+// a context is forbidden (e.g. cfg.NamespaceFallback): namespaces are returned as they are,
+// candidates only if pods may be listed in them. Call it before the adapter is used.
+func (k *KubectlAdapter) SetNamespaceFallback(fallback func(ctxName string) (namespaces, candidates []string)) {
+	k.namespaceFallback = fallback
+}
+
+// NamespacesFromFallback reports whether the last namespaces returned for context came from
+// the namespace fallback because listing them was forbidden
+func (k *KubectlAdapter) NamespacesFromFallback(context string) bool {
+	return k.fallbacks.get(context)
+}
+
+// fallbackNamespaces returns the fallback namespaces of ctxName after listing them failed
+// with listErr. listErr is returned when the fallback yields no namespace.
+func (k *KubectlAdapter) fallbackNamespaces(ctxName string, listErr error) ([]Namespace, error) {
+	namespaces, candidates := k.namespaceFallback(ctxName)
+	for _, namespace := range candidates {
+		allowed, err := k.CanListPods(ctxName, namespace)
+		if err != nil {
+			slog.Warn("namespace access check failed", "context", ctxName, "namespace", namespace, "error", err)
+			continue
+		}
+		if allowed {
+			namespaces = append(namespaces, namespace)
+		}
+	}
+
+	if len(namespaces) == 0 {
+		k.fallbacks.set(ctxName, false)
+		return nil, listErr
+	}
+	slog.Info("listing namespaces forbidden, using configured namespaces", "context", ctxName, "namespaces", len(namespaces))
+	k.fallbacks.set(ctxName, true)
+	return NamespacesNamed(namespaces), nil
+}
+
+// CanListPods reports whether pods may be listed in namespace, using kubectl auth can-i
+func (k *KubectlAdapter) CanListPods(ctxName, namespace string) (bool, error) {
+	if err := validateContextName(ctxName); err != nil {
+		return false, err
+	}
+	if err := validateNamespaceName(namespace); err != nil {
+		return false, err
+	}
+
+	kubectlPath, err := k.lookKubectl()
+	if err != nil {
+		return false, err
+	}
+
+	kubeconfigArgs, err := k.kubeconfigArgs(ctxName)
+	if err != nil {
+		return false, err
+	}
+
+	// can-i prints yes or no, and exits 1 on no
+	args := append(kubeconfigArgs, "--context", ctxName, "auth", "can-i", "list", "pods", "-n", namespace)
+	output, err := k.run(ctxName, RetryOperation("can-i", namespace), kubectlPath, args)
+	answer := strings.TrimSpace(string(output))
+	if answer == "yes" || answer == "no" {
+		return answer == "yes", nil
+	}
+	if err != nil {
+		if errors.Is(err, ErrTimeout) {
+			return false, err
+		}
+		if exitErr, ok := err.(*exec.ExitError); ok {
+			return false, fmt.Errorf("kubectl auth can-i failed: %s", exitErr.Stderr)
+		}
+		return false, fmt.Errorf("failed to execute kubectl: %w", err)
+	}
+	return false, fmt.Errorf("unexpected kubectl auth can-i answer %q", answer)
+}
@@ -0,0 +1,74 @@
+package k8s
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// forbiddenNamespacesKubectl forbids listing namespaces and allows listing pods in team-a only
+const forbiddenNamespacesKubectl = `case "$*" in
+  *"get namespaces"*) echo 'Error from server (Forbidden): namespaces is forbidden' >&2; exit 1;;
+  *"can-i list pods -n team-a") echo yes;;
+  *) echo no; exit 1;;
+esac`
+
+func TestGetNamespaces_FallbackWhenForbidden(t *testing.T) {
+	stubKubectl(t, forbiddenNamespacesKubectl)
+
+	t.Run("static namespaces", func(t *testing.T) {
+		adapter := NewKubectlAdapter("")
+		adapter.SetNamespaceFallback(func(string) ([]string, []string) {
+			return []string{"team-a", "team-b"}, nil
+		})
+
+		namespaces, err := adapter.GetNamespaces("prod")
+		require.NoError(t, err)
+		assert.Equal(t, []string{"team-a", "team-b"}, NamespaceNames(namespaces))
+		assert.True(t, adapter.NamespacesFromFallback("prod"))
+	})
+
+	t.Run("candidates are probed", func(t *testing.T) {
+		adapter := NewKubectlAdapter("")
+		adapter.SetNamespaceFallback(func(string) ([]string, []string) {
+			return nil, []string{"team-a", "team-b"}
+		})
+
+		namespaces, err := adapter.GetNamespaces("prod")
+		require.NoError(t, err)
+		assert.Equal(t, []string{"team-a"}, NamespaceNames(namespaces))
+	})
+
+	t.Run("nothing allowed", func(t *testing.T) {
+		adapter := NewKubectlAdapter("")
+		adapter.SetNamespaceFallback(func(string) ([]string, []string) {
+			return nil, []string{"team-b"}
+		})
+
+		_, err := adapter.GetNamespaces("prod")
+		assert.ErrorIs(t, err, ErrPermissionDenied)
+		assert.False(t, adapter.NamespacesFromFallback("prod"))
+	})
+
+	t.Run("no fallback", func(t *testing.T) {
+		_, err := NewKubectlAdapter("").GetNamespaces("prod")
+		assert.ErrorIs(t, err, ErrPermissionDenied)
+	})
+}
+
+func TestCanListPods(t *testing.T) {
+	stubKubectl(t, forbiddenNamespacesKubectl)
+	adapter := NewKubectlAdapter("")
+
+	allowed, err := adapter.CanListPods("prod", "team-a")
+	require.NoError(t, err)
+	assert.True(t, allowed)
+
+	allowed, err = adapter.CanListPods("prod", "team-b")
+	require.NoError(t, err)
+	assert.False(t, allowed)
+
+	_, err = adapter.CanListPods("prod", "Team B")
+	assert.Error(t, err, "invalid namespace names are rejected")
+}
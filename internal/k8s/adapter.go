@@ -8,7 +8,7 @@ type KubeAdapter interface {
 	GetContexts() ([]string, error)
 
 	// GetNamespaces returns namespaces for a given context (future implementation)
-	GetNamespaces(context string) ([]string, error)
+	GetNamespaces(context string) ([]Namespace, error)
 
 	// GetPods returns pods for a given context and namespace (future implementation)
 	GetPods(context, namespace string) ([]Pod, error)
@@ -0,0 +1,154 @@
+package k8s
+
+import (
+	"errors"
+	"fmt"
+	"os/exec"
+	"regexp"
+	"strings"
+)
+
+// Problems found by the pre-flight credential check
+const (
+	AuthProblemPluginMissing = "plugin-missing" // The exec credential plugin is not installed
+	AuthProblemExpired       = "expired"        // The login (e.g. an OIDC token) has expired
+	AuthProblemUnauthorized  = "unauthorized"   // The cluster rejected the credentials
+)
+
+// expiredPhrases are lower-case kubectl stderr phrases of logins that have to be renewed
+var expiredPhrases = []string{
+	"token is expired",
+	"token has expired",
+	"expired token",
+	"invalid_grant",
+	"refresh token",
+	"id-token",
+	"sso session",
+}
+
+// urlPattern finds login URLs printed by credential plugins
+var urlPattern = regexp.MustCompile(`https?://[^\s"'<>]+`)
+
+// AuthStatus is the result of the pre-flight credential check of a context
+type AuthStatus struct {
+	Context     string
+	Problem     string   // One of the AuthProblem constants, "" when the credentials work
+	Plugin      string   // Exec credential plugin command, "" when none is used
+	InstallHint string   // How to install a missing plugin, from the kubeconfig
+	LoginURL    string   // URL to open to log in again, "" when unknown
+	Refresh     []string // Command that renews the login interactively, nil when unknown
+	Detail      string   // kubectl error output
+}
+
+// OK reports whether the credentials of the context work
+func (s *AuthStatus) OK() bool {
+	return s.Problem == ""
+}
+
+// CheckAuth checks that the credentials of a context work before it is used: the exec
+// credential plugin must be installed and the cluster must accept the credentials, which is
+// checked with kubectl auth whoami. Failures that are not about credentials, e.g. an
+// unreachable cluster, are returned as errors.
+func (k *KubectlAdapter) CheckAuth(ctxName string) (*AuthStatus, error) {
+	if err := validateContextName(ctxName); err != nil {
+		return nil, err
+	}
+
+	user, baseDir, err := findContextUser(k.kubeconfigFiles(ctxName), ctxName)
+	if err != nil {
+		return nil, err
+	}
+
+	status := &AuthStatus{Context: ctxName}
+	if user != nil {
+		status.LoginURL = issuerURL(user)
+	}
+	if user != nil && user.Exec != nil {
+		status.Plugin = user.Exec.Command
+		status.InstallHint = strings.TrimSpace(user.Exec.InstallHint)
+		if _, err := exec.LookPath(execCommandPath(user.Exec, baseDir)); err != nil {
+			status.Problem = AuthProblemPluginMissing
+			status.Detail = err.Error()
+			return status, nil
+		}
+	}
+
+	kubectlPath, err := k.lookKubectl()
+	if err != nil {
+		return nil, err
+	}
+
+	kubeconfigArgs, err := k.kubeconfigArgs(ctxName)
+	if err != nil {
+		return nil, err
+	}
+
+	args := append(kubeconfigArgs, "--context", ctxName, "auth", "whoami")
+	_, err = k.run(ctxName, RetryOperation("whoami", ""), kubectlPath, args)
+	if err == nil {
+		return status, nil
+	}
+	if errors.Is(err, ErrTimeout) {
+		return nil, err
+	}
+
+	var exitErr *exec.ExitError
+	if !errors.As(err, &exitErr) {
+		return nil, fmt.Errorf("failed to execute kubectl: %w", err)
+	}
+
+	stderr := strings.TrimSpace(string(exitErr.Stderr))
+	status.Problem = authProblem(stderr)
+	switch status.Problem {
+	case "":
+		// Servers older than 1.28 lack the whoami API, but answer only after authenticating
+		if strings.Contains(strings.ToLower(stderr), "could not find the requested resource") {
+			return status, nil
+		}
+		return nil, fmt.Errorf("kubectl auth whoami failed: %s", stderr)
+	case AuthProblemExpired, AuthProblemUnauthorized:
+		// Running kubectl interactively lets exec plugins and auth providers log in again
+		status.Refresh = append([]string{k.kubectlName()}, args...)
+	}
+	if url := urlPattern.FindString(stderr); url != "" {
+		status.LoginURL = url
+	}
+	status.Detail = stderr
+	return status, nil
+}
+
+// authProblem classifies kubectl stderr of a failed call, "" when it is not about credentials
+func authProblem(stderr string) string {
+	text := strings.ToLower(stderr)
+	if strings.Contains(text, "executable") && strings.Contains(text, "not found") {
+		return AuthProblemPluginMissing
+	}
+	for _, phrase := range expiredPhrases {
+		if strings.Contains(text, phrase) {
+			return AuthProblemExpired
+		}
+	}
+	if strings.Contains(text, "unauthorized") || strings.Contains(text, "getting credentials") {
+		return AuthProblemUnauthorized
+	}
+	return ""
+}
+
+// issuerURL returns the OIDC issuer of a kubeconfig user, where the user logs in again
+func issuerURL(user *AuthInfo) string {
+	if user.AuthProvider != nil {
+		return user.AuthProvider.Config["idp-issuer-url"]
+	}
+	if user.Exec != nil {
+		// kubelogin: --oidc-issuer-url=https://... or --oidc-issuer-url https://...
+		for i, arg := range user.Exec.Args {
+			if value, ok := strings.CutPrefix(arg, "--oidc-issuer-url="); ok {
+				return value
+			}
+			if arg == "--oidc-issuer-url" && i+1 < len(user.Exec.Args) {
+				return user.Exec.Args[i+1]
+			}
+		}
+	}
+	return ""
+}
@@ -0,0 +1,106 @@
+package k8s
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCheckAuth(t *testing.T) {
+	const oidcPlugin = `    exec:
+      apiVersion: client.authentication.k8s.io/v1
+      command: kubectl
+      args: ["oidc-login", "get-token", "--oidc-issuer-url=https://sso.example.com"]`
+
+	tests := []struct {
+		name        string
+		user        string
+		script      string
+		wantProblem string
+		wantURL     string
+		wantRefresh bool
+		wantErr     bool
+	}{
+		{
+			name:   "credentials work",
+			user:   "    token: abc",
+			script: `echo "Username: alice"`,
+		},
+		{
+			name: "missing exec plugin",
+			user: `    exec:
+      command: no-such-plugin
+      installHint: brew install no-such-plugin`,
+			wantProblem: AuthProblemPluginMissing,
+		},
+		{
+			name:        "expired oidc token",
+			user:        oidcPlugin,
+			script:      `echo "error: You must be logged in to the server (Unauthorized): token is expired" >&2; exit 1`,
+			wantProblem: AuthProblemExpired,
+			wantURL:     "https://sso.example.com",
+			wantRefresh: true,
+		},
+		{
+			name:        "login url printed by the plugin",
+			user:        oidcPlugin,
+			script:      `echo "Please visit https://sso.example.com/device?code=ABCD to log in" >&2; echo "error: You must be logged in to the server (Unauthorized)" >&2; exit 1`,
+			wantProblem: AuthProblemUnauthorized,
+			wantURL:     "https://sso.example.com/device?code=ABCD",
+			wantRefresh: true,
+		},
+		{
+			name:   "server without whoami api",
+			user:   "    token: abc",
+			script: `echo "error: the server could not find the requested resource" >&2; exit 1`,
+		},
+		{
+			name:    "unreachable cluster",
+			user:    "    token: abc",
+			script:  `echo "dial tcp 10.0.0.1:6443: connect: no route to host" >&2; exit 1`,
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			kubeconfig := writeKubeconfig(t, t.TempDir(), tt.user)
+			stubKubectl(t, tt.script)
+
+			status, err := NewKubectlAdapter(kubeconfig).CheckAuth("test")
+			if tt.wantErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.wantProblem, status.Problem)
+			assert.Equal(t, tt.wantProblem == "", status.OK())
+			assert.Equal(t, tt.wantURL, status.LoginURL)
+			assert.Equal(t, tt.wantRefresh, status.Refresh != nil)
+		})
+	}
+}
+
+func TestCheckAuth_MissingPluginInstallHint(t *testing.T) {
+	kubeconfig := writeKubeconfig(t, t.TempDir(), `    exec:
+      command: no-such-plugin
+      installHint: |
+        brew install no-such-plugin`)
+	stubKubectl(t, "exit 0")
+
+	status, err := NewKubectlAdapter(kubeconfig).CheckAuth("test")
+	require.NoError(t, err)
+	assert.Equal(t, "no-such-plugin", status.Plugin)
+	assert.Equal(t, "brew install no-such-plugin", status.InstallHint)
+	assert.Nil(t, status.Refresh, "running kubectl cannot install the plugin")
+}
+
+func TestCheckAuth_RefreshCommand(t *testing.T) {
+	kubeconfig := writeKubeconfig(t, t.TempDir(), "    token: abc")
+	stubKubectl(t, `echo "error: You must be logged in to the server (Unauthorized)" >&2; exit 1`)
+
+	status, err := NewKubectlAdapter(kubeconfig).CheckAuth("test")
+	require.NoError(t, err)
+	assert.Equal(t, []string{"kubectl", "--kubeconfig", kubeconfig, "--context", "test", "auth", "whoami"}, status.Refresh)
+}
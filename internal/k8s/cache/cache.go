@@ -0,0 +1,156 @@
+// Package cache keeps recently fetched namespaces, pods and pod counts so that revisiting a context or
+// namespace shows them instantly while they are refreshed in the background.
+package cache
+
+import (
+	"sync"
+	"time"
+
+	"github.com/maratkarimov/kubertino/internal/k8s"
+)
+
+// Cache holds the namespaces and pods fetched from the cluster. A nil Cache caches nothing.
+type Cache struct {
+	namespaces *Store[[]k8s.Namespace] // Keyed by context
+	pods       *Store[[]k8s.Pod]       // Keyed by context and namespace
+	podCounts  *Store[map[string]int]  // Pods per namespace, keyed by context
+}
+
+// New returns a cache whose entries are fresh for ttl. A ttl of zero disables caching.
+func New(ttl time.Duration) *Cache {
+	return &Cache{
+		namespaces: NewStore[[]k8s.Namespace](ttl),
+		pods:       NewStore[[]k8s.Pod](ttl),
+		podCounts:  NewStore[map[string]int](ttl),
+	}
+}
+
+// Namespaces returns the cached namespaces of a context, whether they are fresh, and whether
+// they were found
+func (c *Cache) Namespaces(context string) ([]k8s.Namespace, bool, bool) {
+	if c == nil {
+		return nil, false, false
+	}
+	return c.namespaces.Get(context)
+}
+
+// SetNamespaces caches the namespaces of a context
+func (c *Cache) SetNamespaces(context string, namespaces []k8s.Namespace) {
+	if c != nil {
+		c.namespaces.Set(context, namespaces)
+	}
+}
+
+// InvalidateNamespaces marks the namespaces of a context as stale
+func (c *Cache) InvalidateNamespaces(context string) {
+	if c != nil {
+		c.namespaces.Invalidate(context)
+	}
+}
+
+// Pods returns the cached pods of a namespace, whether they are fresh, and whether they
+// were found
+func (c *Cache) Pods(context, namespace string) ([]k8s.Pod, bool, bool) {
+	if c == nil {
+		return nil, false, false
+	}
+	return c.pods.Get(podsKey(context, namespace))
+}
+
+// SetPods caches the pods of a namespace
+func (c *Cache) SetPods(context, namespace string, pods []k8s.Pod) {
+	if c != nil {
+		c.pods.Set(podsKey(context, namespace), pods)
+	}
+}
+
+// InvalidatePods marks the pods of a namespace as stale
+func (c *Cache) InvalidatePods(context, namespace string) {
+	if c != nil {
+		c.pods.Invalidate(podsKey(context, namespace))
+	}
+}
+
+// PodCounts returns the cached pod counts per namespace of a context, whether they are fresh,
+// and whether they were found
+func (c *Cache) PodCounts(context string) (map[string]int, bool, bool) {
+	if c == nil {
+		return nil, false, false
+	}
+	return c.podCounts.Get(context)
+}
+
+// SetPodCounts caches the pod counts per namespace of a context
+func (c *Cache) SetPodCounts(context string, counts map[string]int) {
+	if c != nil {
+		c.podCounts.Set(context, counts)
+	}
+}
+
+// InvalidatePodCounts marks the pod counts of a context as stale
+func (c *Cache) InvalidatePodCounts(context string) {
+	if c != nil {
+		c.podCounts.Invalidate(context)
+	}
+}
+
+// podsKey returns the pods store key of a namespace in a context
+func podsKey(context, namespace string) string {
+	return context + "/" + namespace
+}
+
+// entry is a cached value and when it was fetched
+type entry[T any] struct {
+	value     T
+	fetchedAt time.Time
+}
+
+// Store is a cache of values keyed by string. Entries older than the TTL are still served
+// but reported as stale so the caller can refresh them. It is safe for concurrent use.
+type Store[T any] struct {
+	mu      sync.Mutex
+	ttl     time.Duration
+	now     func() time.Time // Replaced in tests
+	entries map[string]entry[T]
+}
+
+// NewStore returns an empty store whose entries are fresh for ttl.
+// A ttl of zero disables the store: nothing is kept.
+func NewStore[T any](ttl time.Duration) *Store[T] {
+	return &Store[T]{ttl: ttl, now: time.Now, entries: make(map[string]entry[T])}
+}
+
+// Get returns the cached value for key, whether it is still fresh, and whether it was found
+func (s *Store[T]) Get(key string) (value T, fresh bool, ok bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	e, ok := s.entries[key]
+	if !ok {
+		return value, false, false
+	}
+	return e.value, s.now().Sub(e.fetchedAt) < s.ttl, true
+}
+
+// Set stores value for key as fetched now
+func (s *Store[T]) Set(key string, value T) {
+	if s.ttl <= 0 {
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.entries[key] = entry[T]{value: value, fetchedAt: s.now()}
+}
+
+// Invalidate marks the entry for key as stale so the next Get triggers a refresh.
+// The value is kept and served until the refresh completes.
+func (s *Store[T]) Invalidate(key string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if e, ok := s.entries[key]; ok {
+		e.fetchedAt = time.Time{}
+		s.entries[key] = e
+	}
+}
@@ -0,0 +1,91 @@
+package cache
+
+import (
+	"testing"
+	"time"
+
+	"github.com/maratkarimov/kubertino/internal/k8s"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestStore_FreshThenStale(t *testing.T) {
+	now := time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC)
+	store := NewStore[[]string](30 * time.Second)
+	store.now = func() time.Time { return now }
+
+	_, _, ok := store.Get("dev")
+	assert.False(t, ok)
+
+	store.Set("dev", []string{"default"})
+	value, fresh, ok := store.Get("dev")
+	assert.True(t, ok)
+	assert.True(t, fresh)
+	assert.Equal(t, []string{"default"}, value)
+
+	now = now.Add(31 * time.Second)
+	value, fresh, ok = store.Get("dev")
+	assert.True(t, ok, "stale entries are still served")
+	assert.False(t, fresh)
+	assert.Equal(t, []string{"default"}, value)
+}
+
+func TestStore_Invalidate(t *testing.T) {
+	store := NewStore[[]string](time.Minute)
+	store.Set("dev", []string{"default"})
+	store.Invalidate("dev")
+	store.Invalidate("missing")
+
+	value, fresh, ok := store.Get("dev")
+	assert.True(t, ok)
+	assert.False(t, fresh)
+	assert.Equal(t, []string{"default"}, value)
+}
+
+func TestStore_ZeroTTLDisables(t *testing.T) {
+	store := NewStore[[]string](0)
+	store.Set("dev", []string{"default"})
+
+	_, _, ok := store.Get("dev")
+	assert.False(t, ok)
+}
+
+func TestCache_PodsKeyedByContextAndNamespace(t *testing.T) {
+	c := New(time.Minute)
+	c.SetPods("dev", "default", []k8s.Pod{{Name: "api-1"}})
+
+	_, _, ok := c.Pods("prod", "default")
+	assert.False(t, ok)
+	pods, fresh, ok := c.Pods("dev", "default")
+	assert.True(t, ok)
+	assert.True(t, fresh)
+	assert.Equal(t, "api-1", pods[0].Name)
+
+	c.InvalidatePods("dev", "default")
+	_, fresh, _ = c.Pods("dev", "default")
+	assert.False(t, fresh)
+}
+
+func TestCache_PodCountsKeyedByContext(t *testing.T) {
+	c := New(time.Minute)
+	c.SetPodCounts("dev", map[string]int{"default": 3})
+
+	_, _, ok := c.PodCounts("prod")
+	assert.False(t, ok)
+	counts, fresh, ok := c.PodCounts("dev")
+	assert.True(t, ok)
+	assert.True(t, fresh)
+	assert.Equal(t, 3, counts["default"])
+
+	c.InvalidatePodCounts("dev")
+	_, fresh, _ = c.PodCounts("dev")
+	assert.False(t, fresh)
+}
+
+func TestCache_Nil(t *testing.T) {
+	var c *Cache
+	c.SetNamespaces("dev", k8s.NamespacesNamed([]string{"default"}))
+	c.InvalidateNamespaces("dev")
+
+	_, _, ok := c.Namespaces("dev")
+	assert.False(t, ok)
+}
@@ -0,0 +1,144 @@
+package k8s
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os/exec"
+	"regexp"
+	"sort"
+	"strings"
+	"unicode/utf8"
+)
+
+// ConfigEntry is one key of a configmap or secret
+type ConfigEntry struct {
+	Key    string
+	Value  string // Decoded value; empty when Binary
+	Binary bool   // Not valid UTF-8 text (binaryData, keystores, ...)
+	Size   int    // Decoded size in bytes
+}
+
+// ConfigData holds the keys and values of a configmap or secret. Secret values are
+// base64-decoded; callers must not log them.
+type ConfigData struct {
+	Kind    ResourceKind
+	Name    string
+	Type    string        // Secret type (e.g. "Opaque", "kubernetes.io/tls")
+	Entries []ConfigEntry // Sorted by key
+}
+
+// GetConfigData fetches the keys and values of a configmap or secret using kubectl.
+// Values never appear in logs or returned errors.
+func (k *KubectlAdapter) GetConfigData(ctxName, namespace string, kind ResourceKind, name string) (ConfigData, error) {
+	if kind != KindConfigMap && kind != KindSecret {
+		return ConfigData{}, fmt.Errorf("unsupported resource kind '%s'", kind)
+	}
+	if err := validateContextName(ctxName); err != nil {
+		return ConfigData{}, err
+	}
+	if err := validateNamespaceName(namespace); err != nil {
+		return ConfigData{}, err
+	}
+	if err := validateObjectName(name); err != nil {
+		return ConfigData{}, err
+	}
+
+	kubectlPath, err := k.lookKubectl()
+	if err != nil {
+		return ConfigData{}, err
+	}
+
+	kubeconfigArgs, err := k.kubeconfigArgs(ctxName)
+	if err != nil {
+		return ConfigData{}, err
+	}
+
+	args := append(kubeconfigArgs, "--context", ctxName, "get", string(kind), name, "-n", namespace, "-o", "json")
+	output, err := k.run(ctxName, RetryOperation(string(kind), namespace), kubectlPath, args)
+	if err != nil {
+		if errors.Is(err, ErrTimeout) {
+			return ConfigData{}, err
+		}
+
+		if exitErr, ok := err.(*exec.ExitError); ok {
+			stderr := string(exitErr.Stderr)
+			if strings.Contains(stderr, "forbidden") || strings.Contains(stderr, "Forbidden") {
+				return ConfigData{}, fmt.Errorf("%w: %s", ErrPermissionDenied, stderr)
+			}
+			return ConfigData{}, fmt.Errorf("kubectl command failed: %s", stderr)
+		}
+
+		return ConfigData{}, fmt.Errorf("failed to execute kubectl: %w", err)
+	}
+
+	var item ResourceItem
+	if err := json.Unmarshal(output, &item); err != nil {
+		// The JSON error only names the offending token type and offset, never the value
+		return ConfigData{}, fmt.Errorf("failed to parse kubectl output: %w", err)
+	}
+	return configDataFromItem(kind, item)
+}
+
+// configDataFromItem decodes the values of a configmap or secret item. Secret data and
+// configmap binaryData are base64; configmap data is plain text.
+func configDataFromItem(kind ResourceKind, item ResourceItem) (ConfigData, error) {
+	data := ConfigData{Kind: kind, Name: item.Metadata.Name, Type: item.Type}
+
+	for key, value := range item.Data {
+		raw := []byte(value)
+		if kind == KindSecret {
+			decoded, err := base64.StdEncoding.DecodeString(value)
+			if err != nil {
+				return ConfigData{}, fmt.Errorf("failed to decode key %s of secret %s: invalid base64", key, item.Metadata.Name)
+			}
+			raw = decoded
+		}
+		data.Entries = append(data.Entries, configEntry(key, raw))
+	}
+	for key, value := range item.BinaryData {
+		decoded, err := base64.StdEncoding.DecodeString(value)
+		if err != nil {
+			return ConfigData{}, fmt.Errorf("failed to decode key %s of configmap %s: invalid base64", key, item.Metadata.Name)
+		}
+		entry := configEntry(key, decoded)
+		entry.Binary, entry.Value = true, ""
+		data.Entries = append(data.Entries, entry)
+	}
+
+	sort.Slice(data.Entries, func(i, j int) bool {
+		return data.Entries[i].Key < data.Entries[j].Key
+	})
+	return data, nil
+}
+
+// configEntry builds an entry for a decoded value, keeping only text values
+func configEntry(key string, raw []byte) ConfigEntry {
+	entry := ConfigEntry{Key: key, Size: len(raw)}
+	if utf8.Valid(raw) {
+		entry.Value = string(raw)
+	} else {
+		entry.Binary = true
+	}
+	return entry
+}
+
+// validateObjectName validates a configmap or secret name against allowed characters
+func validateObjectName(name string) error {
+	if name == "" {
+		return fmt.Errorf("resource name cannot be empty")
+	}
+
+	// Configmap and secret names must be valid DNS subdomain names
+	matched, err := regexp.MatchString(`^[a-z0-9]([-a-z0-9.]*[a-z0-9])?$`, name)
+	if err != nil {
+		return fmt.Errorf("failed to validate resource name: %w", err)
+	}
+
+	if !matched {
+		return fmt.Errorf("invalid resource name '%s': must be lowercase alphanumeric with optional hyphens or dots", name)
+	}
+
+	return nil
+}
@@ -0,0 +1,69 @@
+package k8s
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetConfigData_Secret(t *testing.T) {
+	// "czNjcjN0" is "s3cr3t"; "/w==" is the single byte 0xff
+	stubKubectl(t, `echo '{"metadata": {"name": "db"}, "type": "Opaque", "data": {"password": "czNjcjN0", "user": "YXBw", "keystore": "/w=="}}'`)
+
+	data, err := NewKubectlAdapter("").GetConfigData("minikube", "default", KindSecret, "db")
+	require.NoError(t, err)
+	assert.Equal(t, "db", data.Name)
+	assert.Equal(t, "Opaque", data.Type)
+	assert.Equal(t, []ConfigEntry{
+		{Key: "keystore", Binary: true, Size: 1},
+		{Key: "password", Value: "s3cr3t", Size: 6},
+		{Key: "user", Value: "app", Size: 3},
+	}, data.Entries, "decoded and sorted by key")
+}
+
+func TestGetConfigData_ConfigMap(t *testing.T) {
+	item := ResourceItem{
+		Metadata:   PodMetadata{Name: "web"},
+		Data:       map[string]string{"nginx.conf": "server {\n}\n", "LEVEL": "debug"},
+		BinaryData: map[string]string{"logo.png": "iVBORw=="},
+	}
+
+	data, err := configDataFromItem(KindConfigMap, item)
+	require.NoError(t, err)
+	assert.Equal(t, []ConfigEntry{
+		{Key: "LEVEL", Value: "debug", Size: 5},
+		{Key: "logo.png", Binary: true, Size: 4},
+		{Key: "nginx.conf", Value: "server {\n}\n", Size: 11},
+	}, data.Entries, "configmap data is not base64")
+}
+
+func TestGetConfigData_Errors(t *testing.T) {
+	adapter := NewKubectlAdapter("")
+
+	t.Run("unsupported kind", func(t *testing.T) {
+		_, err := adapter.GetConfigData("minikube", "default", KindDeployment, "web")
+		assert.ErrorContains(t, err, "unsupported resource kind")
+	})
+
+	t.Run("invalid name", func(t *testing.T) {
+		_, err := adapter.GetConfigData("minikube", "default", KindSecret, "db; rm -rf /")
+		assert.Error(t, err)
+	})
+
+	t.Run("forbidden", func(t *testing.T) {
+		stubKubectl(t, `echo 'Error from server (Forbidden): secrets "db" is forbidden' >&2; exit 1`)
+		_, err := adapter.GetConfigData("minikube", "default", KindSecret, "db")
+		assert.ErrorIs(t, err, ErrPermissionDenied)
+	})
+
+	t.Run("invalid base64 does not leak the value", func(t *testing.T) {
+		_, err := configDataFromItem(KindSecret, ResourceItem{
+			Metadata: PodMetadata{Name: "db"},
+			Data:     map[string]string{"password": "not base64!"},
+		})
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "key password of secret db")
+		assert.NotContains(t, err.Error(), "not base64!")
+	})
+}
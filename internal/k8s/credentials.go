@@ -0,0 +1,204 @@
+package k8s
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/maratkarimov/kubertino/internal/config"
+
+	"gopkg.in/yaml.v3"
+)
+
+// Credential sources that can carry an expiry
+const (
+	CredentialSourceExec         = "exec"
+	CredentialSourceAuthProvider = "auth-provider"
+	CredentialSourceToken        = "token"
+)
+
+// Credential describes when the credentials used by a context expire
+type Credential struct {
+	Context   string
+	Source    string // exec, auth-provider or token
+	ExpiresAt time.Time
+}
+
+// Refreshable reports whether a fresh credential can be obtained by re-running the exec plugin
+func (c *Credential) Refreshable() bool {
+	return c.Source == CredentialSourceExec
+}
+
+// CredentialExpiry returns the expiry of the credentials used by the given context.
+// Exec plugins are run to obtain their ExecCredential, which also refreshes plugins
+// that cache tokens on disk. Returns nil without error when no expiry is known.
+func (k *KubectlAdapter) CredentialExpiry(ctxName string) (*Credential, error) {
+	user, baseDir, err := findContextUser(k.kubeconfigFiles(ctxName), ctxName)
+	if err != nil {
+		return nil, err
+	}
+	if user == nil {
+		return nil, nil
+	}
+
+	switch {
+	case user.Exec != nil:
+		// Auth plugins count against the same per-context limit as kubectl
+		release := k.acquire(ctxName)
+		expiresAt, err := runExecPlugin(user.Exec, baseDir)
+		release()
+		if err != nil || expiresAt.IsZero() {
+			return nil, err
+		}
+		return &Credential{Context: ctxName, Source: CredentialSourceExec, ExpiresAt: expiresAt}, nil
+
+	case user.AuthProvider != nil && user.AuthProvider.Config["expiry"] != "":
+		expiresAt, err := time.Parse(time.RFC3339, user.AuthProvider.Config["expiry"])
+		if err != nil {
+			return nil, fmt.Errorf("invalid auth-provider expiry for context %s: %w", ctxName, err)
+		}
+		return &Credential{Context: ctxName, Source: CredentialSourceAuthProvider, ExpiresAt: expiresAt}, nil
+
+	case user.Token != "":
+		expiresAt, ok := jwtExpiry(user.Token)
+		if !ok {
+			return nil, nil
+		}
+		return &Credential{Context: ctxName, Source: CredentialSourceToken, ExpiresAt: expiresAt}, nil
+	}
+
+	return nil, nil
+}
+
+// kubeconfigFiles returns the kubeconfig files kubectl reads for ctxName: the configured one,
+// or those of $KUBECONFIG and the default location
+func (k *KubectlAdapter) kubeconfigFiles(ctxName string) []string {
+	if path := k.kubeconfigFor(ctxName); path != "" {
+		return []string{path}
+	}
+	return config.KubeconfigPaths()
+}
+
+// findContextUser looks up the user entry of a context across the given kubeconfig files.
+// Returns the directory of the file defining the user, used to resolve relative exec commands.
+func findContextUser(paths []string, ctxName string) (*AuthInfo, string, error) {
+	var userName string
+	users := make(map[string]AuthInfo)
+	userDirs := make(map[string]string)
+
+	for _, p := range paths {
+		filename, err := expandPath(p)
+		if err != nil {
+			return nil, "", fmt.Errorf("failed to expand kubeconfig path: %w", err)
+		}
+
+		data, err := os.ReadFile(filename)
+		if err != nil {
+			if os.IsNotExist(err) {
+				continue
+			}
+			return nil, "", fmt.Errorf("failed to read kubeconfig: %w", err)
+		}
+
+		var kc KubeConfig
+		if err := yaml.Unmarshal(data, &kc); err != nil {
+			return nil, "", fmt.Errorf("%w: %v", ErrInvalidKubeconfig, err)
+		}
+
+		// First occurrence wins, matching kubectl's merge rules
+		for _, ctx := range kc.Contexts {
+			if ctx.Name == ctxName && userName == "" {
+				userName = ctx.Context.User
+			}
+		}
+		for _, u := range kc.Users {
+			if _, exists := users[u.Name]; !exists {
+				users[u.Name] = u.User
+				userDirs[u.Name] = filepath.Dir(filename)
+			}
+		}
+	}
+
+	user, ok := users[userName]
+	if userName == "" || !ok {
+		return nil, "", nil
+	}
+	return &user, userDirs[userName], nil
+}
+
+// runExecPlugin runs an exec credential plugin and returns the expiry it reports
+func runExecPlugin(execConfig *ExecConfig, baseDir string) (time.Time, error) {
+	command := execCommandPath(execConfig, baseDir)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	cmd := exec.CommandContext(ctx, command, execConfig.Args...)
+	cmd.Env = os.Environ()
+	for _, env := range execConfig.Env {
+		cmd.Env = append(cmd.Env, fmt.Sprintf("%s=%s", env.Name, env.Value))
+	}
+	execInfo := fmt.Sprintf(`{"apiVersion":%q,"kind":"ExecCredential","spec":{"interactive":false}}`, execConfig.APIVersion)
+	cmd.Env = append(cmd.Env, "KUBERNETES_EXEC_INFO="+execInfo)
+
+	output, err := cmd.Output()
+	if err != nil {
+		if ctx.Err() == context.DeadlineExceeded {
+			return time.Time{}, fmt.Errorf("%w: exec credential plugin timed out after 10s", ErrTimeout)
+		}
+		return time.Time{}, fmt.Errorf("exec credential plugin %s failed: %w", execConfig.Command, err)
+	}
+
+	var credential ExecCredential
+	if err := json.Unmarshal(output, &credential); err != nil {
+		return time.Time{}, fmt.Errorf("failed to parse exec credential: %w", err)
+	}
+
+	if credential.Status.ExpirationTimestamp == "" {
+		return time.Time{}, nil
+	}
+
+	expiresAt, err := time.Parse(time.RFC3339, credential.Status.ExpirationTimestamp)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("invalid exec credential expirationTimestamp: %w", err)
+	}
+	return expiresAt, nil
+}
+
+// execCommandPath returns the command of an exec credential plugin. Relative paths with a
+// separator are resolved against the kubeconfig directory, bare names are looked up in PATH.
+func execCommandPath(execConfig *ExecConfig, baseDir string) string {
+	command := execConfig.Command
+	if strings.Contains(command, string(filepath.Separator)) && !filepath.IsAbs(command) {
+		command = filepath.Join(baseDir, command)
+	}
+	return command
+}
+
+// jwtExpiry extracts the exp claim from a JWT bearer token without verifying it
+func jwtExpiry(token string) (time.Time, bool) {
+	parts := strings.Split(token, ".")
+	if len(parts) != 3 {
+		return time.Time{}, false
+	}
+
+	payload, err := base64.RawURLEncoding.DecodeString(parts[1])
+	if err != nil {
+		return time.Time{}, false
+	}
+
+	var claims struct {
+		Exp int64 `json:"exp"`
+	}
+	if err := json.Unmarshal(payload, &claims); err != nil || claims.Exp == 0 {
+		return time.Time{}, false
+	}
+
+	return time.Unix(claims.Exp, 0), true
+}
@@ -0,0 +1,134 @@
+package k8s
+
+import (
+	"encoding/base64"
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func writeKubeconfig(t *testing.T, dir, user string) string {
+	t.Helper()
+	content := fmt.Sprintf(`apiVersion: v1
+kind: Config
+contexts:
+- name: test
+  context:
+    cluster: test
+    user: test-user
+users:
+- name: test-user
+  user:
+%s
+`, user)
+	path := filepath.Join(dir, "kubeconfig")
+	require.NoError(t, os.WriteFile(path, []byte(content), 0600))
+	return path
+}
+
+func fakeJWT(exp int64) string {
+	payload := base64.RawURLEncoding.EncodeToString([]byte(fmt.Sprintf(`{"sub":"test","exp":%d}`, exp)))
+	return "eyJhbGciOiJub25lIn0." + payload + ".signature"
+}
+
+func TestCredentialExpiry(t *testing.T) {
+	expiry := time.Date(2030, 1, 2, 3, 4, 5, 0, time.UTC)
+
+	tests := []struct {
+		name       string
+		user       string
+		script     string
+		wantSource string
+		wantNil    bool
+		wantErr    bool
+	}{
+		{
+			name:       "jwt token",
+			user:       "    token: " + fakeJWT(expiry.Unix()),
+			wantSource: CredentialSourceToken,
+		},
+		{
+			name:    "opaque token",
+			user:    "    token: not-a-jwt",
+			wantNil: true,
+		},
+		{
+			name: "auth-provider expiry",
+			user: `    auth-provider:
+      name: oidc
+      config:
+        expiry: "2030-01-02T03:04:05Z"`,
+			wantSource: CredentialSourceAuthProvider,
+		},
+		{
+			name: "exec plugin",
+			user: `    exec:
+      apiVersion: client.authentication.k8s.io/v1beta1
+      command: ./plugin.sh`,
+			script:     `echo '{"kind":"ExecCredential","status":{"token":"abc","expirationTimestamp":"2030-01-02T03:04:05Z"}}'`,
+			wantSource: CredentialSourceExec,
+		},
+		{
+			name: "exec plugin without expiry",
+			user: `    exec:
+      apiVersion: client.authentication.k8s.io/v1beta1
+      command: ./plugin.sh`,
+			script:  `echo '{"kind":"ExecCredential","status":{"token":"abc"}}'`,
+			wantNil: true,
+		},
+		{
+			name: "exec plugin failure",
+			user: `    exec:
+      apiVersion: client.authentication.k8s.io/v1beta1
+      command: ./plugin.sh`,
+			script:  `exit 1`,
+			wantErr: true,
+		},
+		{
+			name:    "client certificate",
+			user:    "    client-certificate-data: Zm9v",
+			wantNil: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			dir := t.TempDir()
+			if tt.script != "" {
+				script := "#!/bin/sh\n" + tt.script + "\n"
+				require.NoError(t, os.WriteFile(filepath.Join(dir, "plugin.sh"), []byte(script), 0755))
+			}
+			adapter := NewKubectlAdapter(writeKubeconfig(t, dir, tt.user))
+
+			cred, err := adapter.CredentialExpiry("test")
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			if tt.wantNil {
+				assert.Nil(t, cred)
+				return
+			}
+
+			require.NotNil(t, cred)
+			assert.Equal(t, "test", cred.Context)
+			assert.Equal(t, tt.wantSource, cred.Source)
+			assert.True(t, expiry.Equal(cred.ExpiresAt), "got expiry %v", cred.ExpiresAt)
+			assert.Equal(t, tt.wantSource == CredentialSourceExec, cred.Refreshable())
+		})
+	}
+}
+
+func TestCredentialExpiry_UnknownContext(t *testing.T) {
+	adapter := NewKubectlAdapter(writeKubeconfig(t, t.TempDir(), "    token: abc"))
+
+	cred, err := adapter.CredentialExpiry("missing")
+	require.NoError(t, err)
+	assert.Nil(t, cred)
+}
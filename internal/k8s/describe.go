@@ -0,0 +1,51 @@
+package k8s
+
+import (
+	"errors"
+	"fmt"
+	"os/exec"
+	"strings"
+)
+
+// DescribePod returns the output of kubectl describe pod for the given pod
+func (k *KubectlAdapter) DescribePod(ctxName, namespace, pod string) (string, error) {
+	if err := validateContextName(ctxName); err != nil {
+		return "", err
+	}
+	if err := validateNamespaceName(namespace); err != nil {
+		return "", err
+	}
+	if err := validatePodName(pod); err != nil {
+		return "", err
+	}
+
+	kubectlPath, err := k.lookKubectl()
+	if err != nil {
+		return "", err
+	}
+
+	kubeconfigArgs, err := k.kubeconfigArgs(ctxName)
+	if err != nil {
+		return "", err
+	}
+
+	args := append(kubeconfigArgs, "--context", ctxName, "describe", "pod", pod, "-n", namespace)
+	output, err := k.run(ctxName, RetryOperation("describe", namespace), kubectlPath, args)
+	if err != nil {
+		if errors.Is(err, ErrTimeout) {
+			return "", err
+		}
+
+		if exitErr, ok := err.(*exec.ExitError); ok {
+			stderr := string(exitErr.Stderr)
+			if strings.Contains(stderr, "forbidden") || strings.Contains(stderr, "Forbidden") {
+				return "", fmt.Errorf("%w: %s", ErrPermissionDenied, stderr)
+			}
+			return "", fmt.Errorf("kubectl command failed: %s", stderr)
+		}
+
+		return "", fmt.Errorf("failed to execute kubectl: %w", err)
+	}
+
+	return string(output), nil
+}
@@ -0,0 +1,50 @@
+package k8s
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// stubKubectl puts a kubectl script with the given body first on PATH
+func stubKubectl(t *testing.T, body string) {
+	t.Helper()
+	dir := t.TempDir()
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "kubectl"), []byte("#!/bin/sh\n"+body), 0o755))
+	t.Setenv("PATH", dir)
+}
+
+func TestDescribePod(t *testing.T) {
+	// The stub echoes its arguments so the test can check the command line
+	stubKubectl(t, `echo "Name: web-1"; echo "args: $*"`)
+
+	output, err := NewKubectlAdapter("").DescribePod("minikube", "default", "web-1")
+	require.NoError(t, err)
+	assert.Contains(t, output, "Name: web-1")
+	assert.Contains(t, output, "args: --context minikube describe pod web-1 -n default")
+}
+
+func TestDescribePod_Errors(t *testing.T) {
+	adapter := NewKubectlAdapter("")
+
+	t.Run("invalid pod name", func(t *testing.T) {
+		_, err := adapter.DescribePod("minikube", "default", "web; rm -rf /")
+		assert.Error(t, err)
+	})
+
+	t.Run("forbidden", func(t *testing.T) {
+		stubKubectl(t, `echo 'Error from server (Forbidden): pods "web-1" is forbidden' >&2; exit 1`)
+		_, err := adapter.DescribePod("minikube", "default", "web-1")
+		assert.ErrorIs(t, err, ErrPermissionDenied)
+	})
+
+	t.Run("not found", func(t *testing.T) {
+		stubKubectl(t, `echo 'Error from server (NotFound): pods "web-1" not found' >&2; exit 1`)
+		_, err := adapter.DescribePod("minikube", "default", "web-1")
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "not found")
+	})
+}
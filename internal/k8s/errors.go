@@ -0,0 +1,62 @@
+package k8s
+
+import "strings"
+
+// ErrorSummary is a concise, human-readable description of a kubectl failure
+type ErrorSummary struct {
+	Summary    string
+	Suggestion string
+}
+
+// errorPhrases maps lower-case kubectl stderr phrases to summaries, most specific first
+var errorPhrases = []struct {
+	phrases []string
+	summary ErrorSummary
+}{
+	{
+		phrases: []string{"certificate has expired", "certificate is not yet valid"},
+		summary: ErrorSummary{
+			Summary:    "Cluster certificate has expired",
+			Suggestion: "Renew the client or cluster certificate in your kubeconfig",
+		},
+	},
+	{
+		phrases: []string{"forbidden"},
+		summary: ErrorSummary{
+			Summary:    "Access denied: your account is not allowed to do this",
+			Suggestion: "Ask a cluster admin for the required RBAC permissions",
+		},
+	},
+	{
+		phrases: []string{"connection refused"},
+		summary: ErrorSummary{
+			Summary:    "Cluster API server refused the connection",
+			Suggestion: "Check that the cluster is running and the server address in your kubeconfig is correct",
+		},
+	},
+	{
+		phrases: []string{"no route to host"},
+		summary: ErrorSummary{
+			Summary:    "Cluster API server is unreachable (no route to host)",
+			Suggestion: "Check your VPN or network connection",
+		},
+	},
+}
+
+// SummarizeError maps common kubectl error phrases to a concise summary.
+// Returns false when the error is not recognized.
+func SummarizeError(err error) (ErrorSummary, bool) {
+	if err == nil {
+		return ErrorSummary{}, false
+	}
+
+	text := strings.ToLower(err.Error())
+	for _, entry := range errorPhrases {
+		for _, phrase := range entry.phrases {
+			if strings.Contains(text, phrase) {
+				return entry.summary, true
+			}
+		}
+	}
+	return ErrorSummary{}, false
+}
@@ -0,0 +1,56 @@
+package k8s
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSummarizeError(t *testing.T) {
+	tests := []struct {
+		name    string
+		err     error
+		want    string
+		matched bool
+	}{
+		{
+			name:    "forbidden",
+			err:     fmt.Errorf("%w: %s", ErrPermissionDenied, `Error from server (Forbidden): pods is forbidden: User "dev" cannot list resource "pods"`),
+			want:    "Access denied: your account is not allowed to do this",
+			matched: true,
+		},
+		{
+			name:    "connection refused",
+			err:     errors.New("kubectl command failed: The connection to the server 127.0.0.1:6443 was refused - did you specify the right host or port?: dial tcp 127.0.0.1:6443: connect: connection refused"),
+			want:    "Cluster API server refused the connection",
+			matched: true,
+		},
+		{
+			name:    "no route to host",
+			err:     errors.New("Unable to connect to the server: dial tcp 10.0.0.1:443: connect: no route to host"),
+			want:    "Cluster API server is unreachable (no route to host)",
+			matched: true,
+		},
+		{
+			name:    "certificate expired wins over forbidden",
+			err:     errors.New("Unable to connect to the server: x509: certificate has expired or is not yet valid: forbidden"),
+			want:    "Cluster certificate has expired",
+			matched: true,
+		},
+		{name: "unknown", err: errors.New("something else"), matched: false},
+		{name: "nil", err: nil, matched: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			summary, ok := SummarizeError(tt.err)
+			assert.Equal(t, tt.matched, ok)
+			assert.Equal(t, tt.want, summary.Summary)
+			if ok {
+				assert.NotEmpty(t, summary.Suggestion)
+			}
+		})
+	}
+}
@@ -0,0 +1,189 @@
+package k8s
+
+import (
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+// GitOps annotation and label keys
+const (
+	argoTrackingIDAnnotation = "argocd.argoproj.io/tracking-id"
+	argoInstanceLabel        = "argocd.argoproj.io/instance"
+	fluxKustomizationName    = "kustomize.toolkit.fluxcd.io/name"
+	fluxKustomizationNS      = "kustomize.toolkit.fluxcd.io/namespace"
+	fluxHelmReleaseName      = "helm.toolkit.fluxcd.io/name"
+	fluxHelmReleaseNS        = "helm.toolkit.fluxcd.io/namespace"
+)
+
+// revisionKeys are label/annotation keys commonly used to stamp the git commit onto workloads
+var revisionKeys = []string{
+	"org.opencontainers.image.revision",
+	"git-commit",
+	"git.commit",
+	"git-sha",
+	"commit-sha",
+	"vcs-ref",
+}
+
+// sourceKeys are label/annotation keys commonly used to record the source repository
+var sourceKeys = []string{
+	"org.opencontainers.image.source",
+	"git-repository",
+	"vcs-url",
+}
+
+// ownerKindPattern validates owner kinds before they are passed to kubectl
+var ownerKindPattern = regexp.MustCompile(`^[A-Za-z]+$`)
+
+// maxOwnerDepth limits how far the owner chain is followed (pod → ReplicaSet → Deployment)
+const maxOwnerDepth = 3
+
+// GitOpsInfo connects a running pod back to the GitOps source that deployed it
+type GitOpsInfo struct {
+	WorkloadKind      string // Top-level owner of the pod (e.g. Deployment)
+	WorkloadName      string
+	ArgoApp           string // Argo CD application name
+	ArgoTrackingID    string // Raw Argo CD tracking id
+	FluxKustomization string // namespace/name of the Flux Kustomization
+	FluxHelmRelease   string // namespace/name of the Flux HelmRelease
+	Revision          string // Git commit SHA
+	Source            string // Source repository URL
+}
+
+// Empty reports whether no GitOps metadata was found
+func (g *GitOpsInfo) Empty() bool {
+	return g.ArgoApp == "" && g.FluxKustomization == "" && g.FluxHelmRelease == "" && g.Revision == "" && g.Source == ""
+}
+
+// Link returns a link to the deployed commit when both source and revision are known,
+// otherwise the source repository URL (or "" when unknown)
+func (g *GitOpsInfo) Link() string {
+	if g.Source == "" {
+		return ""
+	}
+	source := strings.TrimSuffix(strings.TrimSuffix(g.Source, "/"), ".git")
+	if g.Revision != "" && strings.HasPrefix(source, "http") {
+		return source + "/commit/" + g.Revision
+	}
+	return source
+}
+
+// GetGitOpsInfo reads GitOps annotations from the pod's top-level owner (following the
+// owner chain, e.g. ReplicaSet → Deployment) and falls back to the pod's own metadata
+func (k *KubectlAdapter) GetGitOpsInfo(ctxName, namespace string, pod Pod) (*GitOpsInfo, error) {
+	info := &GitOpsInfo{WorkloadKind: "Pod", WorkloadName: pod.Name}
+	// Metadata is ordered from the workload down to the pod so owners take precedence
+	metas := []PodMetadata{{Name: pod.Name, Labels: pod.Labels, Annotations: pod.Annotations}}
+
+	kind, name := pod.OwnerKind, pod.OwnerName
+	for depth := 0; depth < maxOwnerDepth && kind != "" && name != ""; depth++ {
+		meta, err := k.getObjectMetadata(ctxName, namespace, kind, name)
+		if err != nil {
+			return nil, err
+		}
+		info.WorkloadKind, info.WorkloadName = kind, name
+		metas = append([]PodMetadata{meta}, metas...)
+
+		owner, ok := controllerOf(meta.OwnerReferences)
+		if !ok {
+			break
+		}
+		kind, name = owner.Kind, owner.Name
+	}
+
+	applyGitOpsMetadata(info, metas)
+	return info, nil
+}
+
+// applyGitOpsMetadata fills info from the first metadata entry carrying each field
+func applyGitOpsMetadata(info *GitOpsInfo, metas []PodMetadata) {
+	for _, meta := range metas {
+		if info.ArgoTrackingID == "" {
+			info.ArgoTrackingID = meta.Annotations[argoTrackingIDAnnotation]
+		}
+		if info.ArgoApp == "" {
+			info.ArgoApp = meta.Labels[argoInstanceLabel]
+		}
+		if info.FluxKustomization == "" {
+			info.FluxKustomization = namespacedName(meta.Labels[fluxKustomizationNS], meta.Labels[fluxKustomizationName])
+		}
+		if info.FluxHelmRelease == "" {
+			info.FluxHelmRelease = namespacedName(meta.Labels[fluxHelmReleaseNS], meta.Labels[fluxHelmReleaseName])
+		}
+		if info.Revision == "" {
+			info.Revision = lookupKeys(meta, revisionKeys)
+		}
+		if info.Source == "" {
+			info.Source = lookupKeys(meta, sourceKeys)
+		}
+	}
+
+	// Tracking id format: <app>:<group>/<kind>:<namespace>/<name>
+	if info.ArgoApp == "" && info.ArgoTrackingID != "" {
+		info.ArgoApp = strings.SplitN(info.ArgoTrackingID, ":", 2)[0]
+	}
+}
+
+// lookupKeys returns the first value found for keys in annotations, then labels
+func lookupKeys(meta PodMetadata, keys []string) string {
+	for _, key := range keys {
+		if value := meta.Annotations[key]; value != "" {
+			return value
+		}
+		if value := meta.Labels[key]; value != "" {
+			return value
+		}
+	}
+	return ""
+}
+
+// namespacedName joins namespace and name, omitting an empty namespace
+func namespacedName(namespace, name string) string {
+	if name == "" {
+		return ""
+	}
+	if namespace == "" {
+		return name
+	}
+	return namespace + "/" + name
+}
+
+// controllerOf returns the controlling owner reference, or the first owner when none is marked
+func controllerOf(owners []OwnerReference) (OwnerReference, bool) {
+	for _, owner := range owners {
+		if owner.Controller {
+			return owner, true
+		}
+	}
+	if len(owners) > 0 {
+		return owners[0], true
+	}
+	return OwnerReference{}, false
+}
+
+// getObjectMetadata fetches the metadata of a namespaced object using kubectl
+func (k *KubectlAdapter) getObjectMetadata(ctxName, namespace, kind, name string) (PodMetadata, error) {
+	// Validate inputs for security
+	if err := validateNamespaceName(namespace); err != nil {
+		return PodMetadata{}, err
+	}
+	if !ownerKindPattern.MatchString(kind) {
+		return PodMetadata{}, fmt.Errorf("invalid owner kind '%s'", kind)
+	}
+	if err := validatePodName(name); err != nil {
+		return PodMetadata{}, err
+	}
+
+	output, err := k.kubectlGet(ctxName, RetryOperation(strings.ToLower(kind), namespace), strings.ToLower(kind)+"/"+name, "-n", namespace, "-o", "json")
+	if err != nil {
+		return PodMetadata{}, err
+	}
+
+	var item ObjectItem
+	if err := json.Unmarshal(output, &item); err != nil {
+		return PodMetadata{}, fmt.Errorf("failed to parse kubectl output: %w", err)
+	}
+	return item.Metadata, nil
+}
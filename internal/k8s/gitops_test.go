@@ -0,0 +1,95 @@
+package k8s
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestApplyGitOpsMetadata(t *testing.T) {
+	tests := []struct {
+		name  string
+		metas []PodMetadata
+		want  GitOpsInfo
+	}{
+		{
+			name: "argo tracking id on deployment",
+			metas: []PodMetadata{
+				{Annotations: map[string]string{argoTrackingIDAnnotation: "shop:apps/Deployment:prod/web"}},
+				{Labels: map[string]string{"git-commit": "abc123"}},
+			},
+			want: GitOpsInfo{ArgoApp: "shop", ArgoTrackingID: "shop:apps/Deployment:prod/web", Revision: "abc123"},
+		},
+		{
+			name: "flux kustomization and helm release labels",
+			metas: []PodMetadata{
+				{Labels: map[string]string{
+					fluxKustomizationName: "apps",
+					fluxKustomizationNS:   "flux-system",
+					fluxHelmReleaseName:   "web",
+				}},
+			},
+			want: GitOpsInfo{FluxKustomization: "flux-system/apps", FluxHelmRelease: "web"},
+		},
+		{
+			name: "owner values take precedence over pod values",
+			metas: []PodMetadata{
+				{Annotations: map[string]string{"org.opencontainers.image.revision": "owner-sha"}},
+				{Annotations: map[string]string{"org.opencontainers.image.revision": "pod-sha", "org.opencontainers.image.source": "https://github.com/acme/web.git"}},
+			},
+			want: GitOpsInfo{Revision: "owner-sha", Source: "https://github.com/acme/web.git"},
+		},
+		{
+			name:  "no gitops metadata",
+			metas: []PodMetadata{{Labels: map[string]string{"app": "web"}}},
+			want:  GitOpsInfo{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var info GitOpsInfo
+			applyGitOpsMetadata(&info, tt.metas)
+			assert.Equal(t, tt.want, info)
+			assert.Equal(t, tt.name == "no gitops metadata", info.Empty())
+		})
+	}
+}
+
+func TestGitOpsInfo_Link(t *testing.T) {
+	tests := []struct {
+		info GitOpsInfo
+		want string
+	}{
+		{GitOpsInfo{Source: "https://github.com/acme/web.git", Revision: "abc123"}, "https://github.com/acme/web/commit/abc123"},
+		{GitOpsInfo{Source: "https://github.com/acme/web/"}, "https://github.com/acme/web"},
+		{GitOpsInfo{Source: "git@github.com:acme/web.git", Revision: "abc123"}, "git@github.com:acme/web"},
+		{GitOpsInfo{Revision: "abc123"}, ""},
+	}
+
+	for _, tt := range tests {
+		assert.Equal(t, tt.want, tt.info.Link())
+	}
+}
+
+func TestPodFromItem_Owner(t *testing.T) {
+	var item PodItem
+	require.NoError(t, json.Unmarshal([]byte(`{
+		"metadata": {
+			"name": "web-7d9f-abcde",
+			"labels": {"app": "web"},
+			"ownerReferences": [
+				{"kind": "Node", "name": "node-1"},
+				{"kind": "ReplicaSet", "name": "web-7d9f", "controller": true}
+			]
+		},
+		"status": {"phase": "Running"}
+	}`), &item))
+
+	pod := podFromItem(item)
+	assert.Equal(t, "ReplicaSet", pod.OwnerKind)
+	assert.Equal(t, "web-7d9f", pod.OwnerName)
+	assert.Equal(t, "web", pod.Labels["app"])
+}
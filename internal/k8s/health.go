@@ -0,0 +1,89 @@
+package k8s
+
+import (
+	"errors"
+	"fmt"
+	"os/exec"
+	"strings"
+	"time"
+)
+
+// Health of a context found by ProbeContext
+const (
+	HealthReachable   = "reachable"   // The API server answered
+	HealthAuthError   = "auth-error"  // The API server or credential plugin rejected the credentials
+	HealthTimeout     = "timeout"     // The API server did not answer in time
+	HealthUnreachable = "unreachable" // The API server could not be reached, e.g. connection refused
+)
+
+// timeoutPhrases are lower-case kubectl stderr phrases of requests that ran out of time
+var timeoutPhrases = []string{
+	"i/o timeout",
+	"context deadline exceeded",
+	"client.timeout exceeded",
+	"tls handshake timeout",
+	"timeout awaiting response headers",
+}
+
+// ContextHealth is the result of probing a context
+type ContextHealth struct {
+	Status  string        // One of the Health constants
+	Detail  string        // First line of the kubectl error, "" when reachable
+	Latency time.Duration // How long the probe took
+}
+
+// ProbeContext checks whether the API server of ctxName answers within timeout, with kubectl
+// version. The probe is not retried. Failures of the probe itself, e.g. a context missing
+// from kubeconfig, are returned as errors.
+func (k *KubectlAdapter) ProbeContext(ctxName string, timeout time.Duration) (*ContextHealth, error) {
+	if err := validateContextName(ctxName); err != nil {
+		return nil, err
+	}
+
+	kubectlPath, err := k.lookKubectl()
+	if err != nil {
+		return nil, err
+	}
+
+	kubeconfigArgs, err := k.kubeconfigArgs(ctxName)
+	if err != nil {
+		return nil, err
+	}
+
+	// kubectl gives up on the request itself first, so its error says why; the process is
+	// killed a little later in case an exec credential plugin hangs
+	args := append(kubeconfigArgs, "--context", ctxName, "--request-timeout", timeout.String(), "version", "-o", "json")
+	start := time.Now()
+	_, err = k.runWithin(ctxName, kubectlPath, args, timeout+time.Second)
+	health := &ContextHealth{Status: HealthReachable, Latency: time.Since(start)}
+	if err == nil {
+		return health, nil
+	}
+	if errors.Is(err, ErrTimeout) {
+		health.Status, health.Detail = HealthTimeout, err.Error()
+		return health, nil
+	}
+
+	var exitErr *exec.ExitError
+	if !errors.As(err, &exitErr) {
+		return nil, fmt.Errorf("failed to execute kubectl: %w", err)
+	}
+	stderr := strings.TrimSpace(string(exitErr.Stderr))
+	health.Status = healthStatus(stderr)
+	health.Detail, _, _ = strings.Cut(stderr, "\n")
+	return health, nil
+}
+
+// healthStatus classifies the stderr of a failed probe
+func healthStatus(stderr string) string {
+	if authProblem(stderr) != "" {
+		return HealthAuthError
+	}
+	text := strings.ToLower(stderr)
+	for _, phrase := range timeoutPhrases {
+		if strings.Contains(text, phrase) {
+			return HealthTimeout
+		}
+	}
+	return HealthUnreachable
+}
@@ -0,0 +1,75 @@
+package k8s
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestProbeContext(t *testing.T) {
+	adapter := NewKubectlAdapter("")
+
+	tests := []struct {
+		name   string
+		stub   string
+		status string
+		detail string
+	}{
+		{
+			name:   "reachable",
+			stub:   `echo '{"serverVersion": {"gitVersion": "v1.30.2"}}'`,
+			status: HealthReachable,
+		},
+		{
+			name:   "unauthorized",
+			stub:   `echo 'error: You must be logged in to the server (Unauthorized)' >&2; exit 1`,
+			status: HealthAuthError,
+			detail: "error: You must be logged in to the server (Unauthorized)",
+		},
+		{
+			name:   "expired login",
+			stub:   `echo 'error: oidc: token is expired' >&2; echo 'more' >&2; exit 1`,
+			status: HealthAuthError,
+			detail: "error: oidc: token is expired",
+		},
+		{
+			name:   "request timeout",
+			stub:   `echo 'Unable to connect to the server: dial tcp 10.0.0.1:443: i/o timeout' >&2; exit 1`,
+			status: HealthTimeout,
+			detail: "Unable to connect to the server: dial tcp 10.0.0.1:443: i/o timeout",
+		},
+		{
+			name:   "connection refused",
+			stub:   `echo 'The connection to the server localhost:8080 was refused' >&2; exit 1`,
+			status: HealthUnreachable,
+			detail: "The connection to the server localhost:8080 was refused",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			stubKubectl(t, tt.stub)
+			health, err := adapter.ProbeContext("minikube", 5*time.Second)
+			require.NoError(t, err)
+			assert.Equal(t, tt.status, health.Status)
+			assert.Equal(t, tt.detail, health.Detail)
+		})
+	}
+}
+
+func TestProbeContext_Args(t *testing.T) {
+	stubKubectl(t, `echo "$*" >&2; exit 1`)
+	health, err := NewKubectlAdapter("").ProbeContext("minikube", 3*time.Second)
+	require.NoError(t, err)
+	assert.Equal(t, "--context minikube --request-timeout 3s version -o json", health.Detail)
+}
+
+func TestProbeContext_KilledAfterTimeout(t *testing.T) {
+	// A hanging credential plugin keeps kubectl from giving up on its own
+	stubKubectl(t, `exec /bin/sleep 5`)
+	health, err := NewKubectlAdapter("").ProbeContext("minikube", 10*time.Millisecond)
+	require.NoError(t, err)
+	assert.Equal(t, HealthTimeout, health.Status)
+	assert.Less(t, health.Latency, 4*time.Second)
+}
@@ -10,7 +10,9 @@ import (
 	"os/exec"
 	"path/filepath"
 	"regexp"
+	"slices"
 	"strings"
+	"sync/atomic"
 	"time"
 
 	"github.com/maratkarimov/kubertino/internal/config"
@@ -26,22 +28,133 @@ var (
 	ErrKubectlNotFound    = errors.New("kubectl not found in PATH")
 	ErrPermissionDenied   = errors.New("permission denied")
 	ErrTimeout            = errors.New("operation timeout")
+	ErrMetricsUnavailable = errors.New("metrics API not available")
 )
 
 // KubectlAdapter implements KubeAdapter by reading kubeconfig files
 type KubectlAdapter struct {
 	kubeconfigPath string
+	// kubectl binary run for every call; "" looks up kubectl in PATH
+	kubectl string
+	// Kubeconfig of a context replacing kubeconfigPath; nil or "" uses kubeconfigPath
+	contextKubeconfig func(ctxName string) string
+	limiter           *contextLimiter
+	throttle          *contextThrottle // nil when unthrottled
+	retry             retryPolicy
+	retries           retryTracker // Calls currently being retried
+	// Namespaces to show when listing them is forbidden; nil disables the fallback
+	namespaceFallback func(ctxName string) (namespaces, candidates []string)
+	fallbacks         fallbackTracker // Contexts whose namespaces came from the fallback
+	// Label selector pods are listed with; nil lists every pod
+	podSelector func(ctxName string) string
+	// Expressions of the custom pod columns; replaced when the configuration is reloaded
+	podFields atomic.Pointer[podFieldPaths]
 }
 
-// NewKubectlAdapter creates a new KubectlAdapter with the specified kubeconfig path
+// NewKubectlAdapter creates a new KubectlAdapter with the specified kubeconfig path.
+// At most config.DefaultKubectlConcurrency kubectl processes run per context until
+// SetConcurrencyLimit is called.
 func NewKubectlAdapter(kubeconfigPath string) *KubectlAdapter {
 	return &KubectlAdapter{
 		kubeconfigPath: kubeconfigPath,
+		retry:          defaultRetryPolicy,
+		limiter: newContextLimiter(func(string) int {
+			return config.DefaultKubectlConcurrency
+		}),
 	}
 }
 
-// GetContexts reads the kubeconfig file and returns available context names
+// SetContextKubeconfig sets the kubeconfig file kubectl reads for a context (e.g.
+// cfg.KubeconfigPath). An empty path uses the adapter's kubeconfig. Call it before the
+// adapter is used.
+func (k *KubectlAdapter) SetContextKubeconfig(kubeconfig func(ctxName string) string) {
+	k.contextKubeconfig = kubeconfig
+}
+
+// kubeconfigFor returns the kubeconfig file configured for ctxName, or "" when kubectl
+// applies its own KUBECONFIG merge
+func (k *KubectlAdapter) kubeconfigFor(ctxName string) string {
+	if k.contextKubeconfig != nil {
+		if path := k.contextKubeconfig(ctxName); path != "" {
+			return path
+		}
+	}
+	return k.kubeconfigPath
+}
+
+// SetKubectlPath sets the kubectl binary the adapter runs (e.g. cfg.KubectlPath). An empty
+// path looks up kubectl in PATH. Call it before the adapter is used.
+func (k *KubectlAdapter) SetKubectlPath(path string) {
+	k.kubectl = path
+}
+
+// lookKubectl returns the path of the kubectl binary the adapter runs
+func (k *KubectlAdapter) lookKubectl() (string, error) {
+	return LookKubectl(k.kubectl)
+}
+
+// kubectlName returns the kubectl binary as configured, for commands run outside the adapter
+func (k *KubectlAdapter) kubectlName() string {
+	if k.kubectl == "" {
+		return "kubectl"
+	}
+	if path, err := expandPath(k.kubectl); err == nil {
+		return path
+	}
+	return k.kubectl
+}
+
+// LookKubectl returns the path of the kubectl binary at path (~ is expanded), or of kubectl
+// in PATH when path is empty. A missing binary fails with ErrKubectlNotFound.
+func LookKubectl(path string) (string, error) {
+	if path == "" {
+		path = "kubectl"
+	}
+	expanded, err := expandPath(path)
+	if err != nil {
+		return "", fmt.Errorf("%w: %v", ErrKubectlNotFound, err)
+	}
+	found, err := exec.LookPath(expanded)
+	if err != nil {
+		return "", fmt.Errorf("%w: %v", ErrKubectlNotFound, err)
+	}
+	return found, nil
+}
+
+// SetConcurrencyLimit sets how many kubectl processes may run at once for a context
+// (e.g. cfg.KubectlLimit). Call it before the adapter is used.
+func (k *KubectlAdapter) SetConcurrencyLimit(limit func(ctxName string) int) {
+	k.limiter = newContextLimiter(limit)
+}
+
+// SetRateLimit throttles how many kubectl processes start per second for a context, with
+// up to burst starting at once (e.g. cfg.KubectlRate). Call it before the adapter is used.
+func (k *KubectlAdapter) SetRateLimit(rate func(ctxName string) (qps float64, burst int)) {
+	k.throttle = newContextThrottle(rate)
+}
+
+// Throttled reports whether kubectl calls for context are currently being delayed by the
+// rate limit
+func (k *KubectlAdapter) Throttled(context string) bool {
+	return k.throttle != nil && k.throttle.throttled(context, time.Now())
+}
+
+// acquire waits until a kubectl process may start for ctxName and returns the function
+// releasing its slot. The rate limit is waited for first so throttled requests hold no slot.
+func (k *KubectlAdapter) acquire(ctxName string) func() {
+	if k.throttle != nil {
+		k.throttle.wait(ctxName)
+	}
+	return k.limiter.acquire(ctxName)
+}
+
+// GetContexts reads the kubeconfig file and returns available context names.
+// Without an explicit kubeconfig path, contexts are merged from KUBECONFIG or ~/.kube/config.
 func (k *KubectlAdapter) GetContexts() ([]string, error) {
+	if k.kubeconfigPath == "" {
+		return config.DiscoverContexts(config.KubeconfigPaths())
+	}
+
 	kubeconfigPath, err := expandPath(k.kubeconfigPath)
 	if err != nil {
 		return nil, fmt.Errorf("failed to expand kubeconfig path: %w", err)
@@ -92,37 +205,44 @@ func validateContextName(name string) error {
 	return nil
 }
 
-// GetNamespaces fetches namespaces for the specified context using kubectl
-func (k *KubectlAdapter) GetNamespaces(ctxName string) ([]string, error) {
+// GetNamespaces fetches namespaces for the specified context using kubectl. When listing
+// them is forbidden, the namespace fallback set with SetNamespaceFallback is used instead.
+func (k *KubectlAdapter) GetNamespaces(ctxName string) ([]Namespace, error) {
+	namespaces, err := k.listNamespaces(ctxName)
+	if errors.Is(err, ErrPermissionDenied) && k.namespaceFallback != nil {
+		return k.fallbackNamespaces(ctxName, err)
+	}
+	k.fallbacks.set(ctxName, false)
+	return namespaces, err
+}
+
+// listNamespaces lists the namespaces of the specified context using kubectl
+func (k *KubectlAdapter) listNamespaces(ctxName string) ([]Namespace, error) {
 	// Validate context name for security
 	if err := validateContextName(ctxName); err != nil {
 		return nil, err
 	}
 
 	// Find kubectl in PATH
-	kubectlPath, err := exec.LookPath("kubectl")
+	kubectlPath, err := k.lookKubectl()
 	if err != nil {
-		return nil, fmt.Errorf("%w: %v", ErrKubectlNotFound, err)
+		return nil, err
 	}
 
-	// Expand kubeconfig path
-	kubeconfigPath, err := expandPath(k.kubeconfigPath)
+	// Resolve kubeconfig flag
+	kubeconfigArgs, err := k.kubeconfigArgs(ctxName)
 	if err != nil {
-		return nil, fmt.Errorf("failed to expand kubeconfig path: %w", err)
+		return nil, err
 	}
 
-	// Create context with timeout
-	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
-	defer cancel()
-
-	// Execute kubectl command
-	cmd := exec.CommandContext(ctx, kubectlPath, "--kubeconfig", kubeconfigPath, "--context", ctxName, "get", "namespaces", "-o", "json")
-	output, err := cmd.Output()
+	// Execute kubectl command, retrying timeouts and transient failures
+	args := append(kubeconfigArgs, "--context", ctxName, "get", "namespaces", "-o", "json")
+	output, err := k.run(ctxName, RetryOperation("namespaces", ""), kubectlPath, args)
 
 	if err != nil {
 		// Check for specific error types
-		if ctx.Err() == context.DeadlineExceeded {
-			return nil, fmt.Errorf("%w: kubectl command timed out after 10s", ErrTimeout)
+		if errors.Is(err, ErrTimeout) {
+			return nil, err
 		}
 
 		// Check for exit error to extract stderr
@@ -151,10 +271,17 @@ func (k *KubectlAdapter) GetNamespaces(ctxName string) ([]string, error) {
 		return nil, fmt.Errorf("failed to parse kubectl output: %w", err)
 	}
 
-	// Extract namespace names
-	namespaces := make([]string, 0, len(response.Items))
-	for _, ns := range response.Items {
-		namespaces = append(namespaces, ns.Metadata.Name)
+	namespaces := make([]Namespace, 0, len(response.Items))
+	for _, item := range response.Items {
+		ns := Namespace{
+			Name:   item.Metadata.Name,
+			Status: item.Status.Phase,
+			Labels: item.Metadata.Labels,
+		}
+		if created, ok := parseTimestamp(item.Metadata.CreationTime); ok {
+			ns.CreatedAt = created
+		}
+		namespaces = append(namespaces, ns)
 	}
 
 	return namespaces, nil
@@ -162,6 +289,13 @@ func (k *KubectlAdapter) GetNamespaces(ctxName string) ([]string, error) {
 
 // GetPods fetches pods for the specified context and namespace using kubectl
 func (k *KubectlAdapter) GetPods(ctxName, namespace string) ([]Pod, error) {
+	return k.GetSelectedPods(ctxName, namespace, "")
+}
+
+// GetSelectedPods fetches the pods of a namespace matching the label selector (e.g.
+// "app=web,tier=frontend"), on top of the context's pod selector. The API server does the
+// filtering, so only matching pods are transferred.
+func (k *KubectlAdapter) GetSelectedPods(ctxName, namespace, selector string) ([]Pod, error) {
 	// Validate context name for security
 	if err := validateContextName(ctxName); err != nil {
 		return nil, err
@@ -173,49 +307,25 @@ func (k *KubectlAdapter) GetPods(ctxName, namespace string) ([]Pod, error) {
 	}
 
 	// Find kubectl in PATH
-	kubectlPath, err := exec.LookPath("kubectl")
+	kubectlPath, err := k.lookKubectl()
 	if err != nil {
-		return nil, fmt.Errorf("%w: %v", ErrKubectlNotFound, err)
+		return nil, err
 	}
 
-	// Expand kubeconfig path
-	kubeconfigPath, err := expandPath(k.kubeconfigPath)
+	// Resolve kubeconfig flag
+	kubeconfigArgs, err := k.kubeconfigArgs(ctxName)
 	if err != nil {
-		return nil, fmt.Errorf("failed to expand kubeconfig path: %w", err)
+		return nil, err
 	}
 
-	// Create context with timeout
-	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
-	defer cancel()
-
-	// Execute kubectl command
-	cmd := exec.CommandContext(ctx, kubectlPath, "--kubeconfig", kubeconfigPath, "--context", ctxName, "get", "pods", "-n", namespace, "-o", "json")
-	output, err := cmd.Output()
-
+	// Execute kubectl command, retrying timeouts and transient failures
+	args := append(kubeconfigArgs, "--context", ctxName, "get", "pods", "-n", namespace, "-o", "json")
+	if selector := k.selector(ctxName, selector); selector != "" {
+		args = append(args, "-l", selector)
+	}
+	output, err := k.run(ctxName, RetryOperation("pods", namespace), kubectlPath, args)
 	if err != nil {
-		// Check for specific error types
-		if ctx.Err() == context.DeadlineExceeded {
-			return nil, fmt.Errorf("%w: kubectl command timed out after 10s", ErrTimeout)
-		}
-
-		// Check for exit error to extract stderr
-		if exitErr, ok := err.(*exec.ExitError); ok {
-			stderr := string(exitErr.Stderr)
-
-			// Check for permission denied
-			if strings.Contains(stderr, "forbidden") || strings.Contains(stderr, "Forbidden") {
-				return nil, fmt.Errorf("%w: %s", ErrPermissionDenied, stderr)
-			}
-
-			// Check for namespace not found
-			if strings.Contains(stderr, "namespace") && (strings.Contains(stderr, "not found") || strings.Contains(stderr, "does not exist")) {
-				return nil, fmt.Errorf("namespace not found: %s", namespace)
-			}
-
-			return nil, fmt.Errorf("kubectl command failed: %s", stderr)
-		}
-
-		return nil, fmt.Errorf("failed to execute kubectl: %w", err)
+		return nil, podsError(err, namespace)
 	}
 
 	// Parse JSON response
@@ -227,15 +337,84 @@ func (k *KubectlAdapter) GetPods(ctxName, namespace string) ([]Pod, error) {
 	// Convert to []Pod
 	pods := make([]Pod, 0, len(response.Items))
 	for _, item := range response.Items {
-		pods = append(pods, Pod{
-			Name:   item.Metadata.Name,
-			Status: item.Status.Phase,
-		})
+		pods = append(pods, podFromItem(item))
 	}
+	k.fillPodFields(pods, output)
 
 	return pods, nil
 }
 
+// podFromItem converts a kubectl pod item into a Pod
+func podFromItem(item PodItem) Pod {
+	pod := Pod{
+		Name:        item.Metadata.Name,
+		Status:      item.Status.Phase,
+		Labels:      item.Metadata.Labels,
+		Annotations: item.Metadata.Annotations,
+	}
+
+	if owner, ok := controllerOf(item.Metadata.OwnerReferences); ok {
+		pod.OwnerKind = owner.Kind
+		pod.OwnerName = owner.Name
+	}
+
+	for _, container := range item.Spec.Containers {
+		pod.Containers = append(pod.Containers, container.Name)
+		for _, port := range container.Ports {
+			pod.Ports = append(pod.Ports, port.ContainerPort)
+		}
+	}
+
+	ready := 0
+	for _, status := range item.Status.ContainerStatuses {
+		pod.Restarts += status.RestartCount
+		if status.Ready {
+			ready++
+		}
+	}
+	if len(item.Status.ContainerStatuses) > 0 {
+		pod.Ready = fmt.Sprintf("%d/%d", ready, len(item.Spec.Containers))
+	}
+	pod.Node = item.Spec.NodeName
+	pod.IP = item.Status.PodIP
+	pod.Hostname = item.Spec.Hostname
+	pod.Subdomain = item.Spec.Subdomain
+	pod.HostNetwork = item.Spec.HostNetwork
+	pod.Privileged, pod.Root = securityOf(item.Spec)
+	pod.CPURequest, pod.MemRequest = requestsOf(item.Spec)
+	if created, ok := parseTimestamp(item.Metadata.CreationTime); ok {
+		pod.CreatedAt = created
+	}
+
+	return pod
+}
+
+// securityOf returns the containers of spec, init containers included, that run privileged
+// and those set to run as root. A container runs as root when its runAsUser, or the pod's if
+// it sets none, is 0; an unset runAsUser leaves it to the image and is not flagged.
+func securityOf(spec PodSpec) (privileged, root []string) {
+	var podUser *int64
+	if spec.SecurityContext != nil {
+		podUser = spec.SecurityContext.RunAsUser
+	}
+
+	for _, container := range slices.Concat(spec.InitContainers, spec.Containers) {
+		user := podUser
+		if sc := container.SecurityContext; sc != nil {
+			if sc.Privileged != nil && *sc.Privileged {
+				privileged = append(privileged, container.Name)
+			}
+			if sc.RunAsUser != nil {
+				user = sc.RunAsUser
+			}
+		}
+		if user != nil && *user == 0 {
+			root = append(root, container.Name)
+		}
+	}
+	return privileged, root
+}
+
 // SwitchContext switches the current kubectl context using kubectl config use-context
 func (k *KubectlAdapter) SwitchContext(ctxName string) error {
 	// Validate context name for security
@@ -244,15 +423,15 @@ func (k *KubectlAdapter) SwitchContext(ctxName string) error {
 	}
 
 	// Find kubectl in PATH
-	kubectlPath, err := exec.LookPath("kubectl")
+	kubectlPath, err := k.lookKubectl()
 	if err != nil {
-		return fmt.Errorf("%w: %v", ErrKubectlNotFound, err)
+		return err
 	}
 
-	// Expand kubeconfig path
-	kubeconfigPath, err := expandPath(k.kubeconfigPath)
+	// Resolve kubeconfig flag
+	kubeconfigArgs, err := k.kubeconfigArgs(ctxName)
 	if err != nil {
-		return fmt.Errorf("failed to expand kubeconfig path: %w", err)
+		return err
 	}
 
 	// Create context with timeout (5 seconds for quick operation)
@@ -260,7 +439,8 @@ func (k *KubectlAdapter) SwitchContext(ctxName string) error {
 	defer cancel()
 
 	// Execute kubectl config use-context command
-	cmd := exec.CommandContext(ctx, kubectlPath, "--kubeconfig", kubeconfigPath, "config", "use-context", ctxName)
+	args := append(kubeconfigArgs, "config", "use-context", ctxName)
+	cmd := exec.CommandContext(ctx, kubectlPath, args...)
 	output, err := cmd.CombinedOutput()
 
 	if err != nil {
@@ -308,21 +488,33 @@ func validateNamespaceName(name string) error {
 	return nil
 }
 
-// MatchContexts compares configured contexts with available kubectl contexts
+// MatchContexts compares configured contexts with available kubectl contexts. A context with
+// its own kubeconfig is looked up in that file, the others in kubeconfigPath.
 // Returns matched contexts, missing contexts, and any error encountered
 func MatchContexts(cfg *config.Config, kubeconfigPath string) (matched, missing []string, err error) {
-	adapter := NewKubectlAdapter(kubeconfigPath)
-	availableContexts, err := adapter.GetContexts()
-	if err != nil {
-		return nil, nil, fmt.Errorf("failed to read kubectl contexts: %w", err)
-	}
+	available := make(map[string]map[string]bool) // Context names per kubeconfig file
+	for _, configCtx := range cfg.Contexts {
+		path := kubeconfigPath
+		if configCtx.Kubeconfig != "" {
+			path = configCtx.Kubeconfig
+		}
 
-	contextSet := make(map[string]bool)
-	for _, ctx := range availableContexts {
-		contextSet[ctx] = true
-	}
+		contextSet, ok := available[path]
+		if !ok {
+			availableContexts, err := NewKubectlAdapter(path).GetContexts()
+			if err != nil {
+				if configCtx.Kubeconfig != "" {
+					return nil, nil, fmt.Errorf("failed to read kubectl contexts of context %s: %w", configCtx.Name, err)
+				}
+				return nil, nil, fmt.Errorf("failed to read kubectl contexts: %w", err)
+			}
+			contextSet = make(map[string]bool)
+			for _, ctx := range availableContexts {
+				contextSet[ctx] = true
+			}
+			available[path] = contextSet
+		}
 
-	for _, configCtx := range cfg.Contexts {
 		if contextSet[configCtx.Name] {
 			matched = append(matched, configCtx.Name)
 		} else {
@@ -348,6 +540,16 @@ func ValidateContexts(cfg *config.Config, kubeconfigPath string) error {
 	return nil
 }
 
+// Preflight checks that kubectl (cfg.KubectlPath, or kubectl in PATH) exists and that at least one configured context exists
+// in kubeconfig. Both touch the filesystem, which can be slow on networked home directories,
+// so the TUI runs it in the background instead of before starting.
+func Preflight(cfg *config.Config, kubeconfigPath string) error {
+	if _, err := LookKubectl(cfg.KubectlPath); err != nil {
+		return err
+	}
+	return ValidateContexts(cfg, kubeconfigPath)
+}
+
 // ExecInPod returns a command configured to execute in a pod with interactive TTY
 func (k *KubectlAdapter) ExecInPod(ctxName, namespace, pod, container, command string) (*exec.Cmd, error) {
 	// Validate inputs for security
@@ -367,26 +569,25 @@ func (k *KubectlAdapter) ExecInPod(ctxName, namespace, pod, container, command s
 	}
 
 	// Find kubectl in PATH
-	kubectlPath, err := exec.LookPath("kubectl")
+	kubectlPath, err := k.lookKubectl()
 	if err != nil {
-		return nil, fmt.Errorf("%w: %v", ErrKubectlNotFound, err)
+		return nil, err
 	}
 
-	// Expand kubeconfig path
-	kubeconfigPath, err := expandPath(k.kubeconfigPath)
+	// Resolve kubeconfig flag
+	kubeconfigArgs, err := k.kubeconfigArgs(ctxName)
 	if err != nil {
-		return nil, fmt.Errorf("failed to expand kubeconfig path: %w", err)
+		return nil, err
 	}
 
 	// Build kubectl exec command with interactive TTY
-	args := []string{
-		"--kubeconfig", kubeconfigPath,
+	args := append(kubeconfigArgs,
 		"--context", ctxName,
 		"-n", namespace,
 		"exec",
 		"-it",
 		pod,
-	}
+	)
 
 	// Add container flag if specified (for multi-container pods)
 	if container != "" {
@@ -445,6 +646,60 @@ func validateContainerName(name string) error {
 	return nil
 }
 
+// kubeconfigArgs returns the --kubeconfig flag for kubectl invocations against ctxName.
+// Returns no arguments when no path is configured so kubectl applies its own KUBECONFIG merge.
+func (k *KubectlAdapter) kubeconfigArgs(ctxName string) ([]string, error) {
+	path := k.kubeconfigFor(ctxName)
+	if path == "" {
+		return nil, nil
+	}
+
+	kubeconfigPath, err := expandPath(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to expand kubeconfig path: %w", err)
+	}
+
+	return []string{"--kubeconfig", kubeconfigPath}, nil
+}
+
+// kubectlGet runs kubectl get with args against ctxName, retried like run under operation.
+// Failures are returned as ErrTimeout, ErrPermissionDenied for forbidden requests, or with the
+// stderr of kubectl.
+func (k *KubectlAdapter) kubectlGet(ctxName, operation string, args ...string) ([]byte, error) {
+	// Validate context name for security
+	if err := validateContextName(ctxName); err != nil {
+		return nil, err
+	}
+
+	// Find kubectl in PATH
+	kubectlPath, err := k.lookKubectl()
+	if err != nil {
+		return nil, err
+	}
+
+	// Resolve kubeconfig flag
+	kubeconfigArgs, err := k.kubeconfigArgs(ctxName)
+	if err != nil {
+		return nil, err
+	}
+
+	output, err := k.run(ctxName, operation, kubectlPath, append(append(kubeconfigArgs, "--context", ctxName, "get"), args...))
+	if err != nil {
+		if errors.Is(err, ErrTimeout) {
+			return nil, err
+		}
+		if exitErr, ok := err.(*exec.ExitError); ok {
+			stderr := string(exitErr.Stderr)
+			if strings.Contains(stderr, "forbidden") || strings.Contains(stderr, "Forbidden") {
+				return nil, fmt.Errorf("%w: %s", ErrPermissionDenied, stderr)
+			}
+			return nil, fmt.Errorf("kubectl command failed: %s", stderr)
+		}
+		return nil, fmt.Errorf("failed to execute kubectl: %w", err)
+	}
+	return output, nil
+}
+
 // expandPath expands ~ to the user's home directory
 func expandPath(path string) (string, error) {
 	if !strings.HasPrefix(path, "~") {
@@ -3,6 +3,7 @@ package k8s
 import (
 	"encoding/json"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -35,6 +36,37 @@ func TestGetPods_ParseJSON(t *testing.T) {
 			},
 			expectedError: false,
 		},
+		{
+			name: "security context",
+			jsonOutput: `{
+				"items": [
+					{
+						"metadata": {"name": "node-agent"},
+						"spec": {
+							"hostNetwork": true,
+							"securityContext": {"runAsUser": 0},
+							"initContainers": [{"name": "sysctl", "securityContext": {"privileged": true}}],
+							"containers": [
+								{"name": "agent"},
+								{"name": "exporter", "securityContext": {"runAsUser": 65534}}
+							]
+						},
+						"status": {"phase": "Running"}
+					}
+				]
+			}`,
+			expectedPods: []Pod{
+				{
+					Name:        "node-agent",
+					Status:      "Running",
+					Containers:  []string{"agent", "exporter"},
+					HostNetwork: true,
+					Privileged:  []string{"sysctl"},
+					Root:        []string{"sysctl", "agent"},
+				},
+			},
+			expectedError: false,
+		},
 		{
 			name:          "empty pod list",
 			jsonOutput:    `{"items": []}`,
@@ -76,6 +108,63 @@ func TestGetPods_ParseJSON(t *testing.T) {
 			},
 			expectedError: false,
 		},
+		{
+			name: "container ports",
+			jsonOutput: `{
+				"items": [
+					{
+						"metadata": {"name": "web"},
+						"spec": {"containers": [
+							{"name": "app", "ports": [{"containerPort": 8080}, {"containerPort": 9090}]},
+							{"name": "sidecar", "ports": [{"containerPort": 15000}]}
+						]},
+						"status": {"phase": "Running"}
+					}
+				]
+			}`,
+			expectedPods: []Pod{
+				{Name: "web", Status: "Running", Ports: []int{8080, 9090, 15000}, Containers: []string{"app", "sidecar"}},
+			},
+			expectedError: false,
+		},
+		{
+			name: "readiness, restarts, age and node",
+			jsonOutput: `{
+				"items": [
+					{
+						"metadata": {"name": "api", "creationTimestamp": "2024-05-01T10:00:00Z"},
+						"spec": {"nodeName": "node-a", "containers": [{"name": "app"}, {"name": "proxy"}]},
+						"status": {"phase": "Running", "containerStatuses": [
+							{"name": "app", "ready": true, "restartCount": 3},
+							{"name": "proxy", "ready": false, "restartCount": 1}
+						]}
+					}
+				]
+			}`,
+			expectedPods: []Pod{
+				{Name: "api", Status: "Running", Containers: []string{"app", "proxy"}, Restarts: 4, Ready: "1/2", Node: "node-a", CreatedAt: time.Date(2024, 5, 1, 10, 0, 0, 0, time.UTC)},
+			},
+			expectedError: false,
+		},
+		{
+			name: "resource requests",
+			jsonOutput: `{
+				"items": [
+					{
+						"metadata": {"name": "web"},
+						"spec": {"containers": [
+							{"name": "app", "resources": {"requests": {"cpu": "250m", "memory": "128Mi"}}},
+							{"name": "proxy", "resources": {"requests": {"cpu": "0.1"}}}
+						]},
+						"status": {"phase": "Running"}
+					}
+				]
+			}`,
+			expectedPods: []Pod{
+				{Name: "web", Status: "Running", Containers: []string{"app", "proxy"}, CPURequest: 350, MemRequest: 128 << 20},
+			},
+			expectedError: false,
+		},
 		{
 			name:          "invalid JSON",
 			jsonOutput:    `invalid json`,
@@ -106,10 +195,7 @@ func TestGetPods_ParseJSON(t *testing.T) {
 			// Convert to []Pod
 			pods := make([]Pod, 0, len(response.Items))
 			for _, item := range response.Items {
-				pods = append(pods, Pod{
-					Name:   item.Metadata.Name,
-					Status: item.Status.Phase,
-				})
+				pods = append(pods, podFromItem(item))
 			}
 
 			assert.Equal(t, tt.expectedPods, pods)
@@ -117,6 +203,14 @@ func TestGetPods_ParseJSON(t *testing.T) {
 	}
 }
 
+func TestPodSecurityWarnings(t *testing.T) {
+	assert.Empty(t, Pod{Name: "web"}.SecurityWarnings())
+	assert.Equal(t, []string{SecurityHostNetwork}, Pod{HostNetwork: true}.SecurityWarnings())
+	assert.Equal(t,
+		[]string{SecurityPrivileged, SecurityHostNetwork, SecurityRoot},
+		Pod{Privileged: []string{"app"}, HostNetwork: true, Root: []string{"app"}}.SecurityWarnings())
+}
+
 func TestValidateNamespaceName(t *testing.T) {
 	tests := []struct {
 		name          string
@@ -218,3 +312,22 @@ func TestPodTypes(t *testing.T) {
 	assert.Equal(t, "pod-1", podList.Items[0].Metadata.Name)
 	assert.Equal(t, "Running", podList.Items[0].Status.Phase)
 }
+
+func TestFormatAge(t *testing.T) {
+	tests := []struct {
+		age      time.Duration
+		expected string
+	}{
+		{45 * time.Second, "45s"},
+		{12 * time.Minute, "12m"},
+		{5 * time.Hour, "5h"},
+		{47 * time.Hour, "47h"},
+		{72 * time.Hour, "3d"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.expected, func(t *testing.T) {
+			assert.Equal(t, tt.expected, FormatAge(tt.age))
+		})
+	}
+}
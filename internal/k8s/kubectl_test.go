@@ -6,6 +6,7 @@ import (
 	"os"
 	"path/filepath"
 	"testing"
+	"time"
 
 	"github.com/maratkarimov/kubertino/internal/config"
 
@@ -187,6 +188,32 @@ func TestMatchContexts(t *testing.T) {
 			wantMissing:    nil,
 			wantErr:        true,
 		},
+		{
+			name: "context kubeconfig",
+			config: &config.Config{
+				Contexts: []config.Context{
+					{Name: "minikube", Kubeconfig: "../testdata/valid-kubeconfig.yml"},
+					{Name: "prod-cluster", Kubeconfig: "../testdata/empty-kubeconfig.yml"},
+				},
+			},
+			kubeconfigPath: "../testdata/nonexistent.yml",
+			wantMatched:    []string{"minikube"},
+			wantMissing:    []string{"prod-cluster"},
+			wantErr:        false,
+		},
+		{
+			name: "context kubeconfig read error",
+			config: &config.Config{
+				Contexts: []config.Context{
+					{Name: "minikube"},
+					{Name: "prod-cluster", Kubeconfig: "../testdata/nonexistent.yml"},
+				},
+			},
+			kubeconfigPath: "../testdata/valid-kubeconfig.yml",
+			wantMatched:    nil,
+			wantMissing:    nil,
+			wantErr:        true,
+		},
 	}
 
 	for _, tt := range tests {
@@ -269,6 +296,52 @@ func TestValidateContexts(t *testing.T) {
 	}
 }
 
+func TestPreflight(t *testing.T) {
+	cfg := &config.Config{Contexts: []config.Context{{Name: "minikube"}}}
+
+	t.Run("kubectl missing", func(t *testing.T) {
+		t.Setenv("PATH", t.TempDir())
+		err := Preflight(cfg, "../testdata/valid-kubeconfig.yml")
+		assert.ErrorIs(t, err, ErrKubectlNotFound)
+	})
+
+	// A stub kubectl is enough: Preflight only looks it up
+	dir := t.TempDir()
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "kubectl"), []byte("#!/bin/sh\n"), 0o755))
+	t.Setenv("PATH", dir)
+
+	t.Run("valid", func(t *testing.T) {
+		assert.NoError(t, Preflight(cfg, "../testdata/valid-kubeconfig.yml"))
+	})
+
+	t.Run("no configured context in kubeconfig", func(t *testing.T) {
+		other := &config.Config{Contexts: []config.Context{{Name: "nonexistent-context"}}}
+		assert.Error(t, Preflight(other, "../testdata/valid-kubeconfig.yml"))
+	})
+}
+
+func TestKubectlPath(t *testing.T) {
+	// The configured binary is run although no kubectl is in PATH
+	dir := t.TempDir()
+	binary := filepath.Join(dir, "kubectl-1.29")
+	require.NoError(t, os.WriteFile(binary, []byte("#!/bin/sh\necho 'Name: web-1'\n"), 0o755))
+	t.Setenv("PATH", t.TempDir())
+
+	adapter := NewKubectlAdapter("")
+	_, err := adapter.DescribePod("minikube", "default", "web-1")
+	assert.ErrorIs(t, err, ErrKubectlNotFound)
+
+	adapter.SetKubectlPath(binary)
+	output, err := adapter.DescribePod("minikube", "default", "web-1")
+	require.NoError(t, err)
+	assert.Contains(t, output, "Name: web-1")
+
+	cfg := &config.Config{KubectlPath: filepath.Join(dir, "missing"), Contexts: []config.Context{{Name: "minikube"}}}
+	err = Preflight(cfg, "../testdata/valid-kubeconfig.yml")
+	assert.ErrorIs(t, err, ErrKubectlNotFound)
+	assert.Contains(t, err.Error(), "missing")
+}
+
 func TestValidateContextName(t *testing.T) {
 	tests := []struct {
 		name        string
@@ -419,6 +492,26 @@ func TestNamespaceJSONParsing(t *testing.T) {
 	assert.Equal(t, "staging", response.Items[3].Metadata.Name)
 }
 
+func TestGetNamespaces_ParsesStatusAndLabels(t *testing.T) {
+	stubKubectl(t, `echo '{"items": [
+  {"metadata": {"name": "payments", "creationTimestamp": "2024-01-01T00:00:00Z", "labels": {"team": "payments"}}, "status": {"phase": "Active"}},
+  {"metadata": {"name": "old-feature"}, "status": {"phase": "Terminating"}}
+]}'`)
+
+	namespaces, err := NewKubectlAdapter("").GetNamespaces("test")
+	require.NoError(t, err)
+	require.Len(t, namespaces, 2)
+
+	assert.Equal(t, "payments", namespaces[0].Name)
+	assert.Equal(t, NamespaceActive, namespaces[0].Status)
+	assert.Equal(t, map[string]string{"team": "payments"}, namespaces[0].Labels)
+	assert.Equal(t, time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC), namespaces[0].CreatedAt)
+	assert.False(t, namespaces[0].Terminating())
+
+	assert.True(t, namespaces[1].Terminating())
+	assert.Zero(t, namespaces[1].Age(time.Now()), "unknown creation time")
+}
+
 func TestSwitchContext_ValidationError(t *testing.T) {
 	adapter := NewKubectlAdapter("~/.kube/config")
 
@@ -470,3 +563,22 @@ func TestSwitchContext_ValidationError(t *testing.T) {
 		}
 	})
 }
+
+func TestSetContextKubeconfig(t *testing.T) {
+	stubKubectl(t, `echo "args: $*"`)
+	adapter := NewKubectlAdapter("/etc/kube/shared.yaml")
+	adapter.SetContextKubeconfig(func(ctxName string) string {
+		if ctxName == "prod" {
+			return "/etc/kube/prod.yaml"
+		}
+		return ""
+	})
+
+	output, err := adapter.DescribePod("prod", "default", "web-1")
+	require.NoError(t, err)
+	assert.Contains(t, output, "args: --kubeconfig /etc/kube/prod.yaml --context prod describe")
+
+	output, err = adapter.DescribePod("dev", "default", "web-1")
+	require.NoError(t, err)
+	assert.Contains(t, output, "args: --kubeconfig /etc/kube/shared.yaml --context dev describe", "other contexts keep the adapter's kubeconfig")
+}
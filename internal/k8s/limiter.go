@@ -0,0 +1,54 @@
+package k8s
+
+import (
+	"log/slog"
+	"sync"
+)
+
+// contextLimiter bounds the number of simultaneous kubectl (and auth plugin) processes per
+// context, so background fetches do not flood a cluster's API server or SSO endpoint
+type contextLimiter struct {
+	mu    sync.Mutex
+	limit func(ctxName string) int // Slots per context; values below 1 mean unlimited
+	slots map[string]chan struct{}
+}
+
+// newContextLimiter creates a limiter allowing limit processes per context
+func newContextLimiter(limit func(ctxName string) int) *contextLimiter {
+	return &contextLimiter{
+		limit: limit,
+		slots: make(map[string]chan struct{}),
+	}
+}
+
+// acquire blocks until a slot for ctxName is free and returns the function releasing it
+func (l *contextLimiter) acquire(ctxName string) func() {
+	sem := l.semaphore(ctxName)
+	if sem == nil {
+		return func() {}
+	}
+
+	select {
+	case sem <- struct{}{}:
+	default:
+		slog.Debug("waiting for a free kubectl slot", "context", ctxName, "limit", cap(sem))
+		sem <- struct{}{}
+	}
+	return func() { <-sem }
+}
+
+// semaphore returns the semaphore of ctxName, creating it on first use.
+// Returns nil when the context is unlimited.
+func (l *contextLimiter) semaphore(ctxName string) chan struct{} {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	sem, ok := l.slots[ctxName]
+	if !ok {
+		if limit := l.limit(ctxName); limit > 0 {
+			sem = make(chan struct{}, limit)
+		}
+		l.slots[ctxName] = sem
+	}
+	return sem
+}
@@ -0,0 +1,72 @@
+package k8s
+
+import (
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestContextLimiter_BoundsConcurrencyPerContext(t *testing.T) {
+	limiter := newContextLimiter(func(string) int { return 2 })
+
+	var running, peak atomic.Int32
+	var wg sync.WaitGroup
+	for i := 0; i < 8; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			release := limiter.acquire("prod")
+			defer release()
+
+			n := running.Add(1)
+			for {
+				p := peak.Load()
+				if n <= p || peak.CompareAndSwap(p, n) {
+					break
+				}
+			}
+			time.Sleep(5 * time.Millisecond)
+			running.Add(-1)
+		}()
+	}
+	wg.Wait()
+
+	assert.Equal(t, int32(2), peak.Load())
+}
+
+func TestContextLimiter_ContextsAreIndependent(t *testing.T) {
+	limiter := newContextLimiter(func(string) int { return 1 })
+
+	release := limiter.acquire("prod")
+	defer release()
+
+	done := make(chan struct{})
+	go func() {
+		limiter.acquire("staging")()
+		close(done)
+	}()
+
+	select {
+	case <-done:
+	case <-time.After(time.Second):
+		t.Fatal("a busy context must not block other contexts")
+	}
+}
+
+func TestContextLimiter_Unlimited(t *testing.T) {
+	limiter := newContextLimiter(func(ctxName string) int {
+		if ctxName == "dev" {
+			return 0
+		}
+		return 1
+	})
+
+	for i := 0; i < 3; i++ {
+		defer limiter.acquire("dev")()
+	}
+	assert.Nil(t, limiter.semaphore("dev"))
+	assert.Equal(t, 1, cap(limiter.semaphore("prod")))
+}
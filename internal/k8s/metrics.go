@@ -0,0 +1,175 @@
+package k8s
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"os/exec"
+	"strconv"
+	"strings"
+)
+
+// PodMetrics is the current resource usage of a pod, summed over its containers
+type PodMetrics struct {
+	CPU    int64 // Millicores
+	Memory int64 // Bytes
+}
+
+// GetPodMetrics fetches the resource usage of the pods of a namespace from metrics-server
+// using kubectl top, keyed by pod name. ErrMetricsUnavailable is returned when the cluster
+// serves no metrics API.
+func (k *KubectlAdapter) GetPodMetrics(ctxName, namespace string) (map[string]PodMetrics, error) {
+	if err := validateContextName(ctxName); err != nil {
+		return nil, err
+	}
+	if err := validateNamespaceName(namespace); err != nil {
+		return nil, err
+	}
+
+	kubectlPath, err := k.lookKubectl()
+	if err != nil {
+		return nil, err
+	}
+
+	kubeconfigArgs, err := k.kubeconfigArgs(ctxName)
+	if err != nil {
+		return nil, err
+	}
+
+	args := append(kubeconfigArgs, "--context", ctxName, "top", "pod", "-n", namespace, "--no-headers")
+	output, err := k.run(ctxName, RetryOperation("metrics", namespace), kubectlPath, args)
+	if err != nil {
+		if errors.Is(err, ErrTimeout) {
+			return nil, err
+		}
+		if exitErr, ok := err.(*exec.ExitError); ok {
+			stderr := string(exitErr.Stderr)
+			if strings.Contains(stderr, "Metrics API not available") || strings.Contains(stderr, "metrics.k8s.io") {
+				return nil, fmt.Errorf("%w: %s", ErrMetricsUnavailable, strings.TrimSpace(stderr))
+			}
+			if strings.Contains(stderr, "forbidden") || strings.Contains(stderr, "Forbidden") {
+				return nil, fmt.Errorf("%w: %s", ErrPermissionDenied, stderr)
+			}
+			return nil, fmt.Errorf("kubectl top failed: %s", stderr)
+		}
+		return nil, fmt.Errorf("failed to execute kubectl: %w", err)
+	}
+
+	return parseTopPods(string(output))
+}
+
+// parseTopPods parses kubectl top pod --no-headers output: one "NAME CPU MEMORY" line per
+// pod (e.g. "web-1   3m   20Mi")
+func parseTopPods(output string) (map[string]PodMetrics, error) {
+	metrics := make(map[string]PodMetrics)
+	for _, line := range strings.Split(output, "\n") {
+		fields := strings.Fields(line)
+		if len(fields) == 0 {
+			continue
+		}
+		if len(fields) < 3 {
+			return nil, fmt.Errorf("unexpected kubectl top line %q", line)
+		}
+
+		cpu, err := ParseCPU(fields[1])
+		if err != nil {
+			return nil, err
+		}
+		memory, err := ParseMemory(fields[2])
+		if err != nil {
+			return nil, err
+		}
+		metrics[fields[0]] = PodMetrics{CPU: cpu, Memory: memory}
+	}
+	return metrics, nil
+}
+
+// requestsOf sums the CPU (millicores) and memory (bytes) requests of the containers of
+// spec. Quantities that do not parse are skipped.
+func requestsOf(spec PodSpec) (cpu, memory int64) {
+	for _, container := range spec.Containers {
+		if value, ok := container.Resources.Requests["cpu"]; ok {
+			if millis, err := ParseCPU(value); err == nil {
+				cpu += millis
+			}
+		}
+		if value, ok := container.Resources.Requests["memory"]; ok {
+			if bytes, err := ParseMemory(value); err == nil {
+				memory += bytes
+			}
+		}
+	}
+	return cpu, memory
+}
+
+// cpuSuffixes scales CPU quantities to millicores
+var cpuSuffixes = []struct {
+	suffix string
+	scale  float64
+}{
+	{"n", 1e-6},
+	{"u", 1e-3},
+	{"m", 1},
+}
+
+// ParseCPU parses a Kubernetes CPU quantity (e.g. "250m", "0.5", "2") into millicores
+func ParseCPU(quantity string) (int64, error) {
+	number, scale := quantity, 1000.0
+	for _, s := range cpuSuffixes {
+		if strings.HasSuffix(quantity, s.suffix) {
+			number, scale = strings.TrimSuffix(quantity, s.suffix), s.scale
+			break
+		}
+	}
+	return parseQuantity(quantity, number, scale)
+}
+
+// memorySuffixes scales memory quantities to bytes, binary suffixes first
+var memorySuffixes = []struct {
+	suffix string
+	scale  float64
+}{
+	{"Ki", 1 << 10},
+	{"Mi", 1 << 20},
+	{"Gi", 1 << 30},
+	{"Ti", 1 << 40},
+	{"k", 1e3},
+	{"M", 1e6},
+	{"G", 1e9},
+	{"T", 1e12},
+}
+
+// ParseMemory parses a Kubernetes memory quantity (e.g. "128Mi", "1G", "1048576") into bytes
+func ParseMemory(quantity string) (int64, error) {
+	number, scale := quantity, 1.0
+	for _, s := range memorySuffixes {
+		if strings.HasSuffix(quantity, s.suffix) {
+			number, scale = strings.TrimSuffix(quantity, s.suffix), s.scale
+			break
+		}
+	}
+	return parseQuantity(quantity, number, scale)
+}
+
+// parseQuantity parses the number of quantity and scales it, rounding up like Kubernetes
+func parseQuantity(quantity, number string, scale float64) (int64, error) {
+	value, err := strconv.ParseFloat(number, 64)
+	if err != nil || value < 0 {
+		return 0, fmt.Errorf("invalid quantity %q", quantity)
+	}
+	return int64(math.Ceil(value * scale)), nil
+}
+
+// FormatCPU formats millicores the way kubectl top does (e.g. "250m")
+func FormatCPU(millis int64) string {
+	return strconv.FormatInt(millis, 10) + "m"
+}
+
+// FormatMemory formats bytes the way kubectl top does, in whole mebibytes (e.g. "20Mi"),
+// or kibibytes below one mebibyte
+func FormatMemory(bytes int64) string {
+	if bytes < 1<<20 {
+		return strconv.FormatInt(bytes>>10, 10) + "Ki"
+	}
+	return strconv.FormatInt(bytes>>20, 10) + "Mi"
+}
@@ -0,0 +1,53 @@
+package k8s
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetPodMetrics(t *testing.T) {
+	stubKubectl(t, `echo "web-1   3m    20Mi"; echo "web-2   1250m 1Gi"`)
+
+	metrics, err := NewKubectlAdapter("").GetPodMetrics("test", "default")
+	require.NoError(t, err)
+	assert.Equal(t, map[string]PodMetrics{
+		"web-1": {CPU: 3, Memory: 20 << 20},
+		"web-2": {CPU: 1250, Memory: 1 << 30},
+	}, metrics)
+}
+
+func TestGetPodMetrics_Unavailable(t *testing.T) {
+	stubKubectl(t, `echo "error: Metrics API not available" >&2; exit 1`)
+
+	_, err := NewKubectlAdapter("").GetPodMetrics("test", "default")
+	assert.ErrorIs(t, err, ErrMetricsUnavailable)
+}
+
+func TestParseQuantities(t *testing.T) {
+	cpu := map[string]int64{"250m": 250, "0.5": 500, "2": 2000, "1500000n": 2, "100u": 1}
+	for quantity, want := range cpu {
+		got, err := ParseCPU(quantity)
+		require.NoError(t, err, quantity)
+		assert.Equal(t, want, got, quantity)
+	}
+
+	memory := map[string]int64{"128Mi": 128 << 20, "1Gi": 1 << 30, "64Ki": 64 << 10, "1G": 1e9, "500M": 5e8, "4096": 4096}
+	for quantity, want := range memory {
+		got, err := ParseMemory(quantity)
+		require.NoError(t, err, quantity)
+		assert.Equal(t, want, got, quantity)
+	}
+
+	_, err := ParseCPU("lots")
+	assert.Error(t, err)
+	_, err = ParseMemory("-1Mi")
+	assert.Error(t, err)
+}
+
+func TestFormatUsage(t *testing.T) {
+	assert.Equal(t, "250m", FormatCPU(250))
+	assert.Equal(t, "20Mi", FormatMemory(20<<20))
+	assert.Equal(t, "512Ki", FormatMemory(512<<10))
+}
@@ -0,0 +1,61 @@
+package k8s
+
+import (
+	"errors"
+	"fmt"
+	"os/exec"
+	"strings"
+)
+
+// CreateNamespace creates namespace in the cluster of ctxName
+func (k *KubectlAdapter) CreateNamespace(ctxName, namespace string) error {
+	return k.changeNamespace(ctxName, "create", namespace)
+}
+
+// DeleteNamespace deletes namespace and everything in it from the cluster of ctxName. It
+// returns once the deletion has started: the namespace stays Terminating until its
+// resources are gone.
+func (k *KubectlAdapter) DeleteNamespace(ctxName, namespace string) error {
+	return k.changeNamespace(ctxName, "delete", namespace, "--wait=false")
+}
+
+// changeNamespace runs kubectl verb on namespace. It is not retried: a call that timed out
+// may still have been applied.
+func (k *KubectlAdapter) changeNamespace(ctxName, verb, namespace string, flags ...string) error {
+	if err := validateContextName(ctxName); err != nil {
+		return err
+	}
+	if err := validateNamespaceName(namespace); err != nil {
+		return err
+	}
+
+	kubectlPath, err := k.lookKubectl()
+	if err != nil {
+		return err
+	}
+
+	kubeconfigArgs, err := k.kubeconfigArgs(ctxName)
+	if err != nil {
+		return err
+	}
+
+	args := append(kubeconfigArgs, "--context", ctxName, verb, "namespace", namespace)
+	args = append(args, flags...)
+	if _, err := k.runOnce(ctxName, kubectlPath, args); err != nil {
+		var exitErr *exec.ExitError
+		if !errors.As(err, &exitErr) {
+			return fmt.Errorf("failed to %s namespace %s: %w", verb, namespace, err)
+		}
+		stderr := strings.TrimSpace(string(exitErr.Stderr))
+		if strings.Contains(stderr, "forbidden") || strings.Contains(stderr, "Forbidden") {
+			return fmt.Errorf("%w: %s", ErrPermissionDenied, stderr)
+		}
+		return fmt.Errorf("failed to %s namespace %s: %s", verb, namespace, stderr)
+	}
+	return nil
+}
+
+// CheckNamespaceName reports why name cannot be the name of a namespace, or nil when it can
+func CheckNamespaceName(name string) error {
+	return validateNamespaceName(name)
+}
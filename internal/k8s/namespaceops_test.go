@@ -0,0 +1,59 @@
+package k8s
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestChangeNamespace(t *testing.T) {
+	// The stub records its arguments so the test can check the command lines
+	calls := filepath.Join(t.TempDir(), "calls")
+	stubKubectl(t, `echo "$*" >> `+calls)
+	adapter := NewKubectlAdapter("")
+
+	require.NoError(t, adapter.CreateNamespace("minikube", "feature-42"))
+	require.NoError(t, adapter.DeleteNamespace("minikube", "feature-42"))
+
+	data, err := os.ReadFile(calls)
+	require.NoError(t, err)
+	assert.Equal(t, "--context minikube create namespace feature-42\n"+
+		"--context minikube delete namespace feature-42 --wait=false\n", string(data))
+}
+
+func TestChangeNamespace_Errors(t *testing.T) {
+	adapter := NewKubectlAdapter("")
+
+	t.Run("invalid name", func(t *testing.T) {
+		stubKubectl(t, `exit 0`)
+		err := adapter.CreateNamespace("minikube", "Feature_42")
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "invalid namespace name")
+	})
+
+	t.Run("already exists", func(t *testing.T) {
+		stubKubectl(t, `echo 'Error from server (AlreadyExists): namespaces "feature-42" already exists' >&2; exit 1`)
+		err := adapter.CreateNamespace("minikube", "feature-42")
+		require.Error(t, err)
+		assert.Equal(t, `failed to create namespace feature-42: Error from server (AlreadyExists): namespaces "feature-42" already exists`, err.Error())
+	})
+
+	t.Run("forbidden", func(t *testing.T) {
+		stubKubectl(t, `echo 'Error from server (Forbidden): namespaces "feature-42" is forbidden' >&2; exit 1`)
+		err := adapter.DeleteNamespace("minikube", "feature-42")
+		assert.ErrorIs(t, err, ErrPermissionDenied)
+	})
+
+	t.Run("not retried", func(t *testing.T) {
+		calls := filepath.Join(t.TempDir(), "calls")
+		stubKubectl(t, `echo x >> `+calls+`; echo 'connection refused' >&2; exit 1`)
+		retrying := NewKubectlAdapter("")
+		retrying.SetRetryPolicy(defaultRetryPolicy.timeout, 3, 0)
+		assert.Error(t, retrying.CreateNamespace("minikube", "feature-42"))
+		data, _ := os.ReadFile(calls)
+		assert.Equal(t, "x\n", string(data))
+	})
+}
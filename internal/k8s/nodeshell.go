@@ -0,0 +1,54 @@
+package k8s
+
+import (
+	"fmt"
+	"os/exec"
+	"regexp"
+	"strings"
+)
+
+// NodeShellCommand returns the kubectl debug command opening an interactive shell in a
+// debug pod on node, running image. The node's filesystem is mounted at /host in the pod.
+// kubectl leaves the debug pod behind when the shell exits.
+func (k *KubectlAdapter) NodeShellCommand(ctxName, node, image string) (*exec.Cmd, error) {
+	if err := validateContextName(ctxName); err != nil {
+		return nil, err
+	}
+	if err := validateNodeName(node); err != nil {
+		return nil, err
+	}
+	if image == "" || strings.HasPrefix(image, "-") || strings.ContainsAny(image, " \t\n") {
+		return nil, fmt.Errorf("invalid image '%s'", image)
+	}
+
+	kubectlPath, err := k.lookKubectl()
+	if err != nil {
+		return nil, err
+	}
+	kubeconfigArgs, err := k.kubeconfigArgs(ctxName)
+	if err != nil {
+		return nil, err
+	}
+
+	args := append(kubeconfigArgs, "--context", ctxName, "debug", "node/"+node, "-it", "--image="+image)
+	return exec.Command(kubectlPath, args...), nil
+}
+
+// validateNodeName validates a node name against allowed characters
+func validateNodeName(name string) error {
+	if name == "" {
+		return fmt.Errorf("node name cannot be empty")
+	}
+
+	// Node names must be valid DNS subdomain names
+	matched, err := regexp.MatchString(`^[a-z0-9]([-a-z0-9.]*[a-z0-9])?$`, name)
+	if err != nil {
+		return fmt.Errorf("failed to validate node name: %w", err)
+	}
+
+	if !matched {
+		return fmt.Errorf("invalid node name '%s': must be lowercase alphanumeric with optional hyphens or dots", name)
+	}
+
+	return nil
+}
@@ -0,0 +1,22 @@
+package k8s
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNodeShellCommand(t *testing.T) {
+	stubKubectl(t, "")
+	adapter := NewKubectlAdapter("")
+
+	cmd, err := adapter.NodeShellCommand("minikube", "worker-1.internal", "busybox:1.36")
+	require.NoError(t, err)
+	assert.Equal(t, []string{"--context", "minikube", "debug", "node/worker-1.internal", "-it", "--image=busybox:1.36"}, cmd.Args[1:])
+
+	_, err = adapter.NodeShellCommand("minikube", "worker-1; rm -rf /", "busybox")
+	assert.Error(t, err)
+	_, err = adapter.NodeShellCommand("minikube", "worker-1", "--privileged")
+	assert.Error(t, err)
+}
@@ -0,0 +1,27 @@
+package k8s
+
+import (
+	"strings"
+)
+
+// GetPodCounts counts the pods of every namespace of a context with a single kubectl call.
+// Only the namespace column is listed, so the output stays small on large clusters.
+// Namespaces without pods are missing from the result.
+func (k *KubectlAdapter) GetPodCounts(ctxName string) (map[string]int, error) {
+	output, err := k.kubectlGet(ctxName, RetryOperation("pods", ""), "pods", "--all-namespaces", "-o", "custom-columns=NAMESPACE:.metadata.namespace", "--no-headers")
+	if err != nil {
+		return nil, err
+	}
+	return ParsePodCounts(string(output)), nil
+}
+
+// ParsePodCounts counts the lines of the namespace column listed by GetPodCounts per namespace
+func ParsePodCounts(output string) map[string]int {
+	counts := make(map[string]int)
+	for _, line := range strings.Split(output, "\n") {
+		if namespace := strings.TrimSpace(line); namespace != "" {
+			counts[namespace]++
+		}
+	}
+	return counts
+}
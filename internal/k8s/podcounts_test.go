@@ -0,0 +1,27 @@
+package k8s
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetPodCounts(t *testing.T) {
+	// The stub lists the namespace column only when asked for it with a single call
+	stubKubectl(t, `[ "$*" = "--context minikube get pods --all-namespaces -o custom-columns=NAMESPACE:.metadata.namespace --no-headers" ] || exit 1
+printf 'default\npayments\npayments\n'`)
+
+	counts, err := NewKubectlAdapter("").GetPodCounts("minikube")
+	require.NoError(t, err)
+	assert.Equal(t, map[string]int{"default": 1, "payments": 2}, counts)
+
+	_, err = NewKubectlAdapter("").GetPodCounts("bad context; rm")
+	assert.Error(t, err)
+}
+
+func TestParsePodCounts(t *testing.T) {
+	output := "default\npayments\npayments\nkube-system\npayments\n\n"
+	assert.Equal(t, map[string]int{"default": 1, "payments": 3, "kube-system": 1}, ParsePodCounts(output))
+	assert.Empty(t, ParsePodCounts(""))
+}
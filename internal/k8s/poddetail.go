@@ -0,0 +1,87 @@
+package k8s
+
+import (
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"time"
+)
+
+// PodDetail is what the pod detail block shows about a pod beyond the pod list
+type PodDetail struct {
+	Node      string
+	IP        string
+	Images    []string // Container images, in spec order
+	OwnerKind string   // Top-level owner (e.g. Deployment), empty for bare pods
+	OwnerName string
+	Restarts  int       // Container restarts summed over all containers
+	StartedAt time.Time // When the kubelet started the pod, zero until then
+}
+
+// GetPodDetail fetches a single pod and follows its owner chain (e.g. ReplicaSet →
+// Deployment) to its top-level owner. An owner that cannot be read ends the chain there
+// instead of failing: the pod itself is what the detail is about.
+func (k *KubectlAdapter) GetPodDetail(ctxName, namespace, pod string) (*PodDetail, error) {
+	if err := validateContextName(ctxName); err != nil {
+		return nil, err
+	}
+	if err := validateNamespaceName(namespace); err != nil {
+		return nil, err
+	}
+	if err := validatePodName(pod); err != nil {
+		return nil, err
+	}
+
+	kubectlPath, err := k.lookKubectl()
+	if err != nil {
+		return nil, err
+	}
+
+	kubeconfigArgs, err := k.kubeconfigArgs(ctxName)
+	if err != nil {
+		return nil, err
+	}
+
+	args := append(kubeconfigArgs, "--context", ctxName, "get", "pod", pod, "-n", namespace, "-o", "json")
+	output, err := k.run(ctxName, RetryOperation("pod", namespace), kubectlPath, args)
+	if err != nil {
+		return nil, podsError(err, namespace)
+	}
+
+	var item PodItem
+	if err := json.Unmarshal(output, &item); err != nil {
+		return nil, fmt.Errorf("failed to parse kubectl output: %w", err)
+	}
+
+	listed := podFromItem(item)
+	detail := &PodDetail{
+		Node:      listed.Node,
+		IP:        listed.IP,
+		OwnerKind: listed.OwnerKind,
+		OwnerName: listed.OwnerName,
+		Restarts:  listed.Restarts,
+	}
+	for _, container := range item.Spec.Containers {
+		detail.Images = append(detail.Images, container.Image)
+	}
+	if started, ok := parseTimestamp(item.Status.StartTime); ok {
+		detail.StartedAt = started
+	}
+
+	kind, name := detail.OwnerKind, detail.OwnerName
+	for depth := 0; depth < maxOwnerDepth && kind != "" && name != ""; depth++ {
+		meta, err := k.getObjectMetadata(ctxName, namespace, kind, name)
+		if err != nil {
+			slog.Debug("pod owner not readable", "kind", kind, "name", name, "error", err)
+			break
+		}
+		detail.OwnerKind, detail.OwnerName = kind, name
+
+		owner, ok := controllerOf(meta.OwnerReferences)
+		if !ok {
+			break
+		}
+		kind, name = owner.Kind, owner.Name
+	}
+	return detail, nil
+}
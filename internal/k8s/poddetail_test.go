@@ -0,0 +1,59 @@
+package k8s
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// podDetailStub answers kubectl get for the pod web-1, its ReplicaSet and its Deployment
+const podDetailStub = `case "$*" in
+*"get pod web-1"*) echo '{"metadata":{"name":"web-1","ownerReferences":[{"kind":"ReplicaSet","name":"web-7d4b9","controller":true}]},"spec":{"nodeName":"node-a","containers":[{"name":"app","image":"shop/web:1.4.2"},{"name":"proxy","image":"envoy:1.30"}]},"status":{"phase":"Running","podIP":"10.0.3.7","startTime":"2026-10-15T08:00:00Z","containerStatuses":[{"name":"app","restartCount":2},{"name":"proxy","restartCount":1}]}}' ;;
+*"get replicaset/web-7d4b9"*) echo '{"metadata":{"name":"web-7d4b9","ownerReferences":[{"kind":"Deployment","name":"web","controller":true}]}}' ;;
+*"get deployment/web"*) echo '{"metadata":{"name":"web"}}' ;;
+*) echo "unexpected: $*" >&2; exit 1 ;;
+esac`
+
+func TestGetPodDetail(t *testing.T) {
+	stubKubectl(t, podDetailStub)
+
+	detail, err := NewKubectlAdapter("").GetPodDetail("minikube", "default", "web-1")
+	require.NoError(t, err)
+	assert.Equal(t, &PodDetail{
+		Node:      "node-a",
+		IP:        "10.0.3.7",
+		Images:    []string{"shop/web:1.4.2", "envoy:1.30"},
+		OwnerKind: "Deployment",
+		OwnerName: "web",
+		Restarts:  3,
+		StartedAt: time.Date(2026, 10, 15, 8, 0, 0, 0, time.UTC),
+	}, detail)
+}
+
+func TestGetPodDetail_UnreadableOwner(t *testing.T) {
+	// Listing ReplicaSets is forbidden: the pod's own owner is kept
+	stubKubectl(t, `case "$*" in
+*"get pod web-1"*) echo '{"metadata":{"name":"web-1","ownerReferences":[{"kind":"ReplicaSet","name":"web-7d4b9"}]},"spec":{"containers":[{"name":"app","image":"shop/web:1.4.2"}]},"status":{"phase":"Pending"}}' ;;
+*) echo 'Error from server (Forbidden): replicasets.apps is forbidden' >&2; exit 1 ;;
+esac`)
+
+	detail, err := NewKubectlAdapter("").GetPodDetail("minikube", "default", "web-1")
+	require.NoError(t, err)
+	assert.Equal(t, "ReplicaSet", detail.OwnerKind)
+	assert.Equal(t, "web-7d4b9", detail.OwnerName)
+	assert.True(t, detail.StartedAt.IsZero(), "not started yet")
+}
+
+func TestGetPodDetail_Errors(t *testing.T) {
+	adapter := NewKubectlAdapter("")
+
+	_, err := adapter.GetPodDetail("minikube", "default", "web-1; rm -rf /")
+	assert.Error(t, err)
+
+	stubKubectl(t, `echo 'Error from server (NotFound): pods "web-1" not found' >&2; exit 1`)
+	_, err = adapter.GetPodDetail("minikube", "default", "web-1")
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "not found")
+}
@@ -0,0 +1,58 @@
+package k8s
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+
+	"github.com/maratkarimov/kubertino/internal/config"
+	"github.com/maratkarimov/kubertino/internal/jsonpath"
+)
+
+// podFieldPaths are the expressions of the custom pod columns, by column name
+type podFieldPaths map[string]*jsonpath.Path
+
+// SetCustomPodColumns sets the custom pod columns whose values are picked from the JSON of
+// listed pods into Pod.Fields (e.g. cfg.CustomPodColumns). Unlike the other settings it may
+// be changed while the adapter is in use, when the configuration is reloaded.
+func (k *KubectlAdapter) SetCustomPodColumns(columns []config.CustomPodColumn) error {
+	paths := make(podFieldPaths, len(columns))
+	for _, column := range columns {
+		path, err := jsonpath.Parse(column.JSONPath)
+		if err != nil {
+			return fmt.Errorf("custom pod column %s: %w", column.Name, err)
+		}
+		paths[column.Name] = path
+	}
+	k.podFields.Store(&paths)
+	return nil
+}
+
+// fillPodFields sets the Fields of pods, listed in that order in the pod list JSON output,
+// to the values of the custom pod columns. Pods keep nil Fields without custom columns.
+func (k *KubectlAdapter) fillPodFields(pods []Pod, output []byte) {
+	paths := k.podFields.Load()
+	if paths == nil || len(*paths) == 0 {
+		return
+	}
+
+	// Numbers are kept as written, so large integers are shown exactly
+	var list struct {
+		Items []any `json:"items"`
+	}
+	decoder := json.NewDecoder(bytes.NewReader(output))
+	decoder.UseNumber()
+	if err := decoder.Decode(&list); err != nil || len(list.Items) != len(pods) {
+		slog.Warn("custom pod columns not evaluated", "error", err)
+		return
+	}
+
+	for i, item := range list.Items {
+		fields := make(map[string]string, len(*paths))
+		for name, path := range *paths {
+			fields[name] = path.Text(item)
+		}
+		pods[i].Fields = fields
+	}
+}
@@ -0,0 +1,39 @@
+package k8s
+
+import (
+	"testing"
+
+	"github.com/maratkarimov/kubertino/internal/config"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCustomPodColumns(t *testing.T) {
+	stubKubectl(t, `/bin/cat <<'EOF'
+{"metadata": {}, "items": [
+  {"metadata": {"name": "web-1", "uid": "u1"}, "spec": {"containers": [{"name": "app", "image": "web:1.2"}]}, "status": {"phase": "Running", "qosClass": "Burstable"}},
+  {"metadata": {"name": "web-2", "uid": "u2"}, "spec": {"containers": [{"name": "app", "image": "web:1.3"}]}, "status": {"phase": "Pending"}}
+]}
+EOF`)
+
+	adapter := NewKubectlAdapter("")
+	pods, err := adapter.GetPods("minikube", "default")
+	require.NoError(t, err)
+	assert.Nil(t, pods[0].Fields, "no custom columns configured")
+
+	require.NoError(t, adapter.SetCustomPodColumns([]config.CustomPodColumn{
+		{Name: "image", JSONPath: ".spec.containers[0].image"},
+		{Name: "qos", JSONPath: "{.status.qosClass}"},
+	}))
+
+	pods, err = adapter.GetPods("minikube", "default")
+	require.NoError(t, err)
+	assert.Equal(t, map[string]string{"image": "web:1.2", "qos": "Burstable"}, pods[0].Fields)
+	assert.Equal(t, map[string]string{"image": "web:1.3", "qos": ""}, pods[1].Fields)
+
+	page, err := adapter.GetPodsPage("minikube", "default", 2, "", "")
+	require.NoError(t, err)
+	assert.Equal(t, "web:1.3", page.Pods[1].Fields["image"])
+
+	assert.Error(t, adapter.SetCustomPodColumns([]config.CustomPodColumn{{Name: "bad", JSONPath: "..name"}}))
+}
@@ -0,0 +1,125 @@
+package k8s
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/url"
+	"os/exec"
+	"strconv"
+	"strings"
+)
+
+// PodPage is one page of a pod list fetched with GetPodsPage
+type PodPage struct {
+	Pods     []Pod
+	Continue string // Token fetching the next page; empty on the last page
+}
+
+// SetPodSelector sets the label selector pods of a context are listed with (e.g.
+// cfg.PodSelector). An empty selector lists every pod. Call it before the adapter is used.
+func (k *KubectlAdapter) SetPodSelector(selector func(ctxName string) string) {
+	k.podSelector = selector
+}
+
+// selector returns the label selector pods of ctxName are listed with: the context's pod
+// selector and extra, both of which must match
+func (k *KubectlAdapter) selector(ctxName, extra string) string {
+	var selectors []string
+	if k.podSelector != nil {
+		if selector := k.podSelector(ctxName); selector != "" {
+			selectors = append(selectors, selector)
+		}
+	}
+	if extra != "" {
+		selectors = append(selectors, extra)
+	}
+	return strings.Join(selectors, ",")
+}
+
+// GetPodsPage fetches at most limit pods of a namespace matching the label selector (empty
+// for all pods), starting at the page continueToken refers to (empty for the first page).
+// Namespaces with thousands of pods can then be listed a page at a time instead of in one
+// slow call.
+func (k *KubectlAdapter) GetPodsPage(ctxName, namespace string, limit int, continueToken, selector string) (PodPage, error) {
+	if err := validateContextName(ctxName); err != nil {
+		return PodPage{}, err
+	}
+	if err := validateNamespaceName(namespace); err != nil {
+		return PodPage{}, err
+	}
+	if limit <= 0 {
+		return PodPage{}, fmt.Errorf("invalid page limit: %d", limit)
+	}
+
+	kubectlPath, err := k.lookKubectl()
+	if err != nil {
+		return PodPage{}, err
+	}
+	kubeconfigArgs, err := k.kubeconfigArgs(ctxName)
+	if err != nil {
+		return PodPage{}, err
+	}
+
+	// kubectl get only pages internally, so the API is queried directly to keep the token
+	args := append(kubeconfigArgs, "--context", ctxName, "get", "--raw", podsPagePath(namespace, limit, continueToken, k.selector(ctxName, selector)))
+	output, err := k.run(ctxName, RetryOperation("pods", namespace), kubectlPath, args)
+	if err != nil {
+		return PodPage{}, podsError(err, namespace)
+	}
+
+	var response PodList
+	if err := json.Unmarshal(output, &response); err != nil {
+		return PodPage{}, fmt.Errorf("failed to parse kubectl output: %w", err)
+	}
+	page := PodPage{Pods: make([]Pod, 0, len(response.Items)), Continue: response.Metadata.Continue}
+	for _, item := range response.Items {
+		page.Pods = append(page.Pods, podFromItem(item))
+	}
+	k.fillPodFields(page.Pods, output)
+	return page, nil
+}
+
+// podsPagePath returns the API path listing one page of pods of a namespace
+func podsPagePath(namespace string, limit int, continueToken, selector string) string {
+	query := url.Values{}
+	query.Set("limit", strconv.Itoa(limit))
+	if continueToken != "" {
+		query.Set("continue", continueToken)
+	}
+	if selector != "" {
+		query.Set("labelSelector", selector)
+	}
+	return "/api/v1/namespaces/" + url.PathEscape(namespace) + "/pods?" + query.Encode()
+}
+
+// podsError converts a failed pod listing into the error reported to the user
+func podsError(err error, namespace string) error {
+	if errors.Is(err, ErrTimeout) {
+		return err
+	}
+
+	// Check for exit error to extract stderr
+	if exitErr, ok := err.(*exec.ExitError); ok {
+		stderr := string(exitErr.Stderr)
+
+		// Check for permission denied
+		if strings.Contains(stderr, "forbidden") || strings.Contains(stderr, "Forbidden") {
+			return fmt.Errorf("%w: %s", ErrPermissionDenied, stderr)
+		}
+
+		// Check for namespace not found
+		if strings.Contains(stderr, "namespace") && (strings.Contains(stderr, "not found") || strings.Contains(stderr, "does not exist")) {
+			return fmt.Errorf("namespace not found: %s", namespace)
+		}
+
+		// An expired continue token means the list changed too much to keep paging
+		if strings.Contains(stderr, "continue parameter is too old") {
+			return fmt.Errorf("pod list changed while paging, refresh to start over: %s", stderr)
+		}
+
+		return fmt.Errorf("kubectl command failed: %s", stderr)
+	}
+
+	return fmt.Errorf("failed to execute kubectl: %w", err)
+}
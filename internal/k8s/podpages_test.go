@@ -0,0 +1,79 @@
+package k8s
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetPodsPage(t *testing.T) {
+	// The stub prints its arguments into the page token so the test can check the request
+	stubKubectl(t, `echo "{\"metadata\":{\"continue\":\"$*\"},\"items\":[{\"metadata\":{\"name\":\"web-1\"},\"status\":{\"phase\":\"Running\"}}]}"`)
+	adapter := NewKubectlAdapter("")
+
+	page, err := adapter.GetPodsPage("minikube", "default", 50, "", "")
+	require.NoError(t, err)
+	require.Len(t, page.Pods, 1)
+	assert.Equal(t, "web-1", page.Pods[0].Name)
+	assert.Equal(t, "--context minikube get --raw /api/v1/namespaces/default/pods?limit=50", page.Continue)
+
+	adapter.SetPodSelector(func(ctxName string) string { return "app=web" })
+	page, err = adapter.GetPodsPage("minikube", "default", 50, "eyJ2IjoxfQ==", "tier in (a,b)")
+	require.NoError(t, err)
+	assert.Equal(t, "--context minikube get --raw /api/v1/namespaces/default/pods?continue=eyJ2IjoxfQ%3D%3D&labelSelector=app%3Dweb%2Ctier+in+%28a%2Cb%29&limit=50", page.Continue)
+}
+
+func TestGetPodsPage_Errors(t *testing.T) {
+	adapter := NewKubectlAdapter("")
+
+	t.Run("invalid namespace", func(t *testing.T) {
+		_, err := adapter.GetPodsPage("minikube", "default; rm -rf /", 50, "", "")
+		assert.Error(t, err)
+	})
+
+	t.Run("invalid limit", func(t *testing.T) {
+		_, err := adapter.GetPodsPage("minikube", "default", 0, "", "")
+		assert.Error(t, err)
+	})
+
+	t.Run("forbidden", func(t *testing.T) {
+		stubKubectl(t, `echo 'Error from server (Forbidden): pods is forbidden' >&2; exit 1`)
+		_, err := adapter.GetPodsPage("minikube", "default", 50, "", "")
+		assert.ErrorIs(t, err, ErrPermissionDenied)
+	})
+
+	t.Run("expired token", func(t *testing.T) {
+		stubKubectl(t, `echo 'Error from server (Expired): The provided continue parameter is too old' >&2; exit 1`)
+		_, err := adapter.GetPodsPage("minikube", "default", 50, "old", "")
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "refresh to start over")
+	})
+}
+
+func TestGetPods_Selector(t *testing.T) {
+	stubKubectl(t, `echo "{\"items\":[{\"metadata\":{\"name\":\"$*\"}}]}"`)
+	adapter := NewKubectlAdapter("")
+
+	pods, err := adapter.GetPods("minikube", "default")
+	require.NoError(t, err)
+	assert.Equal(t, "--context minikube get pods -n default -o json", pods[0].Name)
+
+	adapter.SetPodSelector(func(ctxName string) string {
+		if ctxName == "minikube" {
+			return "app=web"
+		}
+		return ""
+	})
+	pods, err = adapter.GetPods("minikube", "default")
+	require.NoError(t, err)
+	assert.Equal(t, "--context minikube get pods -n default -o json -l app=web", pods[0].Name)
+
+	pods, err = adapter.GetSelectedPods("minikube", "default", "tier=frontend")
+	require.NoError(t, err)
+	assert.Equal(t, "--context minikube get pods -n default -o json -l app=web,tier=frontend", pods[0].Name, "both selectors must match")
+
+	pods, err = adapter.GetSelectedPods("kind", "default", "tier=frontend")
+	require.NoError(t, err)
+	assert.Equal(t, "--context kind get pods -n default -o json -l tier=frontend", pods[0].Name)
+}
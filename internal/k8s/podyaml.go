@@ -0,0 +1,75 @@
+package k8s
+
+import (
+	"errors"
+	"fmt"
+	"os/exec"
+	"strings"
+)
+
+// PodYAML returns the manifest of the given pod as printed by kubectl get pod -o yaml
+func (k *KubectlAdapter) PodYAML(ctxName, namespace, pod string) (string, error) {
+	args, kubectlPath, err := k.podArgs(ctxName, namespace, pod)
+	if err != nil {
+		return "", err
+	}
+
+	args = append(args, "get", "pod", pod, "-n", namespace, "-o", "yaml")
+	output, err := k.run(ctxName, RetryOperation("pods", namespace), kubectlPath, args)
+	if err != nil {
+		if errors.Is(err, ErrTimeout) {
+			return "", err
+		}
+
+		var exitErr *exec.ExitError
+		if errors.As(err, &exitErr) {
+			stderr := strings.TrimSpace(string(exitErr.Stderr))
+			if strings.Contains(stderr, "forbidden") || strings.Contains(stderr, "Forbidden") {
+				return "", fmt.Errorf("%w: %s", ErrPermissionDenied, stderr)
+			}
+			return "", fmt.Errorf("kubectl command failed: %s", stderr)
+		}
+
+		return "", fmt.Errorf("failed to execute kubectl: %w", err)
+	}
+
+	return string(output), nil
+}
+
+// EditPodCommand returns the kubectl edit command for the given pod. kubectl opens the
+// manifest in $KUBE_EDITOR or $EDITOR (vi when neither is set) and applies it when the
+// editor exits, so the command needs the terminal.
+func (k *KubectlAdapter) EditPodCommand(ctxName, namespace, pod string) (*exec.Cmd, error) {
+	args, kubectlPath, err := k.podArgs(ctxName, namespace, pod)
+	if err != nil {
+		return nil, err
+	}
+
+	args = append(args, "edit", "pod", pod, "-n", namespace)
+	return exec.Command(kubectlPath, args...), nil
+}
+
+// podArgs validates the names of a pod command and returns the kubeconfig and context flags
+// it starts with, along with the kubectl to run it with
+func (k *KubectlAdapter) podArgs(ctxName, namespace, pod string) (args []string, kubectlPath string, err error) {
+	if err := validateContextName(ctxName); err != nil {
+		return nil, "", err
+	}
+	if err := validateNamespaceName(namespace); err != nil {
+		return nil, "", err
+	}
+	if err := validatePodName(pod); err != nil {
+		return nil, "", err
+	}
+
+	kubectlPath, err = k.lookKubectl()
+	if err != nil {
+		return nil, "", err
+	}
+
+	kubeconfigArgs, err := k.kubeconfigArgs(ctxName)
+	if err != nil {
+		return nil, "", err
+	}
+	return append(kubeconfigArgs, "--context", ctxName), kubectlPath, nil
+}
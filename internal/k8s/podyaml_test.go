@@ -0,0 +1,43 @@
+package k8s
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPodYAML(t *testing.T) {
+	stubKubectl(t, `echo "kind: Pod"; echo "args: $*"`)
+
+	output, err := NewKubectlAdapter("").PodYAML("minikube", "default", "web-1")
+	require.NoError(t, err)
+	assert.Contains(t, output, "kind: Pod")
+	assert.Contains(t, output, "args: --context minikube get pod web-1 -n default -o yaml")
+}
+
+func TestPodYAML_Errors(t *testing.T) {
+	adapter := NewKubectlAdapter("")
+
+	t.Run("invalid pod name", func(t *testing.T) {
+		_, err := adapter.PodYAML("minikube", "default", "web; rm -rf /")
+		assert.Error(t, err)
+	})
+
+	t.Run("forbidden", func(t *testing.T) {
+		stubKubectl(t, `echo 'Error from server (Forbidden): pods "web-1" is forbidden' >&2; exit 1`)
+		_, err := adapter.PodYAML("minikube", "default", "web-1")
+		assert.ErrorIs(t, err, ErrPermissionDenied)
+	})
+}
+
+func TestEditPodCommand(t *testing.T) {
+	stubKubectl(t, "")
+
+	cmd, err := NewKubectlAdapter("").EditPodCommand("minikube", "default", "web-1")
+	require.NoError(t, err)
+	assert.Equal(t, []string{"--context", "minikube", "edit", "pod", "web-1", "-n", "default"}, cmd.Args[1:])
+
+	_, err = NewKubectlAdapter("").EditPodCommand("minikube", "default", "web; rm -rf /")
+	assert.Error(t, err)
+}
@@ -0,0 +1,161 @@
+package k8s
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+)
+
+// ResourceKind identifies a browsable resource type by its kubectl name
+type ResourceKind string
+
+// Browsable resource kinds
+const (
+	KindPod         ResourceKind = "pod"
+	KindDeployment  ResourceKind = "deployment"
+	KindStatefulSet ResourceKind = "statefulset"
+	KindJob         ResourceKind = "job"
+	KindConfigMap   ResourceKind = "configmap"
+	KindSecret      ResourceKind = "secret"
+)
+
+// ResourceKinds lists the browsable kinds in the order the resource switcher cycles through them
+var ResourceKinds = []ResourceKind{KindPod, KindDeployment, KindStatefulSet, KindJob, KindConfigMap, KindSecret}
+
+// Next returns the kind following k in ResourceKinds, wrapping around to pods
+func (k ResourceKind) Next() ResourceKind {
+	for i, kind := range ResourceKinds {
+		if kind == k {
+			return ResourceKinds[(i+1)%len(ResourceKinds)]
+		}
+	}
+	return KindPod
+}
+
+// Title returns the plural display name of the kind (e.g. "Deployments")
+func (k ResourceKind) Title() string {
+	switch k {
+	case KindStatefulSet:
+		return "StatefulSets"
+	case KindConfigMap:
+		return "ConfigMaps"
+	case "":
+		return "Pods"
+	default:
+		return strings.ToUpper(string(k[:1])) + string(k[1:]) + "s"
+	}
+}
+
+// Resource is a generic namespaced object shown in the resource browser
+type Resource struct {
+	Kind   ResourceKind
+	Name   string
+	Status string // Short status summary (e.g. "2/3 ready", "Complete", "3 keys")
+}
+
+// Ref returns the kind/name reference accepted by kubectl (e.g. "deployment/web")
+func (r Resource) Ref() string {
+	return string(r.Kind) + "/" + r.Name
+}
+
+// PodResource wraps a pod as a generic Resource
+func PodResource(pod Pod) Resource {
+	return Resource{Kind: KindPod, Name: pod.Name, Status: pod.Status}
+}
+
+// GetResources fetches resources of the given kind in a namespace using kubectl.
+// Pods are fetched through GetPods so they keep their pod-specific fields.
+func (k *KubectlAdapter) GetResources(ctxName, namespace string, kind ResourceKind) ([]Resource, error) {
+	if kind == KindPod {
+		pods, err := k.GetPods(ctxName, namespace)
+		if err != nil {
+			return nil, err
+		}
+		resources := make([]Resource, 0, len(pods))
+		for _, pod := range pods {
+			resources = append(resources, PodResource(pod))
+		}
+		return resources, nil
+	}
+
+	if err := validateResourceKind(kind); err != nil {
+		return nil, err
+	}
+
+	// Validate namespace name for security
+	if err := validateNamespaceName(namespace); err != nil {
+		return nil, err
+	}
+
+	output, err := k.kubectlGet(ctxName, RetryOperation(string(kind)+"s", namespace), string(kind)+"s", "-n", namespace, "-o", "json")
+	if err != nil {
+		return nil, err
+	}
+
+	// Parse JSON response
+	var response ResourceList
+	if err := json.Unmarshal(output, &response); err != nil {
+		return nil, fmt.Errorf("failed to parse kubectl output: %w", err)
+	}
+
+	resources := make([]Resource, 0, len(response.Items))
+	for _, item := range response.Items {
+		resources = append(resources, resourceFromItem(kind, item))
+	}
+
+	return resources, nil
+}
+
+// resourceFromItem converts a kubectl item into a Resource with a kind-specific status summary
+func resourceFromItem(kind ResourceKind, item ResourceItem) Resource {
+	resource := Resource{Kind: kind, Name: item.Metadata.Name}
+
+	switch kind {
+	case KindDeployment, KindStatefulSet:
+		desired := 1
+		if item.Spec.Replicas != nil {
+			desired = *item.Spec.Replicas
+		}
+		resource.Status = fmt.Sprintf("%d/%d ready", item.Status.ReadyReplicas, desired)
+
+	case KindJob:
+		completions := 1
+		if item.Spec.Completions != nil {
+			completions = *item.Spec.Completions
+		}
+		switch {
+		case item.Status.Succeeded >= completions:
+			resource.Status = "Complete"
+		case item.Status.Active > 0:
+			resource.Status = "Running"
+		case item.Status.Failed > 0:
+			resource.Status = "Failed"
+		default:
+			resource.Status = "Pending"
+		}
+
+	case KindConfigMap, KindSecret:
+		// Only the keys are counted; values are not kept
+		resource.Status = keyCount(len(item.Data) + len(item.BinaryData))
+	}
+
+	return resource
+}
+
+// keyCount describes the number of keys of a configmap or secret (e.g. "1 key", "3 keys")
+func keyCount(n int) string {
+	if n == 1 {
+		return "1 key"
+	}
+	return fmt.Sprintf("%d keys", n)
+}
+
+// validateResourceKind rejects kinds that are not part of the resource browser
+func validateResourceKind(kind ResourceKind) error {
+	for _, k := range ResourceKinds {
+		if k == kind {
+			return nil
+		}
+	}
+	return fmt.Errorf("unsupported resource kind '%s'", kind)
+}
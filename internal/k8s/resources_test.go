@@ -0,0 +1,87 @@
+package k8s
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestResourceFromItem(t *testing.T) {
+	tests := []struct {
+		name       string
+		kind       ResourceKind
+		jsonOutput string
+		wantStatus string
+	}{
+		{
+			name:       "deployment partially ready",
+			kind:       KindDeployment,
+			jsonOutput: `{"metadata": {"name": "web"}, "spec": {"replicas": 3}, "status": {"readyReplicas": 2}}`,
+			wantStatus: "2/3 ready",
+		},
+		{
+			name:       "statefulset without replicas defaults to one",
+			kind:       KindStatefulSet,
+			jsonOutput: `{"metadata": {"name": "web"}, "spec": {}, "status": {}}`,
+			wantStatus: "0/1 ready",
+		},
+		{
+			name:       "job complete",
+			kind:       KindJob,
+			jsonOutput: `{"metadata": {"name": "web"}, "spec": {"completions": 2}, "status": {"succeeded": 2}}`,
+			wantStatus: "Complete",
+		},
+		{
+			name:       "job running",
+			kind:       KindJob,
+			jsonOutput: `{"metadata": {"name": "web"}, "spec": {}, "status": {"active": 1, "failed": 1}}`,
+			wantStatus: "Running",
+		},
+		{
+			name:       "job failed",
+			kind:       KindJob,
+			jsonOutput: `{"metadata": {"name": "web"}, "spec": {}, "status": {"failed": 3}}`,
+			wantStatus: "Failed",
+		},
+		{
+			name:       "configmap keys",
+			kind:       KindConfigMap,
+			jsonOutput: `{"metadata": {"name": "web"}, "data": {"a": "1", "b": "2"}, "binaryData": {"c": "AA=="}}`,
+			wantStatus: "3 keys",
+		},
+		{
+			name:       "secret with one key",
+			kind:       KindSecret,
+			jsonOutput: `{"metadata": {"name": "web"}, "type": "Opaque", "data": {"password": "czNjcjN0"}}`,
+			wantStatus: "1 key",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var item ResourceItem
+			require.NoError(t, json.Unmarshal([]byte(tt.jsonOutput), &item))
+
+			resource := resourceFromItem(tt.kind, item)
+			assert.Equal(t, tt.kind, resource.Kind)
+			assert.Equal(t, "web", resource.Name)
+			assert.Equal(t, tt.wantStatus, resource.Status)
+		})
+	}
+}
+
+func TestResourceKind(t *testing.T) {
+	assert.Equal(t, KindDeployment, KindPod.Next())
+	assert.Equal(t, KindConfigMap, KindJob.Next())
+	assert.Equal(t, KindPod, KindSecret.Next(), "cycle wraps around to pods")
+	assert.Equal(t, "StatefulSets", KindStatefulSet.Title())
+	assert.Equal(t, "ConfigMaps", KindConfigMap.Title())
+	assert.Equal(t, "Secrets", KindSecret.Title())
+	assert.Equal(t, "Deployments", KindDeployment.Title())
+	assert.Equal(t, "deployment/web", Resource{Kind: KindDeployment, Name: "web"}.Ref())
+
+	assert.NoError(t, validateResourceKind(KindJob))
+	assert.Error(t, validateResourceKind("ingress"))
+}
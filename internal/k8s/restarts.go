@@ -0,0 +1,178 @@
+package k8s
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"time"
+)
+
+// RestartWindow is the period over which recent restarts are counted
+const RestartWindow = time.Hour
+
+// StormThreshold is the number of recent restarts that flags a pod as restart storming
+const StormThreshold = 3
+
+// backOffReason is the kubelet event reason emitted while a container is crash looping
+const backOffReason = "BackOff"
+
+// PodRestarts summarizes the restarts of a single pod
+type PodRestarts struct {
+	Pod         string
+	Restarts    int       // Total restart count over the pod's lifetime
+	Recent      int       // Estimated restarts within RestartWindow
+	LastRestart time.Time // Zero when unknown
+}
+
+// RestartStorm lists the pods of a namespace with abnormally many recent restarts
+type RestartStorm struct {
+	Namespace string
+	Pods      []PodRestarts // Flagged pods, most recent restarts first
+}
+
+// Recent returns the recent restarts summed over all flagged pods
+func (s *RestartStorm) Recent() int {
+	total := 0
+	for _, pod := range s.Pods {
+		total += pod.Recent
+	}
+	return total
+}
+
+// Flagged reports whether the named pod is part of the storm
+func (s *RestartStorm) Flagged(pod string) (PodRestarts, bool) {
+	for _, p := range s.Pods {
+		if p.Pod == pod {
+			return p, true
+		}
+	}
+	return PodRestarts{}, false
+}
+
+// AnalyzeRestarts flags pods whose estimated restarts within RestartWindow reach
+// StormThreshold and groups them by namespace. Recent restarts are estimated from
+// container restart counts (all of them count when the pod started within the window)
+// and from BackOff events, whichever is higher.
+func AnalyzeRestarts(pods []PodItem, events []EventItem, now time.Time) map[string]*RestartStorm {
+	since := now.Add(-RestartWindow)
+
+	// Recent back-offs per namespace/pod
+	backOffs := make(map[string]int)
+	for _, event := range events {
+		if event.Reason != backOffReason || event.InvolvedObject.Kind != "Pod" {
+			continue
+		}
+		key := event.InvolvedObject.Namespace + "/" + event.InvolvedObject.Name
+		backOffs[key] += recentEventCount(event, since)
+	}
+
+	storms := make(map[string]*RestartStorm)
+	for _, item := range pods {
+		restarts := PodRestarts{Pod: item.Metadata.Name}
+		for _, status := range item.Status.ContainerStatuses {
+			restarts.Restarts += status.RestartCount
+			if status.LastState.Terminated == nil {
+				continue
+			}
+			if finished, ok := parseTimestamp(status.LastState.Terminated.FinishedAt); ok && finished.After(restarts.LastRestart) {
+				restarts.LastRestart = finished
+			}
+		}
+
+		if started, ok := parseTimestamp(item.Status.StartTime); ok && started.After(since) {
+			restarts.Recent = restarts.Restarts
+		} else if restarts.LastRestart.After(since) {
+			restarts.Recent = 1
+		}
+		if n := backOffs[item.Metadata.Namespace+"/"+item.Metadata.Name]; n > restarts.Recent {
+			restarts.Recent = n
+		}
+
+		if restarts.Recent < StormThreshold {
+			continue
+		}
+
+		storm, ok := storms[item.Metadata.Namespace]
+		if !ok {
+			storm = &RestartStorm{Namespace: item.Metadata.Namespace}
+			storms[item.Metadata.Namespace] = storm
+		}
+		storm.Pods = append(storm.Pods, restarts)
+	}
+
+	for _, storm := range storms {
+		sort.SliceStable(storm.Pods, func(i, j int) bool {
+			return storm.Pods[i].Recent > storm.Pods[j].Recent
+		})
+	}
+
+	return storms
+}
+
+// recentEventCount returns how many occurrences of an aggregated event fall after since.
+// Occurrences are assumed to be evenly spread between the first and last timestamp.
+func recentEventCount(event EventItem, since time.Time) int {
+	last, ok := parseTimestamp(event.LastTimestamp)
+	if !ok {
+		last, ok = parseTimestamp(event.EventTime)
+	}
+	if !ok || !last.After(since) {
+		return 0
+	}
+
+	count := event.Count
+	if count < 1 {
+		count = 1
+	}
+
+	first, ok := parseTimestamp(event.FirstTimestamp)
+	if !ok || !first.Before(since) {
+		return count
+	}
+
+	recent := int(float64(count) * last.Sub(since).Seconds() / last.Sub(first).Seconds())
+	if recent < 1 {
+		recent = 1
+	}
+	return recent
+}
+
+// parseTimestamp parses an RFC 3339 timestamp, reporting false for empty or invalid values
+func parseTimestamp(value string) (time.Time, bool) {
+	if value == "" {
+		return time.Time{}, false
+	}
+	t, err := time.Parse(time.RFC3339, value)
+	if err != nil {
+		return time.Time{}, false
+	}
+	return t, true
+}
+
+// GetRestartStorms analyzes pods and BackOff events across all namespaces of a context
+func (k *KubectlAdapter) GetRestartStorms(ctxName string) (map[string]*RestartStorm, error) {
+	var pods PodList
+	if err := k.getAllNamespaces(ctxName, &pods, "pods"); err != nil {
+		return nil, err
+	}
+
+	var events EventList
+	if err := k.getAllNamespaces(ctxName, &events, "events", "--field-selector", "reason="+backOffReason); err != nil {
+		return nil, err
+	}
+
+	return AnalyzeRestarts(pods.Items, events.Items, time.Now()), nil
+}
+
+// getAllNamespaces runs kubectl get across all namespaces and decodes the JSON output into out
+func (k *KubectlAdapter) getAllNamespaces(ctxName string, out interface{}, resource string, extraArgs ...string) error {
+	output, err := k.kubectlGet(ctxName, RetryOperation(resource, ""), append([]string{resource, "--all-namespaces", "-o", "json"}, extraArgs...)...)
+	if err != nil {
+		return err
+	}
+
+	if err := json.Unmarshal(output, out); err != nil {
+		return fmt.Errorf("failed to parse kubectl output: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,103 @@
+package k8s
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func restartPod(namespace, name, startTime string, restarts int, lastFinished string) PodItem {
+	status := ContainerStatus{Name: "app", RestartCount: restarts}
+	if lastFinished != "" {
+		status.LastState.Terminated = &ContainerStateTerminated{ExitCode: 1, FinishedAt: lastFinished}
+	}
+	return PodItem{
+		Metadata: PodMetadata{Name: name, Namespace: namespace},
+		Status:   PodStatus{Phase: "Running", StartTime: startTime, ContainerStatuses: []ContainerStatus{status}},
+	}
+}
+
+func TestAnalyzeRestarts(t *testing.T) {
+	now := time.Date(2024, 5, 1, 12, 0, 0, 0, time.UTC)
+
+	pods := []PodItem{
+		// Young pod: every restart is recent
+		restartPod("payments", "api-1", "2024-05-01T11:30:00Z", 4, "2024-05-01T11:58:00Z"),
+		// Old pod with many restarts but a single recent one (not a storm on its own)
+		restartPod("payments", "worker-1", "2024-04-01T00:00:00Z", 40, "2024-05-01T11:50:00Z"),
+		// Old pod crash looping: BackOff events carry the recent count
+		restartPod("shop", "web-1", "2024-04-01T00:00:00Z", 120, "2024-05-01T11:59:00Z"),
+		// Quiet pod
+		restartPod("shop", "db-0", "2024-04-01T00:00:00Z", 2, "2024-04-20T00:00:00Z"),
+	}
+	events := []EventItem{
+		{
+			InvolvedObject: ObjectReference{Kind: "Pod", Namespace: "shop", Name: "web-1"},
+			Reason:         "BackOff",
+			Count:          20,
+			FirstTimestamp: "2024-05-01T10:00:00Z", // Half of the occurrences fall within the window
+			LastTimestamp:  "2024-05-01T11:59:00Z",
+		},
+		{
+			InvolvedObject: ObjectReference{Kind: "Pod", Namespace: "shop", Name: "db-0"},
+			Reason:         "BackOff",
+			Count:          10,
+			LastTimestamp:  "2024-04-30T00:00:00Z", // Outside the window
+		},
+	}
+
+	storms := AnalyzeRestarts(pods, events, now)
+	require.Len(t, storms, 2)
+
+	payments := storms["payments"]
+	require.Len(t, payments.Pods, 1)
+	assert.Equal(t, "api-1", payments.Pods[0].Pod)
+	assert.Equal(t, 4, payments.Recent())
+	_, flagged := payments.Flagged("worker-1")
+	assert.False(t, flagged)
+
+	shop := storms["shop"]
+	require.Len(t, shop.Pods, 1)
+	web, flagged := shop.Flagged("web-1")
+	require.True(t, flagged)
+	assert.Equal(t, 120, web.Restarts)
+	assert.InDelta(t, 10, web.Recent, 1)
+	assert.Equal(t, time.Date(2024, 5, 1, 11, 59, 0, 0, time.UTC), web.LastRestart)
+}
+
+func TestAnalyzeRestarts_SortsPodsByRecentRestarts(t *testing.T) {
+	now := time.Date(2024, 5, 1, 12, 0, 0, 0, time.UTC)
+	pods := []PodItem{
+		restartPod("ns", "a", "2024-05-01T11:30:00Z", 3, ""),
+		restartPod("ns", "b", "2024-05-01T11:30:00Z", 9, ""),
+	}
+
+	storm := AnalyzeRestarts(pods, nil, now)["ns"]
+	require.Len(t, storm.Pods, 2)
+	assert.Equal(t, "b", storm.Pods[0].Pod)
+	assert.Equal(t, 12, storm.Recent())
+}
+
+func TestRecentEventCount(t *testing.T) {
+	since := time.Date(2024, 5, 1, 11, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name  string
+		event EventItem
+		want  int
+	}{
+		{name: "inside window", event: EventItem{Count: 5, FirstTimestamp: "2024-05-01T11:10:00Z", LastTimestamp: "2024-05-01T11:50:00Z"}, want: 5},
+		{name: "outside window", event: EventItem{Count: 5, LastTimestamp: "2024-05-01T10:00:00Z"}, want: 0},
+		{name: "event time only", event: EventItem{EventTime: "2024-05-01T11:30:00Z"}, want: 1},
+		{name: "straddles window", event: EventItem{Count: 8, FirstTimestamp: "2024-05-01T10:00:00Z", LastTimestamp: "2024-05-01T12:00:00Z"}, want: 4},
+		{name: "no timestamps", event: EventItem{Count: 5}, want: 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, recentEventCount(tt.event, since))
+		})
+	}
+}
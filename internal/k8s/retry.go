@@ -0,0 +1,178 @@
+package k8s
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"math/rand/v2"
+	"os/exec"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/maratkarimov/kubertino/internal/config"
+)
+
+// maxRetryDelay caps the exponential backoff between two attempts
+const maxRetryDelay = 30 * time.Second
+
+// transientPhrases are lower-case kubectl stderr phrases of failures worth retrying
+var transientPhrases = []string{
+	"connection refused",
+	"connection reset",
+	"i/o timeout",
+	"tls handshake timeout",
+	"unexpected eof",
+	"too many requests",
+	"service unavailable",
+	"the server is currently unable to handle the request",
+	"etcdserver: request timed out",
+}
+
+// retryPolicy bounds how long one kubectl call may take and how often it is retried
+type retryPolicy struct {
+	timeout time.Duration
+	retries int
+	backoff time.Duration // Delay before the first retry
+}
+
+// defaultRetryPolicy is used until SetRetryPolicy is called: no retries
+var defaultRetryPolicy = retryPolicy{
+	timeout: config.DefaultKubectlTimeout,
+	backoff: config.DefaultRetryBackoff,
+}
+
+// delay returns how long to wait before retry number attempt (1-based): the backoff doubled
+// for each earlier retry, capped at maxRetryDelay, of which jitter (0 to 1) keeps the lower
+// half random so that clients failing together do not retry in lockstep
+func (p retryPolicy) delay(attempt int, jitter float64) time.Duration {
+	d := p.backoff
+	for i := 1; i < attempt && d < maxRetryDelay; i++ {
+		d *= 2
+	}
+	d = min(d, maxRetryDelay)
+	return d/2 + time.Duration(jitter*float64(d/2))
+}
+
+// retryState is the retry a kubectl call is currently waiting for or running
+type retryState struct {
+	attempt int
+	retries int
+}
+
+// retryTracker records which kubectl calls are being retried, for the loading indicators
+type retryTracker struct {
+	mu     sync.Mutex
+	active map[string]retryState
+}
+
+// set records that the call key is on retry attempt of retries
+func (t *retryTracker) set(key string, attempt, retries int) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	if t.active == nil {
+		t.active = make(map[string]retryState)
+	}
+	t.active[key] = retryState{attempt: attempt, retries: retries}
+}
+
+// clear forgets the call key once it has finished
+func (t *retryTracker) clear(key string) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	delete(t.active, key)
+}
+
+// get returns the retry the call key is on. attempt is 0 when it is not being retried.
+func (t *retryTracker) get(key string) (attempt, retries int) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	state := t.active[key]
+	return state.attempt, state.retries
+}
+
+// RetryOperation names a kubectl call for Retrying: the resource, plus the namespace for
+// namespaced resources
+func RetryOperation(resource, namespace string) string {
+	if namespace == "" {
+		return resource
+	}
+	return resource + "/" + namespace
+}
+
+// SetRetryPolicy sets how long one kubectl call may take and how often a call that timed
+// out or failed transiently is retried, waiting backoff before the first retry and doubling
+// it for each further one (e.g. cfg.KubectlTimeoutDuration, cfg.Retries and
+// cfg.RetryBackoffDuration). Call it before the adapter is used.
+func (k *KubectlAdapter) SetRetryPolicy(timeout time.Duration, retries int, backoff time.Duration) {
+	k.retry = retryPolicy{timeout: timeout, retries: retries, backoff: backoff}
+}
+
+// Retrying reports which retry the kubectl call operation (see RetryOperation) of context
+// is on. attempt is 0 when the call is not being retried.
+func (k *KubectlAdapter) Retrying(context, operation string) (attempt, retries int) {
+	return k.retries.get(context + "|" + operation)
+}
+
+// run executes kubectl with args for ctxName and returns its standard output, retrying
+// timeouts and transient failures per the retry policy. Each attempt takes its own
+// concurrency slot, so waiting for a retry does not block other requests. A timeout is
+// returned wrapping ErrTimeout; other failures are returned as is for the caller to classify.
+func (k *KubectlAdapter) run(ctxName, operation, kubectlPath string, args []string) ([]byte, error) {
+	key := ctxName + "|" + operation
+	defer k.retries.clear(key)
+
+	for attempt := 0; ; attempt++ {
+		output, err := k.runOnce(ctxName, kubectlPath, args)
+		if err == nil || attempt >= k.retry.retries || !retryable(err) {
+			return output, err
+		}
+
+		delay := k.retry.delay(attempt+1, rand.Float64())
+		slog.Debug("retrying kubectl", "context", ctxName, "operation", operation,
+			"attempt", attempt+1, "retries", k.retry.retries, "delay", delay, "error", err)
+		k.retries.set(key, attempt+1, k.retry.retries)
+		time.Sleep(delay)
+	}
+}
+
+// runOnce executes kubectl once within the policy's timeout
+func (k *KubectlAdapter) runOnce(ctxName, kubectlPath string, args []string) ([]byte, error) {
+	return k.runWithin(ctxName, kubectlPath, args, k.retry.timeout)
+}
+
+// runWithin executes kubectl once, killing it after timeout
+func (k *KubectlAdapter) runWithin(ctxName, kubectlPath string, args []string, timeout time.Duration) ([]byte, error) {
+	// Limit simultaneous kubectl processes per context
+	release := k.acquire(ctxName)
+	defer release()
+
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+
+	output, err := exec.CommandContext(ctx, kubectlPath, args...).Output()
+	if err != nil && ctx.Err() == context.DeadlineExceeded {
+		return nil, fmt.Errorf("%w: kubectl command timed out after %s", ErrTimeout, timeout)
+	}
+	return output, err
+}
+
+// retryable reports whether a failed kubectl call may succeed when tried again
+func retryable(err error) bool {
+	if errors.Is(err, ErrTimeout) {
+		return true
+	}
+
+	var exitErr *exec.ExitError
+	if !errors.As(err, &exitErr) {
+		return false
+	}
+	stderr := strings.ToLower(string(exitErr.Stderr))
+	for _, phrase := range transientPhrases {
+		if strings.Contains(stderr, phrase) {
+			return true
+		}
+	}
+	return false
+}
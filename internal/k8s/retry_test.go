@@ -0,0 +1,99 @@
+package k8s
+
+import (
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRetryPolicy_DelayBacksOffExponentiallyWithJitter(t *testing.T) {
+	policy := retryPolicy{backoff: 100 * time.Millisecond}
+
+	assert.Equal(t, 50*time.Millisecond, policy.delay(1, 0))
+	assert.Equal(t, 100*time.Millisecond, policy.delay(1, 1))
+	assert.Equal(t, 200*time.Millisecond, policy.delay(2, 1))
+	assert.Equal(t, 400*time.Millisecond, policy.delay(3, 1))
+	assert.Equal(t, maxRetryDelay, policy.delay(20, 1), "capped")
+}
+
+// countingScript returns a shell script that records each run in a file and fails with
+// stderr until it has run succeedAfter times
+func countingScript(t *testing.T, stderr string, succeedAfter int) (script, countFile string) {
+	countFile = filepath.Join(t.TempDir(), "count")
+	script = `echo run >> "` + countFile + `"; ` +
+		`if [ $(wc -l < "` + countFile + `") -lt ` + strconv.Itoa(succeedAfter) + ` ]; then echo "` + stderr + `" >&2; exit 1; fi; echo ok`
+	return script, countFile
+}
+
+func runs(t *testing.T, countFile string) int {
+	data, err := os.ReadFile(countFile)
+	require.NoError(t, err)
+	return strings.Count(string(data), "run")
+}
+
+func TestRun_RetriesTransientFailures(t *testing.T) {
+	adapter := NewKubectlAdapter("")
+	adapter.SetRetryPolicy(time.Second, 3, time.Millisecond)
+
+	script, countFile := countingScript(t, "dial tcp: connection refused", 3)
+	output, err := adapter.run("prod", "pods", "sh", []string{"-c", script})
+	require.NoError(t, err)
+	assert.Equal(t, "ok\n", string(output))
+	assert.Equal(t, 3, runs(t, countFile))
+
+	attempt, _ := adapter.Retrying("prod", "pods")
+	assert.Zero(t, attempt, "cleared once the call finished")
+}
+
+func TestRun_GivesUpAfterRetries(t *testing.T) {
+	adapter := NewKubectlAdapter("")
+	adapter.SetRetryPolicy(time.Second, 2, time.Millisecond)
+
+	script, countFile := countingScript(t, "Error from server (ServiceUnavailable): service unavailable", 9)
+	_, err := adapter.run("prod", "pods", "sh", []string{"-c", script})
+	require.Error(t, err)
+	assert.Equal(t, 3, runs(t, countFile), "first attempt plus two retries")
+}
+
+func TestRun_DoesNotRetryPermanentFailures(t *testing.T) {
+	adapter := NewKubectlAdapter("")
+	adapter.SetRetryPolicy(time.Second, 3, time.Millisecond)
+
+	script, countFile := countingScript(t, "pods is forbidden", 9)
+	_, err := adapter.run("prod", "pods", "sh", []string{"-c", script})
+	require.Error(t, err)
+	assert.Equal(t, 1, runs(t, countFile))
+}
+
+func TestRun_TimeoutIsRetried(t *testing.T) {
+	adapter := NewKubectlAdapter("")
+	adapter.SetRetryPolicy(50*time.Millisecond, 1, time.Millisecond)
+
+	_, err := adapter.run("prod", "pods", "sh", []string{"-c", "exec sleep 2"})
+	require.ErrorIs(t, err, ErrTimeout)
+	assert.Contains(t, err.Error(), "timed out after 50ms")
+}
+
+func TestRun_ReportsRetryAttempt(t *testing.T) {
+	adapter := NewKubectlAdapter("")
+	adapter.SetRetryPolicy(time.Second, 3, 400*time.Millisecond)
+
+	script, _ := countingScript(t, "i/o timeout", 2)
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		_, _ = adapter.run("prod", RetryOperation("pods", "default"), "sh", []string{"-c", script})
+	}()
+
+	assert.Eventually(t, func() bool {
+		attempt, retries := adapter.Retrying("prod", "pods/default")
+		return attempt == 1 && retries == 3
+	}, time.Second, 5*time.Millisecond)
+	<-done
+}
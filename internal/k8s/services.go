@@ -0,0 +1,146 @@
+package k8s
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// ClusterDomain is the DNS domain assumed for service and pod names
+const ClusterDomain = "cluster.local"
+
+// Service is a Kubernetes service with the fields needed to find the pods it targets
+type Service struct {
+	Name      string
+	Type      string // ClusterIP, NodePort, LoadBalancer or ExternalName
+	ClusterIP string // "None" for headless services
+	Selector  map[string]string
+	Ports     []ServicePort
+}
+
+// ServicePort is a port exposed by a service
+type ServicePort struct {
+	Name       string
+	Protocol   string
+	Port       int
+	TargetPort string // Container port number or name; empty means the same as Port
+}
+
+// Headless reports whether the service has no cluster IP and resolves to its pods directly
+func (s Service) Headless() bool {
+	return s.ClusterIP == "None"
+}
+
+// Selects reports whether the service's selector matches the pod's labels. Services without
+// a selector (endpoints managed elsewhere) select no pod.
+func (s Service) Selects(pod Pod) bool {
+	if len(s.Selector) == 0 {
+		return false
+	}
+	for key, value := range s.Selector {
+		if pod.Labels[key] != value {
+			return false
+		}
+	}
+	return true
+}
+
+// DNSName returns the cluster DNS name of the service in namespace
+func (s Service) DNSName(namespace string) string {
+	return s.Name + "." + namespace + ".svc." + ClusterDomain
+}
+
+// PodDNSName returns the DNS name resolving to the pod IP (<a-b-c-d>.<namespace>.pod), or ""
+// while the pod has no IP
+func PodDNSName(pod Pod, namespace string) string {
+	if pod.IP == "" {
+		return ""
+	}
+	return strings.NewReplacer(".", "-", ":", "-").Replace(pod.IP) + "." + namespace + ".pod." + ClusterDomain
+}
+
+// PodHostnameDNSName returns the stable DNS name of a pod with a subdomain that names a
+// headless service (as for statefulset pods), or "" when there is none
+func PodHostnameDNSName(pod Pod, namespace string, services []Service) string {
+	if pod.Subdomain == "" {
+		return ""
+	}
+	for _, service := range services {
+		if service.Name == pod.Subdomain && service.Headless() {
+			hostname := pod.Hostname
+			if hostname == "" {
+				hostname = pod.Name
+			}
+			return hostname + "." + service.DNSName(namespace)
+		}
+	}
+	return ""
+}
+
+// ServicesForPod returns the services whose selector matches pod
+func ServicesForPod(services []Service, pod Pod) []Service {
+	var matched []Service
+	for _, service := range services {
+		if service.Selects(pod) {
+			matched = append(matched, service)
+		}
+	}
+	return matched
+}
+
+// GetServices fetches the services of a namespace using kubectl
+func (k *KubectlAdapter) GetServices(ctxName, namespace string) ([]Service, error) {
+	// Validate inputs for security
+	if err := validateNamespaceName(namespace); err != nil {
+		return nil, err
+	}
+
+	output, err := k.kubectlGet(ctxName, RetryOperation("services", namespace), "services", "-n", namespace, "-o", "json")
+	if err != nil {
+		return nil, err
+	}
+
+	var response ServiceList
+	if err := json.Unmarshal(output, &response); err != nil {
+		return nil, fmt.Errorf("failed to parse kubectl output: %w", err)
+	}
+
+	services := make([]Service, 0, len(response.Items))
+	for _, item := range response.Items {
+		services = append(services, serviceFromItem(item))
+	}
+	return services, nil
+}
+
+// serviceFromItem converts a kubectl service item into a Service
+func serviceFromItem(item ServiceItem) Service {
+	service := Service{
+		Name:      item.Metadata.Name,
+		Type:      item.Spec.Type,
+		ClusterIP: item.Spec.ClusterIP,
+		Selector:  item.Spec.Selector,
+	}
+	for _, port := range item.Spec.Ports {
+		service.Ports = append(service.Ports, ServicePort{
+			Name:       port.Name,
+			Protocol:   port.Protocol,
+			Port:       port.Port,
+			TargetPort: targetPort(port.TargetPort),
+		})
+	}
+	return service
+}
+
+// targetPort decodes a targetPort given as a number or a port name
+func targetPort(raw json.RawMessage) string {
+	var number int
+	if err := json.Unmarshal(raw, &number); err == nil {
+		return strconv.Itoa(number)
+	}
+	var name string
+	if err := json.Unmarshal(raw, &name); err == nil {
+		return name
+	}
+	return ""
+}
@@ -0,0 +1,71 @@
+package k8s
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestServiceFromItem(t *testing.T) {
+	output := `{"items": [
+		{"metadata": {"name": "web"}, "spec": {"type": "ClusterIP", "clusterIP": "10.96.0.12", "selector": {"app": "web"},
+		 "ports": [{"name": "http", "protocol": "TCP", "port": 80, "targetPort": 8080}, {"name": "grpc", "port": 9090, "targetPort": "grpc"}]}},
+		{"metadata": {"name": "db"}, "spec": {"clusterIP": "None", "selector": {"app": "db"}}}
+	]}`
+
+	var list ServiceList
+	require.NoError(t, json.Unmarshal([]byte(output), &list))
+	web := serviceFromItem(list.Items[0])
+	assert.Equal(t, Service{
+		Name:      "web",
+		Type:      "ClusterIP",
+		ClusterIP: "10.96.0.12",
+		Selector:  map[string]string{"app": "web"},
+		Ports: []ServicePort{
+			{Name: "http", Protocol: "TCP", Port: 80, TargetPort: "8080"},
+			{Name: "grpc", Port: 9090, TargetPort: "grpc"},
+		},
+	}, web)
+	assert.False(t, web.Headless())
+	assert.True(t, serviceFromItem(list.Items[1]).Headless())
+}
+
+func TestServicesForPod(t *testing.T) {
+	services := []Service{
+		{Name: "web", Selector: map[string]string{"app": "web"}},
+		{Name: "web-canary", Selector: map[string]string{"app": "web", "track": "canary"}},
+		{Name: "external"}, // No selector
+	}
+	pod := Pod{Name: "web-1", Labels: map[string]string{"app": "web", "track": "stable"}}
+
+	matched := ServicesForPod(services, pod)
+	require.Len(t, matched, 1)
+	assert.Equal(t, "web", matched[0].Name)
+	assert.Equal(t, "web.shop.svc.cluster.local", matched[0].DNSName("shop"))
+}
+
+func TestPodDNSNames(t *testing.T) {
+	pod := Pod{Name: "db-0", IP: "10.244.1.7", Subdomain: "db"}
+	assert.Equal(t, "10-244-1-7.shop.pod.cluster.local", PodDNSName(pod, "shop"))
+	assert.Empty(t, PodDNSName(Pod{Name: "pending"}, "shop"))
+
+	headless := []Service{{Name: "db", ClusterIP: "None"}}
+	assert.Equal(t, "db-0.db.shop.svc.cluster.local", PodHostnameDNSName(pod, "shop", headless))
+
+	pod.Hostname = "primary"
+	assert.Equal(t, "primary.db.shop.svc.cluster.local", PodHostnameDNSName(pod, "shop", headless))
+	assert.Empty(t, PodHostnameDNSName(pod, "shop", []Service{{Name: "db", ClusterIP: "10.96.0.3"}}), "only headless services")
+	assert.Empty(t, PodHostnameDNSName(Pod{Name: "web-1"}, "shop", headless), "no subdomain")
+}
+
+func TestPodFromItem_Network(t *testing.T) {
+	var item PodItem
+	require.NoError(t, json.Unmarshal([]byte(`{"metadata": {"name": "db-0"}, "spec": {"hostname": "db-0", "subdomain": "db"}, "status": {"phase": "Running", "podIP": "10.244.1.7"}}`), &item))
+
+	pod := podFromItem(item)
+	assert.Equal(t, "10.244.1.7", pod.IP)
+	assert.Equal(t, "db-0", pod.Hostname)
+	assert.Equal(t, "db", pod.Subdomain)
+}
@@ -0,0 +1,94 @@
+package k8s
+
+import (
+	"log/slog"
+	"sync"
+	"time"
+)
+
+// throttleHold keeps a context reported as throttled for a moment after its last wait, so
+// the indicator does not flicker between back-to-back requests
+const throttleHold = 2 * time.Second
+
+// contextThrottle is a token bucket per context bounding how many kubectl processes start
+// per second, for clusters with strict API priority and fairness settings
+type contextThrottle struct {
+	mu      sync.Mutex
+	rate    func(ctxName string) (qps float64, burst int) // qps 0 means unthrottled
+	buckets map[string]*bucket
+}
+
+// bucket is the token bucket of one context
+type bucket struct {
+	qps        float64
+	burst      float64
+	tokens     float64
+	last       time.Time // When tokens was last refilled
+	waiting    int       // Requests currently delayed
+	lastWaited time.Time // When the last delayed request started
+}
+
+// newContextThrottle creates a throttle with the per-context rate
+func newContextThrottle(rate func(ctxName string) (qps float64, burst int)) *contextThrottle {
+	return &contextThrottle{rate: rate, buckets: make(map[string]*bucket)}
+}
+
+// wait blocks until a request for ctxName may start
+func (t *contextThrottle) wait(ctxName string) {
+	delay := t.reserve(ctxName, time.Now())
+	if delay <= 0 {
+		return
+	}
+
+	slog.Debug("throttling kubectl", "context", ctxName, "delay", delay)
+	time.Sleep(delay)
+
+	t.mu.Lock()
+	t.buckets[ctxName].waiting--
+	t.mu.Unlock()
+}
+
+// reserve takes a token from the bucket of ctxName at now and returns how long the request
+// must wait for it. Tokens are reserved ahead, so concurrent requests queue up in order.
+func (t *contextThrottle) reserve(ctxName string, now time.Time) time.Duration {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	b := t.bucket(ctxName, now)
+	if b == nil {
+		return 0
+	}
+
+	b.tokens = min(b.burst, b.tokens+now.Sub(b.last).Seconds()*b.qps)
+	b.last = now
+	b.tokens--
+	if b.tokens >= 0 {
+		return 0
+	}
+
+	b.waiting++
+	b.lastWaited = now
+	return time.Duration(-b.tokens / b.qps * float64(time.Second))
+}
+
+// bucket returns the bucket of ctxName, creating it full on first use.
+// Returns nil when the context is unthrottled. Callers must hold t.mu.
+func (t *contextThrottle) bucket(ctxName string, now time.Time) *bucket {
+	b, ok := t.buckets[ctxName]
+	if !ok {
+		if qps, burst := t.rate(ctxName); qps > 0 {
+			b = &bucket{qps: qps, burst: float64(max(burst, 1)), tokens: float64(max(burst, 1)), last: now}
+		}
+		t.buckets[ctxName] = b
+	}
+	return b
+}
+
+// throttled reports whether requests of ctxName are being delayed at now
+func (t *contextThrottle) throttled(ctxName string, now time.Time) bool {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	b := t.buckets[ctxName]
+	return b != nil && (b.waiting > 0 || now.Sub(b.lastWaited) < throttleHold)
+}
@@ -0,0 +1,70 @@
+package k8s
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestContextThrottle_BurstThenRate(t *testing.T) {
+	throttle := newContextThrottle(func(string) (float64, int) { return 2, 3 })
+	now := time.Now()
+
+	for i := 0; i < 3; i++ {
+		assert.Zero(t, throttle.reserve("prod", now), "burst starts immediately")
+	}
+	assert.False(t, throttle.throttled("prod", now))
+
+	// Reservations queue up at 2 per second
+	assert.Equal(t, 500*time.Millisecond, throttle.reserve("prod", now))
+	assert.Equal(t, time.Second, throttle.reserve("prod", now))
+	assert.True(t, throttle.throttled("prod", now))
+
+	// Tokens refill over time
+	later := now.Add(3 * time.Second)
+	assert.Zero(t, throttle.reserve("prod", later))
+}
+
+func TestContextThrottle_ContextsAreIndependent(t *testing.T) {
+	throttle := newContextThrottle(func(ctxName string) (float64, int) {
+		if ctxName == "dev" {
+			return 0, 0
+		}
+		return 1, 1
+	})
+	now := time.Now()
+
+	assert.Zero(t, throttle.reserve("prod", now))
+	assert.Equal(t, time.Second, throttle.reserve("prod", now))
+	assert.Zero(t, throttle.reserve("staging", now), "a throttled context must not delay others")
+
+	for i := 0; i < 10; i++ {
+		assert.Zero(t, throttle.reserve("dev", now), "qps 0 is unthrottled")
+	}
+	assert.False(t, throttle.throttled("dev", now))
+}
+
+func TestContextThrottle_IndicatorHold(t *testing.T) {
+	throttle := newContextThrottle(func(string) (float64, int) { return 50, 1 })
+
+	start := time.Now()
+	throttle.wait("prod")
+	throttle.wait("prod")
+	assert.GreaterOrEqual(t, time.Since(start), 15*time.Millisecond, "second request waits for a token")
+
+	now := time.Now()
+	assert.True(t, throttle.throttled("prod", now), "reported shortly after the wait")
+	assert.False(t, throttle.throttled("prod", now.Add(throttleHold)))
+}
+
+func TestKubectlAdapter_Throttled(t *testing.T) {
+	adapter := NewKubectlAdapter("")
+	assert.False(t, adapter.Throttled("prod"), "unthrottled without a rate limit")
+
+	adapter.SetRateLimit(func(string) (float64, int) { return 50, 1 })
+	adapter.acquire("prod")()
+	adapter.acquire("prod")()
+	assert.True(t, adapter.Throttled("prod"))
+	assert.False(t, adapter.Throttled("staging"))
+}
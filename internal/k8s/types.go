@@ -1,10 +1,17 @@
 package k8s
 
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+)
+
 // KubeConfig represents the structure of a kubeconfig file
 type KubeConfig struct {
 	Contexts       []KubeContext `yaml:"contexts"`
 	CurrentContext string        `yaml:"current-context"`
 	Clusters       []Cluster     `yaml:"clusters,omitempty"`
+	Users          []KubeUser    `yaml:"users,omitempty"`
 }
 
 // KubeContext represents a context entry in kubeconfig
@@ -16,6 +23,7 @@ type KubeContext struct {
 // ContextDetails contains the cluster and namespace for a context
 type ContextDetails struct {
 	Cluster   string `yaml:"cluster"`
+	User      string `yaml:"user,omitempty"`
 	Namespace string `yaml:"namespace,omitempty"`
 }
 
@@ -30,12 +38,94 @@ type ClusterConfig struct {
 	Server string `yaml:"server"`
 }
 
+// KubeUser represents a user (credentials) entry in kubeconfig
+type KubeUser struct {
+	Name string   `yaml:"name"`
+	User AuthInfo `yaml:"user"`
+}
+
+// AuthInfo contains the credential settings of a kubeconfig user
+type AuthInfo struct {
+	Token        string        `yaml:"token,omitempty"`
+	Exec         *ExecConfig   `yaml:"exec,omitempty"`
+	AuthProvider *AuthProvider `yaml:"auth-provider,omitempty"`
+}
+
+// ExecConfig describes an exec credential plugin
+type ExecConfig struct {
+	APIVersion  string       `yaml:"apiVersion,omitempty"`
+	Command     string       `yaml:"command"`
+	Args        []string     `yaml:"args,omitempty"`
+	Env         []ExecEnvVar `yaml:"env,omitempty"`
+	InstallHint string       `yaml:"installHint,omitempty"` // Shown when the command is not installed
+}
+
+// ExecEnvVar is an environment variable passed to an exec credential plugin
+type ExecEnvVar struct {
+	Name  string `yaml:"name"`
+	Value string `yaml:"value"`
+}
+
+// AuthProvider describes a legacy auth-provider (oidc, gcp, azure) entry
+type AuthProvider struct {
+	Name   string            `yaml:"name"`
+	Config map[string]string `yaml:"config,omitempty"`
+}
+
+// ExecCredential is the JSON document printed by exec credential plugins
+type ExecCredential struct {
+	Status struct {
+		ExpirationTimestamp string `json:"expirationTimestamp,omitempty"`
+	} `json:"status"`
+}
+
+// Namespace phases
+const (
+	NamespaceActive      = "Active"
+	NamespaceTerminating = "Terminating"
+)
+
 // Namespace represents a Kubernetes namespace
 type Namespace struct {
 	Name       string
+	Status     string // Phase: NamespaceActive or NamespaceTerminating, empty when unknown
+	Labels     map[string]string
+	CreatedAt  time.Time // Creation timestamp, zero when unknown
 	IsFavorite bool
 }
 
+// Terminating reports whether the namespace is being deleted
+func (n Namespace) Terminating() bool {
+	return n.Status == NamespaceTerminating
+}
+
+// Age returns how long ago the namespace was created (0 when unknown)
+func (n Namespace) Age(now time.Time) time.Duration {
+	if n.CreatedAt.IsZero() {
+		return 0
+	}
+	return now.Sub(n.CreatedAt)
+}
+
+// NamespacesNamed returns namespaces with the given names and no metadata, e.g. for
+// namespaces known from the configuration only
+func NamespacesNamed(names []string) []Namespace {
+	namespaces := make([]Namespace, len(names))
+	for i, name := range names {
+		namespaces[i] = Namespace{Name: name}
+	}
+	return namespaces
+}
+
+// NamespaceNames returns the names of namespaces, in order
+func NamespaceNames(namespaces []Namespace) []string {
+	names := make([]string, len(namespaces))
+	for i, ns := range namespaces {
+		names[i] = ns.Name
+	}
+	return names
+}
+
 // NamespaceList represents the JSON response from kubectl get namespaces
 type NamespaceList struct {
 	Items []NamespaceItem `json:"items"`
@@ -44,36 +134,274 @@ type NamespaceList struct {
 // NamespaceItem represents a single namespace in kubectl JSON output
 type NamespaceItem struct {
 	Metadata NamespaceMetadata `json:"metadata"`
+	Status   NamespaceStatus   `json:"status"`
 }
 
 // NamespaceMetadata contains namespace metadata
 type NamespaceMetadata struct {
-	Name string `json:"name"`
+	Name         string            `json:"name"`
+	CreationTime string            `json:"creationTimestamp,omitempty"`
+	Labels       map[string]string `json:"labels,omitempty"`
+}
+
+// NamespaceStatus contains the namespace phase
+type NamespaceStatus struct {
+	Phase string `json:"phase,omitempty"`
 }
 
 // Pod represents a Kubernetes pod (placeholder for future stories)
 type Pod struct {
-	Name   string
-	Status string
+	Name        string
+	Status      string
+	Ports       []int    // Declared container ports, in container order
+	Containers  []string // Container names, in spec order
+	OwnerKind   string
+	OwnerName   string // Controlling owner (e.g. ReplicaSet), empty for bare pods
+	Labels      map[string]string
+	Annotations map[string]string
+	Restarts    int               // Container restarts summed over all containers
+	Ready       string            // Ready containers out of all containers (e.g. "1/2")
+	CreatedAt   time.Time         // Creation timestamp, zero when unknown
+	Node        string            // Node the pod is scheduled on
+	IP          string            // Pod IP, empty until assigned
+	Hostname    string            // spec.hostname, empty when it defaults to the pod name
+	Subdomain   string            // spec.subdomain, the headless service giving the pod a DNS name
+	Privileged  []string          // Containers (including init containers) running privileged
+	HostNetwork bool              // spec.hostNetwork: the pod shares the node's network namespace
+	Root        []string          // Containers set to run as UID 0 by their or the pod's securityContext
+	CPURequest  int64             // CPU requested by all containers, in millicores (0 when none is set)
+	MemRequest  int64             // Memory requested by all containers, in bytes (0 when none is set)
+	Fields      map[string]string // Values of the custom pod columns, by column name
+}
+
+// Security warnings of a pod
+const (
+	SecurityPrivileged  = "privileged"
+	SecurityHostNetwork = "hostNetwork"
+	SecurityRoot        = "root"
+)
+
+// SecurityWarnings lists the risky settings of the pod: SecurityPrivileged, SecurityHostNetwork
+// and SecurityRoot, in that order. Empty for pods without any.
+func (p Pod) SecurityWarnings() []string {
+	var warnings []string
+	if len(p.Privileged) > 0 {
+		warnings = append(warnings, SecurityPrivileged)
+	}
+	if p.HostNetwork {
+		warnings = append(warnings, SecurityHostNetwork)
+	}
+	if len(p.Root) > 0 {
+		warnings = append(warnings, SecurityRoot)
+	}
+	return warnings
+}
+
+// Age returns how long ago the pod was created (0 when unknown)
+func (p Pod) Age(now time.Time) time.Duration {
+	if p.CreatedAt.IsZero() {
+		return 0
+	}
+	return now.Sub(p.CreatedAt)
+}
+
+// FormatAge formats a pod age the way kubectl does for short listings (e.g. 45s, 12m, 5h, 3d)
+func FormatAge(d time.Duration) string {
+	switch {
+	case d < time.Minute:
+		return fmt.Sprintf("%ds", int(d.Seconds()))
+	case d < time.Hour:
+		return fmt.Sprintf("%dm", int(d.Minutes()))
+	case d < 48*time.Hour:
+		return fmt.Sprintf("%dh", int(d.Hours()))
+	default:
+		return fmt.Sprintf("%dd", int(d.Hours()/24))
+	}
 }
 
 // PodList represents the JSON response from kubectl get pods
 type PodList struct {
-	Items []PodItem `json:"items"`
+	Items    []PodItem `json:"items"`
+	Metadata struct {
+		Continue string `json:"continue,omitempty"` // Set when the list was cut at a page limit
+	} `json:"metadata"`
 }
 
 // PodItem represents a single pod in kubectl JSON output
 type PodItem struct {
 	Metadata PodMetadata `json:"metadata"`
+	Spec     PodSpec     `json:"spec"`
 	Status   PodStatus   `json:"status"`
 }
 
 // PodMetadata contains pod metadata
 type PodMetadata struct {
-	Name string `json:"name"`
+	Name            string            `json:"name"`
+	Namespace       string            `json:"namespace,omitempty"`
+	CreationTime    string            `json:"creationTimestamp,omitempty"`
+	Labels          map[string]string `json:"labels,omitempty"`
+	Annotations     map[string]string `json:"annotations,omitempty"`
+	OwnerReferences []OwnerReference  `json:"ownerReferences,omitempty"`
+}
+
+// OwnerReference identifies the object that owns a resource
+type OwnerReference struct {
+	Kind       string `json:"kind"`
+	Name       string `json:"name"`
+	Controller bool   `json:"controller,omitempty"`
+}
+
+// ObjectItem represents any kubectl object when only its metadata is needed
+type ObjectItem struct {
+	Metadata PodMetadata `json:"metadata"`
+}
+
+// PodSpec contains the pod specification fields used by kubertino
+type PodSpec struct {
+	Containers      []Container         `json:"containers"`
+	InitContainers  []Container         `json:"initContainers,omitempty"`
+	NodeName        string              `json:"nodeName,omitempty"`
+	Hostname        string              `json:"hostname,omitempty"`
+	Subdomain       string              `json:"subdomain,omitempty"`
+	HostNetwork     bool                `json:"hostNetwork,omitempty"`
+	SecurityContext *PodSecurityContext `json:"securityContext,omitempty"`
+}
+
+// PodSecurityContext holds the pod-level security settings containers inherit
+type PodSecurityContext struct {
+	RunAsUser *int64 `json:"runAsUser,omitempty"`
+}
+
+// Container represents a container in the pod spec
+type Container struct {
+	Name            string               `json:"name"`
+	Image           string               `json:"image,omitempty"`
+	Ports           []ContainerPort      `json:"ports,omitempty"`
+	SecurityContext *SecurityContext     `json:"securityContext,omitempty"`
+	Resources       ResourceRequirements `json:"resources,omitempty"`
+}
+
+// ResourceRequirements holds the resource requests of a container
+type ResourceRequirements struct {
+	Requests map[string]string `json:"requests,omitempty"`
+}
+
+// SecurityContext holds the security settings of a container
+type SecurityContext struct {
+	Privileged *bool  `json:"privileged,omitempty"`
+	RunAsUser  *int64 `json:"runAsUser,omitempty"`
+}
+
+// ContainerPort represents a port declared by a container
+type ContainerPort struct {
+	ContainerPort int    `json:"containerPort"`
+	Protocol      string `json:"protocol,omitempty"`
 }
 
 // PodStatus contains pod status information
 type PodStatus struct {
-	Phase string `json:"phase"`
+	Phase             string            `json:"phase"`
+	StartTime         string            `json:"startTime,omitempty"`
+	PodIP             string            `json:"podIP,omitempty"`
+	ContainerStatuses []ContainerStatus `json:"containerStatuses,omitempty"`
+}
+
+// ContainerStatus contains the restart information of a container
+type ContainerStatus struct {
+	Name         string         `json:"name"`
+	RestartCount int            `json:"restartCount"`
+	Ready        bool           `json:"ready"`
+	LastState    ContainerState `json:"lastState"`
+}
+
+// ContainerState describes the previous state of a container
+type ContainerState struct {
+	Terminated *ContainerStateTerminated `json:"terminated,omitempty"`
+}
+
+// ContainerStateTerminated describes a terminated container run
+type ContainerStateTerminated struct {
+	ExitCode   int    `json:"exitCode"`
+	Reason     string `json:"reason,omitempty"`
+	FinishedAt string `json:"finishedAt,omitempty"`
+}
+
+// EventList represents the JSON response from kubectl get events
+type EventList struct {
+	Items []EventItem `json:"items"`
+}
+
+// EventItem represents a single event in kubectl JSON output
+type EventItem struct {
+	InvolvedObject ObjectReference `json:"involvedObject"`
+	Reason         string          `json:"reason"`
+	Count          int             `json:"count,omitempty"`
+	FirstTimestamp string          `json:"firstTimestamp,omitempty"`
+	LastTimestamp  string          `json:"lastTimestamp,omitempty"`
+	EventTime      string          `json:"eventTime,omitempty"`
+}
+
+// ObjectReference identifies the object an event is about
+type ObjectReference struct {
+	Kind      string `json:"kind"`
+	Namespace string `json:"namespace"`
+	Name      string `json:"name"`
+}
+
+// ResourceList represents the JSON response from kubectl get for workload resources
+type ResourceList struct {
+	Items []ResourceItem `json:"items"`
+}
+
+// ResourceItem represents a single deployment, statefulset, job, configmap or secret in
+// kubectl JSON output. Only the fields needed to summarize status are decoded.
+type ResourceItem struct {
+	Metadata   PodMetadata       `json:"metadata"`
+	Spec       ResourceSpec      `json:"spec"`
+	Status     ResourceStatus    `json:"status"`
+	Type       string            `json:"type,omitempty"`       // Secret type
+	Data       map[string]string `json:"data,omitempty"`       // ConfigMap values, base64 Secret values
+	BinaryData map[string]string `json:"binaryData,omitempty"` // Base64 ConfigMap values
+}
+
+// ResourceSpec contains the desired state fields used by kubertino
+type ResourceSpec struct {
+	Replicas    *int `json:"replicas,omitempty"`
+	Completions *int `json:"completions,omitempty"`
+}
+
+// ResourceStatus contains the observed state fields used by kubertino
+type ResourceStatus struct {
+	ReadyReplicas int `json:"readyReplicas"`
+	Active        int `json:"active"`
+	Succeeded     int `json:"succeeded"`
+	Failed        int `json:"failed"`
+}
+
+// ServiceList represents the JSON response from kubectl get services
+type ServiceList struct {
+	Items []ServiceItem `json:"items"`
+}
+
+// ServiceItem represents a single service in kubectl JSON output
+type ServiceItem struct {
+	Metadata PodMetadata `json:"metadata"`
+	Spec     ServiceSpec `json:"spec"`
+}
+
+// ServiceSpec contains the service specification fields used by kubertino
+type ServiceSpec struct {
+	Type      string            `json:"type,omitempty"`
+	ClusterIP string            `json:"clusterIP,omitempty"`
+	Selector  map[string]string `json:"selector,omitempty"`
+	Ports     []ServicePortItem `json:"ports,omitempty"`
+}
+
+// ServicePortItem represents a port exposed by a service. targetPort is a number or the
+// name of a container port.
+type ServicePortItem struct {
+	Name       string          `json:"name,omitempty"`
+	Protocol   string          `json:"protocol,omitempty"`
+	Port       int             `json:"port"`
+	TargetPort json.RawMessage `json:"targetPort,omitempty"`
 }
@@ -0,0 +1,62 @@
+package k8s
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// MinKubectlMinor is the oldest kubectl 1.x minor version Kubertino supports: kubectl debug,
+// which the node shell runs, left alpha in 1.20
+const MinKubectlMinor = 20
+
+// KubectlVersion is the version of the kubectl client
+type KubectlVersion struct {
+	GitVersion string // e.g. v1.29.1
+	Major      int
+	Minor      int
+}
+
+// Supported reports whether the version is at least 1.MinKubectlMinor
+func (v KubectlVersion) Supported() bool {
+	return v.Major > 1 || (v.Major == 1 && v.Minor >= MinKubectlMinor)
+}
+
+// ClientVersion returns the version of the kubectl client, without contacting a cluster
+func (k *KubectlAdapter) ClientVersion(timeout time.Duration) (*KubectlVersion, error) {
+	kubectlPath, err := k.lookKubectl()
+	if err != nil {
+		return nil, err
+	}
+
+	output, err := k.runWithin("", kubectlPath, []string{"version", "--client", "-o", "json"}, timeout)
+	if err != nil {
+		return nil, fmt.Errorf("failed to execute kubectl version: %w", err)
+	}
+	return parseClientVersion(output)
+}
+
+// parseClientVersion reads the client version from kubectl version -o json
+func parseClientVersion(output []byte) (*KubectlVersion, error) {
+	var data struct {
+		ClientVersion struct {
+			Major      string `json:"major"`
+			Minor      string `json:"minor"`
+			GitVersion string `json:"gitVersion"`
+		} `json:"clientVersion"`
+	}
+	if err := json.Unmarshal(output, &data); err != nil {
+		return nil, fmt.Errorf("failed to parse kubectl version: %w", err)
+	}
+
+	client := data.ClientVersion
+	// Vendor builds report minor versions such as "29+"
+	major, majorErr := strconv.Atoi(strings.TrimRight(client.Major, "+"))
+	minor, minorErr := strconv.Atoi(strings.TrimRight(client.Minor, "+"))
+	if majorErr != nil || minorErr != nil {
+		return nil, fmt.Errorf("failed to parse kubectl version %q.%q", client.Major, client.Minor)
+	}
+	return &KubectlVersion{GitVersion: client.GitVersion, Major: major, Minor: minor}, nil
+}
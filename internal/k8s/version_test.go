@@ -0,0 +1,29 @@
+package k8s
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestClientVersion(t *testing.T) {
+	stubKubectl(t, `echo '{"clientVersion": {"major": "1", "minor": "29+", "gitVersion": "v1.29.1-gke.100"}, "kustomizeVersion": "v5.0.4"}'`)
+
+	version, err := NewKubectlAdapter("").ClientVersion(time.Second)
+	require.NoError(t, err)
+	assert.Equal(t, &KubectlVersion{GitVersion: "v1.29.1-gke.100", Major: 1, Minor: 29}, version)
+	assert.True(t, version.Supported())
+}
+
+func TestParseClientVersion(t *testing.T) {
+	version, err := parseClientVersion([]byte(`{"clientVersion": {"major": "1", "minor": "19", "gitVersion": "v1.19.16"}}`))
+	require.NoError(t, err)
+	assert.False(t, version.Supported())
+
+	_, err = parseClientVersion([]byte(`{"clientVersion": {}}`))
+	assert.Error(t, err)
+	_, err = parseClientVersion([]byte(`Client Version: v1.29.1`))
+	assert.Error(t, err)
+}
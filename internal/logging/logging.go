@@ -0,0 +1,234 @@
+// Package logging writes the application log to a size-rotated file, keeps the latest records
+// in memory for the debug overlay and writes crash reports
+package logging
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+)
+
+// Rotation defaults
+const (
+	MaxSize = 10 << 20 // Size in bytes at which the log file is rotated
+	Backups = 3        // Rotated files kept: kubertino.log.1 (newest) to kubertino.log.3
+)
+
+// DefaultPath returns the log file used unless log_file is set: ~/.kubertino/kubertino.log
+func DefaultPath() (string, error) {
+	homeDir, err := os.UserHomeDir()
+	if err != nil {
+		return "", fmt.Errorf("failed to get home directory: %w", err)
+	}
+	return filepath.Join(homeDir, ".kubertino", "kubertino.log"), nil
+}
+
+// RotatingFile is an append-only log file that is rotated once it would grow past maxSize.
+// The current file is renamed to <path>.1, shifting older files up to <path>.<backups>.
+type RotatingFile struct {
+	mu      sync.Mutex
+	path    string
+	maxSize int64
+	backups int
+	file    *os.File
+	size    int64
+}
+
+// OpenRotating opens the log file at path for appending, creating its directory
+func OpenRotating(path string, maxSize int64, backups int) (*RotatingFile, error) {
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		return nil, fmt.Errorf("failed to create log directory: %w", err)
+	}
+
+	f := &RotatingFile{path: path, maxSize: maxSize, backups: backups}
+	if err := f.open(); err != nil {
+		return nil, err
+	}
+	return f, nil
+}
+
+// open opens the file at f.path and records its size
+func (f *RotatingFile) open() error {
+	file, err := os.OpenFile(f.path, os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0644)
+	if err != nil {
+		return fmt.Errorf("failed to open log file: %w", err)
+	}
+	info, err := file.Stat()
+	if err != nil {
+		file.Close()
+		return fmt.Errorf("failed to stat log file: %w", err)
+	}
+	f.file, f.size = file, info.Size()
+	return nil
+}
+
+// Write appends p, rotating the file first when p would take it past the size limit
+func (f *RotatingFile) Write(p []byte) (int, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	if f.size > 0 && f.size+int64(len(p)) > f.maxSize {
+		if err := f.rotate(); err != nil {
+			return 0, err
+		}
+	}
+	n, err := f.file.Write(p)
+	f.size += int64(n)
+	return n, err
+}
+
+// rotate shifts the backups, moves the current file to <path>.1 and starts a new one
+func (f *RotatingFile) rotate() error {
+	if err := f.file.Close(); err != nil {
+		return fmt.Errorf("failed to close log file: %w", err)
+	}
+
+	for i := f.backups - 1; i >= 1; i-- {
+		err := os.Rename(fmt.Sprintf("%s.%d", f.path, i), fmt.Sprintf("%s.%d", f.path, i+1))
+		if err != nil && !errors.Is(err, os.ErrNotExist) {
+			return fmt.Errorf("failed to rotate log file: %w", err)
+		}
+	}
+	if f.backups > 0 {
+		if err := os.Rename(f.path, f.path+".1"); err != nil {
+			return fmt.Errorf("failed to rotate log file: %w", err)
+		}
+	} else if err := os.Remove(f.path); err != nil {
+		return fmt.Errorf("failed to rotate log file: %w", err)
+	}
+	return f.open()
+}
+
+// Close closes the log file
+func (f *RotatingFile) Close() error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return f.file.Close()
+}
+
+// Recent keeps the latest log lines in memory, for the debug overlay
+type Recent struct {
+	mu    sync.Mutex
+	lines []string
+	max   int
+}
+
+// NewRecent returns a buffer keeping the last max lines
+func NewRecent(max int) *Recent {
+	return &Recent{max: max}
+}
+
+// Write records each line of p, dropping the oldest beyond the limit
+func (r *Recent) Write(p []byte) (int, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	for _, line := range strings.Split(strings.TrimRight(string(p), "\n"), "\n") {
+		r.lines = append(r.lines, line)
+	}
+	if len(r.lines) > r.max {
+		r.lines = append([]string(nil), r.lines[len(r.lines)-r.max:]...)
+	}
+	return len(p), nil
+}
+
+// Lines returns the recorded lines, oldest first
+func (r *Recent) Lines() []string {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return append([]string(nil), r.lines...)
+}
+
+// Setup directs slog to a JSON log at path, rotated by size, recording level and above.
+// With recent set, records are also kept there as short text lines. Returns the log file,
+// to be closed on exit.
+func Setup(path string, level slog.Level, recent *Recent) (io.Closer, error) {
+	file, err := OpenRotating(path, MaxSize, Backups)
+	if err != nil {
+		return nil, err
+	}
+
+	options := &slog.HandlerOptions{Level: level}
+	var handler slog.Handler = slog.NewJSONHandler(file, options)
+	if recent != nil {
+		text := slog.NewTextHandler(recent, &slog.HandlerOptions{
+			Level: level,
+			ReplaceAttr: func(groups []string, a slog.Attr) slog.Attr {
+				// The overlay has little room: keep the time of day only
+				if a.Key == slog.TimeKey && len(groups) == 0 {
+					return slog.String(slog.TimeKey, a.Value.Time().Format("15:04:05"))
+				}
+				return a
+			},
+		})
+		handler = teeHandler{handler, text}
+	}
+	slog.SetDefault(slog.New(handler))
+	return file, nil
+}
+
+// teeHandler passes records to several handlers
+type teeHandler []slog.Handler
+
+func (t teeHandler) Enabled(ctx context.Context, level slog.Level) bool {
+	for _, h := range t {
+		if h.Enabled(ctx, level) {
+			return true
+		}
+	}
+	return false
+}
+
+func (t teeHandler) Handle(ctx context.Context, record slog.Record) error {
+	var errs []error
+	for _, h := range t {
+		if h.Enabled(ctx, record.Level) {
+			errs = append(errs, h.Handle(ctx, record.Clone()))
+		}
+	}
+	return errors.Join(errs...)
+}
+
+func (t teeHandler) WithAttrs(attrs []slog.Attr) slog.Handler {
+	handlers := make(teeHandler, len(t))
+	for i, h := range t {
+		handlers[i] = h.WithAttrs(attrs)
+	}
+	return handlers
+}
+
+func (t teeHandler) WithGroup(name string) slog.Handler {
+	handlers := make(teeHandler, len(t))
+	for i, h := range t {
+		handlers[i] = h.WithGroup(name)
+	}
+	return handlers
+}
+
+// WriteCrashReport writes a crash report to dir: the panic value, the stack trace and a
+// snapshot of the application state. Returns the path of the report.
+func WriteCrashReport(dir string, value any, stack []byte, snapshot string) (string, error) {
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return "", fmt.Errorf("failed to create crash report directory: %w", err)
+	}
+
+	now := time.Now()
+	path := filepath.Join(dir, "crash-"+now.Format("20060102-150405")+".log")
+	var report strings.Builder
+	fmt.Fprintf(&report, "time: %s\n", now.Format(time.RFC3339))
+	fmt.Fprintf(&report, "panic: %v\n\n", value)
+	fmt.Fprintf(&report, "state:\n%s\n\n", snapshot)
+	fmt.Fprintf(&report, "stack:\n%s", stack)
+
+	if err := os.WriteFile(path, []byte(report.String()), 0600); err != nil {
+		return "", fmt.Errorf("failed to write crash report: %w", err)
+	}
+	return path, nil
+}
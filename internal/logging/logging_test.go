@@ -0,0 +1,96 @@
+package logging
+
+import (
+	"encoding/json"
+	"log/slog"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRotatingFile_RotatesBySize(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "logs", "kubertino.log")
+	file, err := OpenRotating(path, 10, 2)
+	require.NoError(t, err)
+	defer file.Close()
+
+	for _, line := range []string{"first\n", "second\n", "third\n", "fourth\n"} {
+		_, err := file.Write([]byte(line))
+		require.NoError(t, err)
+	}
+
+	read := func(name string) string {
+		data, err := os.ReadFile(name)
+		require.NoError(t, err)
+		return string(data)
+	}
+	assert.Equal(t, "fourth\n", read(path))
+	assert.Equal(t, "third\n", read(path+".1"))
+	assert.Equal(t, "second\n", read(path+".2"))
+	assert.NoFileExists(t, path+".3", "only two backups are kept")
+}
+
+func TestRotatingFile_AppendsToExistingFile(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "kubertino.log")
+	require.NoError(t, os.WriteFile(path, []byte("old\n"), 0644))
+
+	file, err := OpenRotating(path, 100, 1)
+	require.NoError(t, err)
+	_, err = file.Write([]byte("new\n"))
+	require.NoError(t, err)
+	require.NoError(t, file.Close())
+
+	data, err := os.ReadFile(path)
+	require.NoError(t, err)
+	assert.Equal(t, "old\nnew\n", string(data))
+}
+
+func TestRecent_KeepsLatestLines(t *testing.T) {
+	recent := NewRecent(2)
+	_, _ = recent.Write([]byte("one\ntwo\n"))
+	_, _ = recent.Write([]byte("three\n"))
+
+	assert.Equal(t, []string{"two", "three"}, recent.Lines())
+}
+
+func TestSetup_WritesJSONAtLevel(t *testing.T) {
+	defer slog.SetDefault(slog.Default())
+	path := filepath.Join(t.TempDir(), "kubertino.log")
+	recent := NewRecent(10)
+
+	file, err := Setup(path, slog.LevelWarn, recent)
+	require.NoError(t, err)
+	slog.Info("dropped")
+	slog.Warn("kept", "context", "prod")
+	require.NoError(t, file.Close())
+
+	data, err := os.ReadFile(path)
+	require.NoError(t, err)
+	lines := strings.Split(strings.TrimSpace(string(data)), "\n")
+	require.Len(t, lines, 1)
+	var record map[string]any
+	require.NoError(t, json.Unmarshal([]byte(lines[0]), &record))
+	assert.Equal(t, "kept", record["msg"])
+	assert.Equal(t, "prod", record["context"])
+
+	require.Len(t, recent.Lines(), 1)
+	assert.Contains(t, recent.Lines()[0], "msg=kept context=prod")
+}
+
+func TestWriteCrashReport(t *testing.T) {
+	dir := filepath.Join(t.TempDir(), "crashes")
+	path, err := WriteCrashReport(dir, "boom", []byte("goroutine 1 [running]:"), "view: main")
+	require.NoError(t, err)
+	assert.Equal(t, dir, filepath.Dir(path))
+	assert.True(t, strings.HasPrefix(filepath.Base(path), "crash-"))
+
+	data, err := os.ReadFile(path)
+	require.NoError(t, err)
+	assert.Contains(t, string(data), "panic: boom")
+	assert.Contains(t, string(data), "state:\nview: main")
+	assert.Contains(t, string(data), "stack:\ngoroutine 1 [running]:")
+}
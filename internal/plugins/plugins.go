@@ -0,0 +1,243 @@
+// Package plugins discovers action providers: executables in the plugins directory that
+// print JSON action definitions when run. Their actions are merged with the configured ones.
+package plugins
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log/slog"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/maratkarimov/kubertino/internal/config"
+)
+
+// RunTimeout bounds how long a plugin may take to print its actions
+const RunTimeout = 5 * time.Second
+
+// definition is an action as printed by a plugin. The keys match the config file.
+type definition struct {
+	Name        string   `json:"name"`
+	Shortcut    string   `json:"shortcut"`
+	Command     string   `json:"command"`
+	Description string   `json:"description"`
+	Destructive bool     `json:"destructive"`
+	WaitOnExit  bool     `json:"wait_on_exit"`
+	Tags        []string `json:"tags"`
+	AppliesTo   string   `json:"applies_to"`
+	Scope       string   `json:"scope"`
+	Output      string   `json:"output"`
+	Background  bool     `json:"background"`
+	// Keys of params match the config file; JSON decoding matches them case-insensitively
+	Params []config.ActionParam `json:"params"`
+}
+
+// DefaultDir returns the plugins directory: $XDG_CONFIG_HOME/kubertino/plugins, or
+// ~/.config/kubertino/plugins when XDG_CONFIG_HOME is unset
+func DefaultDir() (string, error) {
+	if dir := os.Getenv("XDG_CONFIG_HOME"); dir != "" {
+		return filepath.Join(dir, "kubertino", "plugins"), nil
+	}
+
+	homeDir, err := os.UserHomeDir()
+	if err != nil {
+		return "", fmt.Errorf("failed to get home directory: %w", err)
+	}
+	return filepath.Join(homeDir, ".config", "kubertino", "plugins"), nil
+}
+
+// Discover returns the executables in dir, sorted by name. Hidden files, directories and
+// files without an execute bit are ignored. A missing directory yields no plugins.
+func Discover(dir string) ([]string, error) {
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to read plugins directory: %w", err)
+	}
+
+	var paths []string
+	for _, entry := range entries {
+		if strings.HasPrefix(entry.Name(), ".") {
+			continue
+		}
+		// Follow symlinks, plugins are often linked from where they are installed
+		path := filepath.Join(dir, entry.Name())
+		info, err := os.Stat(path)
+		if err != nil || !info.Mode().IsRegular() || info.Mode().Perm()&0o111 == 0 {
+			continue
+		}
+		paths = append(paths, path)
+	}
+	sort.Strings(paths)
+	return paths, nil
+}
+
+// Run runs the plugin at path and returns the actions it prints on stdout: a JSON array of
+// actions or an object with an actions array. Each action is marked with the plugin name.
+func Run(path string) ([]config.Action, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), RunTimeout)
+	defer cancel()
+
+	var stderr bytes.Buffer
+	cmd := exec.CommandContext(ctx, path)
+	cmd.Stderr = &stderr
+	output, err := cmd.Output()
+	if err != nil {
+		if ctx.Err() == context.DeadlineExceeded {
+			return nil, fmt.Errorf("timed out after %s", RunTimeout)
+		}
+		return nil, fmt.Errorf("%w: %s", err, strings.TrimSpace(stderr.String()))
+	}
+
+	definitions, err := parse(output)
+	if err != nil {
+		return nil, err
+	}
+
+	name := filepath.Base(path)
+	actions := make([]config.Action, len(definitions))
+	for i, d := range definitions {
+		actions[i] = config.Action{
+			Name:        d.Name,
+			Shortcut:    d.Shortcut,
+			Command:     d.Command,
+			Description: d.Description,
+			Destructive: d.Destructive,
+			WaitOnExit:  d.WaitOnExit,
+			Tags:        d.Tags,
+			AppliesTo:   d.AppliesTo,
+			Scope:       d.Scope,
+			Output:      d.Output,
+			Background:  d.Background,
+			Params:      d.Params,
+			Plugin:      name,
+		}
+	}
+	return actions, nil
+}
+
+// parse decodes plugin output: a JSON array of actions or {"actions": [...]}
+func parse(output []byte) ([]definition, error) {
+	output = bytes.TrimSpace(output)
+	if bytes.HasPrefix(output, []byte("[")) {
+		var definitions []definition
+		if err := json.Unmarshal(output, &definitions); err != nil {
+			return nil, fmt.Errorf("invalid action definitions: %w", err)
+		}
+		return definitions, nil
+	}
+
+	var wrapper struct {
+		Actions []definition `json:"actions"`
+	}
+	if err := json.Unmarshal(output, &wrapper); err != nil {
+		return nil, fmt.Errorf("invalid action definitions: %w", err)
+	}
+	return wrapper.Actions, nil
+}
+
+// Merge appends plugin actions to the configured global actions and returns the result with
+// the reasons actions were skipped. Configured actions take precedence: a plugin action is
+// skipped when its name or shortcut is already taken, by the config or an earlier plugin,
+// and when it is invalid. Per-context actions still override plugin actions by shortcut.
+func Merge(configured, provided []config.Action) ([]config.Action, []error) {
+	merged := append([]config.Action(nil), configured...)
+	names := make(map[string]config.Action)
+	shortcuts := make(map[string]config.Action)
+	for _, action := range configured {
+		names[action.Name] = action
+		// Shortcuts of actions in a group only follow the group's
+		if action.Group == "" {
+			shortcuts[action.Shortcut] = action
+		}
+	}
+
+	var skipped []error
+	indexes := make(map[string]int) // Index of the next action of each plugin
+	for _, action := range provided {
+		index := indexes[action.Plugin]
+		indexes[action.Plugin]++
+		if err := config.ValidateAction(action, index, "plugin "+action.Plugin); err != nil {
+			skipped = append(skipped, err)
+			continue
+		}
+		if existing, ok := shortcuts[action.Shortcut]; ok {
+			skipped = append(skipped, fmt.Errorf("plugin %s: action '%s' skipped: shortcut '%s' is taken by %s",
+				action.Plugin, action.Name, action.Shortcut, describe(existing)))
+			continue
+		}
+		if existing, ok := names[action.Name]; ok {
+			skipped = append(skipped, fmt.Errorf("plugin %s: action '%s' skipped: name is taken by %s",
+				action.Plugin, action.Name, describe(existing)))
+			continue
+		}
+
+		merged = append(merged, action)
+		names[action.Name] = action
+		shortcuts[action.Shortcut] = action
+	}
+	return merged, skipped
+}
+
+// describe names an action and where it came from, for conflict messages
+func describe(action config.Action) string {
+	if action.Plugin != "" {
+		return fmt.Sprintf("action '%s' of plugin %s", action.Name, action.Plugin)
+	}
+	return fmt.Sprintf("configured action '%s'", action.Name)
+}
+
+// Load runs the plugins in dir and merges their actions into the global actions of cfg.
+// Plugins run concurrently; their actions are merged in plugin name order, so the first
+// plugin wins a conflict. Failing plugins and skipped actions are logged and never stop
+// startup. Returns the number of plugin actions added.
+func Load(cfg *config.Config, dir string) int {
+	paths, err := Discover(dir)
+	if err != nil {
+		slog.Warn("plugins disabled", "dir", dir, "error", err)
+		return 0
+	}
+	if len(paths) == 0 {
+		return 0
+	}
+
+	results := make([][]config.Action, len(paths))
+	var wg sync.WaitGroup
+	for i, path := range paths {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			actions, err := Run(path)
+			if err != nil {
+				slog.Warn("plugin failed, its actions are skipped", "plugin", path, "error", err)
+				return
+			}
+			results[i] = actions
+		}()
+	}
+	wg.Wait()
+
+	var provided []config.Action
+	for _, actions := range results {
+		provided = append(provided, actions...)
+	}
+
+	before := len(cfg.Actions)
+	merged, skipped := Merge(cfg.Actions, provided)
+	for _, err := range skipped {
+		slog.Warn("plugin action skipped", "error", err)
+	}
+	cfg.Actions = merged
+	slog.Info("loaded plugins", "dir", dir, "plugins", len(paths), "actions", len(merged)-before)
+	return len(merged) - before
+}
@@ -0,0 +1,129 @@
+package plugins
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/maratkarimov/kubertino/internal/config"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// writePlugin writes an executable shell script called name into dir
+func writePlugin(t *testing.T, dir, name, body string) string {
+	t.Helper()
+	path := filepath.Join(dir, name)
+	require.NoError(t, os.WriteFile(path, []byte("#!/bin/sh\n"+body), 0o755))
+	return path
+}
+
+func TestDefaultDir(t *testing.T) {
+	t.Setenv("XDG_CONFIG_HOME", "/xdg")
+	dir, err := DefaultDir()
+	require.NoError(t, err)
+	assert.Equal(t, filepath.Join("/xdg", "kubertino", "plugins"), dir)
+
+	t.Setenv("XDG_CONFIG_HOME", "")
+	t.Setenv("HOME", "/home/alice")
+	dir, err = DefaultDir()
+	require.NoError(t, err)
+	assert.Equal(t, filepath.Join("/home/alice", ".config", "kubertino", "plugins"), dir)
+}
+
+func TestDiscover(t *testing.T) {
+	dir := t.TempDir()
+	b := writePlugin(t, dir, "b-plugin", "")
+	a := writePlugin(t, dir, "a-plugin", "")
+	writePlugin(t, dir, ".hidden", "")
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "README.md"), []byte("not a plugin"), 0o644))
+	require.NoError(t, os.Mkdir(filepath.Join(dir, "lib"), 0o755))
+
+	paths, err := Discover(dir)
+	require.NoError(t, err)
+	assert.Equal(t, []string{a, b}, paths)
+
+	paths, err = Discover(filepath.Join(dir, "missing"))
+	require.NoError(t, err)
+	assert.Empty(t, paths)
+}
+
+func TestRun(t *testing.T) {
+	dir := t.TempDir()
+
+	t.Run("array", func(t *testing.T) {
+		path := writePlugin(t, dir, "logs", `echo '[{"name": "Loki", "shortcut": "L", "command": "loki {{.pod}}", "description": "Query Loki", "tags": ["logs"], "wait_on_exit": true}]'`)
+		actions, err := Run(path)
+		require.NoError(t, err)
+		assert.Equal(t, []config.Action{{
+			Name: "Loki", Shortcut: "L", Command: "loki {{.pod}}", Description: "Query Loki", Tags: []string{"logs"}, WaitOnExit: true, Plugin: "logs",
+		}}, actions)
+	})
+
+	t.Run("object", func(t *testing.T) {
+		path := writePlugin(t, dir, "db", `echo '{"actions": [{"name": "psql", "shortcut": "P", "command": "psql", "destructive": true}]}'`)
+		actions, err := Run(path)
+		require.NoError(t, err)
+		require.Len(t, actions, 1)
+		assert.True(t, actions[0].Destructive)
+		assert.Equal(t, "db", actions[0].Plugin)
+	})
+
+	t.Run("invalid json", func(t *testing.T) {
+		path := writePlugin(t, dir, "broken", `echo 'name: Loki'`)
+		_, err := Run(path)
+		assert.ErrorContains(t, err, "invalid action definitions")
+	})
+
+	t.Run("failure", func(t *testing.T) {
+		path := writePlugin(t, dir, "failing", `echo "token missing" >&2; exit 2`)
+		_, err := Run(path)
+		assert.ErrorContains(t, err, "token missing")
+	})
+}
+
+func TestMerge(t *testing.T) {
+	configured := []config.Action{
+		{Name: "Shell", Shortcut: "s", Command: "sh"},
+	}
+	provided := []config.Action{
+		{Name: "Other Shell", Shortcut: "s", Command: "bash", Plugin: "a"},
+		{Name: "Loki", Shortcut: "L", Command: "loki", Plugin: "a"},
+		{Name: "Shell", Shortcut: "x", Command: "zsh", Plugin: "a"},
+		{Name: "Loki 2", Shortcut: "L", Command: "loki", Plugin: "b"},
+		{Name: "No Command", Shortcut: "n", Plugin: "b"},
+		{Name: "Trace", Shortcut: "T", Command: "trace {{.pod}}", Plugin: "b"},
+	}
+
+	merged, skipped := Merge(configured, provided)
+
+	var names []string
+	for _, action := range merged {
+		names = append(names, action.Name)
+	}
+	assert.Equal(t, []string{"Shell", "Loki", "Trace"}, names, "configured actions and earlier plugins win")
+	require.Len(t, skipped, 4)
+	assert.ErrorContains(t, skipped[0], "plugin a: action 'Other Shell' skipped: shortcut 's' is taken by configured action 'Shell'")
+	assert.ErrorContains(t, skipped[1], "plugin a: action 'Shell' skipped: name is taken by configured action 'Shell'")
+	assert.ErrorContains(t, skipped[2], "shortcut 'L' is taken by action 'Loki' of plugin a")
+	assert.ErrorContains(t, skipped[3], "context (plugin b), action[1] (No Command): command is required")
+	assert.Len(t, configured, 1, "configured actions are not modified")
+}
+
+func TestLoad(t *testing.T) {
+	dir := t.TempDir()
+	writePlugin(t, dir, "logs", `echo '[{"name": "Loki", "shortcut": "L", "command": "loki {{.pod}}"}]'`)
+	writePlugin(t, dir, "failing", `exit 1`)
+
+	cfg := &config.Config{
+		Version:  "1.0",
+		Actions:  []config.Action{{Name: "Shell", Shortcut: "s", Command: "sh"}},
+		Contexts: []config.Context{{Name: "prod"}},
+	}
+	assert.Equal(t, 1, Load(cfg, dir), "a failing plugin does not stop the others")
+	require.Len(t, cfg.Actions, 2)
+	assert.Equal(t, "Loki", cfg.Actions[1].Name)
+	require.NoError(t, config.Validate(cfg))
+
+	assert.Zero(t, Load(cfg, filepath.Join(dir, "missing")))
+}
@@ -0,0 +1,147 @@
+// Package safefile replaces files so that a crash or a full disk never leaves them truncated,
+// and keeps timestamped backups of files worth protecting such as the user config.
+package safefile
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"time"
+)
+
+// backupTimeFormat names backups so that they sort by age
+const backupTimeFormat = "20060102-150405.000000000"
+
+// WriteFile replaces the file at path with data: the data is written to a temporary file in
+// the same directory, synced to disk and renamed over path, so readers see either the old or
+// the new content. An existing file keeps its permissions; a new one gets perm. A symlink is
+// followed so the file it points to is replaced, not the link.
+func WriteFile(path string, data []byte, perm os.FileMode) error {
+	path, perm, err := target(path, perm)
+	if err != nil {
+		return err
+	}
+
+	tmp, err := os.CreateTemp(filepath.Dir(path), "."+filepath.Base(path)+".tmp-*")
+	if err != nil {
+		return fmt.Errorf("failed to create temporary file for %s: %w", path, err)
+	}
+	// Removing fails harmlessly once the file has been renamed
+	defer os.Remove(tmp.Name())
+
+	if _, err := tmp.Write(data); err != nil {
+		tmp.Close()
+		return fmt.Errorf("failed to write %s: %w", path, err)
+	}
+	if err := tmp.Chmod(perm); err != nil {
+		tmp.Close()
+		return fmt.Errorf("failed to set permissions of %s: %w", path, err)
+	}
+	if err := tmp.Sync(); err != nil {
+		tmp.Close()
+		return fmt.Errorf("failed to sync %s: %w", path, err)
+	}
+	if err := tmp.Close(); err != nil {
+		return fmt.Errorf("failed to write %s: %w", path, err)
+	}
+	if err := os.Rename(tmp.Name(), path); err != nil {
+		return fmt.Errorf("failed to replace %s: %w", path, err)
+	}
+
+	// Persist the rename itself; not every platform can sync a directory
+	if dir, err := os.Open(filepath.Dir(path)); err == nil {
+		dir.Sync()
+		dir.Close()
+	}
+	return nil
+}
+
+// target resolves symlinks in path and returns the permissions to write it with
+func target(path string, perm os.FileMode) (string, os.FileMode, error) {
+	resolved, err := filepath.EvalSymlinks(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return path, perm, nil
+	}
+	if err != nil {
+		return "", 0, fmt.Errorf("failed to resolve %s: %w", path, err)
+	}
+
+	info, err := os.Stat(resolved)
+	if err != nil {
+		return "", 0, fmt.Errorf("failed to stat %s: %w", path, err)
+	}
+	return resolved, info.Mode().Perm(), nil
+}
+
+// Backup copies the file at path to path.bak.<timestamp> and removes all but the keep most
+// recent backups. A missing file needs no backup.
+func Backup(path string, keep int) error {
+	path, perm, err := target(path, 0600)
+	if err != nil {
+		return err
+	}
+
+	src, err := os.Open(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("failed to back up %s: %w", path, err)
+	}
+	defer src.Close()
+
+	backup := path + ".bak." + time.Now().Format(backupTimeFormat)
+	dst, err := os.OpenFile(backup, os.O_WRONLY|os.O_CREATE|os.O_EXCL, perm)
+	if err != nil {
+		return fmt.Errorf("failed to back up %s: %w", path, err)
+	}
+	if _, err := io.Copy(dst, src); err != nil {
+		dst.Close()
+		return fmt.Errorf("failed to back up %s: %w", path, err)
+	}
+	if err := dst.Close(); err != nil {
+		return fmt.Errorf("failed to back up %s: %w", path, err)
+	}
+
+	return prune(path, keep)
+}
+
+// Backups returns the backups of path, oldest first
+func Backups(path string) ([]string, error) {
+	path, _, err := target(path, 0)
+	if err != nil {
+		return nil, err
+	}
+
+	entries, err := os.ReadDir(filepath.Dir(path))
+	if err != nil {
+		return nil, fmt.Errorf("failed to list backups of %s: %w", path, err)
+	}
+	prefix := filepath.Base(path) + ".bak."
+	var backups []string
+	for _, entry := range entries {
+		if !entry.IsDir() && strings.HasPrefix(entry.Name(), prefix) {
+			backups = append(backups, filepath.Join(filepath.Dir(path), entry.Name()))
+		}
+	}
+	slices.Sort(backups)
+	return backups, nil
+}
+
+// prune removes all but the keep most recent backups of path
+func prune(path string, keep int) error {
+	backups, err := Backups(path)
+	if err != nil {
+		return err
+	}
+	for _, old := range backups[:max(len(backups)-keep, 0)] {
+		if err := os.Remove(old); err != nil {
+			return fmt.Errorf("failed to remove old backup %s: %w", old, err)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,83 @@
+package safefile
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWriteFile(t *testing.T) {
+	t.Run("creates and replaces", func(t *testing.T) {
+		dir := t.TempDir()
+		path := filepath.Join(dir, "state.json")
+
+		require.NoError(t, WriteFile(path, []byte("one"), 0640))
+		require.NoError(t, WriteFile(path, []byte("two"), 0644))
+
+		data, err := os.ReadFile(path)
+		require.NoError(t, err)
+		assert.Equal(t, "two", string(data))
+		info, err := os.Stat(path)
+		require.NoError(t, err)
+		assert.Equal(t, os.FileMode(0640), info.Mode().Perm(), "existing permissions are kept")
+
+		entries, err := os.ReadDir(dir)
+		require.NoError(t, err)
+		assert.Len(t, entries, 1, "no temporary files are left behind")
+	})
+
+	t.Run("replaces the target of a symlink", func(t *testing.T) {
+		dir := t.TempDir()
+		real := filepath.Join(dir, "dotfiles.yml")
+		link := filepath.Join(dir, "kubertino.yml")
+		require.NoError(t, os.WriteFile(real, []byte("old"), 0644))
+		require.NoError(t, os.Symlink(real, link))
+
+		require.NoError(t, WriteFile(link, []byte("new"), 0644))
+
+		target, err := os.Readlink(link)
+		require.NoError(t, err)
+		assert.Equal(t, real, target, "the link is kept")
+		data, err := os.ReadFile(real)
+		require.NoError(t, err)
+		assert.Equal(t, "new", string(data))
+	})
+
+	t.Run("missing directory", func(t *testing.T) {
+		err := WriteFile(filepath.Join(t.TempDir(), "missing", "state.json"), nil, 0644)
+		assert.Error(t, err)
+	})
+}
+
+func TestBackup(t *testing.T) {
+	dir := t.TempDir()
+	path := filepath.Join(dir, "kubertino.yml")
+
+	require.NoError(t, Backup(path, 3), "a missing file needs no backup")
+	backups, err := Backups(path)
+	require.NoError(t, err)
+	assert.Empty(t, backups)
+
+	for _, content := range []string{"1", "2", "3", "4", "5"} {
+		require.NoError(t, os.WriteFile(path, []byte(content), 0600))
+		require.NoError(t, Backup(path, 3))
+	}
+
+	backups, err = Backups(path)
+	require.NoError(t, err)
+	require.Len(t, backups, 3, "only the most recent backups are kept")
+	var contents []string
+	for _, backup := range backups {
+		data, err := os.ReadFile(backup)
+		require.NoError(t, err)
+		contents = append(contents, string(data))
+	}
+	assert.Equal(t, []string{"3", "4", "5"}, contents)
+
+	info, err := os.Stat(backups[0])
+	require.NoError(t, err)
+	assert.Equal(t, os.FileMode(0600), info.Mode().Perm(), "backups keep the file's permissions")
+}
@@ -1,6 +1,10 @@
 package search
 
 import (
+	"slices"
+	"sort"
+	"strings"
+
 	"github.com/maratkarimov/kubertino/internal/k8s"
 	"github.com/sahilm/fuzzy"
 )
@@ -11,8 +15,53 @@ type Match struct {
 	MatchIndices []int // Character positions that matched the query
 }
 
-// FuzzyMatch performs fuzzy search on namespace list and returns matches with highlighting indices
+// ParseQuery splits a namespace query into label terms (words of the form key=value) and
+// the remaining text, which is matched against namespace names. Without label terms the text
+// is the query unchanged.
+func ParseQuery(query string) (labels map[string]string, text string) {
+	var words []string
+	for _, word := range strings.Fields(query) {
+		key, value, ok := strings.Cut(word, "=")
+		if !ok || key == "" {
+			words = append(words, word)
+			continue
+		}
+		if labels == nil {
+			labels = make(map[string]string)
+		}
+		labels[key] = value
+	}
+	if labels == nil {
+		return nil, query
+	}
+	return labels, strings.Join(words, " ")
+}
+
+// hasLabels reports whether the namespace carries all labels
+func hasLabels(ns k8s.Namespace, labels map[string]string) bool {
+	for key, value := range labels {
+		if actual, ok := ns.Labels[key]; !ok || actual != value {
+			return false
+		}
+	}
+	return true
+}
+
+// FuzzyMatch performs fuzzy search on namespace list and returns matches with highlighting indices.
+// Label terms of the query (e.g. "team=payments", see ParseQuery) keep only namespaces with
+// those labels; the rest of the query is matched fuzzily against their names.
 func FuzzyMatch(query string, namespaces []k8s.Namespace) []Match {
+	labels, query := ParseQuery(query)
+	if labels != nil {
+		var labeled []k8s.Namespace
+		for _, ns := range namespaces {
+			if hasLabels(ns, labels) {
+				labeled = append(labeled, ns)
+			}
+		}
+		namespaces = labeled
+	}
+
 	// Empty query returns all namespaces
 	if query == "" {
 		matches := make([]Match, len(namespaces))
@@ -31,17 +80,91 @@ func FuzzyMatch(query string, namespaces []k8s.Namespace) []Match {
 		names[i] = ns.Name
 	}
 
-	// Perform fuzzy search
+	// Convert name matches to Match structs
+	nameMatches := FuzzyMatchNames(query, names)
+	matches := make([]Match, len(nameMatches))
+	for i, result := range nameMatches {
+		matches[i] = Match{
+			Namespace:    namespaces[result.Index],
+			MatchIndices: result.MatchIndices,
+		}
+	}
+
+	return matches
+}
+
+// NameMatch represents a fuzzy search match against a plain list of names
+type NameMatch struct {
+	Index        int   // Position of the name in the searched list
+	MatchIndices []int // Character positions that matched the query
+}
+
+// FuzzyMatchNames performs fuzzy search on a list of names (e.g. pods) and returns
+// matches ordered by relevance. An empty query returns all names in original order.
+func FuzzyMatchNames(query string, names []string) []NameMatch {
+	if query == "" {
+		matches := make([]NameMatch, len(names))
+		for i := range names {
+			matches[i] = NameMatch{Index: i, MatchIndices: []int{}}
+		}
+		return matches
+	}
+
 	fuzzyResults := fuzzy.Find(query, names)
 
-	// Convert fuzzy results to Match structs
-	matches := make([]Match, len(fuzzyResults))
+	matches := make([]NameMatch, len(fuzzyResults))
 	for i, result := range fuzzyResults {
-		matches[i] = Match{
-			Namespace:    namespaces[result.Index],
+		matches[i] = NameMatch{
+			Index:        result.Index,
 			MatchIndices: result.MatchedIndexes,
 		}
 	}
 
 	return matches
 }
+
+// FuzzyMatchTerms performs fuzzy search on composite names such as "context/namespace".
+// The query is split into whitespace-separated terms that must all match, in any order
+// and possibly in different parts of the name, so "pay prod" finds "prod/payments".
+// Matches are ordered by their total score; an empty query returns all names in order.
+func FuzzyMatchTerms(query string, names []string) []NameMatch {
+	terms := strings.Fields(query)
+	if len(terms) == 0 {
+		return FuzzyMatchNames("", names)
+	}
+
+	scores := make(map[int]int, len(names))
+	indices := make(map[int][]int, len(names))
+	for i, term := range terms {
+		matched := make(map[int]bool)
+		for _, result := range fuzzy.Find(term, names) {
+			if _, ok := scores[result.Index]; i > 0 && !ok {
+				continue // Already missed by an earlier term
+			}
+			matched[result.Index] = true
+			scores[result.Index] += result.Score
+			indices[result.Index] = append(indices[result.Index], result.MatchedIndexes...)
+		}
+		// Names missed by this term are out
+		for index := range scores {
+			if !matched[index] {
+				delete(scores, index)
+			}
+		}
+	}
+
+	matches := make([]NameMatch, 0, len(scores))
+	for index := range scores {
+		positions := indices[index]
+		sort.Ints(positions)
+		matches = append(matches, NameMatch{Index: index, MatchIndices: slices.Compact(positions)})
+	}
+	sort.Slice(matches, func(i, j int) bool {
+		a, b := matches[i], matches[j]
+		if scores[a.Index] != scores[b.Index] {
+			return scores[a.Index] > scores[b.Index]
+		}
+		return a.Index < b.Index
+	})
+	return matches
+}
@@ -144,3 +144,81 @@ func TestFuzzyMatch_MatchIndices(t *testing.T) {
 	// The fuzzy library should have found 'k' and 's' in "kube-system"
 	assert.Contains(t, matches[0].MatchIndices, 0, "should match 'k' at position 0")
 }
+
+func TestParseQuery(t *testing.T) {
+	labels, text := ParseQuery("team=payments pay")
+	assert.Equal(t, map[string]string{"team": "payments"}, labels)
+	assert.Equal(t, "pay", text)
+
+	labels, text = ParseQuery("kube sys")
+	assert.Nil(t, labels)
+	assert.Equal(t, "kube sys", text, "queries without labels are kept as they are")
+
+	labels, text = ParseQuery("=x")
+	assert.Nil(t, labels)
+	assert.Equal(t, "=x", text)
+}
+
+func TestFuzzyMatch_LabelTerms(t *testing.T) {
+	namespaces := []k8s.Namespace{
+		{Name: "payments-prod", Labels: map[string]string{"team": "payments", "env": "prod"}},
+		{Name: "payments-dev", Labels: map[string]string{"team": "payments", "env": "dev"}},
+		{Name: "search-prod", Labels: map[string]string{"team": "search", "env": "prod"}},
+		{Name: "default"},
+	}
+
+	names := func(matches []Match) []string {
+		var result []string
+		for _, match := range matches {
+			result = append(result, match.Namespace.Name)
+		}
+		return result
+	}
+
+	assert.Equal(t, []string{"payments-prod", "payments-dev"}, names(FuzzyMatch("team=payments", namespaces)))
+	assert.Equal(t, []string{"payments-prod"}, names(FuzzyMatch("team=payments env=prod", namespaces)))
+	assert.Empty(t, FuzzyMatch("team=pay", namespaces), "label values match exactly")
+
+	matches := FuzzyMatch("env=prod search", namespaces)
+	assert.Equal(t, []string{"search-prod"}, names(matches))
+	assert.Equal(t, []int{0, 1, 2, 3, 4, 5}, matches[0].MatchIndices, "the name part is highlighted")
+}
+
+func TestFuzzyMatchNames(t *testing.T) {
+	names := []string{"api-server-7d9f", "worker-5c8b", "api-gateway-1a2b"}
+
+	matches := FuzzyMatchNames("api", names)
+	assert.Equal(t, 2, len(matches))
+	for _, match := range matches {
+		assert.Contains(t, names[match.Index], "api")
+		assert.Equal(t, []int{0, 1, 2}, match.MatchIndices)
+	}
+
+	all := FuzzyMatchNames("", names)
+	assert.Equal(t, 3, len(all))
+	assert.Equal(t, 1, all[1].Index, "empty query keeps original order")
+
+	assert.Empty(t, FuzzyMatchNames("xyz", names))
+}
+
+func TestFuzzyMatchTerms(t *testing.T) {
+	names := []string{"dev/payments", "prod/payments", "prod/search", "prod"}
+
+	matched := func(query string) []string {
+		var result []string
+		for _, match := range FuzzyMatchTerms(query, names) {
+			result = append(result, names[match.Index])
+		}
+		return result
+	}
+
+	assert.Equal(t, []string{"prod/payments"}, matched("prod pay"))
+	assert.Equal(t, []string{"prod/payments"}, matched("pay prod"), "terms match in any order")
+	assert.Equal(t, []string{"prod/payments"}, matched("prodpay"), "a single term can span parts")
+	assert.Equal(t, []string{"prod", "prod/search", "prod/payments"}, matched("prod"), "closest names first")
+	assert.Empty(t, matched("prod xyz"), "every term must match")
+	assert.Len(t, matched("  "), len(names))
+
+	matches := FuzzyMatchTerms("prod pay", names)
+	assert.Equal(t, []int{0, 1, 2, 3, 5, 6, 7}, matches[0].MatchIndices, "positions of all terms, merged")
+}
@@ -0,0 +1,130 @@
+package state
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/maratkarimov/kubertino/internal/safefile"
+)
+
+// State is the runtime state restored between sessions
+type State struct {
+	LastContext string                  `json:"last_context,omitempty"`
+	LastVersion string                  `json:"last_version,omitempty"` // Version of the previous run, for the what's new screen
+	Contexts    map[string]ContextState `json:"contexts,omitempty"`
+	Layout      *LayoutState            `json:"layout,omitempty"`      // Panel sizes chosen in the TUI
+	PinnedPods  map[string][]string     `json:"pinned_pods,omitempty"` // Pin patterns of pods listed first, by "context/namespace"
+}
+
+// LayoutState records the layout preset and panel split chosen with the resize keys
+type LayoutState struct {
+	Preset         string `json:"preset,omitempty"`
+	NamespaceWidth int    `json:"namespace_width,omitempty"` // Percent of the terminal width
+	PodsHeight     int    `json:"pods_height,omitempty"`     // Percent of the right-side height
+}
+
+// ContextState records where the user left off within a context
+type ContextState struct {
+	Namespace       string `json:"namespace,omitempty"`
+	Pod             string `json:"pod,omitempty"`
+	NamespaceScroll int    `json:"namespace_scroll,omitempty"`
+	PodScroll       int    `json:"pod_scroll,omitempty"`
+}
+
+// New returns an empty state
+func New() *State {
+	return &State{Contexts: make(map[string]ContextState)}
+}
+
+// Context returns the recorded state for a context (zero value when unknown)
+func (s *State) Context(name string) ContextState {
+	return s.Contexts[name]
+}
+
+// SetContext records the state for a context
+func (s *State) SetContext(name string, cs ContextState) {
+	if s.Contexts == nil {
+		s.Contexts = make(map[string]ContextState)
+	}
+	s.Contexts[name] = cs
+}
+
+// Pins returns the pin patterns recorded for namespace in context
+func (s *State) Pins(context, namespace string) []string {
+	return s.PinnedPods[context+"/"+namespace]
+}
+
+// SetPins records the pin patterns of namespace in context, forgetting the namespace when
+// pins is empty
+func (s *State) SetPins(context, namespace string, pins []string) {
+	key := context + "/" + namespace
+	if len(pins) == 0 {
+		delete(s.PinnedPods, key)
+		return
+	}
+	if s.PinnedPods == nil {
+		s.PinnedPods = make(map[string][]string)
+	}
+	s.PinnedPods[key] = pins
+}
+
+// DefaultPath returns $XDG_STATE_HOME/kubertino/state.json,
+// falling back to ~/.local/state/kubertino/state.json
+func DefaultPath() (string, error) {
+	if dir := os.Getenv("XDG_STATE_HOME"); dir != "" {
+		return filepath.Join(dir, "kubertino", "state.json"), nil
+	}
+
+	homeDir, err := os.UserHomeDir()
+	if err != nil {
+		return "", fmt.Errorf("failed to get home directory: %w", err)
+	}
+	return filepath.Join(homeDir, ".local", "state", "kubertino", "state.json"), nil
+}
+
+// Load reads the state file. A missing file yields an empty state.
+func Load(path string) (*State, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return New(), nil
+		}
+		return nil, fmt.Errorf("failed to read state file %s: %w", path, err)
+	}
+
+	s := New()
+	if err := json.Unmarshal(data, s); err != nil {
+		return nil, fmt.Errorf("failed to parse state file %s: %w", path, err)
+	}
+	if s.Contexts == nil {
+		s.Contexts = make(map[string]ContextState)
+	}
+	return s, nil
+}
+
+// Save writes the state file, creating its directory if needed.
+// The file is replaced atomically so a crash never leaves a truncated state.
+// Unlike the config, the state is not backed up: it is cheap to lose.
+func Save(s *State, path string) error {
+	if s == nil {
+		return fmt.Errorf("state is nil")
+	}
+
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		return fmt.Errorf("failed to create state directory: %w", err)
+	}
+
+	data, err := json.MarshalIndent(s, "", "  ")
+	if err != nil {
+		return fmt.Errorf("failed to serialize state: %w", err)
+	}
+
+	if err := safefile.WriteFile(path, data, 0644); err != nil {
+		return fmt.Errorf("failed to write state file: %w", err)
+	}
+
+	return nil
+}
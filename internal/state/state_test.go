@@ -0,0 +1,64 @@
+package state
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLoadSave(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "nested", "state.json")
+
+	t.Run("missing file yields empty state", func(t *testing.T) {
+		s, err := Load(path)
+		require.NoError(t, err)
+		assert.Empty(t, s.LastContext)
+		assert.Equal(t, ContextState{}, s.Context("prod"))
+	})
+
+	t.Run("round trip", func(t *testing.T) {
+		s := New()
+		s.LastContext = "prod"
+		s.SetContext("prod", ContextState{Namespace: "app", Pod: "web-1", NamespaceScroll: 3, PodScroll: 2})
+		s.SetPins("prod", "app", []string{"web-*-*", "db-0"})
+
+		require.NoError(t, Save(s, path))
+		assert.NoFileExists(t, path+".tmp")
+
+		loaded, err := Load(path)
+		require.NoError(t, err)
+		assert.Equal(t, s, loaded)
+	})
+
+	t.Run("corrupt file", func(t *testing.T) {
+		require.NoError(t, os.WriteFile(path, []byte("{not json"), 0644))
+		_, err := Load(path)
+		assert.ErrorContains(t, err, "failed to parse state file")
+	})
+}
+
+func TestPins(t *testing.T) {
+	s := New()
+	s.SetPins("prod", "app", []string{"web-*-*"})
+	assert.Equal(t, []string{"web-*-*"}, s.Pins("prod", "app"))
+	assert.Empty(t, s.Pins("dev", "app"))
+
+	s.SetPins("prod", "app", nil)
+	assert.Empty(t, s.PinnedPods, "namespaces without pins are forgotten")
+}
+
+func TestDefaultPath(t *testing.T) {
+	t.Setenv("XDG_STATE_HOME", "/tmp/xdg-state")
+	path, err := DefaultPath()
+	require.NoError(t, err)
+	assert.Equal(t, "/tmp/xdg-state/kubertino/state.json", path)
+
+	t.Setenv("XDG_STATE_HOME", "")
+	t.Setenv("HOME", "/home/tester")
+	path, err = DefaultPath()
+	require.NoError(t, err)
+	assert.Equal(t, "/home/tester/.local/state/kubertino/state.json", path)
+}
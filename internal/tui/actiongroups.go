@@ -0,0 +1,149 @@
+package tui
+
+import (
+	"log/slog"
+	"slices"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/maratkarimov/kubertino/internal/config"
+	"github.com/maratkarimov/kubertino/internal/tui/styles"
+)
+
+// actionEntry is a line of the actions panel: an action, or the header of an action group
+type actionEntry struct {
+	action config.Action
+	group  *config.ActionGroup // Set on the header of a group
+	nested bool                // An action listed under the header of its group
+}
+
+// label returns the text of the entry, without styles
+func (e actionEntry) label() string {
+	switch {
+	case e.group != nil:
+		return "[" + e.group.Shortcut + "] " + e.group.Name + " ▸"
+	case e.nested:
+		return "  [" + e.action.Shortcut + "] " + e.action.Name
+	}
+	return "[" + e.action.Shortcut + "] " + e.action.Name
+}
+
+// actionGroupOf returns the group of action, if it is in a configured group
+func (m AppModel) actionGroupOf(action config.Action) (config.ActionGroup, bool) {
+	if action.Group == "" || m.config == nil {
+		return config.ActionGroup{}, false
+	}
+	return config.FindActionGroup(m.config.ActionGroups, action.Group)
+}
+
+// actionKeys returns the keys that run action: its shortcut, preceded by the shortcut of its
+// group
+func (m AppModel) actionKeys(action config.Action) string {
+	if group, ok := m.actionGroupOf(action); ok {
+		return group.Shortcut + " " + action.Shortcut
+	}
+	return action.Shortcut
+}
+
+// actionEntries returns the lines of the actions panel: the visible actions without a group,
+// then each group with visible actions as a header followed by its actions
+func (m AppModel) actionEntries() []actionEntry {
+	actions := m.visibleActions()
+	var entries []actionEntry
+	for _, action := range actions {
+		if _, ok := m.actionGroupOf(action); !ok {
+			entries = append(entries, actionEntry{action: action})
+		}
+	}
+	if m.config == nil {
+		return entries
+	}
+	for i := range m.config.ActionGroups {
+		group := &m.config.ActionGroups[i]
+		header := len(entries)
+		for _, action := range actions {
+			if action.Group == group.Name {
+				entries = append(entries, actionEntry{action: action, nested: true})
+			}
+		}
+		if len(entries) > header {
+			entries = slices.Insert(entries, header, actionEntry{group: group})
+		}
+	}
+	return entries
+}
+
+// actionEntriesWidth returns the width of the widest entry
+func actionEntriesWidth(entries []actionEntry) int {
+	width := 0
+	for _, entry := range entries {
+		width = max(width, lipgloss.Width(entry.label()))
+	}
+	return width
+}
+
+// handleActionShortcut runs the action whose shortcut msg is, or opens the group whose
+// shortcut it is. Group shortcuts come first, so configured groups are not hidden by plugin
+// actions. ok is false for other keys.
+func (m AppModel) handleActionShortcut(msg tea.KeyMsg) (model tea.Model, cmd tea.Cmd, ok bool) {
+	key := msg.String()
+	if m.config != nil {
+		for _, group := range m.config.ActionGroups {
+			if group.Shortcut == key && slices.ContainsFunc(m.actions, func(a config.Action) bool { return a.Group == group.Name }) {
+				slog.Debug("action group opened", "group", group.Name)
+				m.openActionGroup = group.Name
+				return m, nil, true
+			}
+		}
+	}
+	for _, action := range m.actions {
+		if _, grouped := m.actionGroupOf(action); !grouped && key == action.Shortcut {
+			model, cmd = m.handleActionExecution(action)
+			return model, cmd, true
+		}
+	}
+	return m, nil, false
+}
+
+// handleActionGroupKey runs the action of the open group whose shortcut msg is. Any other key
+// closes the group.
+func (m AppModel) handleActionGroupKey(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	group := m.openActionGroup
+	m.openActionGroup = ""
+	for _, action := range m.actions {
+		if action.Group == group && action.Shortcut == msg.String() {
+			return m.handleActionExecution(action)
+		}
+	}
+	return m, nil
+}
+
+// renderActionEntry renders an entry of the actions panel
+func (m AppModel) renderActionEntry(entry actionEntry) string {
+	if entry.group != nil {
+		label := styles.PanelTitleStyle.Render(entry.group.Name + " ▸")
+		if entry.group.Name == m.openActionGroup {
+			return styles.SelectedActionStyle.Render(entry.label())
+		}
+		return styles.ShortcutStyle.Render("["+entry.group.Shortcut+"]") + " " + label
+	}
+
+	indent := ""
+	if entry.nested {
+		indent = "  "
+	}
+	shortcut := styles.ShortcutStyle.Render("[" + entry.action.Shortcut + "]")
+	name := styles.ActionStyle.Render(entry.action.Name)
+	switch {
+	case !m.actionApplies(entry.action) || m.readOnlyBlocks(entry.action):
+		// applies_to does not match the selection or the context is read-only: greyed out,
+		// blocked when run
+		shortcut = styles.DimStyle.Render("[" + entry.action.Shortcut + "]")
+		name = styles.DimStyle.Render(entry.action.Name)
+	case m.openActionGroup != "" && entry.action.Group != m.openActionGroup:
+		// Only the actions of the open group can be run with the next key
+		shortcut = styles.DimStyle.Render("[" + entry.action.Shortcut + "]")
+		name = styles.DimStyle.Render(entry.action.Name)
+	}
+	return indent + shortcut + " " + name
+}
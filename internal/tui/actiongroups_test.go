@@ -0,0 +1,87 @@
+package tui
+
+import (
+	"testing"
+
+	"github.com/maratkarimov/kubertino/internal/config"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// newActionGroupsTestModel returns a namespace view without pods, so pod actions report that
+// no pod is selected, with an ungrouped action and two groups using the same shortcuts
+func newActionGroupsTestModel() AppModel {
+	model := newTestModel(newMockAdapter(), withContexts(config.Context{Name: "dev"}), withActions(
+		config.Action{Name: "Get All", Shortcut: "l", Command: "echo {{.namespace}}", Scope: config.ActionScopeNamespace},
+		config.Action{Name: "Psql", Shortcut: "p", Group: "db", Command: "psql"},
+		config.Action{Name: "DB Size", Shortcut: "l", Group: "db", Command: "echo {{.namespace}}", Scope: config.ActionScopeNamespace},
+		config.Action{Name: "Previous Logs", Shortcut: "l", Group: "debug", Command: "kubectl logs -p {{.pod}}"},
+	), func(cfg *config.Config) {
+		cfg.ActionGroups = []config.ActionGroup{{Name: "db", Shortcut: "D"}, {Name: "debug", Shortcut: "B"}, {Name: "empty", Shortcut: "E"}}
+	})
+	model.currentNamespace = "app"
+	model.pods = nil
+	return model
+}
+
+func TestActionGroups_LeaderKey(t *testing.T) {
+	model := newActionGroupsTestModel()
+
+	// D opens the db group; l then runs its action, not the ungrouped one
+	updated, cmd := model.Update(runeKey('D'))
+	model = updated.(AppModel)
+	assert.Nil(t, cmd)
+	assert.Equal(t, "db", model.openActionGroup)
+	assert.Contains(t, model.renderActionsPanel(80, 20), "[key]: Execute db action")
+
+	updated, cmd = model.Update(runeKey('p'))
+	model = updated.(AppModel)
+	assert.Empty(t, model.openActionGroup)
+	assert.Nil(t, cmd)
+	require.True(t, model.errorModal.IsVisible, "Psql needs a pod")
+	model.errorModal.Hide()
+
+	model = sendKey(model, runeKey('B'))
+	model = sendKey(model, runeKey('l'))
+	require.True(t, model.errorModal.IsVisible, "the debug group's l runs Previous Logs")
+	model.errorModal.Hide()
+
+	// Without a group, l runs the namespace action
+	_, cmd = model.Update(runeKey('l'))
+	assert.NotNil(t, cmd)
+}
+
+func TestActionGroups_OtherKeyCloses(t *testing.T) {
+	model := newActionGroupsTestModel()
+
+	model = sendKey(model, runeKey('D'))
+	updated, cmd := model.Update(runeKey('q'))
+	model = updated.(AppModel)
+	assert.Nil(t, cmd, "q closes the group instead of quitting")
+	assert.Empty(t, model.openActionGroup)
+
+	// A group without actions in the context is not opened
+	model = sendKey(model, runeKey('E'))
+	assert.Empty(t, model.openActionGroup)
+}
+
+func TestRenderActionsPanel_Groups(t *testing.T) {
+	model := newActionGroupsTestModel()
+
+	var labels []string
+	for _, entry := range model.actionEntries() {
+		labels = append(labels, entry.label())
+	}
+	assert.Equal(t, []string{"[l] Get All", "[D] db ▸", "  [p] Psql", "  [l] DB Size", "[B] debug ▸", "  [l] Previous Logs"}, labels)
+
+	output := model.renderActionsPanel(80, 20)
+	assert.Contains(t, output, "db ▸")
+	assert.NotContains(t, output, "empty", "groups without actions have no header")
+}
+
+func TestActionKeys(t *testing.T) {
+	model := newActionGroupsTestModel()
+	assert.Equal(t, "l", model.actionKeys(model.actions[0]))
+	assert.Equal(t, "D p", model.actionKeys(model.actions[1]))
+	assert.Contains(t, model.renderHelp(), "D p")
+}
@@ -0,0 +1,145 @@
+package tui
+
+import (
+	"fmt"
+	"log/slog"
+	"strings"
+	"unicode"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/maratkarimov/kubertino/internal/search"
+	"github.com/maratkarimov/kubertino/internal/tui/styles"
+)
+
+// actionPickerMatches returns the indices in m.actions of the actions whose names match the
+// picker query, best match first. Actions hidden by the tag filter are included, as their
+// shortcuts keep working too.
+func (m AppModel) actionPickerMatches() []int {
+	names := make([]string, len(m.actions))
+	for i, action := range m.actions {
+		names[i] = action.Name
+	}
+
+	matches := search.FuzzyMatchNames(m.actionPickerQuery, names)
+	result := make([]int, len(matches))
+	for i, match := range matches {
+		result[i] = match.Index
+	}
+	return result
+}
+
+// openActionPicker shows the action picker over the namespace view
+func (m *AppModel) openActionPicker() {
+	slog.Debug("action picker opened")
+	m.viewMode = viewModeActionPicker
+	m.actionPickerQuery = ""
+	m.actionPickerIndex = 0
+}
+
+// closeActionPicker returns to the namespace view
+func (m *AppModel) closeActionPicker() {
+	m.viewMode = viewModeNamespaceView
+	m.actionPickerQuery = ""
+}
+
+// handleActionPickerKey handles key presses while the action picker is open
+func (m AppModel) handleActionPickerKey(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	matches := m.actionPickerMatches()
+
+	switch msg.Type {
+	case tea.KeyCtrlC:
+		return m, tea.Quit
+	case tea.KeyEsc:
+		m.closeActionPicker()
+	case tea.KeyUp:
+		if m.actionPickerIndex > 0 {
+			m.actionPickerIndex--
+		}
+	case tea.KeyDown:
+		if m.actionPickerIndex < len(matches)-1 {
+			m.actionPickerIndex++
+		}
+	case tea.KeyEnter:
+		if m.actionPickerIndex < len(matches) {
+			action := m.actions[matches[m.actionPickerIndex]]
+			slog.Info("action picked", "action", action.Name)
+			m.closeActionPicker()
+			return m.handleActionExecution(action)
+		}
+	case tea.KeyBackspace:
+		if len(m.actionPickerQuery) > 0 {
+			m.actionPickerQuery = m.actionPickerQuery[:len(m.actionPickerQuery)-1]
+			m.actionPickerIndex = 0
+		}
+	case tea.KeyRunes, tea.KeySpace:
+		for _, r := range msg.Runes {
+			if unicode.IsPrint(r) {
+				m.actionPickerQuery += string(r)
+			}
+		}
+		m.actionPickerIndex = 0
+	}
+	return m, nil
+}
+
+// renderActionPicker renders the action picker dialog
+func (m AppModel) renderActionPicker() string {
+	var content string
+	content += styles.TitleStyle.Render("Run Action") + "\n"
+	if target, ok := m.actionTarget(); ok {
+		content += styles.DimStyle.Render("Target: "+target) + "\n"
+	}
+	content += "\n" + styles.SearchLabelStyle.Render("> ") + m.actionPickerQuery + "_\n\n"
+
+	matches := m.actionPickerMatches()
+	if len(m.actions) == 0 {
+		content += styles.PlaceholderStyle.Render("No actions configured") + "\n"
+	} else if len(matches) == 0 {
+		content += styles.PlaceholderStyle.Render("No matches") + "\n"
+	}
+
+	// Keep the highlighted entry inside the visible window
+	start := 0
+	if m.actionPickerIndex >= paletteMaxRows {
+		start = m.actionPickerIndex - paletteMaxRows + 1
+	}
+	end := min(start+paletteMaxRows, len(matches))
+
+	for i := start; i < end; i++ {
+		action := m.actions[matches[i]]
+		details := "[" + m.actionKeys(action) + "]"
+		if len(action.Tags) > 0 {
+			details += " " + strings.Join(action.Tags, ", ")
+		}
+		line := fmt.Sprintf("%-32s %s", action.Name, styles.DimStyle.Render(details))
+		switch {
+		case i == m.actionPickerIndex:
+			content += m.selectionStyle(styles.SelectedStyle).Render(m.cursorMarker(true)+line) + "\n"
+		case !m.actionApplies(action) || m.readOnlyBlocks(action):
+			// Same as the actions panel: applies_to does not match the selection or the
+			// context is read-only
+			content += styles.DimStyle.Render(m.cursorMarker(false)+line) + "\n"
+		default:
+			content += styles.NormalStyle.Render(m.cursorMarker(false)+line) + "\n"
+		}
+	}
+	if remaining := len(matches) - end; remaining > 0 {
+		content += styles.HelpTextStyle.Render(fmt.Sprintf("↓ %d more", remaining)) + "\n"
+	}
+
+	content += "\n" + styles.DimStyle.Render("Type to search | ↑/↓: Navigate | Enter: Run | ESC: Close")
+
+	dialogStyle := lipgloss.NewStyle().
+		Border(lipgloss.RoundedBorder()).
+		BorderForeground(lipgloss.Color("39")). // Bright cyan
+		Padding(1, 2)
+
+	return lipgloss.Place(
+		m.termWidth,
+		m.termHeight,
+		lipgloss.Center,
+		lipgloss.Center,
+		dialogStyle.Render(content),
+	)
+}
@@ -0,0 +1,84 @@
+package tui
+
+import (
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/maratkarimov/kubertino/internal/config"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// newActionPickerTestModel returns the applies_to test model with a few more actions
+func newActionPickerTestModel() AppModel {
+	model := newAppliesToTestModel()
+	model.actions = append(model.actions,
+		config.Action{Name: "Restart deployment", Shortcut: "ctrl+r", Command: "echo restart", Tags: []string{"deploy"}},
+		config.Action{Name: "Port forward postgres", Shortcut: "P", Command: "echo forward"},
+	)
+	return model
+}
+
+func TestActionPicker_OpenAndClose(t *testing.T) {
+	model := newActionPickerTestModel()
+
+	model = sendKey(model, runeKey('a'))
+	require.Equal(t, viewModeActionPicker, model.viewMode)
+	view := model.View()
+	assert.Contains(t, view, "Run Action")
+	assert.Contains(t, view, "Target: web-1")
+	assert.Contains(t, view, "Rails Console")
+	assert.Contains(t, view, "[ctrl+r] deploy")
+
+	// Typed keys go to the query, not to action shortcuts or bindings
+	model = typePalette(model, "lq")
+	assert.Equal(t, viewModeActionPicker, model.viewMode)
+	assert.Equal(t, "lq", model.actionPickerQuery)
+
+	model = sendKey(model, tea.KeyMsg{Type: tea.KeyEsc})
+	assert.Equal(t, viewModeNamespaceView, model.viewMode)
+	assert.Empty(t, model.actionPickerQuery)
+}
+
+func TestActionPicker_FuzzySearch(t *testing.T) {
+	model := sendKey(newActionPickerTestModel(), runeKey('a'))
+	assert.Len(t, model.actionPickerMatches(), 4, "empty query lists every action")
+
+	model = typePalette(model, "pfpg")
+	matches := model.actionPickerMatches()
+	require.NotEmpty(t, matches)
+	assert.Equal(t, "Port forward postgres", model.actions[matches[0]].Name)
+	assert.NotContains(t, model.View(), "Rails Console")
+}
+
+func TestActionPicker_EnterRunsAction(t *testing.T) {
+	model := sendKey(newActionPickerTestModel(), runeKey('a'))
+	model = typePalette(model, "rails")
+
+	updated, cmd := model.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	model = updated.(AppModel)
+	assert.Equal(t, viewModeNamespaceView, model.viewMode)
+	assert.NotNil(t, cmd, "the picked action is executed")
+	assert.False(t, model.errorModal.IsVisible)
+}
+
+func TestActionPicker_RespectsAppliesTo(t *testing.T) {
+	model := newActionPickerTestModel()
+	model.selectedPodIndex = 1 // worker-1
+	model = sendKey(model, runeKey('a'))
+	model = typePalette(model, "rails")
+
+	updated, cmd := model.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	model = updated.(AppModel)
+	assert.Nil(t, cmd)
+	require.True(t, model.errorModal.IsVisible)
+	assert.Contains(t, model.errorModal.View(), "does not apply to worker-1")
+}
+
+func TestActionPicker_ActionShortcutTakesPrecedence(t *testing.T) {
+	model := newActionPickerTestModel()
+	model.actions = append(model.actions, config.Action{Name: "Attach", Shortcut: "a", Command: "echo attach"})
+
+	updated, _ := model.Update(runeKey('a'))
+	assert.NotEqual(t, viewModeActionPicker, updated.(AppModel).viewMode)
+}
@@ -0,0 +1,38 @@
+package tui
+
+import (
+	"fmt"
+	"strings"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/maratkarimov/kubertino/internal/config"
+	"github.com/maratkarimov/kubertino/internal/k8s"
+)
+
+// handleScopedAction runs an action scoped to the namespace or context: it needs no pod, so
+// it also runs while the pod list is empty or resources of another kind are browsed
+func (m AppModel) handleScopedAction(action config.Action) (tea.Model, tea.Cmd) {
+	// Actions with params ask for their values first and come back here with them
+	if len(action.Params) > 0 && m.paramValues == nil {
+		return m.openParamForm(action)
+	}
+
+	command, err := m.executor.Command(action, *m.currentContext, m.currentNamespace, k8s.Resource{}, k8s.Pod{}, m.config.KubeconfigPath(m.currentContext.Name), m.paramValues)
+	if err != nil {
+		m.errorModal.Show(fmt.Sprintf("Action failed: %s", err.Error()), "Execute Action", nil)
+		return m, nil
+	}
+	return m.execAction(action, k8s.Resource{}, command)
+}
+
+// targetPath joins the context, namespace and target an action ran against with "/",
+// leaving out those a namespace or context scoped action did not have
+func targetPath(parts ...string) string {
+	var path []string
+	for _, part := range parts {
+		if part != "" {
+			path = append(path, part)
+		}
+	}
+	return strings.Join(path, "/")
+}
@@ -0,0 +1,85 @@
+package tui
+
+import (
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/maratkarimov/kubertino/internal/config"
+	"github.com/maratkarimov/kubertino/internal/k8s"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// newScopeTestModel returns a namespace view of an empty namespace with a pod, a namespace
+// and a context scoped action
+func newScopeTestModel() AppModel {
+	model := newTestModel(newMockAdapter(), withContexts(config.Context{Name: "dev"}), withActions(
+		config.Action{Name: "Logs", Shortcut: "l", Command: "echo {{.pod}}"},
+		config.Action{Name: "Get All", Shortcut: "g", Command: "echo {{.namespace}}", Scope: config.ActionScopeNamespace},
+		config.Action{Name: "Nodes", Shortcut: "n", Command: "echo {{.context}}", Scope: config.ActionScopeContext},
+	))
+	model.currentNamespace = "app"
+	model.pods = nil
+	model.focusedPanel = PanelPods
+	return model
+}
+
+func TestActionScope_NamespaceRunsWithoutPods(t *testing.T) {
+	model := newScopeTestModel()
+
+	updated, cmd := model.Update(runeKey('g'))
+	model = updated.(AppModel)
+	assert.NotNil(t, cmd)
+	assert.False(t, model.errorModal.IsVisible)
+
+	// Pod scoped actions still need a pod
+	updated, cmd = model.Update(runeKey('l'))
+	model = updated.(AppModel)
+	assert.Nil(t, cmd)
+	require.True(t, model.errorModal.IsVisible)
+	assert.Contains(t, model.errorModal.View(), "No pods available")
+}
+
+func TestActionScope_NamespaceRunsWhileBrowsingResources(t *testing.T) {
+	model := newScopeTestModel()
+	model.resourceKind = k8s.KindDeployment
+
+	updated, cmd := model.Update(runeKey('g'))
+	assert.NotNil(t, cmd)
+	assert.False(t, updated.(AppModel).errorModal.IsVisible)
+}
+
+func TestActionScope_ContextRunsWithoutNamespace(t *testing.T) {
+	model := newScopeTestModel()
+	model.currentNamespace = ""
+
+	updated, cmd := model.Update(runeKey('g'))
+	model = updated.(AppModel)
+	assert.Nil(t, cmd)
+	require.True(t, model.errorModal.IsVisible)
+	assert.Contains(t, model.errorModal.View(), "No namespace selected")
+	model.errorModal.Hide()
+
+	updated, cmd = model.Update(runeKey('n'))
+	assert.NotNil(t, cmd)
+	assert.False(t, updated.(AppModel).errorModal.IsVisible)
+}
+
+func TestActionScope_DestructiveContextActionAsksForContextName(t *testing.T) {
+	model := newScopeTestModel()
+	model.actions[2].Destructive = true
+
+	model = sendKey(model, runeKey('n'))
+	require.True(t, model.confirm.IsVisible)
+	assert.Contains(t, model.View(), "This runs against context dev.")
+
+	model = typeText(model, "dev")
+	_, cmd := model.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	assert.NotNil(t, cmd)
+}
+
+func TestTargetPath(t *testing.T) {
+	assert.Equal(t, "dev/app/web-1", targetPath("dev", "app", "web-1"))
+	assert.Equal(t, "dev/app", targetPath("dev", "app", ""))
+	assert.Equal(t, "dev", targetPath("dev", "", ""))
+}
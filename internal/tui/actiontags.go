@@ -0,0 +1,58 @@
+package tui
+
+import (
+	"slices"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/maratkarimov/kubertino/internal/config"
+)
+
+// actionTags returns the distinct tags of the current context's actions, sorted
+func (m AppModel) actionTags() []string {
+	var tags []string
+	for _, action := range m.actions {
+		for _, tag := range action.Tags {
+			if !slices.Contains(tags, tag) {
+				tags = append(tags, tag)
+			}
+		}
+	}
+	slices.Sort(tags)
+	return tags
+}
+
+// visibleActions returns the actions shown in the actions panel. Shortcuts of actions
+// hidden by the tag filter keep working.
+func (m AppModel) visibleActions() []config.Action {
+	if m.actionTag == "" {
+		return m.actions
+	}
+
+	var actions []config.Action
+	for _, action := range m.actions {
+		if slices.Contains(action.Tags, m.actionTag) {
+			actions = append(actions, action)
+		}
+	}
+	return actions
+}
+
+// handleCycleActionTag narrows the actions panel to the next tag: all → first tag → ... → all
+func (m AppModel) handleCycleActionTag() (tea.Model, tea.Cmd) {
+	tags := m.actionTags()
+	if len(tags) == 0 {
+		m.actionTag = ""
+		return m, nil
+	}
+
+	next := slices.Index(tags, m.actionTag) + 1
+	switch {
+	case m.actionTag == "":
+		m.actionTag = tags[0]
+	case next > 0 && next < len(tags):
+		m.actionTag = tags[next]
+	default:
+		m.actionTag = ""
+	}
+	return m, nil
+}
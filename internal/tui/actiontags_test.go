@@ -0,0 +1,93 @@
+package tui
+
+import (
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/maratkarimov/kubertino/internal/config"
+	"github.com/stretchr/testify/assert"
+)
+
+func newActionTagsTestModel() AppModel {
+	return newTestModel(newMockAdapter(), withActions(
+		config.Action{Name: "Logs", Shortcut: "l", Command: "kubectl logs {{.pod}}", Tags: []string{"logs"}},
+		config.Action{Name: "Psql", Shortcut: "p", Command: "psql", Tags: []string{"db"}},
+		config.Action{Name: "Rollout", Shortcut: "d", Command: "kubectl rollout restart", Tags: []string{"deploy", "db"}},
+		config.Action{Name: "Shell", Shortcut: "s", Command: "sh"},
+	))
+}
+
+func actionNames(actions []config.Action) []string {
+	names := make([]string, len(actions))
+	for i, action := range actions {
+		names[i] = action.Name
+	}
+	return names
+}
+
+func TestActionTags(t *testing.T) {
+	model := newActionTagsTestModel()
+	assert.Equal(t, []string{"db", "deploy", "logs"}, model.actionTags())
+}
+
+func TestCycleActionTag(t *testing.T) {
+	model := newActionTagsTestModel()
+	ctrlA := tea.KeyMsg{Type: tea.KeyCtrlA}
+
+	assert.Len(t, model.visibleActions(), 4)
+
+	model = sendKey(model, ctrlA)
+	assert.Equal(t, "db", model.actionTag)
+	assert.Equal(t, []string{"Psql", "Rollout"}, actionNames(model.visibleActions()))
+
+	model = sendKey(model, ctrlA)
+	assert.Equal(t, "deploy", model.actionTag)
+	assert.Equal(t, []string{"Rollout"}, actionNames(model.visibleActions()))
+
+	model = sendKey(model, ctrlA)
+	model = sendKey(model, ctrlA)
+	assert.Empty(t, model.actionTag, "cycle wraps back to all actions")
+	assert.Len(t, model.visibleActions(), 4)
+}
+
+func TestRenderActionsPanel_Filtered(t *testing.T) {
+	model := newActionTagsTestModel()
+	model.actionTag = "logs"
+
+	output := model.renderActionsPanel(80, 20)
+	assert.Contains(t, output, "tag: logs")
+	assert.Contains(t, output, "Logs")
+	assert.NotContains(t, output, "Psql")
+	assert.Contains(t, output, "ctrl+a: Filter by tag")
+}
+
+func TestCycleActionTag_NoTags(t *testing.T) {
+	model := NewAppModel(&config.Config{
+		Contexts: []config.Context{{Name: "test-context"}},
+		Actions:  []config.Action{{Name: "Shell", Shortcut: "s", Command: "sh"}},
+	}, newMockAdapter())
+
+	model = sendKey(model, tea.KeyMsg{Type: tea.KeyCtrlA})
+	assert.Empty(t, model.actionTag)
+	assert.NotContains(t, model.renderActionsPanel(80, 20), "Filter by tag")
+}
+
+func TestActionTags_GlobalAndContextActions(t *testing.T) {
+	model := NewAppModel(&config.Config{
+		Actions: []config.Action{
+			{Name: "Logs", Shortcut: "l", Command: "kubectl logs {{.pod}}", Tags: []string{"logs"}},
+			{Name: "Shell", Shortcut: "s", Command: "sh"},
+		},
+		Contexts: []config.Context{{
+			Name: "prod",
+			Actions: []config.Action{
+				{Name: "Psql", Shortcut: "p", Command: "psql", Tags: []string{"db"}},
+				{Name: "Prod Shell", Shortcut: "s", Command: "bash"},
+			},
+		}},
+	}, newMockAdapter())
+
+	assert.Equal(t, []string{"Logs", "Prod Shell", "Psql"}, actionNames(model.actions),
+		"context actions extend the global ones and override them by shortcut")
+	assert.Equal(t, []string{"db", "logs"}, model.actionTags())
+}
@@ -1,16 +1,26 @@
 package tui
 
 import (
+	"context"
 	"fmt"
 	"log/slog"
+	"os/exec"
+	"slices"
 	"sort"
+	"time"
 
 	tea "github.com/charmbracelet/bubbletea"
 	"github.com/charmbracelet/lipgloss"
+	"github.com/maratkarimov/kubertino/internal/audit"
+	"github.com/maratkarimov/kubertino/internal/changelog"
 	"github.com/maratkarimov/kubertino/internal/config"
 	"github.com/maratkarimov/kubertino/internal/executor"
+	"github.com/maratkarimov/kubertino/internal/history"
 	"github.com/maratkarimov/kubertino/internal/k8s"
+	"github.com/maratkarimov/kubertino/internal/k8s/cache"
+	"github.com/maratkarimov/kubertino/internal/logging"
 	"github.com/maratkarimov/kubertino/internal/search"
+	"github.com/maratkarimov/kubertino/internal/state"
 	"github.com/maratkarimov/kubertino/internal/tui/components"
 	"github.com/maratkarimov/kubertino/internal/tui/styles"
 )
@@ -19,35 +29,48 @@ const (
 	// View mode constants
 	viewModeContextSelection = "context_selection"
 	viewModeNamespaceView    = "namespace_view"
+	viewModeSettings         = "settings"
+	viewModeGitOps           = "gitops"
+	viewModeNetwork          = "network"
+	viewModePalette          = "palette"
+	viewModeDescribe         = "describe"
+	viewModeYAML             = "yaml"
+	viewModeActionPicker     = "action_picker"
+	viewModeOutput           = "output"
+	viewModeHistory          = "history"
+	viewModeJobs             = "jobs"
+	viewModeConfigData       = "config_data"
+	viewModeHelp             = "help"
+	viewModeStderr           = "stderr"
 
 	// Terminal size constraints
 	MinTerminalWidth  = 80
 	MinTerminalHeight = 24
-	HeaderHeight      = 0 // No header displayed (Story 6.1)
+	HeaderHeight      = 1 // Lines taken by the header when show_header is set
 )
 
-// KubeAdapter is an interface for Kubernetes operations
+// KubeAdapter is an interface for Kubernetes operations. Further capabilities are optional
+// interfaces (e.g. CredentialChecker) checked with a type assertion on the adapter, so adapters
+// that implement only KubeAdapter, such as test mocks, keep working.
 type KubeAdapter interface {
-	GetNamespaces(context string) ([]string, error)
+	GetNamespaces(context string) ([]k8s.Namespace, error)
 	GetPods(context, namespace string) ([]k8s.Pod, error)
 	SwitchContext(context string) error
 }
 
 // namespaceFetchedMsg is sent when namespaces are fetched
-type namespaceFetchedMsg struct {
-	namespaces []string
-	err        error
-}
+type namespaceFetchedMsg = resultMsg[[]k8s.Namespace]
 
 // podsFetchedMsg is sent when pods are fetched
-type podsFetchedMsg struct {
-	pods []k8s.Pod
-	err  error
-}
+type podsFetchedMsg = resultMsg[[]k8s.Pod]
 
 // execFinishedMsg is sent when an external command execution finishes
 type execFinishedMsg struct {
-	err error
+	err     error
+	record  audit.Record      // Audit record of the action, completed with err
+	run     *executor.Run     // Duration and stderr of the command; nil when not tracked
+	rerun   *exec.Cmd         // The same command, to reconnect a dropped session; nil when not offered
+	capture *executor.Capture // Stdout of an action with capture_var; nil for other actions
 }
 
 // PanelType represents which panel has keyboard focus
@@ -57,6 +80,7 @@ const (
 	PanelNamespaces PanelType = iota
 	PanelPods
 	PanelActions // Story 4.1
+	PanelForwards
 )
 
 // AppModel is the main Bubble Tea model for the Kubertino TUI
@@ -70,31 +94,77 @@ type AppModel struct {
 	width                  int
 	height                 int
 	keys                   KeyMap
-	namespaces             []string
+	namespaces             []k8s.Namespace
 	selectedNamespaceIndex int
 	namespaceViewportStart int // Starting index for namespace viewport
 	namespacesLoading      bool
+	namespacesFallback     bool // Listing namespaces was forbidden; they came from the config
 	namespacesError        error
-	kubeAdapter            KubeAdapter
+	// Prefixes of the namespace groups shown expanded, with namespace_grouping
+	expandedNamespaceGroups map[string]bool
+	kubeAdapter             KubeAdapter
 	// Search mode fields
 	searchMode         bool
 	searchQuery        string
-	filteredNamespaces []string
+	filteredNamespaces []k8s.Namespace
 	// Pod state fields
-	pods             []k8s.Pod
-	podsLoading      bool
-	podsError        error
-	currentNamespace string
+	pods            []k8s.Pod
+	podsLoading     bool
+	podsError       error
+	podsContinue    string // Token of the next pod page; empty when every pod is loaded
+	podsLoadingMore bool
+	// Detail block of the selected pod, fetched once it is selected and kept per pod
+	podDetails       map[string]podDetailEntry // Keyed by context/namespace/pod
+	podDetailLoading string                    // Key of the pod whose detail is being fetched
+	// Label selector the pods are listed with, filtered by the API server
+	podSelector        string
+	podSelectorEditing bool
+	podSelectorDraft   string
+	podSelectorError   string // Why the draft was refused
+	currentNamespace   string
+	// Pod search mode fields
+	podSearchMode   bool
+	podSearchQuery  string
+	filteredPods    []k8s.Pod
+	podMatchIndices map[string][]int // Matched character positions per pod name
+	// Resource browser (deployments, statefulsets, jobs, configmaps, secrets)
+	resourceKind          k8s.ResourceKind // Kind shown in the right-top panel ("" or pod = pods)
+	resources             []k8s.Resource
+	resourcesLoading      bool
+	resourcesError        error
+	selectedResourceIndex int
+	// ConfigMap or secret shown in the pager; secret values stay masked until revealed
+	configData    k8s.ConfigData
+	revealSecrets bool
+	// GitOps detail panel
+	gitOpsPod     string
+	gitOpsInfo    *k8s.GitOpsInfo
+	gitOpsError   error
+	gitOpsLoading bool
+	gitOpsMessage string // Copy status shown in the panel
+	// Network quick reference (pod IP, DNS names, ports)
+	networkPod      k8s.Pod
+	networkServices []k8s.Service // Services selecting the pod
+	networkHostname string        // Stable DNS name from the pod's subdomain, if any
+	networkError    error
+	networkLoading  bool
+	networkIndex    int    // Highlighted row
+	networkMessage  string // Copy status shown in the panel
 	// Terminal size fields
 	termWidth        int
 	termHeight       int
 	terminalTooSmall bool
 	// Focus and navigation state (Story 3.3)
-	focusedPanel     PanelType // Which panel has keyboard focus
-	selectedPodIndex int       // Index of selected pod in pods slice (-1 if none, Story 6.2: cursor position = selection)
-	podScrollOffset  int       // Scroll offset for long pod lists
+	focusedPanel     PanelType       // Which panel has keyboard focus
+	selectedPodIndex int             // Index of selected pod in pods slice (-1 if none, Story 6.2: cursor position = selection)
+	podScrollOffset  int             // Scroll offset for long pod lists
+	podSort          PodSortMode     // Order of the pods panel
+	podFilter        PodStatusFilter // Status the pods panel is narrowed to
 	// Actions state (Story 4.1)
-	actions []config.Action // Actions for current context
+	actions   []config.Action // Actions for current context
+	actionTag string          // Tag the actions panel is narrowed to ("" shows all actions)
+
+	openActionGroup string // Action group whose shortcut was pressed; the next key runs one of its actions
 	// Executor and error state (Story 4.2)
 	executor     *executor.Executor
 	errorMessage string // Error message to display in TUI (deprecated in Story 6.3, use errorModal)
@@ -102,59 +172,203 @@ type AppModel struct {
 	favoriteNamespaces []string // Favorite namespaces for current context
 	// Error modal and spinners (Story 6.3)
 	errorModal        *components.ErrorModal
+	confirm           *components.ConfirmInput // Typed confirmation for destructive actions
+	paramForm         *components.Form         // Asks for the params of an action before it runs
+	namespaceForm     *components.Form         // Asks for the name of a namespace to create
+	paramAction       config.Action            // Action the params form is open for
+	paramValues       map[string]string        // Entered params while the action is rendered, nil otherwise
+	pager             *components.Pager        // Scrollable viewer for kubectl describe output
+	yamlPod           k8s.Pod                  // Pod whose manifest the YAML viewer shows
 	namespacesSpinner *components.Spinner
 	podsSpinner       *components.Spinner
 	actionSpinner     *components.Spinner
+	// Key binding settings screen
+	configPath         string // Config file that rebinding changes are persisted to
+	settingsReturnMode string // View mode to restore when leaving settings
+	// Command palette
+	paletteQuery      string
+	paletteIndex      int
+	paletteReturnMode string // View mode to restore when the palette closes
+	helpReturnMode    string // View mode to restore when the help overlay closes
+	jumpNamespace     string // Namespace picked in the palette, opened once its context's namespaces load
+	// Action picker
+	actionPickerQuery string
+	actionPickerIndex int
+	settingsIndex     int    // Cursor position in the settings list
+	settingsCapturing bool   // Waiting for the next key press to assign
+	settingsMessage   string // Conflict or save status shown in the settings screen
+	// Action history view
+	historyQuery   string
+	historyIndex   int
+	historyEntries []history.Entry // Snapshot taken when the view opens, newest first
+	// Port-forward manager
+	portForwards         *executor.PortForwardManager
+	selectedForwardIndex int
+	// Background jobs (background: true) and the cursor of the jobs view
+	jobs     *executor.JobManager
+	jobIndex int
+	// Credential expiry countdown and proactive refresh
+	credentials          map[string]*k8s.Credential // Known credential expiry per context
+	credentialRefreshing map[string]bool            // Contexts with a refresh in flight
+	credentialRetryAt    map[string]time.Time       // Earliest next refresh after a failure
+	credentialTicking    bool                       // Countdown ticker is running
+	authStatus           *k8s.AuthStatus            // Failed credential check of the current context, shown in the auth modal
+	throttled            map[string]bool            // Contexts whose kubectl calls are delayed by kubectl_qps
+	capture              *outputCapture             // Captured action shown in the output viewer
+	captureSeq           int                        // Identifies the latest capture
+	hookWarning          string                     // Last failed on_enter/on_exit hook, shown until the next context switch
+	panels               panelSplit                 // Layout preset and splits chosen with the resize keys
+	statusBar            *components.StatusBar      // Transient notifications under the panels
+	running              *runningAction             // Action command that has the terminal
+	refreshing           asyncKind                  // Panel refetched with the refresh key, announced once loaded
+	lastCommand          string                     // Rendered command of the last executed action, for the copy key
+	lastStderr           *actionStderr              // Stderr of the last action that failed in the terminal
+	autoFollowUp         bool                       // The running action was started by follow_up: run, so its own follow-up is offered
+	// Session state restored on startup and saved on transitions
+	state            *state.State
+	statePath        string
+	restorePod       string // Pod to reselect once pods of the restored namespace load
+	restorePodScroll int
+	whatsNew         []changelog.Release // Release notes shown once after an upgrade
+	// Crash reports and the debug overlay
+	crashDir string          // Where crash reports are written; "" disables them
+	debugLog *logging.Recent // Latest log lines shown in the debug overlay; nil hides it
+	// Why kubectl could not be found; the setup screen is shown until a retry finds it
+	kubectlMissing error
+	// How the screen is drawn on terminals with few glyphs or colors
+	renderMode RenderMode
+	// Records of executed actions; nil unless audit sinks are configured
+	auditLog *audit.Logger
+	// Local history of executed actions, browsable in the TUI; nil disables it
+	history *history.Store
+	// Restart storm analysis of the current context, keyed by namespace
+	restartStorms map[string]*k8s.RestartStorm
+	// Pods per namespace of the current context; nil until they are counted
+	podCounts map[string]int
+	// Resource usage of the pods shown in the cpu and memory columns
+	podMetrics podMetrics
+	// Background requests; results of superseded requests are dropped
+	requests *asyncTracker
+	// Recently fetched namespaces and pods, shown instantly on revisits
+	cache *cache.Cache
+	// Namespace prefetch outcome per context; nil unless prefetch_namespaces is enabled
+	prefetch map[string]prefetchResult
+	// Health of each context shown in the context list; nil while it is not probed
+	health map[string]*k8s.ContextHealth
+	// Re-reads the configuration for the reload key; nil when it cannot be reloaded
+	configReload func() (*config.Config, error)
+	// Deferred startup check (kubectl lookup, kubeconfig validation)
+	startupCheck    func() error
+	startupChecking bool
+	// Namespace view state of previously visited contexts, keyed by context name
+	contextCache map[string]contextSnapshot
+	// Workspace tabs; nil while a single tab is open. The entry of the active tab is only
+	// up to date while another tab is active: its state lives in the fields above.
+	tabs      []session
+	activeTab int
 }
 
 // NewAppModel creates a new AppModel with the provided configuration and KubeAdapter
 func NewAppModel(cfg *config.Config, adapter KubeAdapter) AppModel {
+	ttl, err := cfg.CacheDuration()
+	if err != nil {
+		slog.Warn("invalid cache_ttl, using default", "error", err)
+		ttl = config.DefaultCacheTTL
+	}
+
 	model := AppModel{
 		config:            cfg,
-		contexts:          cfg.Contexts,
-		keys:              DefaultKeyMap(),
+		contexts:          config.GroupContexts(cfg.Contexts),
+		keys:              KeyMapFromConfig(cfg.Keymap),
 		kubeAdapter:       adapter,
 		focusedPanel:      PanelNamespaces,            // Story 3.3: Start with namespace panel focused
 		selectedPodIndex:  -1,                         // Story 6.2: No pod selected initially (cursor = selection)
 		podScrollOffset:   0,                          // Story 3.3: No scroll offset initially
 		executor:          executor.NewExecutor(),     // Story 6.2: Initialize executor (no adapter needed)
 		errorModal:        components.NewErrorModal(), // Story 6.3: Initialize error modal
-		namespacesSpinner: components.NewSpinner(),    // Story 6.3: Initialize namespace spinner
-		podsSpinner:       components.NewSpinner(),    // Story 6.3: Initialize pod spinner
-		actionSpinner:     components.NewSpinner(),    // Story 6.3: Initialize action spinner
-	}
+		confirm:           components.NewConfirmInput(),
+		paramForm:         components.NewForm(),
+		namespaceForm:     &components.Form{Submit: "Create"},
+		pager:             components.NewPager(),
+		statusBar:         components.NewStatusBar(),
+		running:           &runningAction{},
+		namespacesSpinner: components.NewSpinner(), // Story 6.3: Initialize namespace spinner
+		podsSpinner:       components.NewSpinner(), // Story 6.3: Initialize pod spinner
+		actionSpinner:     components.NewSpinner(), // Story 6.3: Initialize action spinner
+		portForwards:      executor.NewPortForwardManager(),
+		jobs:              executor.NewJobManager(),
+		requests:          newAsyncTracker(),
+		cache:             cache.New(ttl),
+		panels:            panelSplit{preset: cfg.LayoutPreset()},
+	}
+	model.configureSpinners()
 
 	// Initialize viewMode based on number of contexts
 	if len(cfg.Contexts) > 1 {
 		model.viewMode = viewModeContextSelection
 		model.selectedContextIndex = 0
+		if cfg.Prefetch {
+			model.prefetch = make(map[string]prefetchResult, len(cfg.Contexts))
+		}
+		if _, ok := adapter.(ContextProber); ok && model.healthTimeout() > 0 {
+			model.health = make(map[string]*k8s.ContextHealth, len(cfg.Contexts))
+		}
 	} else if len(cfg.Contexts) == 1 {
 		// Auto-select single context
-		model.currentContext = &cfg.Contexts[0]
+		model.currentContext = &model.contexts[0]
 		model.viewMode = viewModeNamespaceView
-		// Load actions from context (Story 4.1)
-		model.actions = cfg.Contexts[0].Actions
+		// Load global and per-context actions (Story 4.1)
+		model.actions = config.MergeActions(cfg.Actions, cfg.Contexts[0].Actions)
 	}
 
 	return model
 }
 
-// Init initializes the model. Returns nil as no initial commands are needed
+// SetConfigPath sets the config file that settings changes are written back to
+func (m *AppModel) SetConfigPath(path string) {
+	m.configPath = path
+}
+
+// Close releases background resources such as running port-forwards.
+// Call it on the final model after the program exits.
+func (m AppModel) Close() {
+	m.saveState()
+	if m.portForwards != nil {
+		m.portForwards.StopAll()
+	}
+	if m.jobs != nil {
+		m.jobs.StopAll()
+	}
+	// Stop actions still running and remove captured output
+	m.running.stop()
+	m.capture.close()
+	m.runExitHook()
+}
+
+// Init initializes the model and starts background lookups (startup check, namespaces,
+// credential expiry)
 func (m AppModel) Init() tea.Cmd {
-	// If single context auto-selected, fetch namespaces immediately
+	// Check kubectl and kubeconfig, and look up credential expiry for all contexts, in the background
+	backgroundCmd := tea.Batch(m.startupCheckCmd(), m.checkAllCredentialsCmd(), m.startThrottleCmd(), m.startMetricsCmd(), m.startHeaderCmd())
+	if m.debugLog != nil {
+		backgroundCmd = tea.Batch(backgroundCmd, debugTickCmd())
+	}
+
+	// If single context auto-selected, run its on_enter hook and fetch namespaces immediately
 	if m.viewMode == viewModeNamespaceView && m.currentContext != nil {
+		backgroundCmd = tea.Batch(backgroundCmd, m.runHookCmd(*m.currentContext, hookOnEnter), m.checkAuthCmd(m.currentContext.Name))
 		// Story 6.3: Start namespace spinner
-		m.namespacesSpinner.Start("Loading namespaces...")
-		return tea.Batch(m.fetchNamespacesCmd(), components.TickCmd())
+		m.namespacesSpinner.Start(m.loadingMessage(config.LoadingNamespaces))
+		return tea.Batch(m.fetchNamespacesCmd(), m.spinnerTickCmd(), backgroundCmd)
 	}
-	return nil
+	return tea.Batch(backgroundCmd, m.prefetchNamespacesCmd(), m.probeContextsCmd())
 }
 
 // fetchNamespacesCmd returns a command that fetches namespaces asynchronously
 func (m AppModel) fetchNamespacesCmd() tea.Cmd {
-	return func() tea.Msg {
+	return fetchCmd(m.requests, asyncNamespaces, "", func(context.Context) ([]k8s.Namespace, error) {
 		if m.currentContext == nil {
-			return namespaceFetchedMsg{err: fmt.Errorf("no context selected")}
+			return nil, fmt.Errorf("no context selected")
 		}
 
 		slog.Info("fetching namespaces", "context", m.currentContext.Name)
@@ -162,62 +376,161 @@ func (m AppModel) fetchNamespacesCmd() tea.Cmd {
 
 		if err != nil {
 			slog.Error("namespace fetch failed", "context", m.currentContext.Name, "error", err)
-			return namespaceFetchedMsg{err: err}
+			return nil, err
 		}
 
-		return namespaceFetchedMsg{namespaces: namespaces}
-	}
+		return namespaces, nil
+	})
 }
 
-// fetchPodsCmd returns a command that fetches pods asynchronously
+// fetchPodsCmd returns a command that fetches pods asynchronously. With paging on only the
+// first page is fetched.
 func (m AppModel) fetchPodsCmd() tea.Cmd {
-	return func() tea.Msg {
+	if limit := m.podPageSize(); limit > 0 {
+		return m.fetchPodPageCmd(asyncPods, limit, "")
+	}
+	selector := m.podSelector
+	return fetchCmd(m.requests, asyncPods, "", func(context.Context) ([]k8s.Pod, error) {
 		if m.currentContext == nil {
-			return podsFetchedMsg{err: fmt.Errorf("no context selected")}
+			return nil, fmt.Errorf("no context selected")
 		}
 
 		if m.currentNamespace == "" {
-			return podsFetchedMsg{err: fmt.Errorf("no namespace selected")}
+			return nil, fmt.Errorf("no namespace selected")
 		}
 
-		slog.Info("fetching pods", "context", m.currentContext.Name, "namespace", m.currentNamespace)
-		pods, err := m.kubeAdapter.GetPods(m.currentContext.Name, m.currentNamespace)
+		slog.Info("fetching pods", "context", m.currentContext.Name, "namespace", m.currentNamespace, "selector", selector)
+		pods, err := m.getPods(m.currentContext.Name, m.currentNamespace, selector)
 
 		if err != nil {
 			slog.Error("pod fetch failed", "context", m.currentContext.Name, "namespace", m.currentNamespace, "error", err)
-			return podsFetchedMsg{err: err}
+			return nil, err
+		}
+
+		return pods, nil
+	})
+}
+
+// setNamespaces shows the namespaces of the current context, fetched or cached
+func (m *AppModel) setNamespaces(namespaces []k8s.Namespace) tea.Cmd {
+	// Story 5.3: Get favorites for current context
+	if m.currentContext != nil {
+		favorites, err := config.GetFavorites(m.config, m.currentContext.Name)
+		if err != nil {
+			slog.Warn("failed to get favorites", "context", m.currentContext.Name, "error", err)
+			m.favoriteNamespaces = []string{}
+		} else {
+			m.favoriteNamespaces = favorites
+		}
+	}
+
+	m.namespacesFallback = m.namespacesFromFallback()
+
+	// Story 5.3: Sort namespaces with favorites first
+	m.namespaces = m.sortNamespacesWithFavorites(namespaces, m.favoriteNamespaces)
+
+	// Bug Fix (Story 7.5): Ensure cursor index is valid after namespace list changes
+	if rows := len(m.namespaceRows()); m.selectedNamespaceIndex >= rows && rows > 0 {
+		m.selectedNamespaceIndex = rows - 1
+	}
+	if len(m.namespaces) == 0 {
+		m.selectedNamespaceIndex = 0
+	}
+
+	// Flag namespaces with restart storms and count their pods in the background
+	restartsCmd := m.analyzeRestartsCmd()
+	if countsCmd := m.loadPodCounts(); countsCmd != nil {
+		restartsCmd = tea.Batch(restartsCmd, countsCmd)
+	}
+
+	// Open the namespace picked in the palette, or else the one used in the previous session
+	if cmd := m.openJumpNamespace(); cmd != nil {
+		return tea.Batch(cmd, restartsCmd)
+	}
+	if cmd := m.restoreNamespace(); cmd != nil {
+		return tea.Batch(cmd, restartsCmd)
+	}
+
+	return restartsCmd
+}
+
+// setPods shows the pods of the current namespace, fetched or cached. A refreshed list keeps
+// the cursor on the selected pod.
+func (m *AppModel) setPods(pods []k8s.Pod) {
+	selected, hasSelection := m.selectedPod()
+
+	// Sort a copy: pods may be shared with the cache
+	m.pods = slices.Clone(pods)
+	m.orderPods(m.pods)
+	if m.podSearchMode {
+		m.updatePodSearchQuery(m.podSearchQuery)
+	}
+
+	if hasSelection {
+		list := m.podList()
+		m.selectedPodIndex = slices.IndexFunc(list, func(p k8s.Pod) bool { return p.Name == selected.Name })
+		if m.selectedPodIndex == -1 && len(list) > 0 {
+			m.selectedPodIndex = 0
 		}
+		m.adjustPodScrollOffset()
+	}
 
-		return podsFetchedMsg{pods: pods}
+	// Bug Fix (Story 7.5): Auto-select first pod when pods are loaded and focus is on pod panel
+	// This matches the Tab handler pattern (lines 391-394)
+	if len(m.podList()) > 0 && m.selectedPodIndex == -1 && m.focusedPanel == PanelPods {
+		m.selectedPodIndex = 0
 	}
+	m.restoreSelectedPod()
 }
 
-// Update handles incoming messages and returns an updated model and optional command
-// nolint:gocyclo // Bubble Tea Update pattern inherently has high complexity due to message routing
+// Update handles incoming messages and returns an updated model and optional command.
+// A panic writes a crash report before Bubble Tea restores the terminal.
 func (m AppModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	defer m.recoverCrash(msg)
+	updated, cmd := m.update(msg)
+	if model, ok := updated.(AppModel); ok {
+		if detail := model.fetchPodDetail(); detail != nil {
+			return model, tea.Batch(cmd, detail)
+		}
+	}
+	return updated, cmd
+}
+
+// update routes a message to its handler
+// nolint:gocyclo // Bubble Tea Update pattern inherently has high complexity due to message routing
+func (m AppModel) update(msg tea.Msg) (tea.Model, tea.Cmd) {
 	switch msg := msg.(type) {
 	case components.SpinnerTickMsg:
 		// Story 6.3: Handle spinner animation tick
 		m.namespacesSpinner.Tick()
 		m.podsSpinner.Tick()
 		m.actionSpinner.Tick()
+		m.updateRetrySpinners()
 
 		// Re-subscribe if any spinner is active
 		if m.namespacesSpinner.IsActive || m.podsSpinner.IsActive || m.actionSpinner.IsActive {
-			return m, components.TickCmd()
+			return m, m.spinnerTickCmd()
 		}
 		return m, nil
 
 	case namespaceFetchedMsg:
+		if msg.kind == asyncPrefetch {
+			return m.handleNamespacesPrefetched(msg)
+		}
+		if !m.requests.current(msg.asyncRequest) {
+			return m, nil // Superseded by a newer request
+		}
 		// Handle namespace fetch results (Story 6.3: use spinners and modal)
 		m.namespacesLoading = false
 		m.namespacesSpinner.Stop()
 
 		if msg.err != nil {
+			m.refreshing = ""
 			m.namespacesError = msg.err
 			// Story 6.3: Show error modal with retry capability
-			m.errorModal.ShowWithSuggestion(
+			m.showError(
 				msg.err.Error(),
+				msg.err,
 				"Fetch Namespaces",
 				"Check your network connection and cluster access",
 				func() tea.Cmd { return m.fetchNamespacesCmd() },
@@ -225,72 +538,155 @@ func (m AppModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 			return m, nil
 		}
 		m.namespacesError = nil
-
-		// Story 5.3: Get favorites for current context
 		if m.currentContext != nil {
-			favorites, err := config.GetFavorites(m.config, m.currentContext.Name)
-			if err != nil {
-				slog.Warn("failed to get favorites", "context", m.currentContext.Name, "error", err)
-				m.favoriteNamespaces = []string{}
-			} else {
-				m.favoriteNamespaces = favorites
-			}
+			m.cache.SetNamespaces(m.currentContext.Name, msg.value)
 		}
+		cmd := m.setNamespaces(msg.value)
+		if m.refreshing == asyncNamespaces {
+			m.refreshing = ""
+			cmd = tea.Batch(cmd, m.notify("Namespaces refreshed", components.ToastInfo))
+		}
+		return m, cmd
 
-		// Story 5.3: Sort namespaces with favorites first
-		m.namespaces = m.sortNamespacesWithFavorites(msg.namespaces, m.favoriteNamespaces)
-
-		// Bug Fix (Story 7.5): Ensure cursor index is valid after namespace list changes
-		if m.selectedNamespaceIndex >= len(m.namespaces) && len(m.namespaces) > 0 {
-			m.selectedNamespaceIndex = len(m.namespaces) - 1
+	case podsFetchedMsg:
+		if msg.kind == asyncFavorites {
+			return m.handleFavoritesPrefetched(msg)
 		}
-		if len(m.namespaces) == 0 {
-			m.selectedNamespaceIndex = 0
+		if !m.requests.current(msg.asyncRequest) {
+			return m, nil // Superseded by a newer request
 		}
+		return m.handlePodsFetched(msg.value, "", msg.err)
 
-		return m, nil
+	case podPageFetchedMsg:
+		return m.handlePodPageFetched(msg)
 
-	case podsFetchedMsg:
-		// Handle pod fetch results (Story 6.3: use spinners and modal)
-		m.podsLoading = false
-		m.podsSpinner.Stop()
+	case podDetailFetchedMsg:
+		return m.handlePodDetailFetched(msg)
 
-		if msg.err != nil {
-			m.podsError = msg.err
-			// Story 6.3: Show error modal with retry capability
-			m.errorModal.ShowWithSuggestion(
-				msg.err.Error(),
-				"Fetch Pods",
-				"Check your network connection and cluster access",
-				func() tea.Cmd { return m.fetchPodsCmd() },
-			)
+	case execFinishedMsg:
+		// The terminal may have been resized while the action owned it
+		model, cmd := m.handleExecFinished(msg)
+		return model, tea.Batch(cmd, windowSizeCmd)
+
+	case namespaceChangedMsg:
+		return m.handleNamespaceChanged(msg)
+
+	case portForwardExitedMsg:
+		return m.handlePortForwardExited(msg)
+
+	case resourcesFetchedMsg:
+		return m.handleResourcesFetched(msg)
+
+	case podCountsMsg:
+		return m.handlePodCounts(msg)
+
+	case restartStormsMsg:
+		return m.handleRestartStorms(msg)
+
+	case gitOpsFetchedMsg:
+		return m.handleGitOpsFetched(msg)
+
+	case servicesFetchedMsg:
+		return m.handleServicesFetched(msg)
+
+	case podDescribedMsg:
+		return m.handlePodDescribed(msg)
+
+	case podYAMLFetchedMsg:
+		return m.handlePodYAMLFetched(msg)
+
+	case podEditedMsg:
+		return m.handlePodEdited(msg)
+	case nodeShellExitedMsg:
+		return m.handleNodeShellExited(msg)
+
+	case shellExitedMsg:
+		return m.handleShellExited(msg)
+	case configDataFetchedMsg:
+		return m.handleConfigDataFetched(msg)
+
+	case hookFinishedMsg:
+		return m.handleHookFinished(msg)
+
+	case captureStartedMsg:
+		return m.handleCaptureStarted(msg)
+
+	case captureFinishedMsg:
+		return m.handleCaptureFinished(msg)
+
+	case captureTickMsg:
+		return m.handleCaptureTick(msg)
+
+	case jobStartedMsg:
+		return m.handleJobStarted(msg)
+
+	case jobFinishedMsg:
+		return m.handleJobFinished(msg)
+
+	case jobsTickMsg:
+		return m.handleJobsTick()
+
+	case toastTickMsg:
+		return m.handleToastTick()
+
+	case credentialCheckedMsg:
+		return m.handleCredentialChecked(msg)
+
+	case contextHealthMsg:
+		return m.handleContextHealth(msg)
+
+	case credentialTickMsg:
+		return m.handleCredentialTick(msg)
+
+	case authCheckedMsg:
+		return m.handleAuthChecked(msg)
+
+	case authRefreshedMsg:
+		return m.handleAuthRefreshed(msg)
+
+	case debugTickMsg:
+		return m, debugTickCmd()
+
+	case headerTickMsg:
+		return m, m.startHeaderCmd()
+
+	case throttleTickMsg:
+		return m.handleThrottleTick()
+
+	case metricsTickMsg:
+		return m.handleMetricsTick()
+
+	case podMetricsFetchedMsg:
+		return m.handlePodMetricsFetched(msg)
+
+	case startupCheckedMsg:
+		return m.handleStartupChecked(msg)
+
+	case configReloadedMsg:
+		return m.handleConfigReloaded(msg)
+
+	case tea.KeyMsg:
+		// A failed startup check is fatal: only quitting is possible
+		if m.err != nil {
+			if KeyMatches(msg, m.keys.Quit) {
+				return m, tea.Quit
+			}
 			return m, nil
 		}
-		m.podsError = nil
-		m.pods = msg.pods
-
-		// Bug Fix (Story 7.5): Auto-select first pod when pods are loaded and focus is on pod panel
-		// This matches the Tab handler pattern (lines 391-394)
-		if len(m.pods) > 0 && m.selectedPodIndex == -1 && m.focusedPanel == PanelPods {
-			m.selectedPodIndex = 0
+		if m.kubectlMissing != nil {
+			return m.handleKubectlSetupKey(msg)
 		}
 
-		return m, nil
-
-	case execFinishedMsg:
-		// Handle command execution completion (Story 6.3: use modal for errors)
-		m.actionSpinner.Stop()
+		// What's new screen captures keys until dismissed
+		if len(m.whatsNew) > 0 {
+			return m.handleWhatsNewKey(msg)
+		}
 
-		if msg.err != nil {
-			m.errorModal.Show(
-				fmt.Sprintf("Command failed: %s", msg.err.Error()),
-				"Action Execution",
-				nil,
-			)
+		// Credential problems explain the errors behind them, so they come first
+		if m.authStatus != nil {
+			return m.handleAuthKey(msg)
 		}
-		return m, nil
 
-	case tea.KeyMsg:
 		// Story 6.3: Handle error modal key presses first (blocks other input)
 		if m.errorModal.IsVisible {
 			// Bug Fix: Capture operation BEFORE HandleKeyPress clears it
@@ -312,21 +708,107 @@ func (m AppModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 						slog.Debug("clearing namespace error and starting spinner")
 						m.namespacesError = nil
 						m.namespacesLoading = true
-						m.namespacesSpinner.Start("Loading namespaces...")
+						m.namespacesSpinner.Start(m.loadingMessage(config.LoadingNamespaces))
 					case "Fetch Pods":
 						slog.Debug("clearing pod error and starting spinner")
 						m.podsError = nil
 						m.podsLoading = true
-						m.podsSpinner.Start("Loading pods...")
+						m.podsSpinner.Start(m.loadingMessage(config.LoadingPods))
 					case "Action Execution":
 						// Action spinner already handled in handleActionExecution
 					}
-					return m, tea.Batch(cmd, components.TickCmd())
+					return m, tea.Batch(cmd, m.spinnerTickCmd())
 				}
 				return m, cmd
 			}
 		}
 
+		// Action params form captures all keys while open
+		if m.paramForm != nil && m.paramForm.IsVisible {
+			return m.handleParamFormKey(msg)
+		}
+
+		// Create namespace form captures all keys while open
+		if m.namespaceForm != nil && m.namespaceForm.IsVisible {
+			return m.handleNamespaceFormKey(msg)
+		}
+
+		// Typed confirmation captures all keys while open
+		if m.confirm != nil && m.confirm.IsVisible {
+			_, cmd := m.confirm.HandleKeyPress(msg)
+			return m, cmd
+		}
+
+		// Settings screen captures all keys while open
+		if m.viewMode == viewModeSettings {
+			return m.handleSettingsKey(msg)
+		}
+
+		// GitOps detail panel captures all keys while open
+		if m.viewMode == viewModeGitOps {
+			return m.handleGitOpsKey(msg)
+		}
+
+		// Network quick reference captures all keys while open
+		if m.viewMode == viewModeNetwork {
+			return m.handleNetworkKey(msg)
+		}
+
+		// Command palette captures all keys while open
+		if m.viewMode == viewModePalette {
+			return m.handlePaletteKey(msg)
+		}
+
+		// Action picker captures all keys while open
+		if m.viewMode == viewModeActionPicker {
+			return m.handleActionPickerKey(msg)
+		}
+
+		// History view captures all keys while open
+		if m.viewMode == viewModeHistory {
+			return m.handleHistoryKey(msg)
+		}
+
+		// Jobs view captures all keys while open
+		if m.viewMode == viewModeJobs {
+			return m.handleJobsKey(msg)
+		}
+
+		// Help overlay captures all keys while open
+		if m.viewMode == viewModeHelp {
+			return m.handleHelpKey(msg)
+		}
+
+		// Describe pager captures all keys while open
+		if m.viewMode == viewModeDescribe {
+			return m.handleDescribeKey(msg)
+		}
+
+		// YAML viewer captures all keys while open
+		if m.viewMode == viewModeYAML {
+			return m.handleYAMLKey(msg)
+		}
+
+		// ConfigMap and secret viewer captures all keys while open
+		if m.viewMode == viewModeConfigData {
+			return m.handleConfigDataKey(msg)
+		}
+
+		// Stderr viewer captures all keys while open
+		if m.viewMode == viewModeStderr {
+			return m.handleStderrKey(msg)
+		}
+
+		// Output viewer captures all keys while open
+		if m.viewMode == viewModeOutput {
+			return m.handleOutputKey(msg)
+		}
+
+		// An open action group takes the next key
+		if m.openActionGroup != "" {
+			return m.handleActionGroupKey(msg)
+		}
+
 		// Clear error message on any key press (Story 4.2)
 		if m.errorMessage != "" {
 			m.errorMessage = ""
@@ -339,19 +821,59 @@ func (m AppModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 			return m, nil
 		}
 
+		// The label selector input captures typed characters like pod search
+		if m.podSelectorEditing {
+			return m.handlePodSelectorKey(msg)
+		}
+
+		// Pod search captures typed characters (including quit and action keys)
+		if m.podSearchMode {
+			return m.handlePodSearchKey(msg)
+		}
+
+		// Open key binding settings (not while typing a search query)
+		if !m.searchMode && KeyMatches(msg, m.keys.Settings) {
+			m.openSettings()
+			return m, nil
+		}
+
+		// Open the command palette
+		if !m.searchMode && KeyMatches(msg, m.keys.Palette) {
+			m.openPalette()
+			return m, nil
+		}
+
+		// Show every key and action
+		if !m.searchMode && KeyMatches(msg, m.keys.Help) {
+			return m.openHelp()
+		}
+
+		// Re-read the configuration, keeping fetched namespaces and pods
+		if !m.searchMode && KeyMatches(msg, m.keys.Reload) {
+			return m.handleReload()
+		}
+
+		// Open, close and switch workspace tabs
+		if !m.searchMode {
+			if model, cmd, ok := m.handleTabKey(msg); ok {
+				return model, cmd
+			}
+		}
+		// ESC in the context list goes back to the context that is already open
+		if m.viewMode == viewModeContextSelection && m.currentContext != nil && msg.Type == tea.KeyEsc {
+			return m.closeContextList()
+		}
+
 		// Handle quit keys (but not in search mode where ESC is handled above)
 		if KeyMatches(msg, m.keys.Quit) {
-			return m, tea.Quit
+			return m.quit()
 		}
 
 		// Check for action shortcut key presses (Story 4.2)
 		// Only in namespace view mode and not in search mode
 		if m.viewMode == viewModeNamespaceView && !m.searchMode {
-			keyStr := msg.String()
-			for _, action := range m.actions {
-				if keyStr == action.Shortcut {
-					return m.handleActionExecution(action)
-				}
+			if model, cmd, ok := m.handleActionShortcut(msg); ok {
+				return model, cmd
 			}
 		}
 
@@ -376,72 +898,215 @@ func (m AppModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 			}
 
 			if KeyMatches(msg, m.keys.Enter) {
-				// Select context and switch kubectl context
-				selectedCtx := &m.contexts[m.selectedContextIndex]
-
-				// Switch kubectl context before transitioning to namespace view
-				if err := m.kubeAdapter.SwitchContext(selectedCtx.Name); err != nil {
-					// Show error modal if context switch fails
-					m.errorModal.Show(
-						fmt.Sprintf("Failed to switch kubectl context: %s", err.Error()),
-						"Context Switch",
-						nil,
-					)
-					return m, nil
-				}
+				return m.selectContext(m.selectedContextIndex)
+			}
 
-				// Context switched successfully - proceed with existing logic
-				m.currentContext = selectedCtx
-				m.viewMode = viewModeNamespaceView
-				m.namespacesLoading = true
-				// Bug Fix (Story 7.5): Don't reset namespace cursor - preserve position
-				// m.selectedNamespaceIndex = 0 // REMOVED - preserve cursor position
-				m.namespaceViewportStart = 0 // Reset viewport position
-				m.namespaces = nil           // Clear previous namespaces
-				// Story 5.3: Clear favorites (will be loaded with namespaces)
-				m.favoriteNamespaces = nil
-				// Load actions from context (Story 6.2)
-				m.actions = selectedCtx.Actions
-				// Story 6.3: Start namespace spinner
-				m.namespacesSpinner.Start("Loading namespaces...")
-				return m, tea.Batch(m.fetchNamespacesCmd(), components.TickCmd())
+			// Probe every context again
+			if m.health != nil && KeyMatches(msg, m.keys.Refresh) {
+				slog.Info("probing contexts again")
+				return m, m.probeContextsCmd()
 			}
 		}
 
 		// Handle namespace view navigation
 		if m.viewMode == viewModeNamespaceView {
+			// Tab completes the namespace search query instead of switching focus
+			if m.searchMode && (KeyMatches(msg, m.keys.Tab) || KeyMatches(msg, m.keys.ShiftTab)) {
+				m.completeSearch(KeyMatches(msg, m.keys.ShiftTab))
+				return m, nil
+			}
+
 			// Handle Tab key for focus switching (Story 6.2: Skip actions panel)
-			if msg.String() == "tab" {
+			if KeyMatches(msg, m.keys.Tab) {
 				switch m.focusedPanel {
 				case PanelNamespaces:
 					m.focusedPanel = PanelPods
 					// Auto-select first pod when focusing pod panel
-					if len(m.pods) > 0 && m.selectedPodIndex == -1 {
+					if len(m.podList()) > 0 && m.selectedPodIndex == -1 {
 						m.selectedPodIndex = 0
 					}
 				case PanelPods:
 					m.focusedPanel = PanelNamespaces
+					// Port-forward panel joins the cycle while forwards exist
+					if len(m.forwardList()) > 0 {
+						m.focusedPanel = PanelForwards
+						m.clampForwardSelection()
+					}
+				case PanelForwards:
+					m.focusedPanel = PanelNamespaces
 				}
+				m.skipHiddenNamespaces(false)
 				return m, nil
 			}
 
 			// Handle Shift+Tab key for backward focus switching (Story 6.2: Skip actions panel)
-			if msg.String() == "shift+tab" {
+			if KeyMatches(msg, m.keys.ShiftTab) {
 				switch m.focusedPanel {
 				case PanelPods:
 					m.focusedPanel = PanelNamespaces
+				case PanelForwards:
+					m.focusedPanel = PanelPods
 				case PanelNamespaces:
 					m.focusedPanel = PanelPods
 					// Auto-select first pod when focusing pod panel
-					if len(m.pods) > 0 && m.selectedPodIndex == -1 {
+					if len(m.podList()) > 0 && m.selectedPodIndex == -1 {
 						m.selectedPodIndex = 0
 					}
+					// Port-forward panel joins the cycle while forwards exist
+					if len(m.forwardList()) > 0 {
+						m.focusedPanel = PanelForwards
+						m.clampForwardSelection()
+					}
 				}
+				m.skipHiddenNamespaces(true)
 				return m, nil
 			}
 
-			// Handle search mode activation
-			if !m.searchMode && msg.String() == "/" {
+			// Panel layout: presets and resizing the splits
+			if !m.searchMode && KeyMatches(msg, m.keys.LayoutPreset) {
+				return m.handleCycleLayoutPreset()
+			}
+			if !m.searchMode && KeyMatches(msg, m.keys.ResizeLeft) {
+				return m.handleResizeNamespaces(-splitStep)
+			}
+			if !m.searchMode && KeyMatches(msg, m.keys.ResizeRight) {
+				return m.handleResizeNamespaces(splitStep)
+			}
+			if !m.searchMode && KeyMatches(msg, m.keys.ResizeUp) {
+				return m.handleResizePods(-splitStep)
+			}
+			if !m.searchMode && KeyMatches(msg, m.keys.ResizeDown) {
+				return m.handleResizePods(splitStep)
+			}
+
+			// Port-forward management
+			if !m.searchMode && KeyMatches(msg, m.keys.PortForward) {
+				return m.handleStartPortForward()
+			}
+			if m.focusedPanel == PanelForwards && KeyMatches(msg, m.keys.StopForward) {
+				return m.handleStopPortForward()
+			}
+
+			// Resource browser: cycle pods → deployments → statefulsets → jobs
+			if !m.searchMode && KeyMatches(msg, m.keys.ResourceType) {
+				return m.handleCycleResourceKind()
+			}
+
+			// GitOps source of the selected pod
+			if !m.searchMode && KeyMatches(msg, m.keys.GitOps) {
+				return m.openGitOps()
+			}
+
+			// IP, DNS names and ports of the selected pod
+			if !m.searchMode && !m.browsingResources() && KeyMatches(msg, m.keys.Network) {
+				return m.openNetwork()
+			}
+
+			// Pick an action by name instead of its shortcut
+			if !m.searchMode && KeyMatches(msg, m.keys.ActionPicker) {
+				m.openActionPicker()
+				return m, nil
+			}
+
+			// Browse and re-run previously executed actions
+			if !m.searchMode && KeyMatches(msg, m.keys.History) {
+				m.openHistory()
+				return m, nil
+			}
+
+			// Running and finished background jobs
+			if !m.searchMode && KeyMatches(msg, m.keys.Jobs) {
+				return m.openJobs()
+			}
+
+			// kubectl describe of the selected pod, or the keys of the selected configmap or secret
+			if !m.searchMode && !m.browsingResources() && KeyMatches(msg, m.keys.Describe) {
+				return m.openDescribe()
+			}
+			if !m.searchMode && !m.browsingResources() && KeyMatches(msg, m.keys.YAML) {
+				return m.openPodYAML()
+			}
+			if !m.searchMode && !m.browsingResources() && KeyMatches(msg, m.keys.NodeShell) {
+				return m.openNodeShell()
+			}
+			// Local shell with the selection exported, for ad-hoc kubectl commands
+			if !m.searchMode && KeyMatches(msg, m.keys.Shell) {
+				return m.openShell()
+			}
+			if !m.searchMode && m.focusedPanel == PanelPods && m.browsingConfigData() &&
+				(KeyMatches(msg, m.keys.Describe) || KeyMatches(msg, m.keys.Enter)) {
+				return m.openConfigData()
+			}
+
+			// Pods panel sort order
+			if !m.searchMode && !m.browsingResources() && KeyMatches(msg, m.keys.PodSort) {
+				return m.handleCyclePodSort()
+			}
+			if !m.searchMode && !m.browsingResources() && KeyMatches(msg, m.keys.PodFilter) {
+				return m.handleCyclePodFilter()
+			}
+			// Pins apply to the pods panel; in the namespace panel the key starts type-ahead
+			if !m.searchMode && m.focusedPanel == PanelPods && !m.browsingResources() && KeyMatches(msg, m.keys.PinPod) {
+				return m.handleTogglePin()
+			}
+
+			// Label selector the pods are listed with
+			if !m.searchMode && !m.browsingResources() && KeyMatches(msg, m.keys.LabelSelector) {
+				return m.openPodSelector()
+			}
+			if !m.searchMode && m.podSelector != "" && KeyMatches(msg, m.keys.ClearSelector) {
+				return m.applyPodSelector("")
+			}
+
+			// Narrow the actions panel to the next action tag
+			if !m.searchMode && KeyMatches(msg, m.keys.ActionFilter) {
+				return m.handleCycleActionTag()
+			}
+
+			// Refetch the focused panel, bypassing the cache
+			if !m.searchMode && KeyMatches(msg, m.keys.Refresh) {
+				return m.handleRefresh()
+			}
+
+			// Back to the context list
+			if !m.searchMode && m.focusedPanel == PanelNamespaces && len(m.contexts) > 1 && KeyMatches(msg, m.keys.SwitchContext) {
+				return m.openContextList()
+			}
+
+			// Copy the highlighted name or the last executed command
+			if !m.searchMode && KeyMatches(msg, m.keys.CopyName) {
+				return m.handleCopyName()
+			}
+			if !m.searchMode && KeyMatches(msg, m.keys.CopyCommand) {
+				return m.handleCopyCommand()
+			}
+
+			// Stderr of the last failed action
+			if !m.searchMode && KeyMatches(msg, m.keys.LastStderr) {
+				return m.openStderr()
+			}
+
+			// Favorite toggle for the highlighted namespace
+			if !m.searchMode && m.focusedPanel == PanelNamespaces && KeyMatches(msg, m.keys.Favorite) {
+				return m.handleToggleFavorite()
+			}
+
+			// Create a namespace or delete the highlighted one, with allow_namespace_mutations
+			if !m.searchMode && m.focusedPanel == PanelNamespaces && KeyMatches(msg, m.keys.CreateNamespace) {
+				return m.openNamespaceForm()
+			}
+			if !m.searchMode && m.focusedPanel == PanelNamespaces && KeyMatches(msg, m.keys.DeleteNamespace) {
+				return m.handleDeleteNamespace()
+			}
+
+			// Handle search mode activation (pods panel has its own search)
+			if !m.searchMode && KeyMatches(msg, m.keys.Search) {
+				if m.focusedPanel == PanelPods {
+					if len(m.pods) > 0 && !m.browsingResources() {
+						m.activatePodSearch()
+					}
+					return m, nil
+				}
 				m.activateSearch()
 				return m, nil
 			}
@@ -451,20 +1116,9 @@ func (m AppModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 				// Enter selects current filtered namespace and exits search
 				if KeyMatches(msg, m.keys.Enter) {
 					if len(m.filteredNamespaces) > 0 && m.selectedNamespaceIndex < len(m.filteredNamespaces) {
-						// Select namespace and fetch pods
-						m.currentNamespace = m.filteredNamespaces[m.selectedNamespaceIndex]
+						namespace := m.filteredNamespaces[m.selectedNamespaceIndex]
 						m.deactivateSearch()
-						m.podsLoading = true
-						m.podsError = nil
-						m.pods = nil
-						// Reset pod panel state (Story 6.2)
-						m.selectedPodIndex = -1
-						m.podScrollOffset = 0
-						// QA Fix: Auto-switch focus to pods panel after namespace selection
-						m.focusedPanel = PanelPods
-						// Story 6.3: Start pod spinner
-						m.podsSpinner.Start("Loading pods...")
-						return m, tea.Batch(m.fetchPodsCmd(), components.TickCmd())
+						return m.selectNamespace(namespace.Name)
 					}
 					return m, nil
 				}
@@ -477,128 +1131,138 @@ func (m AppModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 					return m, nil
 				}
 
-				// Handle regular character input (alphanumeric, dash, dot, underscore)
+				// Handle regular character input (alphanumeric, dash, dot, underscore), plus
+				// the equals sign, slash and space of label terms
 				if msg.Type == tea.KeyRunes && len(msg.Runes) == 1 {
-					r := msg.Runes[0]
-					// Accept alphanumeric, dash, dot, underscore
-					if (r >= 'a' && r <= 'z') || (r >= 'A' && r <= 'Z') || (r >= '0' && r <= '9') || r == '-' || r == '.' || r == '_' {
+					if r := msg.Runes[0]; isSearchRune(r) || isLabelQueryRune(r) {
 						m.updateSearchQuery(m.searchQuery + string(r))
 					}
 					return m, nil
 				}
+				if msg.Type == tea.KeySpace && m.searchQuery != "" {
+					m.updateSearchQuery(m.searchQuery + " ")
+					return m, nil
+				}
 			}
 
 			// Handle Enter key in normal mode (namespace selection)
 			if !m.searchMode && KeyMatches(msg, m.keys.Enter) {
 				// Story 6.2: Cursor position = pod selection (no Enter confirmation needed)
 				// Enter only used for namespace selection
-				if m.focusedPanel == PanelNamespaces && len(m.namespaces) > 0 && m.selectedNamespaceIndex < len(m.namespaces) {
-					// Select namespace and fetch pods
-					m.currentNamespace = m.namespaces[m.selectedNamespaceIndex]
-					m.podsLoading = true
-					m.podsError = nil
-					m.pods = nil
-					// Reset pod panel state (Story 6.2)
-					m.selectedPodIndex = -1
-					m.podScrollOffset = 0
-					// QA Fix: Auto-switch focus to pods panel after namespace selection
-					m.focusedPanel = PanelPods
-					// Story 6.3: Start pod spinner
-					m.podsSpinner.Start("Loading pods...")
-					return m, tea.Batch(m.fetchPodsCmd(), components.TickCmd())
+				if m.focusedPanel == PanelNamespaces {
+					return m.openHighlightedNamespaceRow()
 				}
 				return m, nil
 			}
 
 			// Navigation (works in both normal and search mode)
-			// Handle arrow keys based on focused panel (Story 6.2: Actions panel removed)
 			if KeyMatches(msg, m.keys.Up) {
-				switch m.focusedPanel {
-				case PanelNamespaces:
-					// Navigate namespace panel with cursor centering (Story 6.1)
-					navList := m.namespaces
-					if m.searchMode && m.filteredNamespaces != nil {
-						navList = m.filteredNamespaces
-					}
-
-					if len(navList) > 0 {
-						m.selectedNamespaceIndex--
-						if m.selectedNamespaceIndex < 0 {
-							// Wrap to end: adjust viewport to show last item
-							m.selectedNamespaceIndex = len(navList) - 1
-							// Story 6.1: Adjust viewport to show last item with proper centering
-							m.adjustNamespaceViewport(len(navList))
-						} else {
-							// Story 6.1: Implement cursor centering
-							m.adjustNamespaceViewport(len(navList))
-						}
-					}
-				case PanelPods:
-					// Navigate pod panel (Story 3.3)
-					if len(m.pods) > 0 && m.selectedPodIndex > 0 {
-						m.selectedPodIndex--
-						m.adjustPodScrollOffset()
-					}
-				}
+				m.moveUp()
 				return m, nil
 			}
 
 			if KeyMatches(msg, m.keys.Down) {
-				switch m.focusedPanel {
-				case PanelNamespaces:
-					// Navigate namespace panel with cursor centering (Story 6.1)
-					navList := m.namespaces
-					if m.searchMode && m.filteredNamespaces != nil {
-						navList = m.filteredNamespaces
-					}
-
-					if len(navList) > 0 {
-						m.selectedNamespaceIndex++
-						if m.selectedNamespaceIndex >= len(navList) {
-							// Wrap to start: reset viewport to beginning
-							m.selectedNamespaceIndex = 0
-							m.namespaceViewportStart = 0
-						} else {
-							// Story 6.1: Implement cursor centering
-							m.adjustNamespaceViewport(len(navList))
-						}
-					}
-				case PanelPods:
-					// Navigate pod panel (Story 3.3)
-					if len(m.pods) > 0 && m.selectedPodIndex < len(m.pods)-1 {
-						m.selectedPodIndex++
-						m.adjustPodScrollOffset()
-					}
+				if m.atPodListEnd() {
+					return m.loadMorePods()
 				}
+				m.moveDown()
+				return m, nil
+			}
+
+			// Type-ahead: a character no key is bound to starts filtering the namespaces
+			if !m.searchMode && m.focusedPanel == PanelNamespaces && len(m.namespaces) > 0 &&
+				msg.Type == tea.KeyRunes && len(msg.Runes) == 1 && isSearchRune(msg.Runes[0]) {
+				m.activateSearch()
+				m.updateSearchQuery(string(msg.Runes[0]))
 				return m, nil
 			}
 		}
 
+	case tea.MouseMsg:
+		return m.handleMouse(msg)
+
 	case tea.WindowSizeMsg:
-		// Handle terminal resize
-		m.width = msg.Width
-		m.height = msg.Height
-		m.termWidth = msg.Width
-		m.termHeight = msg.Height
-
-		// Story 6.3: Update error modal size for proper centering
-		m.errorModal.SetSize(msg.Width, msg.Height)
-
-		// Check minimum size
-		if msg.Width < MinTerminalWidth || msg.Height < MinTerminalHeight {
-			m.terminalTooSmall = true
-		} else {
-			m.terminalTooSmall = false
-		}
+		m.resize(msg.Width, msg.Height)
 		return m, nil
 	}
 
 	return m, nil
 }
 
+// moveUp moves the cursor of the focused panel up (Story 6.2: Actions panel removed)
+func (m *AppModel) moveUp() {
+	switch m.focusedPanel {
+	case PanelNamespaces:
+		// Navigate namespace panel with cursor centering (Story 6.1)
+		navList := m.namespaceRows()
+
+		if len(navList) > 0 {
+			m.selectedNamespaceIndex--
+			if m.selectedNamespaceIndex < 0 {
+				// Wrap to end: adjust viewport to show last item
+				m.selectedNamespaceIndex = len(navList) - 1
+				// Story 6.1: Adjust viewport to show last item with proper centering
+				m.adjustNamespaceViewport(len(navList))
+			} else {
+				// Story 6.1: Implement cursor centering
+				m.adjustNamespaceViewport(len(navList))
+			}
+		}
+	case PanelPods:
+		if m.browsingResources() {
+			m.moveResourceSelection(-1)
+			break
+		}
+		// Navigate pod panel (Story 3.3)
+		if len(m.podList()) > 0 && m.selectedPodIndex > 0 {
+			m.selectedPodIndex--
+			m.adjustPodScrollOffset()
+		}
+	case PanelForwards:
+		if m.selectedForwardIndex > 0 {
+			m.selectedForwardIndex--
+		}
+	}
+}
+
+// moveDown moves the cursor of the focused panel down
+func (m *AppModel) moveDown() {
+	switch m.focusedPanel {
+	case PanelNamespaces:
+		// Navigate namespace panel with cursor centering (Story 6.1)
+		navList := m.namespaceRows()
+
+		if len(navList) > 0 {
+			m.selectedNamespaceIndex++
+			if m.selectedNamespaceIndex >= len(navList) {
+				// Wrap to start: reset viewport to beginning
+				m.selectedNamespaceIndex = 0
+				m.namespaceViewportStart = 0
+			} else {
+				// Story 6.1: Implement cursor centering
+				m.adjustNamespaceViewport(len(navList))
+			}
+		}
+	case PanelPods:
+		if m.browsingResources() {
+			m.moveResourceSelection(1)
+			break
+		}
+		// Navigate pod panel (Story 3.3)
+		if len(m.podList()) > 0 && m.selectedPodIndex < len(m.podList())-1 {
+			m.selectedPodIndex++
+			m.adjustPodScrollOffset()
+		}
+	case PanelForwards:
+		if m.selectedForwardIndex < len(m.forwardList())-1 {
+			m.selectedForwardIndex++
+		}
+	}
+}
+
 // sortNamespacesWithFavorites sorts namespaces with favorites first
 // Story 6.1: Favorites preserve config order (not sorted), regular namespaces sorted alphabetically
-func (m AppModel) sortNamespacesWithFavorites(namespaces []string, favorites []string) []string {
+func (m AppModel) sortNamespacesWithFavorites(namespaces []k8s.Namespace, favorites []string) []k8s.Namespace {
 	// Create a set of favorites for quick lookup
 	favSet := make(map[string]bool)
 	for _, fav := range favorites {
@@ -606,9 +1270,9 @@ func (m AppModel) sortNamespacesWithFavorites(namespaces []string, favorites []s
 	}
 
 	// Separate favorites and non-favorites
-	var favs, nonFavs []string
+	var favs, nonFavs []k8s.Namespace
 	for _, ns := range namespaces {
-		if favSet[ns] {
+		if favSet[ns.Name] {
 			favs = append(favs, ns)
 		} else {
 			nonFavs = append(nonFavs, ns)
@@ -622,14 +1286,16 @@ func (m AppModel) sortNamespacesWithFavorites(namespaces []string, favorites []s
 		favOrder[fav] = i
 	}
 	sort.SliceStable(favs, func(i, j int) bool {
-		return favOrder[favs[i]] < favOrder[favs[j]]
+		return favOrder[favs[i].Name] < favOrder[favs[j].Name]
 	})
 
 	// Sort only non-favorites alphabetically
-	sort.Strings(nonFavs)
+	sort.Slice(nonFavs, func(i, j int) bool {
+		return nonFavs[i].Name < nonFavs[j].Name
+	})
 
 	// Combine: favorites first (in config order), then rest (alphabetically)
-	result := make([]string, 0, len(namespaces))
+	result := make([]k8s.Namespace, 0, len(namespaces))
 	result = append(result, favs...)
 	result = append(result, nonFavs...)
 
@@ -654,32 +1320,39 @@ func (m *AppModel) deactivateSearch() {
 	// Find the currently selected namespace in filtered list and map it back to full list
 	var selectedNamespace string
 	if m.filteredNamespaces != nil && m.selectedNamespaceIndex >= 0 && m.selectedNamespaceIndex < len(m.filteredNamespaces) {
-		selectedNamespace = m.filteredNamespaces[m.selectedNamespaceIndex]
+		selectedNamespace = m.filteredNamespaces[m.selectedNamespaceIndex].Name
 	}
 
 	m.searchMode = false
 	m.searchQuery = ""
 	m.filteredNamespaces = nil
 
-	// Try to find the selected namespace in the full list and preserve cursor position
-	if selectedNamespace != "" {
-		for i, ns := range m.namespaces {
-			if ns == selectedNamespace {
-				m.selectedNamespaceIndex = i
-				// Adjust viewport to show the selected namespace
-				m.adjustNamespaceViewport(len(m.namespaces))
-				return
-			}
-		}
+	// Try to find the selected namespace in the full list and preserve cursor position,
+	// expanding its group
+	if selectedNamespace != "" && m.focusNamespace(selectedNamespace) {
+		return
 	}
 
 	// Fallback: if namespace not found or none was selected, keep current index but clamp it
-	if m.selectedNamespaceIndex >= len(m.namespaces) {
+	if m.selectedNamespaceIndex >= len(m.namespaceRows()) {
 		m.selectedNamespaceIndex = 0
 	}
 	m.namespaceViewportStart = 0
 }
 
+// isSearchRune reports whether r may be typed into the namespace search: alphanumeric, dash,
+// dot or underscore
+func isSearchRune(r rune) bool {
+	return (r >= 'a' && r <= 'z') || (r >= 'A' && r <= 'Z') || (r >= '0' && r <= '9') || r == '-' || r == '.' || r == '_'
+}
+
+// isLabelQueryRune reports whether r may be typed into the namespace search for label terms
+// such as "app.kubernetes.io/part-of=shop", besides the search runes. They do not start
+// type-ahead filtering.
+func isLabelQueryRune(r rune) bool {
+	return r == '=' || r == '/'
+}
+
 // updateSearchQuery updates the search query and filters namespaces
 func (m *AppModel) updateSearchQuery(query string) {
 	m.searchQuery = query
@@ -700,37 +1373,99 @@ func (m *AppModel) updateSearchQuery(query string) {
 }
 
 // performFuzzySearch performs fuzzy search and returns filtered namespace list
-func (m *AppModel) performFuzzySearch(query string) []string {
-	// Convert string slice to Namespace slice for fuzzy matching
-	namespaces := make([]k8s.Namespace, len(m.namespaces))
-	for i, ns := range m.namespaces {
-		namespaces[i] = k8s.Namespace{Name: ns}
-	}
-
-	// Perform fuzzy search
-	matches := search.FuzzyMatch(query, namespaces)
+func (m *AppModel) performFuzzySearch(query string) []k8s.Namespace {
+	matches := search.FuzzyMatch(query, m.namespaces)
 
-	// Convert matches back to string slice
-	results := make([]string, len(matches))
+	results := make([]k8s.Namespace, len(matches))
 	for i, match := range matches {
-		results[i] = match.Namespace.Name
+		results[i] = match.Namespace
 	}
 
 	return results
 }
 
+// selectContext switches kubectl to the context at index and loads its namespaces
+func (m AppModel) selectContext(index int) (tea.Model, tea.Cmd) {
+	m.selectedContextIndex = index
+	selectedCtx := &m.contexts[index]
+
+	// Remember where we were in the previous context
+	m.recordState()
+	m.cacheContextState()
+
+	// Switch kubectl context before transitioning to namespace view
+	if err := m.kubeAdapter.SwitchContext(selectedCtx.Name); err != nil {
+		// Show error modal if context switch fails
+		m.showError(
+			fmt.Sprintf("Failed to switch kubectl context: %s", err.Error()),
+			err,
+			"Context Switch",
+			"",
+			nil,
+		)
+		return m, nil
+	}
+
+	// Context switched successfully - proceed with existing logic
+	// Hooks and the credential check run in the background; a failing hook only shows a warning
+	hooks := tea.Batch(m.contextHooksCmd(m.currentContext, *selectedCtx), m.checkAuthCmd(selectedCtx.Name), m.prefetchFavoritesCmd(selectedCtx.Name))
+	if m.currentContext == nil || m.currentContext.Name != selectedCtx.Name {
+		m.hookWarning = ""
+	}
+	m.currentContext = selectedCtx
+	m.jumpNamespace = ""
+	// Results still in flight belong to the previous context
+	m.requests.cancel(asyncNamespaces, asyncPods, asyncResources, asyncGitOps, asyncDescribe, asyncYAML, asyncConfigData, asyncRestarts)
+	m.viewMode = viewModeNamespaceView
+	m.resources = nil
+	// Load global and per-context actions (Story 6.2)
+	m.actions = config.MergeActions(m.config.Actions, selectedCtx.Actions)
+	m.actionTag = ""
+
+	// Previously visited contexts come back instantly; their pods refresh in the background
+	if m.restoreContextState(selectedCtx.Name) {
+		m.saveState()
+		hooks = tea.Batch(hooks, m.loadPodCounts())
+		if m.currentNamespace == "" {
+			return m, hooks
+		}
+		return m, tea.Batch(hooks, m.loadPods(), m.startResourceFetch())
+	}
+
+	// Drop the previous context's namespace when switching from the namespace view
+	m.currentNamespace = ""
+	m.pods = nil
+	m.selectedPodIndex = -1
+	m.podScrollOffset = 0
+	m.saveState()
+	// Bug Fix (Story 7.5): Don't reset namespace cursor - preserve position
+	// m.selectedNamespaceIndex = 0 // REMOVED - preserve cursor position
+	m.namespaceViewportStart = 0 // Reset viewport position
+	m.namespaces = nil           // Clear previous namespaces
+	// Story 5.3: Clear favorites (will be loaded with namespaces)
+	m.favoriteNamespaces = nil
+	m.restartStorms = nil
+	m.podCounts = nil
+	return m, tea.Batch(hooks, m.loadNamespaces())
+}
+
+// selectNamespace makes namespace current and fetches its pods
+func (m AppModel) selectNamespace(namespace string) (tea.Model, tea.Cmd) {
+	m.currentNamespace = namespace
+	m.pods = nil
+	// Reset pod panel state (Story 6.2)
+	m.selectedPodIndex = -1
+	m.podScrollOffset = 0
+	// QA Fix: Auto-switch focus to pods panel after namespace selection
+	m.focusedPanel = PanelPods
+	m.saveState()
+	return m, tea.Batch(m.loadPods(), m.startResourceFetch())
+}
+
 // adjustPodScrollOffset adjusts the pod scroll offset based on selected pod index (Story 3.3)
 func (m *AppModel) adjustPodScrollOffset() {
 	// Calculate visible window size based on pod panel height
-	// Pod panel height is roughly half the available height
-	availableHeight := m.termHeight - HeaderHeight
-	podPanelHeight := availableHeight / 2
-
-	// Reserve space for: border (2) + padding (2) + title (1) + blank (1) + help text (2) = 8 lines
-	visibleHeight := podPanelHeight - 8
-	if visibleHeight < 1 {
-		visibleHeight = 5 // Minimum visible items
-	}
+	visibleHeight := m.podListHeight(m.splitLayout().pods.h)
 
 	// Scroll down if selection below visible window
 	if m.selectedPodIndex >= m.podScrollOffset+visibleHeight {
@@ -750,8 +1485,9 @@ func (m *AppModel) adjustNamespaceViewport(listLength int) {
 	// renderNamespacePanel passes: contentHeight = termHeight - 4 (border+padding)
 	// to renderNamespaceList
 
-	// Get the panel height (full terminal height for namespace panel)
-	panelHeight := m.termHeight
+	// Get the panel height (full terminal height unless the actions bar is at the bottom)
+	layout := m.splitLayout()
+	panelHeight := layout.namespaces.h
 	if panelHeight == 0 {
 		panelHeight = 24 // Default for tests
 	}
@@ -763,7 +1499,7 @@ func (m *AppModel) adjustNamespaceViewport(listLength int) {
 	}
 
 	// Story 7.2 FIX (Iteration 7): Calculate exact search box height for viewport
-	panelWidth := m.termWidth / 2
+	panelWidth := layout.namespaces.w
 	if panelWidth == 0 {
 		panelWidth = 40
 	}
@@ -851,14 +1587,8 @@ func (m *AppModel) getMatchIndices(namespaceName string) []int {
 		return nil
 	}
 
-	// Convert string slice to Namespace slice
-	namespaces := make([]k8s.Namespace, len(m.namespaces))
-	for i, ns := range m.namespaces {
-		namespaces[i] = k8s.Namespace{Name: ns}
-	}
-
 	// Perform fuzzy search
-	matches := search.FuzzyMatch(m.searchQuery, namespaces)
+	matches := search.FuzzyMatch(m.searchQuery, m.namespaces)
 
 	// Find matching indices for this namespace
 	for _, match := range matches {
@@ -880,18 +1610,33 @@ func (m AppModel) handleActionExecution(action config.Action) (tea.Model, tea.Cm
 		return m, nil
 	}
 
-	if m.currentNamespace == "" {
+	if m.currentNamespace == "" && action.TargetScope() != config.ActionScopeContext {
 		m.errorModal.Show("No namespace selected", "Execute Action", nil)
 		return m, nil
 	}
 
+	if m.readOnlyBlocks(action) {
+		return m.showReadOnly(action)
+	}
+
+	// Namespace and context scoped actions run without a pod
+	if action.TargetScope() != config.ActionScopePod {
+		return m.handleScopedAction(action)
+	}
+
+	// Resource browser: run against the selected deployment/statefulset/job
+	if m.browsingResources() {
+		return m.handleResourceAction(action)
+	}
+
 	if len(m.pods) == 0 {
 		m.errorModal.Show("No pods available in namespace", "Execute Action", nil)
 		return m, nil
 	}
 
 	// Story 6.2: Check if pod is selected (cursor position = selection)
-	if m.selectedPodIndex == -1 {
+	selectedPod, ok := m.selectedPod()
+	if !ok {
 		m.errorModal.ShowWithSuggestion(
 			"No pod selected",
 			"Execute Action",
@@ -901,28 +1646,100 @@ func (m AppModel) handleActionExecution(action config.Action) (tea.Model, tea.Cm
 		return m, nil
 	}
 
-	// Get the selected pod
-	selectedPod := m.pods[m.selectedPodIndex]
+	if !action.AppliesToTarget(selectedPod.Name) {
+		return m.showNotApplicable(action, selectedPod.Name)
+	}
+
+	// Actions with params ask for their values first and come back here with them
+	if len(action.Params) > 0 && m.paramValues == nil {
+		return m.openParamForm(action)
+	}
 
-	// Prepare the local command using executor (Story 6.2: all actions are local now)
-	cmd, err := m.executor.PrepareLocal(action, *m.currentContext, m.currentNamespace, selectedPod, m.config.Kubeconfig)
+	// Render the local command using executor (Story 6.2: all actions are local now)
+	command, err := m.executor.Command(action, *m.currentContext, m.currentNamespace, k8s.PodResource(selectedPod), selectedPod, m.config.KubeconfigPath(m.currentContext.Name), m.paramValues)
 	if err != nil {
 		m.errorModal.Show(fmt.Sprintf("Action failed: %s", err.Error()), "Execute Action", nil)
 		return m, nil
 	}
 
+	return m.execAction(action, k8s.PodResource(selectedPod), command)
+}
+
+// execAction runs the rendered command of action against resource. It is rendered once so
+// that the audit record shows exactly what ran, {{freePort}} and {{timestamp}} included.
+// Destructive actions first ask the user to type the namespace name they run in.
+func (m AppModel) execAction(action config.Action, resource k8s.Resource, command string) (tea.Model, tea.Cmd) {
+	run := func() tea.Cmd {
+		record := m.auditRecord(action, resource, command)
+		if action.Output == config.ActionOutputCapture {
+			// Runs in the background with its output paged inside the TUI
+			return m.startCaptureCmd(action, resource, record)
+		}
+		if action.Background {
+			// Runs detached from the terminal, tracked in the jobs view
+			return m.startJobCmd(action, resource, record)
+		}
+
+		cmd := m.executor.PrepareRendered(action, command, *m.currentContext, m.currentNamespace, resource, m.config.KubeconfigPath(m.currentContext.Name))
+		return m.execInTerminal(action.Name, cmd, record, action.CaptureVar)
+	}
+
+	if !action.Destructive {
+		return m, run()
+	}
+
+	slog.Info("destructive action awaiting confirmation", "action", action.Name, "context", m.currentContext.Name, "namespace", m.currentNamespace)
+	if action.TargetScope() == config.ActionScopeContext {
+		// Context scoped actions do not run in a namespace: type the context name instead
+		m.confirm.Show(
+			"Destructive action: "+action.Name,
+			fmt.Sprintf("This runs against context %s.", m.currentContext.Name),
+			m.currentContext.Name,
+			run,
+		)
+		return m, nil
+	}
+	m.confirm.Show(
+		"Destructive action: "+action.Name,
+		fmt.Sprintf("This runs in namespace %s of context %s.", m.currentNamespace, m.currentContext.Name),
+		m.currentNamespace,
+		run,
+	)
+	return m, nil
+}
+
+// execInTerminal hands the terminal to cmd until it exits; its outcome completes record in an
+// execFinishedMsg, along with its duration, the end of its stderr and a copy of cmd to run it again.
+// With captureVar set, its stdout is kept for that session variable.
+func (m AppModel) execInTerminal(name string, cmd *exec.Cmd, record audit.Record, captureVar string) tea.Cmd {
 	// Story 6.3: Start action spinner before executing
-	m.actionSpinner.Start(fmt.Sprintf("Executing %s...", action.Name))
+	m.actionSpinner.Start(fmt.Sprintf("Executing %s...", name))
+	m.running.start(name, cmd)
+	rerun := executor.Clone(cmd)
+	run := executor.TrackRun(cmd)
+	var capture *executor.Capture
+	if captureVar != "" {
+		capture = executor.CaptureStdout(cmd, captureVar)
+	}
 
 	// Use tea.ExecProcess to suspend TUI and run command
 	// This gives full terminal control to the command
-	return m, tea.ExecProcess(cmd, func(err error) tea.Msg {
-		return execFinishedMsg{err: err}
+	return tea.ExecProcess(cmd, func(err error) tea.Msg {
+		run.Finish()
+		return execFinishedMsg{err: err, record: record, run: run, rerun: rerun, capture: capture}
 	})
 }
 
-// View renders the UI based on the current model state
+// View renders the UI based on the current model state, with the debug overlay when enabled,
+// in ASCII when the render mode asks for it. A panic writes a crash report before Bubble Tea
+// restores the terminal.
 func (m AppModel) View() string {
+	defer m.recoverCrash(nil)
+	return m.asciiView(m.withDebugOverlay(m.view()))
+}
+
+// view renders the screen of the current view mode
+func (m AppModel) view() string {
 	// If there's an error, display it
 	if m.err != nil {
 		return styles.ErrorStyle.Render(
@@ -930,12 +1747,58 @@ func (m AppModel) View() string {
 				"Press 'q' or ESC to quit",
 		)
 	}
+	if m.kubectlMissing != nil {
+		return m.renderKubectlSetup()
+	}
+
+	if len(m.whatsNew) > 0 {
+		return m.renderWhatsNew()
+	}
+
+	if m.authStatus != nil {
+		return m.renderAuthModal()
+	}
 
 	// Render based on current view mode
 	if m.viewMode == viewModeContextSelection {
 		return m.renderContextList()
 	}
 
+	if m.viewMode == viewModeSettings {
+		return m.renderSettings()
+	}
+
+	if m.viewMode == viewModeGitOps {
+		return m.renderGitOps()
+	}
+
+	if m.viewMode == viewModeNetwork {
+		return m.renderNetwork()
+	}
+
+	if m.viewMode == viewModePalette {
+		return m.renderPalette()
+	}
+
+	if m.viewMode == viewModeDescribe || m.viewMode == viewModeYAML || m.viewMode == viewModeOutput || m.viewMode == viewModeConfigData || m.viewMode == viewModeHelp || m.viewMode == viewModeStderr {
+		if m.confirm != nil && m.confirm.IsVisible {
+			return m.confirm.View()
+		}
+		return m.pager.View()
+	}
+
+	if m.viewMode == viewModeActionPicker {
+		return m.renderActionPicker()
+	}
+
+	if m.viewMode == viewModeHistory {
+		return m.renderHistory()
+	}
+
+	if m.viewMode == viewModeJobs {
+		return m.renderJobs()
+	}
+
 	if m.viewMode == viewModeNamespaceView {
 		// Check terminal size before rendering
 		if m.terminalTooSmall {
@@ -966,6 +1829,45 @@ func (m AppModel) renderBasicLayout() string {
 	return content
 }
 
+// namespaceListLayout returns the search box width, the lines reserved for the search area
+// and the number of namespace rows that fit in a namespace list of the given height
+func (m AppModel) namespaceListLayout(effectiveHeight int) (searchBoxWidth, fixedSearchBoxLines, availableHeight int) {
+	// We need to know the height BEFORE rendering to calculate padding correctly
+	panelWidth := m.splitLayout().namespaces.w
+	if panelWidth == 0 {
+		panelWidth = 40 // Default for tests
+	}
+	contentWidth := panelWidth - 6           // Subtract panel border (2) + padding (4)
+	searchBoxWidth = (contentWidth * 7) / 10 // 70% of content width
+	if searchBoxWidth < 20 {
+		searchBoxWidth = 20
+	}
+	if searchBoxWidth > 50 {
+		searchBoxWidth = 50
+	}
+
+	// Measure exact height of search box with border
+	sampleBox := styles.SearchBoxStyle.Width(searchBoxWidth).Render("_")
+	searchBoxHeight := lipgloss.Height(sampleBox) // Usually 3 (top border + content + bottom border)
+
+	// Calculate total search area height: blank line + label + searchBox
+	fixedSearchBoxLines = 1 + 1 + searchBoxHeight // blank + label + box (with border)
+
+	headerLines := 2
+	footerLines := 2          // blank line + footer text
+	scrollIndicatorLines := 1 // will be shown if list > available
+
+	// Reserve space for fixed UI elements
+	reservedLines := headerLines + footerLines + fixedSearchBoxLines
+
+	// Calculate available space for namespace items
+	availableHeight = effectiveHeight - reservedLines - scrollIndicatorLines
+	if availableHeight < 1 {
+		availableHeight = 1 // Minimum: show at least 1 namespace
+	}
+	return searchBoxWidth, fixedSearchBoxLines, availableHeight
+}
+
 // renderNamespaceList renders the namespace list panel
 // panelHeight is the actual height available for the namespace list (0 = use m.height)
 func (m AppModel) renderNamespaceList(panelHeight int) string {
@@ -981,20 +1883,25 @@ func (m AppModel) renderNamespaceList(panelHeight int) string {
 		effectiveHeight = 20
 	}
 
-	// Determine which list to render
-	renderList := m.namespaces
-	listCount := len(m.namespaces)
-	if m.searchMode && m.filteredNamespaces != nil {
-		renderList = m.filteredNamespaces
-		listCount = len(m.filteredNamespaces)
-	}
+	// Determine which list to render: the search matches, or the namespaces with their groups
+	renderList := m.namespaceRows()
+	listCount := len(renderList)
 
 	// Header with namespace count
 	header := styles.TitleStyle.Render(fmt.Sprintf("Namespaces (%d)", len(m.namespaces)))
 	if m.currentContext != nil {
 		header += styles.DimStyle.Render(fmt.Sprintf(" - %s", m.currentContext.Name))
+		header += readOnlyBadge(*m.currentContext)
+		header += m.credentialBadge(m.currentContext.Name)
+		header += m.throttleBadge(m.currentContext.Name)
+		header += m.namespaceFallbackBadge()
 	}
-	s += header + "\n\n"
+	// A failed context hook is shown in place of the blank line under the header
+	warningWidth := m.splitLayout().namespaces.w - 6 // Panel border (2) + padding (4)
+	if warningWidth <= 0 {
+		warningWidth = 34 // Default for tests
+	}
+	s += header + "\n" + m.hookWarningLine(warningWidth) + "\n"
 
 	// Show loading indicator (Story 6.3: use spinner)
 	if m.namespacesLoading {
@@ -1002,7 +1909,7 @@ func (m AppModel) renderNamespaceList(panelHeight int) string {
 		if spinnerView != "" {
 			s += spinnerView + "\n"
 		} else {
-			s += styles.DimStyle.Render("Loading namespaces...") + "\n"
+			s += styles.DimStyle.Render(m.loadingMessage(config.LoadingNamespaces)) + "\n"
 		}
 		s += "\n"
 		s += styles.DimStyle.Render("ESC/q: Quit")
@@ -1019,40 +1926,12 @@ func (m AppModel) renderNamespaceList(panelHeight int) string {
 	}
 
 	// Story 7.2 FIX (Iteration 7): Pre-calculate search box height to reserve correct space
-	// We need to know the height BEFORE rendering to calculate padding correctly
-	panelWidth := m.termWidth / 2
-	if panelWidth == 0 {
-		panelWidth = 40 // Default for tests
-	}
-	contentWidth := panelWidth - 6            // Subtract panel border (2) + padding (4)
-	searchBoxWidth := (contentWidth * 7) / 10 // 70% of content width
-	if searchBoxWidth < 20 {
-		searchBoxWidth = 20
-	}
-	if searchBoxWidth > 50 {
-		searchBoxWidth = 50
-	}
-
-	// Measure exact height of search box with border
-	sampleBox := styles.SearchBoxStyle.Width(searchBoxWidth).Render("_")
-	searchBoxHeight := lipgloss.Height(sampleBox) // Usually 3 (top border + content + bottom border)
-
-	// Calculate total search area height: blank line + label + searchBox
-	fixedSearchBoxLines := 1 + 1 + searchBoxHeight // blank + label + box (with border)
+	searchBoxWidth, fixedSearchBoxLines, availableHeight := m.namespaceListLayout(effectiveHeight)
 
 	// Calculate viewport layout constants (used for all rendering paths)
 	headerLines := 2
-	footerLines := 2          // blank line + footer text
-	scrollIndicatorLines := 1 // will be shown if list > available
-
-	// Reserve space for fixed UI elements
-	reservedLines := headerLines + footerLines + fixedSearchBoxLines
-
-	// Calculate available space for namespace items
-	availableHeight := effectiveHeight - reservedLines - scrollIndicatorLines
-	if availableHeight < 1 {
-		availableHeight = 1 // Minimum: show at least 1 namespace
-	}
+	footerLines := 2 // blank line + footer text
+	scrollIndicatorLines := 1
 
 	// Render namespace list
 	var linesUsed int
@@ -1075,7 +1954,6 @@ func (m AppModel) renderNamespaceList(panelHeight int) string {
 		// DEBUG: Log to see actual values
 		slog.Debug("viewport calculation",
 			"effectiveHeight", effectiveHeight,
-			"reservedLines", reservedLines,
 			"scrollIndicatorLines", scrollIndicatorLines,
 			"availableHeight", availableHeight,
 			"listCount", listCount)
@@ -1094,20 +1972,28 @@ func (m AppModel) renderNamespaceList(panelHeight int) string {
 
 		// Render visible namespaces
 		for i := start; i < end; i++ {
-			ns := renderList[i]
-			prefix := "  "
-			if i == m.selectedNamespaceIndex {
-				prefix = "> "
+			row := renderList[i]
+			if row.isGroup() {
+				s += m.renderNamespaceGroup(row, i == m.selectedNamespaceIndex) + "\n"
+				continue
+			}
+			namespace := row.namespace
+			ns := namespace.Name
+			// Story 6.1: favorites are marked by color, plus appearance.favorite if configured
+			prefix := m.cursorMarker(i == m.selectedNamespaceIndex) + m.favoriteMarker(favSet[ns])
+			if row.nested {
+				prefix += "  "
 			}
-
-			// Story 6.1: No star icon for favorites (removed)
 
 			// Story 6.1: Apply selection or favorite styling
 			// BUG FIX: Selected namespace should render with one style on entire line (no highlight)
 			if i == m.selectedNamespaceIndex {
 				// Selected item gets selection style (highest priority)
 				// Render without highlight to avoid style conflicts
-				s += styles.SelectedStyle.Render(prefix+ns) + "\n"
+				s += m.selectionStyle(styles.SelectedStyle).Render(prefix+ns) + namespaceStatusBadge(namespace) + m.podCountBadge(ns) + m.restartBadge(ns) + "\n"
+			} else if namespace.Terminating() {
+				// Namespaces being deleted are dimmed in red, whatever else applies
+				s += styles.TerminatingNamespaceStyle.Render(prefix+ns) + namespaceStatusBadge(namespace) + m.podCountBadge(ns) + m.restartBadge(ns) + "\n"
 			} else {
 				// For non-selected items: apply highlight first (if in search mode), then favorite styling
 				var renderedName string
@@ -1119,10 +2005,10 @@ func (m AppModel) renderNamespaceList(panelHeight int) string {
 
 				if favSet[ns] {
 					// Favorite namespace gets color highlight (Story 6.1)
-					s += styles.FavoriteNamespaceStyle.Render(renderedName) + "\n"
+					s += styles.FavoriteNamespaceStyle.Render(renderedName) + m.podCountBadge(ns) + m.restartBadge(ns) + "\n"
 				} else {
 					// Regular namespace - no special styling
-					s += renderedName + "\n"
+					s += renderedName + m.podCountBadge(ns) + m.restartBadge(ns) + "\n"
 				}
 			}
 		}
@@ -1225,33 +2111,68 @@ func (m AppModel) renderContextList() string {
 	var content string
 
 	// Header
+	if m.tabBarHeight() > 0 {
+		content += m.renderTabBar() + "\n"
+	}
 	header := styles.TitleStyle.Render("Select Kubernetes Context")
-	content += header + "\n\n"
+	content += header + "\n"
+	if progress := m.startupProgress(); progress != "" {
+		content += progress + "\n"
+	}
+	if progress := m.prefetchProgress(); progress != "" {
+		content += progress + "\n"
+	}
+	if warning := m.hookWarningLine(m.termWidth); warning != "" {
+		content += warning + "\n"
+	}
+	content += "\n"
 
-	// Context list
+	// Context list, grouped under headers (contexts are ordered by group)
 	for i, ctx := range m.contexts {
-		// Determine if this context is selected
-		prefix := "  "
-		if i == m.selectedContextIndex {
-			prefix = "> "
+		if ctx.Group != "" && (i == 0 || m.contexts[i-1].Group != ctx.Group) {
+			if i > 0 {
+				content += "\n"
+			}
+			content += styles.GroupHeaderStyle.Render(ctx.Group) + "\n"
 		}
 
-		// After Story 5.1: FavoriteNamespaces moved to Config.Favorites
-		// Namespace count display temporarily disabled
-		namespaceCount := ""
+		// Determine if this context is selected
+		prefix := m.cursorMarker(i == m.selectedContextIndex)
+
+		// Namespace count once prefetched (prefetch_namespaces), after the health of the context
+		namespaceCount := m.healthBadge(ctx.Name) + m.prefetchBadge(ctx.Name)
+
+		// Credential expiry countdown and throttling indicator for the context (if any)
+		badge := readOnlyBadge(ctx) + m.credentialBadge(ctx.Name) + m.throttleBadge(ctx.Name)
+		if env := envBadge(ctx.Env); env != "" {
+			badge = " " + env + badge
+		}
 
 		// Render context line with appropriate styling
 		if i == m.selectedContextIndex {
-			content += styles.SelectedStyle.Render(prefix+ctx.Name) + namespaceCount + "\n"
+			content += m.selectionStyle(styles.SelectedStyle).Render(prefix+ctx.Name) + namespaceCount + badge + "\n"
 		} else {
-			content += styles.NormalStyle.Render(prefix+ctx.Name) + namespaceCount + "\n"
+			content += styles.NormalStyle.Render(prefix+ctx.Name) + namespaceCount + badge + "\n"
+		}
+	}
+
+	// Why the selected context failed its health probe
+	if m.selectedContextIndex < len(m.contexts) {
+		if detail := m.healthDetail(m.contexts[m.selectedContextIndex].Name, max(m.termWidth-10, 20)); detail != "" {
+			content += "\n" + detail + "\n"
 		}
 	}
 
 	// Footer with key hints
 	content += "\n"
-	footer := styles.DimStyle.Render("↑/↓ Navigate | Enter: Select | ESC/q: Quit")
-	content += footer
+	footer := "↑/↓ Navigate | Enter: Select | ESC/q: Quit"
+	if m.currentContext != nil {
+		footer = "↑/↓ Navigate | Enter: Select | ESC: Back | q: Quit"
+	}
+	if m.health != nil {
+		footer += " | " + firstKey(m.keys.Refresh) + ": Recheck"
+	}
+	content += styles.DimStyle.Render(footer)
 
 	// Story 7.1: Apply border style (similar to executor context box)
 	dialogStyle := lipgloss.NewStyle().
@@ -1273,25 +2194,31 @@ func (m AppModel) renderContextList() string {
 
 // renderSplitLayout renders the split-pane layout with header, namespace, pods, and actions panels
 func (m AppModel) renderSplitLayout() string {
-	// Calculate dimensions (no header, use full height)
-	availableHeight := m.termHeight
-	leftWidth := m.termWidth / 2
-	rightWidth := m.termWidth - leftWidth
-	rightTopHeight := availableHeight / 2
-	rightBottomHeight := availableHeight - rightTopHeight
+	// Panel sizes depend on the configured actions placement and the optional header
+	fullLayout := m.renderPanels(m.splitLayout())
+	if m.showHeader() {
+		fullLayout = m.renderHeader() + "\n" + fullLayout
+	}
+	if m.tabBarHeight() > 0 {
+		fullLayout = m.renderTabBar() + "\n" + fullLayout
+	}
+	if bar := m.statusBar.View(m.termWidth); bar != "" {
+		fullLayout += "\n" + bar
+	}
 
-	// Render panels
-	namespacePanel := m.renderNamespacePanel(leftWidth, availableHeight)
-	podPanel := m.renderPodPanel(rightWidth, rightTopHeight)
-	actionsPanel := m.renderActionsPanel(rightWidth, rightBottomHeight)
+	// Story 6.3: Removed error bar at bottom - errors now shown via modal
 
-	// Compose layout: combine right panels vertically
-	rightSide := lipgloss.JoinVertical(lipgloss.Left, podPanel, actionsPanel)
+	if m.paramForm != nil && m.paramForm.IsVisible {
+		return m.paramForm.View()
+	}
 
-	// Combine left and right panels horizontally (no header)
-	fullLayout := lipgloss.JoinHorizontal(lipgloss.Top, namespacePanel, rightSide)
+	if m.namespaceForm != nil && m.namespaceForm.IsVisible {
+		return m.namespaceForm.View()
+	}
 
-	// Story 6.3: Removed error bar at bottom - errors now shown via modal
+	if m.confirm != nil && m.confirm.IsVisible {
+		return m.confirm.View()
+	}
 
 	// Story 6.3: Render error modal overlay on top of everything
 	if m.errorModal.IsVisible {
@@ -1305,16 +2232,6 @@ func (m AppModel) renderSplitLayout() string {
 	return fullLayout
 }
 
-// renderHeader renders the header bar with context name
-func (m AppModel) renderHeader() string {
-	contextName := "None"
-	if m.currentContext != nil {
-		contextName = m.currentContext.Name
-	}
-	text := fmt.Sprintf("Context: %s", contextName)
-	return styles.HeaderStyle.Width(m.termWidth).Render(text)
-}
-
 // renderNamespacePanel renders the namespace list panel with borders
 func (m AppModel) renderNamespacePanel(width, height int) string {
 	// PanelBorderStyle has Padding(1, 2) and Border (adds 2 lines vertically, 2 chars horizontally)
@@ -1341,8 +2258,8 @@ func (m AppModel) renderNamespacePanel(width, height int) string {
 		}
 	}
 
-	// Width calculation: Lip Gloss adds border (2) + padding (4) = 6 chars total
-	contentWidth := width - 6
+	// Width calculation: Lip Gloss Width includes the padding, the border (2) is added on top
+	contentWidth := width - 2
 
 	// Select border style based on focus (Story 3.3)
 	borderStyle := styles.UnfocusedPanelBorderStyle
@@ -1359,7 +2276,31 @@ func (m AppModel) renderNamespacePanel(width, height int) string {
 
 // renderPodPanel renders the pod panel with real data, loading, or error states
 func (m AppModel) renderPodPanel(width, height int) string {
-	title := styles.PanelTitleStyle.Render("Pods")
+	// Resource browser shows other kinds in place of pods
+	if m.browsingResources() {
+		return m.renderResourcePanel(width, height)
+	}
+
+	settings := " sort: " + m.podSort.String()
+	if m.podFilter != PodFilterAll {
+		settings += " filter: " + m.podFilter.String()
+	}
+	if m.podSelector != "" {
+		settings += fmt.Sprintf(" -l %s (%s: clear)", m.podSelector, firstKey(m.keys.ClearSelector))
+	}
+	// The status summary gives way to the sort, filter and selector in narrow panels
+	name := "Pods"
+	if !m.podsLoading && m.podsError == nil && len(m.pods) > 0 {
+		summary := name + " (" + podStatusSummary(m.pods) + ")"
+		name = truncateWidth(summary, max(width-8-lipgloss.Width(settings), len(name)))
+	}
+	title := lipgloss.JoinHorizontal(lipgloss.Top,
+		styles.PanelTitleStyle.Render(name),
+		styles.DimStyle.Render(settings))
+
+	// Text width inside the border (2) and horizontal padding (2*2)
+	now := time.Now()
+	layout := m.podColumnLayout(m.podList(), width-6, now)
 
 	var content string
 
@@ -1369,7 +2310,7 @@ func (m AppModel) renderPodPanel(width, height int) string {
 		if spinnerView != "" {
 			content = spinnerView
 		} else {
-			content = styles.LoadingStyle.Render("Loading pods...")
+			content = styles.LoadingStyle.Render(m.loadingMessage(config.LoadingPods))
 		}
 	} else if m.podsError != nil {
 		// Error state
@@ -1378,19 +2319,28 @@ func (m AppModel) renderPodPanel(width, height int) string {
 	} else if m.currentNamespace == "" {
 		// No namespace selected
 		content = styles.PlaceholderStyle.Render("Select a namespace to view pods")
+	} else if len(m.pods) == 0 && m.podSelector != "" {
+		// The label selector matched nothing
+		content = styles.PlaceholderStyle.Render("No pods match -l " + m.podSelector)
 	} else if len(m.pods) == 0 {
 		// Empty state
 		content = styles.PlaceholderStyle.Render("No pods in this namespace")
+	} else if len(m.podList()) == 0 && !m.podSearchMode {
+		// The status filter hides every pod
+		content = styles.PlaceholderStyle.Render(fmt.Sprintf("No %s pods (%s: next filter)", m.podFilter, firstKey(m.keys.PodFilter)))
+	} else if len(m.podList()) == 0 {
+		// Pod search matched nothing
+		content = styles.PlaceholderStyle.Render("No matching pods")
+		helpText := styles.HelpTextStyle.Render("Type to search | Backspace: Delete | ESC: Cancel")
+		content = lipgloss.JoinVertical(lipgloss.Left, content, "", helpText)
 	} else {
+		pods := m.podList()
+
 		// Calculate visible window for scrolling (Story 6.2)
-		// Reserve space for: border (2) + padding (2) + title (1) + blank (1) + help text (2) = 8 lines
-		visibleHeight := height - 8
-		if visibleHeight < 1 {
-			visibleHeight = 5 // Minimum visible items
-		}
+		visibleHeight := m.podListHeight(height)
 
 		// Calculate visible pod range
-		visiblePods := m.pods[m.podScrollOffset:]
+		visiblePods := pods[m.podScrollOffset:]
 		if len(visiblePods) > visibleHeight {
 			visiblePods = visiblePods[:visibleHeight]
 		}
@@ -1399,25 +2349,19 @@ func (m AppModel) renderPodPanel(width, height int) string {
 		var podLines []string
 		for i, pod := range visiblePods {
 			actualIndex := i + m.podScrollOffset
-			statusStyle := m.getPodStatusStyle(pod.Status)
-			statusText := statusStyle.Render(pod.Status)
 
 			// Build selection marker (Story 6.2: cursor position = pod selection)
-			var marker string
-			if actualIndex == m.selectedPodIndex {
-				marker = "> "
-			} else {
-				marker = "  "
-			}
+			marker := m.cursorMarker(actualIndex == m.selectedPodIndex)
 
-			// Apply styling
-			podName := pod.Name
+			// Apply styling (pod search highlights matched characters)
+			badge := m.podBadges(pod)
+			podName := m.renderPodNameWithHighlight(layout.podName(pod.Name, badge))
 			if actualIndex == m.selectedPodIndex {
 				// Selected pod gets special highlighting (Story 6.2: cursor = selection)
-				podName = styles.SelectedPodStyle.Render(podName)
+				podName = m.selectionStyle(styles.SelectedPodStyle).Render(podName)
 			}
 
-			line := fmt.Sprintf("%s%-12s %s", marker, statusText, podName)
+			line := marker + m.renderPodColumns(pod, layout, now) + podName + badge
 			podLines = append(podLines, line)
 		}
 		content = lipgloss.JoinVertical(lipgloss.Left, podLines...)
@@ -1427,18 +2371,46 @@ func (m AppModel) renderPodPanel(width, height int) string {
 			scrollUp := styles.HelpTextStyle.Render("↑ More above")
 			content = lipgloss.JoinVertical(lipgloss.Left, scrollUp, content)
 		}
-		if m.podScrollOffset+visibleHeight < len(m.pods) {
-			remaining := len(m.pods) - (m.podScrollOffset + visibleHeight)
+		if m.podScrollOffset+visibleHeight < len(pods) {
+			remaining := len(pods) - (m.podScrollOffset + visibleHeight)
 			scrollDown := styles.HelpTextStyle.Render(fmt.Sprintf("↓ %d more", remaining))
 			content = lipgloss.JoinVertical(lipgloss.Left, content, scrollDown)
+		} else if more := m.morePodsLine(); more != "" {
+			content = lipgloss.JoinVertical(lipgloss.Left, content, styles.HelpTextStyle.Render(more))
+		}
+
+		// Detail of the selected pod under the list
+		if m.showsPodDetail(height) {
+			content = lipgloss.JoinVertical(lipgloss.Left, content, m.renderPodDetail(width-6))
 		}
 
 		// Add help text (Story 6.2)
-		helpText := styles.HelpTextStyle.Render("↑/↓: Navigate | Enter: Select | Tab: Switch panel")
+		// Wrapped to the text width: wider lines would pad the rows above and make them wrap
+		helpStyle := styles.HelpTextStyle.Copy().Width(width - 6)
+		helpText := helpStyle.Render("↑/↓: Navigate | /: Search | Tab: Switch panel")
+		if m.podSearchMode {
+			helpText = helpStyle.Render("Type to search | ↑/↓: Navigate | Enter/ESC: Done")
+		}
+		if m.podSelectorEditing {
+			helpText = m.renderPodSelectorHelp(width - 6)
+		}
 		content = lipgloss.JoinVertical(lipgloss.Left, content, "", helpText)
 	}
+	if m.podSelectorEditing && (m.podsLoading || m.podsError != nil || len(m.podList()) == 0) {
+		// Without pods there is no help text the selector help could replace
+		content = lipgloss.JoinVertical(lipgloss.Left, content, "", m.renderPodSelectorHelp(width-6))
+	}
 
-	fullContent := lipgloss.JoinVertical(lipgloss.Left, title, "", content)
+	// Column headers sit under the title; pod search replaces them with the query
+	searchLine := ""
+	if m.podSelectorEditing {
+		searchLine = styles.SearchLabelStyle.Render("Label selector: ") + m.podSelectorDraft + "_"
+	} else if m.podSearchMode {
+		searchLine = styles.SearchLabelStyle.Render("Search: ") + m.podSearchQuery + "_"
+	} else if !m.podsLoading && m.podsError == nil && len(m.podList()) > 0 {
+		searchLine = m.renderPodColumnHeader(layout)
+	}
+	fullContent := lipgloss.JoinVertical(lipgloss.Left, title, searchLine, content)
 
 	// Select border style based on focus (Story 3.3)
 	borderStyle := styles.UnfocusedPanelBorderStyle
@@ -1447,7 +2419,7 @@ func (m AppModel) renderPodPanel(width, height int) string {
 	}
 
 	// Apply border style with calculated dimensions
-	contentWidth := width - 4   // 2 for border + 2*2 for padding
+	contentWidth := width - 2   // Width includes the padding; 2 for border
 	contentHeight := height - 2 // 2 for border
 
 	return borderStyle.
@@ -1470,54 +2442,58 @@ func (m AppModel) getPodStatusStyle(status string) lipgloss.Style {
 	}
 }
 
+// actionColumns splits the entries of an actions panel of the given height into its columns
+func actionColumns(actions []actionEntry, height int) [][]actionEntry {
+	// Story 7.4: Dynamic column layout based on HEIGHT, not width
+	// Reserve space for: border (2) + padding (2) + title (1) + blank (1) + help text (2) = 8 lines
+	contentHeight := height - 8
+	if contentHeight < 1 {
+		contentHeight = 1 // Minimum
+	}
+
+	// Calculate minimum columns needed based on available height
+	// If all actions fit in one column → use 1 column
+	// Otherwise calculate: ceil(totalActions / availableHeight)
+	columnCount := 1
+	if len(actions) > contentHeight {
+		// Need multiple columns
+		columnCount = (len(actions) + contentHeight - 1) / contentHeight
+	}
+
+	itemsPerColumn := (len(actions) + columnCount - 1) / columnCount
+
+	var columns [][]actionEntry
+	for start := 0; start < len(actions); start += itemsPerColumn {
+		end := min(start+itemsPerColumn, len(actions))
+		columns = append(columns, actions[start:end])
+	}
+	return columns
+}
+
 // renderActionsPanel renders the actions panel with multi-column layout (Story 6.2)
 func (m AppModel) renderActionsPanel(width, height int) string {
 	title := styles.PanelTitleStyle.Render("Actions")
+	if m.actionTag != "" {
+		title = lipgloss.JoinHorizontal(lipgloss.Top, title, styles.DimStyle.Render(" tag: "+m.actionTag))
+	}
+	if badge := m.runningJobsBadge(); badge != "" {
+		title = lipgloss.JoinHorizontal(lipgloss.Top, title, badge)
+	}
+	entries := m.actionEntries()
 
 	var content string
 
-	if len(m.actions) == 0 {
+	if len(entries) == 0 {
 		// Empty state
 		content = styles.PlaceholderStyle.Render("No actions configured")
 	} else {
-		// Story 7.4: Dynamic column layout based on HEIGHT, not width
-		// Reserve space for: border (2) + padding (2) + title (1) + blank (1) + help text (2) = 8 lines
-		contentHeight := height - 8
-		if contentHeight < 1 {
-			contentHeight = 1 // Minimum
-		}
-
-		// Calculate minimum columns needed based on available height
-		// If all actions fit in one column → use 1 column
-		// Otherwise calculate: ceil(totalActions / availableHeight)
-		columnCount := 1
-		if len(m.actions) > contentHeight {
-			// Need multiple columns
-			columnCount = (len(m.actions) + contentHeight - 1) / contentHeight
-		}
-
-		itemsPerColumn := (len(m.actions) + columnCount - 1) / columnCount
-
 		var columns []string
-		for col := 0; col < columnCount; col++ {
+		for _, column := range actionColumns(entries, height) {
 			var columnLines []string
-			start := col * itemsPerColumn
-			end := start + itemsPerColumn
-			if end > len(m.actions) {
-				end = len(m.actions)
-			}
-
-			for i := start; i < end; i++ {
-				action := m.actions[i]
-				shortcut := styles.ShortcutStyle.Render(fmt.Sprintf("[%s]", action.Shortcut))
-				actionName := styles.ActionStyle.Render(action.Name)
-				line := fmt.Sprintf("%s %s", shortcut, actionName)
-				columnLines = append(columnLines, line)
-			}
-
-			if len(columnLines) > 0 {
-				columns = append(columns, lipgloss.JoinVertical(lipgloss.Left, columnLines...))
+			for _, entry := range column {
+				columnLines = append(columnLines, m.renderActionEntry(entry))
 			}
+			columns = append(columns, lipgloss.JoinVertical(lipgloss.Left, columnLines...))
 		}
 
 		// Join columns with spacing between them (Story 7.4: add column spacing)
@@ -1539,7 +2515,22 @@ func (m AppModel) renderActionsPanel(width, height int) string {
 		}
 
 		// Add help text (Story 6.2)
-		helpText := styles.HelpTextStyle.Render("[key]: Execute action (works from any panel)")
+		extra := fmt.Sprintf(" | %s: Pick by name", firstKey(m.keys.ActionPicker))
+		if len(m.actionTags()) > 0 {
+			extra += fmt.Sprintf(" | %s: Filter by tag", firstKey(m.keys.ActionFilter))
+		}
+		// Keep the help on one line, shortening it in a narrow actions column
+		help := "[key]: Execute action (works from any panel)" + extra
+		if m.openActionGroup != "" {
+			help = "[key]: Execute " + m.openActionGroup + " action | Other keys: Cancel"
+		}
+		if lipgloss.Width(help) > width-6 {
+			help = "[key]: Execute" + extra
+		}
+		if lipgloss.Width(help) > width-6 {
+			help = "[key]: Execute"
+		}
+		helpText := styles.HelpTextStyle.Render(help)
 		content = lipgloss.JoinVertical(lipgloss.Left, content, "", helpText)
 	}
 
@@ -1549,7 +2540,7 @@ func (m AppModel) renderActionsPanel(width, height int) string {
 	borderStyle := styles.UnfocusedPanelBorderStyle
 
 	// Apply border style with calculated dimensions
-	contentWidth := width - 4   // 2 for border + 2*2 for padding
+	contentWidth := width - 2   // Width includes the padding; 2 for border
 	contentHeight := height - 2 // 2 for border
 
 	return borderStyle.
@@ -19,11 +19,11 @@ type mockKubeAdapter struct {
 	err        error
 }
 
-func (m *mockKubeAdapter) GetNamespaces(context string) ([]string, error) {
+func (m *mockKubeAdapter) GetNamespaces(context string) ([]k8s.Namespace, error) {
 	if m.err != nil {
 		return nil, m.err
 	}
-	return m.namespaces, nil
+	return k8s.NamespacesNamed(m.namespaces), nil
 }
 
 func (m *mockKubeAdapter) GetPods(context, namespace string) ([]k8s.Pod, error) {
@@ -583,7 +583,7 @@ func TestAppModel_NamespaceNavigation(t *testing.T) {
 			}
 			model := NewAppModel(cfg, newMockAdapter())
 			model.viewMode = viewModeNamespaceView
-			model.namespaces = []string{"default", "kube-system", "production", "staging"}
+			model.namespaces = k8s.NamespacesNamed([]string{"default", "kube-system", "production", "staging"})
 			model.selectedNamespaceIndex = tt.initialIndex
 
 			keyMsg := tea.KeyMsg{Type: tt.keyType}
@@ -607,8 +607,8 @@ func TestAppModel_NamespaceFetched(t *testing.T) {
 		model.namespacesLoading = true
 
 		msg := namespaceFetchedMsg{
-			namespaces: []string{"default", "kube-system", "production"},
-			err:        nil,
+			value: k8s.NamespacesNamed([]string{"default", "kube-system", "production"}),
+			err:   nil,
 		}
 		newModel, _ := model.Update(msg)
 		m := newModel.(AppModel)
@@ -616,9 +616,9 @@ func TestAppModel_NamespaceFetched(t *testing.T) {
 		assert.False(t, m.namespacesLoading, "loading should be false")
 		assert.Nil(t, m.namespacesError, "error should be nil")
 		assert.Len(t, m.namespaces, 3, "should have 3 namespaces")
-		assert.Contains(t, m.namespaces, "default")
-		assert.Contains(t, m.namespaces, "kube-system")
-		assert.Contains(t, m.namespaces, "production")
+		assert.Contains(t, k8s.NamespaceNames(m.namespaces), "default")
+		assert.Contains(t, k8s.NamespaceNames(m.namespaces), "kube-system")
+		assert.Contains(t, k8s.NamespaceNames(m.namespaces), "production")
 	})
 
 	t.Run("failed namespace fetch", func(t *testing.T) {
@@ -632,8 +632,8 @@ func TestAppModel_NamespaceFetched(t *testing.T) {
 
 		testErr := &testError{msg: "connection timeout"}
 		msg := namespaceFetchedMsg{
-			namespaces: nil,
-			err:        testErr,
+			value: nil,
+			err:   testErr,
 		}
 		newModel, _ := model.Update(msg)
 		m := newModel.(AppModel)
@@ -651,34 +651,34 @@ func TestAppModel_SortNamespacesWithFavorites(t *testing.T) {
 		namespaces := []string{"default", "kube-system", "production", "staging", "dev"}
 		favorites := []string{"production", "staging"}
 
-		sorted := model.sortNamespacesWithFavorites(namespaces, favorites)
+		sorted := model.sortNamespacesWithFavorites(k8s.NamespacesNamed(namespaces), favorites)
 
 		assert.Len(t, sorted, 5)
-		assert.Equal(t, "production", sorted[0], "first favorite should be first")
-		assert.Equal(t, "staging", sorted[1], "second favorite should be second")
-		assert.Contains(t, sorted[2:], "default", "non-favorites should follow")
-		assert.Contains(t, sorted[2:], "kube-system", "non-favorites should follow")
-		assert.Contains(t, sorted[2:], "dev", "non-favorites should follow")
+		assert.Equal(t, "production", sorted[0].Name, "first favorite should be first")
+		assert.Equal(t, "staging", sorted[1].Name, "second favorite should be second")
+		assert.Contains(t, k8s.NamespaceNames(sorted[2:]), "default", "non-favorites should follow")
+		assert.Contains(t, k8s.NamespaceNames(sorted[2:]), "kube-system", "non-favorites should follow")
+		assert.Contains(t, k8s.NamespaceNames(sorted[2:]), "dev", "non-favorites should follow")
 	})
 
 	t.Run("no favorites", func(t *testing.T) {
 		namespaces := []string{"default", "kube-system"}
 		favorites := []string{}
 
-		sorted := model.sortNamespacesWithFavorites(namespaces, favorites)
+		sorted := model.sortNamespacesWithFavorites(k8s.NamespacesNamed(namespaces), favorites)
 
-		assert.Equal(t, namespaces, sorted, "order should remain unchanged")
+		assert.Equal(t, namespaces, k8s.NamespaceNames(sorted), "order should remain unchanged")
 	})
 
 	t.Run("all favorites", func(t *testing.T) {
 		namespaces := []string{"default", "kube-system"}
 		favorites := []string{"default", "kube-system"}
 
-		sorted := model.sortNamespacesWithFavorites(namespaces, favorites)
+		sorted := model.sortNamespacesWithFavorites(k8s.NamespacesNamed(namespaces), favorites)
 
 		assert.Len(t, sorted, 2)
-		assert.Contains(t, sorted, "default")
-		assert.Contains(t, sorted, "kube-system")
+		assert.Contains(t, k8s.NamespaceNames(sorted), "default")
+		assert.Contains(t, k8s.NamespaceNames(sorted), "kube-system")
 	})
 }
 
@@ -728,7 +728,7 @@ func TestAppModel_RenderNamespaceList(t *testing.T) {
 		model.viewMode = viewModeNamespaceView
 		model.termWidth = 80
 		model.termHeight = 24
-		model.namespaces = []string{"production", "default", "kube-system"}
+		model.namespaces = k8s.NamespacesNamed([]string{"production", "default", "kube-system"})
 
 		view := model.View()
 
@@ -762,7 +762,7 @@ func TestFavoritesIntegration(t *testing.T) {
 
 		// Simulate namespace fetch
 		msg := namespaceFetchedMsg{
-			namespaces: []string{"app", "critical", "default", "monitoring"},
+			value: k8s.NamespacesNamed([]string{"app", "critical", "default", "monitoring"}),
 		}
 		updatedModel, _ := model.Update(msg)
 		m := updatedModel.(AppModel)
@@ -772,11 +772,11 @@ func TestFavoritesIntegration(t *testing.T) {
 
 		// Verify namespaces sorted with favorites first
 		require.Len(t, m.namespaces, 4)
-		assert.Equal(t, "critical", m.namespaces[0])
-		assert.Equal(t, "monitoring", m.namespaces[1])
+		assert.Equal(t, "critical", m.namespaces[0].Name)
+		assert.Equal(t, "monitoring", m.namespaces[1].Name)
 		// Regular namespaces should be after favorites
-		assert.Contains(t, m.namespaces[2:], "app")
-		assert.Contains(t, m.namespaces[2:], "default")
+		assert.Contains(t, k8s.NamespaceNames(m.namespaces[2:]), "app")
+		assert.Contains(t, k8s.NamespaceNames(m.namespaces[2:]), "default")
 	})
 
 	t.Run("global favorites format", func(t *testing.T) {
@@ -796,7 +796,7 @@ func TestFavoritesIntegration(t *testing.T) {
 
 		// Simulate namespace fetch
 		msg := namespaceFetchedMsg{
-			namespaces: []string{"app", "common-ns", "default", "shared-ns"},
+			value: k8s.NamespacesNamed([]string{"app", "common-ns", "default", "shared-ns"}),
 		}
 		updatedModel, _ := model.Update(msg)
 		m := updatedModel.(AppModel)
@@ -806,8 +806,8 @@ func TestFavoritesIntegration(t *testing.T) {
 
 		// Story 6.1: Verify namespaces preserve config order for favorites
 		require.Len(t, m.namespaces, 4)
-		assert.Equal(t, "shared-ns", m.namespaces[0], "first favorite should match config order")
-		assert.Equal(t, "common-ns", m.namespaces[1], "second favorite should match config order")
+		assert.Equal(t, "shared-ns", m.namespaces[0].Name, "first favorite should match config order")
+		assert.Equal(t, "common-ns", m.namespaces[1].Name, "second favorite should match config order")
 	})
 
 	t.Run("empty favorites list", func(t *testing.T) {
@@ -825,7 +825,7 @@ func TestFavoritesIntegration(t *testing.T) {
 		model.currentContext = &cfg.Contexts[0]
 
 		msg := namespaceFetchedMsg{
-			namespaces: []string{"default", "app"},
+			value: k8s.NamespacesNamed([]string{"default", "app"}),
 		}
 		updatedModel, _ := model.Update(msg)
 		m := updatedModel.(AppModel)
@@ -834,7 +834,7 @@ func TestFavoritesIntegration(t *testing.T) {
 		assert.Empty(t, m.favoriteNamespaces)
 
 		// Namespaces should still be sorted
-		assert.Equal(t, []string{"app", "default"}, m.namespaces)
+		assert.Equal(t, []string{"app", "default"}, k8s.NamespaceNames(m.namespaces))
 	})
 
 	t.Run("nil favorites", func(t *testing.T) {
@@ -852,7 +852,7 @@ func TestFavoritesIntegration(t *testing.T) {
 		model.currentContext = &cfg.Contexts[0]
 
 		msg := namespaceFetchedMsg{
-			namespaces: []string{"default", "app"},
+			value: k8s.NamespacesNamed([]string{"default", "app"}),
 		}
 		updatedModel, _ := model.Update(msg)
 		m := updatedModel.(AppModel)
@@ -874,47 +874,47 @@ func TestSortNamespacesWithFavorites(t *testing.T) {
 		namespaces := []string{"zeta", "alpha", "beta"}
 		favorites := []string{"zeta", "alpha"} // Config order: zeta first, alpha second
 
-		sorted := model.sortNamespacesWithFavorites(namespaces, favorites)
+		sorted := model.sortNamespacesWithFavorites(k8s.NamespacesNamed(namespaces), favorites)
 
 		// Story 6.1: Favorites should preserve config order (NOT sorted alphabetically)
-		assert.Equal(t, "zeta", sorted[0], "first favorite should match config order")
-		assert.Equal(t, "alpha", sorted[1], "second favorite should match config order")
+		assert.Equal(t, "zeta", sorted[0].Name, "first favorite should match config order")
+		assert.Equal(t, "alpha", sorted[1].Name, "second favorite should match config order")
 		// Last should be regular namespace
-		assert.Equal(t, "beta", sorted[2])
+		assert.Equal(t, "beta", sorted[2].Name)
 	})
 
 	t.Run("sorts regular namespaces alphabetically", func(t *testing.T) {
 		namespaces := []string{"zeta", "alpha", "beta", "gamma"}
 		favorites := []string{"alpha"}
 
-		sorted := model.sortNamespacesWithFavorites(namespaces, favorites)
+		sorted := model.sortNamespacesWithFavorites(k8s.NamespacesNamed(namespaces), favorites)
 
 		// First should be favorite
-		assert.Equal(t, "alpha", sorted[0])
+		assert.Equal(t, "alpha", sorted[0].Name)
 		// Rest should be sorted alphabetically
-		assert.Equal(t, "beta", sorted[1])
-		assert.Equal(t, "gamma", sorted[2])
-		assert.Equal(t, "zeta", sorted[3])
+		assert.Equal(t, "beta", sorted[1].Name)
+		assert.Equal(t, "gamma", sorted[2].Name)
+		assert.Equal(t, "zeta", sorted[3].Name)
 	})
 
 	t.Run("handles empty favorites", func(t *testing.T) {
 		namespaces := []string{"zeta", "alpha", "beta"}
 		favorites := []string{}
 
-		sorted := model.sortNamespacesWithFavorites(namespaces, favorites)
+		sorted := model.sortNamespacesWithFavorites(k8s.NamespacesNamed(namespaces), favorites)
 
 		// All should be sorted as regular namespaces
-		assert.Equal(t, []string{"alpha", "beta", "zeta"}, sorted)
+		assert.Equal(t, []string{"alpha", "beta", "zeta"}, k8s.NamespaceNames(sorted))
 	})
 
 	t.Run("handles all favorites", func(t *testing.T) {
 		namespaces := []string{"zeta", "alpha", "beta"}
 		favorites := []string{"zeta", "alpha", "beta"} // Config order
 
-		sorted := model.sortNamespacesWithFavorites(namespaces, favorites)
+		sorted := model.sortNamespacesWithFavorites(k8s.NamespacesNamed(namespaces), favorites)
 
 		// Story 6.1: All favorites should preserve config order
-		assert.Equal(t, []string{"zeta", "alpha", "beta"}, sorted, "all favorites should preserve config order")
+		assert.Equal(t, []string{"zeta", "alpha", "beta"}, k8s.NamespaceNames(sorted), "all favorites should preserve config order")
 	})
 }
 
@@ -934,7 +934,7 @@ func TestFavoritesDisplay(t *testing.T) {
 		model.viewMode = viewModeNamespaceView
 		model.termWidth = 80
 		model.termHeight = 24
-		model.namespaces = []string{"critical", "default"}
+		model.namespaces = k8s.NamespacesNamed([]string{"critical", "default"})
 		model.favoriteNamespaces = []string{"critical"}
 
 		view := model.View()
@@ -959,7 +959,7 @@ func TestFavoritesDisplay(t *testing.T) {
 		model.viewMode = viewModeNamespaceView
 		model.termWidth = 80
 		model.termHeight = 24
-		model.namespaces = []string{"critical", "default"}
+		model.namespaces = k8s.NamespacesNamed([]string{"critical", "default"})
 		model.favoriteNamespaces = []string{"critical"}
 
 		// Story 6.1: Visual separator between favorites and regular namespaces was removed
@@ -982,7 +982,7 @@ func TestFavoritesDisplay(t *testing.T) {
 		model.viewMode = viewModeNamespaceView
 		model.termWidth = 80
 		model.termHeight = 24
-		model.namespaces = []string{"default", "app"}
+		model.namespaces = k8s.NamespacesNamed([]string{"default", "app"})
 		model.favoriteNamespaces = []string{}
 
 		view := model.View()
@@ -1006,7 +1006,7 @@ func TestFavoritesDisplay(t *testing.T) {
 		model.viewMode = viewModeNamespaceView
 		model.termWidth = 80
 		model.termHeight = 24
-		model.namespaces = []string{"critical", "monitoring"}
+		model.namespaces = k8s.NamespacesNamed([]string{"critical", "monitoring"})
 		model.favoriteNamespaces = []string{"critical", "monitoring"}
 
 		view := model.View()
@@ -1063,7 +1063,7 @@ func TestContextSwitchingWithFavorites(t *testing.T) {
 
 		// Simulate namespace fetch for production context
 		msg := namespaceFetchedMsg{
-			namespaces: []string{"app", "critical", "default", "monitoring"},
+			value: k8s.NamespacesNamed([]string{"app", "critical", "default", "monitoring"}),
 		}
 		updatedModel, _ := model.Update(msg)
 		m := updatedModel.(AppModel)
@@ -0,0 +1,51 @@
+package tui
+
+import (
+	"strings"
+
+	"github.com/charmbracelet/lipgloss"
+	"github.com/maratkarimov/kubertino/internal/config"
+)
+
+// cursorMarker returns the configured cursor for the selected line, or blanks of the same
+// width so unselected lines stay aligned
+func (m AppModel) cursorMarker(selected bool) string {
+	cursor := m.config.CursorMarker()
+	if selected {
+		return cursor
+	}
+	return strings.Repeat(" ", lipgloss.Width(cursor))
+}
+
+// cursorWidth returns the number of cells taken by the cursor marker
+func (m AppModel) cursorWidth() int {
+	return lipgloss.Width(m.config.CursorMarker())
+}
+
+// selectionStyle returns the style for a selected line: base (a background color) by
+// default, or the text attributes set in appearance.selection
+func (m AppModel) selectionStyle(base lipgloss.Style) lipgloss.Style {
+	switch m.config.SelectionRendering() {
+	case config.SelectionBold:
+		return lipgloss.NewStyle().Bold(true)
+	case config.SelectionUnderline:
+		return lipgloss.NewStyle().Bold(true).Underline(true)
+	case config.SelectionNone:
+		return lipgloss.NewStyle()
+	}
+	return base
+}
+
+// favoriteMarker returns the configured favorite marker for a favorite namespace, blanks of
+// the same width for other namespaces, or "" when favorites are only colored. Without colors
+// favorites are marked with "* " unless a marker is configured.
+func (m AppModel) favoriteMarker(favorite bool) string {
+	marker := m.config.FavoriteMarker()
+	if marker == "" && m.renderMode.NoColor {
+		marker = "* "
+	}
+	if favorite {
+		return marker
+	}
+	return strings.Repeat(" ", lipgloss.Width(marker))
+}
@@ -0,0 +1,46 @@
+package tui
+
+import (
+	"testing"
+
+	"github.com/maratkarimov/kubertino/internal/config"
+	"github.com/maratkarimov/kubertino/internal/k8s"
+	"github.com/maratkarimov/kubertino/internal/tui/styles"
+	"github.com/stretchr/testify/assert"
+)
+
+func newAppearanceTestModel(appearance *config.Appearance) AppModel {
+	model := newTestModel(newMockAdapter(), withContexts(config.Context{Name: "dev"}), func(cfg *config.Config) {
+		cfg.Favorites = []interface{}{"staging"}
+		cfg.Appearance = appearance
+	})
+	updated, _ := model.Update(namespaceFetchedMsg{value: k8s.NamespacesNamed([]string{"default", "staging"})})
+	model = updated.(AppModel)
+	model.currentNamespace = "staging"
+	model.pods = []k8s.Pod{{Name: "web-1", Status: "Running"}, {Name: "web-2", Status: "Running"}}
+	model.selectedPodIndex = 0
+	return model
+}
+
+func TestAppearance_DefaultMarkers(t *testing.T) {
+	model := newAppearanceTestModel(nil)
+
+	assert.Equal(t, "> ", model.cursorMarker(true))
+	assert.Equal(t, "  ", model.cursorMarker(false))
+	assert.Equal(t, "", model.favoriteMarker(true), "favorites are only colored by default")
+	assert.Equal(t, styles.SelectedStyle, model.selectionStyle(styles.SelectedStyle))
+}
+
+func TestAppearance_CustomMarkers(t *testing.T) {
+	model := newAppearanceTestModel(&config.Appearance{Cursor: "▶ ", Selection: config.SelectionUnderline, Favorite: "★ "})
+
+	view := model.View()
+	assert.Contains(t, view, "▶ ★ staging", "selected favorite gets cursor and favorite marker")
+	assert.Contains(t, view, "    default", "other namespaces are padded to stay aligned")
+	assert.Contains(t, view, "▶ ", "pods use the configured cursor")
+	assert.Equal(t, "  ", model.cursorMarker(false), "blank marker matches the cursor width")
+
+	style := model.selectionStyle(styles.SelectedPodStyle)
+	assert.True(t, style.GetUnderline())
+	assert.NotEqual(t, styles.SelectedPodStyle.GetBackground(), style.GetBackground(), "no background color")
+}
@@ -0,0 +1,43 @@
+package tui
+
+import (
+	"fmt"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/maratkarimov/kubertino/internal/config"
+)
+
+// actionTarget returns the name of the selected pod, or of the selected resource while
+// browsing other kinds. Returns false when nothing is selected.
+func (m AppModel) actionTarget() (string, bool) {
+	if m.browsingResources() {
+		if m.selectedResourceIndex < 0 || m.selectedResourceIndex >= len(m.resources) {
+			return "", false
+		}
+		return m.resources[m.selectedResourceIndex].Name, true
+	}
+	pod, ok := m.selectedPod()
+	return pod.Name, ok
+}
+
+// actionApplies reports whether action applies to the current selection according to its
+// applies_to pattern. Every action applies while nothing is selected, and namespace and
+// context scoped actions apply whatever is selected.
+func (m AppModel) actionApplies(action config.Action) bool {
+	if action.TargetScope() != config.ActionScopePod {
+		return true
+	}
+	target, ok := m.actionTarget()
+	return !ok || action.AppliesToTarget(target)
+}
+
+// showNotApplicable explains why action cannot run against target
+func (m AppModel) showNotApplicable(action config.Action, target string) (tea.Model, tea.Cmd) {
+	m.errorModal.ShowWithSuggestion(
+		fmt.Sprintf("Action '%s' does not apply to %s", action.Name, target),
+		"Execute Action",
+		fmt.Sprintf("It only runs on names matching %s; select a matching one", action.AppliesTo),
+		nil,
+	)
+	return m, nil
+}
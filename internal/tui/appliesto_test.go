@@ -0,0 +1,65 @@
+package tui
+
+import (
+	"testing"
+
+	"github.com/maratkarimov/kubertino/internal/config"
+	"github.com/maratkarimov/kubertino/internal/k8s"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// newAppliesToTestModel returns a namespace view with a web and a worker pod
+func newAppliesToTestModel() AppModel {
+	model := newTestModel(newMockAdapter(), withContexts(config.Context{Name: "dev"}), withActions(
+		config.Action{Name: "Logs", Shortcut: "l", Command: "echo {{.pod}}"},
+		config.Action{Name: "Rails Console", Shortcut: "c", Command: "echo console {{.pod}}", AppliesTo: "^web-"},
+	))
+	model.currentNamespace = "app"
+	model.pods = []k8s.Pod{{Name: "web-1", Status: "Running"}, {Name: "worker-1", Status: "Running"}}
+	model.selectedPodIndex = 0
+	model.focusedPanel = PanelPods
+	return model
+}
+
+func TestAppliesTo_RunsOnMatchingPod(t *testing.T) {
+	model := newAppliesToTestModel()
+	assert.True(t, model.actionApplies(model.actions[1]))
+
+	updated, cmd := model.Update(runeKey('c'))
+	model = updated.(AppModel)
+	assert.NotNil(t, cmd)
+	assert.False(t, model.errorModal.IsVisible)
+}
+
+func TestAppliesTo_BlocksOtherPods(t *testing.T) {
+	model := newAppliesToTestModel()
+	model.selectedPodIndex = 1
+	assert.False(t, model.actionApplies(model.actions[1]))
+	assert.True(t, model.actionApplies(model.actions[0]), "actions without applies_to apply everywhere")
+
+	updated, cmd := model.Update(runeKey('c'))
+	model = updated.(AppModel)
+	assert.Nil(t, cmd)
+	require.True(t, model.errorModal.IsVisible)
+	assert.Contains(t, model.errorModal.View(), "does not apply to worker-1")
+	assert.Contains(t, model.errorModal.View(), "^web-")
+}
+
+func TestAppliesTo_ResourceNames(t *testing.T) {
+	model := newAppliesToTestModel()
+	model.resourceKind = k8s.KindDeployment
+	model.resources = []k8s.Resource{{Kind: k8s.KindDeployment, Name: "worker"}}
+	model.actions[1].Command = "echo {{.resource}}"
+
+	updated, cmd := model.Update(runeKey('c'))
+	model = updated.(AppModel)
+	assert.Nil(t, cmd)
+	assert.True(t, model.errorModal.IsVisible)
+}
+
+func TestAppliesTo_NoSelection(t *testing.T) {
+	model := newAppliesToTestModel()
+	model.pods = nil
+	assert.True(t, model.actionApplies(model.actions[1]), "nothing to match while no pod is selected")
+}
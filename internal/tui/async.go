@@ -0,0 +1,159 @@
+package tui
+
+import (
+	"context"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// asyncKind names a kind of background request. Only the latest request of a kind (and key)
+// is current; starting a new one supersedes the previous.
+type asyncKind string
+
+const (
+	asyncNamespaces asyncKind = "namespaces"
+	asyncPods       asyncKind = "pods"
+	asyncPodsMore   asyncKind = "pods_more"
+	asyncPodDetail  asyncKind = "pod_detail"
+	asyncResources  asyncKind = "resources"
+	asyncGitOps     asyncKind = "gitops"
+	asyncNetwork    asyncKind = "network"
+	asyncDescribe   asyncKind = "describe"
+	asyncYAML       asyncKind = "yaml"
+	asyncConfigData asyncKind = "config_data"
+	asyncRestarts   asyncKind = "restarts"
+	asyncPodCounts  asyncKind = "pod_counts" // Keyed by context name
+	asyncMetrics    asyncKind = "metrics"
+	asyncCredential asyncKind = "credential" // Keyed by context name
+	asyncAuth       asyncKind = "auth"       // Keyed by context name
+	asyncPrefetch   asyncKind = "prefetch"   // Keyed by context name
+	asyncFavorites  asyncKind = "favorites"  // Pods of a favorite namespace, keyed by context/namespace
+	asyncHealth     asyncKind = "health"     // Keyed by context name
+	asyncStartup    asyncKind = "startup"
+	asyncReload     asyncKind = "reload"
+)
+
+// asyncRequest identifies a background request. The zero value is an untracked request
+// whose result is always accepted.
+type asyncRequest struct {
+	kind asyncKind
+	key  string // Distinguishes concurrent requests of one kind (e.g. per context)
+	id   uint64
+}
+
+// resultMsg carries the result of a background request started with fetchCmd
+type resultMsg[T any] struct {
+	asyncRequest
+	value T
+	err   error
+}
+
+// asyncSlot is the latest request of a kind and key
+type asyncSlot struct {
+	id     uint64
+	cancel context.CancelFunc
+}
+
+// asyncTracker hands out request IDs and remembers the latest request per kind and key.
+// It is only used from Update, so it needs no locking. A nil tracker tracks nothing and
+// accepts every result.
+type asyncTracker struct {
+	next  uint64
+	slots map[asyncRequest]asyncSlot // Keyed by kind and key, id is zero
+}
+
+// newAsyncTracker returns an empty tracker
+func newAsyncTracker() *asyncTracker {
+	return &asyncTracker{slots: make(map[asyncRequest]asyncSlot)}
+}
+
+// start registers a new request, cancelling the previous one of the same kind and key
+func (t *asyncTracker) start(kind asyncKind, key string) (context.Context, asyncRequest) {
+	if t == nil {
+		return context.Background(), asyncRequest{}
+	}
+
+	slot := asyncRequest{kind: kind, key: key}
+	if prev, ok := t.slots[slot]; ok {
+		prev.cancel()
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	t.next++
+	t.slots[slot] = asyncSlot{id: t.next, cancel: cancel}
+	return ctx, asyncRequest{kind: kind, key: key, id: t.next}
+}
+
+// current reports whether req is the latest request of its kind and key, and marks it done.
+// Untracked requests are always current.
+func (t *asyncTracker) current(req asyncRequest) bool {
+	if t == nil || req.id == 0 {
+		return true
+	}
+
+	slot := asyncRequest{kind: req.kind, key: req.key}
+	latest, ok := t.slots[slot]
+	if !ok || latest.id != req.id {
+		return false
+	}
+	latest.cancel()
+	delete(t.slots, slot)
+	return true
+}
+
+// pending reports whether req is the latest request of its kind and key, without marking
+// it done. Untracked requests are always pending.
+func (t *asyncTracker) pending(req asyncRequest) bool {
+	if t == nil || req.id == 0 {
+		return true
+	}
+	latest, ok := t.slots[asyncRequest{kind: req.kind, key: req.key}]
+	return ok && latest.id == req.id
+}
+
+// cancel drops all in-flight requests of the given kinds, whatever their key.
+// Their results will be ignored.
+func (t *asyncTracker) cancel(kinds ...asyncKind) {
+	if t == nil {
+		return
+	}
+	for slot, latest := range t.slots {
+		for _, kind := range kinds {
+			if slot.kind == kind {
+				latest.cancel()
+				delete(t.slots, slot)
+			}
+		}
+	}
+}
+
+// inFlight reports whether a request of kind and key is in flight
+func (t *asyncTracker) inFlight(kind asyncKind, key string) bool {
+	if t == nil {
+		return false
+	}
+	_, ok := t.slots[asyncRequest{kind: kind, key: key}]
+	return ok
+}
+
+// drop drops the in-flight request of kind and key, if any. Its result will be ignored.
+func (t *asyncTracker) drop(kind asyncKind, key string) {
+	if t == nil {
+		return
+	}
+	slot := asyncRequest{kind: kind, key: key}
+	if latest, ok := t.slots[slot]; ok {
+		latest.cancel()
+		delete(t.slots, slot)
+	}
+}
+
+// fetchCmd starts a tracked background request. fetch runs outside of Update with a context
+// that is cancelled once the request is superseded; its result arrives as a resultMsg[T].
+func fetchCmd[T any](t *asyncTracker, kind asyncKind, key string, fetch func(ctx context.Context) (T, error)) tea.Cmd {
+	ctx, req := t.start(kind, key)
+	return func() tea.Msg {
+		value, err := fetch(ctx)
+		return resultMsg[T]{asyncRequest: req, value: value, err: err}
+	}
+}
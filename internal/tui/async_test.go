@@ -0,0 +1,99 @@
+package tui
+
+import (
+	"context"
+	"testing"
+
+	"github.com/maratkarimov/kubertino/internal/config"
+	"github.com/maratkarimov/kubertino/internal/k8s"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAsyncTracker_LatestRequestWins(t *testing.T) {
+	tracker := newAsyncTracker()
+
+	staleCtx, stale := tracker.start(asyncPods, "")
+	_, latest := tracker.start(asyncPods, "")
+
+	assert.ErrorIs(t, staleCtx.Err(), context.Canceled, "superseded request is cancelled")
+	assert.False(t, tracker.current(stale))
+	assert.True(t, tracker.current(latest))
+	assert.False(t, tracker.current(latest), "a result is only accepted once")
+}
+
+func TestAsyncTracker_KeysAreIndependent(t *testing.T) {
+	tracker := newAsyncTracker()
+
+	_, dev := tracker.start(asyncCredential, "dev")
+	_, prod := tracker.start(asyncCredential, "prod")
+
+	assert.True(t, tracker.current(dev))
+	assert.True(t, tracker.current(prod))
+}
+
+func TestAsyncTracker_Cancel(t *testing.T) {
+	tracker := newAsyncTracker()
+
+	podsCtx, pods := tracker.start(asyncPods, "")
+	_, credential := tracker.start(asyncCredential, "dev")
+	tracker.cancel(asyncPods, asyncResources)
+
+	assert.ErrorIs(t, podsCtx.Err(), context.Canceled)
+	assert.False(t, tracker.current(pods))
+	assert.True(t, tracker.current(credential), "other kinds are left running")
+}
+
+func TestAsyncTracker_Drop(t *testing.T) {
+	tracker := newAsyncTracker()
+
+	paymentsCtx, payments := tracker.start(asyncFavorites, "dev/payments")
+	_, billing := tracker.start(asyncFavorites, "dev/billing")
+	tracker.drop(asyncFavorites, "dev/payments")
+	tracker.drop(asyncFavorites, "dev/unknown")
+
+	assert.ErrorIs(t, paymentsCtx.Err(), context.Canceled)
+	assert.False(t, tracker.current(payments))
+	assert.True(t, tracker.current(billing), "other keys are left running")
+}
+
+func TestAsyncTracker_NilAndUntracked(t *testing.T) {
+	var tracker *asyncTracker
+
+	ctx, req := tracker.start(asyncPods, "")
+	tracker.cancel(asyncPods)
+	assert.NoError(t, ctx.Err())
+	assert.True(t, tracker.current(req))
+	assert.True(t, newAsyncTracker().current(asyncRequest{}), "untracked results are always accepted")
+}
+
+func TestFetchCmd_CarriesRequestAndResult(t *testing.T) {
+	tracker := newAsyncTracker()
+
+	cmd := fetchCmd(tracker, asyncNamespaces, "", func(context.Context) ([]k8s.Namespace, error) {
+		return []k8s.Namespace{{Name: "default"}}, nil
+	})
+	msg, ok := cmd().(namespaceFetchedMsg)
+	require.True(t, ok)
+	assert.Equal(t, []k8s.Namespace{{Name: "default"}}, msg.value)
+	assert.NoError(t, msg.err)
+	assert.True(t, tracker.current(msg.asyncRequest))
+}
+
+func TestAsync_StalePodsIgnored(t *testing.T) {
+	model := NewAppModel(&config.Config{Contexts: []config.Context{{Name: "dev"}}}, newMockAdapter())
+	model.currentNamespace = "default"
+
+	stale := model.fetchPodsCmd()
+	fresh := model.fetchPodsCmd()
+
+	updated, _ := model.Update(fresh())
+	model = updated.(AppModel)
+	require.Len(t, model.pods, 2)
+
+	msg := stale().(podsFetchedMsg)
+	msg.value = []k8s.Pod{{Name: "stale"}}
+	updated, _ = model.Update(msg)
+	model = updated.(AppModel)
+	assert.Len(t, model.pods, 2, "pods of a superseded fetch are dropped")
+}
@@ -0,0 +1,37 @@
+package tui
+
+import (
+	"log/slog"
+
+	"github.com/maratkarimov/kubertino/internal/audit"
+	"github.com/maratkarimov/kubertino/internal/config"
+	"github.com/maratkarimov/kubertino/internal/history"
+	"github.com/maratkarimov/kubertino/internal/k8s"
+)
+
+// SetAuditLogger sends a record of every action run from the TUI to logger
+func (m *AppModel) SetAuditLogger(logger *audit.Logger) {
+	m.auditLog = logger
+}
+
+// auditRecord starts the audit record of action running its rendered command against
+// resource in the current namespace
+func (m AppModel) auditRecord(action config.Action, resource k8s.Resource, command string) audit.Record {
+	record := audit.NewRecord(audit.SourceTUI)
+	record.Context = m.currentContext.Name
+	record.Namespace = m.currentNamespace
+	record.Kind = string(resource.Kind)
+	record.Target = resource.Name
+	record.Action = action.Name
+	record.Destructive = action.Destructive
+	record.Command = command
+	return record
+}
+
+// recordAction sends the finished record to the audit sinks and the action history
+func (m AppModel) recordAction(record audit.Record) {
+	m.auditLog.Log(record)
+	if err := m.history.Append(history.FromRecord(record)); err != nil {
+		slog.Warn("failed to record action history", "action", record.Action, "error", err)
+	}
+}
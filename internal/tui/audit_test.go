@@ -0,0 +1,56 @@
+package tui
+
+import (
+	"encoding/json"
+	"errors"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/maratkarimov/kubertino/internal/audit"
+	"github.com/maratkarimov/kubertino/internal/k8s"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAuditRecord(t *testing.T) {
+	model := newAppliesToTestModel()
+	require.NotNil(t, model.currentContext)
+
+	pod := model.pods[0]
+	record := model.auditRecord(model.actions[1], k8s.PodResource(pod), "echo console web-1")
+
+	assert.Equal(t, audit.SourceTUI, record.Source)
+	assert.Equal(t, "dev", record.Context)
+	assert.Equal(t, "app", record.Namespace)
+	assert.Equal(t, string(k8s.KindPod), record.Kind)
+	assert.Equal(t, "web-1", record.Target)
+	assert.Equal(t, "Rails Console", record.Action)
+	assert.Equal(t, "echo console web-1", record.Command)
+}
+
+func TestExecFinished_WritesAuditRecord(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "audit.jsonl")
+	sink, err := audit.NewFileSink(path)
+	require.NoError(t, err)
+	logger := audit.New(sink)
+
+	model := newAppliesToTestModel()
+	model.SetAuditLogger(logger)
+	pod := model.pods[0]
+	record := model.auditRecord(model.actions[0], k8s.PodResource(pod), "echo web-1")
+
+	updated, _ := model.Update(execFinishedMsg{err: errors.New("boom"), record: record})
+	assert.True(t, updated.(AppModel).errorModal.IsVisible)
+	logger.Close()
+
+	data, err := os.ReadFile(path)
+	require.NoError(t, err)
+	var logged audit.Record
+	require.NoError(t, json.Unmarshal([]byte(strings.TrimSpace(string(data))), &logged))
+	assert.Equal(t, "Logs", logged.Action)
+	assert.Equal(t, "web-1", logged.Target)
+	assert.Equal(t, -1, logged.ExitCode)
+	assert.Equal(t, "boom", logged.Error)
+}
@@ -0,0 +1,188 @@
+package tui
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"os/exec"
+	"runtime"
+	"strings"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/maratkarimov/kubertino/internal/k8s"
+	"github.com/maratkarimov/kubertino/internal/tui/components"
+	"github.com/maratkarimov/kubertino/internal/tui/styles"
+)
+
+// AuthChecker is implemented by adapters that can check context credentials before use
+type AuthChecker interface {
+	CheckAuth(context string) (*k8s.AuthStatus, error)
+}
+
+// authCheckedMsg is sent when the pre-flight credential check of a context has finished.
+// The request key is the context name.
+type authCheckedMsg = resultMsg[*k8s.AuthStatus]
+
+// authRefreshedMsg is sent when the login refresh command has given back the terminal
+type authRefreshedMsg struct {
+	context string
+	err     error
+}
+
+// openURL opens url in the default browser (replaced in tests)
+var openURL = func(url string) error {
+	var cmd *exec.Cmd
+	switch runtime.GOOS {
+	case "darwin":
+		cmd = exec.Command("open", url)
+	case "windows":
+		cmd = exec.Command("rundll32", "url.dll,FileProtocolHandler", url)
+	default:
+		cmd = exec.Command("xdg-open", url)
+	}
+	return cmd.Start()
+}
+
+// checkAuthCmd returns a command that checks the credentials of a context.
+// Returns nil when the adapter cannot check credentials.
+func (m AppModel) checkAuthCmd(contextName string) tea.Cmd {
+	checker, ok := m.kubeAdapter.(AuthChecker)
+	if !ok {
+		return nil
+	}
+	return fetchCmd(m.requests, asyncAuth, contextName, func(context.Context) (*k8s.AuthStatus, error) {
+		return checker.CheckAuth(contextName)
+	})
+}
+
+// handleAuthChecked shows the auth modal when the credentials of the current context do not work
+func (m AppModel) handleAuthChecked(msg authCheckedMsg) (tea.Model, tea.Cmd) {
+	if !m.requests.current(msg.asyncRequest) {
+		return m, nil
+	}
+	if msg.err != nil {
+		// Not a credential problem (e.g. the cluster is unreachable); the fetches report it
+		slog.Warn("credential check failed", "context", msg.key, "error", msg.err)
+		return m, nil
+	}
+	if m.currentContext == nil || m.currentContext.Name != msg.key {
+		return m, nil
+	}
+
+	if msg.value.OK() {
+		m.authStatus = nil
+		return m, nil
+	}
+	slog.Warn("context credentials do not work", "context", msg.key, "problem", msg.value.Problem, "detail", msg.value.Detail)
+	m.authStatus = msg.value
+	return m, nil
+}
+
+// handleAuthKey handles keys while the auth modal is shown: o opens the login page, c copies
+// its URL, r runs the refresh command and any other key dismisses the modal
+func (m AppModel) handleAuthKey(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	status := m.authStatus
+	switch {
+	case msg.Type == tea.KeyCtrlC:
+		return m, tea.Quit
+
+	case msg.String() == "o" && status.LoginURL != "":
+		if err := openURL(status.LoginURL); err != nil {
+			slog.Warn("failed to open login page", "url", status.LoginURL, "error", err)
+			return m, m.notify("Could not open a browser, press c to copy the URL", components.ToastWarning)
+		}
+		return m, m.notify("Opened login page", components.ToastInfo)
+
+	case msg.String() == "c" && status.LoginURL != "":
+		return m, m.copyText(status.LoginURL, "Copied login URL")
+
+	case msg.String() == "r" && status.Refresh != nil:
+		m.authStatus = nil
+		slog.Info("running login refresh", "context", status.Context, "command", strings.Join(status.Refresh, " "))
+		cmd := exec.Command(status.Refresh[0], status.Refresh[1:]...)
+		return m, tea.ExecProcess(cmd, func(err error) tea.Msg {
+			return authRefreshedMsg{context: status.Context, err: err}
+		})
+	}
+
+	m.authStatus = nil
+	return m, nil
+}
+
+// handleAuthRefreshed checks the credentials again after the refresh command and reloads the
+// namespaces that failed to load with the old ones. The refresh command owned the terminal,
+// so its size is queried again.
+func (m AppModel) handleAuthRefreshed(msg authRefreshedMsg) (tea.Model, tea.Cmd) {
+	if msg.err != nil {
+		slog.Warn("login refresh failed", "context", msg.context, "error", msg.err)
+	}
+	cmds := []tea.Cmd{m.checkAuthCmd(msg.context), windowSizeCmd}
+	if checker, ok := m.kubeAdapter.(CredentialChecker); ok {
+		cmds = append(cmds, checkCredentialCmd(m.requests, checker, msg.context))
+	}
+	if m.currentContext != nil && m.currentContext.Name == msg.context && m.viewMode == viewModeNamespaceView {
+		cmds = append(cmds, m.loadNamespaces())
+	}
+	return m, tea.Batch(cmds...)
+}
+
+// authExplanation describes a credential problem and how to solve it
+func authExplanation(status *k8s.AuthStatus) string {
+	switch status.Problem {
+	case k8s.AuthProblemPluginMissing:
+		text := fmt.Sprintf("The credential plugin %q is not installed, so kubectl cannot obtain credentials for this context.", status.Plugin)
+		if status.InstallHint != "" {
+			return text + "\n\n" + status.InstallHint
+		}
+		return text + " Install it and make sure it is in your PATH."
+	case k8s.AuthProblemExpired:
+		return "Your login has expired (e.g. an OIDC token that can no longer be refreshed). Log in again to continue."
+	default:
+		return "The cluster rejected your credentials. Log in again, or check the user of this context in your kubeconfig."
+	}
+}
+
+// renderAuthModal renders the failed credential check of the current context as a centered dialog
+func (m AppModel) renderAuthModal() string {
+	status := m.authStatus
+	width := 64
+	if m.termWidth > 0 {
+		width = min(width, m.termWidth-6)
+	}
+
+	content := styles.TitleStyle.Render("Credentials of "+status.Context+" need attention") + "\n\n"
+	content += styles.NormalStyle.Copy().Width(width).Render(authExplanation(status)) + "\n"
+	if status.LoginURL != "" {
+		content += "\n" + styles.NormalStyle.Render("Login: ") + styles.PanelTitleStyle.Render(status.LoginURL) + "\n"
+	}
+	if status.Refresh != nil {
+		content += "\n" + styles.NormalStyle.Render("Refresh: ") + styles.DimStyle.Render(strings.Join(status.Refresh, " ")) + "\n"
+	}
+	if status.Detail != "" {
+		content += "\n" + styles.DimStyle.Copy().Width(width).Render(status.Detail) + "\n"
+	}
+
+	var keys []string
+	if status.LoginURL != "" {
+		keys = append(keys, "o: open login page", "c: copy URL")
+	}
+	if status.Refresh != nil {
+		keys = append(keys, "r: run refresh command")
+	}
+	keys = append(keys, "any other key: dismiss")
+	content += "\n" + styles.DimStyle.Render(strings.Join(keys, "  "))
+
+	dialogStyle := lipgloss.NewStyle().
+		Border(lipgloss.RoundedBorder()).
+		BorderForeground(lipgloss.Color("9")). // Red
+		Padding(1, 2)
+
+	return lipgloss.Place(
+		m.termWidth,
+		m.termHeight,
+		lipgloss.Center,
+		lipgloss.Center,
+		dialogStyle.Render(content),
+	)
+}
@@ -0,0 +1,155 @@
+package tui
+
+import (
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/maratkarimov/kubertino/internal/k8s"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// authAdapter is a mock adapter that also checks context credentials
+type authAdapter struct {
+	*mockKubeAdapter
+	status *k8s.AuthStatus
+}
+
+func (a *authAdapter) CheckAuth(context string) (*k8s.AuthStatus, error) {
+	return a.status, nil
+}
+
+// newAuthTestModel returns a model in the namespace view of the prod context
+func newAuthTestModel(adapter KubeAdapter) AppModel {
+	model := newCredentialTestModel(adapter)
+	model.currentContext = &model.contexts[1]
+	model.viewMode = viewModeNamespaceView
+	return model
+}
+
+func expiredStatus() *k8s.AuthStatus {
+	return &k8s.AuthStatus{
+		Context:  "prod",
+		Problem:  k8s.AuthProblemExpired,
+		LoginURL: "https://sso.example.com",
+		Refresh:  []string{"kubectl", "--context", "prod", "auth", "whoami"},
+		Detail:   "error: You must be logged in to the server (Unauthorized)",
+	}
+}
+
+func authChecked(context string, status *k8s.AuthStatus) authCheckedMsg {
+	return authCheckedMsg{asyncRequest: asyncRequest{kind: asyncAuth, key: context}, value: status}
+}
+
+func TestAuth_CheckSkippedWithoutChecker(t *testing.T) {
+	model := newAuthTestModel(newMockAdapter())
+	assert.Nil(t, model.checkAuthCmd("prod"))
+}
+
+func TestAuth_CheckOnContextSelection(t *testing.T) {
+	adapter := &authAdapter{mockKubeAdapter: newMockAdapter(), status: expiredStatus()}
+	model := newCredentialTestModel(adapter)
+
+	cmd := model.checkAuthCmd("prod")
+	require.NotNil(t, cmd)
+	msg, ok := cmd().(authCheckedMsg)
+	require.True(t, ok)
+	assert.Equal(t, "prod", msg.key)
+	assert.Equal(t, k8s.AuthProblemExpired, msg.value.Problem)
+
+	_, selectCmd := model.selectContext(1)
+	assert.NotNil(t, selectCmd)
+}
+
+func TestAuth_ModalShowsProblem(t *testing.T) {
+	model := newAuthTestModel(newMockAdapter())
+
+	updated, _ := model.Update(authChecked("prod", expiredStatus()))
+	model = updated.(AppModel)
+
+	require.NotNil(t, model.authStatus)
+	view := model.View()
+	assert.Contains(t, view, "Credentials of prod need attention")
+	assert.Contains(t, view, "login has expired")
+	assert.Contains(t, view, "https://sso.example.com")
+	assert.Contains(t, view, "r: run refresh command")
+}
+
+func TestAuth_ModalIgnoresOtherContextsAndWorkingCredentials(t *testing.T) {
+	model := newAuthTestModel(newMockAdapter())
+
+	updated, _ := model.Update(authChecked("dev", expiredStatus()))
+	model = updated.(AppModel)
+	assert.Nil(t, model.authStatus, "dev is not the current context")
+
+	updated, _ = model.Update(authChecked("prod", &k8s.AuthStatus{Context: "prod"}))
+	model = updated.(AppModel)
+	assert.Nil(t, model.authStatus)
+}
+
+func TestAuth_MissingPluginShowsInstallHint(t *testing.T) {
+	model := newAuthTestModel(newMockAdapter())
+	model.authStatus = &k8s.AuthStatus{
+		Context:     "prod",
+		Problem:     k8s.AuthProblemPluginMissing,
+		Plugin:      "kubelogin",
+		InstallHint: "brew install int128/kubelogin/kubelogin",
+	}
+
+	view := model.View()
+	assert.Contains(t, view, `"kubelogin" is not installed`)
+	assert.Contains(t, view, "brew install int128/kubelogin/kubelogin")
+	assert.NotContains(t, view, "r: run refresh command")
+}
+
+func TestAuth_OpenLoginPage(t *testing.T) {
+	var opened string
+	original := openURL
+	openURL = func(url string) error {
+		opened = url
+		return nil
+	}
+	defer func() { openURL = original }()
+
+	model := newAuthTestModel(newMockAdapter())
+	model.authStatus = expiredStatus()
+
+	updated, _ := model.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'o'}})
+	model = updated.(AppModel)
+
+	assert.Equal(t, "https://sso.example.com", opened)
+	assert.NotNil(t, model.authStatus, "modal stays open while logging in")
+}
+
+func TestAuth_RunRefreshCommand(t *testing.T) {
+	model := newAuthTestModel(newMockAdapter())
+	model.authStatus = expiredStatus()
+
+	updated, cmd := model.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'r'}})
+	model = updated.(AppModel)
+
+	assert.NotNil(t, cmd, "refresh command takes over the terminal")
+	assert.Nil(t, model.authStatus)
+}
+
+func TestAuth_RefreshedReloadsNamespaces(t *testing.T) {
+	adapter := &authAdapter{mockKubeAdapter: newMockAdapter(), status: &k8s.AuthStatus{Context: "prod"}}
+	model := newAuthTestModel(adapter)
+
+	updated, cmd := model.Update(authRefreshedMsg{context: "prod"})
+	model = updated.(AppModel)
+
+	assert.NotNil(t, cmd)
+	assert.True(t, model.namespacesLoading, "namespaces are fetched with the new credentials")
+}
+
+func TestAuth_OtherKeyDismisses(t *testing.T) {
+	model := newAuthTestModel(newMockAdapter())
+	model.authStatus = expiredStatus()
+
+	updated, _ := model.Update(tea.KeyMsg{Type: tea.KeyEsc})
+	model = updated.(AppModel)
+
+	assert.Nil(t, model.authStatus)
+	assert.NotEqual(t, viewModeContextSelection, model.viewMode, "dismissing does not leave the view")
+}
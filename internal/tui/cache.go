@@ -0,0 +1,102 @@
+package tui
+
+import (
+	"log/slog"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/maratkarimov/kubertino/internal/config"
+	"github.com/maratkarimov/kubertino/internal/k8s"
+)
+
+// loadNamespaces shows the namespaces of the current context. Cached namespaces are shown
+// instantly and refreshed in the background once stale; only a cold fetch shows the spinner.
+func (m *AppModel) loadNamespaces() tea.Cmd {
+	m.namespacesError = nil
+	// A fetch started for the previous list must not overwrite the one shown now
+	m.requests.cancel(asyncNamespaces)
+
+	var namespaces []k8s.Namespace
+	var fresh, ok bool
+	if m.currentContext != nil {
+		namespaces, fresh, ok = m.cache.Namespaces(m.currentContext.Name)
+	}
+	if !ok {
+		m.namespacesLoading = true
+		m.namespacesSpinner.Start(m.loadingMessage(config.LoadingNamespaces))
+		return tea.Batch(m.fetchNamespacesCmd(), m.spinnerTickCmd())
+	}
+
+	m.namespacesLoading = false
+	m.namespacesSpinner.Stop()
+	cmd := m.setNamespaces(namespaces)
+	if fresh {
+		return cmd
+	}
+	slog.Debug("refreshing cached namespaces", "context", m.currentContext.Name)
+	return tea.Batch(cmd, m.fetchNamespacesCmd())
+}
+
+// loadPods shows the pods of the current namespace. Cached pods are shown instantly and
+// refreshed in the background once stale; only a cold fetch shows the spinner.
+func (m *AppModel) loadPods() tea.Cmd {
+	m.podsError = nil
+	// A fetch started for another namespace must not overwrite the pods shown now
+	m.requests.cancel(asyncPods, asyncPodsMore)
+	m.podsContinue = ""
+	m.podsLoadingMore = false
+
+	var pods []k8s.Pod
+	var fresh, ok bool
+	// Pods matching a label selector are not cached
+	if m.currentContext != nil && m.podSelector == "" {
+		pods, fresh, ok = m.cache.Pods(m.currentContext.Name, m.currentNamespace)
+	}
+	if !ok {
+		m.podsLoading = true
+		m.podsSpinner.Start(m.loadingMessage(config.LoadingPods))
+		return tea.Batch(m.fetchPodsCmd(), m.spinnerTickCmd())
+	}
+
+	m.podsLoading = false
+	m.podsSpinner.Stop()
+	m.setPods(pods)
+	// The cache keeps no page token, so paged pods are always refetched from the first page
+	if fresh && m.podPageSize() == 0 {
+		return nil
+	}
+	slog.Debug("refreshing cached pods", "context", m.currentContext.Name, "namespace", m.currentNamespace)
+	return m.fetchPodsCmd()
+}
+
+// handleRefresh refetches the focused namespaces or pods panel. The current list stays
+// visible while the new one loads.
+func (m AppModel) handleRefresh() (tea.Model, tea.Cmd) {
+	if m.currentContext == nil {
+		return m, nil
+	}
+
+	switch m.focusedPanel {
+	case PanelNamespaces:
+		slog.Info("refreshing namespaces", "context", m.currentContext.Name)
+		m.cache.InvalidateNamespaces(m.currentContext.Name)
+		m.cache.InvalidatePodCounts(m.currentContext.Name)
+		m.invalidateFavorites(m.currentContext.Name)
+		m.refreshing = asyncNamespaces
+		return m, tea.Batch(m.loadNamespaces(), m.prefetchFavoritesCmd(m.currentContext.Name))
+	case PanelPods:
+		if m.currentNamespace == "" {
+			return m, nil
+		}
+		if m.browsingResources() {
+			return m, m.startResourceFetch()
+		}
+		slog.Info("refreshing pods", "context", m.currentContext.Name, "namespace", m.currentNamespace)
+		m.cache.InvalidatePods(m.currentContext.Name, m.currentNamespace)
+		// A prefetch still in flight must not replace the refreshed pods with older ones
+		m.requests.drop(asyncFavorites, favoritesKey(m.currentContext.Name, m.currentNamespace))
+		m.podDetails = nil
+		m.refreshing = asyncPods
+		return m, m.loadPods()
+	}
+	return m, nil
+}
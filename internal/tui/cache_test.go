@@ -0,0 +1,140 @@
+package tui
+
+import (
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/maratkarimov/kubertino/internal/config"
+	"github.com/maratkarimov/kubertino/internal/k8s"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// newCacheTestModel returns a single-context model with namespaces loaded
+func newCacheTestModel(t *testing.T, ttl string) (AppModel, *mockKubeAdapter) {
+	t.Helper()
+	adapter := newMockAdapter()
+	model := newTestModel(adapter, withContexts(config.Context{Name: "dev"}), func(cfg *config.Config) { cfg.CacheTTL = ttl })
+
+	updated, _ := model.Update(model.fetchNamespacesCmd()())
+	return updated.(AppModel), adapter
+}
+
+// openNamespace selects namespace and delivers the pod fetch, if one was started
+func openNamespace(t *testing.T, model AppModel, namespace string) AppModel {
+	t.Helper()
+	updated, _ := model.selectNamespace(namespace)
+	model = updated.(AppModel)
+	if model.podsLoading {
+		updated, _ = model.Update(model.fetchPodsCmd()())
+		model = updated.(AppModel)
+	}
+	return model
+}
+
+func TestCache_RevisitedNamespaceServedInstantly(t *testing.T) {
+	model, adapter := newCacheTestModel(t, "")
+	model = openNamespace(t, model, "default")
+	require.Len(t, model.pods, 2)
+
+	adapter.pods = []k8s.Pod{{Name: "changed"}}
+	model = openNamespace(t, model, "staging")
+	assert.Equal(t, "changed", model.pods[0].Name)
+
+	updated, cmd := model.selectNamespace("default")
+	model = updated.(AppModel)
+	assert.False(t, model.podsLoading, "no spinner for cached pods")
+	assert.Nil(t, cmd, "fresh pods are not refetched")
+	assert.Equal(t, "test-pod-1", model.pods[0].Name)
+	assert.Equal(t, 0, model.selectedPodIndex)
+}
+
+func TestCache_StalePodsRefreshedInBackground(t *testing.T) {
+	model, adapter := newCacheTestModel(t, "")
+	model = openNamespace(t, model, "default")
+	model.cache.InvalidatePods("dev", "default")
+
+	adapter.pods = []k8s.Pod{{Name: "test-pod-2"}, {Name: "test-pod-3"}}
+	model.selectedPodIndex = 1
+	updated, cmd := model.selectNamespace("default")
+	model = updated.(AppModel)
+	require.NotNil(t, cmd)
+	assert.False(t, model.podsLoading, "cached pods are shown while refreshing")
+	assert.Len(t, model.pods, 2)
+
+	updated, _ = model.Update(model.fetchPodsCmd()())
+	model = updated.(AppModel)
+	assert.Equal(t, "test-pod-3", model.pods[1].Name)
+	assert.Equal(t, 0, model.selectedPodIndex, "cursor follows the selected pod")
+}
+
+func TestCache_RefreshKey(t *testing.T) {
+	model, adapter := newCacheTestModel(t, "")
+	model = openNamespace(t, model, "default")
+	pod, ok := model.selectedPod()
+	require.True(t, ok)
+
+	// Pods panel: the list stays visible and the cursor stays on the selected pod
+	adapter.pods = []k8s.Pod{{Name: "new-pod"}, pod}
+	updated, cmd := model.Update(runeKey('R'))
+	model = updated.(AppModel)
+	require.NotNil(t, cmd)
+	assert.False(t, model.podsLoading)
+	assert.Len(t, model.pods, 2)
+
+	updated, _ = model.Update(model.fetchPodsCmd()())
+	model = updated.(AppModel)
+	selected, ok := model.selectedPod()
+	require.True(t, ok)
+	assert.Equal(t, pod.Name, selected.Name)
+
+	// Namespaces panel
+	adapter.namespaces = []string{"default", "fresh"}
+	model.focusedPanel = PanelNamespaces
+	updated, cmd = model.Update(runeKey('R'))
+	model = updated.(AppModel)
+	require.NotNil(t, cmd)
+	assert.False(t, model.namespacesLoading)
+	updated, _ = model.Update(model.fetchNamespacesCmd()())
+	model = updated.(AppModel)
+	assert.Equal(t, []string{"default", "fresh"}, k8s.NamespaceNames(model.namespaces))
+}
+
+func TestCache_StaleFetchForPreviousNamespaceIgnored(t *testing.T) {
+	model, _ := newCacheTestModel(t, "")
+	model = openNamespace(t, model, "default")
+
+	updated, _ := model.selectNamespace("staging")
+	model = updated.(AppModel)
+	stale := model.fetchPodsCmd()
+
+	// Back to the cached namespace while staging is still loading
+	updated, _ = model.selectNamespace("default")
+	model = updated.(AppModel)
+	msg := stale().(podsFetchedMsg)
+	msg.value = []k8s.Pod{{Name: "staging-pod"}}
+	updated, _ = model.Update(msg)
+	model = updated.(AppModel)
+
+	assert.Equal(t, "test-pod-1", model.pods[0].Name)
+}
+
+func TestCache_Disabled(t *testing.T) {
+	model, _ := newCacheTestModel(t, "0")
+	model = openNamespace(t, model, "default")
+
+	updated, cmd := model.selectNamespace("default")
+	model = updated.(AppModel)
+	assert.True(t, model.podsLoading)
+	assert.NotNil(t, cmd)
+}
+
+func TestCache_RefreshIgnoredWhileSearching(t *testing.T) {
+	model, _ := newCacheTestModel(t, "")
+	model.focusedPanel = PanelNamespaces
+	model = sendKey(model, tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'/'}})
+	require.True(t, model.searchMode)
+
+	model = sendKey(model, runeKey('R'))
+	assert.Equal(t, "R", model.searchQuery)
+}
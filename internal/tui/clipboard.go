@@ -0,0 +1,61 @@
+package tui
+
+import (
+	"fmt"
+	"log/slog"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/maratkarimov/kubertino/internal/clipboard"
+	"github.com/maratkarimov/kubertino/internal/tui/components"
+)
+
+// copyToClipboard copies text to the system clipboard (replaced in tests)
+var copyToClipboard = clipboard.Copy
+
+// handleCopyName copies the highlighted namespace, or the selected pod or resource name when
+// the pods panel has focus
+func (m AppModel) handleCopyName() (tea.Model, tea.Cmd) {
+	name, ok := m.highlightedName()
+	if !ok {
+		return m, nil
+	}
+	return m, m.copyText(name, "Copied "+name)
+}
+
+// handleCopyCommand copies the rendered command of the last executed action
+func (m AppModel) handleCopyCommand() (tea.Model, tea.Cmd) {
+	if m.lastCommand == "" {
+		return m, m.notify("No action has been run yet", components.ToastWarning)
+	}
+	return m, m.copyText(m.lastCommand, "Copied command: "+m.lastCommand)
+}
+
+// highlightedName returns the name under the cursor in the focused panel
+func (m AppModel) highlightedName() (string, bool) {
+	switch m.focusedPanel {
+	case PanelNamespaces:
+		if namespace, ok := m.highlightedNamespace(); ok {
+			return namespace.Name, true
+		}
+	case PanelPods:
+		if m.browsingResources() {
+			if m.selectedResourceIndex >= 0 && m.selectedResourceIndex < len(m.resources) {
+				return m.resources[m.selectedResourceIndex].Name, true
+			}
+			return "", false
+		}
+		if pod, ok := m.selectedPod(); ok {
+			return pod.Name, true
+		}
+	}
+	return "", false
+}
+
+// copyText copies text and reports the outcome in the status bar
+func (m AppModel) copyText(text, success string) tea.Cmd {
+	if err := copyToClipboard(text); err != nil {
+		slog.Warn("clipboard copy failed", "error", err)
+		return m.notify(fmt.Sprintf("Copy failed: %v", err), components.ToastWarning)
+	}
+	return m.notify(success, components.ToastSuccess)
+}
@@ -0,0 +1,91 @@
+package tui
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/maratkarimov/kubertino/internal/audit"
+	"github.com/maratkarimov/kubertino/internal/k8s"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// stubClipboard records copied text instead of touching the system clipboard
+func stubClipboard(t *testing.T, err error) *[]string {
+	t.Helper()
+	original := copyToClipboard
+	t.Cleanup(func() { copyToClipboard = original })
+
+	var copied []string
+	copyToClipboard = func(text string) error {
+		copied = append(copied, text)
+		return err
+	}
+	return &copied
+}
+
+func TestCopyName_SelectedPod(t *testing.T) {
+	copied := stubClipboard(t, nil)
+	model := newAppliesToTestModel()
+	model.selectedPodIndex = 1
+
+	updated, cmd := model.Update(runeKey('y'))
+	model = updated.(AppModel)
+	assert.NotNil(t, cmd)
+	assert.Equal(t, []string{"worker-1"}, *copied)
+	toast, ok := model.statusBar.Current()
+	require.True(t, ok)
+	assert.Equal(t, "Copied worker-1", toast.Text)
+}
+
+func TestCopyName_HighlightedNamespace(t *testing.T) {
+	copied := stubClipboard(t, nil)
+	model := newAppliesToTestModel()
+	model.namespaces = k8s.NamespacesNamed([]string{"app", "staging"})
+	model.selectedNamespaceIndex = 1
+	model.focusedPanel = PanelNamespaces
+
+	_, _ = model.Update(runeKey('y'))
+	assert.Equal(t, []string{"staging"}, *copied)
+}
+
+func TestCopyName_SelectedResource(t *testing.T) {
+	copied := stubClipboard(t, nil)
+	model := newAppliesToTestModel()
+	model.resourceKind = k8s.KindDeployment
+	model.resources = []k8s.Resource{{Name: "api"}, {Name: "worker"}}
+	model.selectedResourceIndex = 1
+
+	_, _ = model.Update(runeKey('y'))
+	assert.Equal(t, []string{"worker"}, *copied)
+}
+
+func TestCopyName_FailureShowsWarning(t *testing.T) {
+	stubClipboard(t, errors.New("no clipboard"))
+	model := newAppliesToTestModel()
+
+	updated, _ := model.Update(runeKey('y'))
+	model = updated.(AppModel)
+	toast, ok := model.statusBar.Current()
+	require.True(t, ok)
+	assert.Equal(t, "Copy failed: no clipboard", toast.Text)
+	assert.False(t, model.errorModal.IsVisible)
+}
+
+func TestCopyCommand_LastExecutedAction(t *testing.T) {
+	copied := stubClipboard(t, nil)
+	model := newAppliesToTestModel()
+
+	updated, _ := model.Update(runeKey('Y'))
+	model = updated.(AppModel)
+	assert.Empty(t, *copied)
+	toast, _ := model.statusBar.Current()
+	assert.Equal(t, "No action has been run yet", toast.Text)
+
+	record := audit.Record{Action: "Logs", Command: "kubectl logs -n app web-1"}
+	updated, _ = model.Update(execFinishedMsg{record: record})
+	model = updated.(AppModel)
+
+	_, _ = model.Update(runeKey('Y'))
+	assert.Equal(t, []string{"kubectl logs -n app web-1"}, *copied)
+}
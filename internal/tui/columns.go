@@ -0,0 +1,44 @@
+package tui
+
+import (
+	"strings"
+
+	"github.com/charmbracelet/lipgloss"
+)
+
+// fitColumns lays out a row of columns followed by a name column in textWidth cells.
+// widths are the natural widths of the columns (each followed by a space) and nameWidth is
+// the widest name. Trailing columns are dropped while the names would not fit; the first
+// column is always kept and the name is truncated last. Returns the number of columns shown
+// and the width available for names.
+func fitColumns(widths []int, nameWidth, textWidth int) (int, int) {
+	count := len(widths)
+	used := 0
+	for _, width := range widths {
+		used += width + 1
+	}
+
+	for count > 1 && used+nameWidth > textWidth {
+		count--
+		used -= widths[count] + 1
+	}
+	return count, max(min(nameWidth, textWidth-used), 1)
+}
+
+// truncateName shortens name to width cells, marking the cut with an ellipsis
+func truncateName(name string, width int) string {
+	runes := []rune(name)
+	if len(runes) <= width {
+		return name
+	}
+	if width <= 1 {
+		return "…"
+	}
+	return string(runes[:width-1]) + "…"
+}
+
+// padRight pads text with spaces to width cells. Unlike %-*s it measures the rendered width,
+// so styled text lines up too.
+func padRight(text string, width int) string {
+	return text + strings.Repeat(" ", max(width-lipgloss.Width(text), 0))
+}
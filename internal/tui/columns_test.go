@@ -0,0 +1,44 @@
+package tui
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFitColumns(t *testing.T) {
+	tests := []struct {
+		name      string
+		widths    []int
+		nameWidth int
+		textWidth int
+		wantCount int
+		wantName  int
+	}{
+		{"everything fits", []int{7, 5}, 10, 40, 2, 10},
+		{"trailing column dropped before truncating", []int{7, 5}, 20, 30, 1, 20},
+		{"name truncated last", []int{7, 5}, 40, 30, 1, 22},
+		{"no columns", nil, 40, 30, 0, 30},
+		{"name never below one cell", []int{30}, 10, 20, 1, 1},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			count, nameWidth := fitColumns(tt.widths, tt.nameWidth, tt.textWidth)
+			assert.Equal(t, tt.wantCount, count)
+			assert.Equal(t, tt.wantName, nameWidth)
+		})
+	}
+}
+
+func TestTruncateName(t *testing.T) {
+	assert.Equal(t, "web-1", truncateName("web-1", 5))
+	assert.Equal(t, "web-…", truncateName("web-12", 5))
+	assert.Equal(t, "…", truncateName("web-12", 1))
+	assert.Equal(t, "…", truncateName("web-12", 0))
+}
+
+func TestPadRight(t *testing.T) {
+	assert.Equal(t, "ok   ", padRight("ok", 5))
+	assert.Equal(t, "toolong", padRight("toolong", 3))
+}
@@ -0,0 +1,149 @@
+package components
+
+import (
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+)
+
+// ConfirmInput is a confirmation dialog that only accepts once the user has typed an expected
+// value, such as the namespace a destructive action is about to run in
+type ConfirmInput struct {
+	Title      string
+	Message    string
+	Expected   string // Text the user must type to confirm
+	Input      string
+	OnConfirm  func() tea.Cmd
+	IsVisible  bool
+	termWidth  int
+	termHeight int
+}
+
+// Confirm dialog styles
+var (
+	confirmStyle = lipgloss.NewStyle().
+			BorderStyle(lipgloss.RoundedBorder()).
+			BorderForeground(lipgloss.Color("11")). // Yellow
+			Padding(1, 2).
+			Width(60)
+
+	confirmTitleStyle = lipgloss.NewStyle().
+				Foreground(lipgloss.Color("11")). // Yellow
+				Bold(true)
+
+	confirmInputStyle = lipgloss.NewStyle().
+				BorderStyle(lipgloss.NormalBorder()).
+				BorderForeground(lipgloss.Color("240")).
+				Width(40)
+
+	confirmMatchStyle = lipgloss.NewStyle().
+				Foreground(lipgloss.Color("10")) // Green
+)
+
+// NewConfirmInput creates a new hidden confirmation dialog
+func NewConfirmInput() *ConfirmInput {
+	return &ConfirmInput{}
+}
+
+// Show displays the dialog. onConfirm runs once the typed text equals expected and Enter is pressed.
+func (c *ConfirmInput) Show(title, message, expected string, onConfirm func() tea.Cmd) {
+	c.Title = title
+	c.Message = message
+	c.Expected = expected
+	c.Input = ""
+	c.OnConfirm = onConfirm
+	c.IsVisible = true
+}
+
+// Hide dismisses the dialog without confirming
+func (c *ConfirmInput) Hide() {
+	c.Title = ""
+	c.Message = ""
+	c.Expected = ""
+	c.Input = ""
+	c.OnConfirm = nil
+	c.IsVisible = false
+}
+
+// Matches reports whether the typed text equals the expected value
+func (c *ConfirmInput) Matches() bool {
+	return c.Input == c.Expected
+}
+
+// SetSize updates the terminal dimensions for proper centering
+func (c *ConfirmInput) SetSize(width, height int) {
+	c.termWidth = width
+	c.termHeight = height
+}
+
+// View renders the confirmation dialog overlay
+func (c *ConfirmInput) View() string {
+	if !c.IsVisible {
+		return ""
+	}
+
+	content := confirmTitleStyle.Render(c.Title) + "\n\n"
+	if c.Message != "" {
+		content += c.Message + "\n\n"
+	}
+	content += "Type " + lipgloss.NewStyle().Bold(true).Render(c.Expected) + " to confirm:\n"
+
+	input := c.Input + "█"
+	if c.Matches() {
+		input = confirmMatchStyle.Render(c.Input) + "█"
+	}
+	content += confirmInputStyle.Render(input) + "\n\n"
+
+	footer := "[ESC to cancel]"
+	if c.Matches() {
+		footer = "[Press Enter to confirm] " + footer
+	}
+	content += modalFooterStyle.Render(footer)
+
+	dialog := confirmStyle.Render(content)
+	if c.termWidth > 0 && c.termHeight > 0 {
+		return lipgloss.Place(c.termWidth, c.termHeight, lipgloss.Center, lipgloss.Center, dialog)
+	}
+	return dialog
+}
+
+// HandleKeyPress processes keyboard input for the dialog.
+// Returns true if the key was handled, false otherwise.
+func (c *ConfirmInput) HandleKeyPress(msg tea.KeyMsg) (bool, tea.Cmd) {
+	if !c.IsVisible {
+		return false, nil
+	}
+
+	switch msg.Type {
+	case tea.KeyEnter:
+		if !c.Matches() {
+			return true, nil
+		}
+		onConfirm := c.OnConfirm
+		c.Hide()
+		if onConfirm == nil {
+			return true, nil
+		}
+		return true, onConfirm()
+
+	case tea.KeyEsc, tea.KeyCtrlC:
+		c.Hide()
+		return true, nil
+
+	case tea.KeyBackspace:
+		if runes := []rune(c.Input); len(runes) > 0 {
+			c.Input = string(runes[:len(runes)-1])
+		}
+		return true, nil
+
+	case tea.KeyCtrlU:
+		c.Input = ""
+		return true, nil
+
+	case tea.KeyRunes, tea.KeySpace:
+		c.Input += string(msg.Runes)
+		return true, nil
+	}
+
+	// Block all other input while the dialog is visible
+	return true, nil
+}
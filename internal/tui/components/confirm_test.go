@@ -0,0 +1,78 @@
+package components
+
+import (
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func typeConfirm(c *ConfirmInput, text string) {
+	for _, r := range text {
+		c.HandleKeyPress(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{r}})
+	}
+}
+
+func TestConfirmInput_RequiresExactText(t *testing.T) {
+	confirmed := false
+	c := NewConfirmInput()
+	c.Show("Delete", "", "payments", func() tea.Cmd {
+		confirmed = true
+		return nil
+	})
+
+	typeConfirm(c, "payment")
+	handled, _ := c.HandleKeyPress(tea.KeyMsg{Type: tea.KeyEnter})
+	assert.True(t, handled)
+	assert.False(t, confirmed, "partial input does not confirm")
+	assert.True(t, c.IsVisible)
+
+	typeConfirm(c, "x")
+	c.HandleKeyPress(tea.KeyMsg{Type: tea.KeyBackspace})
+	typeConfirm(c, "s")
+	require.True(t, c.Matches())
+	c.HandleKeyPress(tea.KeyMsg{Type: tea.KeyEnter})
+	assert.True(t, confirmed)
+	assert.False(t, c.IsVisible)
+}
+
+func TestConfirmInput_EscCancels(t *testing.T) {
+	confirmed := false
+	c := NewConfirmInput()
+	c.Show("Delete", "", "payments", func() tea.Cmd {
+		confirmed = true
+		return nil
+	})
+	typeConfirm(c, "payments")
+
+	handled, cmd := c.HandleKeyPress(tea.KeyMsg{Type: tea.KeyEsc})
+	assert.True(t, handled)
+	assert.Nil(t, cmd)
+	assert.False(t, confirmed)
+	assert.False(t, c.IsVisible)
+	assert.Empty(t, c.Input)
+}
+
+func TestConfirmInput_Hidden(t *testing.T) {
+	c := NewConfirmInput()
+
+	handled, _ := c.HandleKeyPress(tea.KeyMsg{Type: tea.KeyEnter})
+	assert.False(t, handled)
+	assert.Empty(t, c.View())
+}
+
+func TestConfirmInput_View(t *testing.T) {
+	c := NewConfirmInput()
+	c.Show("Destructive action: Drop DB", "This runs in namespace payments.", "payments", nil)
+	typeConfirm(c, "pay")
+
+	view := c.View()
+	assert.Contains(t, view, "Destructive action: Drop DB")
+	assert.Contains(t, view, "payments")
+	assert.Contains(t, view, "pay█")
+	assert.NotContains(t, view, "Enter to confirm")
+
+	typeConfirm(c, "ments")
+	assert.Contains(t, c.View(), "Enter to confirm")
+}
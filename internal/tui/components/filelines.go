@@ -0,0 +1,103 @@
+package components
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+)
+
+const (
+	// scanChunk is how much of the file is read at a time while indexing
+	scanChunk = 256 * 1024
+	// scanBudget bounds the bytes indexed per Refresh, so catching up on a huge file never
+	// blocks the UI for long; the rest is indexed on the following refreshes
+	scanBudget = 16 * 1024 * 1024
+	// maxLineBytes is the longest part of a line that is read for display
+	maxLineBytes = 4096
+)
+
+// FileLines is a LineSource reading a file that may still be growing, such as captured
+// command output. Only the start offset of each line is kept in memory; the visible lines
+// are read from disk when shown, so huge outputs never have to be buffered.
+type FileLines struct {
+	file      *os.File
+	offsets   []int64 // Start offset of every line seen so far
+	indexed   int64   // Bytes indexed so far
+	lineStart bool    // The next indexed byte starts a new line
+	buf       []byte
+}
+
+// OpenFileLines opens path for paging. Call Refresh to index its content.
+func OpenFileLines(path string) (*FileLines, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open output: %w", err)
+	}
+	return &FileLines{file: file, lineStart: true, buf: make([]byte, scanChunk)}, nil
+}
+
+// Refresh indexes content appended since the last call. Returns true when it stopped at
+// the scan budget and more content is waiting.
+func (f *FileLines) Refresh() (bool, error) {
+	var scanned int64
+	for scanned < scanBudget {
+		n, err := f.file.ReadAt(f.buf, f.indexed)
+		f.index(f.buf[:n])
+		scanned += int64(n)
+		if err == io.EOF || n == 0 {
+			return false, nil
+		}
+		if err != nil {
+			return false, fmt.Errorf("failed to read output: %w", err)
+		}
+	}
+	return true, nil
+}
+
+// index records the line starts in chunk, which begins at f.indexed
+func (f *FileLines) index(chunk []byte) {
+	for pos := 0; pos < len(chunk); {
+		if f.lineStart {
+			f.offsets = append(f.offsets, f.indexed+int64(pos))
+			f.lineStart = false
+		}
+		newline := bytes.IndexByte(chunk[pos:], '\n')
+		if newline < 0 {
+			break
+		}
+		pos += newline + 1
+		f.lineStart = true
+	}
+	f.indexed += int64(len(chunk))
+}
+
+// Len returns the number of lines indexed so far, including an unterminated last line
+func (f *FileLines) Len() int {
+	return len(f.offsets)
+}
+
+// Lines reads lines from (inclusive) to to (exclusive). Lines longer than maxLineBytes are cut.
+func (f *FileLines) Lines(from, to int) []string {
+	from, to = max(from, 0), min(to, len(f.offsets))
+	lines := make([]string, 0, max(to-from, 0))
+	for i := from; i < to; i++ {
+		end := f.indexed
+		if i+1 < len(f.offsets) {
+			end = f.offsets[i+1]
+		}
+		length := min(end-f.offsets[i], maxLineBytes)
+
+		data := make([]byte, length)
+		n, _ := f.file.ReadAt(data, f.offsets[i])
+		line := strings.TrimRight(string(data[:n]), "\r\n")
+		lines = append(lines, strings.ReplaceAll(line, "\t", "    "))
+	}
+	return lines
+}
+
+// Close closes the file
+func (f *FileLines) Close() error {
+	return f.file.Close()
+}
@@ -0,0 +1,55 @@
+package components
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFileLines_IndexesGrowingFile(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "output.log")
+	out, err := os.Create(path)
+	require.NoError(t, err)
+	defer out.Close()
+
+	lines, err := OpenFileLines(path)
+	require.NoError(t, err)
+	defer lines.Close()
+
+	more, err := lines.Refresh()
+	require.NoError(t, err)
+	assert.False(t, more)
+	assert.Zero(t, lines.Len(), "empty file has no lines")
+
+	_, err = out.WriteString("first\r\nsec")
+	require.NoError(t, err)
+	_, err = lines.Refresh()
+	require.NoError(t, err)
+	assert.Equal(t, []string{"first", "sec"}, lines.Lines(0, lines.Len()), "unterminated last line is shown")
+
+	_, err = out.WriteString("ond\n\tthird\n")
+	require.NoError(t, err)
+	_, err = lines.Refresh()
+	require.NoError(t, err)
+	assert.Equal(t, 3, lines.Len())
+	assert.Equal(t, []string{"second", "    third"}, lines.Lines(1, 10), "range is clamped")
+}
+
+func TestFileLines_CutsLongLines(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "output.log")
+	require.NoError(t, os.WriteFile(path, []byte(strings.Repeat("x", 3*maxLineBytes)+"\nend\n"), 0600))
+
+	lines, err := OpenFileLines(path)
+	require.NoError(t, err)
+	defer lines.Close()
+	_, err = lines.Refresh()
+	require.NoError(t, err)
+
+	read := lines.Lines(0, 2)
+	assert.Len(t, read[0], maxLineBytes)
+	assert.Equal(t, "end", read[1])
+}
@@ -0,0 +1,157 @@
+package components
+
+import (
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+)
+
+// FormField is one labeled text input of a Form
+type FormField struct {
+	Label string
+	Value string
+	Error string // Why the value was refused, shown under the input
+}
+
+// Form is a dialog of labeled text inputs, such as the params an action asks for before
+// it runs. The caller checks the values once the form is submitted.
+type Form struct {
+	Title      string
+	Fields     []FormField
+	Focus      int    // Index of the field receiving typed text
+	Submit     string // What Enter does, shown in the footer (default Run)
+	IsVisible  bool
+	termWidth  int
+	termHeight int
+}
+
+// Form dialog styles
+var (
+	formStyle = lipgloss.NewStyle().
+			BorderStyle(lipgloss.RoundedBorder()).
+			BorderForeground(lipgloss.Color("39")). // Bright cyan
+			Padding(1, 2).
+			Width(64)
+
+	formTitleStyle = lipgloss.NewStyle().
+			Foreground(lipgloss.Color("39")).
+			Bold(true)
+
+	formInputStyle = lipgloss.NewStyle().
+			BorderStyle(lipgloss.NormalBorder()).
+			BorderForeground(lipgloss.Color("240")).
+			Width(56)
+
+	formFocusedInputStyle = formInputStyle.Copy().
+				BorderForeground(lipgloss.Color("39"))
+
+	formErrorStyle = lipgloss.NewStyle().
+			Foreground(lipgloss.Color("9")) // Red
+)
+
+// NewForm creates a new hidden form
+func NewForm() *Form {
+	return &Form{}
+}
+
+// Show displays the form with one input per field, focusing the first
+func (f *Form) Show(title string, fields []FormField) {
+	f.Title = title
+	f.Fields = fields
+	f.Focus = 0
+	f.IsVisible = true
+}
+
+// Hide dismisses the form
+func (f *Form) Hide() {
+	f.Title = ""
+	f.Fields = nil
+	f.Focus = 0
+	f.IsVisible = false
+}
+
+// Values returns the value of every field in order
+func (f *Form) Values() []string {
+	values := make([]string, len(f.Fields))
+	for i, field := range f.Fields {
+		values[i] = field.Value
+	}
+	return values
+}
+
+// SetSize updates the terminal dimensions for proper centering
+func (f *Form) SetSize(width, height int) {
+	f.termWidth = width
+	f.termHeight = height
+}
+
+// View renders the form dialog overlay
+func (f *Form) View() string {
+	if !f.IsVisible {
+		return ""
+	}
+
+	content := formTitleStyle.Render(f.Title) + "\n"
+	for i, field := range f.Fields {
+		content += "\n" + field.Label + "\n"
+		style := formInputStyle
+		input := field.Value
+		if i == f.Focus {
+			style = formFocusedInputStyle
+			input += "█"
+		}
+		content += style.Render(input) + "\n"
+		if field.Error != "" {
+			content += formErrorStyle.Render(field.Error) + "\n"
+		}
+	}
+
+	submit := f.Submit
+	if submit == "" {
+		submit = "Run"
+	}
+	content += "\n" + modalFooterStyle.Render("[Tab/↑/↓: Next field] [Enter: "+submit+"] [ESC: Cancel]")
+
+	dialog := formStyle.Render(content)
+	if f.termWidth > 0 && f.termHeight > 0 {
+		return lipgloss.Place(f.termWidth, f.termHeight, lipgloss.Center, lipgloss.Center, dialog)
+	}
+	return dialog
+}
+
+// HandleKeyPress processes keyboard input for the form. Returns true when the form is
+// submitted with Enter; ESC hides it.
+func (f *Form) HandleKeyPress(msg tea.KeyMsg) bool {
+	if !f.IsVisible || len(f.Fields) == 0 {
+		return false
+	}
+
+	field := &f.Fields[f.Focus]
+	switch msg.Type {
+	case tea.KeyEnter:
+		return true
+
+	case tea.KeyEsc, tea.KeyCtrlC:
+		f.Hide()
+
+	case tea.KeyTab, tea.KeyDown:
+		f.Focus = (f.Focus + 1) % len(f.Fields)
+
+	case tea.KeyShiftTab, tea.KeyUp:
+		f.Focus = (f.Focus + len(f.Fields) - 1) % len(f.Fields)
+
+	case tea.KeyBackspace:
+		if runes := []rune(field.Value); len(runes) > 0 {
+			field.Value = string(runes[:len(runes)-1])
+		}
+		field.Error = ""
+
+	case tea.KeyCtrlU:
+		field.Value = ""
+		field.Error = ""
+
+	case tea.KeyRunes, tea.KeySpace:
+		field.Value += string(msg.Runes)
+		field.Error = ""
+	}
+	return false
+}
@@ -0,0 +1,47 @@
+package components
+
+import (
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/stretchr/testify/assert"
+)
+
+func typeForm(f *Form, text string) {
+	for _, r := range text {
+		f.HandleKeyPress(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{r}})
+	}
+}
+
+func TestForm_EditFields(t *testing.T) {
+	f := NewForm()
+	f.Show("Tail logs", []FormField{{Label: "Lines", Value: "100"}, {Label: "Grep"}})
+	f.Fields[0].Error = "Lines must match ^[0-9]+$"
+
+	f.HandleKeyPress(tea.KeyMsg{Type: tea.KeyBackspace})
+	assert.Equal(t, "10", f.Fields[0].Value)
+	assert.Empty(t, f.Fields[0].Error, "editing clears the error")
+
+	f.HandleKeyPress(tea.KeyMsg{Type: tea.KeyTab})
+	typeForm(f, "ERROR")
+	assert.Equal(t, []string{"10", "ERROR"}, f.Values())
+
+	f.HandleKeyPress(tea.KeyMsg{Type: tea.KeyTab})
+	assert.Equal(t, 0, f.Focus, "focus wraps around")
+	f.HandleKeyPress(tea.KeyMsg{Type: tea.KeyCtrlU})
+	assert.Empty(t, f.Fields[0].Value)
+
+	assert.True(t, f.HandleKeyPress(tea.KeyMsg{Type: tea.KeyEnter}))
+	assert.True(t, f.IsVisible, "the caller decides whether to close")
+	assert.Contains(t, f.View(), "Tail logs")
+}
+
+func TestForm_EscCancels(t *testing.T) {
+	f := NewForm()
+	f.Show("Tail logs", []FormField{{Label: "Lines"}})
+
+	assert.False(t, f.HandleKeyPress(tea.KeyMsg{Type: tea.KeyEsc}))
+	assert.False(t, f.IsVisible)
+	assert.Empty(t, f.View())
+	assert.False(t, f.HandleKeyPress(tea.KeyMsg{Type: tea.KeyEnter}), "hidden forms ignore keys")
+}
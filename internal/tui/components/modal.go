@@ -10,8 +10,10 @@ type ErrorModal struct {
 	Message    string
 	Operation  string
 	Suggestion string
+	Details    string // Raw error text shown behind the details toggle
 	RetryFunc  func() tea.Cmd
 	IsVisible  bool
+	ShowDetail bool // Details are expanded
 	termWidth  int
 	termHeight int
 }
@@ -30,6 +32,9 @@ var (
 
 	modalFooterStyle = lipgloss.NewStyle().
 				Foreground(lipgloss.Color("240")) // Dim gray
+
+	modalDetailsStyle = lipgloss.NewStyle().
+				Foreground(lipgloss.Color("245")) // Gray
 )
 
 // NewErrorModal creates a new error modal
@@ -44,6 +49,8 @@ func (e *ErrorModal) Show(message, operation string, retryFunc func() tea.Cmd) {
 	e.Message = message
 	e.Operation = operation
 	e.RetryFunc = retryFunc
+	e.Details = ""
+	e.ShowDetail = false
 	e.IsVisible = true
 }
 
@@ -53,15 +60,25 @@ func (e *ErrorModal) ShowWithSuggestion(message, operation, suggestion string, r
 	e.Operation = operation
 	e.Suggestion = suggestion
 	e.RetryFunc = retryFunc
+	e.Details = ""
+	e.ShowDetail = false
 	e.IsVisible = true
 }
 
+// SetDetails attaches the raw error text, shown when the user toggles details
+func (e *ErrorModal) SetDetails(details string) {
+	e.Details = details
+	e.ShowDetail = false
+}
+
 // Hide dismisses the error modal
 func (e *ErrorModal) Hide() {
 	e.IsVisible = false
 	e.Message = ""
 	e.Operation = ""
 	e.Suggestion = ""
+	e.Details = ""
+	e.ShowDetail = false
 	e.RetryFunc = nil
 }
 
@@ -92,6 +109,11 @@ func (e *ErrorModal) View() string {
 		content += "\n" + e.Suggestion + "\n"
 	}
 
+	// Raw error text (if expanded)
+	if e.Details != "" && e.ShowDetail {
+		content += "\n" + modalDetailsStyle.Render("Details: "+e.Details) + "\n"
+	}
+
 	// Footer with instructions
 	content += "\n"
 	var footer string
@@ -100,6 +122,13 @@ func (e *ErrorModal) View() string {
 	} else {
 		footer = modalFooterStyle.Render("[Press ESC to exit]")
 	}
+	if e.Details != "" {
+		toggle := "[d: show details]"
+		if e.ShowDetail {
+			toggle = "[d: hide details]"
+		}
+		footer += " " + modalFooterStyle.Render(toggle)
+	}
 	content += footer
 
 	// Apply modal styling
@@ -141,6 +170,13 @@ func (e *ErrorModal) HandleKeyPress(key string) (bool, tea.Cmd) {
 		// Dismiss modal
 		e.Hide()
 		return true, nil
+
+	case "d":
+		// Toggle the raw error text
+		if e.Details != "" {
+			e.ShowDetail = !e.ShowDetail
+		}
+		return true, nil
 	}
 
 	// Block all other input when modal is visible
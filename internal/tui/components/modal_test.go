@@ -179,3 +179,29 @@ func TestErrorModal_HandleKeyPress_OtherKey(t *testing.T) {
 	assert.Nil(t, cmd)              // But doesn't execute any command
 	assert.True(t, modal.IsVisible) // Modal stays visible
 }
+
+func TestErrorModal_DetailsToggle(t *testing.T) {
+	modal := NewErrorModal()
+	modal.SetSize(100, 30)
+	modal.Show("Cluster API server refused the connection", "Fetch Pods", nil)
+	modal.SetDetails("dial tcp 127.0.0.1:6443: connect: connection refused")
+
+	view := modal.View()
+	assert.Contains(t, view, "[d: show details]")
+	assert.NotContains(t, view, "dial tcp")
+
+	handled, cmd := modal.HandleKeyPress("d")
+	assert.True(t, handled)
+	assert.Nil(t, cmd)
+	view = modal.View()
+	assert.Contains(t, view, "dial tcp")
+	assert.Contains(t, view, "[d: hide details]")
+
+	modal.HandleKeyPress("d")
+	assert.False(t, modal.ShowDetail)
+
+	// A new error drops the previous details
+	modal.Show("other error", "Fetch Pods", nil)
+	assert.Empty(t, modal.Details)
+	assert.NotContains(t, modal.View(), "[d:")
+}
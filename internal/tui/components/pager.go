@@ -0,0 +1,254 @@
+package components
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+)
+
+// LineSource provides the lines shown in a Pager
+type LineSource interface {
+	Len() int
+	Lines(from, to int) []string // Lines from (inclusive) to to (exclusive)
+}
+
+// stringLines is a LineSource over text held in memory
+type stringLines []string
+
+func (s stringLines) Len() int                    { return len(s) }
+func (s stringLines) Lines(from, to int) []string { return s[from:to] }
+
+// Pager is a full-screen, scrollable text viewer for command output such as kubectl describe
+type Pager struct {
+	Title      string
+	Status     string                   // Shown in the footer, e.g. whether a command is still running
+	Offset     int                      // Index of the first visible line
+	Follow     bool                     // Keep the last line visible as the source grows
+	Highlight  func(line string) string // Colors each visible line, e.g. HighlightYAML; nil shows lines as they are
+	Loading    bool
+	Err        error
+	IsVisible  bool
+	source     LineSource
+	termWidth  int
+	termHeight int
+}
+
+// Pager styles
+var (
+	pagerStyle = lipgloss.NewStyle().
+			BorderStyle(lipgloss.RoundedBorder()).
+			BorderForeground(lipgloss.Color("39")). // Bright cyan
+			Padding(0, 1)
+
+	pagerTitleStyle = lipgloss.NewStyle().
+			Foreground(lipgloss.Color("39")).
+			Bold(true)
+
+	pagerDimStyle = lipgloss.NewStyle().
+			Foreground(lipgloss.Color("240"))
+
+	pagerErrorStyle = lipgloss.NewStyle().
+			Foreground(lipgloss.Color("9")) // Red
+)
+
+// NewPager creates a new hidden pager
+func NewPager() *Pager {
+	return &Pager{}
+}
+
+// ShowLoading opens the pager with a loading message until SetContent or SetError is called
+func (p *Pager) ShowLoading(title string) {
+	p.Hide()
+	p.Title = title
+	p.Loading = true
+	p.IsVisible = true
+}
+
+// ShowSource opens the pager on a source that may still grow, following its end until
+// the user scrolls up
+func (p *Pager) ShowSource(title string, source LineSource) {
+	p.Hide()
+	p.Title = title
+	p.source = source
+	p.Follow = true
+	p.IsVisible = true
+	p.Refresh()
+}
+
+// Refresh picks up lines added to the source, scrolling to the end while following
+func (p *Pager) Refresh() {
+	if p.Follow {
+		p.scrollTo(p.lineCount())
+		return
+	}
+	p.scrollTo(p.Offset)
+}
+
+// SetContent replaces the text shown in the pager and scrolls back to the top
+func (p *Pager) SetContent(content string) {
+	p.source = stringLines(strings.Split(strings.TrimRight(strings.ReplaceAll(content, "\t", "    "), "\n"), "\n"))
+	p.Offset = 0
+	p.Err = nil
+	p.Loading = false
+}
+
+// SetError shows err instead of content
+func (p *Pager) SetError(err error) {
+	p.source = nil
+	p.Offset = 0
+	p.Err = err
+	p.Loading = false
+}
+
+// Hide closes the pager
+func (p *Pager) Hide() {
+	p.Title = ""
+	p.Status = ""
+	p.source = nil
+	p.Offset = 0
+	p.Follow = false
+	p.Highlight = nil
+	p.Err = nil
+	p.Loading = false
+	p.IsVisible = false
+}
+
+// lineCount returns the number of lines of the source
+func (p *Pager) lineCount() int {
+	if p.source == nil {
+		return 0
+	}
+	return p.source.Len()
+}
+
+// SetSize updates the terminal dimensions the pager fills
+func (p *Pager) SetSize(width, height int) {
+	p.termWidth = width
+	p.termHeight = height
+	p.scrollTo(p.Offset)
+}
+
+// pageHeight returns the number of content lines that fit on screen: border (2), title (1),
+// blank (1) and footer (2) take 6 lines
+func (p *Pager) pageHeight() int {
+	if p.termHeight == 0 {
+		return 20 // Default for tests
+	}
+	return max(p.termHeight-6, 1)
+}
+
+// scrollTo moves the first visible line to offset, keeping the last page full
+func (p *Pager) scrollTo(offset int) {
+	maxOffset := max(p.lineCount()-p.pageHeight(), 0)
+	p.Offset = min(max(offset, 0), maxOffset)
+}
+
+// HandleKeyPress scrolls or closes the pager. Returns true if the key was handled.
+func (p *Pager) HandleKeyPress(msg tea.KeyMsg) bool {
+	if !p.IsVisible {
+		return false
+	}
+
+	// Scrolling anywhere but to the end stops following the output
+	follow := false
+	switch msg.String() {
+	case "up", "k":
+		p.scrollTo(p.Offset - 1)
+	case "down", "j":
+		p.scrollTo(p.Offset + 1)
+	case "pgup", "b", "ctrl+b":
+		p.scrollTo(p.Offset - p.pageHeight())
+	case "pgdown", " ", "f", "ctrl+f":
+		p.scrollTo(p.Offset + p.pageHeight())
+	case "ctrl+u":
+		p.scrollTo(p.Offset - p.pageHeight()/2)
+	case "ctrl+d":
+		p.scrollTo(p.Offset + p.pageHeight()/2)
+	case "home", "g":
+		p.scrollTo(0)
+	case "end", "G":
+		p.scrollTo(p.lineCount())
+		follow = true
+	case "esc", "q":
+		p.Hide()
+	default:
+		return false
+	}
+	p.Follow = follow
+	return true
+}
+
+// View renders the pager filling the terminal
+func (p *Pager) View() string {
+	if !p.IsVisible {
+		return ""
+	}
+
+	height := p.pageHeight()
+	count := p.lineCount()
+	var body string
+	switch {
+	case p.Loading:
+		body = pagerDimStyle.Render("Loading...")
+	case p.Err != nil:
+		body = pagerErrorStyle.Render(fmt.Sprintf("Error: %v", p.Err))
+	case p.source != nil:
+		lines := p.source.Lines(p.Offset, min(p.Offset+height, count))
+		if p.Highlight != nil {
+			// Cut before coloring: truncateLines below cannot cut through the color codes
+			lines = slices.Clone(lines)
+			for i, line := range lines {
+				if p.termWidth > 0 {
+					line = truncateRunes(line, p.termWidth-4)
+				}
+				lines[i] = p.Highlight(line)
+			}
+		}
+		body = strings.Join(lines, "\n")
+	}
+	// Pad so the footer stays at the bottom
+	if lines := lipgloss.Height(body); lines < height {
+		body += strings.Repeat("\n", height-lines)
+	}
+
+	footer := "↑/↓ j/k: Scroll | PgUp/PgDn: Page | g/G: Top/Bottom | ESC/q: Close"
+	if count > height {
+		footer = fmt.Sprintf("Lines %d-%d of %d | %s", p.Offset+1, min(p.Offset+height, count), count, footer)
+	}
+	if p.Status != "" {
+		footer = p.Status + " | " + footer
+	}
+
+	content := pagerTitleStyle.Render(p.Title) + "\n\n" + body + "\n\n" + pagerDimStyle.Render(footer)
+
+	style := pagerStyle
+	if p.termWidth > 0 {
+		// Width includes the padding; the border (2) is added on top. Long lines are cut, not wrapped.
+		style = style.Copy().Width(p.termWidth - 2).MaxWidth(p.termWidth)
+		content = truncateLines(content, p.termWidth-4)
+	}
+	return style.Render(content)
+}
+
+// truncateLines cuts every line of s to width cells
+func truncateLines(s string, width int) string {
+	lines := strings.Split(s, "\n")
+	for i, line := range lines {
+		if lipgloss.Width(line) > width {
+			lines[i] = truncateRunes(line, width)
+		}
+	}
+	return strings.Join(lines, "\n")
+}
+
+// truncateRunes cuts plain text to width runes
+func truncateRunes(s string, width int) string {
+	runes := []rune(s)
+	if len(runes) <= max(width, 0) {
+		return s
+	}
+	return string(runes[:max(width, 0)])
+}
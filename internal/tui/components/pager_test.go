@@ -0,0 +1,114 @@
+package components
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/stretchr/testify/assert"
+)
+
+func pagerKey(p *Pager, key string) bool {
+	msg := tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune(key)}
+	switch key {
+	case "esc":
+		msg = tea.KeyMsg{Type: tea.KeyEsc}
+	case "pgdown":
+		msg = tea.KeyMsg{Type: tea.KeyPgDown}
+	}
+	return p.HandleKeyPress(msg)
+}
+
+func numberedLines(n int) string {
+	lines := make([]string, n)
+	for i := range lines {
+		lines[i] = fmt.Sprintf("line %d", i+1)
+	}
+	return strings.Join(lines, "\n")
+}
+
+func TestPager_Scrolling(t *testing.T) {
+	p := NewPager()
+	p.SetSize(80, 16) // 10 content lines per page
+	p.ShowLoading("Describe")
+	p.SetContent(numberedLines(25))
+
+	assert.True(t, pagerKey(p, "j"))
+	assert.Equal(t, 1, p.Offset)
+	pagerKey(p, "k")
+	pagerKey(p, "k")
+	assert.Equal(t, 0, p.Offset, "cannot scroll above the first line")
+
+	pagerKey(p, "pgdown")
+	assert.Equal(t, 10, p.Offset)
+	pagerKey(p, "G")
+	assert.Equal(t, 15, p.Offset, "last page stays full")
+	pagerKey(p, "j")
+	assert.Equal(t, 15, p.Offset)
+	pagerKey(p, "g")
+	assert.Equal(t, 0, p.Offset)
+
+	assert.False(t, pagerKey(p, "x"), "unknown keys are not handled")
+}
+
+func TestPager_View(t *testing.T) {
+	p := NewPager()
+	p.SetSize(60, 16)
+	p.ShowLoading("Describe: web-1")
+	assert.Contains(t, p.View(), "Loading")
+
+	p.SetContent(numberedLines(25) + "\n" + strings.Repeat("x", 200))
+	view := p.View()
+	assert.Contains(t, view, "Describe: web-1")
+	assert.Contains(t, view, "line 10")
+	assert.NotContains(t, view, "line 11")
+	assert.Contains(t, view, "Lines 1-10 of 26")
+	assert.Equal(t, 16, lipgloss.Height(view), "pager fills the terminal height")
+	for _, line := range strings.Split(view, "\n") {
+		assert.LessOrEqual(t, lipgloss.Width(line), 60, "long lines are cut to the terminal width")
+	}
+
+	p.SetError(errors.New("forbidden"))
+	assert.Contains(t, p.View(), "Error: forbidden")
+}
+
+func TestPager_EscCloses(t *testing.T) {
+	p := NewPager()
+	p.ShowLoading("Describe")
+	p.SetContent("Name: web-1")
+
+	assert.True(t, pagerKey(p, "esc"))
+	assert.False(t, p.IsVisible)
+	assert.Empty(t, p.View())
+	assert.False(t, pagerKey(p, "j"), "hidden pager ignores keys")
+}
+
+func TestPager_FollowsGrowingSource(t *testing.T) {
+	source := stringLines(strings.Split(numberedLines(5), "\n"))
+	p := NewPager()
+	p.SetSize(80, 16) // 10 content lines per page
+	p.ShowSource("Output", source)
+	assert.True(t, p.Follow)
+	assert.Equal(t, 0, p.Offset)
+
+	source = stringLines(strings.Split(numberedLines(30), "\n"))
+	p.source = source
+	p.Refresh()
+	assert.Equal(t, 20, p.Offset, "following keeps the last line visible")
+
+	pagerKey(p, "k")
+	assert.False(t, p.Follow, "scrolling up stops following")
+	p.source = stringLines(strings.Split(numberedLines(40), "\n"))
+	p.Refresh()
+	assert.Equal(t, 19, p.Offset)
+
+	pagerKey(p, "G")
+	assert.True(t, p.Follow, "jumping to the end follows again")
+	assert.Equal(t, 30, p.Offset)
+
+	p.Status = "Running"
+	assert.Contains(t, p.View(), "Running | Lines 31-40 of 40")
+}
@@ -1,6 +1,7 @@
 package components
 
 import (
+	"fmt"
 	"time"
 
 	tea "github.com/charmbracelet/bubbletea"
@@ -10,12 +11,20 @@ import (
 // SpinnerTickMsg is sent when the spinner should advance to the next frame
 type SpinnerTickMsg struct{}
 
+// DefaultSpinnerInterval is the time between spinner frames unless Interval is set
+const DefaultSpinnerInterval = 100 * time.Millisecond
+
+// elapsedAfter is how long a spinner runs before it shows for how long, e.g. "Loading pods... 7s"
+const elapsedAfter = 2 * time.Second
+
 // Spinner represents a loading animation
 type Spinner struct {
 	FrameIndex int
 	Message    string
 	IsActive   bool
 	Frames     []string
+	Interval   time.Duration // Time between frames, advanced by ticks from TickEvery
+	Started    time.Time     // When Start was called
 }
 
 var (
@@ -30,7 +39,8 @@ func NewSpinner() *Spinner {
 		FrameIndex: 0,
 		IsActive:   false,
 		// Unicode dot spinner frames
-		Frames: []string{"⠋", "⠙", "⠹", "⠸", "⠼", "⠴", "⠦", "⠧", "⠇", "⠏"},
+		Frames:   []string{"⠋", "⠙", "⠹", "⠸", "⠼", "⠴", "⠦", "⠧", "⠇", "⠏"},
+		Interval: DefaultSpinnerInterval,
 	}
 }
 
@@ -39,6 +49,15 @@ func (s *Spinner) Start(message string) {
 	s.Message = message
 	s.IsActive = true
 	s.FrameIndex = 0
+	s.Started = time.Now()
+}
+
+// Elapsed returns how long the spinner has been running, zero when it is stopped
+func (s *Spinner) Elapsed() time.Duration {
+	if !s.IsActive {
+		return 0
+	}
+	return time.Since(s.Started)
 }
 
 // Stop deactivates the spinner
@@ -64,14 +83,29 @@ func (s *Spinner) View() string {
 		return s.Message
 	}
 
-	frame := s.Frames[s.FrameIndex]
-	return spinnerStyle.Render(frame) + " " + s.Message
+	frame := s.Frames[s.FrameIndex%len(s.Frames)]
+	return spinnerStyle.Render(frame) + " " + s.Message + s.elapsedText()
 }
 
-// TickCmd returns a command that sends a spinner tick message after a delay
+// elapsedText returns the time the spinner has been running, once that is long enough to
+// be worth showing
+func (s *Spinner) elapsedText() string {
+	elapsed := s.Elapsed()
+	if elapsed < elapsedAfter {
+		return ""
+	}
+	return fmt.Sprintf(" %ds", int(elapsed.Seconds()))
+}
+
+// TickCmd returns a command that sends a spinner tick message after DefaultSpinnerInterval
 func TickCmd() tea.Cmd {
+	return TickEvery(DefaultSpinnerInterval)
+}
+
+// TickEvery returns a command that sends a spinner tick message after interval
+func TickEvery(interval time.Duration) tea.Cmd {
 	return func() tea.Msg {
-		time.Sleep(100 * time.Millisecond)
+		time.Sleep(interval)
 		return SpinnerTickMsg{}
 	}
 }
@@ -2,6 +2,7 @@ package components
 
 import (
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 )
@@ -133,3 +134,25 @@ func TestTickCmd(t *testing.T) {
 	_, ok := msg.(SpinnerTickMsg)
 	assert.True(t, ok)
 }
+
+func TestSpinner_View_Elapsed(t *testing.T) {
+	spinner := NewSpinner()
+	spinner.Frames = []string{"A"}
+	spinner.Start("Loading pods...")
+	assert.Equal(t, "A Loading pods...", spinner.View(), "short fetches show no time")
+
+	spinner.Started = time.Now().Add(-7 * time.Second)
+	assert.Equal(t, "A Loading pods... 7s", spinner.View())
+
+	spinner.Stop()
+	assert.Zero(t, spinner.Elapsed())
+}
+
+func TestTickEvery(t *testing.T) {
+	start := time.Now()
+	msg := TickEvery(20 * time.Millisecond)()
+
+	_, ok := msg.(SpinnerTickMsg)
+	assert.True(t, ok)
+	assert.GreaterOrEqual(t, time.Since(start), 20*time.Millisecond)
+}
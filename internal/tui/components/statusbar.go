@@ -0,0 +1,132 @@
+package components
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/charmbracelet/lipgloss"
+)
+
+// ToastLevel sets how a toast is colored
+type ToastLevel int
+
+const (
+	ToastInfo ToastLevel = iota
+	ToastSuccess
+	ToastWarning
+	ToastError
+)
+
+// Toast is a transient, non-blocking notification such as "Pods refreshed"
+type Toast struct {
+	Text  string
+	Level ToastLevel
+}
+
+// maxQueuedToasts bounds the queue; the oldest waiting toasts are dropped first
+const maxQueuedToasts = 5
+
+// StatusBar is a one-line bar showing queued toasts one after another, each for Duration
+type StatusBar struct {
+	Duration time.Duration
+	queue    []Toast // queue[0] is shown
+	shownAt  time.Time
+}
+
+// Status bar styles
+var (
+	toastInfoStyle = lipgloss.NewStyle().
+			Foreground(lipgloss.Color("252")) // Light gray
+
+	toastSuccessStyle = lipgloss.NewStyle().
+				Foreground(lipgloss.Color("10")) // Green
+
+	toastWarningStyle = lipgloss.NewStyle().
+				Foreground(lipgloss.Color("214")) // Orange/yellow
+
+	toastErrorStyle = lipgloss.NewStyle().
+			Foreground(lipgloss.Color("9")) // Red
+)
+
+// NewStatusBar creates an empty status bar showing each toast for 3 seconds
+func NewStatusBar() *StatusBar {
+	return &StatusBar{Duration: 3 * time.Second}
+}
+
+// Push queues a toast. A toast repeating the last queued one only restarts its time. Returns
+// true when the bar was empty and the toast is shown right away, so the caller has to
+// schedule its expiry.
+func (s *StatusBar) Push(toast Toast, now time.Time) bool {
+	if n := len(s.queue); n > 0 && s.queue[n-1] == toast {
+		if n == 1 {
+			s.shownAt = now
+		}
+		return false
+	}
+
+	s.queue = append(s.queue, toast)
+	if len(s.queue) == 1 {
+		s.shownAt = now
+		return true
+	}
+	if len(s.queue) > maxQueuedToasts {
+		// Keep the shown toast and drop the oldest waiting one
+		s.queue = append(s.queue[:1], s.queue[2:]...)
+	}
+	return false
+}
+
+// Expire drops the shown toast once it has been shown for Duration and shows the next one.
+// Returns how long until the shown toast expires, or 0 when the bar is empty.
+func (s *StatusBar) Expire(now time.Time) time.Duration {
+	if len(s.queue) == 0 {
+		return 0
+	}
+	if elapsed := now.Sub(s.shownAt); elapsed < s.Duration {
+		return s.Duration - elapsed
+	}
+
+	s.queue = s.queue[1:]
+	if len(s.queue) == 0 {
+		return 0
+	}
+	s.shownAt = now
+	return s.Duration
+}
+
+// Current returns the shown toast, if any. A nil bar shows nothing.
+func (s *StatusBar) Current() (Toast, bool) {
+	if s == nil || len(s.queue) == 0 {
+		return Toast{}, false
+	}
+	return s.queue[0], true
+}
+
+// View renders the shown toast cut to width cells, or "" when the bar is empty
+func (s *StatusBar) View(width int) string {
+	if s == nil {
+		return ""
+	}
+	toast, ok := s.Current()
+	if !ok {
+		return ""
+	}
+
+	style := toastInfoStyle
+	switch toast.Level {
+	case ToastSuccess:
+		style = toastSuccessStyle
+	case ToastWarning:
+		style = toastWarningStyle
+	case ToastError:
+		style = toastErrorStyle
+	}
+	text := " " + toast.Text
+	if pending := len(s.queue) - 1; pending > 0 {
+		text += fmt.Sprintf(" (+%d more)", pending)
+	}
+	if width > 0 {
+		return style.Copy().MaxWidth(width).Render(text)
+	}
+	return style.Render(text)
+}
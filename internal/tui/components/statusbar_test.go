@@ -0,0 +1,61 @@
+package components
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestStatusBar_ShowsToastsInTurn(t *testing.T) {
+	bar := NewStatusBar()
+	now := time.Now()
+
+	assert.True(t, bar.Push(Toast{Text: "Pods refreshed"}, now), "first toast is shown right away")
+	assert.False(t, bar.Push(Toast{Text: "Copied pod name", Level: ToastSuccess}, now), "second toast waits")
+	assert.Contains(t, bar.View(0), "Pods refreshed (+1 more)")
+
+	// Not expired yet: the remaining time is returned
+	assert.Equal(t, time.Second, bar.Expire(now.Add(2*time.Second)))
+
+	assert.Equal(t, bar.Duration, bar.Expire(now.Add(3*time.Second)))
+	toast, ok := bar.Current()
+	assert.True(t, ok)
+	assert.Equal(t, "Copied pod name", toast.Text)
+
+	assert.Zero(t, bar.Expire(now.Add(6*time.Second)))
+	assert.Empty(t, bar.View(80))
+}
+
+func TestStatusBar_RepeatedToastRestartsTimer(t *testing.T) {
+	bar := NewStatusBar()
+	now := time.Now()
+
+	bar.Push(Toast{Text: "Pods refreshed"}, now)
+	assert.False(t, bar.Push(Toast{Text: "Pods refreshed"}, now.Add(2*time.Second)))
+	assert.Equal(t, 2*time.Second, bar.Expire(now.Add(3*time.Second)), "timer restarted by the repeat")
+	assert.Contains(t, bar.View(0), "Pods refreshed")
+	assert.NotContains(t, bar.View(0), "more")
+}
+
+func TestStatusBar_QueueIsBounded(t *testing.T) {
+	bar := NewStatusBar()
+	now := time.Now()
+	for i := range 10 {
+		bar.Push(Toast{Text: fmt.Sprintf("toast %d", i)}, now)
+	}
+
+	assert.Len(t, bar.queue, maxQueuedToasts)
+	assert.Equal(t, "toast 0", bar.queue[0].Text, "shown toast is kept")
+	assert.Equal(t, "toast 9", bar.queue[maxQueuedToasts-1].Text, "newest toasts are kept")
+}
+
+func TestStatusBar_ViewTruncates(t *testing.T) {
+	bar := NewStatusBar()
+	bar.Push(Toast{Text: "Forwarding localhost:8080 → web-1:8080", Level: ToastWarning}, time.Now())
+	assert.Equal(t, " Forwarding", bar.View(11))
+
+	var empty *StatusBar
+	assert.Empty(t, empty.View(80))
+}
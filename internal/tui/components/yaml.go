@@ -0,0 +1,81 @@
+package components
+
+import (
+	"strconv"
+	"strings"
+
+	"github.com/charmbracelet/lipgloss"
+)
+
+// YAML highlighting styles
+var (
+	yamlKeyStyle = lipgloss.NewStyle().
+			Foreground(lipgloss.Color("75")) // Light blue
+
+	yamlStringStyle = lipgloss.NewStyle().
+			Foreground(lipgloss.Color("114")) // Green
+
+	yamlLiteralStyle = lipgloss.NewStyle().
+				Foreground(lipgloss.Color("179")) // Amber
+
+	yamlDimStyle = lipgloss.NewStyle().
+			Foreground(lipgloss.Color("240")) // Gray
+)
+
+// HighlightYAML colors one line of YAML: keys, string values, numbers, booleans and null,
+// comments and list markers. It works line by line, so the content of multi-line strings
+// is colored as if it were YAML too.
+func HighlightYAML(line string) string {
+	body := strings.TrimLeft(line, " ")
+	out := line[:len(line)-len(body)]
+	if strings.HasPrefix(body, "#") {
+		return out + yamlDimStyle.Render(body)
+	}
+
+	for strings.HasPrefix(body, "- ") || body == "-" {
+		rest := body[1:]
+		body = strings.TrimLeft(rest, " ")
+		out += yamlDimStyle.Render("-") + rest[:len(rest)-len(body)]
+	}
+	if body == "" {
+		return out
+	}
+
+	if key, value, ok := splitYAMLKey(body); ok {
+		out += yamlKeyStyle.Render(key) + ":"
+		if value == "" {
+			return out
+		}
+		return out + " " + highlightYAMLValue(value)
+	}
+	return out + highlightYAMLValue(body)
+}
+
+// splitYAMLKey splits "key: value" and "key:" lines; keys with spaces are not recognized, so
+// plain text containing a colon is left alone
+func splitYAMLKey(body string) (key, value string, ok bool) {
+	if strings.HasSuffix(body, ":") {
+		key = body[:len(body)-1]
+	} else if i := strings.Index(body, ": "); i > 0 {
+		key, value = body[:i], strings.TrimLeft(body[i+2:], " ")
+	}
+	if key == "" || strings.ContainsAny(key, " \t") {
+		return "", "", false
+	}
+	return key, value, true
+}
+
+// highlightYAMLValue colors a scalar value by its type; block indicators and empty
+// collections are dimmed
+func highlightYAMLValue(value string) string {
+	switch value {
+	case "|", "|-", "|+", ">", ">-", ">+", "{}", "[]":
+		return yamlDimStyle.Render(value)
+	case "true", "false", "null", "~":
+		return yamlLiteralStyle.Render(value)
+	}
+	if _, err := strconv.ParseFloat(value, 64); err == nil {
+		return yamlLiteralStyle.Render(value)
+	}
+	return yamlStringStyle.Render(value)
+}
@@ -0,0 +1,53 @@
+package components
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/charmbracelet/lipgloss"
+	"github.com/muesli/termenv"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestHighlightYAML(t *testing.T) {
+	profile := lipgloss.ColorProfile()
+	defer lipgloss.SetColorProfile(profile)
+	lipgloss.SetColorProfile(termenv.ANSI256)
+
+	key, str, literal, dim := yamlKeyStyle.Render, yamlStringStyle.Render, yamlLiteralStyle.Render, yamlDimStyle.Render
+	tests := []struct {
+		line string
+		want string
+	}{
+		{"apiVersion: v1", key("apiVersion") + ": " + str("v1")},
+		{"metadata:", key("metadata") + ":"},
+		{"  restartCount: 3", "  " + key("restartCount") + ": " + literal("3")},
+		{"    ready: true", "    " + key("ready") + ": " + literal("true")},
+		{"  - name: web", "  " + dim("-") + " " + key("name") + ": " + str("web")},
+		{"  - --port=8080", "  " + dim("-") + " " + str("--port=8080")},
+		{"  labels: {}", "  " + key("labels") + ": " + dim("{}")},
+		{"  script: |", "  " + key("script") + ": " + dim("|")},
+		{"# generated", dim("# generated")},
+		{"    message: back-off restarting: web", "    " + key("message") + ": " + str("back-off restarting: web")},
+		{"    echo hello: world", "    " + str("echo hello: world")},
+		{"", ""},
+	}
+	for _, tt := range tests {
+		assert.Equal(t, tt.want, HighlightYAML(tt.line), tt.line)
+	}
+}
+
+func TestPager_Highlight(t *testing.T) {
+	p := NewPager()
+	p.SetSize(20, 16)
+	p.ShowLoading("YAML")
+	p.Highlight = strings.ToUpper
+	p.SetContent("kind: Pod\nmetadata:\n  name: a-pod-with-a-very-long-name")
+
+	view := p.View()
+	assert.Contains(t, view, "KIND: POD")
+	assert.Contains(t, view, "  NAME: A-POD-WI │", "lines are cut to the width before highlighting")
+
+	p.Hide()
+	assert.Nil(t, p.Highlight)
+}
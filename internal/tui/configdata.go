@@ -0,0 +1,166 @@
+package tui
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"strings"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/maratkarimov/kubertino/internal/k8s"
+)
+
+// secretMask replaces every line of a masked secret value
+const secretMask = "••••••••"
+
+// ConfigDataViewer is implemented by adapters that can read the keys of configmaps and
+// secrets. Without it, the resource browser only lists them.
+type ConfigDataViewer interface {
+	GetConfigData(context, namespace string, kind k8s.ResourceKind, name string) (k8s.ConfigData, error)
+}
+
+// configDataFetchedMsg is sent when the keys of a configmap or secret have been fetched
+type configDataFetchedMsg = resultMsg[k8s.ConfigData]
+
+// browsingConfigData reports whether the right panel lists configmaps or secrets
+func (m AppModel) browsingConfigData() bool {
+	return m.resourceKind == k8s.KindConfigMap || m.resourceKind == k8s.KindSecret
+}
+
+// openConfigData shows the pager for the selected configmap or secret and starts fetching
+// its keys. Secret values start masked.
+func (m AppModel) openConfigData() (tea.Model, tea.Cmd) {
+	viewer, ok := m.kubeAdapter.(ConfigDataViewer)
+	if !ok || m.currentContext == nil {
+		return m, nil
+	}
+	if m.selectedResourceIndex < 0 || m.selectedResourceIndex >= len(m.resources) {
+		return m, nil
+	}
+
+	resource := m.resources[m.selectedResourceIndex]
+	m.viewMode = viewModeConfigData
+	m.configData = k8s.ConfigData{}
+	m.revealSecrets = false
+	m.pager.SetSize(m.termWidth, m.termHeight)
+	m.pager.ShowLoading(configDataTitle(resource.Kind, resource.Name))
+
+	contextName, namespace := m.currentContext.Name, m.currentNamespace
+	return m, fetchCmd(m.requests, asyncConfigData, "", func(context.Context) (k8s.ConfigData, error) {
+		// Only the name is logged, never values
+		slog.Info("fetching config data", "kind", resource.Kind, "name", resource.Name, "namespace", namespace)
+		data, err := viewer.GetConfigData(contextName, namespace, resource.Kind, resource.Name)
+		if err != nil {
+			slog.Error("config data fetch failed", "kind", resource.Kind, "name", resource.Name, "error", err)
+		}
+		return data, err
+	})
+}
+
+// handleConfigDataFetched shows the fetched keys if the pager is still open for that request
+func (m AppModel) handleConfigDataFetched(msg configDataFetchedMsg) (tea.Model, tea.Cmd) {
+	if !m.requests.current(msg.asyncRequest) || m.viewMode != viewModeConfigData {
+		return m, nil
+	}
+
+	if msg.err != nil {
+		m.pager.SetError(msg.err)
+		return m, nil
+	}
+	m.configData = msg.value
+	if m.configData.Type != "" {
+		m.pager.Title = configDataTitle(m.configData.Kind, m.configData.Name) + " (" + m.configData.Type + ")"
+	}
+	m.pager.SetContent(renderConfigData(m.configData, m.revealSecrets))
+	m.pager.Status = m.configDataStatus()
+	return m, nil
+}
+
+// handleConfigDataKey handles key presses while the configmap or secret viewer is open:
+// m toggles whether secret values are shown, everything else scrolls or closes the pager
+func (m AppModel) handleConfigDataKey(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	if msg.Type == tea.KeyCtrlC {
+		return m, tea.Quit
+	}
+
+	switch {
+	case KeyMatches(msg, m.keys.Describe):
+		m.pager.Hide()
+	case msg.String() == "m" && m.configData.Kind == k8s.KindSecret:
+		m.revealSecrets = !m.revealSecrets
+		slog.Info("secret values toggled", "name", m.configData.Name, "revealed", m.revealSecrets)
+		// Masking keeps one line per value line, so the scroll position stays put
+		offset := m.pager.Offset
+		m.pager.SetContent(renderConfigData(m.configData, m.revealSecrets))
+		m.pager.Offset = offset
+		m.pager.Status = m.configDataStatus()
+	default:
+		m.pager.HandleKeyPress(msg)
+	}
+
+	if !m.pager.IsVisible {
+		m.requests.cancel(asyncConfigData)
+		// Drop the values as soon as they are no longer shown
+		m.configData = k8s.ConfigData{}
+		m.revealSecrets = false
+		m.viewMode = viewModeNamespaceView
+	}
+	return m, nil
+}
+
+// configDataStatus describes in the pager footer whether secret values are shown
+func (m AppModel) configDataStatus() string {
+	if m.configData.Kind != k8s.KindSecret {
+		return ""
+	}
+	if m.revealSecrets {
+		return "Values shown | m: Hide"
+	}
+	return "Values hidden | m: Reveal"
+}
+
+// configDataTitle names the configmap or secret in the pager title
+func configDataTitle(kind k8s.ResourceKind, name string) string {
+	if kind == k8s.KindSecret {
+		return "Secret: " + name
+	}
+	return "ConfigMap: " + name
+}
+
+// renderConfigData renders every key with its value indented below it. Secret values are
+// replaced by a mask line per value line unless reveal is set; binary values only show
+// their size.
+func renderConfigData(data k8s.ConfigData, reveal bool) string {
+	if len(data.Entries) == 0 {
+		return "(no keys)"
+	}
+
+	masked := data.Kind == k8s.KindSecret && !reveal
+	var lines []string
+	for i, entry := range data.Entries {
+		if i > 0 {
+			lines = append(lines, "")
+		}
+		lines = append(lines, fmt.Sprintf("%s (%s)", entry.Key, byteCount(entry.Size)))
+
+		if entry.Binary {
+			lines = append(lines, "    (binary data)")
+			continue
+		}
+		for _, line := range strings.Split(strings.TrimSuffix(entry.Value, "\n"), "\n") {
+			if masked {
+				line = secretMask
+			}
+			lines = append(lines, "    "+line)
+		}
+	}
+	return strings.Join(lines, "\n")
+}
+
+// byteCount describes a value size (e.g. "1 byte", "12 bytes")
+func byteCount(n int) string {
+	if n == 1 {
+		return "1 byte"
+	}
+	return fmt.Sprintf("%d bytes", n)
+}
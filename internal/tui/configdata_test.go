@@ -0,0 +1,90 @@
+package tui
+
+import (
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/maratkarimov/kubertino/internal/k8s"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// configDataAdapter is a mock adapter that also lists and reads configmaps and secrets
+type configDataAdapter struct {
+	*resourceAdapter
+	data map[string]k8s.ConfigData
+}
+
+func (a *configDataAdapter) GetConfigData(context, namespace string, kind k8s.ResourceKind, name string) (k8s.ConfigData, error) {
+	return a.data[name], nil
+}
+
+// newConfigDataTestModel returns a model browsing secrets with db highlighted
+func newConfigDataTestModel(t *testing.T) AppModel {
+	t.Helper()
+	model := newResourceTestModel()
+	model.kubeAdapter = &configDataAdapter{
+		resourceAdapter: &resourceAdapter{
+			mockKubeAdapter: newMockAdapter(),
+			resources: map[k8s.ResourceKind][]k8s.Resource{
+				k8s.KindSecret: {{Kind: k8s.KindSecret, Name: "db", Status: "2 keys"}},
+			},
+		},
+		data: map[string]k8s.ConfigData{
+			"db": {Kind: k8s.KindSecret, Name: "db", Type: "Opaque", Entries: []k8s.ConfigEntry{
+				{Key: "password", Value: "s3cr3t", Size: 6},
+				{Key: "tls.key", Value: "line one\nline two\n", Size: 18},
+			}},
+		},
+	}
+	model.resourceKind = k8s.KindConfigMap
+	model = cycleResourceKind(t, model)
+	require.Equal(t, k8s.KindSecret, model.resourceKind)
+	return model
+}
+
+func TestConfigData_SecretMaskedUntilRevealed(t *testing.T) {
+	model := newConfigDataTestModel(t)
+	assert.Contains(t, model.renderResourcePanel(60, 20), "Enter: View keys")
+
+	updated, cmd := model.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	model = updated.(AppModel)
+	require.Equal(t, viewModeConfigData, model.viewMode)
+	require.NotNil(t, cmd)
+	updated, _ = model.Update(cmd())
+	model = updated.(AppModel)
+
+	view := model.View()
+	assert.Contains(t, view, "Secret: db (Opaque)")
+	assert.Contains(t, view, "password (6 bytes)")
+	assert.Contains(t, view, "Values hidden")
+	assert.NotContains(t, view, "s3cr3t", "values start masked")
+	assert.NotContains(t, view, "line one")
+
+	model = sendKey(model, runeKey('m'))
+	view = model.View()
+	assert.Contains(t, view, "s3cr3t")
+	assert.Contains(t, view, "line two")
+	assert.Contains(t, view, "Values shown")
+
+	model = sendKey(model, runeKey('m'))
+	assert.NotContains(t, model.View(), "s3cr3t")
+
+	model = sendKey(model, tea.KeyMsg{Type: tea.KeyEsc})
+	assert.Equal(t, viewModeNamespaceView, model.viewMode)
+	assert.Empty(t, model.configData.Entries, "values are dropped on close")
+	assert.False(t, model.revealSecrets)
+}
+
+func TestRenderConfigData(t *testing.T) {
+	data := k8s.ConfigData{Kind: k8s.KindConfigMap, Name: "web", Entries: []k8s.ConfigEntry{
+		{Key: "LEVEL", Value: "debug", Size: 5},
+		{Key: "logo.png", Binary: true, Size: 1},
+	}}
+	assert.Equal(t, "LEVEL (5 bytes)\n    debug\n\nlogo.png (1 byte)\n    (binary data)", renderConfigData(data, false),
+		"configmap values are never masked")
+
+	data.Kind = k8s.KindSecret
+	assert.Equal(t, "LEVEL (5 bytes)\n    "+secretMask+"\n\nlogo.png (1 byte)\n    (binary data)", renderConfigData(data, false))
+	assert.Equal(t, "(no keys)", renderConfigData(k8s.ConfigData{Kind: k8s.KindSecret}, false))
+}
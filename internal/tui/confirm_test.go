@@ -0,0 +1,67 @@
+package tui
+
+import (
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/maratkarimov/kubertino/internal/config"
+	"github.com/maratkarimov/kubertino/internal/k8s"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// newConfirmTestModel returns a namespace view with a pod selected and a destructive action
+func newConfirmTestModel() AppModel {
+	model := newTestModel(newMockAdapter(), withContexts(config.Context{Name: "dev"}), withActions(
+		config.Action{Name: "Logs", Shortcut: "l", Command: "echo {{.pod}}"},
+		config.Action{Name: "Drop DB", Shortcut: "d", Command: "echo drop {{.namespace}}", Destructive: true},
+	))
+	model.currentNamespace = "payments"
+	model.pods = []k8s.Pod{{Name: "api-1", Status: "Running"}}
+	model.selectedPodIndex = 0
+	model.focusedPanel = PanelPods
+	return model
+}
+
+func TestDestructiveAction_RequiresTypedNamespace(t *testing.T) {
+	model := newConfirmTestModel()
+
+	updated, cmd := model.Update(runeKey('d'))
+	model = updated.(AppModel)
+	assert.Nil(t, cmd, "destructive action waits for confirmation")
+	require.True(t, model.confirm.IsVisible)
+	assert.Contains(t, model.View(), "Type payments to confirm")
+
+	// Keys go to the prompt, not to bindings or action shortcuts
+	for _, r := range "paymentsq" {
+		model = sendKey(model, runeKey(r))
+	}
+	model = sendKey(model, tea.KeyMsg{Type: tea.KeyEnter})
+	assert.True(t, model.confirm.IsVisible, "wrong name does not confirm")
+
+	model = sendKey(model, tea.KeyMsg{Type: tea.KeyBackspace})
+	updated, cmd = model.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	model = updated.(AppModel)
+	assert.NotNil(t, cmd, "action runs once the namespace is typed")
+	assert.False(t, model.confirm.IsVisible)
+}
+
+func TestDestructiveAction_Cancel(t *testing.T) {
+	model := newConfirmTestModel()
+	model = sendKey(model, runeKey('d'))
+
+	updated, cmd := model.Update(tea.KeyMsg{Type: tea.KeyEsc})
+	model = updated.(AppModel)
+	assert.Nil(t, cmd, "ESC cancels instead of quitting")
+	assert.False(t, model.confirm.IsVisible)
+	assert.Equal(t, viewModeNamespaceView, model.viewMode)
+}
+
+func TestNonDestructiveAction_RunsImmediately(t *testing.T) {
+	model := newConfirmTestModel()
+
+	updated, cmd := model.Update(runeKey('l'))
+	model = updated.(AppModel)
+	assert.NotNil(t, cmd)
+	assert.False(t, model.confirm.IsVisible)
+}
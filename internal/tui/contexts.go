@@ -0,0 +1,93 @@
+package tui
+
+import (
+	"log/slog"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/maratkarimov/kubertino/internal/k8s"
+)
+
+// contextSnapshot is the namespace view state of a context, kept while another context is
+// shown so that switching back is instant
+type contextSnapshot struct {
+	namespaces             []k8s.Namespace
+	favoriteNamespaces     []string
+	selectedNamespaceIndex int
+	namespaceViewportStart int
+	currentNamespace       string
+	pods                   []k8s.Pod
+	selectedPodIndex       int
+	podScrollOffset        int
+	restartStorms          map[string]*k8s.RestartStorm
+	podCounts              map[string]int
+}
+
+// cacheContextState stores the namespace view state of the current context. Contexts whose
+// namespaces are still loading are not cached.
+func (m *AppModel) cacheContextState() {
+	if m.currentContext == nil || m.namespacesLoading || m.namespacesError != nil {
+		return
+	}
+	if m.contextCache == nil {
+		m.contextCache = make(map[string]contextSnapshot)
+	}
+
+	m.contextCache[m.currentContext.Name] = contextSnapshot{
+		namespaces:             m.namespaces,
+		favoriteNamespaces:     m.favoriteNamespaces,
+		selectedNamespaceIndex: m.selectedNamespaceIndex,
+		namespaceViewportStart: m.namespaceViewportStart,
+		currentNamespace:       m.currentNamespace,
+		pods:                   m.pods,
+		selectedPodIndex:       m.selectedPodIndex,
+		podScrollOffset:        m.podScrollOffset,
+		restartStorms:          m.restartStorms,
+		podCounts:              m.podCounts,
+	}
+}
+
+// restoreContextState restores the cached namespace view state of a context.
+// Returns false when the context has not been visited yet.
+func (m *AppModel) restoreContextState(contextName string) bool {
+	snapshot, ok := m.contextCache[contextName]
+	if !ok {
+		return false
+	}
+
+	slog.Debug("restoring cached context state", "context", contextName, "namespace", snapshot.currentNamespace)
+	m.namespaces = snapshot.namespaces
+	m.favoriteNamespaces = snapshot.favoriteNamespaces
+	m.selectedNamespaceIndex = snapshot.selectedNamespaceIndex
+	m.namespaceViewportStart = snapshot.namespaceViewportStart
+	m.currentNamespace = snapshot.currentNamespace
+	m.pods = snapshot.pods
+	m.selectedPodIndex = snapshot.selectedPodIndex
+	m.podScrollOffset = snapshot.podScrollOffset
+	m.restartStorms = snapshot.restartStorms
+	m.podCounts = snapshot.podCounts
+	m.namespacesLoading = false
+	m.namespacesError = nil
+	m.podsLoading = false
+	m.podsError = nil
+	return true
+}
+
+// openContextList returns to the context list, caching the state of the current context and
+// probing the health of every context again
+func (m AppModel) openContextList() (tea.Model, tea.Cmd) {
+	m.cacheContextState()
+	m.saveState()
+	m.viewMode = viewModeContextSelection
+	return m, m.probeContextsCmd()
+}
+
+// closeContextList returns to the namespace view of the current context without switching
+func (m AppModel) closeContextList() (tea.Model, tea.Cmd) {
+	for i, ctx := range m.contexts {
+		if ctx.Name == m.currentContext.Name {
+			m.selectedContextIndex = i
+		}
+	}
+	m.viewMode = viewModeNamespaceView
+	return m, nil
+}
@@ -0,0 +1,94 @@
+package tui
+
+import (
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/maratkarimov/kubertino/internal/config"
+	"github.com/maratkarimov/kubertino/internal/k8s"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// newContextSwitchTestModel returns a model showing "dev" with namespace "payments" open
+func newContextSwitchTestModel(t *testing.T) AppModel {
+	t.Helper()
+	model := newTestModel(newMockAdapter(), withContexts(config.Context{Name: "dev"}, config.Context{Name: "prod"}))
+
+	model = sendKey(model, tea.KeyMsg{Type: tea.KeyEnter})
+	require.Equal(t, "dev", model.currentContext.Name)
+	updated, _ := model.Update(namespaceFetchedMsg{value: k8s.NamespacesNamed([]string{"default", "payments"})})
+	model = updated.(AppModel)
+	updated, _ = model.selectNamespace("payments")
+	model = updated.(AppModel)
+	updated, _ = model.Update(podsFetchedMsg{value: []k8s.Pod{{Name: "api-1"}, {Name: "api-2"}}})
+	model = updated.(AppModel)
+	model.selectedPodIndex = 1
+	model.focusedPanel = PanelNamespaces
+	return model
+}
+
+func TestSwitchContext_BackToListAndReturn(t *testing.T) {
+	model := newContextSwitchTestModel(t)
+
+	model = sendKey(model, tea.KeyMsg{Type: tea.KeyBackspace})
+	assert.Equal(t, viewModeContextSelection, model.viewMode)
+	assert.Contains(t, model.View(), "ESC: Back")
+
+	// Switch to prod: namespaces are fetched
+	model = sendKey(model, tea.KeyMsg{Type: tea.KeyDown})
+	updated, cmd := model.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	model = updated.(AppModel)
+	assert.NotNil(t, cmd)
+	assert.Equal(t, "prod", model.currentContext.Name)
+	assert.True(t, model.namespacesLoading)
+	assert.Empty(t, model.currentNamespace)
+	updated, _ = model.Update(namespaceFetchedMsg{value: k8s.NamespacesNamed([]string{"monitoring"})})
+	model = updated.(AppModel)
+
+	// Back to dev: state is restored instantly without a namespace fetch
+	model.focusedPanel = PanelNamespaces
+	model = sendKey(model, tea.KeyMsg{Type: tea.KeyBackspace})
+	model = sendKey(model, tea.KeyMsg{Type: tea.KeyUp})
+	updated, _ = model.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	model = updated.(AppModel)
+
+	assert.Equal(t, "dev", model.currentContext.Name)
+	assert.False(t, model.namespacesLoading)
+	assert.Equal(t, []string{"default", "payments"}, k8s.NamespaceNames(model.namespaces))
+	assert.Equal(t, "payments", model.currentNamespace)
+	pod, ok := model.selectedPod()
+	require.True(t, ok)
+	assert.Equal(t, "api-2", pod.Name)
+}
+
+func TestSwitchContext_EscReturnsToCurrentContext(t *testing.T) {
+	model := newContextSwitchTestModel(t)
+
+	model = sendKey(model, tea.KeyMsg{Type: tea.KeyBackspace})
+	model = sendKey(model, tea.KeyMsg{Type: tea.KeyDown})
+	updated, cmd := model.Update(tea.KeyMsg{Type: tea.KeyEsc})
+	model = updated.(AppModel)
+
+	assert.Nil(t, cmd, "ESC does not quit once a context is open")
+	assert.Equal(t, viewModeNamespaceView, model.viewMode)
+	assert.Equal(t, "dev", model.currentContext.Name)
+	assert.Equal(t, 0, model.selectedContextIndex)
+	assert.Equal(t, "payments", model.currentNamespace)
+}
+
+func TestSwitchContext_OnlyFromNamespacePanel(t *testing.T) {
+	model := newContextSwitchTestModel(t)
+	model.focusedPanel = PanelPods
+
+	model = sendKey(model, tea.KeyMsg{Type: tea.KeyBackspace})
+	assert.Equal(t, viewModeNamespaceView, model.viewMode)
+}
+
+func TestSwitchContext_SingleContext(t *testing.T) {
+	model := NewAppModel(&config.Config{Contexts: []config.Context{{Name: "only"}}}, newMockAdapter())
+	model.focusedPanel = PanelNamespaces
+
+	model = sendKey(model, tea.KeyMsg{Type: tea.KeyBackspace})
+	assert.Equal(t, viewModeNamespaceView, model.viewMode)
+}
@@ -0,0 +1,175 @@
+package tui
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/maratkarimov/kubertino/internal/k8s"
+	"github.com/maratkarimov/kubertino/internal/tui/styles"
+)
+
+const (
+	// credentialRefreshWindow is how long before expiry refreshable credentials are renewed
+	credentialRefreshWindow = 2 * time.Minute
+	// credentialWarnWindow is how long before expiry the badge turns yellow
+	credentialWarnWindow = 10 * time.Minute
+	// credentialRetryBackoff delays the next refresh attempt after a failed one
+	credentialRetryBackoff = 30 * time.Second
+)
+
+// CredentialChecker is implemented by adapters that can report when context credentials expire
+type CredentialChecker interface {
+	CredentialExpiry(context string) (*k8s.Credential, error)
+}
+
+// credentialCheckedMsg is sent when the credential expiry of a context has been determined
+// The request key is the context name.
+type credentialCheckedMsg = resultMsg[*k8s.Credential]
+
+// credentialTickMsg drives the countdown badges and proactive refresh
+type credentialTickMsg time.Time
+
+// credentialTickCmd schedules the next countdown tick
+func credentialTickCmd() tea.Cmd {
+	return tea.Tick(time.Second, func(t time.Time) tea.Msg {
+		return credentialTickMsg(t)
+	})
+}
+
+// checkCredentialCmd returns a command that looks up the credential expiry of a context
+func checkCredentialCmd(t *asyncTracker, checker CredentialChecker, contextName string) tea.Cmd {
+	return fetchCmd(t, asyncCredential, contextName, func(context.Context) (*k8s.Credential, error) {
+		return checker.CredentialExpiry(contextName)
+	})
+}
+
+// checkAllCredentialsCmd checks credential expiry for every configured context.
+// Returns nil when the adapter cannot report credentials.
+func (m AppModel) checkAllCredentialsCmd() tea.Cmd {
+	checker, ok := m.kubeAdapter.(CredentialChecker)
+	if !ok {
+		return nil
+	}
+
+	cmds := make([]tea.Cmd, 0, len(m.contexts))
+	for _, ctx := range m.contexts {
+		cmds = append(cmds, checkCredentialCmd(m.requests, checker, ctx.Name))
+	}
+	return tea.Batch(cmds...)
+}
+
+// handleCredentialChecked records the credential of a context and starts the countdown ticker
+func (m AppModel) handleCredentialChecked(msg credentialCheckedMsg) (tea.Model, tea.Cmd) {
+	if !m.requests.current(msg.asyncRequest) {
+		return m, nil
+	}
+	if m.credentials == nil {
+		m.credentials = make(map[string]*k8s.Credential)
+	}
+	if m.credentialRetryAt == nil {
+		m.credentialRetryAt = make(map[string]time.Time)
+	}
+	delete(m.credentialRefreshing, msg.key)
+
+	if msg.err != nil {
+		// Keep the previous credential so the badge still counts down
+		slog.Warn("credential check failed", "context", msg.key, "error", msg.err)
+		m.credentialRetryAt[msg.key] = time.Now().Add(credentialRetryBackoff)
+		return m, nil
+	}
+
+	if msg.value == nil {
+		delete(m.credentials, msg.key)
+		return m, nil
+	}
+
+	slog.Info("credential expiry detected", "context", msg.key, "source", msg.value.Source, "expires_at", msg.value.ExpiresAt)
+	previous := m.credentials[msg.key]
+	m.credentials[msg.key] = msg.value
+	// An exec plugin may return its cached token: back off unless the expiry moved later,
+	// or the next tick would run the plugin again
+	if previous != nil && !msg.value.ExpiresAt.After(previous.ExpiresAt) {
+		m.credentialRetryAt[msg.key] = time.Now().Add(credentialRetryBackoff)
+	} else {
+		delete(m.credentialRetryAt, msg.key)
+	}
+
+	if m.credentialTicking {
+		return m, nil
+	}
+	m.credentialTicking = true
+	return m, credentialTickCmd()
+}
+
+// handleCredentialTick refreshes credentials that are about to expire and keeps the ticker running
+func (m AppModel) handleCredentialTick(msg credentialTickMsg) (tea.Model, tea.Cmd) {
+	if len(m.credentials) == 0 {
+		m.credentialTicking = false
+		return m, nil
+	}
+
+	now := time.Time(msg)
+	cmds := []tea.Cmd{credentialTickCmd()}
+
+	checker, ok := m.kubeAdapter.(CredentialChecker)
+	if ok {
+		for name, credential := range m.credentials {
+			if !credential.Refreshable() || m.credentialRefreshing[name] {
+				continue
+			}
+			if credential.ExpiresAt.Sub(now) > credentialRefreshWindow || now.Before(m.credentialRetryAt[name]) {
+				continue
+			}
+
+			slog.Info("refreshing credential before expiry", "context", name, "expires_at", credential.ExpiresAt)
+			if m.credentialRefreshing == nil {
+				m.credentialRefreshing = make(map[string]bool)
+			}
+			m.credentialRefreshing[name] = true
+			cmds = append(cmds, checkCredentialCmd(m.requests, checker, name))
+		}
+	}
+
+	return m, tea.Batch(cmds...)
+}
+
+// credentialBadge renders the expiry countdown for a context, or "" when no expiry is known
+func (m AppModel) credentialBadge(context string) string {
+	credential, ok := m.credentials[context]
+	if !ok {
+		return ""
+	}
+
+	remaining := time.Until(credential.ExpiresAt)
+	text := fmt.Sprintf(" [expires in %s]", formatCountdown(remaining))
+
+	switch {
+	case m.credentialRefreshing[context]:
+		return styles.DimStyle.Render(" [refreshing credentials]")
+	case remaining <= 0:
+		return styles.FailedStyle.Render(" [credentials expired]")
+	case remaining <= credentialWarnWindow:
+		return styles.WarningStyle.Render(text)
+	default:
+		return styles.RunningStyle.Render(text)
+	}
+}
+
+// formatCountdown formats a remaining duration compactly (e.g. "45s", "12m", "3h05m", "2d")
+func formatCountdown(d time.Duration) string {
+	switch {
+	case d <= 0:
+		return "0s"
+	case d < time.Minute:
+		return fmt.Sprintf("%ds", int(d.Seconds()))
+	case d < time.Hour:
+		return fmt.Sprintf("%dm", int(d.Minutes()))
+	case d < 48*time.Hour:
+		return fmt.Sprintf("%dh%02dm", int(d.Hours()), int(d.Minutes())%60)
+	default:
+		return fmt.Sprintf("%dd", int(d.Hours())/24)
+	}
+}
@@ -0,0 +1,155 @@
+package tui
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/maratkarimov/kubertino/internal/config"
+	"github.com/maratkarimov/kubertino/internal/k8s"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// credentialAdapter is a mock adapter that also reports credential expiry
+type credentialAdapter struct {
+	*mockKubeAdapter
+	credential *k8s.Credential
+	err        error
+	calls      int
+}
+
+func (a *credentialAdapter) CredentialExpiry(context string) (*k8s.Credential, error) {
+	a.calls++
+	return a.credential, a.err
+}
+
+func newCredentialTestModel(adapter KubeAdapter) AppModel {
+	return newTestModel(adapter, withContexts(config.Context{Name: "dev"}, config.Context{Name: "prod"}))
+}
+
+func TestFormatCountdown(t *testing.T) {
+	tests := []struct {
+		in   time.Duration
+		want string
+	}{
+		{-time.Second, "0s"},
+		{45 * time.Second, "45s"},
+		{12*time.Minute + 30*time.Second, "12m"},
+		{3*time.Hour + 5*time.Minute, "3h05m"},
+		{72 * time.Hour, "3d"},
+	}
+
+	for _, tt := range tests {
+		assert.Equal(t, tt.want, formatCountdown(tt.in), "formatCountdown(%v)", tt.in)
+	}
+}
+
+func TestCredentials_InitWithoutChecker(t *testing.T) {
+	model := newCredentialTestModel(newMockAdapter())
+	assert.Nil(t, model.checkAllCredentialsCmd(), "adapters without credential support are skipped")
+}
+
+func TestCredentials_BadgeInContextList(t *testing.T) {
+	adapter := &credentialAdapter{mockKubeAdapter: newMockAdapter()}
+	model := newCredentialTestModel(adapter)
+
+	updated, cmd := model.Update(credentialCheckedMsg{
+		asyncRequest: asyncRequest{kind: asyncCredential, key: "prod"},
+		value:        &k8s.Credential{Context: "prod", Source: k8s.CredentialSourceToken, ExpiresAt: time.Now().Add(5*time.Minute + 30*time.Second)},
+	})
+	model = updated.(AppModel)
+
+	assert.NotNil(t, cmd, "first known credential should start the countdown ticker")
+	assert.True(t, model.credentialTicking)
+	assert.Contains(t, model.View(), "expires in 5m")
+
+	// Expired credentials are flagged instead of counting down
+	model.credentials["prod"].ExpiresAt = time.Now().Add(-time.Minute)
+	assert.Contains(t, model.View(), "credentials expired")
+}
+
+func TestCredentials_ProactiveRefresh(t *testing.T) {
+	expiresAt := time.Now().Add(time.Minute)
+	adapter := &credentialAdapter{
+		mockKubeAdapter: newMockAdapter(),
+		credential:      &k8s.Credential{Context: "dev", Source: k8s.CredentialSourceExec, ExpiresAt: expiresAt},
+	}
+	model := newCredentialTestModel(adapter)
+
+	updated, _ := model.Update(credentialCheckedMsg{asyncRequest: asyncRequest{kind: asyncCredential, key: "dev"}, value: adapter.credential})
+	model = updated.(AppModel)
+
+	// Within the refresh window the exec plugin is re-run
+	renewed := &k8s.Credential{Context: "dev", Source: k8s.CredentialSourceExec, ExpiresAt: time.Now().Add(time.Hour)}
+	adapter.credential = renewed
+	updated, cmd := model.Update(credentialTickMsg(time.Now()))
+	model = updated.(AppModel)
+	require.NotNil(t, cmd)
+	assert.True(t, model.credentialRefreshing["dev"])
+
+	// A second tick while refreshing must not start another refresh
+	updated, _ = model.Update(credentialTickMsg(time.Now()))
+	model = updated.(AppModel)
+
+	updated, _ = model.Update(checkCredentialCmd(model.requests, adapter, "dev")())
+	model = updated.(AppModel)
+	assert.False(t, model.credentialRefreshing["dev"])
+	assert.Equal(t, renewed.ExpiresAt, model.credentials["dev"].ExpiresAt)
+	assert.Equal(t, 1, adapter.calls, "only the explicit check should have hit the adapter")
+}
+
+func TestCredentials_RefreshFailureBacksOff(t *testing.T) {
+	adapter := &credentialAdapter{mockKubeAdapter: newMockAdapter()}
+	model := newCredentialTestModel(adapter)
+
+	original := &k8s.Credential{Context: "dev", Source: k8s.CredentialSourceExec, ExpiresAt: time.Now().Add(time.Minute)}
+	updated, _ := model.Update(credentialCheckedMsg{asyncRequest: asyncRequest{kind: asyncCredential, key: "dev"}, value: original})
+	model = updated.(AppModel)
+
+	updated, _ = model.Update(credentialCheckedMsg{asyncRequest: asyncRequest{kind: asyncCredential, key: "dev"}, err: errors.New("plugin failed")})
+	model = updated.(AppModel)
+
+	assert.Equal(t, original, model.credentials["dev"], "previous credential is kept on failure")
+	assert.True(t, model.credentialRetryAt["dev"].After(time.Now()))
+
+	updated, _ = model.Update(credentialTickMsg(time.Now()))
+	model = updated.(AppModel)
+	assert.False(t, model.credentialRefreshing["dev"], "refresh is not retried before the backoff elapses")
+}
+
+func TestCredentials_UnchangedExpiryBacksOff(t *testing.T) {
+	adapter := &credentialAdapter{mockKubeAdapter: newMockAdapter()}
+	model := newCredentialTestModel(adapter)
+
+	cached := &k8s.Credential{Context: "dev", Source: k8s.CredentialSourceExec, ExpiresAt: time.Now().Add(time.Minute)}
+	for range 2 {
+		updated, _ := model.Update(credentialCheckedMsg{asyncRequest: asyncRequest{kind: asyncCredential, key: "dev"}, value: cached})
+		model = updated.(AppModel)
+	}
+	assert.True(t, model.credentialRetryAt["dev"].After(time.Now()), "a refresh returning the cached token backs off")
+
+	updated, _ := model.Update(credentialTickMsg(time.Now()))
+	model = updated.(AppModel)
+	assert.False(t, model.credentialRefreshing["dev"], "refresh is not retried before the backoff elapses")
+
+	renewed := &k8s.Credential{Context: "dev", Source: k8s.CredentialSourceExec, ExpiresAt: time.Now().Add(time.Hour)}
+	updated, _ = model.Update(credentialCheckedMsg{asyncRequest: asyncRequest{kind: asyncCredential, key: "dev"}, value: renewed})
+	model = updated.(AppModel)
+	assert.NotContains(t, model.credentialRetryAt, "dev", "a later expiry ends the backoff")
+}
+
+func TestCredentials_NonRefreshableNotRefreshed(t *testing.T) {
+	adapter := &credentialAdapter{mockKubeAdapter: newMockAdapter()}
+	model := newCredentialTestModel(adapter)
+
+	updated, _ := model.Update(credentialCheckedMsg{
+		asyncRequest: asyncRequest{kind: asyncCredential, key: "dev"},
+		value:        &k8s.Credential{Context: "dev", Source: k8s.CredentialSourceToken, ExpiresAt: time.Now().Add(30 * time.Second)},
+	})
+	model = updated.(AppModel)
+
+	updated, _ = model.Update(credentialTickMsg(time.Now()))
+	model = updated.(AppModel)
+	assert.False(t, model.credentialRefreshing["dev"])
+}
@@ -0,0 +1,138 @@
+package tui
+
+import (
+	"fmt"
+	"log/slog"
+	"runtime"
+	"runtime/debug"
+	"strings"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/maratkarimov/kubertino/internal/changelog"
+	"github.com/maratkarimov/kubertino/internal/logging"
+	"github.com/maratkarimov/kubertino/internal/tui/styles"
+)
+
+// debugOverlayLines is how many log lines the debug overlay shows
+const debugOverlayLines = 6
+
+// debugTickMsg redraws the debug overlay so new log lines show up
+type debugTickMsg time.Time
+
+// debugTickCmd schedules the next debug overlay redraw
+func debugTickCmd() tea.Cmd {
+	return tea.Tick(time.Second, func(t time.Time) tea.Msg {
+		return debugTickMsg(t)
+	})
+}
+
+// SetCrashDir enables crash reports: a panic while handling a message or rendering writes
+// the panic, its stack trace and a snapshot of the model state to a file in dir
+func (m *AppModel) SetCrashDir(dir string) {
+	m.crashDir = dir
+}
+
+// SetDebugLog enables the debug overlay, which shows the model state and the latest lines
+// of recent at the bottom of the screen
+func (m *AppModel) SetDebugLog(recent *logging.Recent) {
+	m.debugLog = recent
+}
+
+// recoverCrash writes a crash report for a panic and panics again with the report path, so
+// Bubble Tea restores the terminal and prints where the report is. msg is the message being
+// handled, nil while rendering. It must be deferred.
+func (m AppModel) recoverCrash(msg tea.Msg) {
+	r := recover()
+	if r == nil {
+		return
+	}
+	if m.crashDir == "" {
+		panic(r)
+	}
+
+	snapshot := m.snapshot()
+	if msg != nil {
+		snapshot += fmt.Sprintf("\nmessage: %T", msg)
+	}
+	path, err := logging.WriteCrashReport(m.crashDir, r, debug.Stack(), snapshot)
+	if err != nil {
+		slog.Error("failed to write crash report", "panic", r, "error", err)
+		panic(r)
+	}
+	slog.Error("kubertino crashed", "panic", r, "report", path)
+	panic(fmt.Sprintf("%v\n\nCrash report: %s", r, path))
+}
+
+// snapshot describes the model state for crash reports and the debug overlay
+func (m AppModel) snapshot() string {
+	contextName := ""
+	if m.currentContext != nil {
+		contextName = m.currentContext.Name
+	}
+
+	lines := []string{
+		"version: " + changelog.Current(),
+		"view: " + m.viewMode,
+		"context: " + contextName,
+		"namespace: " + m.currentNamespace,
+		fmt.Sprintf("focused panel: %d", m.focusedPanel),
+		fmt.Sprintf("namespaces: %d (selected %d, loading %t)", len(m.namespaces), m.selectedNamespaceIndex, m.namespacesLoading),
+		fmt.Sprintf("pods: %d (selected %d, loading %t)", len(m.pods), m.selectedPodIndex, m.podsLoading),
+		fmt.Sprintf("resources: %d", len(m.resources)),
+		fmt.Sprintf("search: %q (active %t)", m.searchQuery, m.searchMode),
+		fmt.Sprintf("terminal: %dx%d", m.termWidth, m.termHeight),
+	}
+	if m.namespacesError != nil {
+		lines = append(lines, "namespaces error: "+m.namespacesError.Error())
+	}
+	if m.podsError != nil {
+		lines = append(lines, "pods error: "+m.podsError.Error())
+	}
+	return strings.Join(lines, "\n")
+}
+
+// withDebugOverlay replaces the bottom of view with the debug overlay when it is enabled
+func (m AppModel) withDebugOverlay(view string) string {
+	if m.debugLog == nil {
+		return view
+	}
+
+	width := m.termWidth
+	if width <= 0 {
+		width = 80
+	}
+
+	var memory runtime.MemStats
+	runtime.ReadMemStats(&memory)
+	contextName := "-"
+	if m.currentContext != nil {
+		contextName = m.currentContext.Name
+	}
+	state := fmt.Sprintf("DEBUG view=%s context=%s namespace=%s pods=%d goroutines=%d heap=%dMB",
+		m.viewMode, contextName, m.currentNamespace, len(m.pods), runtime.NumGoroutine(), memory.HeapAlloc>>20)
+
+	lines := []string{styles.WarningStyle.Render(truncateName(state, width))}
+	logLines := m.debugLog.Lines()
+	if len(logLines) > debugOverlayLines {
+		logLines = logLines[len(logLines)-debugOverlayLines:]
+	}
+	for _, line := range logLines {
+		lines = append(lines, styles.DimStyle.Render(truncateName(line, width)))
+	}
+	overlay := lipgloss.NewStyle().
+		Border(lipgloss.NormalBorder(), true, false, false, false).
+		BorderForeground(lipgloss.Color("11")). // Yellow
+		Render(strings.Join(lines, "\n"))
+
+	// Keep the screen height: the overlay takes the place of the last lines of the view
+	viewLines := strings.Split(view, "\n")
+	if m.termHeight > 0 {
+		keep := max(m.termHeight-lipgloss.Height(overlay), 0)
+		if len(viewLines) > keep {
+			viewLines = viewLines[:keep]
+		}
+	}
+	return strings.Join(viewLines, "\n") + "\n" + overlay
+}
@@ -0,0 +1,77 @@
+package tui
+
+import (
+	"log/slog"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/maratkarimov/kubertino/internal/logging"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDebugOverlay_ShowsStateAndLatestLogLines(t *testing.T) {
+	model := newDescribeTestModel(&describeAdapter{})
+	recent := logging.NewRecent(20)
+	for _, line := range []string{"line-1", "line-2", "line-3", "line-4", "line-5", "line-6", "line-7"} {
+		_, _ = recent.Write([]byte(line + "\n"))
+	}
+	model.SetDebugLog(recent)
+
+	view := model.View()
+	assert.Contains(t, view, "DEBUG view=namespace_view context=test-context namespace=default pods=1")
+	assert.Contains(t, view, "line-7")
+	assert.NotContains(t, view, "line-1", "only the latest lines are shown")
+	assert.Equal(t, 30, lipgloss.Height(view), "the overlay does not grow the screen")
+}
+
+func TestDebugOverlay_OffByDefault(t *testing.T) {
+	model := newDescribeTestModel(&describeAdapter{})
+	assert.NotContains(t, model.View(), "DEBUG")
+}
+
+func TestDebugTick_KeepsTicking(t *testing.T) {
+	model := newDescribeTestModel(&describeAdapter{})
+	_, cmd := model.Update(debugTickMsg{})
+	assert.NotNil(t, cmd)
+}
+
+func TestRecoverCrash_WritesReport(t *testing.T) {
+	defer slog.SetDefault(slog.Default())
+	slog.SetDefault(slog.New(slog.NewTextHandler(&strings.Builder{}, nil)))
+
+	model := newDescribeTestModel(&describeAdapter{})
+	dir := t.TempDir()
+	model.SetCrashDir(dir)
+
+	var recovered any
+	func() {
+		defer func() { recovered = recover() }()
+		defer model.recoverCrash(tea.KeyMsg{})
+		panic("boom")
+	}()
+	require.NotNil(t, recovered)
+	assert.Contains(t, recovered, "boom\n\nCrash report: "+dir)
+
+	reports, err := filepath.Glob(filepath.Join(dir, "crash-*.log"))
+	require.NoError(t, err)
+	require.Len(t, reports, 1)
+	data, err := os.ReadFile(reports[0])
+	require.NoError(t, err)
+	assert.Contains(t, string(data), "panic: boom")
+	assert.Contains(t, string(data), "context: test-context")
+	assert.Contains(t, string(data), "pods: 1 (selected 0, loading false)")
+	assert.Contains(t, string(data), "message: tea.KeyMsg")
+}
+
+func TestRecoverCrash_WithoutCrashDirRepanics(t *testing.T) {
+	model := newDescribeTestModel(&describeAdapter{})
+	assert.PanicsWithValue(t, "boom", func() {
+		defer model.recoverCrash(nil)
+		panic("boom")
+	})
+}
@@ -0,0 +1,86 @@
+package tui
+
+import (
+	"context"
+	"log/slog"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// PodDescriber is implemented by adapters that can describe a pod. Without it, the describe
+// key does nothing.
+type PodDescriber interface {
+	DescribePod(context, namespace, pod string) (string, error)
+}
+
+// podDescribedMsg is sent when kubectl describe output for a pod has been fetched
+type podDescribedMsg = resultMsg[string]
+
+// openDescribe shows the describe pager for the selected pod and starts fetching its output
+func (m AppModel) openDescribe() (tea.Model, tea.Cmd) {
+	describer, ok := m.kubeAdapter.(PodDescriber)
+	if !ok || m.currentContext == nil {
+		return m, nil
+	}
+
+	pod, ok := m.selectedPod()
+	if !ok {
+		m.errorModal.ShowWithSuggestion(
+			"No pod selected",
+			"Describe",
+			"Press Tab to focus pod panel, then use arrow keys to select a pod",
+			nil,
+		)
+		return m, nil
+	}
+
+	m.viewMode = viewModeDescribe
+	m.pager.SetSize(m.termWidth, m.termHeight)
+	title := "Describe: " + pod.Name
+	if summary := securitySummary(pod); summary != "" {
+		title += "  ⚠ " + summary
+	}
+	m.pager.ShowLoading(title)
+
+	contextName, namespace := m.currentContext.Name, m.currentNamespace
+	return m, fetchCmd(m.requests, asyncDescribe, "", func(context.Context) (string, error) {
+		output, err := describer.DescribePod(contextName, namespace, pod.Name)
+		if err != nil {
+			slog.Error("describe pod failed", "pod", pod.Name, "error", err)
+		}
+		return output, err
+	})
+}
+
+// handlePodDescribed shows the describe output if the pager is still open for that request
+func (m AppModel) handlePodDescribed(msg podDescribedMsg) (tea.Model, tea.Cmd) {
+	if !m.requests.current(msg.asyncRequest) || m.viewMode != viewModeDescribe {
+		return m, nil
+	}
+
+	if msg.err != nil {
+		m.pager.SetError(msg.err)
+		return m, nil
+	}
+	m.pager.SetContent(msg.value)
+	return m, nil
+}
+
+// handleDescribeKey handles key presses while the describe pager is open
+func (m AppModel) handleDescribeKey(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	if msg.Type == tea.KeyCtrlC {
+		return m, tea.Quit
+	}
+
+	if KeyMatches(msg, m.keys.Describe) {
+		m.pager.Hide()
+	} else {
+		m.pager.HandleKeyPress(msg)
+	}
+
+	if !m.pager.IsVisible {
+		m.requests.cancel(asyncDescribe)
+		m.viewMode = viewModeNamespaceView
+	}
+	return m, nil
+}
@@ -0,0 +1,116 @@
+package tui
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/maratkarimov/kubertino/internal/config"
+	"github.com/maratkarimov/kubertino/internal/k8s"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// describeAdapter is a mock adapter that also describes pods
+type describeAdapter struct {
+	*mockKubeAdapter
+	output string
+	err    error
+	pod    string // Last described pod
+}
+
+func (a *describeAdapter) DescribePod(context, namespace, pod string) (string, error) {
+	a.pod = pod
+	return a.output, a.err
+}
+
+func newDescribeTestModel(adapter *describeAdapter) AppModel {
+	adapter.mockKubeAdapter = newMockAdapter()
+	model := newTestModel(adapter)
+	updated, _ := model.Update(tea.WindowSizeMsg{Width: 120, Height: 30})
+	model = updated.(AppModel)
+	model.currentNamespace = "default"
+	model.pods = []k8s.Pod{{Name: "web-1", Status: "Running"}}
+	model.focusedPanel = PanelPods
+	model.selectedPodIndex = 0
+	return model
+}
+
+// openDescribePager presses the describe key and delivers the describe output
+func openDescribePager(t *testing.T, model AppModel) AppModel {
+	t.Helper()
+	updated, cmd := model.Update(runeKey('d'))
+	model = updated.(AppModel)
+	require.Equal(t, viewModeDescribe, model.viewMode)
+	require.NotNil(t, cmd)
+	assert.Contains(t, model.View(), "Loading")
+
+	updated, _ = model.Update(cmd())
+	return updated.(AppModel)
+}
+
+func TestDescribe_ShowsOutputInPager(t *testing.T) {
+	var lines []string
+	for i := 1; i <= 100; i++ {
+		lines = append(lines, fmt.Sprintf("Event %d", i))
+	}
+	adapter := &describeAdapter{output: "Name: web-1\n" + strings.Join(lines, "\n")}
+	model := openDescribePager(t, newDescribeTestModel(adapter))
+
+	assert.Equal(t, "web-1", adapter.pod)
+	view := model.View()
+	assert.Contains(t, view, "Describe: web-1")
+	assert.Contains(t, view, "Name: web-1")
+	assert.NotContains(t, view, "Event 100")
+
+	model = sendKey(model, runeKey('G'))
+	assert.Contains(t, model.View(), "Event 100")
+
+	model = sendKey(model, runeKey('q'))
+	assert.Equal(t, viewModeNamespaceView, model.viewMode, "q closes the pager instead of quitting")
+}
+
+func TestDescribe_ShowsError(t *testing.T) {
+	adapter := &describeAdapter{err: errors.New("pods \"web-1\" is forbidden")}
+	model := openDescribePager(t, newDescribeTestModel(adapter))
+
+	assert.Contains(t, model.View(), "forbidden")
+
+	model = sendKey(model, tea.KeyMsg{Type: tea.KeyEsc})
+	assert.Equal(t, viewModeNamespaceView, model.viewMode)
+}
+
+func TestDescribe_NoPodSelected(t *testing.T) {
+	model := newDescribeTestModel(&describeAdapter{})
+	model.pods = nil
+	model.selectedPodIndex = -1
+
+	updated, cmd := model.Update(runeKey('d'))
+	model = updated.(AppModel)
+	assert.Nil(t, cmd)
+	assert.Equal(t, viewModeNamespaceView, model.viewMode)
+	assert.True(t, model.errorModal.IsVisible)
+}
+
+func TestDescribe_ActionShortcutTakesPrecedence(t *testing.T) {
+	model := newDescribeTestModel(&describeAdapter{})
+	model.actions = []config.Action{{Name: "Describe Pod", Shortcut: "d", Command: "kubectl describe pod {{.pod}}"}}
+
+	updated, _ := model.Update(runeKey('d'))
+	assert.NotEqual(t, viewModeDescribe, updated.(AppModel).viewMode)
+}
+
+func TestDescribe_StaleResultIgnored(t *testing.T) {
+	adapter := &describeAdapter{output: "Name: web-1"}
+	model := newDescribeTestModel(adapter)
+
+	updated, cmd := model.Update(runeKey('d'))
+	model = updated.(AppModel)
+	model = sendKey(model, tea.KeyMsg{Type: tea.KeyEsc})
+
+	updated, _ = model.Update(cmd())
+	model = updated.(AppModel)
+	assert.Equal(t, viewModeNamespaceView, model.viewMode, "a result arriving after close does not reopen the pager")
+}
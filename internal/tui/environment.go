@@ -0,0 +1,59 @@
+package tui
+
+import (
+	"strings"
+
+	"github.com/charmbracelet/lipgloss"
+	"github.com/maratkarimov/kubertino/internal/config"
+	"github.com/maratkarimov/kubertino/internal/tui/styles"
+)
+
+// envBadge renders the environment of a context (e.g. " PROD " in white on red), "" when the
+// context declares none
+func envBadge(env string) string {
+	var style lipgloss.Style
+	switch env {
+	case config.EnvProd:
+		style = styles.ProdEnvStyle
+	case config.EnvStaging:
+		style = styles.StagingEnvStyle
+	case config.EnvDev:
+		style = styles.DevEnvStyle
+	default:
+		return ""
+	}
+	return style.Render(" " + strings.ToUpper(env) + " ")
+}
+
+// currentEnvBadge renders the environment badge of the open context
+func (m AppModel) currentEnvBadge() string {
+	if m.currentContext == nil {
+		return ""
+	}
+	return envBadge(m.currentContext.Env)
+}
+
+// withEnvBadge draws the environment badge of the open context into the top border of a
+// panel rendered with the focused or unfocused panel border style. The panel is returned as
+// it is when the context declares no environment or the panel is too narrow.
+func (m AppModel) withEnvBadge(panel string, focused bool) string {
+	badge := m.currentEnvBadge()
+	top, rest, found := strings.Cut(panel, "\n")
+	if badge == "" || !found {
+		return panel
+	}
+
+	// "╭─" + badge + "─...─╮"
+	fill := lipgloss.Width(top) - lipgloss.Width(badge) - 3
+	if fill < 1 {
+		return panel
+	}
+	borderStyle := styles.UnfocusedPanelBorderStyle
+	if focused {
+		borderStyle = styles.FocusedPanelBorderStyle
+	}
+	border := lipgloss.RoundedBorder()
+	line := lipgloss.NewStyle().Foreground(borderStyle.GetBorderTopForeground())
+	top = line.Render(border.TopLeft+border.Top) + badge + line.Render(strings.Repeat(border.Top, fill)+border.TopRight)
+	return top + "\n" + rest
+}
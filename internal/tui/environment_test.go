@@ -0,0 +1,79 @@
+package tui
+
+import (
+	"strings"
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/maratkarimov/kubertino/internal/config"
+	"github.com/maratkarimov/kubertino/internal/k8s"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newEnvironmentTestModel() AppModel {
+	return newTestModel(newMockAdapter(), withContexts(
+		config.Context{Name: "prod-eu", Group: "Production", Env: config.EnvProd},
+		config.Context{Name: "minikube", Env: config.EnvDev},
+		config.Context{Name: "stage", Group: "Staging", Env: config.EnvStaging},
+		config.Context{Name: "prod-us", Group: "Production", Env: config.EnvProd},
+	))
+}
+
+func TestContextList_GroupsUnderHeaders(t *testing.T) {
+	model := newEnvironmentTestModel()
+
+	view := model.View()
+	order := []string{"minikube", "Production", "prod-eu", "prod-us", "Staging", "stage"}
+	last := -1
+	for _, text := range order {
+		index := strings.Index(view, text)
+		require.GreaterOrEqual(t, index, 0, text)
+		assert.Greater(t, index, last, "%s is listed in group order", text)
+		last = index
+	}
+	assert.Contains(t, view, "PROD")
+	assert.Contains(t, view, "STAGING")
+	assert.Contains(t, view, "DEV")
+
+	// The cursor follows the listed order
+	model = sendKey(model, tea.KeyMsg{Type: tea.KeyDown})
+	model = sendKey(model, tea.KeyMsg{Type: tea.KeyDown})
+	model = sendKey(model, tea.KeyMsg{Type: tea.KeyEnter})
+	require.NotNil(t, model.currentContext)
+	assert.Equal(t, "prod-us", model.currentContext.Name)
+}
+
+func TestNamespaceView_EnvBadgeInPanelBorder(t *testing.T) {
+	model := newEnvironmentTestModel()
+	model = sendKey(model, tea.KeyMsg{Type: tea.KeyDown})
+	model = sendKey(model, tea.KeyMsg{Type: tea.KeyEnter})
+	require.Equal(t, "prod-eu", model.currentContext.Name)
+	updated, _ := model.Update(namespaceFetchedMsg{value: k8s.NamespacesNamed([]string{"default"})})
+	model = updated.(AppModel)
+
+	top := strings.Split(model.View(), "\n")[0]
+	assert.Contains(t, top, "╭─"+envBadge(config.EnvProd)+"─", "badge drawn into the namespace panel border")
+	assert.Equal(t, 2, strings.Count(top, "PROD"), "badge on the namespace and pod panels")
+	assert.Equal(t, model.termWidth, lipgloss.Width(top), "border keeps its width")
+}
+
+func TestWithEnvBadge(t *testing.T) {
+	model := newEnvironmentTestModel()
+	panel := lipgloss.NewStyle().Border(lipgloss.RoundedBorder()).Width(20).Render("pods")
+
+	assert.Equal(t, panel, model.withEnvBadge(panel, true), "no context open")
+
+	model.currentContext = &config.Context{Name: "minikube", Env: config.EnvDev}
+	badged := model.withEnvBadge(panel, true)
+	assert.Contains(t, badged, "DEV")
+	assert.Equal(t, lipgloss.Width(panel), lipgloss.Width(badged))
+	assert.Equal(t, lipgloss.Height(panel), lipgloss.Height(badged))
+
+	narrow := lipgloss.NewStyle().Border(lipgloss.RoundedBorder()).Render("x")
+	assert.Equal(t, narrow, model.withEnvBadge(narrow, true), "too narrow for the badge")
+
+	model.currentContext = &config.Context{Name: "kind"}
+	assert.Equal(t, panel, model.withEnvBadge(panel, false), "no env declared")
+}
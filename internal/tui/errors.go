@@ -0,0 +1,19 @@
+package tui
+
+import (
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/maratkarimov/kubertino/internal/k8s"
+)
+
+// showError shows err in the error modal. Known kubectl failures are replaced by a concise
+// summary and suggestion, with the raw text kept behind the modal's details toggle.
+func (m AppModel) showError(message string, err error, operation, suggestion string, retryFunc func() tea.Cmd) {
+	summary, ok := k8s.SummarizeError(err)
+	if !ok {
+		m.errorModal.ShowWithSuggestion(message, operation, suggestion, retryFunc)
+		return
+	}
+
+	m.errorModal.ShowWithSuggestion(summary.Summary, operation, summary.Suggestion, retryFunc)
+	m.errorModal.SetDetails(err.Error())
+}
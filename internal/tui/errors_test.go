@@ -0,0 +1,45 @@
+package tui
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/maratkarimov/kubertino/internal/k8s"
+	"github.com/stretchr/testify/assert"
+)
+
+func newErrorTestModel() AppModel {
+	model := newTestModel(newMockAdapter())
+	model.errorModal.SetSize(120, 40)
+	model.currentNamespace = "default"
+	return model
+}
+
+func TestShowError_SummarizesKnownFailures(t *testing.T) {
+	raw := `Error from server (Forbidden): pods is forbidden: User "dev" cannot list resource "pods" in namespace "default"`
+	model := newErrorTestModel()
+
+	updated, _ := model.Update(podsFetchedMsg{err: fmt.Errorf("%w: %s", k8s.ErrPermissionDenied, raw)})
+	model = updated.(AppModel)
+
+	assert.Equal(t, "Access denied: your account is not allowed to do this", model.errorModal.Message)
+	assert.Equal(t, "Ask a cluster admin for the required RBAC permissions", model.errorModal.Suggestion)
+	assert.NotContains(t, model.View(), "cannot list resource")
+
+	// Raw kubectl output stays reachable behind the details toggle
+	model = sendKey(model, runeKey('d'))
+	assert.True(t, model.errorModal.IsVisible)
+	assert.Contains(t, model.errorModal.View(), "cannot list")
+}
+
+func TestShowError_KeepsUnknownMessages(t *testing.T) {
+	model := newErrorTestModel()
+
+	updated, _ := model.Update(namespaceFetchedMsg{err: errors.New("kubectl command failed: boom")})
+	model = updated.(AppModel)
+
+	assert.Equal(t, "kubectl command failed: boom", model.errorModal.Message)
+	assert.Equal(t, "Check your network connection and cluster access", model.errorModal.Suggestion)
+	assert.Empty(t, model.errorModal.Details)
+}
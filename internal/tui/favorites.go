@@ -0,0 +1,54 @@
+package tui
+
+import (
+	"fmt"
+	"log/slog"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/maratkarimov/kubertino/internal/config"
+	"github.com/maratkarimov/kubertino/internal/tui/components"
+)
+
+// handleToggleFavorite toggles the highlighted namespace as a favorite, re-sorts the namespace
+// list with the cursor kept on it and writes the change back to the config file
+func (m AppModel) handleToggleFavorite() (tea.Model, tea.Cmd) {
+	highlighted, ok := m.highlightedNamespace()
+	if m.currentContext == nil || m.config == nil || !ok {
+		return m, nil
+	}
+
+	namespace := highlighted.Name
+	added, err := config.ToggleFavorite(m.config, m.currentContext.Name, namespace)
+	if err != nil {
+		m.errorModal.Show(fmt.Sprintf("Failed to update favorites: %s", err.Error()), "Toggle Favorite", nil)
+		return m, nil
+	}
+	slog.Info("favorite toggled", "context", m.currentContext.Name, "namespace", namespace, "favorite", added)
+
+	favorites, err := config.GetFavorites(m.config, m.currentContext.Name)
+	if err != nil {
+		slog.Warn("failed to get favorites", "context", m.currentContext.Name, "error", err)
+		favorites = []string{}
+	}
+	m.favoriteNamespaces = favorites
+	m.namespaces = m.sortNamespacesWithFavorites(m.namespaces, favorites)
+
+	// Keep the cursor on the toggled namespace after it moves
+	m.focusNamespace(namespace)
+
+	notice := fmt.Sprintf("Removed %s from favorites", namespace)
+	if added {
+		notice = fmt.Sprintf("Added %s to favorites", namespace)
+	}
+
+	if m.configPath == "" {
+		slog.Info("favorite not saved: no config file")
+		return m, m.notify(notice+" (not saved: no config file)", components.ToastWarning)
+	}
+	if err := config.SaveFavorites(m.config, m.configPath); err != nil {
+		slog.Error("failed to save favorites", "path", m.configPath, "error", err)
+		m.errorModal.Show(fmt.Sprintf("Failed to save favorites: %s", err.Error()), "Toggle Favorite", nil)
+		return m, nil
+	}
+	return m, m.notify(notice, components.ToastInfo)
+}
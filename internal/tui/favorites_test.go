@@ -0,0 +1,83 @@
+package tui
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/maratkarimov/kubertino/internal/config"
+	"github.com/maratkarimov/kubertino/internal/k8s"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const favoritesTestConfig = `# keep me
+version: "1.0"
+favorites:
+  test-context:
+    - staging
+contexts:
+  - name: test-context
+`
+
+func newFavoritesTestModel(t *testing.T) (AppModel, string) {
+	t.Helper()
+	path := filepath.Join(t.TempDir(), "kubertino.yml")
+	require.NoError(t, os.WriteFile(path, []byte(favoritesTestConfig), 0644))
+	cfg, err := config.Parse(path)
+	require.NoError(t, err)
+
+	model := newTestModel(newMockAdapter(), func(c *config.Config) { *c = *cfg })
+	model.SetConfigPath(path)
+
+	updated, _ := model.Update(namespaceFetchedMsg{value: k8s.NamespacesNamed([]string{"default", "kube-system", "production", "staging"})})
+	return updated.(AppModel), path
+}
+
+func TestToggleFavorite_AddsAndPersists(t *testing.T) {
+	model, path := newFavoritesTestModel(t)
+	require.Equal(t, []string{"staging", "default", "kube-system", "production"}, k8s.NamespaceNames(model.namespaces))
+
+	// Highlight "production" and mark it as favorite
+	model.selectedNamespaceIndex = 3
+	model = sendKey(model, runeKey('f'))
+
+	assert.Equal(t, []string{"staging", "production"}, model.favoriteNamespaces)
+	assert.Equal(t, []string{"staging", "production", "default", "kube-system"}, k8s.NamespaceNames(model.namespaces))
+	assert.Equal(t, "production", model.namespaces[model.selectedNamespaceIndex].Name, "cursor follows the namespace")
+
+	data, err := os.ReadFile(path)
+	require.NoError(t, err)
+	assert.Contains(t, string(data), "# keep me")
+	saved, err := config.Parse(path)
+	require.NoError(t, err)
+	favorites, err := config.GetFavorites(saved, "test-context")
+	require.NoError(t, err)
+	assert.Equal(t, []string{"staging", "production"}, favorites)
+}
+
+func TestToggleFavorite_Removes(t *testing.T) {
+	model, _ := newFavoritesTestModel(t)
+
+	model.selectedNamespaceIndex = 0 // staging
+	model = sendKey(model, runeKey('f'))
+
+	assert.Empty(t, model.favoriteNamespaces)
+	assert.Equal(t, []string{"default", "kube-system", "production", "staging"}, k8s.NamespaceNames(model.namespaces))
+	assert.Equal(t, 3, model.selectedNamespaceIndex)
+}
+
+func TestToggleFavorite_OnlyInNamespacePanel(t *testing.T) {
+	model, _ := newFavoritesTestModel(t)
+	model.focusedPanel = PanelPods
+	model = sendKey(model, runeKey('f'))
+	assert.Equal(t, []string{"staging"}, model.favoriteNamespaces)
+
+	// Search mode treats "f" as query input
+	model.focusedPanel = PanelNamespaces
+	model = sendKey(model, tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'/'}})
+	model = sendKey(model, runeKey('f'))
+	assert.Equal(t, "f", model.searchQuery)
+	assert.Equal(t, []string{"staging"}, model.favoriteNamespaces)
+}
@@ -54,6 +54,7 @@ func TestFocusCycling_Tab(t *testing.T) {
 				actions:           tt.actions,
 				selectedPodIndex:  -1,
 				viewMode:          viewModeNamespaceView,
+				keys:              DefaultKeyMap(),            // Need keys for KeyMatches
 				errorModal:        components.NewErrorModal(), // Story 6.3: Initialize components
 				namespacesSpinner: components.NewSpinner(),    // Story 6.3
 				podsSpinner:       components.NewSpinner(),    // Story 6.3
@@ -114,6 +115,7 @@ func TestFocusCycling_ShiftTab(t *testing.T) {
 				actions:           tt.actions,
 				selectedPodIndex:  -1,
 				viewMode:          viewModeNamespaceView,
+				keys:              DefaultKeyMap(),            // Need keys for KeyMatches
 				errorModal:        components.NewErrorModal(), // Story 6.3
 				namespacesSpinner: components.NewSpinner(),    // Story 6.3
 				podsSpinner:       components.NewSpinner(),    // Story 6.3
@@ -153,6 +155,7 @@ func TestAutoSelectFirstPod(t *testing.T) {
 		pods:              []k8s.Pod{{Name: "pod-1", Status: "Running"}, {Name: "pod-2", Status: "Running"}},
 		selectedPodIndex:  -1,
 		viewMode:          viewModeNamespaceView,
+		keys:              DefaultKeyMap(),            // Need keys for KeyMatches
 		errorModal:        components.NewErrorModal(), // Story 6.3
 		namespacesSpinner: components.NewSpinner(),    // Story 6.3
 		podsSpinner:       components.NewSpinner(),    // Story 6.3
@@ -175,6 +178,7 @@ func TestNoAutoSelectWhenAlreadySelected(t *testing.T) {
 		pods:              []k8s.Pod{{Name: "pod-1", Status: "Running"}, {Name: "pod-2", Status: "Running"}},
 		selectedPodIndex:  1, // Already selected
 		viewMode:          viewModeNamespaceView,
+		keys:              DefaultKeyMap(),            // Need keys for KeyMatches
 		errorModal:        components.NewErrorModal(), // Story 6.3
 		namespacesSpinner: components.NewSpinner(),    // Story 6.3
 		podsSpinner:       components.NewSpinner(),    // Story 6.3
@@ -291,7 +295,7 @@ func TestContextSwitch_PreservesNamespaceCursor(t *testing.T) {
 			assert.Equal(t, tt.initialNamespaceIndex, m.selectedNamespaceIndex, "Cursor position should be preserved")
 
 			// Now simulate namespace fetch completion
-			namespaceMsg := namespaceFetchedMsg{namespaces: tt.newContextNamespaces}
+			namespaceMsg := namespaceFetchedMsg{value: k8s.NamespacesNamed(tt.newContextNamespaces)}
 			updatedModel, _ = m.Update(namespaceMsg)
 			m = updatedModel.(AppModel)
 
@@ -337,7 +341,7 @@ func TestNamespaceSelection_AutoSelectsFirstPod(t *testing.T) {
 				actionSpinner:          components.NewSpinner(),
 				viewMode:               viewModeNamespaceView,
 				kubeAdapter:            adapter,
-				namespaces:             []string{"default", "kube-system"},
+				namespaces:             k8s.NamespacesNamed([]string{"default", "kube-system"}),
 				selectedNamespaceIndex: 0,
 				focusedPanel:           PanelNamespaces,
 				selectedPodIndex:       -1,
@@ -354,7 +358,7 @@ func TestNamespaceSelection_AutoSelectsFirstPod(t *testing.T) {
 			assert.Equal(t, -1, m.selectedPodIndex, "Pod index should be reset before fetch")
 
 			// Simulate pod fetch completion
-			podMsg := podsFetchedMsg{pods: tt.pods}
+			podMsg := podsFetchedMsg{value: tt.pods}
 			updatedModel, _ = m.Update(podMsg)
 			m = updatedModel.(AppModel)
 
@@ -386,6 +390,7 @@ func TestAutoSelectFirstPod_ConsistentBehavior(t *testing.T) {
 			focusedPanel:      PanelNamespaces,
 			pods:              pods,
 			selectedPodIndex:  -1,
+			keys:              DefaultKeyMap(),
 		}
 
 		// Press Tab to switch to pods
@@ -405,7 +410,7 @@ func TestAutoSelectFirstPod_ConsistentBehavior(t *testing.T) {
 			actionSpinner:          components.NewSpinner(),
 			viewMode:               viewModeNamespaceView,
 			kubeAdapter:            adapter,
-			namespaces:             []string{"default"},
+			namespaces:             k8s.NamespacesNamed([]string{"default"}),
 			selectedNamespaceIndex: 0,
 			focusedPanel:           PanelNamespaces,
 			selectedPodIndex:       -1,
@@ -418,7 +423,7 @@ func TestAutoSelectFirstPod_ConsistentBehavior(t *testing.T) {
 		m := updatedModel.(AppModel)
 
 		// Simulate pod fetch
-		podMsg := podsFetchedMsg{pods: pods}
+		podMsg := podsFetchedMsg{value: pods}
 		updatedModel, _ = m.Update(podMsg)
 		m = updatedModel.(AppModel)
 
@@ -0,0 +1,132 @@
+package tui
+
+import (
+	"fmt"
+	"log/slog"
+	"strings"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/maratkarimov/kubertino/internal/audit"
+	"github.com/maratkarimov/kubertino/internal/config"
+	"github.com/maratkarimov/kubertino/internal/k8s"
+	"github.com/maratkarimov/kubertino/internal/tui/components"
+)
+
+// startFollowUp starts the follow-up the action of record declares for its outcome
+// (on_success, on_failure) against the same target: offered in a confirmation, or run right
+// away with follow_up: run. The follow-up of an action that was itself run that way is
+// always offered, so a failing runbook cannot loop. Returns false when no follow-up applies.
+func (m *AppModel) startFollowUp(record audit.Record, failed bool) (tea.Cmd, bool) {
+	chained := m.autoFollowUp
+	m.autoFollowUp = false
+
+	// The target is looked up in the lists shown, so they must still be the ones it ran in
+	if m.currentContext == nil || record.Context != m.currentContext.Name || record.Namespace != m.currentNamespace {
+		return nil, false
+	}
+	action, ok := config.FindAction(m.actions, record.Action)
+	if !ok || action.FollowUpFor(failed) == "" {
+		return nil, false
+	}
+	next, ok := config.FindAction(m.actions, action.FollowUpFor(failed))
+	if !ok {
+		return nil, false
+	}
+
+	outcome := fmt.Sprintf("%s exited 0", action.Name)
+	level := components.ToastSuccess
+	if failed {
+		outcome = fmt.Sprintf("%s failed (exit %d)", action.Name, record.ExitCode)
+		level = components.ToastWarning
+	}
+
+	if action.FollowUp == config.FollowUpRun && !chained {
+		slog.Info("running follow-up action", "action", action.Name, "follow_up", next.Name, "target", record.Target)
+		updated, cmd := m.runFollowUp(next, record)
+		*m = updated
+		m.autoFollowUp = cmd != nil
+		return tea.Batch(m.notify(fmt.Sprintf("%s, running %s", outcome, next.Name), level), cmd), true
+	}
+
+	current := *m
+	m.confirm.Show(
+		outcome,
+		fmt.Sprintf("Run %s on %s %s?", next.Name, record.Kind, record.Target),
+		"y",
+		func() tea.Cmd {
+			_, cmd := current.runFollowUp(next, record)
+			return cmd
+		},
+	)
+	return nil, true
+}
+
+// runFollowUp runs action against the pod or resource record ran against, if it is still listed
+func (m AppModel) runFollowUp(action config.Action, record audit.Record) (AppModel, tea.Cmd) {
+	if m.readOnlyBlocks(action) {
+		updated, _ := m.showReadOnly(action)
+		return updated.(AppModel), nil
+	}
+	// Follow-ups run with the param defaults instead of asking for values
+	if err := action.CheckParams(nil); err != nil {
+		m.errorModal.Show(fmt.Sprintf("Follow-up %s needs params: %s", action.Name, err), "Follow-up Action", nil)
+		return m, nil
+	}
+	// Namespace and context scoped follow-ups need no pod
+	if action.TargetScope() != config.ActionScopePod {
+		command, err := m.executor.Command(action, *m.currentContext, m.currentNamespace, k8s.Resource{}, k8s.Pod{}, m.config.KubeconfigPath(m.currentContext.Name), nil)
+		if err != nil {
+			m.errorModal.Show(fmt.Sprintf("Action failed: %s", err.Error()), "Follow-up Action", nil)
+			return m, nil
+		}
+		updated, teaCmd := m.execAction(action, k8s.Resource{}, command)
+		return updated.(AppModel), teaCmd
+	}
+	if record.Target == "" {
+		m.errorModal.Show(fmt.Sprintf("Follow-up %s needs a selected pod", action.Name), "Follow-up Action", nil)
+		return m, nil
+	}
+	if k8s.ResourceKind(record.Kind) == k8s.KindPod {
+		for _, pod := range m.pods {
+			if pod.Name != record.Target {
+				continue
+			}
+			if !action.AppliesToTarget(pod.Name) {
+				updated, _ := m.showNotApplicable(action, pod.Name)
+				return updated.(AppModel), nil
+			}
+			command, err := m.executor.Command(action, *m.currentContext, m.currentNamespace, k8s.PodResource(pod), pod, m.config.KubeconfigPath(m.currentContext.Name), nil)
+			if err != nil {
+				m.errorModal.Show(fmt.Sprintf("Action failed: %s", err.Error()), "Follow-up Action", nil)
+				return m, nil
+			}
+			updated, teaCmd := m.execAction(action, k8s.PodResource(pod), command)
+			return updated.(AppModel), teaCmd
+		}
+	} else {
+		for _, resource := range m.resources {
+			if string(resource.Kind) != record.Kind || resource.Name != record.Target {
+				continue
+			}
+			// Pod-only actions cannot run against deployments, jobs, ...
+			if strings.Contains(action.Command, ".pod") {
+				m.errorModal.Show(fmt.Sprintf("Action '%s' requires a pod", action.Name), "Follow-up Action", nil)
+				return m, nil
+			}
+			if !action.AppliesToTarget(resource.Name) {
+				updated, _ := m.showNotApplicable(action, resource.Name)
+				return updated.(AppModel), nil
+			}
+			command, err := m.executor.Command(action, *m.currentContext, m.currentNamespace, resource, k8s.Pod{}, m.config.KubeconfigPath(m.currentContext.Name), nil)
+			if err != nil {
+				m.errorModal.Show(fmt.Sprintf("Action failed: %s", err.Error()), "Follow-up Action", nil)
+				return m, nil
+			}
+			updated, teaCmd := m.execAction(action, resource, command)
+			return updated.(AppModel), teaCmd
+		}
+	}
+
+	m.errorModal.Show(fmt.Sprintf("%s %s is no longer listed", record.Kind, record.Target), "Follow-up Action", nil)
+	return m, nil
+}
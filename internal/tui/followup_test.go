@@ -0,0 +1,122 @@
+package tui
+
+import (
+	"os/exec"
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/maratkarimov/kubertino/internal/audit"
+	"github.com/maratkarimov/kubertino/internal/config"
+	"github.com/maratkarimov/kubertino/internal/executor"
+	"github.com/maratkarimov/kubertino/internal/k8s"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// newFollowUpTestModel returns a model with a health check that follows up with show-logs
+// on failure, started as followUp says
+func newFollowUpTestModel(followUp string) AppModel {
+	model := newTestModel(newMockAdapter(), withContexts(config.Context{Name: "dev"}), withActions(
+		config.Action{Name: "Health", Shortcut: "h", Command: "curl {{.pod}}", OnFailure: "show-logs", FollowUp: followUp},
+		config.Action{Name: "show-logs", Shortcut: "l", Command: "kubectl logs {{.pod}}"},
+	))
+	model.currentNamespace = "app"
+	model.pods = []k8s.Pod{{Name: "web-1", Status: "Running"}}
+	return model
+}
+
+// finished returns the message of the named action exiting with code against web-1
+func finished(t *testing.T, action string, code int) execFinishedMsg {
+	t.Helper()
+	record := audit.Record{Context: "dev", Namespace: "app", Kind: string(k8s.KindPod), Target: "web-1", Action: action}
+	if code == 0 {
+		return execFinishedMsg{record: record}
+	}
+	err := exec.Command("sh", "-c", "exit 2").Run()
+	require.Error(t, err)
+	return execFinishedMsg{record: record, err: err}
+}
+
+func TestFollowUp_OfferedOnFailure(t *testing.T) {
+	model := newFollowUpTestModel("")
+
+	updated, _ := model.Update(finished(t, "Health", 2))
+	model = updated.(AppModel)
+	require.True(t, model.confirm.IsVisible, "follow-ups are offered by default")
+	assert.Contains(t, model.View(), "Health failed (exit 2)")
+	assert.Contains(t, model.View(), "Run show-logs on pod web-1?")
+	assert.False(t, model.errorModal.IsVisible, "the offer replaces the error dialog")
+
+	updated, _ = model.Update(runeKey('y'))
+	model = updated.(AppModel)
+	_, cmd := model.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	assert.NotNil(t, cmd, "confirming runs the follow-up")
+}
+
+func TestFollowUp_NotOfferedOnSuccess(t *testing.T) {
+	model := newFollowUpTestModel("")
+
+	updated, _ := model.Update(finished(t, "Health", 0))
+	model = updated.(AppModel)
+	assert.False(t, model.confirm.IsVisible, "only on_failure is declared")
+}
+
+func TestFollowUp_RunAutomaticallyOnce(t *testing.T) {
+	model := newFollowUpTestModel(config.FollowUpRun)
+
+	updated, cmd := model.Update(finished(t, "Health", 2))
+	model = updated.(AppModel)
+	require.NotNil(t, cmd)
+	assert.False(t, model.confirm.IsVisible)
+	assert.True(t, model.autoFollowUp)
+
+	// A follow-up run automatically does not run further follow-ups on its own
+	updated, _ = model.Update(finished(t, "show-logs", 0))
+	model = updated.(AppModel)
+	assert.False(t, model.autoFollowUp)
+	updated, _ = model.Update(finished(t, "Health", 2))
+	model = updated.(AppModel)
+	assert.False(t, model.confirm.IsVisible, "runs again once the chain has ended")
+	assert.True(t, model.autoFollowUp)
+}
+
+func TestFollowUp_TargetNoLongerListed(t *testing.T) {
+	model := newFollowUpTestModel(config.FollowUpRun)
+	model.pods = nil
+
+	updated, _ := model.Update(finished(t, "Health", 2))
+	model = updated.(AppModel)
+	assert.True(t, model.errorModal.IsVisible)
+	assert.False(t, model.autoFollowUp)
+}
+
+func TestFollowUp_UsesCapturedVar(t *testing.T) {
+	model := newFollowUpTestModel("")
+	model.config.Actions = []config.Action{
+		{Name: "Pod IP", Shortcut: "i", Command: "hostname -i", CaptureVar: "POD_IP", OnSuccess: "Ping"},
+		{Name: "Ping", Shortcut: "p", Command: "ping -c 1 {{.vars.POD_IP}}"},
+	}
+	model.actions = model.config.Actions
+
+	cmd := exec.Command("sh", "-c", "echo 10.1.2.3; exit 0")
+	capture := executor.CaptureStdout(cmd, "POD_IP")
+	require.NoError(t, cmd.Run())
+	msg := finished(t, "Pod IP", 0)
+	msg.capture = capture
+
+	updated, _ := model.Update(msg)
+	model = updated.(AppModel)
+	assert.Equal(t, map[string]string{"POD_IP": "10.1.2.3"}, model.executor.Vars().Values())
+	require.True(t, model.confirm.IsVisible, "the follow-up is offered")
+
+	command, err := model.executor.Command(model.config.Actions[1], config.Context{Name: "dev"}, "app", k8s.PodResource(model.pods[0]), model.pods[0], "", nil)
+	require.NoError(t, err)
+	assert.Equal(t, "ping -c 1 10.1.2.3", command)
+
+	// A failed run leaves the variable as it was
+	msg = finished(t, "Pod IP", 2)
+	msg.capture = executor.CaptureStdout(exec.Command("true"), "POD_IP")
+	updated, _ = model.Update(msg)
+	model = updated.(AppModel)
+	assert.Equal(t, "10.1.2.3", model.executor.Vars().Values()["POD_IP"])
+}
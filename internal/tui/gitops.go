@@ -0,0 +1,151 @@
+package tui
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/maratkarimov/kubertino/internal/k8s"
+	"github.com/maratkarimov/kubertino/internal/tui/styles"
+)
+
+// GitOpsInspector is implemented by adapters that can resolve GitOps metadata for a pod
+type GitOpsInspector interface {
+	GetGitOpsInfo(context, namespace string, pod k8s.Pod) (*k8s.GitOpsInfo, error)
+}
+
+// gitOpsFetchedMsg is sent when GitOps metadata for a pod has been resolved
+type gitOpsFetchedMsg = resultMsg[*k8s.GitOpsInfo]
+
+// openGitOps shows the GitOps detail panel for the selected pod and starts the lookup
+func (m AppModel) openGitOps() (tea.Model, tea.Cmd) {
+	inspector, ok := m.kubeAdapter.(GitOpsInspector)
+	if !ok || m.currentContext == nil {
+		return m, nil
+	}
+
+	pod, ok := m.selectedPod()
+	if !ok {
+		m.errorModal.ShowWithSuggestion(
+			"No pod selected",
+			"GitOps",
+			"Press Tab to focus pod panel, then use arrow keys to select a pod",
+			nil,
+		)
+		return m, nil
+	}
+
+	m.viewMode = viewModeGitOps
+	m.gitOpsPod = pod.Name
+	m.gitOpsInfo = nil
+	m.gitOpsError = nil
+	m.gitOpsMessage = ""
+	m.gitOpsLoading = true
+
+	contextName, namespace := m.currentContext.Name, m.currentNamespace
+	return m, fetchCmd(m.requests, asyncGitOps, "", func(context.Context) (*k8s.GitOpsInfo, error) {
+		info, err := inspector.GetGitOpsInfo(contextName, namespace, pod)
+		if err != nil {
+			slog.Error("gitops lookup failed", "pod", pod.Name, "error", err)
+		}
+		return info, err
+	})
+}
+
+// handleGitOpsFetched stores the lookup result if the panel still shows that pod
+func (m AppModel) handleGitOpsFetched(msg gitOpsFetchedMsg) (tea.Model, tea.Cmd) {
+	if !m.requests.current(msg.asyncRequest) || m.viewMode != viewModeGitOps {
+		return m, nil
+	}
+
+	m.gitOpsLoading = false
+	m.gitOpsInfo = msg.value
+	m.gitOpsError = msg.err
+	return m, nil
+}
+
+// handleGitOpsKey handles key presses while the GitOps detail panel is open
+func (m AppModel) handleGitOpsKey(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	switch {
+	case msg.Type == tea.KeyCtrlC:
+		return m, tea.Quit
+
+	case msg.Type == tea.KeyEsc || msg.String() == "q" || KeyMatches(msg, m.keys.GitOps):
+		m.viewMode = viewModeNamespaceView
+		return m, nil
+
+	case msg.String() == "c":
+		if m.gitOpsInfo == nil || m.gitOpsInfo.Link() == "" {
+			m.gitOpsMessage = "No source link available"
+			return m, nil
+		}
+		link := m.gitOpsInfo.Link()
+		if err := copyToClipboard(link); err != nil {
+			slog.Warn("clipboard copy failed", "error", err)
+			m.gitOpsMessage = fmt.Sprintf("Copy failed: %v", err)
+			return m, nil
+		}
+		m.gitOpsMessage = "Copied " + link
+	}
+
+	return m, nil
+}
+
+// renderGitOps renders the GitOps detail panel as a centered dialog
+func (m AppModel) renderGitOps() string {
+	var content string
+	content += styles.TitleStyle.Render("GitOps Source") + "\n"
+	content += styles.DimStyle.Render("Pod: "+m.gitOpsPod) + "\n\n"
+
+	info := m.gitOpsInfo
+	switch {
+	case m.gitOpsLoading:
+		content += styles.LoadingStyle.Render("Resolving owner and annotations...") + "\n"
+	case m.gitOpsError != nil:
+		content += styles.ErrorStyle.Render(fmt.Sprintf("Error: %v", m.gitOpsError)) + "\n"
+	case info == nil:
+		content += styles.PlaceholderStyle.Render("No GitOps metadata") + "\n"
+	default:
+		content += gitOpsLine("Workload", info.WorkloadKind+"/"+info.WorkloadName)
+		if info.Empty() {
+			content += "\n" + styles.PlaceholderStyle.Render("No GitOps annotations found on this workload") + "\n"
+			break
+		}
+		content += gitOpsLine("Argo CD app", info.ArgoApp)
+		content += gitOpsLine("Tracking id", info.ArgoTrackingID)
+		content += gitOpsLine("Kustomization", info.FluxKustomization)
+		content += gitOpsLine("HelmRelease", info.FluxHelmRelease)
+		content += gitOpsLine("Commit", info.Revision)
+		content += gitOpsLine("Source", info.Source)
+		content += gitOpsLine("Link", info.Link())
+	}
+
+	if m.gitOpsMessage != "" {
+		content += "\n" + styles.WarningStyle.Render(m.gitOpsMessage) + "\n"
+	}
+
+	content += "\n" + styles.DimStyle.Render("c: Copy link | ESC/q: Back")
+
+	dialogStyle := lipgloss.NewStyle().
+		Border(lipgloss.RoundedBorder()).
+		BorderForeground(lipgloss.Color("39")). // Bright cyan
+		Padding(1, 2)
+
+	return lipgloss.Place(
+		m.termWidth,
+		m.termHeight,
+		lipgloss.Center,
+		lipgloss.Center,
+		dialogStyle.Render(content),
+	)
+}
+
+// gitOpsLine renders a label/value row, or nothing when the value is empty
+func gitOpsLine(label, value string) string {
+	if value == "" {
+		return ""
+	}
+	return fmt.Sprintf("%-14s %s\n", label+":", styles.NormalStyle.Render(value))
+}
@@ -0,0 +1,89 @@
+package tui
+
+import (
+	"errors"
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/maratkarimov/kubertino/internal/k8s"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// gitOpsAdapter is a mock adapter that also resolves GitOps metadata
+type gitOpsAdapter struct {
+	*mockKubeAdapter
+	info *k8s.GitOpsInfo
+}
+
+func (a *gitOpsAdapter) GetGitOpsInfo(context, namespace string, pod k8s.Pod) (*k8s.GitOpsInfo, error) {
+	return a.info, nil
+}
+
+func newGitOpsTestModel(info *k8s.GitOpsInfo) AppModel {
+	model := newTestModel(&gitOpsAdapter{mockKubeAdapter: newMockAdapter(), info: info})
+	model.currentNamespace = "default"
+	model.pods = []k8s.Pod{{Name: "web-7d9f-abcde", Status: "Running", OwnerKind: "ReplicaSet", OwnerName: "web-7d9f"}}
+	model.focusedPanel = PanelPods
+	model.selectedPodIndex = 0
+	return model
+}
+
+// openGitOpsPanel presses the GitOps key and delivers the lookup result
+func openGitOpsPanel(t *testing.T, model AppModel) AppModel {
+	t.Helper()
+	updated, cmd := model.Update(runeKey('g'))
+	model = updated.(AppModel)
+	require.Equal(t, viewModeGitOps, model.viewMode)
+	require.NotNil(t, cmd)
+	assert.Contains(t, model.View(), "Resolving owner")
+
+	updated, _ = model.Update(cmd())
+	return updated.(AppModel)
+}
+
+func TestGitOps_ShowsWorkloadSource(t *testing.T) {
+	model := openGitOpsPanel(t, newGitOpsTestModel(&k8s.GitOpsInfo{
+		WorkloadKind: "Deployment",
+		WorkloadName: "web",
+		ArgoApp:      "shop",
+		Revision:     "abc123",
+		Source:       "https://github.com/acme/web",
+	}))
+
+	view := model.View()
+	assert.Contains(t, view, "Deployment/web")
+	assert.Contains(t, view, "shop")
+	assert.Contains(t, view, "https://github.com/acme/web/commit/abc123")
+
+	model = sendKey(model, tea.KeyMsg{Type: tea.KeyEsc})
+	assert.Equal(t, viewModeNamespaceView, model.viewMode)
+}
+
+func TestGitOps_CopyLink(t *testing.T) {
+	original := copyToClipboard
+	t.Cleanup(func() { copyToClipboard = original })
+
+	var copied string
+	copyToClipboard = func(text string) error {
+		copied = text
+		return nil
+	}
+
+	model := openGitOpsPanel(t, newGitOpsTestModel(&k8s.GitOpsInfo{Source: "https://github.com/acme/web", Revision: "abc123"}))
+	model = sendKey(model, runeKey('c'))
+	assert.Equal(t, "https://github.com/acme/web/commit/abc123", copied)
+	assert.Contains(t, model.gitOpsMessage, "Copied")
+
+	copyToClipboard = func(string) error { return errors.New("no clipboard") }
+	model = sendKey(model, runeKey('c'))
+	assert.Contains(t, model.gitOpsMessage, "Copy failed")
+}
+
+func TestGitOps_NoAnnotations(t *testing.T) {
+	model := openGitOpsPanel(t, newGitOpsTestModel(&k8s.GitOpsInfo{WorkloadKind: "Deployment", WorkloadName: "web"}))
+
+	assert.Contains(t, model.View(), "No GitOps annotations")
+	model = sendKey(model, runeKey('c'))
+	assert.Equal(t, "No source link available", model.gitOpsMessage)
+}
@@ -0,0 +1,97 @@
+package tui
+
+import (
+	"strings"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/maratkarimov/kubertino/internal/tui/styles"
+)
+
+// headerSeparator separates the context, namespace and pod in the header breadcrumb
+const headerSeparator = " ▸ "
+
+// headerTickMsg updates the header clock at every full minute
+type headerTickMsg time.Time
+
+// startHeaderCmd schedules the next header clock update, or returns nil when the header is
+// not shown
+func (m AppModel) startHeaderCmd() tea.Cmd {
+	if !m.showHeader() {
+		return nil
+	}
+	return tea.Every(time.Minute, func(t time.Time) tea.Msg {
+		return headerTickMsg(t)
+	})
+}
+
+// showHeader reports whether show_header is set
+func (m AppModel) showHeader() bool {
+	return m.config != nil && m.config.ShowHeader
+}
+
+// headerHeight returns the lines taken by the tab bar and the header above the panels
+func (m AppModel) headerHeight() int {
+	if m.showHeader() {
+		return m.tabBarHeight() + HeaderHeight
+	}
+	return m.tabBarHeight()
+}
+
+// renderHeader renders the header line: the context ▸ namespace ▸ pod breadcrumb on the
+// left, the connection status and the clock on the right
+func (m AppModel) renderHeader() string {
+	contextName := "None"
+	if m.currentContext != nil {
+		contextName = m.currentContext.Name
+	}
+	crumbs := []string{"Context: " + contextName}
+	if m.currentNamespace != "" {
+		crumbs = append(crumbs, m.currentNamespace)
+		if pod, ok := m.selectedPod(); ok {
+			crumbs = append(crumbs, pod.Name)
+		}
+	}
+	left := strings.Join(crumbs, headerSeparator)
+	right := m.connectionStatus() + "  " + time.Now().Format("15:04")
+
+	// HeaderStyle pads 2 cells on both sides; the breadcrumb is cut before the status is
+	inner := max(m.termWidth-4, 0)
+	left = truncateWidth(left, max(inner-lipgloss.Width(right)-1, 0))
+	gap := max(inner-lipgloss.Width(left)-lipgloss.Width(right), 1)
+	return styles.HeaderStyle.Width(m.termWidth).MaxHeight(1).Render(left + strings.Repeat(" ", gap) + right)
+}
+
+// connectionStatus describes the state of the cluster connection of the current context
+func (m AppModel) connectionStatus() string {
+	switch {
+	case m.currentContext == nil:
+		return "○ not connected"
+	case m.namespacesError != nil || m.podsError != nil:
+		return "✗ error"
+	case m.namespacesLoading || m.podsLoading:
+		return "◌ loading"
+	case m.throttled[m.currentContext.Name]:
+		return "◌ throttled"
+	case m.namespacesFallback:
+		return "● connected (namespaces from config)"
+	default:
+		return "● connected"
+	}
+}
+
+// truncateWidth cuts s to width cells, marking the cut with an ellipsis
+func truncateWidth(s string, width int) string {
+	if lipgloss.Width(s) <= width {
+		return s
+	}
+	if width <= 0 {
+		return ""
+	}
+	runes := []rune(s)
+	for len(runes) > 0 && lipgloss.Width(string(runes))+1 > width {
+		runes = runes[:len(runes)-1]
+	}
+	return string(runes) + "…"
+}
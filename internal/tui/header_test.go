@@ -0,0 +1,89 @@
+package tui
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/charmbracelet/lipgloss"
+	"github.com/maratkarimov/kubertino/internal/config"
+	"github.com/maratkarimov/kubertino/internal/k8s"
+	"github.com/stretchr/testify/assert"
+)
+
+// newHeaderTestModel returns a model in the namespace view with web-1 selected
+func newHeaderTestModel(showHeader bool) AppModel {
+	model := newTestModel(newMockAdapter(), withContexts(config.Context{Name: "prod"}),
+		func(cfg *config.Config) { cfg.ShowHeader = showHeader })
+	model.termHeight = 30
+	model.viewMode = viewModeNamespaceView
+	model.currentContext = &model.config.Contexts[0]
+	model.namespaces = k8s.NamespacesNamed([]string{"default"})
+	model.currentNamespace = "default"
+	model.pods = []k8s.Pod{{Name: "web-1", Status: "Running"}}
+	model.focusedPanel = PanelPods
+	model.selectedPodIndex = 0
+	return model
+}
+
+func TestHeader_Breadcrumb(t *testing.T) {
+	model := newHeaderTestModel(true)
+
+	header := model.renderHeader()
+	assert.Equal(t, 1, lipgloss.Height(header))
+	assert.Equal(t, model.termWidth, lipgloss.Width(header))
+	assert.Contains(t, header, "Context: prod ▸ default ▸ web-1")
+	assert.Contains(t, header, "● connected")
+	assert.Regexp(t, `\d\d:\d\d`, header, "the clock is shown")
+
+	model.currentNamespace = ""
+	assert.NotContains(t, model.renderHeader(), "▸")
+}
+
+func TestHeader_LongBreadcrumbKeepsStatus(t *testing.T) {
+	model := newHeaderTestModel(true)
+	model.termWidth = 80
+	model.pods = []k8s.Pod{{Name: strings.Repeat("very-long-pod-name-", 5), Status: "Running"}}
+
+	header := model.renderHeader()
+	assert.Equal(t, 80, lipgloss.Width(header))
+	assert.Contains(t, header, "…")
+	assert.Contains(t, header, "● connected")
+}
+
+func TestHeader_Layout(t *testing.T) {
+	hidden := newHeaderTestModel(false)
+	shown := newHeaderTestModel(true)
+
+	assert.Zero(t, hidden.splitLayout().namespaces.y)
+	assert.Equal(t, hidden.termHeight, hidden.splitLayout().namespaces.h)
+	assert.NotContains(t, hidden.View(), "Context: prod")
+
+	layout := shown.splitLayout()
+	assert.Equal(t, 1, layout.namespaces.y, "panels start under the header")
+	assert.Equal(t, 1, layout.pods.y)
+	assert.Equal(t, shown.termHeight-1, layout.namespaces.h)
+
+	view := shown.View()
+	assert.Contains(t, strings.Split(view, "\n")[0], "Context: prod", "the header is the first line")
+	assert.Equal(t, shown.termHeight, lipgloss.Height(view), "the view still fits the terminal")
+}
+
+func TestConnectionStatus(t *testing.T) {
+	model := newHeaderTestModel(true)
+	assert.Equal(t, "● connected", model.connectionStatus())
+
+	model.podsLoading = true
+	assert.Equal(t, "◌ loading", model.connectionStatus())
+
+	model.podsError = errors.New("connection refused")
+	assert.Equal(t, "✗ error", model.connectionStatus())
+
+	model.podsError = nil
+	model.podsLoading = false
+	model.throttled = map[string]bool{"prod": true}
+	assert.Equal(t, "◌ throttled", model.connectionStatus())
+
+	model.currentContext = nil
+	assert.Equal(t, "○ not connected", model.connectionStatus())
+}
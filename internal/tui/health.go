@@ -0,0 +1,118 @@
+package tui
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/maratkarimov/kubertino/internal/config"
+	"github.com/maratkarimov/kubertino/internal/k8s"
+	"github.com/maratkarimov/kubertino/internal/tui/styles"
+)
+
+// ContextProber is implemented by adapters that can check whether the API server of a
+// context answers. Without it, the context list shows no health.
+type ContextProber interface {
+	ProbeContext(context string, timeout time.Duration) (*k8s.ContextHealth, error)
+}
+
+// healthConcurrency bounds how many contexts are probed at once
+const healthConcurrency = 8
+
+// contextHealthMsg is sent when the health probe of a context has finished. The request key
+// is the context name.
+type contextHealthMsg = resultMsg[*k8s.ContextHealth]
+
+// healthTimeout returns how long a health probe may take, 0 when probes are off
+func (m AppModel) healthTimeout() time.Duration {
+	timeout, err := m.config.HealthTimeoutDuration()
+	if err != nil {
+		slog.Warn("invalid health_timeout, using default", "error", err)
+		return config.DefaultHealthTimeout
+	}
+	return timeout
+}
+
+// probeContextsCmd probes every context concurrently so the context list shows which are
+// reachable. Returns nil while only one context is configured, when health_timeout is "0"
+// or when the adapter cannot probe.
+func (m AppModel) probeContextsCmd() tea.Cmd {
+	prober, ok := m.kubeAdapter.(ContextProber)
+	timeout := m.healthTimeout()
+	if !ok || timeout == 0 || len(m.contexts) < 2 {
+		return nil
+	}
+
+	sem := make(chan struct{}, healthConcurrency)
+	cmds := make([]tea.Cmd, 0, len(m.contexts))
+	for _, ctx := range m.contexts {
+		name := ctx.Name
+		cmds = append(cmds, fetchCmd(m.requests, asyncHealth, name, func(context.Context) (*k8s.ContextHealth, error) {
+			sem <- struct{}{}
+			defer func() { <-sem }()
+
+			slog.Debug("probing context", "context", name)
+			return prober.ProbeContext(name, timeout)
+		}))
+	}
+	return tea.Batch(cmds...)
+}
+
+// handleContextHealth records the health of a context. A probe that could not run at all
+// (e.g. the context is missing from kubeconfig) counts as unreachable.
+func (m AppModel) handleContextHealth(msg contextHealthMsg) (tea.Model, tea.Cmd) {
+	if !m.requests.current(msg.asyncRequest) {
+		return m, nil
+	}
+	if m.health == nil {
+		m.health = make(map[string]*k8s.ContextHealth)
+	}
+
+	health := msg.value
+	if msg.err != nil {
+		health = &k8s.ContextHealth{Status: k8s.HealthUnreachable, Detail: msg.err.Error()}
+	}
+	slog.Debug("context probed", "context", msg.key, "status", health.Status, "latency", health.Latency, "detail", health.Detail)
+	m.health[msg.key] = health
+	return m, nil
+}
+
+// healthBadge renders the health of a context in the context list: ◌ while it is being
+// probed for the first time, then ✓ with the latency, ✗ or ⏱ with the reason
+func (m AppModel) healthBadge(contextName string) string {
+	if m.health == nil {
+		return ""
+	}
+	health, ok := m.health[contextName]
+	switch {
+	case !ok:
+		return styles.DimStyle.Render(" ◌")
+	case health.Status == k8s.HealthReachable:
+		return styles.RunningStyle.Render(" ✓") + styles.DimStyle.Render(" "+formatLatency(health.Latency))
+	case health.Status == k8s.HealthAuthError:
+		return styles.FailedStyle.Render(" ✗ auth error")
+	case health.Status == k8s.HealthTimeout:
+		return styles.WarningStyle.Render(" ⏱ timeout")
+	default:
+		return styles.FailedStyle.Render(" ✗ unreachable")
+	}
+}
+
+// healthDetail renders why the probe of a context failed, "" when it did not
+func (m AppModel) healthDetail(contextName string, width int) string {
+	health, ok := m.health[contextName]
+	if !ok || health.Detail == "" {
+		return ""
+	}
+	return styles.DimStyle.Render(truncateName(contextName+": "+health.Detail, width))
+}
+
+// formatLatency renders how long a probe took, e.g. 85ms or 1.2s
+func formatLatency(d time.Duration) string {
+	if d < time.Second {
+		return fmt.Sprintf("%dms", d.Milliseconds())
+	}
+	return fmt.Sprintf("%.1fs", d.Seconds())
+}
@@ -0,0 +1,83 @@
+package tui
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/maratkarimov/kubertino/internal/config"
+	"github.com/maratkarimov/kubertino/internal/k8s"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// proberAdapter is a mock adapter answering health probes with a fixed health per context
+type proberAdapter struct {
+	*mockKubeAdapter
+	health map[string]*k8s.ContextHealth
+}
+
+func (a *proberAdapter) ProbeContext(context string, timeout time.Duration) (*k8s.ContextHealth, error) {
+	health, ok := a.health[context]
+	if !ok {
+		return nil, errors.New("context not found")
+	}
+	return health, nil
+}
+
+func newHealthTestModel(opts ...testModelOption) (AppModel, *proberAdapter) {
+	adapter := &proberAdapter{
+		mockKubeAdapter: newMockAdapter(),
+		health: map[string]*k8s.ContextHealth{
+			"dev":     {Status: k8s.HealthReachable, Latency: 85 * time.Millisecond},
+			"prod":    {Status: k8s.HealthAuthError, Detail: "error: You must be logged in to the server (Unauthorized)"},
+			"staging": {Status: k8s.HealthTimeout, Detail: "i/o timeout"},
+		},
+	}
+	contexts := withContexts(config.Context{Name: "dev"}, config.Context{Name: "prod"}, config.Context{Name: "staging"}, config.Context{Name: "gone"})
+	return newTestModel(adapter, append([]testModelOption{contexts}, opts...)...), adapter
+}
+
+func TestHealth_ShownInContextList(t *testing.T) {
+	model, _ := newHealthTestModel()
+	assert.Contains(t, model.View(), "dev ◌")
+
+	model = runCmds(model, model.probeContextsCmd(), contextHealthMsg{})
+
+	view := model.View()
+	assert.Contains(t, view, "dev ✓ 85ms")
+	assert.Contains(t, view, "prod ✗ auth error")
+	assert.Contains(t, view, "staging ⏱ timeout")
+	assert.Contains(t, view, "gone ✗ unreachable")
+	assert.Contains(t, view, "R: Recheck")
+
+	// Why the selected context failed is shown under the list
+	model.selectedContextIndex = 1
+	assert.Contains(t, model.View(), "prod: error: You must be logged in to the server (Unauthorized)")
+}
+
+func TestHealth_Recheck(t *testing.T) {
+	model, adapter := newHealthTestModel()
+	model = runCmds(model, model.probeContextsCmd(), contextHealthMsg{})
+
+	adapter.health["prod"] = &k8s.ContextHealth{Status: k8s.HealthReachable, Latency: 1200 * time.Millisecond}
+	updated, cmd := model.Update(runeKey('R'))
+	model = updated.(AppModel)
+	model = runCmds(model, cmd, contextHealthMsg{})
+	assert.Contains(t, model.View(), "prod ✓ 1.2s")
+}
+
+func TestHealth_Disabled(t *testing.T) {
+	model, _ := newHealthTestModel(func(cfg *config.Config) { cfg.HealthTimeout = "0" })
+	assert.Nil(t, model.health)
+	assert.Nil(t, model.probeContextsCmd())
+	assert.NotContains(t, model.View(), "◌")
+	assert.NotContains(t, model.View(), "Recheck")
+}
+
+func TestHealth_NotProbedWithOneContext(t *testing.T) {
+	model, _ := newHealthTestModel()
+	model.contexts = model.contexts[:1]
+	require.NotNil(t, model.health)
+	assert.Nil(t, model.probeContextsCmd())
+}
@@ -0,0 +1,168 @@
+package tui
+
+import (
+	"fmt"
+	"log/slog"
+	"strings"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/maratkarimov/kubertino/internal/config"
+)
+
+// helpKey is one line of the help overlay: the keys and what they do
+type helpKey struct {
+	keys string
+	help string
+}
+
+// openHelp shows every key binding, the keys of the panels and views, and the actions of the
+// current context in a pager. It is built from the key map and config in use, so rebound keys
+// and reloaded actions show up as they are.
+func (m AppModel) openHelp() (tea.Model, tea.Cmd) {
+	slog.Debug("help opened")
+	m.helpReturnMode = m.viewMode
+	m.viewMode = viewModeHelp
+	m.pager.SetSize(m.termWidth, m.termHeight)
+	m.pager.ShowLoading("Help")
+	m.pager.SetContent(m.renderHelp())
+	return m, nil
+}
+
+// handleHelpKey handles key presses while the help overlay is open: the help key closes it,
+// everything else scrolls or closes the pager
+func (m AppModel) handleHelpKey(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	if msg.Type == tea.KeyCtrlC {
+		return m, tea.Quit
+	}
+
+	if KeyMatches(msg, m.keys.Help) {
+		m.pager.Hide()
+	} else {
+		m.pager.HandleKeyPress(msg)
+	}
+
+	if !m.pager.IsVisible {
+		m.viewMode = m.helpReturnMode
+	}
+	return m, nil
+}
+
+// renderHelp lists the key bindings, the panel and view keys, and the actions
+func (m AppModel) renderHelp() string {
+	var keys []helpKey
+	for _, b := range m.keys.bindings() {
+		if len(*b.keys) == 0 {
+			continue
+		}
+		keys = append(keys, helpKey{keys: strings.Join(*b.keys, ", "), help: b.help})
+	}
+
+	sections := []string{
+		renderHelpSection("Keys", keys),
+		renderHelpSection("Namespaces panel", []helpKey{
+			{"a-z, 0-9, -", "Start filtering the namespaces"},
+			{"tab, shift+tab", "While filtering: complete the query, then cycle the top matches"},
+			{"backspace, ctrl+u", "While filtering: delete a character, clear the query"},
+		}),
+		renderHelpSection("Pods panel", m.podPanelHelp()),
+		renderHelpSection("Inputs (search, label selector, params)", []helpKey{
+			{"enter", "Apply"},
+			{"esc", "Cancel"},
+			{"backspace, ctrl+u", "Delete a character, clear the input"},
+		}),
+		renderHelpSection("Pagers (describe, output, stderr, help)", []helpKey{
+			{"up, k, down, j", "Scroll"},
+			{"pgup, b, pgdown, space", "Scroll a page"},
+			{"ctrl+u, ctrl+d", "Scroll half a page"},
+			{"g, G", "Top, bottom"},
+			{"esc, q", "Close"},
+			{"m", "In a secret: reveal or hide the values"},
+		}),
+		m.actionsHelp(),
+	}
+	return strings.Join(sections, "\n\n")
+}
+
+// podPanelHelp lists the keys of the pods panel that are not key bindings
+func (m AppModel) podPanelHelp() []helpKey {
+	keys := []helpKey{
+		{strings.Join(m.keys.Down, ", "), "On the last pod: load the next page when pod_page_size is set"},
+	}
+	if m.podSelector != "" {
+		keys = append(keys, helpKey{strings.Join(m.keys.ClearSelector, ", "), "Clear the label selector -l " + m.podSelector})
+	}
+	return keys
+}
+
+// actionsHelp lists the actions of the current context, or the global ones in the context
+// list, with their shortcuts and what they do
+func (m AppModel) actionsHelp() string {
+	title := "Actions"
+	actions := m.actions
+	if m.currentContext != nil {
+		title += " (" + m.currentContext.Name + ")"
+	} else if m.config != nil {
+		actions = m.config.Actions
+	}
+	if len(actions) == 0 {
+		return renderHelpSection(title, []helpKey{{"", "No actions configured"}})
+	}
+
+	keys := make([]helpKey, 0, len(actions))
+	for _, action := range actions {
+		keys = append(keys, helpKey{keys: m.actionKeys(action), help: actionHelp(action)})
+	}
+	return renderHelpSection(title, keys)
+}
+
+// actionHelp describes an action: its name, its description or else its command, and how it
+// runs
+func actionHelp(action config.Action) string {
+	text := action.Name + ": "
+	if action.Description != "" {
+		text += action.Description
+	} else {
+		text += action.Command
+	}
+
+	var notes []string
+	if action.Destructive {
+		notes = append(notes, "destructive")
+	}
+	if action.Background {
+		notes = append(notes, "background")
+	}
+	if action.Output == config.ActionOutputCapture {
+		notes = append(notes, "output paged")
+	}
+	if len(action.Params) > 0 {
+		notes = append(notes, "asks for params")
+	}
+	if action.AppliesTo != "" {
+		notes = append(notes, "pods matching "+action.AppliesTo)
+	}
+	if scope := action.TargetScope(); scope != config.ActionScopePod {
+		notes = append(notes, scope+" scope")
+	}
+	if action.Plugin != "" {
+		notes = append(notes, "plugin "+action.Plugin)
+	}
+	if len(notes) > 0 {
+		text += " [" + strings.Join(notes, ", ") + "]"
+	}
+	return text
+}
+
+// renderHelpSection renders a titled section with the keys aligned in a column
+func renderHelpSection(title string, keys []helpKey) string {
+	width := 0
+	for _, k := range keys {
+		width = max(width, len([]rune(k.keys)))
+	}
+
+	lines := []string{title}
+	for _, k := range keys {
+		lines = append(lines, fmt.Sprintf("  %-*s  %s", width, k.keys, k.help))
+	}
+	return strings.Join(lines, "\n")
+}
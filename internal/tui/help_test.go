@@ -0,0 +1,89 @@
+package tui
+
+import (
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/maratkarimov/kubertino/internal/config"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// newHelpTestModel returns a model in the namespace view of a context with two actions and
+// the pod sort rebound
+func newHelpTestModel() AppModel {
+	model := newTestModel(newMockAdapter(),
+		withActions(config.Action{Name: "Logs", Shortcut: "l", Command: "kubectl logs {{.pod}}", Description: "Follow the pod logs"}),
+		withContexts(config.Context{
+			Name: "prod",
+			Actions: []config.Action{
+				{Name: "Drop DB", Shortcut: "X", Command: "rake db:drop", Destructive: true},
+			},
+		}),
+		func(cfg *config.Config) { cfg.Keymap = &config.Keymap{PodSort: []string{"O"}} })
+	model.termWidth = 160
+	model.termHeight = 200
+	model.viewMode = viewModeNamespaceView
+	model.currentContext = &model.config.Contexts[0]
+	model.actions = config.MergeActions(model.config.Actions, model.currentContext.Actions)
+	return model
+}
+
+func TestHelp_ListsKeysAndActions(t *testing.T) {
+	model := newHelpTestModel()
+
+	model = sendKey(model, runeKey('?'))
+	require.Equal(t, viewModeHelp, model.viewMode)
+
+	view := model.View()
+	assert.Contains(t, view, "Sort pods by name, status, age or restarts")
+	assert.Regexp(t, `O +Sort pods`, view, "rebound keys are shown as configured")
+	assert.Regexp(t, `ctrl\+p +Jump to any context`, view)
+	assert.Contains(t, view, "Actions (prod)")
+	assert.Regexp(t, `l +Logs: Follow the pod logs`, view)
+	assert.Regexp(t, `X +Drop DB: rake db:drop \[destructive\]`, view, "without a description the command is shown")
+	assert.Contains(t, view, "Pagers (describe, output, stderr, help)")
+}
+
+func TestHelp_Closes(t *testing.T) {
+	model := newHelpTestModel()
+
+	model = sendKey(model, runeKey('?'))
+	model = sendKey(model, runeKey('?'))
+	assert.Equal(t, viewModeNamespaceView, model.viewMode, "the help key closes it again")
+
+	model = sendKey(model, runeKey('?'))
+	model = sendKey(model, tea.KeyMsg{Type: tea.KeyEsc})
+	assert.Equal(t, viewModeNamespaceView, model.viewMode, "ESC closes it without quitting")
+	assert.False(t, model.pager.IsVisible)
+}
+
+func TestHelp_FromContextList(t *testing.T) {
+	model := newHelpTestModel()
+	model.viewMode = viewModeContextSelection
+	model.currentContext = nil
+
+	model = sendKey(model, runeKey('?'))
+	require.Equal(t, viewModeHelp, model.viewMode)
+	help := model.renderHelp()
+	assert.Contains(t, help, "\nActions\n", "global actions are listed without a context")
+	assert.Contains(t, help, "Logs: Follow the pod logs")
+	assert.NotContains(t, help, "Drop DB")
+
+	model = sendKey(model, runeKey('q'))
+	assert.Equal(t, viewModeContextSelection, model.viewMode)
+}
+
+func TestActionHelp(t *testing.T) {
+	action := config.Action{
+		Name:       "Tail",
+		Command:    "kubectl logs --tail={{.params.lines}} {{.pod}}",
+		Background: true,
+		AppliesTo:  "^web-",
+		Params:     []config.ActionParam{{Name: "lines"}},
+	}
+	assert.Equal(t, "Tail: kubectl logs --tail={{.params.lines}} {{.pod}} [background, asks for params, pods matching ^web-]", actionHelp(action))
+
+	action = config.Action{Name: "Console", Command: "rails c", Description: "Rails console"}
+	assert.Equal(t, "Console: Rails console", actionHelp(action))
+}
@@ -0,0 +1,107 @@
+package tui
+
+import (
+	"reflect"
+	"sync"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/maratkarimov/kubertino/internal/config"
+)
+
+// testModelOption adjusts the config of a test model before the model is created
+type testModelOption func(cfg *config.Config)
+
+// withContexts replaces the single test-context of a test model
+func withContexts(contexts ...config.Context) testModelOption {
+	return func(cfg *config.Config) {
+		cfg.Contexts = contexts
+	}
+}
+
+// withActions configures global actions
+func withActions(actions ...config.Action) testModelOption {
+	return func(cfg *config.Config) {
+		cfg.Actions = actions
+	}
+}
+
+// newTestModel returns a model on adapter for a config with the single context test-context,
+// sized for a 120x40 terminal. opts adjust the config first; the config is model.config.
+func newTestModel(adapter KubeAdapter, opts ...testModelOption) AppModel {
+	cfg := &config.Config{
+		Version:  "1.0",
+		Contexts: []config.Context{{Name: "test-context"}},
+	}
+	for _, opt := range opts {
+		opt(cfg)
+	}
+	model := NewAppModel(cfg, adapter)
+	model.termWidth = 120
+	model.termHeight = 40
+	return model
+}
+
+func sendKey(model AppModel, msg tea.KeyMsg) AppModel {
+	updated, _ := model.Update(msg)
+	return updated.(AppModel)
+}
+
+func runeKey(r rune) tea.KeyMsg {
+	return tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{r}}
+}
+
+// runCmds runs cmd and feeds the messages of the same types as deliver to the model, following
+// the commands their updates return. Other messages, such as spinner ticks, are dropped.
+func runCmds(model AppModel, cmd tea.Cmd, deliver ...tea.Msg) AppModel {
+	for _, msg := range cmdMsgs(cmd) {
+		if !msgOfType(msg, deliver) {
+			continue
+		}
+		updated, next := model.Update(msg)
+		model = runCmds(updated.(AppModel), next, deliver...)
+	}
+	return model
+}
+
+// cmdMsgs runs cmd and returns its messages. The commands of a batch run concurrently, like
+// Bubble Tea runs them, so commands waiting for a shared slot do not block each other.
+func cmdMsgs(cmd tea.Cmd) []tea.Msg {
+	if cmd == nil {
+		return nil
+	}
+	msg := cmd()
+	batch, ok := msg.(tea.BatchMsg)
+	if !ok {
+		if msg == nil {
+			return nil
+		}
+		return []tea.Msg{msg}
+	}
+
+	results := make([][]tea.Msg, len(batch))
+	var wg sync.WaitGroup
+	for i, c := range batch {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			results[i] = cmdMsgs(c)
+		}()
+	}
+	wg.Wait()
+
+	var msgs []tea.Msg
+	for _, result := range results {
+		msgs = append(msgs, result...)
+	}
+	return msgs
+}
+
+// msgOfType reports whether msg has the type of one of types
+func msgOfType(msg tea.Msg, types []tea.Msg) bool {
+	for _, t := range types {
+		if reflect.TypeOf(msg) == reflect.TypeOf(t) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,233 @@
+package tui
+
+import (
+	"fmt"
+	"log/slog"
+	"strings"
+	"unicode"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/maratkarimov/kubertino/internal/audit"
+	"github.com/maratkarimov/kubertino/internal/config"
+	"github.com/maratkarimov/kubertino/internal/history"
+	"github.com/maratkarimov/kubertino/internal/k8s"
+	"github.com/maratkarimov/kubertino/internal/search"
+	"github.com/maratkarimov/kubertino/internal/tui/styles"
+)
+
+// SetHistory records every action run from the TUI in store and makes it browsable with the
+// history key
+func (m *AppModel) SetHistory(store *history.Store) {
+	m.history = store
+}
+
+// historyMatches returns the indices in m.historyEntries of the entries matching the history
+// query (action, target, namespace, context or command), best match first. An empty query
+// keeps the newest-first order.
+func (m AppModel) historyMatches() []int {
+	names := make([]string, len(m.historyEntries))
+	for i, entry := range m.historyEntries {
+		names[i] = strings.Join([]string{entry.Action, entry.Target, entry.Namespace, entry.Context, entry.Command}, " ")
+	}
+
+	matches := search.FuzzyMatchNames(m.historyQuery, names)
+	result := make([]int, len(matches))
+	for i, match := range matches {
+		result[i] = match.Index
+	}
+	return result
+}
+
+// openHistory shows the history of executed actions over the namespace view
+func (m *AppModel) openHistory() {
+	slog.Debug("action history opened")
+	m.viewMode = viewModeHistory
+	m.historyQuery = ""
+	m.historyIndex = 0
+	m.historyEntries = m.history.Entries()
+}
+
+// closeHistory returns to the namespace view
+func (m *AppModel) closeHistory() {
+	m.viewMode = viewModeNamespaceView
+	m.historyQuery = ""
+	m.historyEntries = nil
+}
+
+// handleHistoryKey handles key presses while the history view is open
+func (m AppModel) handleHistoryKey(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	matches := m.historyMatches()
+
+	switch msg.Type {
+	case tea.KeyCtrlC:
+		return m.quit()
+	case tea.KeyEsc:
+		m.closeHistory()
+	case tea.KeyUp:
+		if m.historyIndex > 0 {
+			m.historyIndex--
+		}
+	case tea.KeyDown:
+		if m.historyIndex < len(matches)-1 {
+			m.historyIndex++
+		}
+	case tea.KeyEnter:
+		if m.historyIndex < len(matches) {
+			entry := m.historyEntries[matches[m.historyIndex]]
+			m.closeHistory()
+			return m.rerunHistoryEntry(entry)
+		}
+	case tea.KeyBackspace:
+		if len(m.historyQuery) > 0 {
+			m.historyQuery = m.historyQuery[:len(m.historyQuery)-1]
+			m.historyIndex = 0
+		}
+	case tea.KeyRunes, tea.KeySpace:
+		for _, r := range msg.Runes {
+			if unicode.IsPrint(r) {
+				m.historyQuery += string(r)
+			}
+		}
+		m.historyIndex = 0
+	}
+	return m, nil
+}
+
+// rerunHistoryEntry runs the recorded command of entry again in the terminal, against the
+// context, namespace and target it ran in. The command is not rendered again, so it does not
+// depend on the current selection. Destructive actions ask for confirmation again.
+func (m AppModel) rerunHistoryEntry(entry history.Entry) (tea.Model, tea.Cmd) {
+	var context *config.Context
+	for i := range m.config.Contexts {
+		if m.config.Contexts[i].Name == entry.Context {
+			context = &m.config.Contexts[i]
+			break
+		}
+	}
+	if context == nil {
+		m.errorModal.Show(fmt.Sprintf("Context '%s' is no longer configured", entry.Context), "Re-run Action", nil)
+		return m, nil
+	}
+
+	// The action still in the config decides the wait-on-exit prompt
+	action := config.Action{Name: entry.Action, Destructive: entry.Destructive}
+	for _, configured := range config.MergeActions(m.config.Actions, context.Actions) {
+		if configured.Name == entry.Action {
+			action.WaitOnExit = configured.WaitOnExit
+			action.Destructive = action.Destructive || configured.Destructive
+			break
+		}
+	}
+
+	// Read-only contexts classify the command that ran
+	action.Command = entry.Command
+	if m.config.ReadOnlyBlocks(*context, action) {
+		m.errorModal.Show(fmt.Sprintf("Action '%s' is disabled: context %s is read-only", action.Name, context.Name), "Re-run Action", nil)
+		return m, nil
+	}
+
+	resource := k8s.Resource{Kind: k8s.ResourceKind(entry.Kind), Name: entry.Target}
+	cmd := m.executor.PrepareRendered(action, entry.Command, *context, entry.Namespace, resource, m.config.KubeconfigPath(context.Name))
+
+	record := audit.NewRecord(audit.SourceTUI)
+	record.Context = entry.Context
+	record.Namespace = entry.Namespace
+	record.Kind = entry.Kind
+	record.Target = entry.Target
+	record.Action = entry.Action
+	record.Command = entry.Command
+	record.Destructive = action.Destructive
+
+	slog.Info("re-running action from history", "action", entry.Action, "context", entry.Context, "namespace", entry.Namespace, "target", entry.Target)
+	run := func() tea.Cmd {
+		return m.execInTerminal(action.Name, cmd, record, action.CaptureVar)
+	}
+	if !action.Destructive {
+		return m, run()
+	}
+
+	m.confirm.Show(
+		"Destructive action: "+action.Name,
+		fmt.Sprintf("This runs in namespace %s of context %s.", entry.Namespace, entry.Context),
+		entry.Namespace,
+		run,
+	)
+	return m, nil
+}
+
+// historyLine renders one history entry: when it ran, its outcome, the action and its target
+func historyLine(entry history.Entry) string {
+	status := "ok"
+	if entry.Failed() {
+		status = fmt.Sprintf("exit %d", entry.ExitCode)
+	}
+	target := targetPath(entry.Context, entry.Namespace, entry.Target)
+	return fmt.Sprintf("%s  %-7s %-24s %s %s",
+		entry.Time.Local().Format("Jan 02 15:04"),
+		status,
+		entry.Action,
+		target,
+		styles.DimStyle.Render(fmt.Sprintf("%.1fs", float64(entry.DurationMS)/1000)),
+	)
+}
+
+// renderHistory renders the history dialog with the command of the highlighted entry
+func (m AppModel) renderHistory() string {
+	var content string
+	content += styles.TitleStyle.Render("Action History") + "\n"
+	content += "\n" + styles.SearchLabelStyle.Render("> ") + m.historyQuery + "_\n\n"
+
+	matches := m.historyMatches()
+	if len(m.historyEntries) == 0 {
+		content += styles.PlaceholderStyle.Render("No actions run yet") + "\n"
+	} else if len(matches) == 0 {
+		content += styles.PlaceholderStyle.Render("No matches") + "\n"
+	}
+
+	// Keep the highlighted entry inside the visible window
+	start := 0
+	if m.historyIndex >= paletteMaxRows {
+		start = m.historyIndex - paletteMaxRows + 1
+	}
+	end := min(start+paletteMaxRows, len(matches))
+
+	for i := start; i < end; i++ {
+		entry := m.historyEntries[matches[i]]
+		line := historyLine(entry)
+		switch {
+		case i == m.historyIndex:
+			content += m.selectionStyle(styles.SelectedStyle).Render(m.cursorMarker(true)+line) + "\n"
+		case entry.Failed():
+			content += styles.ErrorStyle.Render(m.cursorMarker(false)+line) + "\n"
+		default:
+			content += styles.NormalStyle.Render(m.cursorMarker(false)+line) + "\n"
+		}
+	}
+	if remaining := len(matches) - end; remaining > 0 {
+		content += styles.HelpTextStyle.Render(fmt.Sprintf("↓ %d more", remaining)) + "\n"
+	}
+
+	if m.historyIndex < len(matches) {
+		command := m.historyEntries[matches[m.historyIndex]].Command
+		if maxWidth := m.termWidth - 12; maxWidth > 0 && lipgloss.Width(command) > maxWidth {
+			command = string([]rune(command)[:max(maxWidth-1, 0)]) + "…"
+		}
+		content += "\n" + styles.DimStyle.Render("$ "+command) + "\n"
+	}
+
+	content += "\n" + styles.DimStyle.Render("Type to search | ↑/↓: Navigate | Enter: Re-run | ESC: Close")
+
+	dialogStyle := lipgloss.NewStyle().
+		Border(lipgloss.RoundedBorder()).
+		BorderForeground(lipgloss.Color("39")). // Bright cyan
+		Padding(1, 2)
+
+	return lipgloss.Place(
+		m.termWidth,
+		m.termHeight,
+		lipgloss.Center,
+		lipgloss.Center,
+		dialogStyle.Render(content),
+	)
+}
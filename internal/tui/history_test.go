@@ -0,0 +1,113 @@
+package tui
+
+import (
+	"path/filepath"
+	"testing"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/maratkarimov/kubertino/internal/audit"
+	"github.com/maratkarimov/kubertino/internal/history"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// newHistoryTestModel returns the applies_to test model with a history store holding entries
+func newHistoryTestModel(t *testing.T, entries ...history.Entry) AppModel {
+	t.Helper()
+	store, err := history.Open(filepath.Join(t.TempDir(), "history.jsonl"))
+	require.NoError(t, err)
+	for _, entry := range entries {
+		require.NoError(t, store.Append(entry))
+	}
+
+	model := newAppliesToTestModel()
+	model.SetHistory(store)
+	return model
+}
+
+func TestHistory_RecordsFinishedActions(t *testing.T) {
+	model := newHistoryTestModel(t)
+
+	record := audit.Record{Time: time.Now(), Context: "dev", Namespace: "app", Kind: "pod", Target: "web-1", Action: "Logs", Command: "kubectl logs web-1"}
+	updated, _ := model.Update(execFinishedMsg{record: record})
+	model = updated.(AppModel)
+
+	entries := model.history.Entries()
+	require.Len(t, entries, 1)
+	assert.Equal(t, "Logs", entries[0].Action)
+	assert.Equal(t, "kubectl logs web-1", entries[0].Command)
+	assert.Equal(t, 0, entries[0].ExitCode)
+}
+
+func TestHistory_OpenSearchAndClose(t *testing.T) {
+	model := newHistoryTestModel(t,
+		history.Entry{Time: time.Now(), Context: "dev", Namespace: "app", Target: "web-1", Action: "Logs", Command: "kubectl logs web-1"},
+		history.Entry{Time: time.Now(), Context: "dev", Namespace: "app", Target: "worker-1", Action: "Rails Console", Command: "kubectl exec -it worker-1 -- rails c", ExitCode: 1},
+	)
+
+	model = sendKey(model, runeKey('h'))
+	require.Equal(t, viewModeHistory, model.viewMode)
+	view := model.View()
+	assert.Contains(t, view, "Action History")
+	assert.Contains(t, view, "dev/app/worker-1")
+	assert.Contains(t, view, "exit 1")
+	assert.Contains(t, view, "$ kubectl exec -it worker-1 -- rails c", "newest entry is highlighted")
+
+	model = typePalette(model, "logs")
+	matches := model.historyMatches()
+	require.Len(t, matches, 1)
+	assert.Equal(t, "Logs", model.historyEntries[matches[0]].Action)
+
+	model = sendKey(model, tea.KeyMsg{Type: tea.KeyEsc})
+	assert.Equal(t, viewModeNamespaceView, model.viewMode)
+	assert.Empty(t, model.historyQuery)
+}
+
+func TestHistory_EmptyHistory(t *testing.T) {
+	model := sendKey(newHistoryTestModel(t), runeKey('h'))
+	assert.Contains(t, model.View(), "No actions run yet")
+
+	updated, cmd := model.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	assert.Nil(t, cmd)
+	assert.Equal(t, viewModeHistory, updated.(AppModel).viewMode)
+}
+
+func TestHistory_EnterRerunsRecordedCommand(t *testing.T) {
+	model := newHistoryTestModel(t,
+		history.Entry{Time: time.Now(), Context: "dev", Namespace: "other", Kind: "pod", Target: "api-1", Action: "Logs", Command: "kubectl logs api-1 -n other"},
+	)
+	model = sendKey(model, runeKey('h'))
+
+	updated, cmd := model.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	model = updated.(AppModel)
+	assert.Equal(t, viewModeNamespaceView, model.viewMode)
+	assert.NotNil(t, cmd, "the recorded command is run")
+	assert.False(t, model.errorModal.IsVisible)
+	require.NotNil(t, model.running.cmd)
+	assert.Contains(t, model.running.cmd.Args[len(model.running.cmd.Args)-1], "kubectl logs api-1 -n other")
+}
+
+func TestHistory_RerunDestructiveAsksAgain(t *testing.T) {
+	model := newHistoryTestModel(t,
+		history.Entry{Time: time.Now(), Context: "dev", Namespace: "app", Kind: "pod", Target: "web-1", Action: "Drop DB", Command: "rake db:drop", Destructive: true},
+	)
+	model = sendKey(model, runeKey('h'))
+
+	updated, cmd := model.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	model = updated.(AppModel)
+	assert.Nil(t, cmd)
+	assert.True(t, model.confirm.IsVisible)
+}
+
+func TestHistory_RerunUnknownContext(t *testing.T) {
+	model := newHistoryTestModel(t,
+		history.Entry{Time: time.Now(), Context: "gone", Namespace: "app", Kind: "pod", Target: "web-1", Action: "Logs", Command: "kubectl logs web-1"},
+	)
+	model = sendKey(model, runeKey('h'))
+
+	updated, _ := model.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	model = updated.(AppModel)
+	require.True(t, model.errorModal.IsVisible)
+	assert.Contains(t, model.errorModal.View(), "Context 'gone' is no longer configured")
+}
@@ -0,0 +1,101 @@
+package tui
+
+import (
+	"fmt"
+	"log/slog"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/maratkarimov/kubertino/internal/config"
+	"github.com/maratkarimov/kubertino/internal/tui/styles"
+)
+
+// Context hook events
+const (
+	hookOnEnter = "on_enter"
+	hookOnExit  = "on_exit"
+)
+
+// hookFinishedMsg is sent when a context hook has run
+type hookFinishedMsg struct {
+	context string
+	event   string
+	err     error
+}
+
+// runHookCmd returns a command running the hook of ctx for event in the background.
+// Returns nil when the context has no such hook.
+func (m AppModel) runHookCmd(ctx config.Context, event string) tea.Cmd {
+	hook := ctx.OnEnter
+	if event == hookOnExit {
+		hook = ctx.OnExit
+	}
+	if hook == "" {
+		return nil
+	}
+
+	kubeconfig := m.config.KubeconfigPath(ctx.Name)
+	return func() tea.Msg {
+		slog.Info("running context hook", "context", ctx.Name, "event", event)
+		return hookFinishedMsg{context: ctx.Name, event: event, err: m.executor.RunHook(hook, ctx, kubeconfig)}
+	}
+}
+
+// contextHooksCmd runs the on_exit hook of the context being left, then the on_enter hook of
+// the context being entered. Re-selecting the current context runs neither.
+func (m AppModel) contextHooksCmd(previous *config.Context, next config.Context) tea.Cmd {
+	if previous != nil && previous.Name == next.Name {
+		return nil
+	}
+
+	var cmds []tea.Cmd
+	if previous != nil {
+		if cmd := m.runHookCmd(*previous, hookOnExit); cmd != nil {
+			cmds = append(cmds, cmd)
+		}
+	}
+	if cmd := m.runHookCmd(next, hookOnEnter); cmd != nil {
+		cmds = append(cmds, cmd)
+	}
+	if len(cmds) == 0 {
+		return nil
+	}
+	return tea.Sequence(cmds...)
+}
+
+// handleHookFinished shows a warning for a failed hook; navigation is never blocked
+func (m AppModel) handleHookFinished(msg hookFinishedMsg) (tea.Model, tea.Cmd) {
+	if msg.err == nil {
+		slog.Info("context hook succeeded", "context", msg.context, "event", msg.event)
+		return m, nil
+	}
+
+	slog.Warn("context hook failed", "context", msg.context, "event", msg.event, "error", msg.err)
+	m.hookWarning = fmt.Sprintf("⚠ %s hook of %s failed: %v", msg.event, msg.context, msg.err)
+	return m, nil
+}
+
+// runExitHook runs the on_exit hook of the current context and waits for it, for when
+// kubertino exits. Failures can only be logged.
+func (m AppModel) runExitHook() {
+	if m.currentContext == nil || m.currentContext.OnExit == "" {
+		return
+	}
+
+	slog.Info("running context hook", "context", m.currentContext.Name, "event", hookOnExit)
+	if err := m.executor.RunHook(m.currentContext.OnExit, *m.currentContext, m.config.KubeconfigPath(m.currentContext.Name)); err != nil {
+		slog.Warn("context hook failed", "context", m.currentContext.Name, "event", hookOnExit, "error", err)
+	}
+}
+
+// hookWarningLine renders the last hook failure cut to width cells, or "" when there is none.
+// A width of 0 does not cut it.
+func (m AppModel) hookWarningLine(width int) string {
+	if m.hookWarning == "" {
+		return ""
+	}
+	warning := m.hookWarning
+	if width > 0 {
+		warning = truncateName(warning, width)
+	}
+	return styles.WarningStyle.Render(warning)
+}
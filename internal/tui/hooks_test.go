@@ -0,0 +1,58 @@
+package tui
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/maratkarimov/kubertino/internal/config"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newHookTestModel(contexts ...config.Context) AppModel {
+	return newTestModel(newMockAdapter(), withContexts(contexts...))
+}
+
+func TestContextHooksCmd(t *testing.T) {
+	dev := config.Context{Name: "dev", OnEnter: "echo enter dev", OnExit: "echo exit dev"}
+	prod := config.Context{Name: "prod"}
+	model := newHookTestModel(dev, prod)
+
+	assert.NotNil(t, model.contextHooksCmd(nil, dev), "first selection runs on_enter")
+	assert.Nil(t, model.contextHooksCmd(&dev, dev), "re-selecting the current context runs no hooks")
+	assert.NotNil(t, model.contextHooksCmd(&dev, prod), "leaving dev runs its on_exit")
+	assert.Nil(t, model.contextHooksCmd(&prod, prod))
+	assert.Nil(t, model.contextHooksCmd(nil, prod), "contexts without hooks run nothing")
+}
+
+func TestHookFailure_ShowsWarningWithoutBlocking(t *testing.T) {
+	prod := config.Context{Name: "prod", OnEnter: "echo 'VPN is down' >&2; exit 1"}
+	model := newHookTestModel(config.Context{Name: "dev"}, prod)
+
+	updated, cmd := model.selectContext(1)
+	model = updated.(AppModel)
+	require.NotNil(t, cmd)
+	assert.Equal(t, viewModeNamespaceView, model.viewMode, "navigation does not wait for the hook")
+
+	msg := model.runHookCmd(prod, hookOnEnter)()
+	updated, _ = model.Update(msg)
+	model = updated.(AppModel)
+	assert.False(t, model.errorModal.IsVisible)
+	assert.Contains(t, model.View(), "on_enter hook of prod failed")
+
+	// The warning goes away with the context
+	updated, _ = model.selectContext(0)
+	assert.Empty(t, updated.(AppModel).hookWarning)
+}
+
+func TestClose_RunsExitHook(t *testing.T) {
+	marker := filepath.Join(t.TempDir(), "exited")
+	model := newHookTestModel(config.Context{Name: "dev", OnExit: "touch " + marker})
+	require.NotNil(t, model.currentContext)
+
+	model.Close()
+
+	_, err := os.Stat(marker)
+	assert.NoError(t, err, "on_exit runs when kubertino exits")
+}
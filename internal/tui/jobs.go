@@ -0,0 +1,287 @@
+package tui
+
+import (
+	"errors"
+	"fmt"
+	"log/slog"
+	"os/exec"
+	"strings"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/maratkarimov/kubertino/internal/audit"
+	"github.com/maratkarimov/kubertino/internal/config"
+	"github.com/maratkarimov/kubertino/internal/executor"
+	"github.com/maratkarimov/kubertino/internal/k8s"
+	"github.com/maratkarimov/kubertino/internal/tui/components"
+	"github.com/maratkarimov/kubertino/internal/tui/styles"
+)
+
+// Jobs view refresh interval and output preview size
+const (
+	jobsInterval    = time.Second
+	jobPreviewLines = 8
+)
+
+// jobStartedMsg is sent once a background action has been started (or failed to start)
+type jobStartedMsg struct {
+	job    *executor.Job
+	record audit.Record
+	err    error
+}
+
+// jobFinishedMsg is sent when the process of a background job exits
+type jobFinishedMsg struct {
+	job    *executor.Job
+	record audit.Record
+}
+
+// jobsTickMsg refreshes the status and output preview of the jobs view
+type jobsTickMsg time.Time
+
+// jobsTickCmd schedules the next refresh of the jobs view
+func jobsTickCmd() tea.Cmd {
+	return tea.Tick(jobsInterval, func(t time.Time) tea.Msg {
+		return jobsTickMsg(t)
+	})
+}
+
+// jobList returns the tracked jobs (empty when no manager is configured)
+func (m AppModel) jobList() []*executor.Job {
+	if m.jobs == nil {
+		return nil
+	}
+	return m.jobs.List()
+}
+
+// startJobCmd returns a command starting the rendered command of record detached from the
+// terminal as a background job
+func (m AppModel) startJobCmd(action config.Action, resource k8s.Resource, record audit.Record) tea.Cmd {
+	jobs, kubeconfig := m.jobs, m.config.KubeconfigPath(record.Context)
+	job := executor.Job{
+		Action:    action.Name,
+		Context:   record.Context,
+		Namespace: record.Namespace,
+		Target:    resource.Name,
+		Command:   record.Command,
+	}
+	return func() tea.Msg {
+		started, err := jobs.Start(job, kubeconfig)
+		return jobStartedMsg{job: started, record: record, err: err}
+	}
+}
+
+// waitJobCmd waits for the process of job to exit
+func waitJobCmd(job *executor.Job, record audit.Record) tea.Cmd {
+	return func() tea.Msg {
+		<-job.Done()
+		return jobFinishedMsg{job: job, record: record}
+	}
+}
+
+// handleJobStarted announces a started job and waits for it to exit
+func (m AppModel) handleJobStarted(msg jobStartedMsg) (tea.Model, tea.Cmd) {
+	m.lastCommand = msg.record.Command
+	if msg.err != nil {
+		m.recordAction(msg.record.Finish(msg.err))
+		m.errorModal.Show(fmt.Sprintf("Action failed: %s", msg.err.Error()), "Execute Action", nil)
+		return m, nil
+	}
+
+	notice := m.notify(fmt.Sprintf("Started job #%d: %s (%s: jobs)", msg.job.ID, msg.job.Action, firstKey(m.keys.Jobs)), components.ToastInfo)
+	return m, tea.Batch(waitJobCmd(msg.job, msg.record), notice)
+}
+
+// handleJobFinished audits the finished job and reports its outcome without interrupting
+func (m AppModel) handleJobFinished(msg jobFinishedMsg) (tea.Model, tea.Cmd) {
+	err := msg.job.Err()
+	record := msg.record.Finish(err)
+	m.recordAction(record)
+
+	text := fmt.Sprintf("Job #%d %s: %s", msg.job.ID, msg.job.Action, captureStatus(record))
+	if err != nil {
+		return m, m.notify(text, components.ToastWarning)
+	}
+	return m, m.notify(text, components.ToastSuccess)
+}
+
+// openJobs shows the background jobs over the namespace view
+func (m AppModel) openJobs() (tea.Model, tea.Cmd) {
+	slog.Debug("jobs view opened")
+	m.viewMode = viewModeJobs
+	m.clampJobSelection()
+	return m, jobsTickCmd()
+}
+
+// handleJobsTick keeps refreshing the jobs view while it is open
+func (m AppModel) handleJobsTick() (tea.Model, tea.Cmd) {
+	if m.viewMode != viewModeJobs {
+		return m, nil
+	}
+	return m, jobsTickCmd()
+}
+
+// clampJobSelection keeps the jobs cursor on a listed job
+func (m *AppModel) clampJobSelection() {
+	m.jobIndex = min(max(m.jobIndex, 0), max(len(m.jobList())-1, 0))
+}
+
+// handleJobsKey handles key presses while the jobs view is open
+func (m AppModel) handleJobsKey(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	jobs := m.jobList()
+
+	switch {
+	case msg.Type == tea.KeyCtrlC:
+		return m.quit()
+	case msg.Type == tea.KeyEsc || KeyMatches(msg, m.keys.Jobs):
+		m.viewMode = viewModeNamespaceView
+	case KeyMatches(msg, m.keys.Up):
+		if m.jobIndex > 0 {
+			m.jobIndex--
+		}
+	case KeyMatches(msg, m.keys.Down):
+		if m.jobIndex < len(jobs)-1 {
+			m.jobIndex++
+		}
+	case msg.String() == "x" && m.jobIndex < len(jobs):
+		job := jobs[m.jobIndex]
+		if !job.Running() {
+			return m, nil
+		}
+		if err := m.jobs.Kill(job.ID); err != nil {
+			m.errorModal.Show(err.Error(), "Kill Job", nil)
+		}
+	case msg.String() == "d" && m.jobIndex < len(jobs):
+		job := jobs[m.jobIndex]
+		if job.Running() {
+			return m, m.notify(fmt.Sprintf("Job #%d is still running; x kills it", job.ID), components.ToastWarning)
+		}
+		if err := m.jobs.Remove(job.ID); err != nil {
+			m.errorModal.Show(err.Error(), "Remove Job", nil)
+		}
+		m.clampJobSelection()
+	}
+	return m, nil
+}
+
+// jobStatus describes the state of job: "running", "exit 0", "exit 3" or "killed"
+func jobStatus(job *executor.Job) string {
+	if job.Running() {
+		return "running"
+	}
+	err := job.Err()
+	if err == nil {
+		return "exit 0"
+	}
+	var exitErr *exec.ExitError
+	if errors.As(err, &exitErr) {
+		if exitErr.ExitCode() < 0 {
+			return "killed"
+		}
+		return fmt.Sprintf("exit %d", exitErr.ExitCode())
+	}
+	return "failed"
+}
+
+// jobLine renders one job: its number, state, run time, action and target
+func jobLine(job *executor.Job, now time.Time) string {
+	target := targetPath(job.Context, job.Namespace, job.Target)
+	return fmt.Sprintf("#%-3d %-8s %6s  %-24s %s",
+		job.ID,
+		jobStatus(job),
+		job.Duration(now).Round(time.Second),
+		job.Action,
+		target,
+	)
+}
+
+// renderJobs renders the jobs dialog with the last output lines of the highlighted job
+func (m AppModel) renderJobs() string {
+	var content string
+	content += styles.TitleStyle.Render("Background Jobs") + "\n\n"
+
+	jobs := m.jobList()
+	if len(jobs) == 0 {
+		content += styles.PlaceholderStyle.Render("No background jobs. Actions with background: true run here.") + "\n"
+	}
+
+	// Keep the highlighted job inside the visible window
+	start := 0
+	if m.jobIndex >= paletteMaxRows {
+		start = m.jobIndex - paletteMaxRows + 1
+	}
+	end := min(start+paletteMaxRows, len(jobs))
+
+	now := time.Now()
+	for i := start; i < end; i++ {
+		job := jobs[i]
+		line := jobLine(job, now)
+		switch {
+		case i == m.jobIndex:
+			content += m.selectionStyle(styles.SelectedStyle).Render(m.cursorMarker(true)+line) + "\n"
+		case job.Running():
+			content += styles.RunningStyle.Render(m.cursorMarker(false)+line) + "\n"
+		case job.Err() != nil:
+			content += styles.FailedStyle.Render(m.cursorMarker(false)+line) + "\n"
+		default:
+			content += styles.NormalStyle.Render(m.cursorMarker(false)+line) + "\n"
+		}
+	}
+	if remaining := len(jobs) - end; remaining > 0 {
+		content += styles.HelpTextStyle.Render(fmt.Sprintf("↓ %d more", remaining)) + "\n"
+	}
+
+	if m.jobIndex < len(jobs) {
+		content += "\n" + m.renderJobPreview(jobs[m.jobIndex]) + "\n"
+	}
+
+	content += "\n" + styles.DimStyle.Render("↑/↓: Navigate | x: Kill | d: Remove finished | ESC: Close")
+
+	dialogStyle := lipgloss.NewStyle().
+		Border(lipgloss.RoundedBorder()).
+		BorderForeground(lipgloss.Color("39")). // Bright cyan
+		Padding(1, 2)
+
+	return lipgloss.Place(
+		m.termWidth,
+		m.termHeight,
+		lipgloss.Center,
+		lipgloss.Center,
+		dialogStyle.Render(content),
+	)
+}
+
+// renderJobPreview renders the command and the last output lines of job
+func (m AppModel) renderJobPreview(job *executor.Job) string {
+	maxWidth := max(m.termWidth-12, 20)
+	lines := []string{"$ " + job.Command}
+
+	tail, err := job.Tail(jobPreviewLines)
+	switch {
+	case err != nil:
+		lines = append(lines, err.Error())
+	case len(tail) == 0:
+		lines = append(lines, "(no output yet)")
+	default:
+		lines = append(lines, tail...)
+	}
+
+	for i, line := range lines {
+		if runes := []rune(line); len(runes) > maxWidth {
+			lines[i] = string(runes[:maxWidth-1]) + "…"
+		}
+	}
+	return styles.DimStyle.Render(strings.Join(lines, "\n"))
+}
+
+// runningJobsBadge counts the running background jobs for the actions panel title
+func (m AppModel) runningJobsBadge() string {
+	if m.jobs == nil {
+		return ""
+	}
+	if running := m.jobs.Running(); running > 0 {
+		return styles.DimStyle.Render(fmt.Sprintf(" jobs: %d running", running))
+	}
+	return ""
+}
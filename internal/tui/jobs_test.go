@@ -0,0 +1,94 @@
+package tui
+
+import (
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/maratkarimov/kubertino/internal/config"
+	"github.com/maratkarimov/kubertino/internal/k8s"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// startJob runs command as a background action against web-1 and returns the model with the
+// job started
+func startJob(t *testing.T, command string) (AppModel, jobStartedMsg) {
+	t.Helper()
+	model := newTestModel(newMockAdapter(), withContexts(config.Context{Name: "dev"}),
+		withActions(config.Action{Name: "Seed", Shortcut: "b", Command: command, Background: true}))
+	model.currentNamespace = "app"
+	model.pods = []k8s.Pod{{Name: "web-1", Status: "Running"}}
+	model.selectedPodIndex = 0
+	model.focusedPanel = PanelPods
+	t.Cleanup(model.jobs.StopAll)
+
+	_, cmd := model.Update(runeKey('b'))
+	require.NotNil(t, cmd)
+	started, ok := cmd().(jobStartedMsg)
+	require.True(t, ok, "background actions do not take over the terminal")
+	require.NoError(t, started.err)
+
+	updated, _ := model.Update(started)
+	model = updated.(AppModel)
+	assert.Equal(t, viewModeNamespaceView, model.viewMode, "the TUI stays usable")
+	return model, started
+}
+
+func TestJobs_RunInBackground(t *testing.T) {
+	model, started := startJob(t, "echo seeding {{.pod}}; exit 2")
+	assert.Equal(t, "echo seeding web-1; exit 2", started.job.Command)
+	assert.Equal(t, "web-1", started.job.Target)
+	toast, ok := model.statusBar.Current()
+	require.True(t, ok)
+	assert.Contains(t, toast.Text, "Started job #1: Seed")
+
+	updated, _ := model.Update(waitJobCmd(started.job, started.record)())
+	model = updated.(AppModel)
+	assert.False(t, model.errorModal.IsVisible, "finished jobs do not interrupt")
+	assert.Equal(t, "exit 2", jobStatus(started.job))
+
+	model = sendKey(model, runeKey('J'))
+	require.Equal(t, viewModeJobs, model.viewMode)
+	view := model.View()
+	assert.Contains(t, view, "Background Jobs")
+	assert.Contains(t, view, "exit 2")
+	assert.Contains(t, view, "dev/app/web-1")
+	assert.Contains(t, view, "seeding web-1", "output tail preview")
+
+	// Finished jobs can be removed
+	model = sendKey(model, runeKey('d'))
+	assert.Empty(t, model.jobList())
+	assert.Contains(t, model.View(), "No background jobs")
+
+	model = sendKey(model, tea.KeyMsg{Type: tea.KeyEsc})
+	assert.Equal(t, viewModeNamespaceView, model.viewMode)
+}
+
+func TestJobs_KillRunningJob(t *testing.T) {
+	model, started := startJob(t, "sleep 30")
+	assert.Contains(t, model.renderActionsPanel(60, 10), "jobs: 1 running")
+	assert.Equal(t, "Job #1 (Seed)", model.busyAction(), "quitting asks first")
+
+	model = sendKey(model, runeKey('J'))
+	model = sendKey(model, runeKey('d'))
+	assert.Len(t, model.jobList(), 1, "running jobs are not removed")
+
+	model = sendKey(model, runeKey('x'))
+	assert.False(t, started.job.Running())
+	assert.Equal(t, "killed", jobStatus(started.job))
+	assert.Empty(t, model.busyAction())
+}
+
+func TestJobs_Tick(t *testing.T) {
+	model := NewAppModel(&config.Config{Contexts: []config.Context{{Name: "dev"}}}, newMockAdapter())
+	updated, cmd := model.openJobs()
+	model = updated.(AppModel)
+	assert.NotNil(t, cmd)
+
+	_, cmd = model.Update(jobsTickMsg{})
+	assert.NotNil(t, cmd, "keeps refreshing while open")
+
+	model.viewMode = viewModeNamespaceView
+	_, cmd = model.Update(jobsTickMsg{})
+	assert.Nil(t, cmd)
+}
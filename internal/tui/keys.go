@@ -1,6 +1,11 @@
 package tui
 
-import tea "github.com/charmbracelet/bubbletea"
+import (
+	"fmt"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/maratkarimov/kubertino/internal/config"
+)
 
 // KeyMap defines keyboard bindings for the TUI
 type KeyMap struct {
@@ -10,6 +15,49 @@ type KeyMap struct {
 	Enter    []string // Keys for selection (enter)
 	Tab      []string // Keys for switching focus forward (tab) - Story 3.3, 4.1: Namespaces → Pods → Actions
 	ShiftTab []string // Keys for switching focus backward (shift+tab) - Story 3.3, 4.1: Actions → Pods → Namespaces
+	Search   []string // Keys for activating search in the focused namespaces or pods panel (/)
+	Settings []string // Keys for opening the key binding settings screen (ctrl+s)
+	// Port-forward manager
+	PortForward []string // Keys for starting a port-forward to the selected pod (ctrl+f)
+	StopForward []string // Keys for stopping the selected port-forward (ctrl+x)
+	// Resource browser
+	ResourceType    []string // Keys for cycling the right panel through pods, deployments, statefulsets and jobs (r)
+	GitOps          []string // Keys for showing the GitOps source of the selected pod (g)
+	Network         []string // Keys for showing the IP, DNS names and ports of the selected pod (i)
+	Describe        []string // Keys for showing kubectl describe output of the selected pod (d)
+	YAML            []string // Keys for showing the manifest of the selected pod, which can be edited from there (v)
+	NodeShell       []string // Keys for opening a shell on the node of the selected pod with kubectl debug (S)
+	Shell           []string // Keys for suspending the TUI for a local shell with the selection exported (!)
+	Favorite        []string // Keys for toggling the highlighted namespace as a favorite (f)
+	ActionFilter    []string // Keys for cycling the actions panel through action tags (ctrl+a)
+	ActionPicker    []string // Keys for opening the fuzzy-searchable action picker (a)
+	PodSort         []string // Keys for cycling the pods panel through name, status, age and restarts order (o)
+	PinPod          []string // Keys for pinning the selected pod to the top of the pods panel of its namespace (m)
+	PodFilter       []string // Keys for cycling the pods panel through all, not running and failed pods (F)
+	CreateNamespace []string // Keys for creating a namespace, with allow_namespace_mutations (N)
+	DeleteNamespace []string // Keys for deleting the highlighted namespace, with allow_namespace_mutations (D)
+	LabelSelector   []string // Keys for entering a label selector the pods are listed with (L)
+	ClearSelector   []string // Keys for clearing the label selector of the pods panel (ctrl+k)
+	Palette         []string // Keys for opening the command palette (ctrl+p)
+	Help            []string // Keys for opening the overview of all keys and actions (?)
+	SwitchContext   []string // Keys for returning from the namespace panel to the context list (backspace)
+	Refresh         []string // Keys for refetching the focused namespaces or pods panel, bypassing the cache (R)
+	CopyName        []string // Keys for copying the highlighted namespace, pod or resource name (y)
+	CopyCommand     []string // Keys for copying the rendered command of the last executed action (Y)
+	History         []string // Keys for opening the history of executed actions (h)
+	LastStderr      []string // Keys for viewing the stderr of the last failed action (ctrl+e)
+	Jobs            []string // Keys for opening the list of background jobs (J)
+	Reload          []string // Keys for re-reading the configuration without a restart (ctrl+r)
+	// Workspace tabs
+	NewTab    []string // Keys for opening a tab with its own context, namespace and pods (ctrl+t)
+	CloseTab  []string // Keys for closing the active tab (ctrl+w)
+	SwitchTab []string // Keys switching to the tab of their position: the first key to tab 1 (alt+1 to alt+9)
+	// Panel layout
+	LayoutPreset []string // Keys for cycling the layout presets (ctrl+l)
+	ResizeLeft   []string // Keys for narrowing the namespace panel (ctrl+left)
+	ResizeRight  []string // Keys for widening the namespace panel (ctrl+right)
+	ResizeUp     []string // Keys for shrinking the pod panel in favor of the actions panel (ctrl+up)
+	ResizeDown   []string // Keys for growing the pod panel (ctrl+down)
 }
 
 // DefaultKeyMap returns the default keyboard bindings
@@ -21,6 +69,274 @@ func DefaultKeyMap() KeyMap {
 		Enter:    []string{"enter"},
 		Tab:      []string{"tab"},       // Story 3.3, 4.1: Three-panel focus switching
 		ShiftTab: []string{"shift+tab"}, // Story 3.3, 4.1: Three-panel backward focus switching
+		Search:   []string{"/"},
+		Settings: []string{"ctrl+s"},
+		// Port-forward manager
+		PortForward: []string{"ctrl+f"},
+		StopForward: []string{"ctrl+x"},
+		// Resource browser
+		ResourceType:    []string{"r"},
+		GitOps:          []string{"g"},
+		Network:         []string{"i"},
+		Describe:        []string{"d"},
+		YAML:            []string{"v"},
+		NodeShell:       []string{"S"},
+		Shell:           []string{"!"},
+		Favorite:        []string{"f"},
+		ActionFilter:    []string{"ctrl+a"},
+		ActionPicker:    []string{"a"},
+		PodSort:         []string{"o"},
+		PinPod:          []string{"m"},
+		PodFilter:       []string{"F"},
+		CreateNamespace: []string{"N"},
+		DeleteNamespace: []string{"D"},
+		LabelSelector:   []string{"L"},
+		ClearSelector:   []string{"ctrl+k"},
+		Palette:         []string{"ctrl+p"},
+		Help:            []string{"?"},
+		SwitchContext:   []string{"backspace"},
+		Refresh:         []string{"R"},
+		CopyName:        []string{"y"},
+		CopyCommand:     []string{"Y"},
+		History:         []string{"h"},
+		LastStderr:      []string{"ctrl+e"},
+		Jobs:            []string{"J"},
+		Reload:          []string{"ctrl+r"},
+		// Workspace tabs
+		NewTab:    []string{"ctrl+t"},
+		CloseTab:  []string{"ctrl+w"},
+		SwitchTab: []string{"alt+1", "alt+2", "alt+3", "alt+4", "alt+5", "alt+6", "alt+7", "alt+8", "alt+9"},
+		// Panel layout
+		LayoutPreset: []string{"ctrl+l"},
+		ResizeLeft:   []string{"ctrl+left"},
+		ResizeRight:  []string{"ctrl+right"},
+		ResizeUp:     []string{"ctrl+up"},
+		ResizeDown:   []string{"ctrl+down"},
+	}
+}
+
+// KeyMapFromConfig returns the default bindings with any overrides from the config keymap applied
+func KeyMapFromConfig(km *config.Keymap) KeyMap {
+	keys := DefaultKeyMap()
+	if km == nil {
+		return keys
+	}
+
+	for _, b := range keys.bindings() {
+		if override := b.configKeys(km); len(*override) > 0 {
+			*b.keys = append([]string(nil), *override...)
+		}
+	}
+	return keys
+}
+
+// ConfigKeymap returns the bindings as a config keymap with every binding filled in
+func (k KeyMap) ConfigKeymap() *config.Keymap {
+	km := &config.Keymap{}
+	for _, b := range k.bindings() {
+		*b.configKeys(km) = append([]string(nil), *b.keys...)
+	}
+	return km
+}
+
+// Keys returns every key assigned to a navigation binding
+func (k KeyMap) Keys() []string {
+	var keys []string
+	for _, b := range k.bindings() {
+		keys = append(keys, *b.keys...)
+	}
+	return keys
+}
+
+// ShadowedKeys describes every action and action group of cfg whose shortcut is also a key
+// binding. Action shortcuts are handled before built-in keys, so such a binding never fires.
+func ShadowedKeys(cfg *config.Config) []string {
+	keys := KeyMapFromConfig(cfg.Keymap)
+	owners := make(map[string]string)
+	for _, b := range keys.bindings() {
+		for _, key := range *b.keys {
+			if _, ok := owners[key]; !ok {
+				owners[key] = b.name
+			}
+		}
+	}
+
+	var shadowed []string
+	check := func(kind, name, where, shortcut string) {
+		if binding, ok := owners[shortcut]; ok {
+			shadowed = append(shadowed, fmt.Sprintf("%s %q%s on %s hides the %s key", kind, name, where, shortcut, binding))
+		}
+	}
+	for _, group := range cfg.ActionGroups {
+		check("action group", group.Name, "", group.Shortcut)
+	}
+	// Grouped actions are run with the group's shortcut first, so they cannot clash
+	for _, action := range cfg.Actions {
+		if action.Group == "" {
+			check("action", action.Name, "", action.Shortcut)
+		}
+	}
+	for _, ctx := range cfg.Contexts {
+		for _, action := range ctx.Actions {
+			if action.Group == "" {
+				check("action", action.Name, " of context "+ctx.Name, action.Shortcut)
+			}
+		}
+	}
+	return shadowed
+}
+
+// keyBinding pairs a named navigation binding with the keys assigned to it
+type keyBinding struct {
+	name string
+	keys *[]string
+	help string // What the keys do, shown in the help overlay
+}
+
+// configKeys returns the config keymap field backing this binding
+func (b keyBinding) configKeys(km *config.Keymap) *[]string {
+	switch b.name {
+	case "Quit":
+		return &km.Quit
+	case "Up":
+		return &km.Up
+	case "Down":
+		return &km.Down
+	case "Enter":
+		return &km.Enter
+	case "Tab":
+		return &km.Tab
+	case "Shift+Tab":
+		return &km.ShiftTab
+	case "Search":
+		return &km.Search
+	case "Settings":
+		return &km.Settings
+	case "Port Forward":
+		return &km.PortForward
+	case "Stop Forward":
+		return &km.StopForward
+	case "Resource Type":
+		return &km.ResourceType
+	case "GitOps":
+		return &km.GitOps
+	case "Network":
+		return &km.Network
+	case "Describe":
+		return &km.Describe
+	case "YAML":
+		return &km.YAML
+	case "Node Shell":
+		return &km.NodeShell
+	case "Shell":
+		return &km.Shell
+	case "Favorite":
+		return &km.Favorite
+	case "Action Filter":
+		return &km.ActionFilter
+	case "Action Picker":
+		return &km.ActionPicker
+	case "Pod Sort":
+		return &km.PodSort
+	case "Pin Pod":
+		return &km.PinPod
+	case "Pod Filter":
+		return &km.PodFilter
+	case "Create Namespace":
+		return &km.CreateNamespace
+	case "Delete Namespace":
+		return &km.DeleteNamespace
+	case "Label Selector":
+		return &km.LabelSelector
+	case "Clear Selector":
+		return &km.ClearSelector
+	case "Command Palette":
+		return &km.Palette
+	case "Help":
+		return &km.Help
+	case "Switch Context":
+		return &km.SwitchContext
+	case "Copy Name":
+		return &km.CopyName
+	case "Copy Command":
+		return &km.CopyCommand
+	case "History":
+		return &km.History
+	case "Last Stderr":
+		return &km.LastStderr
+	case "Jobs":
+		return &km.Jobs
+	case "Reload Config":
+		return &km.Reload
+	case "New Tab":
+		return &km.NewTab
+	case "Close Tab":
+		return &km.CloseTab
+	case "Switch Tab":
+		return &km.SwitchTab
+	case "Layout Preset":
+		return &km.LayoutPreset
+	case "Resize Left":
+		return &km.ResizeLeft
+	case "Resize Right":
+		return &km.ResizeRight
+	case "Resize Up":
+		return &km.ResizeUp
+	case "Resize Down":
+		return &km.ResizeDown
+	default:
+		return &km.Refresh
+	}
+}
+
+// bindings lists the rebindable navigation bindings in display order
+func (k *KeyMap) bindings() []keyBinding {
+	return []keyBinding{
+		{name: "Quit", keys: &k.Quit, help: "Quit (ESC also closes views and cancels searches)"},
+		{name: "Up", keys: &k.Up, help: "Move up"},
+		{name: "Down", keys: &k.Down, help: "Move down"},
+		{name: "Enter", keys: &k.Enter, help: "Select the highlighted context or namespace"},
+		{name: "Tab", keys: &k.Tab, help: "Focus the next panel"},
+		{name: "Shift+Tab", keys: &k.ShiftTab, help: "Focus the previous panel"},
+		{name: "Search", keys: &k.Search, help: "Search the focused namespaces or pods panel"},
+		{name: "Settings", keys: &k.Settings, help: "Edit key bindings and action shortcuts"},
+		{name: "Port Forward", keys: &k.PortForward, help: "Forward a local port to the selected pod"},
+		{name: "Stop Forward", keys: &k.StopForward, help: "Stop the selected port-forward"},
+		{name: "Resource Type", keys: &k.ResourceType, help: "Switch the right panel between pods, deployments, statefulsets, jobs, configmaps and secrets"},
+		{name: "GitOps", keys: &k.GitOps, help: "Show the GitOps source of the selected pod"},
+		{name: "Network", keys: &k.Network, help: "Show the IP, DNS names and ports of the selected pod"},
+		{name: "Describe", keys: &k.Describe, help: "Describe the selected pod, or view the keys of a configmap or secret"},
+		{name: "YAML", keys: &k.YAML, help: "View the YAML of the selected pod; e edits it in $EDITOR"},
+		{name: "Node Shell", keys: &k.NodeShell, help: "Open a shell on the node of the selected pod (kubectl debug node)"},
+		{name: "Shell", keys: &k.Shell, help: "Open a local shell with KUBECONFIG, KUBE_CONTEXT, KUBE_NAMESPACE and KUBE_POD exported"},
+		{name: "Favorite", keys: &k.Favorite, help: "Toggle the highlighted namespace as a favorite"},
+		{name: "Action Filter", keys: &k.ActionFilter, help: "Narrow the actions panel to the next tag"},
+		{name: "Action Picker", keys: &k.ActionPicker, help: "Pick an action by name"},
+		{name: "Pod Sort", keys: &k.PodSort, help: "Sort pods by name, status, age or restarts (o for order, as s is the Shell action)"},
+		{name: "Pin Pod", keys: &k.PinPod, help: "Pin or unpin the selected pod at the top of the pods panel (m for mark, as p is the Previous Logs action)"},
+		{name: "Pod Filter", keys: &k.PodFilter, help: "Show all pods, only those not running, or only failed ones"},
+		{name: "Create Namespace", keys: &k.CreateNamespace, help: "Create a namespace (with allow_namespace_mutations)"},
+		{name: "Delete Namespace", keys: &k.DeleteNamespace, help: "Delete the highlighted namespace (with allow_namespace_mutations)"},
+		{name: "Label Selector", keys: &k.LabelSelector, help: "List only the pods matching a label selector"},
+		{name: "Clear Selector", keys: &k.ClearSelector, help: "List all pods again"},
+		{name: "Command Palette", keys: &k.Palette, help: "Jump to any context, namespace, pod or action"},
+		{name: "Help", keys: &k.Help, help: "Show this help"},
+		{name: "Switch Context", keys: &k.SwitchContext, help: "Return from the namespaces panel to the context list"},
+		{name: "Refresh", keys: &k.Refresh, help: "Refetch the focused panel, bypassing the cache, or probe the contexts again in the context list"},
+		{name: "Copy Name", keys: &k.CopyName, help: "Copy the highlighted namespace, pod or resource name"},
+		{name: "Copy Command", keys: &k.CopyCommand, help: "Copy the command of the last executed action"},
+		{name: "History", keys: &k.History, help: "Browse and re-run executed actions"},
+		{name: "Last Stderr", keys: &k.LastStderr, help: "View the stderr of the last failed action"},
+		{name: "Jobs", keys: &k.Jobs, help: "Show running and finished background jobs"},
+		{name: "Reload Config", keys: &k.Reload, help: "Re-read the configuration"},
+		{name: "New Tab", keys: &k.NewTab, help: "Open a tab with its own context, namespace and pods"},
+		{name: "Close Tab", keys: &k.CloseTab, help: "Close the active tab"},
+		{name: "Switch Tab", keys: &k.SwitchTab, help: "Switch to tab 1 to 9"},
+		{name: "Layout Preset", keys: &k.LayoutPreset, help: "Cycle the panel layout presets"},
+		{name: "Resize Left", keys: &k.ResizeLeft, help: "Narrow the namespaces panel"},
+		{name: "Resize Right", keys: &k.ResizeRight, help: "Widen the namespaces panel"},
+		{name: "Resize Up", keys: &k.ResizeUp, help: "Shrink the pods panel"},
+		{name: "Resize Down", keys: &k.ResizeDown, help: "Grow the pods panel"},
 	}
 }
 
@@ -0,0 +1,96 @@
+package tui
+
+import (
+	"log/slog"
+	"runtime"
+	"strings"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/maratkarimov/kubertino/internal/tui/components"
+	"github.com/maratkarimov/kubertino/internal/tui/styles"
+)
+
+// kubectlInstallSteps returns how to install kubectl on goos
+func kubectlInstallSteps(goos string) []string {
+	switch goos {
+	case "darwin":
+		return []string{"brew install kubectl"}
+	case "windows":
+		return []string{"winget install -e --id Kubernetes.kubectl"}
+	}
+	return []string{
+		"sudo snap install kubectl --classic, or your distribution's kubectl package",
+		"https://kubernetes.io/docs/tasks/tools/install-kubectl-linux/",
+	}
+}
+
+// handleKubectlSetupKey handles keys while the kubectl setup screen is shown: r checks again
+// for kubectl, quit leaves
+func (m AppModel) handleKubectlSetupKey(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	switch {
+	case msg.Type == tea.KeyCtrlC || KeyMatches(msg, m.keys.Quit):
+		return m, tea.Quit
+	case msg.String() == "r" && !m.startupChecking:
+		slog.Info("checking for kubectl again")
+		m.startupChecking = true
+		return m, m.startupCheckCmd()
+	}
+	return m, nil
+}
+
+// kubectlFound leaves the setup screen once a retry found kubectl, and loads what failed
+// while it was missing
+func (m AppModel) kubectlFound() (tea.Model, tea.Cmd) {
+	m.kubectlMissing = nil
+	cmds := []tea.Cmd{m.notify("kubectl found", components.ToastSuccess)}
+	if m.currentContext != nil {
+		cmds = append(cmds, m.checkAuthCmd(m.currentContext.Name))
+		if m.viewMode == viewModeNamespaceView {
+			cmds = append(cmds, m.loadNamespaces())
+		}
+	}
+	return m, tea.Batch(cmds...)
+}
+
+// renderKubectlSetup renders the guided setup screen shown when kubectl is missing
+func (m AppModel) renderKubectlSetup() string {
+	var lines []string
+	lines = append(lines, styles.TitleStyle.Render("kubectl is needed"), "")
+	lines = append(lines, styles.ErrorStyle.Render(m.kubectlMissing.Error()), "")
+	lines = append(lines, styles.NormalStyle.Render("Kubertino runs kubectl for every cluster call. Install it:"))
+	for _, step := range kubectlInstallSteps(runtime.GOOS) {
+		lines = append(lines, styles.NormalStyle.Render("  "+step))
+	}
+
+	configFile := m.configPath
+	if configFile == "" {
+		configFile = "the configuration file"
+	}
+	lines = append(lines, "",
+		styles.NormalStyle.Render("Or point Kubertino at an installed kubectl in "+configFile+":"),
+		styles.HighlightStyle.Render("  kubectl_path: /opt/homebrew/bin/kubectl"),
+		"")
+
+	retry := styles.SelectedActionStyle.Render(" r: Retry ")
+	if m.startupChecking {
+		retry = styles.LoadingStyle.Render("Checking for kubectl...")
+	}
+	lines = append(lines, retry+"  "+styles.DimStyle.Render(firstKey(m.keys.Quit)+": quit"))
+
+	dialogStyle := lipgloss.NewStyle().
+		Border(lipgloss.RoundedBorder()).
+		BorderForeground(lipgloss.Color("39")). // Bright cyan
+		Padding(1, 2)
+	if m.termWidth > 0 {
+		dialogStyle = dialogStyle.MaxWidth(m.termWidth)
+	}
+
+	return lipgloss.Place(
+		m.termWidth,
+		m.termHeight,
+		lipgloss.Center,
+		lipgloss.Center,
+		dialogStyle.Render(strings.Join(lines, "\n")),
+	)
+}
@@ -0,0 +1,71 @@
+package tui
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/maratkarimov/kubertino/internal/k8s"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestKubectlSetup_ShownWhenKubectlIsMissing(t *testing.T) {
+	found := false
+	model := newStartupTestModel(func() error {
+		if !found {
+			return fmt.Errorf("%w: exec: \"kubectl\": executable file not found in $PATH", k8s.ErrKubectlNotFound)
+		}
+		return nil
+	})
+
+	updated, _ := model.Update(model.startupCheckCmd()())
+	model = updated.(AppModel)
+	view := model.View()
+	assert.Contains(t, view, "kubectl is needed")
+	assert.Contains(t, view, "executable file not found")
+	assert.Contains(t, view, "kubectl_path: /opt/homebrew/bin/kubectl")
+	assert.Contains(t, view, "r: Retry")
+	assert.True(t, errors.Is(model.Err(), k8s.ErrKubectlNotFound), "reported when quitting")
+
+	// Navigation keys are captured by the setup screen
+	model = sendKey(model, tea.KeyMsg{Type: tea.KeyDown})
+	assert.Equal(t, 0, model.selectedContextIndex)
+
+	// Retrying while still missing keeps the screen
+	model = sendKey(model, runeKey('r'))
+	assert.Contains(t, model.View(), "Checking for kubectl...")
+	_, cmd := model.Update(runeKey('r'))
+	assert.Nil(t, cmd, "one check at a time")
+	updated, _ = model.Update(model.startupCheckCmd()())
+	model = updated.(AppModel)
+	assert.Contains(t, model.View(), "kubectl is needed")
+
+	// Found on the next retry
+	found = true
+	model = sendKey(model, runeKey('r'))
+	updated, _ = model.Update(model.startupCheckCmd()())
+	model = updated.(AppModel)
+	assert.NotContains(t, model.View(), "kubectl is needed")
+	assert.NoError(t, model.Err())
+	toast, ok := model.statusBar.Current()
+	require.True(t, ok)
+	assert.Equal(t, "kubectl found", toast.Text)
+}
+
+func TestKubectlSetup_Quit(t *testing.T) {
+	model := newStartupTestModel(func() error { return k8s.ErrKubectlNotFound })
+	updated, _ := model.Update(model.startupCheckCmd()())
+	model = updated.(AppModel)
+
+	_, cmd := model.Update(runeKey('q'))
+	require.NotNil(t, cmd)
+	assert.Equal(t, tea.Quit(), cmd())
+}
+
+func TestKubectlInstallSteps(t *testing.T) {
+	assert.Equal(t, []string{"brew install kubectl"}, kubectlInstallSteps("darwin"))
+	assert.Contains(t, kubectlInstallSteps("windows")[0], "winget")
+	assert.Contains(t, kubectlInstallSteps("linux")[1], "install-kubectl-linux")
+}
@@ -0,0 +1,247 @@
+package tui
+
+import (
+	"log/slog"
+	"slices"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/maratkarimov/kubertino/internal/config"
+	"github.com/maratkarimov/kubertino/internal/state"
+)
+
+// Bounds and step, in percent, of the splits changed with the resize keys
+const (
+	minSplit  = 20
+	maxSplit  = 80
+	splitStep = 5
+)
+
+// panelSplit is the layout preset and the splits chosen with the resize keys. A zero split
+// uses the preset's default.
+type panelSplit struct {
+	preset         string // layout.preset, "" for the even split
+	namespaceWidth int    // Percent of the width given to the namespace panel
+	podsHeight     int    // Percent of the right-side height given to the pod panel
+}
+
+// namespacePercent returns the percent of the width given to the namespace panel; 0 when
+// pods-only hides it, which happens once a namespace is open
+func (m AppModel) namespacePercent() int {
+	switch {
+	case m.panels.preset == config.PresetPodsOnly && m.currentNamespace != "":
+		return 0
+	case m.panels.namespaceWidth > 0:
+		return m.panels.namespaceWidth
+	case m.panels.preset == config.PresetWideNamespaces:
+		return 65
+	case m.panels.preset == config.PresetWidePods:
+		return 35
+	}
+	return 50
+}
+
+// podsPercent returns the percent of the right-side height given to the pod panel in the
+// default placement
+func (m AppModel) podsPercent() int {
+	if m.panels.podsHeight > 0 {
+		return m.panels.podsHeight
+	}
+	return 50
+}
+
+// rect is the screen area of a panel. A zero height means the panel is not shown.
+type rect struct {
+	x, y int
+	w, h int
+}
+
+// splitLayout holds the panel areas of the namespace view. Rendering, mouse hit-testing and
+// scrolling all use it so they agree on panel sizes for every actions placement.
+type splitLayout struct {
+	placement  string // layout.actions, "" for the default quadrant under the pod panel
+	namespaces rect
+	pods       rect
+	forwards   rect
+	actions    rect
+}
+
+// splitLayout computes the panel areas for the terminal size and configured placement:
+//
+//	default: namespaces | pods        bottom: namespaces | pods    right: namespaces | pods | actions
+//	                    | forwards                       | forwards        | forwards      |
+//	                    | actions             actions (full width)
+//
+// The hidden placement drops the actions panel and gives its space to the other panels. The
+// namespace/pods and pods/actions splits follow the layout preset and the resize keys.
+func (m AppModel) splitLayout() splitLayout {
+	// The header takes the first line when shown, the status bar the last while a toast is shown
+	top := m.headerHeight()
+	layout := m.panelLayout(max(m.termHeight-top-m.statusBarHeight(), 0))
+	for _, r := range []*rect{&layout.namespaces, &layout.pods, &layout.forwards, &layout.actions} {
+		r.y += top
+	}
+	return layout
+}
+
+// panelLayout computes the panel areas for height lines starting at the top of the terminal
+func (m AppModel) panelLayout(height int) splitLayout {
+	layout := splitLayout{placement: m.config.ActionsPlacement()}
+	mainHeight := height
+	leftWidth := m.termWidth * m.namespacePercent() / 100
+	rightWidth := m.termWidth - leftWidth
+
+	switch layout.placement {
+	case config.ActionsBottom:
+		barHeight := m.actionsBarHeight(m.termWidth)
+		mainHeight = max(height-barHeight, 0)
+		layout.actions = rect{x: 0, y: mainHeight, w: m.termWidth, h: barHeight}
+	case config.ActionsRight:
+		actionsWidth := m.actionsColumnWidth(height)
+		leftWidth = (m.termWidth - actionsWidth) * m.namespacePercent() / 100
+		rightWidth = m.termWidth - actionsWidth - leftWidth
+		layout.actions = rect{x: leftWidth + rightWidth, y: 0, w: actionsWidth, h: height}
+	}
+
+	layout.namespaces = rect{x: 0, y: 0, w: leftWidth, h: mainHeight}
+
+	if layout.placement == "" {
+		// Pods take the top; port-forwards take part of the actions quadrant
+		podsHeight := mainHeight * m.podsPercent() / 100
+		bottomHeight := mainHeight - podsHeight
+		forwardsHeight := m.forwardsPanelHeight(bottomHeight)
+		layout.pods = rect{x: leftWidth, y: 0, w: rightWidth, h: podsHeight}
+		layout.forwards = rect{x: leftWidth, y: podsHeight, w: rightWidth, h: forwardsHeight}
+		layout.actions = rect{x: leftWidth, y: podsHeight + forwardsHeight, w: rightWidth, h: bottomHeight - forwardsHeight}
+		return layout
+	}
+
+	forwardsHeight := m.forwardsPanelHeight(mainHeight)
+	layout.pods = rect{x: leftWidth, y: 0, w: rightWidth, h: mainHeight - forwardsHeight}
+	layout.forwards = rect{x: leftWidth, y: mainHeight - forwardsHeight, w: rightWidth, h: forwardsHeight}
+	return layout
+}
+
+// actionLabelWidth returns the width of the widest "[key] name" entry of the actions panel
+func (m AppModel) actionLabelWidth() int {
+	entries := m.actionEntries()
+	if len(entries) == 0 {
+		return lipgloss.Width("No actions configured")
+	}
+	return actionEntriesWidth(entries)
+}
+
+// actionsBarHeight returns the height of a full-width actions bar: as many rows as needed to
+// lay the actions out in columns across width, capped at half the terminal
+func (m AppModel) actionsBarHeight(width int) int {
+	// Columns are separated by 3 spaces; border (2) + padding (4) around the text
+	perRow := max((width-6+3)/(m.actionLabelWidth()+3), 1)
+	rows := max((len(m.actionEntries())+perRow-1)/perRow, 1)
+	// Title, blank line, help text and the panel frame take 8 lines
+	return min(rows+8, m.termHeight/2)
+}
+
+// actionsColumnWidth returns the width of the actions column: wide enough for the action
+// columns needed at height, capped at a third of the terminal
+func (m AppModel) actionsColumnWidth(height int) int {
+	columns := len(actionColumns(m.actionEntries(), height))
+	// Border (2) + padding (4) around the text
+	width := max(columns, 1)*(m.actionLabelWidth()+3) - 3 + 6
+	return min(max(width, 24), m.termWidth/3)
+}
+
+// renderPanels composes the namespace view panels according to the layout
+func (m AppModel) renderPanels(layout splitLayout) string {
+	namespacePanel := ""
+	if m.namespacePercent() > 0 {
+		namespacePanel = m.withEnvBadge(m.renderNamespacePanel(layout.namespaces.w, layout.namespaces.h), m.focusedPanel == PanelNamespaces)
+	}
+
+	// The environment badge of the context stays in sight in every layout
+	podPanel := m.withEnvBadge(m.renderPodPanel(layout.pods.w, layout.pods.h), m.focusedPanel == PanelPods)
+	rightPanels := []string{podPanel}
+	if layout.forwards.h > 0 {
+		rightPanels = append(rightPanels, m.renderForwardsPanel(layout.forwards.w, layout.forwards.h))
+	}
+
+	switch layout.placement {
+	case config.ActionsHidden:
+		return lipgloss.JoinHorizontal(lipgloss.Top, namespacePanel, lipgloss.JoinVertical(lipgloss.Left, rightPanels...))
+	case config.ActionsRight:
+		actionsPanel := m.renderActionsPanel(layout.actions.w, layout.actions.h)
+		return lipgloss.JoinHorizontal(lipgloss.Top, namespacePanel, lipgloss.JoinVertical(lipgloss.Left, rightPanels...), actionsPanel)
+	case config.ActionsBottom:
+		main := lipgloss.JoinHorizontal(lipgloss.Top, namespacePanel, lipgloss.JoinVertical(lipgloss.Left, rightPanels...))
+		return lipgloss.JoinVertical(lipgloss.Left, main, m.renderActionsPanel(layout.actions.w, layout.actions.h))
+	default:
+		rightPanels = append(rightPanels, m.renderActionsPanel(layout.actions.w, layout.actions.h))
+		return lipgloss.JoinHorizontal(lipgloss.Top, namespacePanel, lipgloss.JoinVertical(lipgloss.Left, rightPanels...))
+	}
+}
+
+// handleCycleLayoutPreset switches to the next layout preset (even split, wide-namespaces,
+// wide-pods, pods-only), dropping the namespace width chosen with the resize keys
+func (m AppModel) handleCycleLayoutPreset() (tea.Model, tea.Cmd) {
+	next := 0
+	if i := slices.Index(config.LayoutPresets, m.panels.preset); i >= 0 {
+		next = i + 1
+	}
+	m.panels.preset = ""
+	if next < len(config.LayoutPresets) {
+		m.panels.preset = config.LayoutPresets[next]
+	}
+	m.panels.namespaceWidth = 0
+	slog.Info("layout preset changed", "preset", m.panels.preset)
+	return m.applyPanelSplit()
+}
+
+// handleResizeNamespaces moves the vertical split by delta percent. Resizing leaves the
+// pods-only preset, bringing the namespace panel back.
+func (m AppModel) handleResizeNamespaces(delta int) (tea.Model, tea.Cmd) {
+	m.panels.namespaceWidth = min(max(m.namespacePercent()+delta, minSplit), maxSplit)
+	if m.panels.preset == config.PresetPodsOnly {
+		m.panels.preset = ""
+	}
+	return m.applyPanelSplit()
+}
+
+// handleResizePods moves the split between the pod and actions panels by delta percent. Only
+// the default placement stacks them, so other placements ignore it.
+func (m AppModel) handleResizePods(delta int) (tea.Model, tea.Cmd) {
+	if m.config.ActionsPlacement() != "" {
+		return m, nil
+	}
+	m.panels.podsHeight = min(max(m.podsPercent()+delta, minSplit), maxSplit)
+	return m.applyPanelSplit()
+}
+
+// applyPanelSplit keeps focus and scrolling valid for the new panel sizes and records them
+// in the session state
+func (m AppModel) applyPanelSplit() (tea.Model, tea.Cmd) {
+	if m.focusedPanel == PanelNamespaces && m.namespacePercent() == 0 {
+		m.focusedPanel = PanelPods
+	}
+	m.adjustPodScrollOffset()
+
+	if m.state != nil {
+		m.state.Layout = &state.LayoutState{
+			Preset:         m.panels.preset,
+			NamespaceWidth: m.panels.namespaceWidth,
+			PodsHeight:     m.panels.podsHeight,
+		}
+	}
+	return m, nil
+}
+
+// skipHiddenNamespaces moves focus past the namespace panel while pods-only hides it: to the
+// pods panel going forward, to the port-forward panel (if any) going backward
+func (m *AppModel) skipHiddenNamespaces(backward bool) {
+	if m.focusedPanel != PanelNamespaces || m.namespacePercent() > 0 {
+		return
+	}
+	m.focusedPanel = PanelPods
+	if backward && len(m.forwardList()) > 0 {
+		m.focusedPanel = PanelForwards
+		m.clampForwardSelection()
+	}
+}
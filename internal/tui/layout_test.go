@@ -6,7 +6,9 @@ import (
 	"testing"
 
 	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
 	"github.com/maratkarimov/kubertino/internal/config"
+	"github.com/maratkarimov/kubertino/internal/k8s"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -62,7 +64,7 @@ func TestDimensionCalculations(t *testing.T) {
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			// Calculate dimensions using story logic
-			availableHeight := tt.termHeight - HeaderHeight
+			availableHeight := tt.termHeight // No header unless show_header is set
 			leftW := tt.termWidth / 2
 			rightW := tt.termWidth - leftW
 			topH := availableHeight / 2
@@ -95,7 +97,7 @@ func TestRenderSplitLayout(t *testing.T) {
 	model.viewMode = viewModeNamespaceView
 	model.termWidth = 80
 	model.termHeight = 24
-	model.namespaces = []string{"default", "kube-system", "test-ns"}
+	model.namespaces = k8s.NamespacesNamed([]string{"default", "kube-system", "test-ns"})
 
 	output := model.renderSplitLayout()
 
@@ -274,7 +276,7 @@ func TestViewModeSwitchesToSplitLayout(t *testing.T) {
 	model.viewMode = viewModeNamespaceView
 	model.termWidth = 80
 	model.termHeight = 24
-	model.namespaces = []string{"default"}
+	model.namespaces = k8s.NamespacesNamed([]string{"default"})
 
 	output := model.View()
 
@@ -519,7 +521,7 @@ func TestRenderNamespacePanel(t *testing.T) {
 	model.currentContext = &cfg.Contexts[0]
 	model.termWidth = 80
 	model.termHeight = 24
-	model.namespaces = []string{"default", "kube-system"}
+	model.namespaces = k8s.NamespacesNamed([]string{"default", "kube-system"})
 
 	panel := model.renderNamespacePanel(40, 23)
 
@@ -562,7 +564,7 @@ func TestLayoutWithEmptyNamespaces(t *testing.T) {
 	model.viewMode = viewModeNamespaceView
 	model.termWidth = 80
 	model.termHeight = 24
-	model.namespaces = []string{} // Empty namespace list
+	model.namespaces = k8s.NamespacesNamed([]string{}) // Empty namespace list
 
 	output := model.renderSplitLayout()
 
@@ -582,7 +584,7 @@ func TestPanelBordersPresent(t *testing.T) {
 	model.viewMode = viewModeNamespaceView
 	model.termWidth = 80
 	model.termHeight = 24
-	model.namespaces = []string{"default"}
+	model.namespaces = k8s.NamespacesNamed([]string{"default"})
 
 	output := model.renderSplitLayout()
 
@@ -615,7 +617,7 @@ func TestRenderNamespacePanel_LongListInSplitLayout(t *testing.T) {
 	for i := 0; i < 50; i++ {
 		namespaces[i] = fmt.Sprintf("namespace-%d", i)
 	}
-	model.namespaces = namespaces
+	model.namespaces = k8s.NamespacesNamed(namespaces)
 
 	// Render split layout
 	output := model.renderSplitLayout()
@@ -649,3 +651,127 @@ func TestRenderNamespacePanel_LongListInSplitLayout(t *testing.T) {
 	assert.Contains(t, output, "Pods", "Pods panel should be visible despite long namespace list")
 	assert.Contains(t, output, "Actions", "Actions panel should be visible despite long namespace list")
 }
+
+// TestSplitLayout_ActionsPlacement verifies the panel areas of every actions placement
+func TestSplitLayout_ActionsPlacement(t *testing.T) {
+	newModel := func(placement string) AppModel {
+		return newTestModel(newMockAdapter(), withContexts(config.Context{Name: "dev"}), withActions(
+			config.Action{Name: "Logs", Shortcut: "l", Command: "echo {{.pod}}"},
+			config.Action{Name: "Shell", Shortcut: "s", Command: "echo {{.pod}}"},
+		), func(cfg *config.Config) { cfg.Layout = &config.Layout{Actions: placement} })
+	}
+
+	t.Run("default quadrant", func(t *testing.T) {
+		layout := newModel("").splitLayout()
+		assert.Equal(t, rect{x: 0, y: 0, w: 60, h: 40}, layout.namespaces)
+		assert.Equal(t, rect{x: 60, y: 0, w: 60, h: 20}, layout.pods)
+		assert.Equal(t, rect{x: 60, y: 20, w: 60, h: 20}, layout.actions)
+	})
+
+	t.Run("bottom bar", func(t *testing.T) {
+		layout := newModel(config.ActionsBottom).splitLayout()
+		assert.Equal(t, rect{x: 0, y: 31, w: 120, h: 9}, layout.actions, "both actions fit in one row")
+		assert.Equal(t, 31, layout.namespaces.h)
+		assert.Equal(t, 31, layout.pods.h)
+	})
+
+	t.Run("right column", func(t *testing.T) {
+		layout := newModel(config.ActionsRight).splitLayout()
+		assert.Equal(t, 40, layout.actions.h)
+		assert.Equal(t, 24, layout.actions.w)
+		assert.Equal(t, 120, layout.namespaces.w+layout.pods.w+layout.actions.w)
+		assert.Equal(t, 40, layout.pods.h)
+	})
+
+	t.Run("hidden", func(t *testing.T) {
+		model := newModel(config.ActionsHidden)
+		layout := model.splitLayout()
+		assert.Equal(t, 0, layout.actions.h)
+		assert.Equal(t, 40, layout.pods.h)
+		model.viewMode = viewModeNamespaceView
+		assert.NotContains(t, model.View(), "Actions")
+	})
+}
+
+// TestRenderSplitLayout_PlacementsFillTerminal verifies every placement renders to the terminal size
+func TestRenderSplitLayout_PlacementsFillTerminal(t *testing.T) {
+	for _, placement := range []string{"", config.ActionsBottom, config.ActionsRight, config.ActionsHidden} {
+		t.Run(fmt.Sprintf("placement %q", placement), func(t *testing.T) {
+			model := NewAppModel(&config.Config{
+				Layout:   &config.Layout{Actions: placement},
+				Actions:  []config.Action{{Name: "Logs", Shortcut: "l", Command: "echo {{.pod}}"}},
+				Contexts: []config.Context{{Name: "dev"}},
+			}, newMockAdapter())
+			updated, _ := model.Update(tea.WindowSizeMsg{Width: 120, Height: 40})
+			model = updated.(AppModel)
+			model.viewMode = viewModeNamespaceView
+
+			view := model.View()
+			assert.LessOrEqual(t, lipgloss.Width(view), 120)
+			assert.LessOrEqual(t, lipgloss.Height(view), 40)
+			if placement != config.ActionsHidden {
+				assert.Contains(t, view, "[l] Logs")
+			}
+		})
+	}
+}
+
+func TestSplitLayout_PresetsAndResize(t *testing.T) {
+	newModel := func(preset string) AppModel {
+		model := newTestModel(newMockAdapter(), withContexts(config.Context{Name: "dev"}),
+			func(cfg *config.Config) { cfg.Layout = &config.Layout{Preset: preset} })
+		model.termWidth = 100
+		model.viewMode = viewModeNamespaceView
+		return model
+	}
+
+	t.Run("presets", func(t *testing.T) {
+		assert.Equal(t, 65, newModel(config.PresetWideNamespaces).splitLayout().namespaces.w)
+		assert.Equal(t, 35, newModel(config.PresetWidePods).splitLayout().namespaces.w)
+
+		model := newModel(config.PresetPodsOnly)
+		assert.Equal(t, 50, model.splitLayout().namespaces.w, "namespaces shown until one is open")
+		model.currentNamespace = "default"
+		layout := model.splitLayout()
+		assert.Equal(t, 0, layout.namespaces.w)
+		assert.Equal(t, rect{x: 0, y: 0, w: 100, h: 20}, layout.pods)
+		assert.NotContains(t, model.View(), "Namespaces")
+	})
+
+	t.Run("resize keys clamp the splits", func(t *testing.T) {
+		model := newModel("")
+		model = sendKey(model, tea.KeyMsg{Type: tea.KeyCtrlRight})
+		assert.Equal(t, 55, model.splitLayout().namespaces.w)
+		for range 10 {
+			model = sendKey(model, tea.KeyMsg{Type: tea.KeyCtrlLeft})
+		}
+		assert.Equal(t, 20, model.splitLayout().namespaces.w)
+
+		model = sendKey(model, tea.KeyMsg{Type: tea.KeyCtrlUp})
+		assert.Equal(t, 18, model.splitLayout().pods.h)
+		assert.Equal(t, 22, model.splitLayout().actions.h)
+	})
+
+	t.Run("cycling presets", func(t *testing.T) {
+		model := newModel("")
+		model.currentNamespace = "default"
+		ctrlL := tea.KeyMsg{Type: tea.KeyCtrlL}
+		for _, want := range []string{config.PresetWideNamespaces, config.PresetWidePods, config.PresetPodsOnly, ""} {
+			model = sendKey(model, ctrlL)
+			assert.Equal(t, want, model.panels.preset)
+		}
+	})
+
+	t.Run("pods-only moves focus off the hidden namespaces", func(t *testing.T) {
+		model := newModel(config.PresetWidePods)
+		model.currentNamespace = "default"
+		model = sendKey(model, tea.KeyMsg{Type: tea.KeyCtrlL})
+		assert.Equal(t, PanelPods, model.focusedPanel)
+		model = sendKey(model, tea.KeyMsg{Type: tea.KeyTab})
+		assert.Equal(t, PanelPods, model.focusedPanel, "tab skips the hidden namespace panel")
+
+		// Resizing brings the namespace panel back
+		model = sendKey(model, tea.KeyMsg{Type: tea.KeyCtrlRight})
+		assert.Equal(t, 20, model.splitLayout().namespaces.w)
+	})
+}
@@ -0,0 +1,145 @@
+package tui
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"slices"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/maratkarimov/kubertino/internal/config"
+	"github.com/maratkarimov/kubertino/internal/k8s"
+	"github.com/maratkarimov/kubertino/internal/tui/styles"
+)
+
+// Usage above these percentages of the pod's request is highlighted in the cpu and memory
+// columns
+const (
+	usageWarnPercent     = 80
+	usageCriticalPercent = 100
+)
+
+// MetricsFetcher is implemented by adapters that can fetch the resource usage of pods
+type MetricsFetcher interface {
+	GetPodMetrics(context, namespace string) (map[string]k8s.PodMetrics, error)
+}
+
+// podMetrics is the resource usage of the pods of one namespace, keyed by pod name
+type podMetrics struct {
+	context   string
+	namespace string
+	usage     map[string]k8s.PodMetrics
+}
+
+// podMetricsFetchedMsg is sent when the resource usage of the current namespace is fetched
+type podMetricsFetchedMsg = resultMsg[podMetrics]
+
+// metricsTickMsg triggers the periodic refresh of the cpu and memory columns
+type metricsTickMsg time.Time
+
+// metricsTickCmd schedules the next metrics refresh after interval
+func metricsTickCmd(interval time.Duration) tea.Cmd {
+	return tea.Tick(interval, func(t time.Time) tea.Msg {
+		return metricsTickMsg(t)
+	})
+}
+
+// metricsInterval returns how often the cpu and memory columns are refreshed
+func (m AppModel) metricsInterval() time.Duration {
+	if m.config == nil {
+		return config.DefaultMetricsInterval
+	}
+	interval, err := m.config.MetricsIntervalDuration()
+	if err != nil {
+		return config.DefaultMetricsInterval
+	}
+	return interval
+}
+
+// showsMetrics reports whether the pod list shows the cpu or memory column and the adapter
+// can fetch them
+func (m AppModel) showsMetrics() bool {
+	if _, ok := m.kubeAdapter.(MetricsFetcher); !ok {
+		return false
+	}
+	columns := m.visiblePodColumns()
+	return slices.Contains(columns, config.PodColumnCPU) || slices.Contains(columns, config.PodColumnMemory)
+}
+
+// startMetricsCmd starts the periodic metrics refresh when the adapter can fetch metrics.
+// Returns nil otherwise. Ticks keep running while the columns are hidden so that a reloaded
+// configuration showing them takes effect.
+func (m AppModel) startMetricsCmd() tea.Cmd {
+	if _, ok := m.kubeAdapter.(MetricsFetcher); !ok {
+		return nil
+	}
+	return metricsTickCmd(m.metricsInterval())
+}
+
+// handleMetricsTick refreshes the resource usage of the current namespace and schedules the
+// next refresh
+func (m AppModel) handleMetricsTick() (tea.Model, tea.Cmd) {
+	return m, tea.Batch(m.fetchMetricsCmd(), metricsTickCmd(m.metricsInterval()))
+}
+
+// fetchMetricsCmd returns a command that fetches the resource usage of the pods of the
+// current namespace. Returns nil when the columns are hidden or no pods are shown.
+func (m AppModel) fetchMetricsCmd() tea.Cmd {
+	if !m.showsMetrics() || m.currentContext == nil || m.currentNamespace == "" || m.browsingResources() {
+		return nil
+	}
+
+	fetcher := m.kubeAdapter.(MetricsFetcher)
+	contextName, namespace := m.currentContext.Name, m.currentNamespace
+	return fetchCmd(m.requests, asyncMetrics, "", func(context.Context) (podMetrics, error) {
+		usage, err := fetcher.GetPodMetrics(contextName, namespace)
+		if err != nil {
+			if errors.Is(err, k8s.ErrMetricsUnavailable) {
+				slog.Debug("pod metrics unavailable", "context", contextName, "namespace", namespace, "error", err)
+			} else {
+				slog.Warn("pod metrics fetch failed", "context", contextName, "namespace", namespace, "error", err)
+			}
+		}
+		return podMetrics{context: contextName, namespace: namespace, usage: usage}, err
+	})
+}
+
+// handlePodMetricsFetched shows the fetched resource usage. Failures leave the columns
+// showing "-" rather than interrupting with an error.
+func (m AppModel) handlePodMetricsFetched(msg podMetricsFetchedMsg) (tea.Model, tea.Cmd) {
+	if !m.requests.current(msg.asyncRequest) {
+		return m, nil
+	}
+	m.podMetrics = msg.value
+	return m, nil
+}
+
+// podUsage returns the resource usage of the named pod of the current namespace, nil when it
+// is unknown
+func (m AppModel) podUsage(pod string) *k8s.PodMetrics {
+	if m.currentContext == nil || m.podMetrics.context != m.currentContext.Name || m.podMetrics.namespace != m.currentNamespace {
+		return nil
+	}
+	usage, ok := m.podMetrics.usage[pod]
+	if !ok {
+		return nil
+	}
+	return &usage
+}
+
+// usageStyle returns the style of a cpu or memory value: yellow above usageWarnPercent of
+// the request, red above usageCriticalPercent. Pods without a request are not highlighted.
+func usageStyle(used, requested int64) lipgloss.Style {
+	if requested <= 0 {
+		return lipgloss.NewStyle()
+	}
+	switch percent := used * 100 / requested; {
+	case percent > usageCriticalPercent:
+		return styles.FailedStyle
+	case percent > usageWarnPercent:
+		return styles.PendingStyle
+	}
+	return lipgloss.NewStyle()
+}
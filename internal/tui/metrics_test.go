@@ -0,0 +1,104 @@
+package tui
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/maratkarimov/kubertino/internal/config"
+	"github.com/maratkarimov/kubertino/internal/k8s"
+	"github.com/maratkarimov/kubertino/internal/tui/styles"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// metricsAdapter is a mock adapter that also fetches pod resource usage
+type metricsAdapter struct {
+	*mockKubeAdapter
+	usage map[string]k8s.PodMetrics
+	err   error
+}
+
+func (a *metricsAdapter) GetPodMetrics(context, namespace string) (map[string]k8s.PodMetrics, error) {
+	return a.usage, a.err
+}
+
+func newMetricsTestModel(adapter *metricsAdapter, columns []string) AppModel {
+	model := newTestModel(adapter, func(cfg *config.Config) { cfg.PodColumns = columns })
+	model.currentNamespace = "production"
+	model.pods = []k8s.Pod{
+		{Name: "test-pod-1", Status: "Running", CPURequest: 100, MemRequest: 100 << 20},
+		{Name: "test-pod-2", Status: "Running"},
+	}
+	return model
+}
+
+func TestPodMetrics_FillsColumns(t *testing.T) {
+	adapter := &metricsAdapter{
+		mockKubeAdapter: newMockAdapter(),
+		usage: map[string]k8s.PodMetrics{
+			"test-pod-1": {CPU: 90, Memory: 40 << 20},
+			"test-pod-2": {CPU: 5, Memory: 512 << 10},
+		},
+	}
+	model := newMetricsTestModel(adapter, []string{"status", "cpu", "memory"})
+
+	// Columns show "-" until the first fetch
+	output := model.renderPodPanel(100, 20)
+	header := podPanelLine(t, output, "CPU")
+	assert.Contains(t, header, "MEMORY")
+	assert.Contains(t, podPanelLine(t, output, "test-pod-1"), "-")
+
+	cmd := model.fetchMetricsCmd()
+	require.NotNil(t, cmd)
+	updated, _ := model.Update(cmd())
+	model = updated.(AppModel)
+
+	output = model.renderPodPanel(100, 20)
+	assert.Contains(t, podPanelLine(t, output, "test-pod-1"), "90m")
+	assert.Contains(t, podPanelLine(t, output, "test-pod-1"), "40Mi")
+	assert.Contains(t, podPanelLine(t, output, "test-pod-2"), "512Ki")
+
+	// Usage of another namespace is not shown
+	model.currentNamespace = "staging"
+	assert.Nil(t, model.podUsage("test-pod-1"))
+}
+
+func TestPodMetrics_TickRefreshesOnlyShownColumns(t *testing.T) {
+	adapter := &metricsAdapter{mockKubeAdapter: newMockAdapter()}
+
+	hidden := newMetricsTestModel(adapter, nil)
+	assert.NotNil(t, hidden.startMetricsCmd(), "ticks run so a reload can show the columns")
+	assert.Nil(t, hidden.fetchMetricsCmd())
+
+	shown := newMetricsTestModel(adapter, []string{"cpu"})
+	_, cmd := shown.Update(metricsTickMsg{})
+	require.NotNil(t, cmd)
+	assert.NotNil(t, shown.fetchMetricsCmd())
+
+	// Adapters without metrics never tick
+	plain := newTestModel(newMockAdapter())
+	assert.Nil(t, plain.startMetricsCmd())
+}
+
+func TestPodMetrics_FailureShowsDash(t *testing.T) {
+	adapter := &metricsAdapter{mockKubeAdapter: newMockAdapter(), err: k8s.ErrMetricsUnavailable}
+	model := newMetricsTestModel(adapter, []string{"cpu"})
+	model.podMetrics = podMetrics{context: "test-context", namespace: "production", usage: map[string]k8s.PodMetrics{"test-pod-1": {CPU: 1}}}
+
+	updated, _ := model.Update(model.fetchMetricsCmd()())
+	model = updated.(AppModel)
+	assert.False(t, model.errorModal.IsVisible, "metrics failures do not interrupt")
+	assert.Nil(t, model.podUsage("test-pod-1"))
+
+	adapter.err = errors.New("forbidden")
+	updated, _ = model.Update(model.fetchMetricsCmd()())
+	model = updated.(AppModel)
+	assert.False(t, model.errorModal.IsVisible)
+}
+
+func TestUsageStyle(t *testing.T) {
+	assert.Equal(t, styles.PendingStyle.Render("x"), usageStyle(90, 100).Render("x"), "above 80% of request")
+	assert.Equal(t, styles.FailedStyle.Render("x"), usageStyle(150, 100).Render("x"), "above request")
+	assert.Equal(t, "x", usageStyle(50, 100).Render("x"))
+	assert.Equal(t, "x", usageStyle(500, 0).Render("x"), "no request")
+}
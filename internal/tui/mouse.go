@@ -0,0 +1,189 @@
+package tui
+
+import (
+	"log/slog"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// panelContentTop is the number of rows between a panel's top edge and its first content row:
+// border (1) + padding (1)
+const panelContentTop = 2
+
+// panelRegion is the screen area of a panel in the split layout
+type panelRegion struct {
+	panel     PanelType
+	x, y      int
+	w, h      int
+	isActions bool // The actions panel is never focused, so it has no PanelType of its own
+}
+
+// contains reports whether the cell at (x, y) lies within the region
+func (r panelRegion) contains(x, y int) bool {
+	return x >= r.x && x < r.x+r.w && y >= r.y && y < r.y+r.h
+}
+
+// panelRegions returns the screen areas of the panels, mirroring renderSplitLayout
+func (m AppModel) panelRegions() []panelRegion {
+	layout := m.splitLayout()
+	region := func(panel PanelType, r rect) panelRegion {
+		return panelRegion{panel: panel, x: r.x, y: r.y, w: r.w, h: r.h}
+	}
+
+	actions := region(0, layout.actions)
+	actions.isActions = true
+	return []panelRegion{
+		region(PanelNamespaces, layout.namespaces),
+		region(PanelPods, layout.pods),
+		region(PanelForwards, layout.forwards),
+		actions,
+	}
+}
+
+// handleMouse focuses and selects on left click, moves the cursor with the wheel and runs an
+// action when its shortcut is clicked
+func (m AppModel) handleMouse(msg tea.MouseMsg) (tea.Model, tea.Cmd) {
+	if m.viewMode != viewModeNamespaceView || len(m.whatsNew) > 0 || m.terminalTooSmall || m.errorModal.IsVisible || m.podSearchMode || m.podSelectorEditing || (m.confirm != nil && m.confirm.IsVisible) || (m.paramForm != nil && m.paramForm.IsVisible) {
+		return m, nil
+	}
+	if msg.Action != tea.MouseActionPress {
+		return m, nil
+	}
+
+	for _, region := range m.panelRegions() {
+		if !region.contains(msg.X, msg.Y) {
+			continue
+		}
+
+		if region.isActions {
+			if msg.Button != tea.MouseButtonLeft {
+				return m, nil
+			}
+			if entry, ok := m.actionAt(region, msg.X, msg.Y); ok {
+				if entry.group != nil {
+					m.openActionGroup = entry.group.Name
+					return m, nil
+				}
+				slog.Debug("action clicked", "action", entry.action.Name)
+				m.openActionGroup = ""
+				return m.handleActionExecution(entry.action)
+			}
+			return m, nil
+		}
+
+		// Namespace search keeps the cursor in the filtered list
+		if m.searchMode && region.panel != PanelNamespaces {
+			return m, nil
+		}
+		m.focusedPanel = region.panel
+
+		switch msg.Button {
+		case tea.MouseButtonWheelUp:
+			m.scrollCursor(-1)
+		case tea.MouseButtonWheelDown:
+			m.scrollCursor(1)
+		case tea.MouseButtonLeft:
+			return m.clickRow(region, msg.Y-region.y-panelContentTop)
+		}
+		return m, nil
+	}
+	return m, nil
+}
+
+// scrollCursor moves the cursor of the focused panel by one row without wrapping around
+func (m *AppModel) scrollCursor(delta int) {
+	if m.focusedPanel == PanelNamespaces {
+		count := len(m.namespaceRows())
+		next := m.selectedNamespaceIndex + delta
+		if next < 0 || next >= count {
+			return
+		}
+	}
+
+	if delta < 0 {
+		m.moveUp()
+	} else {
+		m.moveDown()
+	}
+}
+
+// clickRow selects the list item on the given content row of a panel. Clicking the
+// highlighted namespace opens it, like Enter.
+func (m AppModel) clickRow(region panelRegion, row int) (tea.Model, tea.Cmd) {
+	switch region.panel {
+	case PanelNamespaces:
+		// Header line and blank line precede the list
+		index := m.namespaceViewportStart + row - 2
+		list := m.namespaceRows()
+		if row < 2 || index >= len(list) || index >= m.namespaceViewportStart+m.visibleNamespaceRows() {
+			return m, nil
+		}
+		if list[index].isGroup() {
+			m.selectedNamespaceIndex = index
+			m.toggleNamespaceGroup(list[index].group)
+			return m, nil
+		}
+		if index == m.selectedNamespaceIndex {
+			if m.searchMode {
+				m.deactivateSearch()
+			}
+			return m.selectNamespace(list[index].namespace.Name)
+		}
+		m.selectedNamespaceIndex = index
+	case PanelPods:
+		if m.browsingResources() {
+			return m, nil
+		}
+		// Title and column header line precede the list, plus the "More above" indicator
+		first := 2
+		if m.podScrollOffset > 0 {
+			first++
+		}
+		index := m.podScrollOffset + row - first
+		visibleHeight := m.podListHeight(region.h)
+		if row < first || index >= len(m.podList()) || index >= m.podScrollOffset+visibleHeight {
+			return m, nil
+		}
+		m.selectedPodIndex = index
+	case PanelForwards:
+		// Title and blank line precede the list
+		index := row - 2
+		if row >= 2 && index < len(m.forwardList()) {
+			m.selectedForwardIndex = index
+		}
+	}
+	return m, nil
+}
+
+// visibleNamespaceRows returns how many namespaces fit in the namespace list, matching
+// renderNamespacePanel (border and padding take 4 lines)
+func (m AppModel) visibleNamespaceRows() int {
+	_, _, rows := m.namespaceListLayout(m.splitLayout().namespaces.h - 4)
+	return rows
+}
+
+// actionAt returns the entry of the actions panel rendered at (x, y): an action or the header
+// of an action group
+func (m AppModel) actionAt(region panelRegion, x, y int) (actionEntry, bool) {
+	// Title and blank line precede the columns
+	row := y - region.y - panelContentTop - 2
+	// Border (1) + horizontal padding (2)
+	col := x - region.x - 3
+	if row < 0 || col < 0 {
+		return actionEntry{}, false
+	}
+
+	left := 0
+	for _, column := range actionColumns(m.actionEntries(), region.h) {
+		width := actionEntriesWidth(column)
+		if col >= left && col < left+width {
+			if row < len(column) {
+				return column[row], true
+			}
+			return actionEntry{}, false
+		}
+		// Columns are separated by 3 spaces
+		left += width + 3
+	}
+	return actionEntry{}, false
+}
@@ -0,0 +1,163 @@
+package tui
+
+import (
+	"regexp"
+	"strings"
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/maratkarimov/kubertino/internal/config"
+	"github.com/maratkarimov/kubertino/internal/k8s"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// newMouseTestModel returns a namespace view with namespaces, pods and actions loaded
+func newMouseTestModel(t *testing.T) AppModel {
+	t.Helper()
+	model := newTestModel(newMockAdapter(), withActions(
+		config.Action{Name: "Logs", Shortcut: "l", Command: "echo {{.pod}}"},
+		config.Action{Name: "Shell", Shortcut: "x", Command: "echo {{.pod}}"},
+	))
+	updated, _ := model.Update(tea.WindowSizeMsg{Width: 120, Height: 40})
+	model = updated.(AppModel)
+	updated, _ = model.Update(namespaceFetchedMsg{value: k8s.NamespacesNamed([]string{"default", "kube-system", "production", "staging"})})
+	model = updated.(AppModel)
+	updated, _ = model.selectNamespace("default")
+	model = updated.(AppModel)
+	updated, _ = model.Update(podsFetchedMsg{value: []k8s.Pod{
+		{Name: "api-1", Status: "Running"},
+		{Name: "api-2", Status: "Running"},
+		{Name: "worker-1", Status: "Running"},
+	}})
+	return updated.(AppModel)
+}
+
+// ansiSequence matches the color escape sequences emitted by lipgloss
+var ansiSequence = regexp.MustCompile("\x1b\\[[0-9;]*m")
+
+// cellOf returns the screen position of text in the rendered view
+func cellOf(t *testing.T, model AppModel, text string) (int, int) {
+	t.Helper()
+	for y, line := range strings.Split(model.View(), "\n") {
+		plain := ansiSequence.ReplaceAllString(line, "")
+		if x := strings.Index(plain, text); x >= 0 {
+			return lipgloss.Width(plain[:x]), y
+		}
+	}
+	require.Failf(t, "text not rendered", "%q", text)
+	return 0, 0
+}
+
+func click(model AppModel, x, y int) (AppModel, tea.Cmd) {
+	updated, cmd := model.Update(tea.MouseMsg{X: x, Y: y, Action: tea.MouseActionPress, Button: tea.MouseButtonLeft})
+	return updated.(AppModel), cmd
+}
+
+func wheel(model AppModel, x, y int, button tea.MouseButton) AppModel {
+	updated, _ := model.Update(tea.MouseMsg{X: x, Y: y, Action: tea.MouseActionPress, Button: button})
+	return updated.(AppModel)
+}
+
+func TestMouse_ClickSelectsPod(t *testing.T) {
+	model := newMouseTestModel(t)
+	model.focusedPanel = PanelNamespaces
+
+	x, y := cellOf(t, model, "worker-1")
+	model, _ = click(model, x, y)
+
+	assert.Equal(t, PanelPods, model.focusedPanel)
+	pod, ok := model.selectedPod()
+	require.True(t, ok)
+	assert.Equal(t, "worker-1", pod.Name)
+}
+
+func TestMouse_ClickSelectsThenOpensNamespace(t *testing.T) {
+	model := newMouseTestModel(t)
+
+	x, y := cellOf(t, model, "production")
+	model, cmd := click(model, x, y)
+	assert.Equal(t, PanelNamespaces, model.focusedPanel)
+	assert.Equal(t, "production", model.namespaces[model.selectedNamespaceIndex].Name)
+	assert.Nil(t, cmd)
+	assert.Equal(t, "default", model.currentNamespace)
+
+	// A second click on the highlighted namespace opens it
+	model, cmd = click(model, x, y)
+	assert.NotNil(t, cmd)
+	assert.Equal(t, "production", model.currentNamespace)
+}
+
+func TestMouse_ClickOutsideListIgnored(t *testing.T) {
+	model := newMouseTestModel(t)
+	selected := model.selectedNamespaceIndex
+
+	// Namespace panel title row
+	x, y := cellOf(t, model, "Namespaces (4)")
+	model, cmd := click(model, x, y)
+	assert.Nil(t, cmd)
+	assert.Equal(t, selected, model.selectedNamespaceIndex)
+}
+
+func TestMouse_WheelMovesCursor(t *testing.T) {
+	model := newMouseTestModel(t)
+	model.focusedPanel = PanelNamespaces
+	x, y := cellOf(t, model, "api-1")
+
+	model = wheel(model, x, y, tea.MouseButtonWheelDown)
+	assert.Equal(t, PanelPods, model.focusedPanel)
+	assert.Equal(t, 1, model.selectedPodIndex)
+
+	model = wheel(model, x, y, tea.MouseButtonWheelUp)
+	model = wheel(model, x, y, tea.MouseButtonWheelUp)
+	assert.Equal(t, 0, model.selectedPodIndex)
+
+	// The namespace list does not wrap around when scrolled past its start
+	nx, ny := cellOf(t, model, "kube-system")
+	model = wheel(model, nx, ny, tea.MouseButtonWheelUp)
+	assert.Equal(t, PanelNamespaces, model.focusedPanel)
+	assert.Equal(t, 0, model.selectedNamespaceIndex)
+}
+
+func TestMouse_ClickActionExecutes(t *testing.T) {
+	model := newMouseTestModel(t)
+	model.selectedPodIndex = 0
+
+	x, y := cellOf(t, model, "[x] Shell")
+	_, cmd := click(model, x+4, y)
+	assert.NotNil(t, cmd, "action is executed")
+
+	// Clicking past the entry does nothing
+	_, cmd = click(model, x+40, y)
+	assert.Nil(t, cmd)
+}
+
+func TestMouse_IgnoredOutsideNamespaceView(t *testing.T) {
+	model := newMouseTestModel(t)
+	model.focusedPanel = PanelNamespaces
+	model.openPalette()
+
+	x, y := cellOf(t, model, "worker-1")
+	model, _ = click(model, x, y)
+	assert.Equal(t, viewModePalette, model.viewMode)
+	assert.NotEqual(t, PanelPods, model.focusedPanel)
+}
+
+func TestMouse_ClickActionInEachPlacement(t *testing.T) {
+	for _, placement := range []string{config.ActionsBottom, config.ActionsRight} {
+		t.Run(placement, func(t *testing.T) {
+			model := newMouseTestModel(t)
+			model.config.Layout = &config.Layout{Actions: placement}
+			model.selectedPodIndex = 0
+
+			x, y := cellOf(t, model, "[x] Shell")
+			_, cmd := click(model, x+4, y)
+			assert.NotNil(t, cmd, "action is executed")
+
+			x, y = cellOf(t, model, "api-2")
+			model, _ = click(model, x, y)
+			assert.Equal(t, 1, model.selectedPodIndex, "pod rows are still hit")
+		})
+	}
+}
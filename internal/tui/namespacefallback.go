@@ -0,0 +1,26 @@
+package tui
+
+import (
+	"github.com/maratkarimov/kubertino/internal/tui/styles"
+)
+
+// NamespaceFallbackReporter is implemented by adapters that fall back to configured
+// namespaces when listing namespaces is forbidden
+type NamespaceFallbackReporter interface {
+	NamespacesFromFallback(context string) bool
+}
+
+// namespacesFromFallback reports whether the namespaces of the current context came from
+// the context's namespaces list or probed favorites instead of the cluster
+func (m AppModel) namespacesFromFallback() bool {
+	reporter, ok := m.kubeAdapter.(NamespaceFallbackReporter)
+	return ok && m.currentContext != nil && reporter.NamespacesFromFallback(m.currentContext.Name)
+}
+
+// namespaceFallbackBadge marks a namespace list that was not listed from the cluster
+func (m AppModel) namespaceFallbackBadge() string {
+	if !m.namespacesFallback {
+		return ""
+	}
+	return styles.WarningStyle.Render(" [from config]")
+}
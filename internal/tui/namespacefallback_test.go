@@ -0,0 +1,33 @@
+package tui
+
+import (
+	"testing"
+
+	"github.com/maratkarimov/kubertino/internal/config"
+	"github.com/maratkarimov/kubertino/internal/k8s"
+	"github.com/stretchr/testify/assert"
+)
+
+// fallbackAdapter is a mock adapter whose namespaces came from the namespace fallback
+type fallbackAdapter struct {
+	*mockKubeAdapter
+	fallback bool
+}
+
+func (a *fallbackAdapter) NamespacesFromFallback(context string) bool {
+	return a.fallback
+}
+
+func TestNamespaceFallback_Badge(t *testing.T) {
+	adapter := &fallbackAdapter{mockKubeAdapter: newMockAdapter(), fallback: true}
+	model := newTestModel(adapter, withContexts(config.Context{Name: "prod", Namespaces: []string{"team-a"}}))
+
+	updated, _ := model.Update(namespaceFetchedMsg{value: k8s.NamespacesNamed([]string{"team-a"})})
+	model = updated.(AppModel)
+	assert.Contains(t, model.View(), "[from config]")
+
+	adapter.fallback = false
+	updated, _ = model.Update(namespaceFetchedMsg{value: k8s.NamespacesNamed([]string{"team-a", "team-b"})})
+	model = updated.(AppModel)
+	assert.NotContains(t, model.View(), "[from config]")
+}
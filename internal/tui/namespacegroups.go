@@ -0,0 +1,169 @@
+package tui
+
+import (
+	"fmt"
+	"log/slog"
+	"maps"
+	"slices"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/maratkarimov/kubertino/internal/k8s"
+	"github.com/maratkarimov/kubertino/internal/tui/styles"
+)
+
+// namespaceRow is a line of the namespaces panel: a namespace, or the header of a group of
+// namespaces sharing a prefix
+type namespaceRow struct {
+	namespace k8s.Namespace
+	group     string // Prefix of the group; set on group headers only
+	size      int    // Namespaces in the group, on group headers
+	nested    bool   // A namespace listed under the header of its expanded group
+}
+
+// isGroup reports whether the row is the header of a group
+func (r namespaceRow) isGroup() bool {
+	return r.group != ""
+}
+
+// namespaceRows returns the lines of the namespaces panel the cursor moves over. While
+// searching they are the matches; with namespace_grouping, namespaces sharing a prefix with
+// at least one other namespace are collapsed under a group header, favorites excepted.
+func (m AppModel) namespaceRows() []namespaceRow {
+	if m.searchMode && m.filteredNamespaces != nil {
+		return flatNamespaceRows(m.filteredNamespaces)
+	}
+	if m.config == nil || m.config.NamespaceGrouping == "" {
+		return flatNamespaceRows(m.namespaces)
+	}
+
+	members := make(map[string][]k8s.Namespace)
+	for _, ns := range m.namespaces {
+		if prefix, ok := m.namespaceGroup(ns.Name); ok {
+			members[prefix] = append(members[prefix], ns)
+		}
+	}
+
+	rows := make([]namespaceRow, 0, len(m.namespaces))
+	listed := make(map[string]bool) // Groups whose header is in rows
+	for _, ns := range m.namespaces {
+		prefix, ok := m.namespaceGroup(ns.Name)
+		if !ok || len(members[prefix]) < 2 {
+			rows = append(rows, namespaceRow{namespace: ns})
+			continue
+		}
+		if listed[prefix] {
+			continue
+		}
+		listed[prefix] = true
+		rows = append(rows, namespaceRow{group: prefix, size: len(members[prefix])})
+		if m.expandedNamespaceGroups[prefix] {
+			for _, member := range members[prefix] {
+				rows = append(rows, namespaceRow{namespace: member, nested: true})
+			}
+		}
+	}
+	return rows
+}
+
+// flatNamespaceRows returns a row for each of namespaces
+func flatNamespaceRows(namespaces []k8s.Namespace) []namespaceRow {
+	rows := make([]namespaceRow, len(namespaces))
+	for i, ns := range namespaces {
+		rows[i] = namespaceRow{namespace: ns}
+	}
+	return rows
+}
+
+// namespaceGroup returns the prefix namespace is grouped by. Favorites are not grouped, so
+// they stay at the top of the list.
+func (m AppModel) namespaceGroup(namespace string) (string, bool) {
+	if slices.Contains(m.favoriteNamespaces, namespace) {
+		return "", false
+	}
+	return m.config.NamespaceGroupPrefix(namespace)
+}
+
+// highlightedNamespace returns the namespace under the cursor of the namespaces panel. ok is
+// false on a group header.
+func (m AppModel) highlightedNamespace() (k8s.Namespace, bool) {
+	rows := m.namespaceRows()
+	if m.selectedNamespaceIndex < 0 || m.selectedNamespaceIndex >= len(rows) || rows[m.selectedNamespaceIndex].isGroup() {
+		return k8s.Namespace{}, false
+	}
+	return rows[m.selectedNamespaceIndex].namespace, true
+}
+
+// focusNamespace moves the cursor of the namespaces panel to namespace, expanding its group.
+// Returns false when the namespace is not listed.
+func (m *AppModel) focusNamespace(namespace string) bool {
+	if prefix, ok := m.namespaceGroup(namespace); ok && !m.expandedNamespaceGroups[prefix] && !m.searchMode {
+		m.setNamespaceGroupExpanded(prefix, true)
+	}
+	rows := m.namespaceRows()
+	for i, row := range rows {
+		if !row.isGroup() && row.namespace.Name == namespace {
+			m.selectedNamespaceIndex = i
+			m.adjustNamespaceViewport(len(rows))
+			return true
+		}
+	}
+	return false
+}
+
+// toggleNamespaceGroup expands or collapses the group whose header is under the cursor,
+// keeping the cursor on the header
+func (m *AppModel) toggleNamespaceGroup(prefix string) {
+	expanded := !m.expandedNamespaceGroups[prefix]
+	slog.Debug("namespace group toggled", "group", prefix, "expanded", expanded)
+	m.setNamespaceGroupExpanded(prefix, expanded)
+	m.adjustNamespaceViewport(len(m.namespaceRows()))
+}
+
+// setNamespaceGroupExpanded records whether the group of prefix is shown expanded. The map is
+// copied, as it is shared with earlier copies of the model.
+func (m *AppModel) setNamespaceGroupExpanded(prefix string, expanded bool) {
+	groups := maps.Clone(m.expandedNamespaceGroups)
+	if groups == nil {
+		groups = make(map[string]bool)
+	}
+	if expanded {
+		groups[prefix] = true
+	} else {
+		delete(groups, prefix)
+	}
+	m.expandedNamespaceGroups = groups
+}
+
+// namespaceGroupLabel returns the text of a group header, without styles
+func (r namespaceRow) namespaceGroupLabel(expanded bool) string {
+	arrow := "▸"
+	if expanded {
+		arrow = "▾"
+	}
+	return fmt.Sprintf("%s %s (%d)", arrow, r.group, r.size)
+}
+
+// openHighlightedNamespaceRow opens the namespace under the cursor, or expands or collapses
+// the group whose header it is on
+func (m AppModel) openHighlightedNamespaceRow() (tea.Model, tea.Cmd) {
+	rows := m.namespaceRows()
+	if m.selectedNamespaceIndex < 0 || m.selectedNamespaceIndex >= len(rows) {
+		return m, nil
+	}
+	row := rows[m.selectedNamespaceIndex]
+	if row.isGroup() {
+		m.toggleNamespaceGroup(row.group)
+		return m, nil
+	}
+	return m.selectNamespace(row.namespace.Name)
+}
+
+// renderNamespaceGroup renders the header of a namespace group
+func (m AppModel) renderNamespaceGroup(row namespaceRow, selected bool) string {
+	prefix := m.cursorMarker(selected) + m.favoriteMarker(false)
+	label := row.namespaceGroupLabel(m.expandedNamespaceGroups[row.group])
+	if selected {
+		return m.selectionStyle(styles.SelectedStyle).Render(prefix + label)
+	}
+	return prefix + styles.PanelTitleStyle.Render(label)
+}
@@ -0,0 +1,95 @@
+package tui
+
+import (
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/maratkarimov/kubertino/internal/config"
+	"github.com/maratkarimov/kubertino/internal/k8s"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// newNamespaceGroupsTestModel returns a model listing namespaces of two teams, grouped by prefix
+func newNamespaceGroupsTestModel(t *testing.T) AppModel {
+	t.Helper()
+	model := newTestModel(newMockAdapter(), func(cfg *config.Config) {
+		cfg.NamespaceGrouping = config.NamespaceGroupingPrefix
+		cfg.Favorites = map[string]interface{}{"test-context": []interface{}{"team-a-web"}}
+	})
+
+	updated, _ := model.Update(namespaceFetchedMsg{value: k8s.NamespacesNamed([]string{
+		"default", "kube-public", "kube-system", "team-a-api", "team-a-web", "team-b-api", "tools",
+	})})
+	return updated.(AppModel)
+}
+
+// rowLabels describes rows as namespace names, group headers as "[prefix]"
+func rowLabels(rows []namespaceRow) []string {
+	labels := make([]string, len(rows))
+	for i, row := range rows {
+		labels[i] = row.namespace.Name
+		if row.isGroup() {
+			labels[i] = "[" + row.group + "]"
+		}
+	}
+	return labels
+}
+
+func TestNamespaceRows_Grouped(t *testing.T) {
+	model := newNamespaceGroupsTestModel(t)
+
+	// The favorite stays on top; groups need two namespaces
+	assert.Equal(t, []string{"team-a-web", "default", "[kube]", "[team]", "tools"}, rowLabels(model.namespaceRows()))
+
+	model.config.NamespaceGrouping = ""
+	assert.Len(t, model.namespaceRows(), 7, "grouping is off by default")
+}
+
+func TestNamespaceGroups_EnterExpandsAndCollapses(t *testing.T) {
+	model := newNamespaceGroupsTestModel(t)
+	model.selectedNamespaceIndex = 3 // [team]
+
+	model = sendKey(model, tea.KeyMsg{Type: tea.KeyEnter})
+	assert.Empty(t, model.currentNamespace, "enter on a group does not open a namespace")
+	assert.Equal(t, []string{"team-a-web", "default", "[kube]", "[team]", "team-a-api", "team-b-api", "tools"}, rowLabels(model.namespaceRows()))
+	assert.Contains(t, model.renderNamespaceList(0), "▾ team (2)")
+	assert.Contains(t, model.renderNamespaceList(0), "▸ kube (2)")
+
+	// Open a namespace of the group
+	model = sendKey(model, tea.KeyMsg{Type: tea.KeyDown})
+	highlighted, ok := model.highlightedNamespace()
+	require.True(t, ok)
+	assert.Equal(t, "team-a-api", highlighted.Name)
+
+	model.selectedNamespaceIndex = 3
+	model = sendKey(model, tea.KeyMsg{Type: tea.KeyEnter})
+	assert.Equal(t, []string{"team-a-web", "default", "[kube]", "[team]", "tools"}, rowLabels(model.namespaceRows()))
+	assert.Equal(t, 3, model.selectedNamespaceIndex, "the cursor stays on the header")
+}
+
+func TestNamespaceGroups_SearchListsMatchesFlat(t *testing.T) {
+	model := newNamespaceGroupsTestModel(t)
+
+	model = sendKey(model, runeKey('/'))
+	for _, r := range "system" {
+		model = sendKey(model, runeKey(r))
+	}
+	assert.Equal(t, []string{"kube-system"}, rowLabels(model.namespaceRows()))
+
+	// Leaving the search expands the group of the highlighted namespace
+	model.deactivateSearch()
+	highlighted, ok := model.highlightedNamespace()
+	require.True(t, ok)
+	assert.Equal(t, "kube-system", highlighted.Name)
+	assert.True(t, model.expandedNamespaceGroups["kube"])
+}
+
+func TestNamespaceGroups_FocusNamespaceExpandsGroup(t *testing.T) {
+	model := newNamespaceGroupsTestModel(t)
+
+	require.True(t, model.focusNamespace("team-b-api"))
+	assert.Equal(t, []string{"team-a-web", "default", "[kube]", "[team]", "team-a-api", "team-b-api", "tools"}, rowLabels(model.namespaceRows()))
+	assert.Equal(t, 5, model.selectedNamespaceIndex)
+	assert.False(t, model.focusNamespace("missing"))
+}
@@ -0,0 +1,155 @@
+package tui
+
+import (
+	"fmt"
+	"log/slog"
+	"strings"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/maratkarimov/kubertino/internal/audit"
+	"github.com/maratkarimov/kubertino/internal/k8s"
+	"github.com/maratkarimov/kubertino/internal/tui/components"
+)
+
+// NamespaceMutator is implemented by adapters that can create and delete namespaces. Without
+// it, the namespace keys do nothing.
+type NamespaceMutator interface {
+	CreateNamespace(context, namespace string) error
+	DeleteNamespace(context, namespace string) error
+}
+
+// namespaceChangedMsg reports the outcome of creating or deleting a namespace
+type namespaceChangedMsg struct {
+	context   string
+	namespace string
+	verb      string // create or delete
+	err       error
+	record    audit.Record
+}
+
+// namespaceMutator returns the adapter when namespace mutations are allowed in the current
+// context. ok is false when allow_namespace_mutations is off or the adapter cannot mutate;
+// a read_only context is refused with a warning toast.
+func (m AppModel) namespaceMutator() (mutator NamespaceMutator, cmd tea.Cmd, ok bool) {
+	if m.config == nil || !m.config.AllowNamespaceMutations || m.currentContext == nil {
+		return nil, nil, false
+	}
+	mutator, ok = m.kubeAdapter.(NamespaceMutator)
+	if !ok {
+		return nil, nil, false
+	}
+	if m.currentContext.ReadOnly {
+		slog.Info("namespace change refused in read-only context", "context", m.currentContext.Name)
+		return nil, m.notify(fmt.Sprintf("Context %s is read-only", m.currentContext.Name), components.ToastWarning), false
+	}
+	return mutator, nil, true
+}
+
+// openNamespaceForm asks for the name of a namespace to create in the current context
+func (m AppModel) openNamespaceForm() (tea.Model, tea.Cmd) {
+	if _, cmd, ok := m.namespaceMutator(); !ok {
+		return m, cmd
+	}
+	slog.Debug("create namespace form opened", "context", m.currentContext.Name)
+	m.namespaceForm.SetSize(m.termWidth, m.termHeight)
+	m.namespaceForm.Show("Create namespace in "+m.currentContext.Name, []components.FormField{{Label: "Name"}})
+	return m, nil
+}
+
+// handleNamespaceFormKey handles key presses while the create namespace form is open. A
+// name that is not a valid namespace name is marked in the form.
+func (m AppModel) handleNamespaceFormKey(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	if !m.namespaceForm.HandleKeyPress(msg) {
+		return m, nil
+	}
+
+	namespace := strings.TrimSpace(m.namespaceForm.Values()[0])
+	if err := k8s.CheckNamespaceName(namespace); err != nil {
+		m.namespaceForm.Fields[0].Error = err.Error()
+		return m, nil
+	}
+	m.namespaceForm.Hide()
+	return m, m.changeNamespaceCmd("create", namespace)
+}
+
+// handleDeleteNamespace asks the user to type the name of the highlighted namespace before
+// deleting it
+func (m AppModel) handleDeleteNamespace() (tea.Model, tea.Cmd) {
+	highlighted, ok := m.highlightedNamespace()
+	if !ok {
+		return m, nil
+	}
+	if _, cmd, ok := m.namespaceMutator(); !ok {
+		return m, cmd
+	}
+
+	namespace := highlighted.Name
+	slog.Info("namespace deletion awaiting confirmation", "context", m.currentContext.Name, "namespace", namespace)
+	m.confirm.Show(
+		"Delete namespace "+namespace+"?",
+		fmt.Sprintf("This deletes namespace %s of context %s and everything in it.", namespace, m.currentContext.Name),
+		namespace,
+		func() tea.Cmd { return m.changeNamespaceCmd("delete", namespace) },
+	)
+	return m, nil
+}
+
+// changeNamespaceCmd creates or deletes namespace in the current context in the background
+func (m AppModel) changeNamespaceCmd(verb, namespace string) tea.Cmd {
+	mutator, _, ok := m.namespaceMutator()
+	if !ok {
+		return nil
+	}
+	contextName := m.currentContext.Name
+	change := mutator.CreateNamespace
+	if verb == "delete" {
+		change = mutator.DeleteNamespace
+	}
+
+	record := audit.NewRecord(audit.SourceTUI)
+	record.Context = contextName
+	record.Namespace = namespace
+	record.Kind = "namespace"
+	record.Target = namespace
+	record.Action = strings.ToUpper(verb[:1]) + verb[1:] + " Namespace"
+	record.Destructive = verb == "delete"
+	record.Command = fmt.Sprintf("kubectl --context %s %s namespace %s", contextName, verb, namespace)
+
+	slog.Info("changing namespace", "verb", verb, "context", contextName, "namespace", namespace)
+	return func() tea.Msg {
+		start := time.Now()
+		err := change(contextName, namespace)
+		record.DurationMS = time.Since(start).Milliseconds()
+		return namespaceChangedMsg{context: contextName, namespace: namespace, verb: verb, err: err, record: record}
+	}
+}
+
+// handleNamespaceChanged reports a namespace change and refetches the namespaces of its
+// context, so a created namespace appears and a deleted one shows as Terminating
+func (m AppModel) handleNamespaceChanged(msg namespaceChangedMsg) (tea.Model, tea.Cmd) {
+	if msg.err != nil {
+		msg.record.ExitCode = 1
+		msg.record.Error = msg.err.Error()
+	}
+	m.auditLog.Log(msg.record)
+
+	if msg.err != nil {
+		slog.Error("namespace change failed", "verb", msg.verb, "context", msg.context, "namespace", msg.namespace, "error", msg.err)
+		m.errorModal.Show(msg.err.Error(), msg.record.Action, nil)
+		return m, nil
+	}
+
+	slog.Info("namespace changed", "verb", msg.verb, "context", msg.context, "namespace", msg.namespace)
+	notice := "Created namespace " + msg.namespace
+	if msg.verb == "delete" {
+		notice = "Deleting namespace " + msg.namespace
+	}
+	cmds := []tea.Cmd{m.notify(notice, components.ToastSuccess)}
+
+	m.cache.InvalidateNamespaces(msg.context)
+	if m.currentContext != nil && m.currentContext.Name == msg.context && m.viewMode == viewModeNamespaceView {
+		cmds = append(cmds, m.loadNamespaces())
+	}
+	return m, tea.Batch(cmds...)
+}
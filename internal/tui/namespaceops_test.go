@@ -0,0 +1,141 @@
+package tui
+
+import (
+	"errors"
+	"slices"
+	"testing"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/maratkarimov/kubertino/internal/config"
+	"github.com/maratkarimov/kubertino/internal/k8s"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// mutatorAdapter is a mock adapter that creates and deletes namespaces in its list
+type mutatorAdapter struct {
+	*mockKubeAdapter
+	calls []string
+	err   error
+}
+
+func (a *mutatorAdapter) CreateNamespace(context, namespace string) error {
+	a.calls = append(a.calls, "create "+context+"/"+namespace)
+	if a.err != nil {
+		return a.err
+	}
+	a.namespaces = append(a.namespaces, namespace)
+	return nil
+}
+
+func (a *mutatorAdapter) DeleteNamespace(context, namespace string) error {
+	a.calls = append(a.calls, "delete "+context+"/"+namespace)
+	if a.err != nil {
+		return a.err
+	}
+	a.namespaces = slices.DeleteFunc(a.namespaces, func(ns string) bool { return ns == namespace })
+	return nil
+}
+
+// newNamespaceOpsTestModel returns a model with the namespaces panel focused, allowing
+// namespace mutations when allow is set
+func newNamespaceOpsTestModel(t *testing.T, allow bool) (AppModel, *mutatorAdapter) {
+	t.Helper()
+	adapter := &mutatorAdapter{mockKubeAdapter: newMockAdapter()}
+	model := newTestModel(adapter, func(cfg *config.Config) { cfg.AllowNamespaceMutations = allow })
+	model.statusBar.Duration = time.Millisecond // Toast ticks are run with the namespace change
+
+	updated, _ := model.Update(namespaceFetchedMsg{value: k8s.NamespacesNamed(adapter.namespaces)})
+	model = updated.(AppModel)
+	require.Equal(t, viewModeNamespaceView, model.viewMode)
+	require.Equal(t, PanelNamespaces, model.focusedPanel)
+	return model, adapter
+}
+
+func TestNamespaceOps_Create(t *testing.T) {
+	model, adapter := newNamespaceOpsTestModel(t, true)
+
+	model = sendKey(model, runeKey('N'))
+	require.True(t, model.namespaceForm.IsVisible)
+	assert.Contains(t, model.View(), "Create namespace in test-context")
+	assert.Contains(t, model.View(), "[Enter: Create]")
+
+	// An invalid name is marked in the form
+	model = typeText(model, "Team_A")
+	model = sendKey(model, tea.KeyMsg{Type: tea.KeyEnter})
+	require.True(t, model.namespaceForm.IsVisible)
+	assert.Contains(t, model.namespaceForm.Fields[0].Error, "invalid namespace name")
+	assert.Empty(t, adapter.calls)
+
+	model.namespaceForm.Fields[0].Value = ""
+	model = typeText(model, "team-a")
+	updated, cmd := model.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	model = runCmds(updated.(AppModel), cmd, namespaceChangedMsg{}, namespaceFetchedMsg{})
+
+	assert.False(t, model.namespaceForm.IsVisible)
+	assert.Equal(t, []string{"create test-context/team-a"}, adapter.calls)
+	assert.Contains(t, k8s.NamespaceNames(model.namespaces), "team-a")
+	assert.Contains(t, model.statusBar.View(120), "Created namespace team-a")
+}
+
+func TestNamespaceOps_DeleteAsksToTypeTheName(t *testing.T) {
+	model, adapter := newNamespaceOpsTestModel(t, true)
+	model.selectedNamespaceIndex = slices.Index(k8s.NamespaceNames(model.namespaces), "staging")
+
+	model = sendKey(model, runeKey('D'))
+	require.True(t, model.confirm.IsVisible)
+	assert.Contains(t, model.View(), "Delete namespace staging?")
+
+	// The wrong name does not delete
+	model = typeText(model, "stage")
+	model = sendKey(model, tea.KeyMsg{Type: tea.KeyEnter})
+	assert.Empty(t, adapter.calls)
+
+	model = sendKey(model, tea.KeyMsg{Type: tea.KeyCtrlU})
+	model = typeText(model, "staging")
+	updated, cmd := model.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	model = runCmds(updated.(AppModel), cmd, namespaceChangedMsg{}, namespaceFetchedMsg{})
+
+	assert.Equal(t, []string{"delete test-context/staging"}, adapter.calls)
+	assert.NotContains(t, k8s.NamespaceNames(model.namespaces), "staging")
+}
+
+func TestNamespaceOps_Failure(t *testing.T) {
+	model, adapter := newNamespaceOpsTestModel(t, true)
+	adapter.err = errors.New("failed to create namespace team-a: already exists")
+
+	model = sendKey(model, runeKey('N'))
+	model = typeText(model, "team-a")
+	updated, cmd := model.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	model = runCmds(updated.(AppModel), cmd, namespaceChangedMsg{}, namespaceFetchedMsg{})
+
+	assert.True(t, model.errorModal.IsVisible)
+	assert.Contains(t, model.errorModal.Message, "already exists")
+}
+
+func TestNamespaceOps_Refused(t *testing.T) {
+	t.Run("not allowed", func(t *testing.T) {
+		model, adapter := newNamespaceOpsTestModel(t, false)
+		model = sendKey(model, runeKey('N'))
+		model = sendKey(model, runeKey('D'))
+		assert.False(t, model.namespaceForm.IsVisible)
+		assert.False(t, model.confirm.IsVisible)
+		assert.Empty(t, adapter.calls)
+	})
+
+	t.Run("read-only context", func(t *testing.T) {
+		model, _ := newNamespaceOpsTestModel(t, true)
+		model.currentContext.ReadOnly = true
+		model = sendKey(model, runeKey('D'))
+		assert.False(t, model.confirm.IsVisible)
+		assert.Contains(t, model.statusBar.View(120), "Context test-context is read-only")
+	})
+
+	t.Run("pods panel", func(t *testing.T) {
+		model, _ := newNamespaceOpsTestModel(t, true)
+		model.focusedPanel = PanelPods
+		model = sendKey(model, runeKey('N'))
+		assert.False(t, model.namespaceForm.IsVisible)
+	})
+}
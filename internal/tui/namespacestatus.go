@@ -0,0 +1,16 @@
+package tui
+
+import (
+	"strings"
+
+	"github.com/maratkarimov/kubertino/internal/k8s"
+	"github.com/maratkarimov/kubertino/internal/tui/styles"
+)
+
+// namespaceStatusBadge marks a namespace that is not active, e.g. " (terminating)"
+func namespaceStatusBadge(ns k8s.Namespace) string {
+	if ns.Status == "" || ns.Status == k8s.NamespaceActive {
+		return ""
+	}
+	return styles.TerminatingNamespaceStyle.Render(" (" + strings.ToLower(ns.Status) + ")")
+}
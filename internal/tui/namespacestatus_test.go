@@ -0,0 +1,27 @@
+package tui
+
+import (
+	"testing"
+
+	"github.com/maratkarimov/kubertino/internal/k8s"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNamespaceStatusBadge(t *testing.T) {
+	assert.Empty(t, namespaceStatusBadge(k8s.Namespace{Name: "default"}), "unknown status")
+	assert.Empty(t, namespaceStatusBadge(k8s.Namespace{Name: "default", Status: k8s.NamespaceActive}))
+	assert.Contains(t, namespaceStatusBadge(k8s.Namespace{Name: "old", Status: k8s.NamespaceTerminating}), "(terminating)")
+}
+
+func TestRenderNamespaceList_TerminatingNamespace(t *testing.T) {
+	model := newTestModel(newMockAdapter())
+	model.viewMode = viewModeNamespaceView
+	model.namespaces = []k8s.Namespace{
+		{Name: "default", Status: k8s.NamespaceActive},
+		{Name: "feature-x", Status: k8s.NamespaceTerminating},
+	}
+
+	view := model.renderNamespaceList(20)
+	assert.Contains(t, view, "feature-x (terminating)")
+	assert.NotContains(t, view, "default (")
+}
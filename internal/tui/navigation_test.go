@@ -173,7 +173,7 @@ func TestPodStateReset(t *testing.T) {
 		actionSpinner:     components.NewSpinner(),
 		viewMode:          viewModeNamespaceView,
 		kubeAdapter:       adapter,
-		namespaces:        []string{"default", "kube-system"},
+		namespaces:        k8s.NamespacesNamed([]string{"default", "kube-system"}),
 		selectedPodIndex:  5,
 		podScrollOffset:   10,
 		focusedPanel:      PanelNamespaces, // Must be on namespaces to select
@@ -261,12 +261,12 @@ func TestFavoritesConfigOrder(t *testing.T) {
 	// All namespaces (includes non-favorites too)
 	namespaces := []string{"apple-ns", "banana-ns", "default", "kube-system", "zebra-ns"}
 
-	result := model.sortNamespacesWithFavorites(namespaces, favorites)
+	result := model.sortNamespacesWithFavorites(k8s.NamespacesNamed(namespaces), favorites)
 
 	// Expected: favorites in config order, then non-favorites alphabetically
 	expected := []string{"zebra-ns", "apple-ns", "banana-ns", "default", "kube-system"}
 
-	assert.Equal(t, expected, result, "Favorites should preserve config order, non-favorites alphabetically sorted")
+	assert.Equal(t, expected, k8s.NamespaceNames(result), "Favorites should preserve config order, non-favorites alphabetically sorted")
 }
 
 // Story 6.1: Test cursor visibility during scrolling
@@ -316,7 +316,7 @@ func TestNamespaceCursorVisibilityDuringScroll(t *testing.T) {
 				focusedPanel:           PanelNamespaces,
 				selectedNamespaceIndex: tt.initialIndex,
 				namespaceViewportStart: tt.initialViewport,
-				namespaces:             namespaces,
+				namespaces:             k8s.NamespacesNamed(namespaces),
 				viewMode:               viewModeNamespaceView,
 				height:                 tt.height,
 				keys:                   DefaultKeyMap(),
@@ -424,7 +424,7 @@ func TestNamespaceCursorCentering(t *testing.T) {
 				focusedPanel:           PanelNamespaces,
 				selectedNamespaceIndex: tt.initialIndex,
 				namespaceViewportStart: 0,
-				namespaces:             namespaces,
+				namespaces:             k8s.NamespacesNamed(namespaces),
 				viewMode:               viewModeNamespaceView,
 				height:                 tt.height,
 				keys:                   DefaultKeyMap(),
@@ -492,7 +492,7 @@ func TestNamespaceCursorPersistenceOnFocusChange(t *testing.T) {
 		actionSpinner:          components.NewSpinner(),
 		viewMode:               viewModeNamespaceView,
 		kubeAdapter:            adapter,
-		namespaces:             []string{"default", "kube-system", "test-ns"},
+		namespaces:             k8s.NamespacesNamed([]string{"default", "kube-system", "test-ns"}),
 		selectedNamespaceIndex: 2, // Selected "test-ns"
 		focusedPanel:           PanelNamespaces,
 		currentNamespace:       "test-ns",
@@ -551,7 +551,7 @@ func TestNamespaceCursorWrapAround(t *testing.T) {
 				focusedPanel:           PanelNamespaces,
 				selectedNamespaceIndex: tt.initialIndex,
 				namespaceViewportStart: tt.initialViewport,
-				namespaces:             namespaces,
+				namespaces:             k8s.NamespacesNamed(namespaces),
 				viewMode:               viewModeNamespaceView,
 				height:                 20,
 				keys:                   DefaultKeyMap(),
@@ -578,7 +578,7 @@ func TestNamespaceCenteringShortList(t *testing.T) {
 		focusedPanel:           PanelNamespaces,
 		selectedNamespaceIndex: 0,
 		namespaceViewportStart: 0,
-		namespaces:             namespaces,
+		namespaces:             k8s.NamespacesNamed(namespaces),
 		viewMode:               viewModeNamespaceView,
 		height:                 20, // Much larger than list
 		keys:                   DefaultKeyMap(),
@@ -617,7 +617,7 @@ func TestNamespaceLargeListBottomNavigation(t *testing.T) {
 		focusedPanel:           PanelNamespaces,
 		selectedNamespaceIndex: 0,
 		namespaceViewportStart: 0,
-		namespaces:             namespaces,
+		namespaces:             k8s.NamespacesNamed(namespaces),
 		viewMode:               viewModeNamespaceView,
 		termHeight:             termHeight, // CRITICAL: Use termHeight, not height
 		keys:                   DefaultKeyMap(),
@@ -0,0 +1,225 @@
+package tui
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"strconv"
+	"strings"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/maratkarimov/kubertino/internal/k8s"
+	"github.com/maratkarimov/kubertino/internal/tui/styles"
+)
+
+// ServiceLister is implemented by adapters that can list the services of a namespace.
+// Without it, the network panel shows the pod IP and ports only.
+type ServiceLister interface {
+	GetServices(context, namespace string) ([]k8s.Service, error)
+}
+
+// servicesFetchedMsg is sent when the services of the network panel's namespace are listed
+type servicesFetchedMsg = resultMsg[[]k8s.Service]
+
+// networkRow is one copyable line of the network panel
+type networkRow struct {
+	label  string
+	value  string // Copied with Enter or c
+	detail string // Shown dimmed after the value
+}
+
+// openNetwork shows the IP, DNS names and ports of the selected pod and looks up the
+// services selecting it
+func (m AppModel) openNetwork() (tea.Model, tea.Cmd) {
+	if m.currentContext == nil {
+		return m, nil
+	}
+
+	pod, ok := m.selectedPod()
+	if !ok {
+		m.errorModal.ShowWithSuggestion(
+			"No pod selected",
+			"Network",
+			"Press Tab to focus pod panel, then use arrow keys to select a pod",
+			nil,
+		)
+		return m, nil
+	}
+
+	m.viewMode = viewModeNetwork
+	m.networkPod = pod
+	m.networkServices = nil
+	m.networkHostname = ""
+	m.networkError = nil
+	m.networkMessage = ""
+	m.networkIndex = 0
+
+	lister, ok := m.kubeAdapter.(ServiceLister)
+	if !ok {
+		return m, nil
+	}
+	m.networkLoading = true
+	contextName, namespace := m.currentContext.Name, m.currentNamespace
+	return m, fetchCmd(m.requests, asyncNetwork, "", func(context.Context) ([]k8s.Service, error) {
+		services, err := lister.GetServices(contextName, namespace)
+		if err != nil {
+			slog.Error("service lookup failed", "namespace", namespace, "error", err)
+		}
+		return services, err
+	})
+}
+
+// handleServicesFetched keeps the services selecting the pod if the panel is still open
+func (m AppModel) handleServicesFetched(msg servicesFetchedMsg) (tea.Model, tea.Cmd) {
+	if !m.requests.current(msg.asyncRequest) || m.viewMode != viewModeNetwork {
+		return m, nil
+	}
+
+	m.networkLoading = false
+	m.networkError = msg.err
+	m.networkServices = k8s.ServicesForPod(msg.value, m.networkPod)
+	// A headless service named by the pod's subdomain gives it a stable DNS name
+	m.networkHostname = k8s.PodHostnameDNSName(m.networkPod, m.currentNamespace, msg.value)
+	return m, nil
+}
+
+// networkRows lists the copyable values of the network panel: pod IP and DNS names, the
+// container ports on the pod IP and each port of the services selecting the pod
+func (m AppModel) networkRows() []networkRow {
+	pod, namespace := m.networkPod, m.currentNamespace
+	var rows []networkRow
+
+	if pod.IP != "" {
+		rows = append(rows, networkRow{label: "Pod IP", value: pod.IP})
+		rows = append(rows, networkRow{label: "Pod DNS", value: k8s.PodDNSName(pod, namespace)})
+	}
+	if m.networkHostname != "" {
+		rows = append(rows, networkRow{label: "Hostname", value: m.networkHostname})
+	}
+	for _, port := range pod.Ports {
+		value := strconv.Itoa(port)
+		if pod.IP != "" {
+			value = pod.IP + ":" + value
+		}
+		rows = append(rows, networkRow{label: "Port", value: value})
+	}
+
+	for _, service := range m.networkServices {
+		label := "Service " + service.Name
+		dnsName := service.DNSName(namespace)
+		if len(service.Ports) == 0 {
+			rows = append(rows, networkRow{label: label, value: dnsName})
+			continue
+		}
+		for _, port := range service.Ports {
+			var details []string
+			if port.TargetPort != "" && port.TargetPort != strconv.Itoa(port.Port) {
+				details = append(details, "→ "+port.TargetPort)
+			}
+			if port.Name != "" {
+				details = append(details, port.Name)
+			}
+			if service.Headless() {
+				details = append(details, "headless")
+			}
+			rows = append(rows, networkRow{
+				label:  label,
+				value:  dnsName + ":" + strconv.Itoa(port.Port),
+				detail: strings.Join(details, " "),
+			})
+		}
+	}
+	return rows
+}
+
+// handleNetworkKey handles key presses while the network panel is open
+func (m AppModel) handleNetworkKey(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	rows := m.networkRows()
+
+	switch {
+	case msg.Type == tea.KeyCtrlC:
+		return m.quit()
+
+	case msg.Type == tea.KeyEsc || msg.String() == "q" || KeyMatches(msg, m.keys.Network):
+		m.viewMode = viewModeNamespaceView
+		return m, nil
+
+	case KeyMatches(msg, m.keys.Up):
+		if m.networkIndex > 0 {
+			m.networkIndex--
+		}
+
+	case KeyMatches(msg, m.keys.Down):
+		if m.networkIndex < len(rows)-1 {
+			m.networkIndex++
+		}
+
+	case msg.Type == tea.KeyEnter || msg.String() == "c":
+		if m.networkIndex >= len(rows) {
+			return m, nil
+		}
+		value := rows[m.networkIndex].value
+		if err := copyToClipboard(value); err != nil {
+			slog.Warn("clipboard copy failed", "error", err)
+			m.networkMessage = fmt.Sprintf("Copy failed: %v", err)
+			return m, nil
+		}
+		m.networkMessage = "Copied " + value
+	}
+
+	return m, nil
+}
+
+// renderNetwork renders the network panel as a centered dialog
+func (m AppModel) renderNetwork() string {
+	var content string
+	content += styles.TitleStyle.Render("Network") + "\n"
+	content += styles.DimStyle.Render("Pod: "+m.networkPod.Name) + "\n\n"
+
+	rows := m.networkRows()
+	if m.networkPod.IP == "" {
+		content += styles.PlaceholderStyle.Render("No pod IP assigned yet") + "\n"
+	}
+	for i, row := range rows {
+		line := fmt.Sprintf("%-22s %s", row.label, row.value)
+		if row.detail != "" {
+			line += " " + styles.DimStyle.Render(row.detail)
+		}
+		if i == m.networkIndex {
+			content += m.selectionStyle(styles.SelectedStyle).Render(m.cursorMarker(true)+line) + "\n"
+		} else {
+			content += styles.NormalStyle.Render(m.cursorMarker(false)+line) + "\n"
+		}
+	}
+
+	switch {
+	case m.networkLoading:
+		content += "\n" + styles.LoadingStyle.Render("Looking up services...") + "\n"
+	case m.networkError != nil:
+		content += "\n" + styles.ErrorStyle.Render(fmt.Sprintf("Services unavailable: %v", m.networkError)) + "\n"
+	case len(m.networkServices) == 0:
+		if _, ok := m.kubeAdapter.(ServiceLister); ok {
+			content += "\n" + styles.PlaceholderStyle.Render("No services select this pod") + "\n"
+		}
+	}
+
+	if m.networkMessage != "" {
+		content += "\n" + styles.WarningStyle.Render(m.networkMessage) + "\n"
+	}
+
+	content += "\n" + styles.DimStyle.Render("↑/↓: Navigate | Enter/c: Copy | ESC/q: Back")
+
+	dialogStyle := lipgloss.NewStyle().
+		Border(lipgloss.RoundedBorder()).
+		BorderForeground(lipgloss.Color("39")). // Bright cyan
+		Padding(1, 2)
+
+	return lipgloss.Place(
+		m.termWidth,
+		m.termHeight,
+		lipgloss.Center,
+		lipgloss.Center,
+		dialogStyle.Render(content),
+	)
+}
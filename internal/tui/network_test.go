@@ -0,0 +1,115 @@
+package tui
+
+import (
+	"errors"
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/maratkarimov/kubertino/internal/k8s"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// serviceAdapter is a mock adapter that also lists services
+type serviceAdapter struct {
+	*mockKubeAdapter
+	services []k8s.Service
+	err      error
+}
+
+func (a *serviceAdapter) GetServices(context, namespace string) ([]k8s.Service, error) {
+	return a.services, a.err
+}
+
+func newNetworkTestModel(adapter KubeAdapter, pod k8s.Pod) AppModel {
+	model := newTestModel(adapter)
+	model.currentNamespace = "shop"
+	model.pods = []k8s.Pod{pod}
+	model.focusedPanel = PanelPods
+	model.selectedPodIndex = 0
+	return model
+}
+
+// openNetworkPanel presses the network key and delivers the service lookup, if any
+func openNetworkPanel(t *testing.T, model AppModel) AppModel {
+	t.Helper()
+	updated, cmd := model.Update(runeKey('i'))
+	model = updated.(AppModel)
+	require.Equal(t, viewModeNetwork, model.viewMode)
+	if cmd == nil {
+		return model
+	}
+	assert.Contains(t, model.View(), "Looking up services")
+	updated, _ = model.Update(cmd())
+	return updated.(AppModel)
+}
+
+func TestNetwork_ShowsIPDNSAndPorts(t *testing.T) {
+	adapter := &serviceAdapter{mockKubeAdapter: newMockAdapter(), services: []k8s.Service{
+		{Name: "web", ClusterIP: "10.96.0.12", Selector: map[string]string{"app": "web"}, Ports: []k8s.ServicePort{{Name: "http", Port: 80, TargetPort: "8080"}}},
+		{Name: "db", ClusterIP: "None", Selector: map[string]string{"app": "db"}},
+	}}
+	pod := k8s.Pod{Name: "web-1", Status: "Running", IP: "10.244.1.7", Ports: []int{8080}, Labels: map[string]string{"app": "web"}}
+	model := openNetworkPanel(t, newNetworkTestModel(adapter, pod))
+
+	values := []string{}
+	for _, row := range model.networkRows() {
+		values = append(values, row.value)
+	}
+	assert.Equal(t, []string{
+		"10.244.1.7",
+		"10-244-1-7.shop.pod.cluster.local",
+		"10.244.1.7:8080",
+		"web.shop.svc.cluster.local:80",
+	}, values)
+
+	view := model.View()
+	assert.Contains(t, view, "Service web")
+	assert.Contains(t, view, "→ 8080 http")
+	assert.NotContains(t, view, "db.shop")
+
+	model = sendKey(model, tea.KeyMsg{Type: tea.KeyEsc})
+	assert.Equal(t, viewModeNamespaceView, model.viewMode)
+}
+
+func TestNetwork_HeadlessHostname(t *testing.T) {
+	adapter := &serviceAdapter{mockKubeAdapter: newMockAdapter(), services: []k8s.Service{
+		{Name: "db", ClusterIP: "None", Selector: map[string]string{"app": "db"}, Ports: []k8s.ServicePort{{Port: 5432}}},
+	}}
+	pod := k8s.Pod{Name: "db-0", Status: "Running", IP: "10.244.2.3", Subdomain: "db", Labels: map[string]string{"app": "db"}}
+	model := openNetworkPanel(t, newNetworkTestModel(adapter, pod))
+
+	view := model.View()
+	assert.Contains(t, view, "db-0.db.shop.svc.cluster.local")
+	assert.Contains(t, view, "db.shop.svc.cluster.local:5432 headless")
+}
+
+func TestNetwork_CopySelectedRow(t *testing.T) {
+	copied := stubClipboard(t, nil)
+	pod := k8s.Pod{Name: "web-1", Status: "Running", IP: "10.244.1.7", Ports: []int{8080}}
+	model := openNetworkPanel(t, newNetworkTestModel(newMockAdapter(), pod))
+
+	model = sendKey(model, runeKey('j'))
+	model = sendKey(model, runeKey('j'))
+	model = sendKey(model, tea.KeyMsg{Type: tea.KeyEnter})
+	assert.Equal(t, []string{"10.244.1.7:8080"}, *copied)
+	assert.Contains(t, model.View(), "Copied 10.244.1.7:8080")
+
+	model = sendKey(model, runeKey('k'))
+	model = sendKey(model, runeKey('c'))
+	assert.Equal(t, "10-244-1-7.shop.pod.cluster.local", (*copied)[1])
+}
+
+func TestNetwork_WithoutIPOrServices(t *testing.T) {
+	adapter := &serviceAdapter{mockKubeAdapter: newMockAdapter(), err: errors.New("forbidden")}
+	model := openNetworkPanel(t, newNetworkTestModel(adapter, k8s.Pod{Name: "pending-1", Status: "Pending"}))
+
+	view := model.View()
+	assert.Contains(t, view, "No pod IP assigned yet")
+	assert.Contains(t, view, "Services unavailable: forbidden")
+	assert.Empty(t, model.networkRows())
+
+	// Copy with nothing to copy is a no-op
+	model = sendKey(model, tea.KeyMsg{Type: tea.KeyEnter})
+	assert.Equal(t, viewModeNetwork, model.viewMode)
+}
@@ -0,0 +1,101 @@
+package tui
+
+import (
+	"fmt"
+	"log/slog"
+	"os"
+	"os/exec"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/maratkarimov/kubertino/internal/audit"
+	"github.com/maratkarimov/kubertino/internal/executor"
+	"github.com/maratkarimov/kubertino/internal/tui/components"
+)
+
+// NodeShellOpener is implemented by adapters that can open a shell on a node. Without it,
+// the node shell key does nothing.
+type NodeShellOpener interface {
+	NodeShellCommand(context, node, image string) (*exec.Cmd, error)
+}
+
+// nodeShellExitedMsg is sent when the node shell has exited and the TUI is back
+type nodeShellExitedMsg struct {
+	node   string
+	err    error
+	stderr string // Why kubectl could not start the debug pod
+	record audit.Record
+}
+
+// openNodeShell runs kubectl debug node on the node of the selected pod, handing it the
+// terminal. read_only contexts refuse it: the debug pod has access to the node's filesystem.
+func (m AppModel) openNodeShell() (tea.Model, tea.Cmd) {
+	opener, ok := m.kubeAdapter.(NodeShellOpener)
+	if !ok || m.currentContext == nil {
+		return m, nil
+	}
+	pod, ok := m.selectedPod()
+	if !ok {
+		return m, nil
+	}
+	if pod.Node == "" {
+		return m, m.notify(fmt.Sprintf("%s is not scheduled on a node yet", pod.Name), components.ToastWarning)
+	}
+	if m.currentContext.ReadOnly {
+		slog.Info("node shell refused in read-only context", "context", m.currentContext.Name)
+		m.errorModal.ShowWithSuggestion(
+			fmt.Sprintf("Node shell is disabled: context %s is read-only", m.currentContext.Name),
+			"Node Shell",
+			"The debug pod can read and change the node's files; remove read_only from the context to use it",
+			nil,
+		)
+		return m, nil
+	}
+
+	image := m.config.NodeDebugImage()
+	cmd, err := opener.NodeShellCommand(m.currentContext.Name, pod.Node, image)
+	if err != nil {
+		return m, m.notify("Node shell failed: "+err.Error(), components.ToastError)
+	}
+
+	record := audit.NewRecord(audit.SourceTUI)
+	record.Context = m.currentContext.Name
+	record.Namespace = m.currentNamespace
+	record.Kind = "node"
+	record.Target = pod.Node
+	record.Action = "Node Shell"
+	record.Command = fmt.Sprintf("kubectl --context %s debug node/%s -it --image=%s", m.currentContext.Name, pod.Node, image)
+
+	slog.Info("opening node shell", "context", record.Context, "node", pod.Node, "pod", pod.Name, "image", image)
+	// stderr still goes to the terminal, and is kept to explain a failure afterwards
+	cmd.Stderr = os.Stderr
+	run := executor.TrackRun(cmd)
+	node := pod.Node
+	return m, tea.ExecProcess(cmd, func(err error) tea.Msg {
+		run.Finish()
+		record.DurationMS = run.Duration().Milliseconds()
+		stderr, _ := run.Stderr()
+		return nodeShellExitedMsg{node: node, err: err, stderr: stderr, record: record}
+	})
+}
+
+// handleNodeShellExited records the node shell and reports a failure. The terminal may have
+// been resized while the shell owned it, so its size is queried again.
+func (m AppModel) handleNodeShellExited(msg nodeShellExitedMsg) (tea.Model, tea.Cmd) {
+	if msg.err == nil {
+		m.auditLog.Log(msg.record)
+		return m, tea.Batch(m.notify(fmt.Sprintf("Node shell on %s closed (kubectl keeps the debug pod)", msg.node), components.ToastInfo), windowSizeCmd)
+	}
+
+	reason := msg.err.Error()
+	if line := lastLine(msg.stderr); line != "" {
+		reason = line
+	}
+	msg.record.ExitCode = 1
+	if exitErr, ok := msg.err.(*exec.ExitError); ok {
+		msg.record.ExitCode = exitErr.ExitCode()
+	}
+	msg.record.Error = reason
+	m.auditLog.Log(msg.record)
+	slog.Error("node shell failed", "node", msg.node, "error", msg.err, "stderr", msg.stderr)
+	return m, tea.Batch(m.notify(fmt.Sprintf("Node shell on %s failed: %s", msg.node, reason), components.ToastError), windowSizeCmd)
+}
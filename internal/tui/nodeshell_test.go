@@ -0,0 +1,73 @@
+package tui
+
+import (
+	"errors"
+	"os/exec"
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/maratkarimov/kubertino/internal/config"
+	"github.com/maratkarimov/kubertino/internal/k8s"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// nodeShellAdapter is a mock adapter that also opens node shells
+type nodeShellAdapter struct {
+	*mockKubeAdapter
+	node, image string // Of the last node shell
+}
+
+func (a *nodeShellAdapter) NodeShellCommand(context, node, image string) (*exec.Cmd, error) {
+	a.node, a.image = node, image
+	return exec.Command("true"), nil
+}
+
+func newNodeShellTestModel(adapter *nodeShellAdapter, opts ...testModelOption) AppModel {
+	adapter.mockKubeAdapter = newMockAdapter()
+	model := newTestModel(adapter, opts...)
+	updated, _ := model.Update(tea.WindowSizeMsg{Width: 120, Height: 30})
+	model = updated.(AppModel)
+	model.currentNamespace = "default"
+	model.pods = []k8s.Pod{{Name: "web-1", Status: "Running", Node: "worker-1"}, {Name: "web-2", Status: "Pending"}}
+	model.focusedPanel = PanelPods
+	model.selectedPodIndex = 0
+	return model
+}
+
+func TestNodeShell(t *testing.T) {
+	adapter := &nodeShellAdapter{}
+	model := newNodeShellTestModel(adapter, withContexts(config.Context{Name: "dev"}),
+		func(cfg *config.Config) { cfg.NodeShellImage = "nicolaka/netshoot" })
+
+	_, cmd := model.Update(runeKey('S'))
+	require.NotNil(t, cmd)
+	assert.Equal(t, "worker-1", adapter.node)
+	assert.Equal(t, "nicolaka/netshoot", adapter.image)
+
+	updated, _ := model.Update(nodeShellExitedMsg{node: "worker-1", err: errors.New("exit status 1"), stderr: "error: nodes \"worker-1\" is forbidden\n"})
+	model = updated.(AppModel)
+	assert.Contains(t, model.View(), `Node shell on worker-1 failed: error: nodes "worker-1" is forbidden`)
+}
+
+func TestNodeShell_Refused(t *testing.T) {
+	t.Run("unscheduled pod", func(t *testing.T) {
+		adapter := &nodeShellAdapter{}
+		model := newNodeShellTestModel(adapter, withContexts(config.Context{Name: "dev"}))
+		model.selectedPodIndex = 1
+
+		updated, _ := model.Update(runeKey('S'))
+		assert.Empty(t, adapter.node)
+		assert.Contains(t, updated.(AppModel).View(), "web-2 is not scheduled on a node yet")
+	})
+
+	t.Run("read-only context", func(t *testing.T) {
+		adapter := &nodeShellAdapter{}
+		model := newNodeShellTestModel(adapter, withContexts(config.Context{Name: "prod", ReadOnly: true}))
+
+		updated, cmd := model.Update(runeKey('S'))
+		assert.Nil(t, cmd)
+		assert.Empty(t, adapter.node)
+		assert.True(t, updated.(AppModel).errorModal.IsVisible)
+	})
+}
@@ -0,0 +1,194 @@
+package tui
+
+import (
+	"fmt"
+	"log/slog"
+	"os"
+	"os/exec"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/maratkarimov/kubertino/internal/audit"
+	"github.com/maratkarimov/kubertino/internal/config"
+	"github.com/maratkarimov/kubertino/internal/executor"
+	"github.com/maratkarimov/kubertino/internal/k8s"
+	"github.com/maratkarimov/kubertino/internal/tui/components"
+)
+
+// captureInterval is how often captured output is indexed while the viewer is open
+const captureInterval = 200 * time.Millisecond
+
+// outputCapture is an action running in the background (output: capture) whose output is
+// streamed to a temporary file and paged from there, so huge outputs are never buffered
+type outputCapture struct {
+	id      int
+	title   string
+	path    string
+	cmd     *exec.Cmd
+	lines   *components.FileLines
+	running bool
+}
+
+// captureStartedMsg is sent once a captured action has been started (or failed to start)
+type captureStartedMsg struct {
+	title  string
+	path   string
+	cmd    *exec.Cmd
+	record audit.Record
+	err    error
+}
+
+// captureFinishedMsg is sent when the command of a captured action exits
+type captureFinishedMsg struct {
+	id     int
+	err    error
+	record audit.Record
+}
+
+// captureTickMsg indexes newly written output of the capture with the given id
+type captureTickMsg int
+
+// captureTickCmd schedules the next indexing of the capture with the given id
+func captureTickCmd(id int) tea.Cmd {
+	return tea.Tick(captureInterval, func(time.Time) tea.Msg {
+		return captureTickMsg(id)
+	})
+}
+
+// startCaptureCmd returns a command starting the command of record in the background with
+// its output written to a temporary file
+func (m AppModel) startCaptureCmd(action config.Action, resource k8s.Resource, record audit.Record) tea.Cmd {
+	kubeconfig := m.config.KubeconfigPath(record.Context)
+	target := resource.Name
+	if target == "" {
+		target = targetPath(record.Context, record.Namespace)
+	}
+	title := fmt.Sprintf("%s: %s", action.Name, target)
+
+	return func() tea.Msg {
+		file, err := os.CreateTemp("", "kubertino-output-*.log")
+		if err != nil {
+			return captureStartedMsg{record: record, err: fmt.Errorf("failed to create output file: %w", err)}
+		}
+		// The command writes through its own copy of the descriptor
+		defer file.Close()
+
+		cmd := m.executor.PrepareCaptureRendered(record.Command, kubeconfig, file)
+		if err := cmd.Start(); err != nil {
+			os.Remove(file.Name())
+			return captureStartedMsg{record: record, err: err}
+		}
+
+		slog.Info("capturing action output", "action", action.Name, "target", resource.Name, "path", file.Name())
+		return captureStartedMsg{title: title, path: file.Name(), cmd: cmd, record: record}
+	}
+}
+
+// waitCaptureCmd waits for the command of the capture with the given id to exit
+func waitCaptureCmd(id int, cmd *exec.Cmd, record audit.Record) tea.Cmd {
+	return func() tea.Msg {
+		return captureFinishedMsg{id: id, err: cmd.Wait(), record: record}
+	}
+}
+
+// handleCaptureStarted opens the output viewer on a started capture
+func (m AppModel) handleCaptureStarted(msg captureStartedMsg) (tea.Model, tea.Cmd) {
+	m.lastCommand = msg.record.Command
+	if msg.err != nil {
+		m.recordAction(msg.record.Finish(msg.err))
+		m.errorModal.Show(fmt.Sprintf("Action failed: %s", msg.err.Error()), "Execute Action", nil)
+		return m, nil
+	}
+
+	lines, err := components.OpenFileLines(msg.path)
+	if err != nil {
+		// The command keeps running unobserved; its exit is still audited
+		slog.Error("failed to open captured output", "path", msg.path, "error", err)
+		os.Remove(msg.path)
+		m.errorModal.Show(err.Error(), "Execute Action", nil)
+		return m, waitCaptureCmd(0, msg.cmd, msg.record)
+	}
+
+	m.captureSeq++
+	m.capture = &outputCapture{id: m.captureSeq, title: msg.title, path: msg.path, cmd: msg.cmd, lines: lines, running: true}
+	m.viewMode = viewModeOutput
+	m.pager.SetSize(m.termWidth, m.termHeight)
+	m.pager.ShowSource(msg.title, lines)
+	m.pager.Status = "Running"
+	return m, tea.Batch(waitCaptureCmd(m.captureSeq, msg.cmd, msg.record), captureTickCmd(m.captureSeq))
+}
+
+// handleCaptureFinished audits the finished command and shows its outcome in the viewer
+func (m AppModel) handleCaptureFinished(msg captureFinishedMsg) (tea.Model, tea.Cmd) {
+	record := msg.record.Finish(msg.err)
+	m.recordAction(record)
+	if m.capture == nil || m.capture.id != msg.id {
+		return m, nil
+	}
+
+	m.capture.running = false
+	m.pager.Status = captureStatus(record)
+	cmd, _ := m.startFollowUp(record, msg.err != nil)
+	return m, cmd
+}
+
+// captureStatus summarizes how the command of a finished record exited, e.g.
+// "Failed (exit 3, 1.2s)". Commands that did not exit on their own (e.g. killed) show the error.
+func captureStatus(record audit.Record) string {
+	duration := (time.Duration(record.DurationMS) * time.Millisecond).Round(100 * time.Millisecond)
+	switch {
+	case record.Error == "":
+		return fmt.Sprintf("Done (exit 0, %s)", duration)
+	case record.ExitCode >= 0:
+		return fmt.Sprintf("Failed (exit %d, %s)", record.ExitCode, duration)
+	}
+	return "Failed: " + record.Error
+}
+
+// handleCaptureTick indexes new output and keeps ticking until the command has exited and
+// all of its output is indexed
+func (m AppModel) handleCaptureTick(msg captureTickMsg) (tea.Model, tea.Cmd) {
+	if m.capture == nil || m.capture.id != int(msg) {
+		return m, nil
+	}
+
+	more, err := m.capture.lines.Refresh()
+	if err != nil {
+		slog.Warn("failed to index captured output", "path", m.capture.path, "error", err)
+	}
+	m.pager.Refresh()
+	if m.capture.running || more {
+		return m, captureTickCmd(m.capture.id)
+	}
+	return m, nil
+}
+
+// handleOutputKey handles key presses while the output viewer is open
+func (m AppModel) handleOutputKey(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	if msg.Type == tea.KeyCtrlC {
+		return m.quit()
+	}
+
+	m.pager.HandleKeyPress(msg)
+	if !m.pager.IsVisible {
+		m.capture.close()
+		m.capture = nil
+		m.viewMode = viewModeNamespaceView
+	}
+	return m, nil
+}
+
+// close stops the command if it is still running and removes the captured output
+func (c *outputCapture) close() {
+	if c == nil {
+		return
+	}
+	if c.running {
+		slog.Info("stopping captured action", "path", c.path)
+		executor.StopProcess(c.cmd)
+	}
+	c.lines.Close()
+	if err := os.Remove(c.path); err != nil {
+		slog.Warn("failed to remove captured output", "path", c.path, "error", err)
+	}
+}
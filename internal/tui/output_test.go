@@ -0,0 +1,81 @@
+package tui
+
+import (
+	"os"
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/maratkarimov/kubertino/internal/audit"
+	"github.com/maratkarimov/kubertino/internal/config"
+	"github.com/maratkarimov/kubertino/internal/k8s"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// startCapture runs the action with the given shortcut and delivers the started capture
+func startCapture(t *testing.T, command string) (AppModel, captureStartedMsg) {
+	t.Helper()
+	model := newTestModel(newMockAdapter(), withContexts(config.Context{Name: "dev"}),
+		withActions(config.Action{Name: "Dump", Shortcut: "o", Command: command, Output: config.ActionOutputCapture}))
+	model.currentNamespace = "app"
+	model.pods = []k8s.Pod{{Name: "web-1", Status: "Running"}}
+	model.selectedPodIndex = 0
+	model.focusedPanel = PanelPods
+
+	_, cmd := model.Update(runeKey('o'))
+	require.NotNil(t, cmd)
+	started, ok := cmd().(captureStartedMsg)
+	require.True(t, ok, "captured actions start in the background instead of taking over the terminal")
+	require.NoError(t, started.err)
+
+	updated, _ := model.Update(started)
+	model = updated.(AppModel)
+	require.Equal(t, viewModeOutput, model.viewMode)
+	return model, started
+}
+
+func TestCapture_PagesOutput(t *testing.T) {
+	model, started := startCapture(t, "echo pod={{.pod}}; echo oops >&2; exit 3")
+	assert.Contains(t, model.View(), "Dump: web-1")
+	assert.Contains(t, model.View(), "Running")
+
+	updated, _ := model.Update(captureFinishedMsg{id: model.capture.id, err: started.cmd.Wait(), record: started.record})
+	model = updated.(AppModel)
+	updated, cmd := model.Update(captureTickMsg(model.capture.id))
+	model = updated.(AppModel)
+	assert.Nil(t, cmd, "indexing stops once the command exited and all output is read")
+
+	view := model.View()
+	assert.Contains(t, view, "pod=web-1")
+	assert.Contains(t, view, "oops")
+	assert.Contains(t, view, "Failed (exit 3, ")
+
+	updated, _ = model.Update(tea.KeyMsg{Type: tea.KeyEsc})
+	model = updated.(AppModel)
+	assert.Equal(t, viewModeNamespaceView, model.viewMode)
+	assert.Nil(t, model.capture)
+	_, err := os.Stat(started.path)
+	assert.True(t, os.IsNotExist(err), "captured output is removed when the viewer closes")
+}
+
+func TestCaptureStatus(t *testing.T) {
+	assert.Equal(t, "Done (exit 0, 1.2s)", captureStatus(audit.Record{DurationMS: 1234}))
+	assert.Equal(t, "Failed (exit 2, 300ms)", captureStatus(audit.Record{DurationMS: 310, Error: "exit status 2", ExitCode: 2}))
+	assert.Equal(t, "Failed: signal: killed", captureStatus(audit.Record{Error: "signal: killed", ExitCode: -1}))
+}
+
+func TestCapture_CloseStopsRunningCommand(t *testing.T) {
+	model, started := startCapture(t, "sleep 30")
+	// Reaped in the background, as waitCaptureCmd does
+	exited := make(chan error, 1)
+	go func() { exited <- started.cmd.Wait() }()
+
+	updated, _ := model.Update(runeKey('q'))
+	model = updated.(AppModel)
+	assert.Equal(t, viewModeNamespaceView, model.viewMode)
+	assert.Error(t, <-exited, "the command is killed")
+
+	// The late exit is still handled without a viewer
+	updated, _ = model.Update(captureFinishedMsg{id: 1, record: started.record})
+	assert.Equal(t, viewModeNamespaceView, updated.(AppModel).viewMode)
+}
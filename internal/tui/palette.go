@@ -0,0 +1,263 @@
+package tui
+
+import (
+	"fmt"
+	"log/slog"
+	"unicode"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/maratkarimov/kubertino/internal/k8s"
+	"github.com/maratkarimov/kubertino/internal/search"
+	"github.com/maratkarimov/kubertino/internal/tui/components"
+	"github.com/maratkarimov/kubertino/internal/tui/styles"
+)
+
+// paletteMaxRows is the number of entries shown in the command palette at once
+const paletteMaxRows = 12
+
+// paletteKind is the kind of item a command palette entry refers to
+type paletteKind string
+
+const (
+	paletteContext   paletteKind = "context"
+	paletteNamespace paletteKind = "namespace"
+	palettePod       paletteKind = "pod"
+	paletteAction    paletteKind = "action"
+)
+
+// paletteEntry is a searchable command palette item
+type paletteEntry struct {
+	kind      paletteKind
+	name      string
+	index     int    // Position in m.contexts, m.namespaces, m.pods or m.actions
+	namespace string // Namespace to open in context index, for namespaces of other contexts
+}
+
+// paletteEntries lists contexts, the loaded namespaces, pods of the current namespace,
+// actions of the current context and the known namespaces of other contexts
+func (m AppModel) paletteEntries() []paletteEntry {
+	var entries []paletteEntry
+	for i, ctx := range m.contexts {
+		entries = append(entries, paletteEntry{kind: paletteContext, name: ctx.Name, index: i})
+	}
+	if m.currentContext == nil {
+		return append(entries, m.otherNamespaceEntries()...)
+	}
+
+	for i, ns := range m.namespaces {
+		entries = append(entries, paletteEntry{kind: paletteNamespace, name: ns.Name, index: i})
+	}
+	for i, pod := range m.statusPods() {
+		entries = append(entries, paletteEntry{kind: palettePod, name: pod.Name, index: i})
+	}
+	for i, action := range m.actions {
+		entries = append(entries, paletteEntry{kind: paletteAction, name: action.Name, index: i})
+	}
+	return append(entries, m.otherNamespaceEntries()...)
+}
+
+// otherNamespaceEntries lists the namespaces of contexts other than the current one as
+// "context/namespace", for those whose namespaces are known: visited, cached or prefetched
+func (m AppModel) otherNamespaceEntries() []paletteEntry {
+	var entries []paletteEntry
+	for i, ctx := range m.contexts {
+		if m.currentContext != nil && ctx.Name == m.currentContext.Name {
+			continue
+		}
+		for _, ns := range m.knownNamespaces(ctx.Name) {
+			entries = append(entries, paletteEntry{kind: paletteNamespace, name: ctx.Name + "/" + ns.Name, index: i, namespace: ns.Name})
+		}
+	}
+	return entries
+}
+
+// knownNamespaces returns the namespaces of a context without fetching them: those of a
+// visited context, else the cached ones (even when stale)
+func (m AppModel) knownNamespaces(contextName string) []k8s.Namespace {
+	if snapshot, ok := m.contextCache[contextName]; ok {
+		return snapshot.namespaces
+	}
+	namespaces, _, _ := m.cache.Namespaces(contextName)
+	return namespaces
+}
+
+// paletteMatches returns the entries matching the palette query, best match first
+func (m AppModel) paletteMatches() []paletteEntry {
+	entries := m.paletteEntries()
+	names := make([]string, len(entries))
+	for i, entry := range entries {
+		names[i] = entry.name
+	}
+
+	// Terms may match different parts of "context/namespace" entries
+	matches := search.FuzzyMatchTerms(m.paletteQuery, names)
+	result := make([]paletteEntry, len(matches))
+	for i, match := range matches {
+		result[i] = entries[match.Index]
+	}
+	return result
+}
+
+// openPalette shows the command palette over the current view
+func (m *AppModel) openPalette() {
+	slog.Debug("command palette opened")
+	m.paletteReturnMode = m.viewMode
+	m.viewMode = viewModePalette
+	m.paletteQuery = ""
+	m.paletteIndex = 0
+}
+
+// closePalette returns to the view the palette was opened from
+func (m *AppModel) closePalette() {
+	m.viewMode = m.paletteReturnMode
+	m.paletteQuery = ""
+}
+
+// handlePaletteKey handles key presses while the command palette is open
+func (m AppModel) handlePaletteKey(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	matches := m.paletteMatches()
+
+	switch msg.Type {
+	case tea.KeyCtrlC:
+		return m, tea.Quit
+	case tea.KeyEsc:
+		m.closePalette()
+	case tea.KeyUp:
+		if m.paletteIndex > 0 {
+			m.paletteIndex--
+		}
+	case tea.KeyDown:
+		if m.paletteIndex < len(matches)-1 {
+			m.paletteIndex++
+		}
+	case tea.KeyEnter:
+		if m.paletteIndex < len(matches) {
+			m.closePalette()
+			return m.runPaletteEntry(matches[m.paletteIndex])
+		}
+	case tea.KeyBackspace:
+		if len(m.paletteQuery) > 0 {
+			m.paletteQuery = m.paletteQuery[:len(m.paletteQuery)-1]
+			m.paletteIndex = 0
+		}
+	case tea.KeyRunes, tea.KeySpace:
+		for _, r := range msg.Runes {
+			if unicode.IsPrint(r) {
+				m.paletteQuery += string(r)
+			}
+		}
+		m.paletteIndex = 0
+	}
+	return m, nil
+}
+
+// runPaletteEntry navigates to the selected context, namespace or pod, or executes the action
+func (m AppModel) runPaletteEntry(entry paletteEntry) (tea.Model, tea.Cmd) {
+	slog.Info("command palette entry selected", "kind", entry.kind, "name", entry.name)
+
+	switch entry.kind {
+	case paletteContext:
+		return m.selectContext(entry.index)
+	case paletteNamespace:
+		if entry.namespace != "" {
+			return m.jumpTo(entry.index, entry.namespace)
+		}
+		m.focusNamespace(entry.name)
+		return m.selectNamespace(entry.name)
+	case palettePod:
+		m.resourceKind = ""
+		m.focusedPanel = PanelPods
+		m.selectedPodIndex = entry.index
+		m.adjustPodScrollOffset()
+	case paletteAction:
+		return m.handleActionExecution(m.actions[entry.index])
+	}
+	return m, nil
+}
+
+// jumpTo switches to the context at index and opens namespace there, right away when the
+// context's namespaces are known or else once they load
+func (m AppModel) jumpTo(index int, namespace string) (tea.Model, tea.Cmd) {
+	updated, cmd := m.selectContext(index)
+	m = updated.(AppModel)
+	if m.currentContext == nil || m.currentContext.Name != m.contexts[index].Name {
+		return m, cmd // The context switch failed and is reported
+	}
+
+	m.jumpNamespace = namespace
+	if len(m.namespaces) == 0 {
+		return m, cmd
+	}
+	return m, tea.Batch(cmd, m.openJumpNamespace())
+}
+
+// openJumpNamespace opens the namespace picked in the palette, or warns when the current
+// context does not have it. Returns nil when there is nothing to open.
+func (m *AppModel) openJumpNamespace() tea.Cmd {
+	namespace := m.jumpNamespace
+	if namespace == "" {
+		return nil
+	}
+	m.jumpNamespace = ""
+
+	if m.focusNamespace(namespace) {
+		slog.Info("jumping to namespace", "context", m.currentContext.Name, "namespace", namespace)
+		updated, cmd := m.selectNamespace(namespace)
+		*m = updated.(AppModel)
+		return cmd
+	}
+
+	slog.Warn("namespace to jump to not found", "context", m.currentContext.Name, "namespace", namespace)
+	return m.notify(fmt.Sprintf("Namespace %s not found in %s", namespace, m.currentContext.Name), components.ToastWarning)
+}
+
+// renderPalette renders the command palette dialog
+func (m AppModel) renderPalette() string {
+	var content string
+	content += styles.TitleStyle.Render("Command Palette") + "\n\n"
+	content += styles.SearchLabelStyle.Render("> ") + m.paletteQuery + "_\n\n"
+
+	matches := m.paletteMatches()
+	if len(matches) == 0 {
+		content += styles.PlaceholderStyle.Render("No matches") + "\n"
+	}
+
+	// Keep the highlighted entry inside the visible window
+	start := 0
+	if m.paletteIndex >= paletteMaxRows {
+		start = m.paletteIndex - paletteMaxRows + 1
+	}
+	end := min(start+paletteMaxRows, len(matches))
+
+	for i := start; i < end; i++ {
+		entry := matches[i]
+		line := fmt.Sprintf("%-40s %s", entry.name, styles.DimStyle.Render(string(entry.kind)))
+		if entry.kind == paletteAction {
+			line = fmt.Sprintf("%-40s %s", entry.name, styles.DimStyle.Render(fmt.Sprintf("action [%s]", m.actionKeys(m.actions[entry.index]))))
+		}
+		if i == m.paletteIndex {
+			content += m.selectionStyle(styles.SelectedStyle).Render(m.cursorMarker(true)+line) + "\n"
+		} else {
+			content += styles.NormalStyle.Render(m.cursorMarker(false)+line) + "\n"
+		}
+	}
+	if remaining := len(matches) - end; remaining > 0 {
+		content += styles.HelpTextStyle.Render(fmt.Sprintf("↓ %d more", remaining)) + "\n"
+	}
+
+	content += "\n" + styles.DimStyle.Render("Type to search (e.g. \"prod pay\") | ↑/↓: Navigate | Enter: Go/Run | ESC: Close")
+
+	dialogStyle := lipgloss.NewStyle().
+		Border(lipgloss.RoundedBorder()).
+		BorderForeground(lipgloss.Color("39")). // Bright cyan
+		Padding(1, 2)
+
+	return lipgloss.Place(
+		m.termWidth,
+		m.termHeight,
+		lipgloss.Center,
+		lipgloss.Center,
+		dialogStyle.Render(content),
+	)
+}
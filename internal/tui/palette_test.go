@@ -0,0 +1,185 @@
+package tui
+
+import (
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/maratkarimov/kubertino/internal/config"
+	"github.com/maratkarimov/kubertino/internal/k8s"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// newPaletteTestModel returns a model in the namespace view of "dev" with namespaces and pods loaded
+func newPaletteTestModel(t *testing.T) AppModel {
+	t.Helper()
+	model := newTestModel(newMockAdapter(), withContexts(config.Context{Name: "dev"}, config.Context{Name: "prod"}),
+		withActions(config.Action{Name: "Tail logs", Shortcut: "l", Command: "echo {{.pod}}"}))
+
+	updated, _ := model.selectContext(0)
+	model = updated.(AppModel)
+	updated, _ = model.Update(namespaceFetchedMsg{value: k8s.NamespacesNamed([]string{"default", "payments", "staging"})})
+	model = updated.(AppModel)
+	updated, _ = model.selectNamespace("default")
+	model = updated.(AppModel)
+	updated, _ = model.Update(podsFetchedMsg{value: []k8s.Pod{
+		{Name: "api-7f9", Status: "Running"},
+		{Name: "worker-1c2", Status: "Running"},
+	}})
+	return updated.(AppModel)
+}
+
+func typePalette(model AppModel, query string) AppModel {
+	for _, r := range query {
+		model = sendKey(model, runeKey(r))
+	}
+	return model
+}
+
+func TestPalette_OpenAndClose(t *testing.T) {
+	model := newPaletteTestModel(t)
+
+	model = sendKey(model, tea.KeyMsg{Type: tea.KeyCtrlP})
+	require.Equal(t, viewModePalette, model.viewMode)
+	view := model.View()
+	assert.Contains(t, view, "Command Palette")
+	assert.Contains(t, view, "prod")
+	assert.Contains(t, view, "payments")
+	assert.Contains(t, view, "worker-1c2")
+	assert.Contains(t, view, "action [l]")
+
+	// Typed keys go to the query, not to bindings
+	model = typePalette(model, "q")
+	assert.Equal(t, viewModePalette, model.viewMode)
+	assert.Equal(t, "q", model.paletteQuery)
+
+	model = sendKey(model, tea.KeyMsg{Type: tea.KeyEsc})
+	assert.Equal(t, viewModeNamespaceView, model.viewMode)
+}
+
+func TestPalette_SelectNamespace(t *testing.T) {
+	model := newPaletteTestModel(t)
+	model = sendKey(model, tea.KeyMsg{Type: tea.KeyCtrlP})
+	model = typePalette(model, "paym")
+
+	matches := model.paletteMatches()
+	require.NotEmpty(t, matches)
+	assert.Equal(t, paletteEntry{kind: paletteNamespace, name: "payments", index: 1}, matches[0])
+
+	updated, cmd := model.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	model = updated.(AppModel)
+	assert.NotNil(t, cmd, "pods of the namespace are fetched")
+	assert.Equal(t, viewModeNamespaceView, model.viewMode)
+	assert.Equal(t, "payments", model.currentNamespace)
+	assert.True(t, model.podsLoading)
+}
+
+func TestPalette_SelectPod(t *testing.T) {
+	model := newPaletteTestModel(t)
+	model.focusedPanel = PanelNamespaces
+	model = sendKey(model, tea.KeyMsg{Type: tea.KeyCtrlP})
+	model = typePalette(model, "worker")
+	model = sendKey(model, tea.KeyMsg{Type: tea.KeyEnter})
+
+	assert.Equal(t, PanelPods, model.focusedPanel)
+	pod, ok := model.selectedPod()
+	require.True(t, ok)
+	assert.Equal(t, "worker-1c2", pod.Name)
+}
+
+func TestPalette_SelectContext(t *testing.T) {
+	model := newPaletteTestModel(t)
+	model = sendKey(model, tea.KeyMsg{Type: tea.KeyCtrlP})
+	model = typePalette(model, "prod")
+
+	updated, cmd := model.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	model = updated.(AppModel)
+	assert.NotNil(t, cmd, "namespaces of the context are fetched")
+	require.NotNil(t, model.currentContext)
+	assert.Equal(t, "prod", model.currentContext.Name)
+	assert.Empty(t, model.currentNamespace, "namespace of the previous context is dropped")
+	assert.Empty(t, model.pods)
+}
+
+func TestPalette_NavigationClamped(t *testing.T) {
+	model := newPaletteTestModel(t)
+	model = sendKey(model, tea.KeyMsg{Type: tea.KeyCtrlP})
+
+	model = sendKey(model, tea.KeyMsg{Type: tea.KeyUp})
+	assert.Equal(t, 0, model.paletteIndex)
+
+	total := len(model.paletteEntries())
+	for i := 0; i < total+3; i++ {
+		model = sendKey(model, tea.KeyMsg{Type: tea.KeyDown})
+	}
+	assert.Equal(t, total-1, model.paletteIndex)
+
+	// Typing resets the highlight to the best match
+	model = typePalette(model, "a")
+	assert.Equal(t, 0, model.paletteIndex)
+}
+
+func TestPalette_ContextSelectionListsOnlyContexts(t *testing.T) {
+	model := NewAppModel(&config.Config{
+		Contexts: []config.Context{{Name: "dev"}, {Name: "prod"}},
+	}, newMockAdapter())
+
+	entries := model.paletteEntries()
+	assert.Equal(t, []paletteEntry{
+		{kind: paletteContext, name: "dev", index: 0},
+		{kind: paletteContext, name: "prod", index: 1},
+	}, entries)
+}
+
+func TestPalette_JumpToNamespaceOfOtherContext(t *testing.T) {
+	model := newPaletteTestModel(t)
+
+	// Visit prod once so its namespaces are known, then go back to dev
+	updated, _ := model.selectContext(1)
+	model = updated.(AppModel)
+	updated, _ = model.Update(namespaceFetchedMsg{value: k8s.NamespacesNamed([]string{"default", "billing"})})
+	model = updated.(AppModel)
+	updated, _ = model.selectContext(0)
+	model = updated.(AppModel)
+	require.Equal(t, "default", model.currentNamespace)
+
+	model = sendKey(model, tea.KeyMsg{Type: tea.KeyCtrlP})
+	assert.Contains(t, model.View(), "prod/billing")
+	assert.NotContains(t, model.View(), "dev/payments", "namespaces of the current context are listed plainly")
+	model = typePalette(model, "bill prod")
+
+	matches := model.paletteMatches()
+	require.NotEmpty(t, matches)
+	assert.Equal(t, paletteEntry{kind: paletteNamespace, name: "prod/billing", index: 1, namespace: "billing"}, matches[0])
+
+	updated, cmd := model.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	model = updated.(AppModel)
+	assert.NotNil(t, cmd, "pods of the namespace are fetched")
+	assert.Equal(t, viewModeNamespaceView, model.viewMode)
+	require.NotNil(t, model.currentContext)
+	assert.Equal(t, "prod", model.currentContext.Name)
+	assert.Equal(t, "billing", model.currentNamespace)
+	assert.Equal(t, "billing", model.namespaces[model.selectedNamespaceIndex].Name, "the namespace cursor follows")
+	assert.True(t, model.podsLoading)
+}
+
+func TestPalette_JumpWaitsForNamespaces(t *testing.T) {
+	model := newPaletteTestModel(t)
+
+	updated, _ := model.jumpTo(1, "billing")
+	model = updated.(AppModel)
+	assert.Equal(t, "prod", model.currentContext.Name)
+	assert.Empty(t, model.currentNamespace, "namespaces of prod are still loading")
+
+	updated, _ = model.Update(namespaceFetchedMsg{value: k8s.NamespacesNamed([]string{"billing"})})
+	model = updated.(AppModel)
+	assert.Equal(t, "billing", model.currentNamespace)
+	assert.Empty(t, model.jumpNamespace)
+
+	updated, _ = model.jumpTo(0, "gone")
+	model = updated.(AppModel)
+	assert.Equal(t, "default", model.currentNamespace, "a missing namespace leaves the context as it was")
+	toast, ok := model.statusBar.Current()
+	require.True(t, ok)
+	assert.Contains(t, toast.Text, "Namespace gone not found in dev")
+}
@@ -0,0 +1,62 @@
+package tui
+
+import (
+	"log/slog"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/maratkarimov/kubertino/internal/config"
+	"github.com/maratkarimov/kubertino/internal/tui/components"
+)
+
+// openParamForm asks for the params of action, pre-filled with their defaults, before it runs
+func (m AppModel) openParamForm(action config.Action) (tea.Model, tea.Cmd) {
+	slog.Debug("action params form opened", "action", action.Name)
+	fields := make([]components.FormField, len(action.Params))
+	for i, param := range action.Params {
+		label := param.Label()
+		if param.Required {
+			label += " *"
+		}
+		fields[i] = components.FormField{Label: label, Value: param.Default}
+	}
+
+	m.paramAction = action
+	m.paramForm.SetSize(m.termWidth, m.termHeight)
+	m.paramForm.Show(action.Name, fields)
+	return m, nil
+}
+
+// handleParamFormKey handles key presses while the params form is open. Once submitted with
+// accepted values the action runs with them; refused values are marked in the form.
+func (m AppModel) handleParamFormKey(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	if !m.paramForm.HandleKeyPress(msg) {
+		return m, nil
+	}
+
+	action := m.paramAction
+	entered := m.paramForm.Values()
+	values := make(map[string]string, len(action.Params))
+	refused := -1
+	for i, param := range action.Params {
+		value := entered[i]
+		values[param.Name] = value
+		if err := param.Check(value); err != nil {
+			m.paramForm.Fields[i].Error = err.Error()
+			if refused < 0 {
+				refused = i
+			}
+		}
+	}
+	if refused >= 0 {
+		m.paramForm.Focus = refused
+		return m, nil
+	}
+
+	m.paramForm.Hide()
+	m.paramAction = config.Action{}
+	m.paramValues = values
+	updated, cmd := m.handleActionExecution(action)
+	model := updated.(AppModel)
+	model.paramValues = nil
+	return model, cmd
+}
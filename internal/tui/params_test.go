@@ -0,0 +1,79 @@
+package tui
+
+import (
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/maratkarimov/kubertino/internal/config"
+	"github.com/maratkarimov/kubertino/internal/k8s"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// newParamsModel returns a model with a background action asking for params, with web-1
+// selected
+func newParamsModel(t *testing.T) AppModel {
+	t.Helper()
+	model := newTestModel(newMockAdapter(), withContexts(config.Context{Name: "dev"}), withActions(config.Action{
+		Name:       "Tail",
+		Shortcut:   "t",
+		Command:    "echo {{.pod}} {{.params.lines}} {{.params.grep}}",
+		Background: true,
+		Params: []config.ActionParam{
+			{Name: "lines", Prompt: "Lines", Default: "100", Required: true, Pattern: "^[0-9]+$"},
+			{Name: "grep"},
+		},
+	}))
+	model.currentNamespace = "app"
+	model.pods = []k8s.Pod{{Name: "web-1", Status: "Running"}}
+	model.selectedPodIndex = 0
+	model.focusedPanel = PanelPods
+	t.Cleanup(model.jobs.StopAll)
+	return model
+}
+
+func TestParams_AskBeforeRunning(t *testing.T) {
+	model := newParamsModel(t)
+
+	model = sendKey(model, runeKey('t'))
+	require.True(t, model.paramForm.IsVisible)
+	assert.Equal(t, []string{"100", ""}, model.paramForm.Values(), "pre-filled with defaults")
+	view := model.View()
+	assert.Contains(t, view, "Lines *")
+	assert.Contains(t, view, "grep")
+
+	// Refused values keep the form open
+	model = sendKey(model, tea.KeyMsg{Type: tea.KeyCtrlU})
+	model = sendKey(model, runeKey('x'))
+	updated, cmd := model.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	model = updated.(AppModel)
+	assert.Nil(t, cmd)
+	assert.True(t, model.paramForm.IsVisible)
+	assert.Equal(t, "Lines must match ^[0-9]+$", model.paramForm.Fields[0].Error)
+
+	model = sendKey(model, tea.KeyMsg{Type: tea.KeyBackspace})
+	model = sendKey(model, runeKey('5'))
+	model = sendKey(model, tea.KeyMsg{Type: tea.KeyTab})
+	model = sendKey(model, runeKey('E'))
+
+	updated, cmd = model.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	model = updated.(AppModel)
+	assert.False(t, model.paramForm.IsVisible)
+	assert.Nil(t, model.paramValues, "values are not reused by later actions")
+	require.NotNil(t, cmd)
+	started, ok := cmd().(jobStartedMsg)
+	require.True(t, ok)
+	require.NoError(t, started.err)
+	assert.Equal(t, "echo web-1 5 E", started.job.Command)
+}
+
+func TestParams_EscCancels(t *testing.T) {
+	model := newParamsModel(t)
+
+	model = sendKey(model, runeKey('t'))
+	updated, cmd := model.Update(tea.KeyMsg{Type: tea.KeyEsc})
+	model = updated.(AppModel)
+	assert.False(t, model.paramForm.IsVisible)
+	assert.Nil(t, cmd, "nothing runs")
+	assert.Empty(t, model.jobList())
+}
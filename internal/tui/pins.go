@@ -0,0 +1,132 @@
+package tui
+
+import (
+	"log/slog"
+	"slices"
+	"strings"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/maratkarimov/kubertino/internal/k8s"
+	"github.com/maratkarimov/kubertino/internal/state"
+	"github.com/maratkarimov/kubertino/internal/tui/components"
+	"github.com/maratkarimov/kubertino/internal/tui/styles"
+)
+
+// pinPattern returns the pattern a pin of pod is recorded as: its name with the segments
+// generated for every new pod replaced by *, so the pin survives rollouts, e.g. web-*-* for
+// web-7d9f8b6c5-x2k4q of a deployment. Statefulset and bare pods keep their name.
+func pinPattern(pod k8s.Pod) string {
+	segments := strings.Split(pod.Name, "-")
+	generated := 0
+	switch pod.OwnerKind {
+	case "ReplicaSet":
+		// <deployment>-<pod template hash>-<random>
+		generated = 2
+	case "Job":
+		// <job>-<random>, or <cronjob>-<scheduled time>-<random>
+		generated = 1
+		if owner := strings.Split(pod.OwnerName, "-"); len(owner) > 1 && isDigits(owner[len(owner)-1]) {
+			generated = 2
+		}
+	case "DaemonSet":
+		// <daemonset>-<random>
+		generated = 1
+	}
+	// Keep at least the first segment
+	generated = min(generated, len(segments)-1)
+	for i := len(segments) - generated; i < len(segments); i++ {
+		segments[i] = "*"
+	}
+	return strings.Join(segments, "-")
+}
+
+// isDigits reports whether s is a non-empty string of ASCII digits
+func isDigits(s string) bool {
+	return s != "" && strings.Trim(s, "0123456789") == ""
+}
+
+// pinMatches reports whether pod name matches pin pattern: the same number of segments, each
+// equal to the one of the pattern or matched by *
+func pinMatches(pattern, name string) bool {
+	patternSegments := strings.Split(pattern, "-")
+	nameSegments := strings.Split(name, "-")
+	if len(patternSegments) != len(nameSegments) {
+		return false
+	}
+	for i, segment := range patternSegments {
+		if segment != "*" && segment != nameSegments[i] {
+			return false
+		}
+	}
+	return true
+}
+
+// podPins returns the pin patterns of the current namespace
+func (m AppModel) podPins() []string {
+	if m.state == nil || m.currentContext == nil || m.currentNamespace == "" {
+		return nil
+	}
+	return m.state.Pins(m.currentContext.Name, m.currentNamespace)
+}
+
+// podPinned reports whether pod is pinned in the current namespace
+func (m AppModel) podPinned(pod k8s.Pod) bool {
+	return slices.ContainsFunc(m.podPins(), func(pattern string) bool { return pinMatches(pattern, pod.Name) })
+}
+
+// orderPods sorts pods in place in the order of the pods panel: pinned pods first, each part
+// in the sort order
+func (m AppModel) orderPods(pods []k8s.Pod) {
+	sortPods(pods, m.podSort)
+	if len(m.podPins()) == 0 {
+		return
+	}
+	slices.SortStableFunc(pods, func(a, b k8s.Pod) int {
+		aPinned, bPinned := m.podPinned(a), m.podPinned(b)
+		switch {
+		case aPinned && !bPinned:
+			return -1
+		case bPinned && !aPinned:
+			return 1
+		}
+		return 0
+	})
+}
+
+// handleTogglePin pins the selected pod to the top of the pods panel of the namespace, or
+// unpins it. Pins are kept in the state file; without one they last until Kubertino exits.
+func (m AppModel) handleTogglePin() (tea.Model, tea.Cmd) {
+	pod, ok := m.selectedPod()
+	if !ok || m.currentContext == nil {
+		return m, nil
+	}
+	if m.state == nil {
+		m.state = state.New()
+	}
+
+	pins := slices.Clone(m.podPins())
+	matches := func(pattern string) bool { return pinMatches(pattern, pod.Name) }
+	var message string
+	if slices.ContainsFunc(pins, matches) {
+		pins = slices.DeleteFunc(pins, matches)
+		message = "Unpinned " + pod.Name
+	} else {
+		pins = append(pins, pinPattern(pod))
+		message = "Pinned " + pod.Name
+	}
+	slog.Info("pod pins changed", "context", m.currentContext.Name, "namespace", m.currentNamespace, "pins", pins)
+	m.state.SetPins(m.currentContext.Name, m.currentNamespace, pins)
+
+	// Reorder, keeping the cursor on the pod
+	m.setPods(m.pods)
+	m.saveState()
+	return m, m.notify(message, components.ToastInfo)
+}
+
+// podPinBadge renders the marker of a pinned pod
+func (m AppModel) podPinBadge(pod k8s.Pod) string {
+	if !m.podPinned(pod) {
+		return ""
+	}
+	return styles.FavoriteNamespaceStyle.Render(" ⚑")
+}
@@ -0,0 +1,71 @@
+package tui
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/maratkarimov/kubertino/internal/config"
+	"github.com/maratkarimov/kubertino/internal/k8s"
+	"github.com/maratkarimov/kubertino/internal/state"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPinPattern(t *testing.T) {
+	tests := []struct {
+		pod  k8s.Pod
+		want string
+	}{
+		{k8s.Pod{Name: "web-api-7d9f8b6c5-x2k4q", OwnerKind: "ReplicaSet", OwnerName: "web-api-7d9f8b6c5"}, "web-api-*-*"},
+		{k8s.Pod{Name: "db-0", OwnerKind: "StatefulSet", OwnerName: "db"}, "db-0"},
+		{k8s.Pod{Name: "node-exporter-abcde", OwnerKind: "DaemonSet", OwnerName: "node-exporter"}, "node-exporter-*"},
+		{k8s.Pod{Name: "migrate-x7k2p", OwnerKind: "Job", OwnerName: "migrate"}, "migrate-*"},
+		{k8s.Pod{Name: "backup-28391234-q8w2e", OwnerKind: "Job", OwnerName: "backup-28391234"}, "backup-*-*"},
+		{k8s.Pod{Name: "debug"}, "debug"},
+		{k8s.Pod{Name: "odd-x2k4q", OwnerKind: "ReplicaSet"}, "odd-*"},
+	}
+	for _, tt := range tests {
+		assert.Equal(t, tt.want, pinPattern(tt.pod), tt.pod.Name)
+	}
+}
+
+func TestPinMatches(t *testing.T) {
+	assert.True(t, pinMatches("web-*-*", "web-5c8d7f9b4-p9m3n"), "a new replicaset")
+	assert.False(t, pinMatches("web-*-*", "web-gateway-5c8d7f9b4-p9m3n"), "another deployment")
+	assert.True(t, pinMatches("db-0", "db-0"))
+	assert.False(t, pinMatches("db-0", "db-1"))
+}
+
+func TestTogglePin(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "state.json")
+	model := newTestModel(newMockAdapter(), withContexts(config.Context{Name: "dev"}))
+	model.SetState(state.New(), path)
+	model.currentNamespace = "app"
+	model.focusedPanel = PanelPods
+	model.setPods([]k8s.Pod{
+		{Name: "api-6b7c8d9e0-aaaaa", OwnerKind: "ReplicaSet"},
+		{Name: "web-7d9f8b6c5-x2k4q", OwnerKind: "ReplicaSet"},
+	})
+	model.selectedPodIndex = 1
+
+	model = sendKey(model, runeKey('m'))
+	assert.Equal(t, "web-7d9f8b6c5-x2k4q", model.pods[0].Name, "pinned pods come first")
+	assert.Equal(t, 0, model.selectedPodIndex, "the cursor follows the pod")
+	assert.Contains(t, model.View(), "web-7d9f8b6c5-x2k4q ⚑")
+
+	saved, err := state.Load(path)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"web-*-*"}, saved.Pins("dev", "app"))
+
+	// After a rollout the new pod of the deployment is still pinned
+	model.setPods([]k8s.Pod{
+		{Name: "api-6b7c8d9e0-aaaaa", OwnerKind: "ReplicaSet"},
+		{Name: "web-5c8d7f9b4-p9m3n", OwnerKind: "ReplicaSet"},
+	})
+	assert.Equal(t, "web-5c8d7f9b4-p9m3n", model.pods[0].Name)
+
+	model.selectedPodIndex = 0
+	model = sendKey(model, runeKey('m'))
+	assert.Equal(t, "api-6b7c8d9e0-aaaaa", model.pods[0].Name, "unpinned")
+	assert.Empty(t, model.state.Pins("dev", "app"))
+}
@@ -0,0 +1,183 @@
+package tui
+
+import (
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/charmbracelet/lipgloss"
+	"github.com/maratkarimov/kubertino/internal/config"
+	"github.com/maratkarimov/kubertino/internal/k8s"
+	"github.com/maratkarimov/kubertino/internal/tui/styles"
+)
+
+// CustomPodColumnSetter is implemented by adapters that fill in the values of custom pod
+// columns (k8s.Pod.Fields). Without it, custom columns show "-".
+type CustomPodColumnSetter interface {
+	SetCustomPodColumns(columns []config.CustomPodColumn) error
+}
+
+// podColumn is an optional column of the pod list, rendered before the pod name
+type podColumn struct {
+	header string
+	value  func(pod k8s.Pod, row podRow) string
+}
+
+// podRow holds what column values depend on besides the pod itself
+type podRow struct {
+	now   time.Time
+	usage *k8s.PodMetrics // nil while the pod's resource usage is unknown
+}
+
+// podColumnDefs maps pod_columns entries to their header and value
+var podColumnDefs = map[string]podColumn{
+	config.PodColumnStatus: {header: "STATUS", value: func(pod k8s.Pod, _ podRow) string {
+		return pod.Status
+	}},
+	config.PodColumnReady: {header: "READY", value: func(pod k8s.Pod, _ podRow) string {
+		return valueOrDash(pod.Ready)
+	}},
+	config.PodColumnRestarts: {header: "RESTARTS", value: func(pod k8s.Pod, _ podRow) string {
+		return strconv.Itoa(pod.Restarts)
+	}},
+	config.PodColumnAge: {header: "AGE", value: func(pod k8s.Pod, row podRow) string {
+		if pod.CreatedAt.IsZero() {
+			return "-"
+		}
+		return k8s.FormatAge(pod.Age(row.now))
+	}},
+	config.PodColumnNode: {header: "NODE", value: func(pod k8s.Pod, _ podRow) string {
+		return valueOrDash(pod.Node)
+	}},
+	config.PodColumnCPU: {header: "CPU", value: func(_ k8s.Pod, row podRow) string {
+		if row.usage == nil {
+			return "-"
+		}
+		return k8s.FormatCPU(row.usage.CPU)
+	}},
+	config.PodColumnMemory: {header: "MEMORY", value: func(_ k8s.Pod, row podRow) string {
+		if row.usage == nil {
+			return "-"
+		}
+		return k8s.FormatMemory(row.usage.Memory)
+	}},
+}
+
+// customColumnWidth caps the width of custom pod column values, which may be long (e.g.
+// image references)
+const customColumnWidth = 40
+
+// podColumnDef returns the header and value of a built-in or custom pod column
+func podColumnDef(name string) podColumn {
+	if column, ok := podColumnDefs[name]; ok {
+		return column
+	}
+	return podColumn{header: strings.ToUpper(name), value: func(pod k8s.Pod, _ podRow) string {
+		return truncateName(valueOrDash(pod.Fields[name]), customColumnWidth)
+	}}
+}
+
+// visiblePodColumns returns the configured pod columns, or the defaults when unset, followed
+// by the custom columns they do not place
+func (m AppModel) visiblePodColumns() []string {
+	if m.config == nil {
+		return config.DefaultPodColumns
+	}
+	columns := m.config.PodColumns
+	if len(columns) == 0 {
+		columns = config.DefaultPodColumns
+	}
+	for _, custom := range m.config.CustomPodColumns {
+		if !slices.Contains(columns, custom.Name) {
+			columns = append(slices.Clone(columns), custom.Name)
+		}
+	}
+	return columns
+}
+
+// podColumnLayout holds the columns shown in the pod list and their widths
+type podColumnLayout struct {
+	columns   []string
+	widths    []int
+	nameWidth int // Room for the pod name and its restart badge
+}
+
+// podColumnLayout sizes the configured columns over all pods so rows stay aligned while
+// scrolling. Trailing columns are dropped when they would leave no room for the pod names
+// in textWidth; the first column is always kept and names are truncated last.
+func (m AppModel) podColumnLayout(pods []k8s.Pod, textWidth int, now time.Time) podColumnLayout {
+	columns := m.visiblePodColumns()
+	widths := make([]int, len(columns))
+	nameWidth := 0
+	for _, pod := range pods {
+		nameWidth = max(nameWidth, lipgloss.Width(pod.Name+m.podBadges(pod)))
+	}
+
+	for i, name := range columns {
+		column := podColumnDef(name)
+		widths[i] = lipgloss.Width(column.header)
+		for _, pod := range pods {
+			widths[i] = max(widths[i], lipgloss.Width(column.value(pod, m.podRow(pod, now))))
+		}
+	}
+
+	count, nameWidth := fitColumns(widths, nameWidth, textWidth-m.cursorWidth())
+	return podColumnLayout{columns: columns[:count], widths: widths[:count], nameWidth: nameWidth}
+}
+
+// podName returns the pod name shortened to fit the layout next to badge
+func (layout podColumnLayout) podName(name, badge string) string {
+	return truncateName(name, layout.nameWidth-lipgloss.Width(badge))
+}
+
+// renderPodColumns renders the columns of a pod, each padded to its width
+func (m AppModel) renderPodColumns(pod k8s.Pod, layout podColumnLayout, now time.Time) string {
+	row := m.podRow(pod, now)
+	var b strings.Builder
+	for i, name := range layout.columns {
+		cell := padRight(podColumnDef(name).value(pod, row), layout.widths[i]) + " "
+		switch name {
+		case config.PodColumnStatus:
+			cell = m.getPodStatusStyle(pod.Status).Render(cell)
+		case config.PodColumnRestarts:
+			if pod.Restarts > 0 {
+				cell = styles.WarningStyle.Render(cell)
+			}
+		case config.PodColumnCPU:
+			if row.usage != nil {
+				cell = usageStyle(row.usage.CPU, pod.CPURequest).Render(cell)
+			}
+		case config.PodColumnMemory:
+			if row.usage != nil {
+				cell = usageStyle(row.usage.Memory, pod.MemRequest).Render(cell)
+			}
+		}
+		b.WriteString(cell)
+	}
+	return b.String()
+}
+
+// podRow returns what the column values of pod depend on at now
+func (m AppModel) podRow(pod k8s.Pod, now time.Time) podRow {
+	return podRow{now: now, usage: m.podUsage(pod.Name)}
+}
+
+// renderPodColumnHeader renders the column headers aligned with the pod rows
+func (m AppModel) renderPodColumnHeader(layout podColumnLayout) string {
+	var b strings.Builder
+	b.WriteString(m.cursorMarker(false))
+	for i, name := range layout.columns {
+		b.WriteString(padRight(podColumnDef(name).header, layout.widths[i]) + " ")
+	}
+	b.WriteString("NAME")
+	return styles.DimStyle.Render(b.String())
+}
+
+// valueOrDash returns value, or "-" when it is empty
+func valueOrDash(value string) string {
+	if value == "" {
+		return "-"
+	}
+	return value
+}
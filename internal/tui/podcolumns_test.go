@@ -0,0 +1,145 @@
+package tui
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/charmbracelet/lipgloss"
+	"github.com/maratkarimov/kubertino/internal/config"
+	"github.com/maratkarimov/kubertino/internal/k8s"
+	"github.com/maratkarimov/kubertino/internal/tui/components"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newPodColumnsTestModel(columns []string) AppModel {
+	now := time.Now()
+	return AppModel{
+		config:            &config.Config{PodColumns: columns},
+		errorModal:        components.NewErrorModal(),
+		namespacesSpinner: components.NewSpinner(),
+		podsSpinner:       components.NewSpinner(),
+		actionSpinner:     components.NewSpinner(),
+		currentNamespace:  "default",
+		pods: []k8s.Pod{
+			{Name: "api-1", Status: "Running", Ready: "2/2", Restarts: 0, CreatedAt: now.Add(-3 * time.Hour), Node: "node-a"},
+			{Name: "worker-1", Status: "CrashLoopBackOff", Ready: "0/1", Restarts: 12, CreatedAt: now.Add(-5 * 24 * time.Hour), Node: "node-b"},
+		},
+		termWidth:  160,
+		termHeight: 30,
+	}
+}
+
+// podPanelLine returns the rendered panel line containing text
+func podPanelLine(t *testing.T, output, text string) string {
+	t.Helper()
+	for _, line := range strings.Split(output, "\n") {
+		if strings.Contains(line, text) {
+			return line
+		}
+	}
+	require.Failf(t, "line not found", "%q not in output:\n%s", text, output)
+	return ""
+}
+
+func TestRenderPodPanel_DefaultColumns(t *testing.T) {
+	model := newPodColumnsTestModel(nil)
+	output := model.renderPodPanel(100, 20)
+
+	header := podPanelLine(t, output, "STATUS")
+	assert.Contains(t, header, "READY")
+	assert.Contains(t, header, "RESTARTS")
+	assert.Contains(t, header, "AGE")
+	assert.NotContains(t, header, "NODE")
+
+	api := podPanelLine(t, output, "api-1")
+	worker := podPanelLine(t, output, "worker-1")
+	assert.Contains(t, api, "2/2")
+	assert.Contains(t, api, "3h")
+	assert.Contains(t, worker, "0/1")
+	assert.Contains(t, worker, "12")
+	assert.Contains(t, worker, "5d")
+
+	// Columns are aligned: pod names start at the same offset as the NAME header
+	nameAt := strings.Index(header, "NAME")
+	assert.Equal(t, nameAt, strings.Index(api, "api-1"))
+	assert.Equal(t, nameAt, strings.Index(worker, "worker-1"))
+}
+
+func TestRenderPodPanel_ConfiguredColumns(t *testing.T) {
+	model := newPodColumnsTestModel([]string{config.PodColumnNode, config.PodColumnStatus})
+	output := model.renderPodPanel(100, 20)
+
+	header := podPanelLine(t, output, "NODE")
+	assert.Less(t, strings.Index(header, "NODE"), strings.Index(header, "STATUS"))
+	assert.NotContains(t, header, "READY")
+	assert.Contains(t, podPanelLine(t, output, "api-1"), "node-a")
+}
+
+func TestPodColumnLayout_DropsColumnsWhenNarrow(t *testing.T) {
+	model := newPodColumnsTestModel(nil)
+	now := time.Now()
+
+	wide := model.podColumnLayout(model.pods, 80, now)
+	assert.Equal(t, config.DefaultPodColumns, wide.columns)
+
+	narrow := model.podColumnLayout(model.pods, 30, now)
+	assert.Equal(t, []string{config.PodColumnStatus}, narrow.columns, "first column is always kept")
+	assert.Equal(t, []int{len("CrashLoopBackOff")}, narrow.widths)
+}
+
+func TestRenderPodPanel_TruncatesLongNamesLast(t *testing.T) {
+	model := newPodColumnsTestModel(nil)
+	model.pods[0].Name = "payments-api-deployment-with-a-very-long-name-7f9c8d-x2x9q"
+	output := model.renderPodPanel(50, 20)
+
+	header := podPanelLine(t, output, "STATUS")
+	assert.NotContains(t, header, "READY", "trailing columns are dropped first")
+
+	api := podPanelLine(t, output, "payments-api")
+	assert.Contains(t, api, "…")
+	assert.NotContains(t, api, "x2x9q")
+	assert.Equal(t, strings.Index(header, "NAME"), strings.Index(api, "payments-api"))
+	for _, line := range strings.Split(output, "\n") {
+		assert.LessOrEqual(t, lipgloss.Width(line), 50, "rows stay inside the panel")
+	}
+}
+
+func TestRenderPodPanel_CustomColumns(t *testing.T) {
+	model := newPodColumnsTestModel([]string{"status", "image"})
+	model.config.CustomPodColumns = []config.CustomPodColumn{
+		{Name: "image", JSONPath: ".spec.containers[0].image"},
+		{Name: "qos", JSONPath: ".status.qosClass"},
+	}
+	model.pods[0].Fields = map[string]string{"image": "registry.example.com/api:1.4", "qos": "Burstable"}
+	model.pods[1].Fields = map[string]string{"image": "worker:2", "qos": ""}
+
+	assert.Equal(t, []string{"status", "image", "qos"}, model.visiblePodColumns(), "unplaced custom columns follow")
+
+	output := model.renderPodPanel(120, 20)
+	header := podPanelLine(t, output, "STATUS")
+	assert.Regexp(t, `STATUS +IMAGE +QOS +NAME`, header)
+	assert.Regexp(t, `registry.example.com/api:1.4 +Burstable +api-1`, podPanelLine(t, output, "api-1"))
+	assert.Regexp(t, `worker:2 +- +worker-1`, podPanelLine(t, output, "worker-1"), "empty values show a dash")
+}
+
+// columnsAdapter is a mock adapter that records the custom pod columns it is given
+type columnsAdapter struct {
+	*mockKubeAdapter
+	columns []config.CustomPodColumn
+}
+
+func (a *columnsAdapter) SetCustomPodColumns(columns []config.CustomPodColumn) error {
+	a.columns = columns
+	return nil
+}
+
+func TestApplyConfig_SetsCustomPodColumns(t *testing.T) {
+	adapter := &columnsAdapter{mockKubeAdapter: newMockAdapter()}
+	model := NewAppModel(&config.Config{Version: "1.0", Contexts: []config.Context{{Name: "test"}}}, adapter)
+
+	columns := []config.CustomPodColumn{{Name: "image", JSONPath: ".spec.containers[0].image"}}
+	model.applyConfig(&config.Config{Version: "1.0", Contexts: []config.Context{{Name: "test"}}, CustomPodColumns: columns})
+	assert.Equal(t, columns, adapter.columns)
+}
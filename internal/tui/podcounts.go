@@ -0,0 +1,81 @@
+package tui
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"maps"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/maratkarimov/kubertino/internal/tui/styles"
+)
+
+// PodCounter is implemented by adapters that can count the pods of every namespace of a
+// context in one call. Without it, namespaces are listed without counts.
+type PodCounter interface {
+	GetPodCounts(context string) (map[string]int, error)
+}
+
+// podCountsMsg is sent when the pod counts of a context have been fetched. The request key
+// is the context name.
+type podCountsMsg = resultMsg[map[string]int]
+
+// loadPodCounts shows the pod counts of the namespaces of the current context. Cached counts
+// are shown instantly and refreshed in the background once stale.
+func (m *AppModel) loadPodCounts() tea.Cmd {
+	counter, ok := m.kubeAdapter.(PodCounter)
+	if !ok || m.currentContext == nil {
+		return nil
+	}
+
+	contextName := m.currentContext.Name
+	counts, fresh, ok := m.cache.PodCounts(contextName)
+	if ok {
+		m.podCounts = counts
+	}
+	if fresh || m.requests.inFlight(asyncPodCounts, contextName) {
+		return nil
+	}
+	return fetchCmd(m.requests, asyncPodCounts, contextName, func(context.Context) (map[string]int, error) {
+		slog.Debug("counting pods", "context", contextName)
+		return counter.GetPodCounts(contextName)
+	})
+}
+
+// handlePodCounts caches the pod counts of a context and shows them if it is still current
+func (m AppModel) handlePodCounts(msg podCountsMsg) (tea.Model, tea.Cmd) {
+	if !m.requests.current(msg.asyncRequest) {
+		return m, nil
+	}
+	if msg.err != nil {
+		// Cluster-wide listing is often forbidden; counts are best effort
+		slog.Warn("pod count failed", "context", msg.key, "error", msg.err)
+		return m, nil
+	}
+
+	m.cache.SetPodCounts(msg.key, msg.value)
+	if m.currentContext != nil && m.currentContext.Name == msg.key {
+		m.podCounts = msg.value
+	}
+	return m, nil
+}
+
+// updatePodCount sets the count of the current namespace from its freshly fetched pods, so
+// that it matches the pods panel until the counts are refreshed
+func (m *AppModel) updatePodCount(count int) {
+	if m.podCounts == nil || m.podCounts[m.currentNamespace] == count {
+		return
+	}
+	// The map is shared with the cache and snapshots
+	m.podCounts = maps.Clone(m.podCounts)
+	m.podCounts[m.currentNamespace] = count
+}
+
+// podCountBadge renders the pod count of a namespace, e.g. " (37)". Nothing is shown before
+// the counts are known; namespaces without pods show " (0)".
+func (m AppModel) podCountBadge(namespace string) string {
+	if m.podCounts == nil {
+		return ""
+	}
+	return styles.DimStyle.Render(fmt.Sprintf(" (%d)", m.podCounts[namespace]))
+}
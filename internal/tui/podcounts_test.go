@@ -0,0 +1,86 @@
+package tui
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/maratkarimov/kubertino/internal/k8s"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// podCountAdapter is a mock adapter that also counts pods per namespace
+type podCountAdapter struct {
+	*mockKubeAdapter
+	counts map[string]int
+	err    error
+	calls  int
+}
+
+func (a *podCountAdapter) GetPodCounts(context string) (map[string]int, error) {
+	a.calls++
+	return a.counts, a.err
+}
+
+func newPodCountTestModel(adapter *podCountAdapter) AppModel {
+	adapter.mockKubeAdapter = newMockAdapter()
+	return newTestModel(adapter)
+}
+
+func TestPodCounts_ShownNextToNamespaces(t *testing.T) {
+	adapter := &podCountAdapter{counts: map[string]int{"default": 37, "kube-system": 12}}
+	model := newPodCountTestModel(adapter)
+
+	updated, cmd := model.Update(namespaceFetchedMsg{value: k8s.NamespacesNamed(adapter.namespaces)})
+	model = runCmds(updated.(AppModel), cmd, podCountsMsg{})
+	require.Equal(t, 1, adapter.calls, "one call for all namespaces")
+
+	view := model.View()
+	assert.Contains(t, view, "default (37)")
+	assert.Contains(t, view, "kube-system (12)")
+	assert.Contains(t, view, "staging (0)", "namespaces without pods")
+
+	// Cached counts are reused until they go stale
+	updated, cmd = model.Update(namespaceFetchedMsg{value: k8s.NamespacesNamed(adapter.namespaces)})
+	model = runCmds(updated.(AppModel), cmd, podCountsMsg{})
+	assert.Equal(t, 1, adapter.calls)
+}
+
+func TestPodCounts_RefreshedWithTheNamespaces(t *testing.T) {
+	adapter := &podCountAdapter{counts: map[string]int{"default": 37}}
+	model := newPodCountTestModel(adapter)
+	updated, cmd := model.Update(namespaceFetchedMsg{value: k8s.NamespacesNamed(adapter.namespaces)})
+	model = runCmds(updated.(AppModel), cmd, podCountsMsg{})
+
+	adapter.counts = map[string]int{"default": 40}
+	model.focusedPanel = PanelNamespaces
+	updated, cmd = model.Update(runeKey('R'))
+	model = runCmds(updated.(AppModel), cmd, podCountsMsg{})
+	assert.Equal(t, 2, adapter.calls)
+	assert.Contains(t, model.View(), "default (40)")
+}
+
+func TestPodCounts_UpdatedByFetchedPods(t *testing.T) {
+	adapter := &podCountAdapter{counts: map[string]int{"default": 37}}
+	model := newPodCountTestModel(adapter)
+	updated, cmd := model.Update(namespaceFetchedMsg{value: k8s.NamespacesNamed(adapter.namespaces)})
+	model = runCmds(updated.(AppModel), cmd, podCountsMsg{})
+	counts := model.podCounts
+
+	model.currentNamespace = "default"
+	updated, _ = model.Update(podsFetchedMsg{value: adapter.pods})
+	model = updated.(AppModel)
+	assert.Equal(t, 2, model.podCounts["default"])
+	assert.Equal(t, 37, counts["default"], "the cached counts are not changed")
+}
+
+func TestPodCounts_FailureHidesCounts(t *testing.T) {
+	adapter := &podCountAdapter{err: errors.New("pods is forbidden")}
+	model := newPodCountTestModel(adapter)
+
+	updated, cmd := model.Update(namespaceFetchedMsg{value: k8s.NamespacesNamed(adapter.namespaces)})
+	model = runCmds(updated.(AppModel), cmd, podCountsMsg{})
+	assert.Nil(t, model.podCounts)
+	assert.NotContains(t, model.View(), "default (")
+	assert.False(t, model.errorModal.IsVisible, "counts are best effort")
+}
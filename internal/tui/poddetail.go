@@ -0,0 +1,170 @@
+package tui
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"strings"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/maratkarimov/kubertino/internal/k8s"
+	"github.com/maratkarimov/kubertino/internal/tui/styles"
+)
+
+// PodDetailer is implemented by adapters that can fetch the detail of a single pod. Without
+// it, the pods panel shows no detail.
+type PodDetailer interface {
+	GetPodDetail(context, namespace, pod string) (*k8s.PodDetail, error)
+}
+
+// podDetailFetchedMsg is sent when the detail of the selected pod has been fetched
+type podDetailFetchedMsg = resultMsg[*k8s.PodDetail]
+
+// podDetailHeight is the lines the detail block takes under the pod list: a blank line and
+// three lines of detail
+const podDetailHeight = 4
+
+// podDetailDelay is how long a pod has to stay selected before its detail is fetched, so
+// scrolling through the list does not start a kubectl call per pod passed
+const podDetailDelay = 150 * time.Millisecond
+
+// podDetailEntry is the fetched detail of a pod, or why it could not be fetched
+type podDetailEntry struct {
+	detail *k8s.PodDetail
+	err    error
+}
+
+// podDetailKey identifies pod in the current context and namespace
+func (m AppModel) podDetailKey(pod string) string {
+	if m.currentContext == nil {
+		return ""
+	}
+	return m.currentContext.Name + "/" + m.currentNamespace + "/" + pod
+}
+
+// showsPodDetail reports whether the pods panel of the given height has room for the detail
+// block of the selected pod, leaving at least three pods visible
+func (m AppModel) showsPodDetail(height int) bool {
+	if _, ok := m.kubeAdapter.(PodDetailer); !ok || m.podsLoading || m.podsError != nil {
+		return false
+	}
+	if _, ok := m.selectedPod(); !ok {
+		return false
+	}
+	return height-8-podDetailHeight >= 3
+}
+
+// podListHeight returns how many pods fit in a pods panel of the given height
+func (m AppModel) podListHeight(height int) int {
+	// Reserve space for: border (2) + padding (2) + title (1) + blank (1) + help text (2) = 8 lines
+	visibleHeight := height - 8
+	if m.showsPodDetail(height) {
+		visibleHeight -= podDetailHeight
+	}
+	if visibleHeight < 1 {
+		visibleHeight = 5 // Minimum visible items
+	}
+	return visibleHeight
+}
+
+// fetchPodDetail starts fetching the detail of the selected pod unless it is cached or
+// already being fetched. It runs after every update, so whatever selected the pod (keys,
+// mouse, search, a refresh) the detail follows.
+func (m *AppModel) fetchPodDetail() tea.Cmd {
+	if m.viewMode != viewModeNamespaceView || !m.showsPodDetail(m.splitLayout().pods.h) {
+		return nil
+	}
+	pod, _ := m.selectedPod()
+	key := m.podDetailKey(pod.Name)
+	if _, ok := m.podDetails[key]; ok || key == m.podDetailLoading {
+		return nil
+	}
+
+	m.podDetailLoading = key
+	detailer := m.kubeAdapter.(PodDetailer)
+	contextName, namespace := m.currentContext.Name, m.currentNamespace
+	return fetchCmd(m.requests, asyncPodDetail, "", func(ctx context.Context) (*k8s.PodDetail, error) {
+		select {
+		case <-time.After(podDetailDelay):
+		case <-ctx.Done():
+			return nil, ctx.Err() // Another pod was selected in the meantime
+		}
+		detail, err := detailer.GetPodDetail(contextName, namespace, pod.Name)
+		if err != nil {
+			slog.Warn("pod detail fetch failed", "pod", pod.Name, "error", err)
+		}
+		return detail, err
+	})
+}
+
+// handlePodDetailFetched keeps the detail of the pod it was fetched for
+func (m AppModel) handlePodDetailFetched(msg podDetailFetchedMsg) (tea.Model, tea.Cmd) {
+	if !m.requests.current(msg.asyncRequest) {
+		return m, nil
+	}
+	if m.podDetails == nil {
+		m.podDetails = make(map[string]podDetailEntry)
+	}
+	m.podDetails[m.podDetailLoading] = podDetailEntry{detail: msg.value, err: msg.err}
+	m.podDetailLoading = ""
+	return m, nil
+}
+
+// renderPodDetail renders the detail block of the selected pod, cut to width cells
+func (m AppModel) renderPodDetail(width int) string {
+	pod, _ := m.selectedPod()
+	entry, ok := m.podDetails[m.podDetailKey(pod.Name)]
+
+	var lines []string
+	switch {
+	case !ok:
+		lines = []string{"Loading pod details..."}
+	case entry.err != nil:
+		lines = []string{fmt.Sprintf("Pod details unavailable: %v", entry.err)}
+	default:
+		lines = podDetailLines(entry.detail, time.Now())
+	}
+
+	for i, line := range lines {
+		lines[i] = styles.DimStyle.Render(truncateWidth(line, width))
+	}
+	for len(lines) < podDetailHeight-1 {
+		lines = append(lines, "")
+	}
+	return lipgloss.JoinVertical(lipgloss.Left, append([]string{""}, lines...)...)
+}
+
+// podDetailLines returns the three lines describing detail
+func podDetailLines(detail *k8s.PodDetail, now time.Time) []string {
+	orNone := func(value string) string {
+		if value == "" {
+			return "-"
+		}
+		return value
+	}
+
+	owner := "-"
+	if detail.OwnerKind != "" {
+		owner = detail.OwnerKind + "/" + detail.OwnerName
+	}
+	started := "-"
+	if !detail.StartedAt.IsZero() {
+		started = fmt.Sprintf("%s ago (%s)", k8s.FormatAge(now.Sub(detail.StartedAt)), detail.StartedAt.Local().Format("2006-01-02 15:04"))
+	}
+
+	images := "Image: -"
+	if len(detail.Images) > 0 {
+		images = "Image: " + strings.Join(detail.Images, ", ")
+		if len(detail.Images) > 1 {
+			images = "Images: " + strings.Join(detail.Images, ", ")
+		}
+	}
+
+	return []string{
+		fmt.Sprintf("Node: %s  IP: %s  Owner: %s", orNone(detail.Node), orNone(detail.IP), owner),
+		fmt.Sprintf("Restarts: %d  Started: %s", detail.Restarts, started),
+		images,
+	}
+}
@@ -0,0 +1,137 @@
+package tui
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/maratkarimov/kubertino/internal/config"
+	"github.com/maratkarimov/kubertino/internal/k8s"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// podDetailAdapter is a mock adapter that also fetches pod details
+type podDetailAdapter struct {
+	*mockKubeAdapter
+	err     error
+	fetched []string // Pods whose detail was fetched, in order
+}
+
+func (a *podDetailAdapter) GetPodDetail(context, namespace, pod string) (*k8s.PodDetail, error) {
+	a.fetched = append(a.fetched, pod)
+	if a.err != nil {
+		return nil, a.err
+	}
+	return &k8s.PodDetail{
+		Node:      "node-a",
+		IP:        "10.0.3.7",
+		Images:    []string{"shop/" + pod + ":1.4.2"},
+		OwnerKind: "Deployment",
+		OwnerName: "web",
+		Restarts:  2,
+		StartedAt: time.Now().Add(-3 * time.Hour),
+	}, nil
+}
+
+func newPodDetailTestModel(adapter *podDetailAdapter) AppModel {
+	adapter.mockKubeAdapter = newMockAdapter()
+	model := newTestModel(adapter, withContexts(config.Context{Name: "prod"}))
+	model.currentNamespace = "default"
+	model.pods = []k8s.Pod{{Name: "web-1", Status: "Running"}, {Name: "web-2", Status: "Running"}}
+	model.focusedPanel = PanelPods
+	model.selectedPodIndex = 0
+	return model
+}
+
+// deliverPodDetail runs the pending detail fetch of model and hands over its result
+func deliverPodDetail(t *testing.T, model AppModel) AppModel {
+	t.Helper()
+	cmd := model.fetchPodDetail()
+	require.NotNil(t, cmd)
+	updated, _ := model.Update(cmd())
+	return updated.(AppModel)
+}
+
+func TestPodDetail_ShownUnderPodList(t *testing.T) {
+	adapter := &podDetailAdapter{}
+	model := newPodDetailTestModel(adapter)
+
+	assert.Contains(t, model.View(), "Loading pod details...")
+	model = deliverPodDetail(t, model)
+
+	view := model.View()
+	assert.Contains(t, view, "Node: node-a  IP: 10.0.3.7  Owner: Deployment/web")
+	assert.Contains(t, view, "Restarts: 2  Started: 3h ago")
+	assert.Contains(t, view, "Image: shop/web-1:1.4.2")
+	assert.LessOrEqual(t, lipgloss.Height(view), model.termHeight)
+	assert.Equal(t, []string{"web-1"}, adapter.fetched)
+}
+
+func TestPodDetail_FetchedOncePerPod(t *testing.T) {
+	adapter := &podDetailAdapter{}
+	model := newPodDetailTestModel(adapter)
+	model = deliverPodDetail(t, model)
+
+	// Selecting another pod starts fetching its detail
+	model = sendKey(model, tea.KeyMsg{Type: tea.KeyDown})
+	assert.Equal(t, "prod/default/web-2", model.podDetailLoading)
+	assert.Nil(t, model.fetchPodDetail(), "already being fetched")
+	model.podDetailLoading = ""
+	model = deliverPodDetail(t, model)
+	assert.Contains(t, model.View(), "Image: shop/web-2:1.4.2")
+
+	// Going back shows the kept detail without fetching again
+	model = sendKey(model, tea.KeyMsg{Type: tea.KeyUp})
+	assert.Nil(t, model.fetchPodDetail())
+	assert.Contains(t, model.View(), "Image: shop/web-1:1.4.2")
+	assert.Equal(t, []string{"web-1", "web-2"}, adapter.fetched)
+
+	// The refresh key fetches it again
+	model = sendKey(model, runeKey('R'))
+	assert.Empty(t, model.podDetails)
+}
+
+func TestPodDetail_SupersededFetchIsDropped(t *testing.T) {
+	adapter := &podDetailAdapter{}
+	model := newPodDetailTestModel(adapter)
+
+	stale := model.fetchPodDetail()
+	model.selectedPodIndex = 1
+	current := model.fetchPodDetail()
+	require.NotNil(t, current)
+
+	updated, _ := model.Update(stale())
+	model = updated.(AppModel)
+	assert.Empty(t, model.podDetails, "the first pod was left before its detail was fetched")
+	assert.NotContains(t, adapter.fetched, "web-1")
+
+	updated, _ = model.Update(current())
+	model = updated.(AppModel)
+	assert.Contains(t, model.podDetails, "prod/default/web-2")
+}
+
+func TestPodDetail_Error(t *testing.T) {
+	adapter := &podDetailAdapter{err: errors.New("pods \"web-1\" is forbidden")}
+	model := newPodDetailTestModel(adapter)
+	model = deliverPodDetail(t, model)
+
+	assert.Contains(t, model.View(), "Pod details unavailable")
+}
+
+func TestPodDetail_HiddenWithoutRoom(t *testing.T) {
+	model := newPodDetailTestModel(&podDetailAdapter{})
+	model.termHeight = 12
+
+	assert.False(t, model.showsPodDetail(model.splitLayout().pods.h))
+	assert.Nil(t, model.fetchPodDetail())
+	assert.NotContains(t, model.View(), "Loading pod details")
+
+	// Adapters without pod details show none
+	model = newPodDetailTestModel(&podDetailAdapter{})
+	model.kubeAdapter = newMockAdapter()
+	assert.Nil(t, model.fetchPodDetail())
+	assert.Equal(t, model.splitLayout().pods.h-8, model.podListHeight(model.splitLayout().pods.h))
+}
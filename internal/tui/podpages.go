@@ -0,0 +1,156 @@
+package tui
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"slices"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/maratkarimov/kubertino/internal/k8s"
+	"github.com/maratkarimov/kubertino/internal/tui/components"
+)
+
+// PodPager is implemented by adapters that can list pods a page at a time. Without it, pods
+// are always fetched at once.
+type PodPager interface {
+	GetPodsPage(context, namespace string, limit int, continueToken, selector string) (k8s.PodPage, error)
+}
+
+// podPageFetchedMsg is sent when a page of pods has been fetched: the first page as an
+// asyncPods request, further ones as asyncPodsMore
+type podPageFetchedMsg = resultMsg[k8s.PodPage]
+
+// podPageSize returns how many pods are fetched per page in the current context, or 0 when
+// all pods are fetched at once
+func (m AppModel) podPageSize() int {
+	if _, ok := m.kubeAdapter.(PodPager); !ok || m.config == nil || m.currentContext == nil {
+		return 0
+	}
+	return m.config.PodPageLimit(m.currentContext.Name)
+}
+
+// fetchPodPageCmd returns a command that fetches the pod page continueToken refers to, or the
+// first page when it is empty
+func (m AppModel) fetchPodPageCmd(kind asyncKind, limit int, continueToken string) tea.Cmd {
+	pager, _ := m.kubeAdapter.(PodPager)
+	if m.currentContext == nil || pager == nil {
+		return nil
+	}
+
+	contextName, namespace, selector := m.currentContext.Name, m.currentNamespace, m.podSelector
+	return fetchCmd(m.requests, kind, "", func(context.Context) (k8s.PodPage, error) {
+		if namespace == "" {
+			return k8s.PodPage{}, fmt.Errorf("no namespace selected")
+		}
+
+		slog.Info("fetching pod page", "context", contextName, "namespace", namespace, "limit", limit, "next", continueToken != "")
+		page, err := pager.GetPodsPage(contextName, namespace, limit, continueToken, selector)
+		if err != nil {
+			slog.Error("pod page fetch failed", "context", contextName, "namespace", namespace, "error", err)
+		}
+		return page, err
+	})
+}
+
+// handlePodsFetched shows fetched pods, or the error the fetch failed with. continueToken is
+// set when only the first page of the pods was fetched.
+func (m AppModel) handlePodsFetched(pods []k8s.Pod, continueToken string, err error) (tea.Model, tea.Cmd) {
+	// Handle pod fetch results (Story 6.3: use spinners and modal)
+	m.podsLoading = false
+	m.podsSpinner.Stop()
+
+	if err != nil {
+		m.refreshing = ""
+		m.podsError = err
+		// Story 6.3: Show error modal with retry capability
+		m.showError(
+			err.Error(),
+			err,
+			"Fetch Pods",
+			"Check your network connection and cluster access",
+			func() tea.Cmd { return m.fetchPodsCmd() },
+		)
+		return m, nil
+	}
+	m.podsError = nil
+	m.podsContinue = continueToken
+	m.podsLoadingMore = false
+	// The cache holds every pod of a namespace, not those a label selector matched
+	if m.currentContext != nil && m.podSelector == "" {
+		m.cache.SetPods(m.currentContext.Name, m.currentNamespace, pods)
+		if continueToken == "" {
+			m.updatePodCount(len(pods))
+		}
+	}
+	m.setPods(pods)
+	metricsCmd := m.fetchMetricsCmd()
+	if m.refreshing == asyncPods {
+		m.refreshing = ""
+		return m, tea.Batch(metricsCmd, m.notify("Pods refreshed", components.ToastInfo))
+	}
+	return m, metricsCmd
+}
+
+// handlePodPageFetched shows a fetched page of pods. The first page replaces the pods shown,
+// further pages are added to them.
+func (m AppModel) handlePodPageFetched(msg podPageFetchedMsg) (tea.Model, tea.Cmd) {
+	if !m.requests.current(msg.asyncRequest) {
+		return m, nil // Superseded by a newer request
+	}
+	if msg.kind != asyncPodsMore {
+		return m.handlePodsFetched(msg.value.Pods, msg.value.Continue, msg.err)
+	}
+
+	m.podsLoadingMore = false
+	if msg.err != nil {
+		// The pods already shown stay; pressing ↓ again retries
+		return m, m.notify("Could not load more pods: "+msg.err.Error(), components.ToastWarning)
+	}
+
+	pods := append(slices.Clone(m.pods), msg.value.Pods...)
+	m.podsContinue = msg.value.Continue
+	if m.currentContext != nil && m.podSelector == "" {
+		m.cache.SetPods(m.currentContext.Name, m.currentNamespace, pods)
+		if m.podsContinue == "" {
+			m.updatePodCount(len(pods)) // The last page
+		}
+	}
+	m.setPods(pods)
+	return m, nil
+}
+
+// atPodListEnd reports whether the last loaded pod is selected while more pods are left
+// on the server
+func (m AppModel) atPodListEnd() bool {
+	if m.focusedPanel != PanelPods || m.browsingResources() || m.podsContinue == "" {
+		return false
+	}
+	list := m.podList()
+	return len(list) > 0 && m.selectedPodIndex == len(list)-1
+}
+
+// loadMorePods fetches the next page of pods unless it is already being fetched
+func (m AppModel) loadMorePods() (tea.Model, tea.Cmd) {
+	limit := m.podPageSize()
+	if m.podsLoadingMore || limit == 0 {
+		return m, nil
+	}
+
+	cmd := m.fetchPodPageCmd(asyncPodsMore, limit, m.podsContinue)
+	m.podsLoadingMore = cmd != nil
+	return m, cmd
+}
+
+// morePodsLine tells below the pod list that more pods are left on the server, or "" when
+// every pod is loaded
+func (m AppModel) morePodsLine() string {
+	switch {
+	case m.podsLoadingMore:
+		return "Loading more pods..."
+	case m.podsContinue != "":
+		return fmt.Sprintf("%d pods loaded, ↓ at the end loads %d more", len(m.pods), m.podPageSize())
+	default:
+		return ""
+	}
+}
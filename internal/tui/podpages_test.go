@@ -0,0 +1,160 @@
+package tui
+
+import (
+	"strconv"
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/maratkarimov/kubertino/internal/config"
+	"github.com/maratkarimov/kubertino/internal/k8s"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// pagingAdapter is a mock adapter that lists its pods a page at a time; the continue
+// token is the index of the first pod of the next page
+type pagingAdapter struct {
+	*mockKubeAdapter
+	calls int
+}
+
+func (a *pagingAdapter) GetPodsPage(context, namespace string, limit int, continueToken, selector string) (k8s.PodPage, error) {
+	a.calls++
+	if a.err != nil {
+		return k8s.PodPage{}, a.err
+	}
+	start, _ := strconv.Atoi(continueToken)
+	end := min(start+limit, len(a.pods))
+	page := k8s.PodPage{Pods: a.pods[start:end]}
+	if end < len(a.pods) {
+		page.Continue = strconv.Itoa(end)
+	}
+	return page, nil
+}
+
+// newPagingTestModel returns a model showing the first page of five pods paged by two
+func newPagingTestModel(t *testing.T) (AppModel, *pagingAdapter) {
+	t.Helper()
+	adapter := &pagingAdapter{mockKubeAdapter: newMockAdapter()}
+	adapter.pods = []k8s.Pod{
+		{Name: "pod-a", Status: "Running"},
+		{Name: "pod-b", Status: "Running"},
+		{Name: "pod-c", Status: "Running"},
+		{Name: "pod-d", Status: "Running"},
+		{Name: "pod-e", Status: "Running"},
+	}
+	model := newTestModel(adapter, withContexts(config.Context{Name: "test-context", PodPageSize: 2}),
+		func(cfg *config.Config) { cfg.PodPageSize = 100 })
+	model.viewMode = viewModeNamespaceView
+	model.currentContext = &model.config.Contexts[0]
+	model.currentNamespace = "default"
+	model.focusedPanel = PanelPods
+
+	updated, _ := model.Update(model.fetchPodsCmd()())
+	model = updated.(AppModel)
+	require.Len(t, model.pods, 2)
+	return model, adapter
+}
+
+// pressDown presses ↓ and delivers the fetch result it starts, if any
+func pressDown(t *testing.T, model AppModel) AppModel {
+	t.Helper()
+	updated, cmd := model.Update(tea.KeyMsg{Type: tea.KeyDown})
+	model = updated.(AppModel)
+	if cmd != nil {
+		updated, _ = model.Update(cmd())
+		model = updated.(AppModel)
+	}
+	return model
+}
+
+func TestPodPages_DownAtEndLoadsMore(t *testing.T) {
+	model, adapter := newPagingTestModel(t)
+	assert.Equal(t, "2", model.podsContinue)
+	assert.Contains(t, model.renderPodPanel(60, 30), "2 pods loaded, ↓ at the end loads 2 more")
+
+	model = pressDown(t, model)
+	assert.Equal(t, 1, model.selectedPodIndex)
+	assert.Equal(t, 1, adapter.calls, "moving within the loaded pods fetches nothing")
+
+	model = pressDown(t, model)
+	assert.Len(t, model.pods, 4)
+	assert.Equal(t, "pod-b", model.pods[model.selectedPodIndex].Name, "the selection stays put")
+
+	model = pressDown(t, model)
+	model = pressDown(t, model)
+	model = pressDown(t, model)
+	assert.Len(t, model.pods, 5)
+	assert.Empty(t, model.podsContinue)
+	assert.NotContains(t, model.renderPodPanel(60, 30), "pods loaded")
+
+	model = pressDown(t, model)
+	model = pressDown(t, model)
+	assert.Equal(t, 4, model.selectedPodIndex)
+	assert.Equal(t, 3, adapter.calls, "nothing is left to load")
+}
+
+func TestPodPages_LoadingMoreOnce(t *testing.T) {
+	model, adapter := newPagingTestModel(t)
+	model.selectedPodIndex = 1
+
+	updated, cmd := model.Update(tea.KeyMsg{Type: tea.KeyDown})
+	model = updated.(AppModel)
+	require.NotNil(t, cmd)
+	assert.True(t, model.podsLoadingMore)
+	assert.Contains(t, model.renderPodPanel(60, 30), "Loading more pods...")
+
+	updated, second := model.Update(tea.KeyMsg{Type: tea.KeyDown})
+	model = updated.(AppModel)
+	assert.Nil(t, second, "a page already being fetched is not fetched again")
+
+	updated, _ = model.Update(cmd())
+	model = updated.(AppModel)
+	assert.False(t, model.podsLoadingMore)
+	assert.Len(t, model.pods, 4)
+	assert.Equal(t, 2, adapter.calls)
+}
+
+func TestPodPages_FailedPageKeepsPods(t *testing.T) {
+	model, adapter := newPagingTestModel(t)
+	model.selectedPodIndex = 1
+	adapter.err = assert.AnError
+
+	model = pressDown(t, model)
+	assert.Len(t, model.pods, 2)
+	assert.Equal(t, "2", model.podsContinue, "pressing ↓ again retries")
+	assert.False(t, model.podsLoadingMore)
+	assert.False(t, model.errorModal.IsVisible)
+}
+
+func TestPodPages_NamespaceSwitchDropsPages(t *testing.T) {
+	model, _ := newPagingTestModel(t)
+	model.selectedPodIndex = 1
+
+	updated, cmd := model.Update(tea.KeyMsg{Type: tea.KeyDown})
+	model = updated.(AppModel)
+	require.NotNil(t, cmd)
+
+	model.currentNamespace = "staging"
+	model.loadPods()
+	assert.Empty(t, model.podsContinue)
+	assert.False(t, model.podsLoadingMore)
+
+	updated, _ = model.Update(cmd())
+	model = updated.(AppModel)
+	assert.Len(t, model.pods, 2, "a page of the previous namespace is dropped")
+}
+
+func TestPodPages_OffWithoutPageSize(t *testing.T) {
+	adapter := &pagingAdapter{mockKubeAdapter: newMockAdapter()}
+	model := newTestModel(adapter)
+	model.currentContext = &model.config.Contexts[0]
+	model.currentNamespace = "default"
+
+	assert.Zero(t, model.podPageSize())
+	updated, _ := model.Update(model.fetchPodsCmd()())
+	model = updated.(AppModel)
+	assert.Len(t, model.pods, 2)
+	assert.Zero(t, adapter.calls, "pods are fetched at once")
+	assert.Empty(t, model.podsContinue)
+}
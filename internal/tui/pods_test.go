@@ -191,7 +191,7 @@ func TestPodsFetchedMsg_Success(t *testing.T) {
 
 	// Simulate successful pod fetch
 	msg := podsFetchedMsg{
-		pods: []k8s.Pod{
+		value: []k8s.Pod{
 			{Name: "pod-1", Status: "Running"},
 			{Name: "pod-2", Status: "Pending"},
 		},
@@ -229,8 +229,8 @@ func TestPodsFetchedMsg_Error(t *testing.T) {
 
 	// Simulate failed pod fetch
 	msg := podsFetchedMsg{
-		pods: nil,
-		err:  errors.New("permission denied"),
+		value: nil,
+		err:   errors.New("permission denied"),
 	}
 
 	updatedModel, _ := model.Update(msg)
@@ -287,7 +287,7 @@ func TestNamespaceSelection_TriggersPodFetch(t *testing.T) {
 		kubeAdapter:            adapter,
 		viewMode:               viewModeNamespaceView,
 		currentNamespace:       "default",
-		namespaces:             []string{"default", "kube-system"},
+		namespaces:             k8s.NamespacesNamed([]string{"default", "kube-system"}),
 		selectedNamespaceIndex: 0,
 		termWidth:              80,
 		termHeight:             24,
@@ -304,7 +304,7 @@ func TestNamespaceSelection_TriggersPodFetch(t *testing.T) {
 	podMsg, ok := msg.(podsFetchedMsg)
 	assert.True(t, ok, "Message should be podsFetchedMsg")
 	assert.NoError(t, podMsg.err)
-	assert.NotNil(t, podMsg.pods)
+	assert.NotNil(t, podMsg.value)
 }
 
 func TestSearchModeNamespaceSelection_TriggersPodFetch(t *testing.T) {
@@ -326,8 +326,8 @@ func TestSearchModeNamespaceSelection_TriggersPodFetch(t *testing.T) {
 		kubeAdapter:            adapter,
 		viewMode:               viewModeNamespaceView,
 		currentNamespace:       "default",
-		namespaces:             []string{"default", "kube-system"},
-		filteredNamespaces:     []string{"default"},
+		namespaces:             k8s.NamespacesNamed([]string{"default", "kube-system"}),
+		filteredNamespaces:     k8s.NamespacesNamed([]string{"default"}),
 		selectedNamespaceIndex: 0,
 		searchMode:             false, // Already exited search mode
 		termWidth:              80,
@@ -345,7 +345,7 @@ func TestSearchModeNamespaceSelection_TriggersPodFetch(t *testing.T) {
 	podMsg, ok := msg.(podsFetchedMsg)
 	assert.True(t, ok, "Message should be podsFetchedMsg")
 	assert.NoError(t, podMsg.err)
-	assert.NotNil(t, podMsg.pods)
+	assert.NotNil(t, podMsg.value)
 }
 
 func TestPodPanel_FormattingConsistency(t *testing.T) {
@@ -0,0 +1,165 @@
+package tui
+
+import (
+	"log/slog"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/maratkarimov/kubertino/internal/k8s"
+	"github.com/maratkarimov/kubertino/internal/search"
+	"github.com/maratkarimov/kubertino/internal/tui/styles"
+)
+
+// podList returns the pods shown in the pods panel: those passing the status filter,
+// narrowed further while pod search is active. selectedPodIndex and podScrollOffset index
+// into this list.
+func (m AppModel) podList() []k8s.Pod {
+	if m.podSearchMode && m.filteredPods != nil {
+		return m.filteredPods
+	}
+	return m.statusPods()
+}
+
+// selectedPod returns the pod under the cursor in the pods panel
+func (m AppModel) selectedPod() (k8s.Pod, bool) {
+	if m.browsingResources() {
+		return k8s.Pod{}, false
+	}
+	pods := m.podList()
+	if m.selectedPodIndex < 0 || m.selectedPodIndex >= len(pods) {
+		return k8s.Pod{}, false
+	}
+	return pods[m.selectedPodIndex], true
+}
+
+// activatePodSearch enables search mode for the pods panel
+func (m *AppModel) activatePodSearch() {
+	slog.Debug("pod search mode activated")
+	m.podSearchMode = true
+	m.updatePodSearchQuery("")
+}
+
+// deactivatePodSearch disables pod search and keeps the cursor on the selected pod
+func (m *AppModel) deactivatePodSearch() {
+	slog.Debug("pod search mode deactivated")
+
+	selected, ok := m.selectedPod()
+
+	m.podSearchMode = false
+	m.podSearchQuery = ""
+	m.filteredPods = nil
+	m.podMatchIndices = nil
+	m.podScrollOffset = 0
+
+	pods := m.statusPods()
+	if ok {
+		for i, pod := range pods {
+			if pod.Name == selected.Name {
+				m.selectedPodIndex = i
+				m.adjustPodScrollOffset()
+				return
+			}
+		}
+	}
+
+	// Fallback: nothing matched the query, select the first pod
+	m.selectedPodIndex = -1
+	if len(pods) > 0 {
+		m.selectedPodIndex = 0
+	}
+}
+
+// updatePodSearchQuery updates the pod search query and filters pods
+func (m *AppModel) updatePodSearchQuery(query string) {
+	m.podSearchQuery = query
+
+	pods := m.statusPods()
+	names := make([]string, len(pods))
+	for i, pod := range pods {
+		names[i] = pod.Name
+	}
+
+	matches := search.FuzzyMatchNames(query, names)
+	m.filteredPods = make([]k8s.Pod, len(matches))
+	m.podMatchIndices = make(map[string][]int, len(matches))
+	for i, match := range matches {
+		pod := pods[match.Index]
+		m.filteredPods[i] = pod
+		m.podMatchIndices[pod.Name] = match.MatchIndices
+	}
+
+	// Reset selection to first result
+	m.selectedPodIndex = -1
+	if len(m.filteredPods) > 0 {
+		m.selectedPodIndex = 0
+	}
+	m.podScrollOffset = 0
+
+	slog.Debug("pod search query updated", "query", query, "results", len(m.filteredPods))
+}
+
+// handlePodSearchKey handles key presses while pod search is active.
+// Printable characters go to the query, so navigation uses the arrow keys only.
+func (m AppModel) handlePodSearchKey(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	switch msg.Type {
+	case tea.KeyCtrlC:
+		return m, tea.Quit
+
+	case tea.KeyEsc, tea.KeyEnter:
+		m.deactivatePodSearch()
+		return m, nil
+
+	case tea.KeyUp:
+		if m.selectedPodIndex > 0 {
+			m.selectedPodIndex--
+			m.adjustPodScrollOffset()
+		}
+		return m, nil
+
+	case tea.KeyDown:
+		if m.selectedPodIndex < len(m.podList())-1 {
+			m.selectedPodIndex++
+			m.adjustPodScrollOffset()
+		}
+		return m, nil
+
+	case tea.KeyBackspace:
+		if len(m.podSearchQuery) > 0 {
+			m.updatePodSearchQuery(m.podSearchQuery[:len(m.podSearchQuery)-1])
+		}
+		return m, nil
+
+	case tea.KeyRunes:
+		if len(msg.Runes) == 1 {
+			r := msg.Runes[0]
+			// Accept characters valid in pod names
+			if (r >= 'a' && r <= 'z') || (r >= 'A' && r <= 'Z') || (r >= '0' && r <= '9') || r == '-' || r == '.' || r == '_' {
+				m.updatePodSearchQuery(m.podSearchQuery + string(r))
+			}
+		}
+		return m, nil
+	}
+
+	return m, nil
+}
+
+// renderPodNameWithHighlight renders a pod name with the characters matching the search highlighted
+func (m AppModel) renderPodNameWithHighlight(name string) string {
+	if !m.podSearchMode || m.podSearchQuery == "" {
+		return name
+	}
+
+	matchSet := make(map[int]bool)
+	for _, idx := range m.podMatchIndices[name] {
+		matchSet[idx] = true
+	}
+
+	result := ""
+	for i, char := range name {
+		if matchSet[i] {
+			result += styles.HighlightStyle.Render(string(char))
+		} else {
+			result += string(char)
+		}
+	}
+	return result
+}
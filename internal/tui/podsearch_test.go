@@ -0,0 +1,108 @@
+package tui
+
+import (
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/maratkarimov/kubertino/internal/k8s"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newPodSearchTestModel() AppModel {
+	model := newTestModel(newMockAdapter())
+	model.currentNamespace = "default"
+	model.pods = []k8s.Pod{
+		{Name: "api-server-7d9f", Status: "Running"},
+		{Name: "worker-5c8b", Status: "Running"},
+		{Name: "api-gateway-1a2b", Status: "Pending"},
+		{Name: "queue-9x8y", Status: "Running"},
+	}
+	model.focusedPanel = PanelPods
+	model.selectedPodIndex = 0
+	return model
+}
+
+func typeQuery(model AppModel, query string) AppModel {
+	for _, r := range query {
+		model = sendKey(model, runeKey(r))
+	}
+	return model
+}
+
+func TestPodSearch_ActivatesOnlyInPodsPanel(t *testing.T) {
+	model := newPodSearchTestModel()
+	model = sendKey(model, runeKey('/'))
+	assert.True(t, model.podSearchMode, "/ should start pod search when pods panel is focused")
+	assert.False(t, model.searchMode, "namespace search must stay inactive")
+
+	model = newPodSearchTestModel()
+	model.focusedPanel = PanelNamespaces
+	model = sendKey(model, runeKey('/'))
+	assert.False(t, model.podSearchMode)
+	assert.True(t, model.searchMode, "/ should start namespace search when namespaces panel is focused")
+}
+
+func TestPodSearch_FiltersAndHighlights(t *testing.T) {
+	model := newPodSearchTestModel()
+	model = sendKey(model, runeKey('/'))
+	model = typeQuery(model, "api")
+
+	require.Len(t, model.podList(), 2)
+	for _, pod := range model.podList() {
+		assert.Contains(t, pod.Name, "api")
+	}
+	assert.Equal(t, []int{0, 1, 2}, model.podMatchIndices[model.podList()[0].Name])
+
+	view := model.renderPodPanel(60, 20)
+	assert.Contains(t, view, "Search: api_")
+	assert.NotContains(t, view, "worker-5c8b")
+
+	// Typed characters that are also bindings (q quits, k moves up) go to the query
+	model = typeQuery(model, "q")
+	assert.Equal(t, "apiq", model.podSearchQuery)
+	assert.Empty(t, model.podList())
+	assert.Contains(t, model.renderPodPanel(60, 20), "No matching pods")
+
+	model = sendKey(model, tea.KeyMsg{Type: tea.KeyBackspace})
+	assert.Equal(t, "api", model.podSearchQuery)
+	assert.Len(t, model.podList(), 2)
+}
+
+func TestPodSearch_ExitPreservesCursor(t *testing.T) {
+	tests := []struct {
+		name string
+		key  tea.KeyMsg
+	}{
+		{"enter", tea.KeyMsg{Type: tea.KeyEnter}},
+		{"escape", tea.KeyMsg{Type: tea.KeyEsc}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			model := newPodSearchTestModel()
+			model = sendKey(model, runeKey('/'))
+			model = typeQuery(model, "queue")
+			selected, ok := model.selectedPod()
+			require.True(t, ok)
+			require.Equal(t, "queue-9x8y", selected.Name)
+
+			model = sendKey(model, tt.key)
+
+			assert.False(t, model.podSearchMode)
+			assert.Nil(t, model.filteredPods)
+			assert.Equal(t, 3, model.selectedPodIndex, "cursor should map back to the pod in the full list")
+		})
+	}
+}
+
+func TestPodSearch_ActionUsesFilteredSelection(t *testing.T) {
+	model := newPodSearchTestModel()
+	model = sendKey(model, runeKey('/'))
+	model = typeQuery(model, "work")
+	model = sendKey(model, tea.KeyMsg{Type: tea.KeyDown})
+
+	pod, ok := model.selectedPod()
+	require.True(t, ok)
+	assert.Equal(t, "worker-5c8b", pod.Name, "selection indexes into the filtered list")
+}
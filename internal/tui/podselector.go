@@ -0,0 +1,103 @@
+package tui
+
+import (
+	"log/slog"
+	"strings"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/maratkarimov/kubertino/internal/config"
+	"github.com/maratkarimov/kubertino/internal/k8s"
+	"github.com/maratkarimov/kubertino/internal/tui/styles"
+)
+
+// PodSelectorLister is implemented by adapters that can list the pods matching a label
+// selector. Without it, the label selector key does nothing.
+type PodSelectorLister interface {
+	GetSelectedPods(context, namespace, selector string) ([]k8s.Pod, error)
+}
+
+// getPods fetches the pods of a namespace matching selector, or all pods when it is empty
+func (m AppModel) getPods(contextName, namespace, selector string) ([]k8s.Pod, error) {
+	if lister, ok := m.kubeAdapter.(PodSelectorLister); ok && selector != "" {
+		return lister.GetSelectedPods(contextName, namespace, selector)
+	}
+	return m.kubeAdapter.GetPods(contextName, namespace)
+}
+
+// openPodSelector starts editing the label selector of the pods panel, pre-filled with the
+// active one
+func (m AppModel) openPodSelector() (tea.Model, tea.Cmd) {
+	if _, ok := m.kubeAdapter.(PodSelectorLister); !ok || m.currentContext == nil {
+		return m, nil
+	}
+	slog.Debug("pod label selector input opened", "selector", m.podSelector)
+	m.podSelectorEditing = true
+	m.podSelectorDraft = m.podSelector
+	m.podSelectorError = ""
+	return m, nil
+}
+
+// handlePodSelectorKey handles key presses while the label selector is being edited.
+// Enter applies it (an empty selector lists all pods again), ESC keeps the active one.
+func (m AppModel) handlePodSelectorKey(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	switch msg.Type {
+	case tea.KeyCtrlC:
+		return m, tea.Quit
+
+	case tea.KeyEsc:
+		m.podSelectorEditing = false
+		m.podSelectorError = ""
+
+	case tea.KeyEnter:
+		selector := strings.TrimSpace(m.podSelectorDraft)
+		if selector != "" {
+			if err := config.CheckLabelSelector(selector); err != nil {
+				m.podSelectorError = err.Error()
+				return m, nil
+			}
+		}
+		return m.applyPodSelector(selector)
+
+	case tea.KeyBackspace:
+		if runes := []rune(m.podSelectorDraft); len(runes) > 0 {
+			m.podSelectorDraft = string(runes[:len(runes)-1])
+		}
+		m.podSelectorError = ""
+
+	case tea.KeyCtrlU:
+		m.podSelectorDraft = ""
+		m.podSelectorError = ""
+
+	case tea.KeyRunes, tea.KeySpace:
+		m.podSelectorDraft += string(msg.Runes)
+		m.podSelectorError = ""
+	}
+	return m, nil
+}
+
+// applyPodSelector lists the pods matching selector from now on, or all pods when it is
+// empty, and refetches them
+func (m AppModel) applyPodSelector(selector string) (tea.Model, tea.Cmd) {
+	m.podSelectorEditing = false
+	m.podSelectorError = ""
+	if selector == m.podSelector {
+		return m, nil
+	}
+
+	slog.Info("pod label selector changed", "selector", selector)
+	m.podSelector = selector
+	if m.currentNamespace == "" {
+		return m, nil
+	}
+	cmd := m.loadPods()
+	return m, cmd
+}
+
+// renderPodSelectorHelp renders the help line under the pods while the label selector is
+// being edited, or why the entered one was refused
+func (m AppModel) renderPodSelectorHelp(width int) string {
+	if m.podSelectorError != "" {
+		return styles.ErrorStyle.Copy().Width(width).Render(m.podSelectorError)
+	}
+	return styles.HelpTextStyle.Copy().Width(width).Render("Type a selector, e.g. app=web,tier=frontend | Enter: Apply (empty lists all pods) | ESC: Cancel")
+}
@@ -0,0 +1,141 @@
+package tui
+
+import (
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/maratkarimov/kubertino/internal/k8s"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// selectorAdapter is a mock adapter that lists the pods whose app label equals the
+// value of an "app=" selector
+type selectorAdapter struct {
+	*mockKubeAdapter
+	selectors []string
+}
+
+func (a *selectorAdapter) GetSelectedPods(context, namespace, selector string) ([]k8s.Pod, error) {
+	a.selectors = append(a.selectors, selector)
+	var pods []k8s.Pod
+	for _, pod := range a.pods {
+		if "app="+pod.Labels["app"] == selector {
+			pods = append(pods, pod)
+		}
+	}
+	return pods, nil
+}
+
+// newSelectorTestModel returns a model showing the web and db pods of the default namespace
+func newSelectorTestModel(t *testing.T) (AppModel, *selectorAdapter) {
+	t.Helper()
+	adapter := &selectorAdapter{mockKubeAdapter: newMockAdapter()}
+	adapter.pods = []k8s.Pod{
+		{Name: "web-1", Status: "Running", Labels: map[string]string{"app": "web"}},
+		{Name: "db-1", Status: "Running", Labels: map[string]string{"app": "db"}},
+	}
+	model := newTestModel(adapter)
+	model.viewMode = viewModeNamespaceView
+	model.currentContext = &model.config.Contexts[0]
+	model.currentNamespace = "default"
+	model.focusedPanel = PanelPods
+
+	model.loadPods()
+	updated, _ := model.Update(model.fetchPodsCmd()())
+	model = updated.(AppModel)
+	require.Len(t, model.pods, 2)
+	return model, adapter
+}
+
+// typeText sends each character of text as a key press
+func typeText(model AppModel, text string) AppModel {
+	for _, r := range text {
+		model = sendKey(model, runeKey(r))
+	}
+	return model
+}
+
+func TestPodSelector_AppliedAndCleared(t *testing.T) {
+	model, adapter := newSelectorTestModel(t)
+
+	model = sendKey(model, runeKey('L'))
+	require.True(t, model.podSelectorEditing)
+	model = typeText(model, "app=web")
+	assert.Contains(t, model.renderPodPanel(60, 30), "Label selector: app=web_")
+
+	updated, cmd := model.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	model = updated.(AppModel)
+	require.NotNil(t, cmd)
+	assert.False(t, model.podSelectorEditing)
+	assert.True(t, model.podsLoading, "pods matching a selector are not cached")
+
+	updated, _ = model.Update(model.fetchPodsCmd()())
+	model = updated.(AppModel)
+	assert.Equal(t, []string{"app=web"}, adapter.selectors)
+	require.Len(t, model.pods, 1)
+	assert.Equal(t, "web-1", model.pods[0].Name)
+	assert.Contains(t, model.renderPodPanel(60, 30), "-l app=web (ctrl+k: clear)")
+
+	cached, _, _ := model.cache.Pods("test-context", "default")
+	assert.Len(t, cached, 2, "the cache keeps every pod")
+
+	model = sendKey(model, tea.KeyMsg{Type: tea.KeyCtrlK})
+	assert.Empty(t, model.podSelector)
+	assert.Len(t, model.pods, 2, "all pods are shown from the cache")
+	assert.NotContains(t, model.renderPodPanel(60, 30), "-l app=web")
+}
+
+func TestPodSelector_NoMatches(t *testing.T) {
+	model, _ := newSelectorTestModel(t)
+
+	model = sendKey(model, runeKey('L'))
+	model = typeText(model, "app=cache")
+	updated, _ := model.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	model = updated.(AppModel)
+	updated, _ = model.Update(model.fetchPodsCmd()())
+	model = updated.(AppModel)
+
+	assert.Empty(t, model.pods)
+	assert.Contains(t, model.renderPodPanel(60, 30), "No pods match -l app=cache")
+}
+
+func TestPodSelector_InvalidRefused(t *testing.T) {
+	model, adapter := newSelectorTestModel(t)
+
+	model = sendKey(model, runeKey('L'))
+	model = typeText(model, "app=web;")
+	model = sendKey(model, tea.KeyMsg{Type: tea.KeyEnter})
+	assert.True(t, model.podSelectorEditing)
+	assert.Contains(t, model.renderPodPanel(80, 30), "invalid label selector")
+	assert.Empty(t, adapter.selectors)
+
+	model = sendKey(model, tea.KeyMsg{Type: tea.KeyBackspace})
+	assert.Empty(t, model.podSelectorError)
+	assert.Equal(t, "app=web", model.podSelectorDraft)
+}
+
+func TestPodSelector_EscKeepsActive(t *testing.T) {
+	model, _ := newSelectorTestModel(t)
+	model.podSelector = "app=web"
+
+	model = sendKey(model, runeKey('L'))
+	assert.Equal(t, "app=web", model.podSelectorDraft, "the input starts with the active selector")
+	model = sendKey(model, tea.KeyMsg{Type: tea.KeyCtrlU})
+	model = typeText(model, "app=db")
+	model = sendKey(model, tea.KeyMsg{Type: tea.KeyEsc})
+
+	assert.False(t, model.podSelectorEditing)
+	assert.Equal(t, "app=web", model.podSelector)
+	assert.Equal(t, viewModeNamespaceView, model.viewMode, "ESC does not quit")
+}
+
+func TestPodSelector_Unsupported(t *testing.T) {
+	model := newTestModel(newMockAdapter())
+	model.viewMode = viewModeNamespaceView
+	model.currentContext = &model.config.Contexts[0]
+	model.currentNamespace = "default"
+
+	model = sendKey(model, runeKey('L'))
+	assert.False(t, model.podSelectorEditing)
+}
@@ -0,0 +1,78 @@
+package tui
+
+import (
+	"slices"
+	"strings"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/maratkarimov/kubertino/internal/k8s"
+)
+
+// PodSortMode is the order of the pods panel
+type PodSortMode int
+
+const (
+	PodSortName     PodSortMode = iota // Alphabetical by pod name
+	PodSortStatus                      // Alphabetical by status, then name
+	PodSortAge                         // Newest pods first
+	PodSortRestarts                    // Most restarts first
+)
+
+// String returns the label shown in the pods panel title
+func (s PodSortMode) String() string {
+	switch s {
+	case PodSortStatus:
+		return "status"
+	case PodSortAge:
+		return "age"
+	case PodSortRestarts:
+		return "restarts"
+	default:
+		return "name"
+	}
+}
+
+// next returns the sort mode that follows s, wrapping around to name
+func (s PodSortMode) next() PodSortMode {
+	return (s + 1) % (PodSortRestarts + 1)
+}
+
+// sortPods orders pods in place by mode. Ties are broken by name so the order is stable
+// across refreshes.
+func sortPods(pods []k8s.Pod, mode PodSortMode) {
+	slices.SortStableFunc(pods, func(a, b k8s.Pod) int {
+		var c int
+		switch mode {
+		case PodSortStatus:
+			c = strings.Compare(a.Status, b.Status)
+		case PodSortAge:
+			c = b.CreatedAt.Compare(a.CreatedAt)
+		case PodSortRestarts:
+			c = b.Restarts - a.Restarts
+		}
+		if c != 0 {
+			return c
+		}
+		return strings.Compare(a.Name, b.Name)
+	})
+}
+
+// handleCyclePodSort switches the pods panel to the next sort mode, keeping the cursor on the
+// selected pod
+func (m AppModel) handleCyclePodSort() (tea.Model, tea.Cmd) {
+	selected, hasSelection := m.selectedPod()
+
+	m.podSort = m.podSort.next()
+	m.pods = slices.Clone(m.pods)
+	m.orderPods(m.pods)
+
+	if m.podSearchMode {
+		m.updatePodSearchQuery(m.podSearchQuery)
+	}
+
+	if hasSelection {
+		m.selectedPodIndex = slices.IndexFunc(m.podList(), func(p k8s.Pod) bool { return p.Name == selected.Name })
+		m.adjustPodScrollOffset()
+	}
+	return m, nil
+}
@@ -0,0 +1,94 @@
+package tui
+
+import (
+	"testing"
+	"time"
+
+	"github.com/maratkarimov/kubertino/internal/k8s"
+	"github.com/stretchr/testify/assert"
+)
+
+func podNames(pods []k8s.Pod) []string {
+	names := make([]string, len(pods))
+	for i, pod := range pods {
+		names[i] = pod.Name
+	}
+	return names
+}
+
+func TestSortPods(t *testing.T) {
+	now := time.Now()
+	pods := []k8s.Pod{
+		{Name: "web-b", Status: "Running", Restarts: 1, CreatedAt: now.Add(-2 * time.Hour)},
+		{Name: "api", Status: "Pending", Restarts: 0, CreatedAt: now.Add(-time.Minute)},
+		{Name: "web-a", Status: "Running", Restarts: 7, CreatedAt: now.Add(-48 * time.Hour)},
+		{Name: "db", Status: "CrashLoopBackOff", Restarts: 7, CreatedAt: now.Add(-time.Hour)},
+	}
+
+	tests := []struct {
+		mode     PodSortMode
+		expected []string
+	}{
+		{PodSortName, []string{"api", "db", "web-a", "web-b"}},
+		{PodSortStatus, []string{"db", "api", "web-a", "web-b"}},
+		{PodSortAge, []string{"api", "db", "web-b", "web-a"}},
+		{PodSortRestarts, []string{"db", "web-a", "web-b", "api"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.mode.String(), func(t *testing.T) {
+			sorted := append([]k8s.Pod(nil), pods...)
+			sortPods(sorted, tt.mode)
+			assert.Equal(t, tt.expected, podNames(sorted))
+		})
+	}
+}
+
+func TestCyclePodSort_KeepsCursorOnPod(t *testing.T) {
+	model := newPodColumnsTestModel(nil)
+	model.keys = DefaultKeyMap()
+	model.viewMode = viewModeNamespaceView
+	model.focusedPanel = PanelPods
+	model.selectedPodIndex = 0 // api-1
+	assert.Contains(t, model.renderPodPanel(100, 20), "sort: name")
+
+	// name → status: CrashLoopBackOff sorts before Running
+	model = sendKey(model, runeKey('o'))
+	assert.Equal(t, PodSortStatus, model.podSort)
+	assert.Equal(t, []string{"worker-1", "api-1"}, podNames(model.pods))
+	assert.Equal(t, "api-1", model.pods[model.selectedPodIndex].Name)
+	assert.Contains(t, model.renderPodPanel(100, 20), "sort: status")
+
+	// status → age → restarts → name
+	model = sendKey(model, runeKey('o'))
+	assert.Equal(t, []string{"api-1", "worker-1"}, podNames(model.pods))
+	model = sendKey(model, runeKey('o'))
+	assert.Equal(t, []string{"worker-1", "api-1"}, podNames(model.pods))
+	model = sendKey(model, runeKey('o'))
+	assert.Equal(t, PodSortName, model.podSort)
+	assert.Equal(t, "api-1", model.pods[model.selectedPodIndex].Name)
+}
+
+func TestPodsFetched_AppliesSort(t *testing.T) {
+	model := newPodColumnsTestModel(nil)
+	model.podSort = PodSortRestarts
+
+	updated, _ := model.Update(podsFetchedMsg{value: []k8s.Pod{
+		{Name: "a", Restarts: 1},
+		{Name: "b", Restarts: 5},
+	}})
+	assert.Equal(t, []string{"b", "a"}, podNames(updated.(AppModel).pods))
+}
+
+func TestCyclePodSort_PodSearchTakesInput(t *testing.T) {
+	model := newPodColumnsTestModel(nil)
+	model.keys = DefaultKeyMap()
+	model.viewMode = viewModeNamespaceView
+	model.focusedPanel = PanelPods
+	model.selectedPodIndex = 0
+	model.activatePodSearch()
+
+	model = sendKey(model, runeKey('o'))
+	assert.Equal(t, PodSortName, model.podSort)
+	assert.Equal(t, "o", model.podSearchQuery)
+}
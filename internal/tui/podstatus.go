@@ -0,0 +1,115 @@
+package tui
+
+import (
+	"fmt"
+	"log/slog"
+	"slices"
+	"strings"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/maratkarimov/kubertino/internal/k8s"
+)
+
+// PodStatusFilter narrows the pods panel to the pods that need attention
+type PodStatusFilter int
+
+const (
+	PodFilterAll        PodStatusFilter = iota // Every pod
+	PodFilterNotRunning                        // Pods whose phase is not Running
+	PodFilterFailed                            // Failed pods only
+)
+
+// String returns the label shown in the pods panel title
+func (f PodStatusFilter) String() string {
+	switch f {
+	case PodFilterNotRunning:
+		return "not running"
+	case PodFilterFailed:
+		return "failed"
+	default:
+		return "all"
+	}
+}
+
+// next returns the filter that follows f, wrapping around to all
+func (f PodStatusFilter) next() PodStatusFilter {
+	return (f + 1) % (PodFilterFailed + 1)
+}
+
+// matches reports whether pod passes the filter
+func (f PodStatusFilter) matches(pod k8s.Pod) bool {
+	switch f {
+	case PodFilterNotRunning:
+		return pod.Status != "Running"
+	case PodFilterFailed:
+		return pod.Status == "Failed"
+	}
+	return true
+}
+
+// statusPods returns the pods that pass the status filter, in panel order
+func (m AppModel) statusPods() []k8s.Pod {
+	if m.podFilter == PodFilterAll {
+		return m.pods
+	}
+	var pods []k8s.Pod
+	for _, pod := range m.pods {
+		if m.podFilter.matches(pod) {
+			pods = append(pods, pod)
+		}
+	}
+	return pods
+}
+
+// podStatusOrder is the order pod phases are counted in the summary; other statuses follow
+// alphabetically
+var podStatusOrder = []string{"Running", "Pending", "Succeeded", "Failed", "Unknown"}
+
+// podStatusSummary counts pods per status, e.g. "12: 10 Running, 1 Pending, 1 Failed"
+func podStatusSummary(pods []k8s.Pod) string {
+	counts := make(map[string]int)
+	var others []string
+	for _, pod := range pods {
+		status := pod.Status
+		if status == "" {
+			status = "Unknown"
+		}
+		if counts[status] == 0 && !slices.Contains(podStatusOrder, status) {
+			others = append(others, status)
+		}
+		counts[status]++
+	}
+	slices.Sort(others)
+
+	var parts []string
+	for _, status := range append(slices.Clone(podStatusOrder), others...) {
+		if counts[status] > 0 {
+			parts = append(parts, fmt.Sprintf("%d %s", counts[status], status))
+		}
+	}
+	return fmt.Sprintf("%d: %s", len(pods), strings.Join(parts, ", "))
+}
+
+// handleCyclePodFilter switches the pods panel to the next status filter, keeping the cursor
+// on the selected pod while it stays visible
+func (m AppModel) handleCyclePodFilter() (tea.Model, tea.Cmd) {
+	selected, hasSelection := m.selectedPod()
+
+	m.podFilter = m.podFilter.next()
+	slog.Debug("pod status filter changed", "filter", m.podFilter.String())
+	if m.podSearchMode {
+		m.updatePodSearchQuery(m.podSearchQuery)
+	}
+
+	list := m.podList()
+	m.selectedPodIndex = -1
+	if hasSelection {
+		m.selectedPodIndex = slices.IndexFunc(list, func(p k8s.Pod) bool { return p.Name == selected.Name })
+	}
+	if m.selectedPodIndex == -1 && len(list) > 0 {
+		m.selectedPodIndex = 0
+	}
+	m.podScrollOffset = 0
+	m.adjustPodScrollOffset()
+	return m, nil
+}
@@ -0,0 +1,98 @@
+package tui
+
+import (
+	"testing"
+
+	"github.com/maratkarimov/kubertino/internal/config"
+	"github.com/maratkarimov/kubertino/internal/k8s"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newPodStatusTestModel() AppModel {
+	model := newTestModel(newMockAdapter(), withContexts(config.Context{Name: "prod"}))
+	model.termWidth = 140
+	model.viewMode = viewModeNamespaceView
+	model.currentNamespace = "shop"
+	model.focusedPanel = PanelPods
+	model.setPods([]k8s.Pod{
+		{Name: "api-1", Status: "Running"},
+		{Name: "api-2", Status: "Running"},
+		{Name: "migrate", Status: "Failed"},
+		{Name: "web-1", Status: "Pending"},
+		{Name: "web-2", Status: "Running"},
+	})
+	return model
+}
+
+func TestPodStatusSummary(t *testing.T) {
+	pods := []k8s.Pod{
+		{Status: "Failed"}, {Status: "Running"}, {Status: "Evicted"}, {Status: "Running"}, {Status: ""}, {Status: "Pending"},
+	}
+	assert.Equal(t, "6: 2 Running, 1 Pending, 1 Failed, 1 Unknown, 1 Evicted", podStatusSummary(pods))
+	assert.Equal(t, "1: 1 Running", podStatusSummary(pods[1:2]))
+}
+
+func TestPodStatus_SummaryInTitle(t *testing.T) {
+	model := newPodStatusTestModel()
+	assert.Contains(t, model.renderPodPanel(100, 20), "Pods (5: 3 Running, 1 Pending, 1 Failed)  sort: name")
+
+	// It follows the pods as they are refreshed
+	model.setPods(model.pods[:2])
+	assert.Contains(t, model.renderPodPanel(100, 20), "Pods (2: 2 Running)")
+
+	// Narrow panels cut the summary, not the sort
+	panel := model.renderPodPanel(30, 20)
+	assert.Contains(t, panel, "Pods (2: 2…")
+	assert.Contains(t, panel, "sort: name")
+}
+
+func TestPodStatus_CycleFilter(t *testing.T) {
+	model := newPodStatusTestModel()
+	model.selectedPodIndex = 3 // web-1
+
+	model = sendKey(model, runeKey('F'))
+	assert.Equal(t, PodFilterNotRunning, model.podFilter)
+	assert.Equal(t, []string{"migrate", "web-1"}, podNames(model.podList()))
+	pod, _ := model.selectedPod()
+	assert.Equal(t, "web-1", pod.Name, "cursor stays on the pod")
+	panel := model.renderPodPanel(120, 20)
+	assert.Contains(t, panel, "filter: not running")
+	assert.Contains(t, panel, "Pods (5:", "the summary counts every pod")
+	assert.NotContains(t, panel, "api-1")
+
+	model = sendKey(model, runeKey('F'))
+	assert.Equal(t, []string{"migrate"}, podNames(model.podList()))
+	pod, _ = model.selectedPod()
+	assert.Equal(t, "migrate", pod.Name, "a hidden pod moves the cursor to the first one")
+
+	model = sendKey(model, runeKey('F'))
+	assert.Equal(t, PodFilterAll, model.podFilter)
+	assert.Len(t, model.podList(), 5)
+	assert.NotContains(t, model.renderPodPanel(120, 20), "filter:")
+}
+
+func TestPodStatus_FilterHidesEveryPod(t *testing.T) {
+	model := newPodStatusTestModel()
+	model.setPods(model.pods[:2])
+	model.podFilter = PodFilterFailed
+
+	_, ok := model.selectedPod()
+	assert.False(t, ok)
+	assert.Contains(t, model.renderPodPanel(100, 20), "No failed pods (F: next filter)")
+}
+
+func TestPodStatus_SearchWithinFilter(t *testing.T) {
+	model := newPodStatusTestModel()
+	model.podFilter = PodFilterNotRunning
+
+	model.activatePodSearch()
+	model.updatePodSearchQuery("web")
+	assert.Equal(t, []string{"web-1"}, podNames(model.podList()), "web-2 is running")
+
+	model.deactivatePodSearch()
+	pod, ok := model.selectedPod()
+	require.True(t, ok)
+	assert.Equal(t, "web-1", pod.Name)
+	assert.Equal(t, 1, model.selectedPodIndex, "index into the filtered list")
+}
@@ -0,0 +1,186 @@
+package tui
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"os"
+	"os/exec"
+	"strings"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/maratkarimov/kubertino/internal/audit"
+	"github.com/maratkarimov/kubertino/internal/executor"
+	"github.com/maratkarimov/kubertino/internal/k8s"
+	"github.com/maratkarimov/kubertino/internal/tui/components"
+)
+
+// PodYAMLViewer is implemented by adapters that can fetch and edit the manifest of a pod.
+// Without it, the YAML key does nothing.
+type PodYAMLViewer interface {
+	PodYAML(context, namespace, pod string) (string, error)
+	EditPodCommand(context, namespace, pod string) (*exec.Cmd, error)
+}
+
+// podYAML is the output of kubectl get pod -o yaml, a type of its own so that it is not
+// mistaken for describe output
+type podYAML string
+
+// podYAMLFetchedMsg is sent when the manifest of a pod has been fetched
+type podYAMLFetchedMsg = resultMsg[podYAML]
+
+// podEditedMsg is sent when kubectl edit has exited and the TUI is back
+type podEditedMsg struct {
+	pod    k8s.Pod
+	err    error
+	stderr string // Why kubectl rejected the edit
+	record audit.Record
+}
+
+// editKey opens the manifest shown in the YAML viewer in the editor
+const editKey = "e"
+
+// openPodYAML shows the YAML viewer for the selected pod and starts fetching its manifest
+func (m AppModel) openPodYAML() (tea.Model, tea.Cmd) {
+	if _, ok := m.kubeAdapter.(PodYAMLViewer); !ok || m.currentContext == nil {
+		return m, nil
+	}
+
+	pod, ok := m.selectedPod()
+	if !ok {
+		m.errorModal.ShowWithSuggestion(
+			"No pod selected",
+			"YAML",
+			"Press Tab to focus pod panel, then use arrow keys to select a pod",
+			nil,
+		)
+		return m, nil
+	}
+
+	m.viewMode = viewModeYAML
+	m.yamlPod = pod
+	return m, m.fetchPodYAML()
+}
+
+// fetchPodYAML (re)opens the viewer on the manifest of the pod it shows
+func (m AppModel) fetchPodYAML() tea.Cmd {
+	viewer := m.kubeAdapter.(PodYAMLViewer)
+	m.pager.SetSize(m.termWidth, m.termHeight)
+	m.pager.ShowLoading("YAML: " + m.yamlPod.Name)
+	m.pager.Highlight = components.HighlightYAML
+	if !m.currentContext.ReadOnly {
+		m.pager.Status = editKey + ": Edit"
+	}
+
+	contextName, namespace, pod := m.currentContext.Name, m.currentNamespace, m.yamlPod.Name
+	return fetchCmd(m.requests, asyncYAML, "", func(context.Context) (podYAML, error) {
+		output, err := viewer.PodYAML(contextName, namespace, pod)
+		if err != nil {
+			slog.Error("fetching pod yaml failed", "pod", pod, "error", err)
+		}
+		return podYAML(output), err
+	})
+}
+
+// handlePodYAMLFetched shows the manifest if the viewer is still open for that request
+func (m AppModel) handlePodYAMLFetched(msg podYAMLFetchedMsg) (tea.Model, tea.Cmd) {
+	if !m.requests.current(msg.asyncRequest) || m.viewMode != viewModeYAML {
+		return m, nil
+	}
+
+	if msg.err != nil {
+		m.pager.SetError(msg.err)
+		return m, nil
+	}
+	m.pager.SetContent(string(msg.value))
+	return m, nil
+}
+
+// handleYAMLKey handles key presses while the YAML viewer is open
+func (m AppModel) handleYAMLKey(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	if msg.Type == tea.KeyCtrlC {
+		return m, tea.Quit
+	}
+
+	switch {
+	case msg.String() == editKey:
+		return m.editPodYAML()
+	case KeyMatches(msg, m.keys.YAML):
+		m.pager.Hide()
+	default:
+		m.pager.HandleKeyPress(msg)
+	}
+
+	if !m.pager.IsVisible {
+		m.requests.cancel(asyncYAML)
+		m.viewMode = viewModeNamespaceView
+	}
+	return m, nil
+}
+
+// editPodYAML suspends the TUI and runs kubectl edit on the pod shown in the viewer. Edits are
+// refused in read_only contexts.
+func (m AppModel) editPodYAML() (tea.Model, tea.Cmd) {
+	if m.currentContext.ReadOnly {
+		slog.Info("pod edit refused in read-only context", "context", m.currentContext.Name)
+		m.pager.Status = fmt.Sprintf("Context %s is read-only", m.currentContext.Name)
+		return m, nil
+	}
+
+	pod := m.yamlPod
+	cmd, err := m.kubeAdapter.(PodYAMLViewer).EditPodCommand(m.currentContext.Name, m.currentNamespace, pod.Name)
+	if err != nil {
+		m.pager.Status = "Edit failed: " + err.Error()
+		return m, nil
+	}
+
+	record := audit.NewRecord(audit.SourceTUI)
+	record.Context = m.currentContext.Name
+	record.Namespace = m.currentNamespace
+	record.Kind = "pod"
+	record.Target = pod.Name
+	record.Action = "Edit Pod"
+	record.Command = fmt.Sprintf("kubectl --context %s edit pod %s -n %s", m.currentContext.Name, pod.Name, m.currentNamespace)
+
+	slog.Info("editing pod", "context", record.Context, "namespace", record.Namespace, "pod", pod.Name)
+	// stderr still goes to the terminal, and is kept to explain a rejected edit afterwards
+	cmd.Stderr = os.Stderr
+	run := executor.TrackRun(cmd)
+	return m, tea.ExecProcess(cmd, func(err error) tea.Msg {
+		run.Finish()
+		record.DurationMS = run.Duration().Milliseconds()
+		stderr, _ := run.Stderr()
+		return podEditedMsg{pod: pod, err: err, stderr: stderr, record: record}
+	})
+}
+
+// handlePodEdited shows the manifest as it is after the edit, with the reason in the footer
+// when kubectl rejected it, and queries the terminal size again after the editor
+func (m AppModel) handlePodEdited(msg podEditedMsg) (tea.Model, tea.Cmd) {
+	reason := ""
+	if msg.err != nil {
+		reason = msg.err.Error()
+		if line := lastLine(msg.stderr); line != "" {
+			reason = line
+		}
+		msg.record.ExitCode = 1
+		msg.record.Error = reason
+		slog.Error("pod edit failed", "pod", msg.pod.Name, "error", msg.err, "stderr", msg.stderr)
+	}
+	m.auditLog.Log(msg.record)
+
+	if m.viewMode != viewModeYAML || m.yamlPod.Name != msg.pod.Name {
+		return m, windowSizeCmd
+	}
+	cmd := m.fetchPodYAML()
+	if reason != "" {
+		m.pager.Status = "Edit failed: " + reason
+	}
+	return m, tea.Batch(cmd, windowSizeCmd)
+}
+
+// lastLine returns the last non-empty line of s
+func lastLine(s string) string {
+	lines := strings.Split(strings.TrimSpace(s), "\n")
+	return strings.TrimSpace(lines[len(lines)-1])
+}
@@ -0,0 +1,110 @@
+package tui
+
+import (
+	"errors"
+	"os/exec"
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/maratkarimov/kubertino/internal/config"
+	"github.com/maratkarimov/kubertino/internal/k8s"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// yamlAdapter is a mock adapter that also fetches and edits pod manifests
+type yamlAdapter struct {
+	*mockKubeAdapter
+	output  string
+	fetches int
+	edited  string // Last edited pod
+}
+
+func (a *yamlAdapter) PodYAML(context, namespace, pod string) (string, error) {
+	a.fetches++
+	return a.output, nil
+}
+
+func (a *yamlAdapter) EditPodCommand(context, namespace, pod string) (*exec.Cmd, error) {
+	a.edited = pod
+	return exec.Command("true"), nil
+}
+
+func newYAMLTestModel(adapter *yamlAdapter, readOnly bool) AppModel {
+	adapter.mockKubeAdapter = newMockAdapter()
+	model := newTestModel(adapter, withContexts(config.Context{Name: "test-context", ReadOnly: readOnly}))
+	updated, _ := model.Update(tea.WindowSizeMsg{Width: 120, Height: 30})
+	model = updated.(AppModel)
+	model.currentNamespace = "default"
+	model.pods = []k8s.Pod{{Name: "web-1", Status: "Running"}}
+	model.focusedPanel = PanelPods
+	model.selectedPodIndex = 0
+	return model
+}
+
+// openYAMLViewer presses the YAML key and delivers the manifest
+func openYAMLViewer(t *testing.T, model AppModel) AppModel {
+	t.Helper()
+	updated, cmd := model.Update(runeKey('v'))
+	model = updated.(AppModel)
+	require.Equal(t, viewModeYAML, model.viewMode)
+	require.NotNil(t, cmd)
+
+	updated, _ = model.Update(cmd())
+	return updated.(AppModel)
+}
+
+func TestPodYAML_ShowsManifest(t *testing.T) {
+	adapter := &yamlAdapter{output: "apiVersion: v1\nkind: Pod\nmetadata:\n  name: web-1\n"}
+	model := openYAMLViewer(t, newYAMLTestModel(adapter, false))
+
+	view := model.View()
+	assert.Contains(t, view, "YAML: web-1")
+	assert.Contains(t, view, "kind: Pod")
+	assert.Contains(t, view, "e: Edit")
+	assert.NotNil(t, model.pager.Highlight)
+
+	model = sendKey(model, runeKey('v'))
+	assert.Equal(t, viewModeNamespaceView, model.viewMode)
+	assert.False(t, model.pager.IsVisible)
+}
+
+func TestPodYAML_Edit(t *testing.T) {
+	adapter := &yamlAdapter{output: "kind: Pod\n"}
+	model := openYAMLViewer(t, newYAMLTestModel(adapter, false))
+
+	_, cmd := model.Update(runeKey('e'))
+	require.NotNil(t, cmd, "e suspends the TUI for kubectl edit")
+	assert.Equal(t, "web-1", adapter.edited)
+
+	// A rejected edit is explained in the footer and the manifest is fetched again
+	updated, cmd := model.Update(podEditedMsg{
+		pod:    model.yamlPod,
+		err:    errors.New("exit status 1"),
+		stderr: "A copy of your changes has been stored to \"/tmp/kubectl-edit.yaml\"\nerror: pods \"web-1\" is invalid\n",
+	})
+	model = updated.(AppModel)
+	require.NotNil(t, cmd)
+	batch, ok := cmd().(tea.BatchMsg)
+	require.True(t, ok, "the manifest is fetched and the terminal size queried")
+	updated, _ = model.Update(batch[0]())
+	model = updated.(AppModel)
+
+	assert.Equal(t, 2, adapter.fetches)
+	assert.Contains(t, model.View(), "Edit failed: error: pods \"web-1\" is invalid")
+	assert.Contains(t, model.View(), "kind: Pod")
+}
+
+func TestPodYAML_EditRefusedInReadOnlyContext(t *testing.T) {
+	adapter := &yamlAdapter{output: "kind: Pod\n"}
+	model := newYAMLTestModel(adapter, true)
+	model.currentContext = &model.config.Contexts[0]
+	model = openYAMLViewer(t, model)
+	assert.NotContains(t, model.View(), "e: Edit")
+
+	updated, cmd := model.Update(runeKey('e'))
+	model = updated.(AppModel)
+	assert.Nil(t, cmd)
+	assert.Empty(t, adapter.edited)
+	assert.Contains(t, model.View(), "Context test-context is read-only")
+}
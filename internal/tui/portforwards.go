@@ -0,0 +1,179 @@
+package tui
+
+import (
+	"fmt"
+	"log/slog"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/maratkarimov/kubertino/internal/executor"
+	"github.com/maratkarimov/kubertino/internal/tui/components"
+	"github.com/maratkarimov/kubertino/internal/tui/styles"
+)
+
+// portForwardExitedMsg is sent when a background port-forward process exits
+type portForwardExitedMsg struct {
+	id int
+}
+
+// waitPortForwardCmd returns a command that reports when the port-forward process exits
+func waitPortForwardCmd(pf *executor.PortForward) tea.Cmd {
+	return func() tea.Msg {
+		<-pf.Done()
+		return portForwardExitedMsg{id: pf.ID}
+	}
+}
+
+// forwardList returns the tracked port-forwards (empty when no manager is configured)
+func (m AppModel) forwardList() []*executor.PortForward {
+	if m.portForwards == nil {
+		return nil
+	}
+	return m.portForwards.List()
+}
+
+// handleStartPortForward starts a background port-forward to the first declared port of the selected pod
+func (m AppModel) handleStartPortForward() (tea.Model, tea.Cmd) {
+	if m.portForwards == nil || m.currentContext == nil {
+		return m, nil
+	}
+
+	pod, ok := m.selectedPod()
+	if !ok {
+		m.errorModal.ShowWithSuggestion(
+			"No pod selected",
+			"Port Forward",
+			"Press Tab to focus pod panel, then use arrow keys to select a pod",
+			nil,
+		)
+		return m, nil
+	}
+
+	if len(pod.Ports) == 0 {
+		m.errorModal.ShowWithSuggestion(
+			fmt.Sprintf("Pod %s declares no container ports", pod.Name),
+			"Port Forward",
+			"Configure a port-forward action with an explicit port instead",
+			nil,
+		)
+		return m, nil
+	}
+
+	pf, err := m.portForwards.Start(m.currentContext.Name, m.currentNamespace, pod.Name, pod.Ports[0], m.config.KubeconfigPath(m.currentContext.Name))
+	if err != nil {
+		m.errorModal.Show(err.Error(), "Port Forward", nil)
+		return m, nil
+	}
+
+	notice := m.notify(fmt.Sprintf("Forwarding localhost:%d → %s:%d", pf.LocalPort, pf.Pod, pf.RemotePort), components.ToastSuccess)
+	return m, tea.Batch(waitPortForwardCmd(pf), notice)
+}
+
+// handleStopPortForward stops the port-forward under the cursor in the forwards panel
+func (m AppModel) handleStopPortForward() (tea.Model, tea.Cmd) {
+	forwards := m.forwardList()
+	if m.selectedForwardIndex < 0 || m.selectedForwardIndex >= len(forwards) {
+		return m, nil
+	}
+
+	pf := forwards[m.selectedForwardIndex]
+	if err := m.portForwards.Stop(pf.ID); err != nil {
+		m.errorModal.Show(err.Error(), "Stop Port Forward", nil)
+		return m, nil
+	}
+
+	m.clampForwardSelection()
+	return m, m.notify(fmt.Sprintf("Stopped forward localhost:%d → %s:%d", pf.LocalPort, pf.Pod, pf.RemotePort), components.ToastInfo)
+}
+
+// handlePortForwardExited reports port-forwards that died on their own.
+// Forwards stopped by the user are no longer tracked and are ignored.
+func (m AppModel) handlePortForwardExited(msg portForwardExitedMsg) (tea.Model, tea.Cmd) {
+	for _, pf := range m.forwardList() {
+		if pf.ID != msg.id {
+			continue
+		}
+		slog.Warn("port-forward exited unexpectedly", "id", pf.ID, "pod", pf.Pod, "error", pf.Err())
+		message := fmt.Sprintf("Port-forward to %s:%d exited", pf.Pod, pf.RemotePort)
+		if pf.Err() != nil {
+			message += ": " + pf.Err().Error()
+		}
+		m.errorModal.Show(message, "Port Forward", nil)
+	}
+	return m, nil
+}
+
+// clampForwardSelection keeps the forwards cursor valid and moves focus away when the list empties
+func (m *AppModel) clampForwardSelection() {
+	count := len(m.forwardList())
+	if m.selectedForwardIndex >= count {
+		m.selectedForwardIndex = count - 1
+	}
+	if m.selectedForwardIndex < 0 {
+		m.selectedForwardIndex = 0
+	}
+	if count == 0 && m.focusedPanel == PanelForwards {
+		m.focusedPanel = PanelPods
+	}
+}
+
+// forwardsPanelHeight returns the height of the forwards panel carved out of the
+// given right-bottom area, or 0 when there are no port-forwards to show
+func (m AppModel) forwardsPanelHeight(available int) int {
+	count := len(m.forwardList())
+	if count == 0 {
+		return 0
+	}
+
+	// border (2) + padding (2) + title (1) + blank (1) + help text (2) = 8 lines
+	height := count + 8
+	if height > available/2 {
+		height = available / 2
+	}
+	return height
+}
+
+// renderForwardsPanel renders the list of active port-forwards
+func (m AppModel) renderForwardsPanel(width, height int) string {
+	forwards := m.forwardList()
+	title := styles.PanelTitleStyle.Render(fmt.Sprintf("Port Forwards (%d)", len(forwards)))
+
+	var lines []string
+	for i, pf := range forwards {
+		selected := i == m.selectedForwardIndex && m.focusedPanel == PanelForwards
+		marker := m.cursorMarker(selected)
+
+		status := styles.RunningStyle.Render("running")
+		if !pf.Running() {
+			status = styles.FailedStyle.Render("exited")
+		}
+
+		target := fmt.Sprintf("%slocalhost:%d → %s:%d", marker, pf.LocalPort, pf.Pod, pf.RemotePort)
+		if selected {
+			target = m.selectionStyle(styles.SelectedPodStyle).Render(target)
+		}
+		lines = append(lines, target+"  "+status)
+	}
+
+	helpText := styles.HelpTextStyle.Render(fmt.Sprintf("%s: Stop forward | Tab: Switch panel", firstKey(m.keys.StopForward)))
+	content := lipgloss.JoinVertical(lipgloss.Left, lines...)
+	content = lipgloss.JoinVertical(lipgloss.Left, title, "", content, "", helpText)
+
+	borderStyle := styles.UnfocusedPanelBorderStyle
+	if m.focusedPanel == PanelForwards {
+		borderStyle = styles.FocusedPanelBorderStyle
+	}
+
+	return borderStyle.
+		Width(width - 2).
+		Height(height - 2).
+		Render(content)
+}
+
+// firstKey returns the first key of a binding for display in help text
+func firstKey(keys []string) string {
+	if len(keys) == 0 {
+		return ""
+	}
+	return keys[0]
+}
@@ -0,0 +1,92 @@
+package tui
+
+import (
+	"os/exec"
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/maratkarimov/kubertino/internal/executor"
+	"github.com/maratkarimov/kubertino/internal/k8s"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newPortForwardTestModel(t *testing.T) AppModel {
+	t.Helper()
+	model := newTestModel(newMockAdapter())
+	model.portForwards = executor.NewPortForwardManagerWithCommand(func(string, ...string) *exec.Cmd {
+		return exec.Command("sleep", "30")
+	})
+	t.Cleanup(model.Close)
+
+	model.currentNamespace = "default"
+	model.pods = []k8s.Pod{
+		{Name: "web-1", Status: "Running", Ports: []int{8080}},
+		{Name: "worker-1", Status: "Running"},
+	}
+	model.focusedPanel = PanelPods
+	model.selectedPodIndex = 0
+	return model
+}
+
+func TestPortForward_StartFromSelectedPod(t *testing.T) {
+	model := newPortForwardTestModel(t)
+
+	updated, cmd := model.Update(tea.KeyMsg{Type: tea.KeyCtrlF})
+	model = updated.(AppModel)
+
+	require.NotNil(t, cmd, "should wait for the process to exit")
+	forwards := model.forwardList()
+	require.Len(t, forwards, 1)
+	assert.Equal(t, "web-1", forwards[0].Pod)
+	assert.Equal(t, 8080, forwards[0].RemotePort)
+	assert.Contains(t, model.View(), "Port Forwards (1)")
+}
+
+func TestPortForward_PodWithoutPorts(t *testing.T) {
+	model := newPortForwardTestModel(t)
+	model.selectedPodIndex = 1
+
+	model = sendKey(model, tea.KeyMsg{Type: tea.KeyCtrlF})
+
+	assert.True(t, model.errorModal.IsVisible)
+	assert.Contains(t, model.errorModal.Message, "declares no container ports")
+	assert.Empty(t, model.forwardList())
+}
+
+func TestPortForward_FocusCycleAndStop(t *testing.T) {
+	model := newPortForwardTestModel(t)
+	model = sendKey(model, tea.KeyMsg{Type: tea.KeyCtrlF})
+
+	// Tab from pods reaches the forwards panel while forwards exist
+	model = sendKey(model, tea.KeyMsg{Type: tea.KeyTab})
+	assert.Equal(t, PanelForwards, model.focusedPanel)
+
+	model = sendKey(model, tea.KeyMsg{Type: tea.KeyCtrlX})
+	assert.Empty(t, model.forwardList(), "stop key should terminate the selected forward")
+	assert.Equal(t, PanelPods, model.focusedPanel, "focus leaves the empty forwards panel")
+
+	// Without forwards Tab goes straight back to namespaces
+	model = sendKey(model, tea.KeyMsg{Type: tea.KeyTab})
+	assert.Equal(t, PanelNamespaces, model.focusedPanel)
+}
+
+func TestPortForward_ExitedUnexpectedly(t *testing.T) {
+	model := newPortForwardTestModel(t)
+	model.portForwards = executor.NewPortForwardManagerWithCommand(func(string, ...string) *exec.Cmd {
+		return exec.Command("false")
+	})
+
+	updated, cmd := model.Update(tea.KeyMsg{Type: tea.KeyCtrlF})
+	model = updated.(AppModel)
+	require.NotNil(t, cmd)
+
+	// Run the wait command (batched with the toast) until the process exits
+	batch, ok := cmd().(tea.BatchMsg)
+	require.True(t, ok)
+	updated, _ = model.Update(batch[0]())
+	model = updated.(AppModel)
+
+	assert.True(t, model.errorModal.IsVisible)
+	assert.Contains(t, model.errorModal.Message, "Port-forward to web-1:8080 exited")
+}
@@ -0,0 +1,181 @@
+package tui
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"strings"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/maratkarimov/kubertino/internal/config"
+	"github.com/maratkarimov/kubertino/internal/k8s"
+	"github.com/maratkarimov/kubertino/internal/tui/styles"
+)
+
+// prefetchConcurrency bounds how many contexts have their namespaces fetched at once
+const prefetchConcurrency = 4
+
+// prefetchResult is the outcome of prefetching the namespaces of one context
+type prefetchResult struct {
+	count int
+	err   error
+}
+
+// prefetchNamespacesCmd fetches the namespaces of every context into the cache so that
+// selecting any context is instant. Returns nil unless prefetch_namespaces is enabled and
+// several contexts are configured.
+func (m AppModel) prefetchNamespacesCmd() tea.Cmd {
+	if m.prefetch == nil {
+		return nil
+	}
+
+	sem := make(chan struct{}, prefetchConcurrency)
+	cmds := make([]tea.Cmd, 0, len(m.contexts))
+	for _, ctx := range m.contexts {
+		name := ctx.Name
+		cmds = append(cmds, fetchCmd(m.requests, asyncPrefetch, name, func(context.Context) ([]k8s.Namespace, error) {
+			sem <- struct{}{}
+			defer func() { <-sem }()
+
+			slog.Debug("prefetching namespaces", "context", name)
+			return m.kubeAdapter.GetNamespaces(name)
+		}))
+	}
+	return tea.Batch(cmds...)
+}
+
+// handleNamespacesPrefetched caches the namespaces of a context. A failure only affects that
+// context: selecting it fetches its namespaces as usual.
+func (m AppModel) handleNamespacesPrefetched(msg namespaceFetchedMsg) (tea.Model, tea.Cmd) {
+	if !m.requests.current(msg.asyncRequest) || m.prefetch == nil {
+		return m, nil
+	}
+
+	contextName := msg.key
+	if msg.err != nil {
+		slog.Warn("namespace prefetch failed", "context", contextName, "error", msg.err)
+		m.prefetch[contextName] = prefetchResult{err: msg.err}
+		return m, nil
+	}
+
+	slog.Info("namespaces prefetched", "context", contextName, "count", len(msg.value))
+	m.cache.SetNamespaces(contextName, msg.value)
+	m.prefetch[contextName] = prefetchResult{count: len(msg.value)}
+
+	// The context was opened before its prefetch finished: show the namespaces now
+	if m.currentContext != nil && m.currentContext.Name == contextName && m.namespacesLoading {
+		if _, _, ok := m.cache.Namespaces(contextName); ok {
+			return m, m.loadNamespaces()
+		}
+	}
+	return m, nil
+}
+
+// prefetchProgress renders how many contexts have been prefetched, or "" once all are done
+func (m AppModel) prefetchProgress() string {
+	if m.prefetch == nil || len(m.prefetch) >= len(m.contexts) {
+		return ""
+	}
+	return styles.DimStyle.Render(fmt.Sprintf("Prefetching namespaces %d/%d...", len(m.prefetch), len(m.contexts)))
+}
+
+// prefetchBadge renders the prefetch outcome for a context in the context list
+func (m AppModel) prefetchBadge(contextName string) string {
+	if m.prefetch == nil {
+		return ""
+	}
+	result, ok := m.prefetch[contextName]
+	switch {
+	case !ok:
+		return styles.DimStyle.Render(" …")
+	case result.err != nil:
+		return styles.FailedStyle.Render(" ✗ prefetch failed")
+	default:
+		return styles.DimStyle.Render(fmt.Sprintf(" (%d namespaces)", result.count))
+	}
+}
+
+// favoritesKey returns the request key of prefetching the pods of a favorite namespace
+func favoritesKey(contextName, namespace string) string {
+	return contextName + "/" + namespace
+}
+
+// splitFavoritesKey returns the context and namespace of a favoritesKey. Context names may
+// contain a slash, namespace names cannot.
+func splitFavoritesKey(key string) (contextName, namespace string) {
+	i := strings.LastIndex(key, "/")
+	return key[:i], key[i+1:]
+}
+
+// prefetchFavoritesCmd fetches the pods of the favorite namespaces of a context into the cache,
+// so that switching between favorites is instant. Namespaces with fresh cached pods are
+// skipped. Returns nil unless prefetch_favorites is enabled and pods are cached.
+func (m AppModel) prefetchFavoritesCmd(contextName string) tea.Cmd {
+	if !m.config.PrefetchFavorites {
+		return nil
+	}
+	// Pods listed with a label selector are not cached
+	if ttl, err := m.config.CacheDuration(); (err == nil && ttl == 0) || m.config.PodSelector(contextName) != "" {
+		return nil
+	}
+	favorites, err := config.GetFavorites(m.config, contextName)
+	if err != nil {
+		slog.Warn("failed to get favorites", "context", contextName, "error", err)
+		return nil
+	}
+
+	sem := make(chan struct{}, prefetchConcurrency)
+	var cmds []tea.Cmd
+	for _, namespace := range favorites {
+		if _, fresh, _ := m.cache.Pods(contextName, namespace); fresh {
+			continue
+		}
+		cmds = append(cmds, fetchCmd(m.requests, asyncFavorites, favoritesKey(contextName, namespace), func(ctx context.Context) ([]k8s.Pod, error) {
+			sem <- struct{}{}
+			defer func() { <-sem }()
+			if ctx.Err() != nil {
+				return nil, ctx.Err() // Superseded while waiting, e.g. by a refresh
+			}
+
+			slog.Debug("prefetching favorite pods", "context", contextName, "namespace", namespace)
+			return m.kubeAdapter.GetPods(contextName, namespace)
+		}))
+	}
+	return tea.Batch(cmds...)
+}
+
+// handleFavoritesPrefetched caches the pods of a favorite namespace. A failure is only
+// logged: opening the namespace fetches its pods as usual.
+func (m AppModel) handleFavoritesPrefetched(msg podsFetchedMsg) (tea.Model, tea.Cmd) {
+	if !m.requests.current(msg.asyncRequest) {
+		return m, nil
+	}
+
+	contextName, namespace := splitFavoritesKey(msg.key)
+	if msg.err != nil {
+		slog.Warn("favorite pods prefetch failed", "context", contextName, "namespace", namespace, "error", msg.err)
+		return m, nil
+	}
+	slog.Debug("favorite pods prefetched", "context", contextName, "namespace", namespace, "count", len(msg.value))
+	m.cache.SetPods(contextName, namespace, msg.value)
+
+	// The namespace was opened before its prefetch finished: show the pods now
+	if m.currentContext != nil && m.currentContext.Name == contextName && m.currentNamespace == namespace &&
+		m.podsLoading && m.podSelector == "" {
+		return m, m.loadPods()
+	}
+	return m, nil
+}
+
+// invalidateFavorites marks the cached pods of the favorite namespaces of a context as stale
+// and drops their prefetches in flight, so that a refresh fetches them again
+func (m AppModel) invalidateFavorites(contextName string) {
+	favorites, err := config.GetFavorites(m.config, contextName)
+	if err != nil {
+		return
+	}
+	for _, namespace := range favorites {
+		m.cache.InvalidatePods(contextName, namespace)
+		m.requests.drop(asyncFavorites, favoritesKey(contextName, namespace))
+	}
+}
@@ -0,0 +1,236 @@
+package tui
+
+import (
+	"errors"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/maratkarimov/kubertino/internal/config"
+	"github.com/maratkarimov/kubertino/internal/k8s"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// prefetchAdapter is a mock adapter with namespaces per context that records concurrency
+type prefetchAdapter struct {
+	*mockKubeAdapter
+	byContext map[string][]string
+	failing   string
+	running   atomic.Int32
+	peak      atomic.Int32
+}
+
+func (a *prefetchAdapter) GetNamespaces(context string) ([]k8s.Namespace, error) {
+	running := a.running.Add(1)
+	defer a.running.Add(-1)
+	for {
+		peak := a.peak.Load()
+		if running <= peak || a.peak.CompareAndSwap(peak, running) {
+			break
+		}
+	}
+	time.Sleep(5 * time.Millisecond)
+
+	if context == a.failing {
+		return nil, errors.New("forbidden")
+	}
+	return k8s.NamespacesNamed(a.byContext[context]), nil
+}
+
+func newPrefetchTestModel(adapter *prefetchAdapter, contexts ...string) AppModel {
+	return newTestModel(adapter, func(cfg *config.Config) {
+		cfg.Prefetch = true
+		cfg.Contexts = nil
+		for _, name := range contexts {
+			cfg.Contexts = append(cfg.Contexts, config.Context{Name: name})
+		}
+	})
+}
+
+func TestPrefetch_CachesAllContexts(t *testing.T) {
+	adapter := &prefetchAdapter{
+		mockKubeAdapter: newMockAdapter(),
+		byContext:       map[string][]string{"dev": {"default", "payments"}, "prod": {"default"}},
+		failing:         "staging",
+	}
+	model := newPrefetchTestModel(adapter, "dev", "prod", "staging")
+	assert.Contains(t, model.View(), "Prefetching namespaces 0/3")
+
+	model = runCmds(model, model.prefetchNamespacesCmd(), namespaceFetchedMsg{})
+
+	view := model.View()
+	assert.NotContains(t, view, "Prefetching namespaces")
+	assert.Contains(t, view, "dev (2 namespaces)")
+	assert.Contains(t, view, "prod (1 namespaces)")
+	assert.Contains(t, view, "staging ✗ prefetch failed")
+
+	// A prefetched context opens without fetching
+	updated, _ := model.selectContext(0)
+	model = updated.(AppModel)
+	assert.False(t, model.namespacesLoading)
+	assert.Equal(t, []string{"default", "payments"}, k8s.NamespaceNames(model.namespaces))
+
+	// A failed one is fetched as usual
+	updated, cmd := model.selectContext(2)
+	model = updated.(AppModel)
+	assert.True(t, model.namespacesLoading)
+	assert.NotNil(t, cmd)
+}
+
+func TestPrefetch_BoundedConcurrency(t *testing.T) {
+	adapter := &prefetchAdapter{mockKubeAdapter: newMockAdapter(), byContext: map[string][]string{}}
+	names := []string{"a", "b", "c", "d", "e", "f", "g", "h"}
+	model := newPrefetchTestModel(adapter, names...)
+
+	runCmds(model, model.prefetchNamespacesCmd())
+	assert.LessOrEqual(t, int(adapter.peak.Load()), prefetchConcurrency)
+}
+
+func TestPrefetch_OpensContextStillLoading(t *testing.T) {
+	adapter := &prefetchAdapter{
+		mockKubeAdapter: newMockAdapter(),
+		byContext:       map[string][]string{"dev": {"default"}, "prod": {"monitoring"}},
+	}
+	model := newPrefetchTestModel(adapter, "dev", "prod")
+	prefetch := model.prefetchNamespacesCmd()
+
+	updated, _ := model.selectContext(1)
+	model = updated.(AppModel)
+	require.True(t, model.namespacesLoading)
+
+	model = runCmds(model, prefetch, namespaceFetchedMsg{})
+	assert.False(t, model.namespacesLoading)
+	assert.Equal(t, []string{"monitoring"}, k8s.NamespaceNames(model.namespaces))
+}
+
+func TestPrefetch_Disabled(t *testing.T) {
+	model := NewAppModel(&config.Config{
+		Contexts: []config.Context{{Name: "dev"}, {Name: "prod"}},
+	}, newMockAdapter())
+
+	assert.Nil(t, model.prefetchNamespacesCmd())
+	assert.NotContains(t, model.View(), "namespaces")
+}
+
+// favoritesAdapter is a mock adapter with pods per namespace that records concurrency
+type favoritesAdapter struct {
+	*mockKubeAdapter
+	byNamespace map[string][]k8s.Pod
+	running     atomic.Int32
+	peak        atomic.Int32
+}
+
+func (a *favoritesAdapter) GetPods(context, namespace string) ([]k8s.Pod, error) {
+	running := a.running.Add(1)
+	defer a.running.Add(-1)
+	for {
+		peak := a.peak.Load()
+		if running <= peak || a.peak.CompareAndSwap(peak, running) {
+			break
+		}
+	}
+	time.Sleep(5 * time.Millisecond)
+
+	if namespace == "forbidden" {
+		return nil, errors.New("forbidden")
+	}
+	return a.byNamespace[namespace], nil
+}
+
+func newFavoritePodsTestModel(adapter *favoritesAdapter, favorites ...string) AppModel {
+	adapter.mockKubeAdapter = newMockAdapter()
+	list := make([]interface{}, len(favorites))
+	for i, namespace := range favorites {
+		list[i] = namespace
+	}
+	return newTestModel(adapter, withContexts(config.Context{Name: "dev"}, config.Context{Name: "prod"}), func(cfg *config.Config) {
+		cfg.PrefetchFavorites = true
+		cfg.Favorites = map[string]interface{}{"dev": list}
+	})
+}
+
+func TestPrefetchFavorites_CachesFavoritePods(t *testing.T) {
+	adapter := &favoritesAdapter{byNamespace: map[string][]k8s.Pod{"payments": {{Name: "api-1", Status: "Running"}}}}
+	model := newFavoritePodsTestModel(adapter, "payments", "billing", "forbidden", "ns-4", "ns-5", "ns-6")
+
+	model = runCmds(model, model.prefetchFavoritesCmd("dev"), podsFetchedMsg{})
+	assert.LessOrEqual(t, int(adapter.peak.Load()), prefetchConcurrency)
+
+	pods, fresh, ok := model.cache.Pods("dev", "payments")
+	require.True(t, ok)
+	assert.True(t, fresh)
+	assert.Equal(t, "api-1", pods[0].Name)
+	_, _, ok = model.cache.Pods("dev", "forbidden")
+	assert.False(t, ok, "a failed prefetch is fetched as usual when opened")
+
+	// Fresh favorites are not fetched again
+	cmd := model.prefetchFavoritesCmd("dev")
+	assert.Len(t, cmdMsgs(cmd), 1, "only the failed one")
+}
+
+func TestPrefetchFavorites_StartedWithTheContext(t *testing.T) {
+	model := newFavoritePodsTestModel(&favoritesAdapter{}, "payments")
+
+	updated, _ := model.selectContext(0)
+	model = updated.(AppModel)
+	assert.Contains(t, model.requests.slots, asyncRequest{kind: asyncFavorites, key: "dev/payments"})
+
+	t.Run("disabled", func(t *testing.T) {
+		model := newFavoritePodsTestModel(&favoritesAdapter{}, "payments")
+		model.config.PrefetchFavorites = false
+		assert.Nil(t, model.prefetchFavoritesCmd("dev"))
+	})
+	t.Run("cache disabled", func(t *testing.T) {
+		model := newFavoritePodsTestModel(&favoritesAdapter{}, "payments")
+		model.config.CacheTTL = "0"
+		assert.Nil(t, model.prefetchFavoritesCmd("dev"))
+	})
+}
+
+func TestPrefetchFavorites_ShowsNamespaceOpenedMeanwhile(t *testing.T) {
+	adapter := &favoritesAdapter{byNamespace: map[string][]k8s.Pod{"payments": {{Name: "api-1", Status: "Running"}}}}
+	model := newFavoritePodsTestModel(adapter, "payments")
+	model.currentContext = &model.contexts[0]
+	prefetch := model.prefetchFavoritesCmd("dev")
+
+	updated, _ := model.selectNamespace("payments")
+	model = updated.(AppModel)
+	require.True(t, model.podsLoading)
+
+	model = runCmds(model, prefetch, podsFetchedMsg{})
+	assert.False(t, model.podsLoading)
+	require.Len(t, model.pods, 1)
+	assert.Equal(t, "api-1", model.pods[0].Name)
+}
+
+func TestPrefetchFavorites_DroppedByRefresh(t *testing.T) {
+	adapter := &favoritesAdapter{byNamespace: map[string][]k8s.Pod{"payments": {{Name: "api-1", Status: "Running"}}}}
+	model := newFavoritePodsTestModel(adapter, "payments")
+	model.currentContext = &model.contexts[0]
+	model.currentNamespace = "payments"
+	model.focusedPanel = PanelPods
+	prefetch := model.prefetchFavoritesCmd("dev")
+
+	// The refresh starts after the prefetch, so the prefetched pods may be older
+	updated, _ := model.handleRefresh()
+	model = updated.(AppModel)
+	model = runCmds(model, prefetch, podsFetchedMsg{})
+	_, _, ok := model.cache.Pods("dev", "payments")
+	assert.False(t, ok)
+
+	// Refreshing the namespaces marks the favorites stale and prefetches them again
+	model.cache.SetPods("dev", "payments", nil)
+	model.focusedPanel = PanelNamespaces
+	updated, _ = model.handleRefresh()
+	model = updated.(AppModel)
+	_, fresh, _ := model.cache.Pods("dev", "payments")
+	assert.False(t, fresh)
+	assert.Contains(t, model.requests.slots, asyncRequest{kind: asyncFavorites, key: "dev/payments"})
+}
+
+func TestSplitFavoritesKey(t *testing.T) {
+	contextName, namespace := splitFavoritesKey(favoritesKey("arn:aws:eks:eu-west-1:123:cluster/prod", "payments"))
+	assert.Equal(t, "arn:aws:eks:eu-west-1:123:cluster/prod", contextName)
+	assert.Equal(t, "payments", namespace)
+}
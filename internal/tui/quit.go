@@ -0,0 +1,76 @@
+package tui
+
+import (
+	"fmt"
+	"log/slog"
+	"os/exec"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/maratkarimov/kubertino/internal/executor"
+)
+
+// runningAction is the action command handed the terminal that has not reported back yet.
+// It is shared by all copies of the model, like the spinners.
+type runningAction struct {
+	name string
+	cmd  *exec.Cmd
+}
+
+// start records that the command of the named action is running
+func (r *runningAction) start(name string, cmd *exec.Cmd) {
+	if r == nil {
+		return
+	}
+	r.name, r.cmd = name, cmd
+}
+
+// finish records that the action has reported back
+func (r *runningAction) finish() {
+	if r == nil {
+		return
+	}
+	r.name, r.cmd = "", nil
+}
+
+// stop terminates the command and the processes it started if it is still running
+func (r *runningAction) stop() {
+	if r == nil || r.cmd == nil {
+		return
+	}
+	slog.Info("stopping running action", "action", r.name)
+	executor.StopProcess(r.cmd)
+	r.finish()
+}
+
+// busyAction returns the name of an action that is still running, or "" when none is
+func (m AppModel) busyAction() string {
+	if m.running != nil && m.running.cmd != nil {
+		return m.running.name
+	}
+	if m.capture != nil && m.capture.running {
+		return m.capture.title
+	}
+	for _, job := range m.jobList() {
+		if job.Running() {
+			return fmt.Sprintf("Job #%d (%s)", job.ID, job.Action)
+		}
+	}
+	return ""
+}
+
+// quit exits, first asking for confirmation while an action is still running. Its processes
+// are stopped in Close, so no kubectl session outlives kubertino.
+func (m AppModel) quit() (tea.Model, tea.Cmd) {
+	name := m.busyAction()
+	if name == "" || m.confirm == nil {
+		return m, tea.Quit
+	}
+
+	m.confirm.Show(
+		"Quit while an action is running?",
+		fmt.Sprintf("%s is still running. Quitting stops it and the processes it started.", name),
+		"y",
+		func() tea.Cmd { return tea.Quit },
+	)
+	return m, nil
+}
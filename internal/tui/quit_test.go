@@ -0,0 +1,69 @@
+package tui
+
+import (
+	"os/exec"
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/maratkarimov/kubertino/internal/audit"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// isQuit reports whether cmd quits the program
+func isQuit(cmd tea.Cmd) bool {
+	if cmd == nil {
+		return false
+	}
+	_, ok := cmd().(tea.QuitMsg)
+	return ok
+}
+
+func TestQuit_ConfirmsWhileActionRuns(t *testing.T) {
+	model := newAppliesToTestModel()
+	model.viewMode = viewModeNamespaceView
+	model.running.start("Rails Console", exec.Command("true"))
+
+	updated, cmd := model.Update(runeKey('q'))
+	model = updated.(AppModel)
+	assert.False(t, isQuit(cmd), "quit waits for confirmation")
+	require.True(t, model.confirm.IsVisible)
+	assert.Contains(t, model.View(), "Rails Console is still running")
+
+	// ESC keeps kubertino open
+	updated, _ = model.Update(tea.KeyMsg{Type: tea.KeyEsc})
+	model = updated.(AppModel)
+	assert.False(t, model.confirm.IsVisible)
+
+	model = sendKey(model, runeKey('q'))
+	model = sendKey(model, runeKey('y'))
+	_, cmd = model.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	assert.True(t, isQuit(cmd))
+}
+
+func TestQuit_ImmediateOnceActionFinished(t *testing.T) {
+	model := newAppliesToTestModel()
+	model.viewMode = viewModeNamespaceView
+	model.running.start("Logs", exec.Command("true"))
+
+	updated, _ := model.Update(execFinishedMsg{record: audit.Record{Action: "Logs"}})
+	model = updated.(AppModel)
+	assert.Empty(t, model.busyAction())
+
+	_, cmd := model.Update(runeKey('q'))
+	assert.True(t, isQuit(cmd))
+}
+
+func TestClose_StopsRunningAction(t *testing.T) {
+	model := newAppliesToTestModel()
+	cmd := exec.Command("sleep", "30")
+	require.NoError(t, cmd.Start())
+	exited := make(chan error, 1)
+	go func() { exited <- cmd.Wait() }()
+	model.running.start("Logs", cmd)
+
+	model.Close()
+
+	assert.Error(t, <-exited, "the command is terminated")
+	assert.Empty(t, model.busyAction())
+}
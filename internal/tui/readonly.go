@@ -0,0 +1,33 @@
+package tui
+
+import (
+	"fmt"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/maratkarimov/kubertino/internal/config"
+	"github.com/maratkarimov/kubertino/internal/tui/styles"
+)
+
+// readOnlyBlocks reports whether the open context is read_only and refuses to run action
+func (m AppModel) readOnlyBlocks(action config.Action) bool {
+	return m.currentContext != nil && m.config.ReadOnlyBlocks(*m.currentContext, action)
+}
+
+// showReadOnly explains why action cannot run in the open context
+func (m AppModel) showReadOnly(action config.Action) (tea.Model, tea.Cmd) {
+	m.errorModal.ShowWithSuggestion(
+		fmt.Sprintf("Action '%s' is disabled: context %s is read-only", action.Name, m.currentContext.Name),
+		"Execute Action",
+		"Its command matches destructive_patterns or it is marked destructive; remove read_only from the context to run it",
+		nil,
+	)
+	return m, nil
+}
+
+// readOnlyBadge shows a lock next to read_only contexts
+func readOnlyBadge(ctx config.Context) string {
+	if !ctx.ReadOnly {
+		return ""
+	}
+	return styles.WarningStyle.Render(" 🔒")
+}
@@ -0,0 +1,60 @@
+package tui
+
+import (
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/maratkarimov/kubertino/internal/config"
+	"github.com/maratkarimov/kubertino/internal/k8s"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newReadOnlyTestModel(readOnly bool) AppModel {
+	model := newTestModel(newMockAdapter(), withContexts(config.Context{Name: "prod", ReadOnly: readOnly}), withActions(
+		config.Action{Name: "Logs", Shortcut: "l", Command: "kubectl logs {{.pod}}"},
+		config.Action{Name: "Shell", Shortcut: "s", Command: "kubectl exec -it {{.pod}} -- sh"},
+	))
+	model.currentNamespace = "app"
+	model.pods = []k8s.Pod{{Name: "web-1", Status: "Running"}}
+	model.selectedPodIndex = 0
+	model.focusedPanel = PanelPods
+	return model
+}
+
+func TestReadOnly_BlocksDestructiveActions(t *testing.T) {
+	model := newReadOnlyTestModel(true)
+	assert.False(t, model.readOnlyBlocks(model.actions[0]))
+	assert.True(t, model.readOnlyBlocks(model.actions[1]))
+
+	updated, cmd := model.Update(runeKey('s'))
+	model = updated.(AppModel)
+	assert.Nil(t, cmd)
+	require.True(t, model.errorModal.IsVisible)
+	assert.Contains(t, model.errorModal.View(), "context prod is read-only")
+
+	model.errorModal.Hide()
+	updated, cmd = model.Update(runeKey('l'))
+	model = updated.(AppModel)
+	assert.NotNil(t, cmd, "other actions still run")
+	assert.False(t, model.errorModal.IsVisible)
+}
+
+func TestReadOnly_WritableContextRunsEverything(t *testing.T) {
+	model := newReadOnlyTestModel(false)
+	assert.False(t, model.readOnlyBlocks(model.actions[1]))
+
+	updated, cmd := model.Update(runeKey('s'))
+	model = updated.(AppModel)
+	assert.NotNil(t, cmd)
+	assert.False(t, model.errorModal.IsVisible)
+	assert.NotContains(t, model.View(), "🔒")
+}
+
+func TestReadOnly_ShowsLock(t *testing.T) {
+	model := newTestModel(newMockAdapter(), withContexts(config.Context{Name: "prod", ReadOnly: true}, config.Context{Name: "dev"}))
+	assert.Contains(t, model.View(), "prod 🔒")
+
+	model = sendKey(model, tea.KeyMsg{Type: tea.KeyEnter})
+	assert.Contains(t, model.renderNamespaceList(30), "prod 🔒")
+}
@@ -0,0 +1,343 @@
+package tui
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+	"maps"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/maratkarimov/kubertino/internal/changelog"
+	"github.com/maratkarimov/kubertino/internal/config"
+	"github.com/maratkarimov/kubertino/internal/k8s"
+	"gopkg.in/yaml.v3"
+)
+
+// recordingVersion is the format version of session recordings
+const recordingVersion = 1
+
+// redacted replaces recorded values that may be secret
+const redacted = "[redacted]"
+
+// recordingHeader is the first line of a recording: what the model is built from
+type recordingHeader struct {
+	Version    int        `json:"version"`
+	Kubertino  string     `json:"kubertino"`
+	Started    time.Time  `json:"started"`
+	RenderMode RenderMode `json:"render_mode"`
+	Config     string     `json:"config"` // YAML, without the audit sinks
+}
+
+// recordedMsg is a line of a recording after the header
+type recordedMsg struct {
+	At   int64           `json:"at"` // Milliseconds since the recording started
+	Type string          `json:"type"`
+	Msg  json.RawMessage `json:"msg"`
+}
+
+// Recorder writes the messages a TUI session receives to a recording that Replay can drive a
+// model with: keys, mouse events, window sizes and the results of background requests.
+// Describe output and pod annotation values are redacted. Results of commands run outside
+// the TUI (actions, shells, hooks, port-forwards) and timer ticks are not recorded.
+type Recorder struct {
+	enc     *json.Encoder
+	started time.Time
+	err     error // First write error; recording stops there
+}
+
+// NewRecorder writes the header of a recording of a session with cfg drawn in mode to w
+func NewRecorder(w io.Writer, cfg *config.Config, mode RenderMode) (*Recorder, error) {
+	recorded := *cfg
+	recorded.Audit = nil
+	data, err := yaml.Marshal(&recorded)
+	if err != nil {
+		return nil, fmt.Errorf("failed to serialize config for the recording: %w", err)
+	}
+
+	r := &Recorder{enc: json.NewEncoder(w), started: time.Now()}
+	header := recordingHeader{
+		Version:    recordingVersion,
+		Kubertino:  changelog.Current(),
+		Started:    r.started,
+		RenderMode: mode,
+		Config:     string(data),
+	}
+	if err := r.enc.Encode(header); err != nil {
+		return nil, fmt.Errorf("failed to write recording: %w", err)
+	}
+	return r, nil
+}
+
+// Filter records msg on its way to model; use it with tea.WithFilter. Results of superseded
+// requests, which the model drops, are not recorded.
+func (r *Recorder) Filter(model tea.Model, msg tea.Msg) tea.Msg {
+	if r.err != nil {
+		return msg
+	}
+	if app, ok := model.(AppModel); ok {
+		if req, ok := requestOf(msg); ok && !app.requests.pending(req) {
+			return msg
+		}
+	}
+
+	for name, codec := range msgCodecs {
+		value, ok := codec.encode(msg)
+		if !ok {
+			continue
+		}
+		data, err := json.Marshal(value)
+		if err == nil {
+			err = r.enc.Encode(recordedMsg{At: time.Since(r.started).Milliseconds(), Type: name, Msg: data})
+		}
+		if err != nil {
+			slog.Error("session recording stopped", "error", err)
+			r.err = err
+		}
+		break
+	}
+	return msg
+}
+
+// Err returns the error that stopped the recording, if any
+func (r *Recorder) Err() error {
+	return r.err
+}
+
+// requestOf returns the request msg is the result of, if it is one
+func requestOf(msg tea.Msg) (asyncRequest, bool) {
+	result, ok := msg.(interface{ request() asyncRequest })
+	if !ok {
+		return asyncRequest{}, false
+	}
+	return result.request(), true
+}
+
+// request returns the request the result belongs to
+func (r resultMsg[T]) request() asyncRequest {
+	return r.asyncRequest
+}
+
+// msgCodec converts a kind of message to and from its recorded form. shift moves the
+// timestamps of decoded values by the time passed since recording, so ages render as
+// they did then.
+type msgCodec struct {
+	encode func(msg tea.Msg) (value any, ok bool)
+	decode func(data json.RawMessage, shift time.Duration) (tea.Msg, error)
+}
+
+// msgCodecs are the recorded messages by type name
+var msgCodecs = map[string]msgCodec{
+	"key":        keyCodec(),
+	"mouse":      plainCodec[tea.MouseMsg](),
+	"window":     plainCodec[tea.WindowSizeMsg](),
+	"namespaces": resultCodec(nil, shiftNamespaces),
+	"pods":       resultCodec(redactPods, shiftPods),
+	"pod_page":   resultCodec(redactPodPage, shiftPodPage),
+	"resources":  resultCodec[[]k8s.Resource](nil, nil),
+	"pod_detail": resultCodec(nil, shiftPodDetail),
+	"describe":   resultCodec(func(string) string { return redacted }, nil),
+	"services":   resultCodec[[]k8s.Service](nil, nil),
+	"restarts":   resultCodec[map[string]*k8s.RestartStorm](nil, nil),
+	"pod_counts": resultCodec[map[string]int](nil, nil),
+	"health":     resultCodec[*k8s.ContextHealth](nil, nil),
+	"startup":    resultCodec[struct{}](nil, nil),
+	"auth":       resultCodec[*k8s.AuthStatus](nil, nil),
+	"credential": resultCodec(nil, shiftCredential),
+	"gitops":     resultCodec[*k8s.GitOpsInfo](nil, nil),
+}
+
+// recordedKey is a recorded tea.KeyMsg
+type recordedKey struct {
+	Type  tea.KeyType `json:"type"`
+	Runes string      `json:"runes,omitempty"`
+	Alt   bool        `json:"alt,omitempty"`
+}
+
+// keyCodec records key presses
+func keyCodec() msgCodec {
+	return msgCodec{
+		encode: func(msg tea.Msg) (any, bool) {
+			key, ok := msg.(tea.KeyMsg)
+			return recordedKey{Type: key.Type, Runes: string(key.Runes), Alt: key.Alt}, ok
+		},
+		decode: func(data json.RawMessage, _ time.Duration) (tea.Msg, error) {
+			var key recordedKey
+			err := json.Unmarshal(data, &key)
+			msg := tea.KeyMsg{Type: key.Type, Alt: key.Alt}
+			if key.Runes != "" {
+				msg.Runes = []rune(key.Runes)
+			}
+			return msg, err
+		},
+	}
+}
+
+// plainCodec records messages of type T as they are
+func plainCodec[T tea.Msg]() msgCodec {
+	return msgCodec{
+		encode: func(msg tea.Msg) (any, bool) {
+			value, ok := msg.(T)
+			return value, ok
+		},
+		decode: func(data json.RawMessage, _ time.Duration) (tea.Msg, error) {
+			var value T
+			err := json.Unmarshal(data, &value)
+			return value, err
+		},
+	}
+}
+
+// recordedResult is a recorded resultMsg. The request ID is not kept: only current results
+// are recorded, so replayed ones are accepted as untracked.
+type recordedResult[T any] struct {
+	Kind  asyncKind      `json:"kind"`
+	Key   string         `json:"key,omitempty"`
+	Value T              `json:"value"`
+	Err   *recordedError `json:"error,omitempty"`
+}
+
+// resultCodec records the results of background requests of type T. redact, if set,
+// removes what may be secret from recorded values; shift, if set, moves their timestamps.
+func resultCodec[T any](redact func(T) T, shift func(T, time.Duration) T) msgCodec {
+	return msgCodec{
+		encode: func(msg tea.Msg) (any, bool) {
+			result, ok := msg.(resultMsg[T])
+			if !ok {
+				return nil, false
+			}
+			value := result.value
+			if redact != nil && result.err == nil {
+				value = redact(value)
+			}
+			return recordedResult[T]{Kind: result.kind, Key: result.key, Value: value, Err: recordError(result.err)}, true
+		},
+		decode: func(data json.RawMessage, by time.Duration) (tea.Msg, error) {
+			var recorded recordedResult[T]
+			if err := json.Unmarshal(data, &recorded); err != nil {
+				return nil, err
+			}
+			value := recorded.Value
+			if shift != nil {
+				value = shift(value, by)
+			}
+			msg := resultMsg[T]{asyncRequest: asyncRequest{kind: recorded.Kind, key: recorded.Key}, value: value}
+			if recorded.Err != nil {
+				msg.err = recorded.Err
+			}
+			return msg, nil
+		},
+	}
+}
+
+// recordedError is a recorded error. Kind names the k8s error it wrapped, so errors.Is
+// still tells timeouts and denied permissions apart when it is replayed.
+type recordedError struct {
+	Message string `json:"message"`
+	Kind    string `json:"kind,omitempty"`
+}
+
+// recordedErrorKinds are the errors recordedError keeps the identity of
+var recordedErrorKinds = map[string]error{
+	"kubeconfig_not_found": k8s.ErrKubeconfigNotFound,
+	"context_not_found":    k8s.ErrContextNotFound,
+	"invalid_kubeconfig":   k8s.ErrInvalidKubeconfig,
+	"kubectl_not_found":    k8s.ErrKubectlNotFound,
+	"permission_denied":    k8s.ErrPermissionDenied,
+	"timeout":              k8s.ErrTimeout,
+	"metrics_unavailable":  k8s.ErrMetricsUnavailable,
+}
+
+// recordError returns the recorded form of err, nil for nil
+func recordError(err error) *recordedError {
+	if err == nil {
+		return nil
+	}
+	recorded := &recordedError{Message: err.Error()}
+	for kind, known := range recordedErrorKinds {
+		if errors.Is(err, known) {
+			recorded.Kind = kind
+			break
+		}
+	}
+	return recorded
+}
+
+// Error returns the message of the recorded error
+func (e *recordedError) Error() string {
+	return e.Message
+}
+
+// Unwrap returns the k8s error the recorded error wrapped, if any
+func (e *recordedError) Unwrap() error {
+	return recordedErrorKinds[e.Kind]
+}
+
+// redactPods removes the annotation values of pods, which may hold configuration
+func redactPods(pods []k8s.Pod) []k8s.Pod {
+	redactedPods := make([]k8s.Pod, len(pods))
+	for i, pod := range pods {
+		if len(pod.Annotations) > 0 {
+			pod.Annotations = maps.Clone(pod.Annotations)
+			for key := range pod.Annotations {
+				pod.Annotations[key] = redacted
+			}
+		}
+		redactedPods[i] = pod
+	}
+	return redactedPods
+}
+
+// redactPodPage removes the annotation values of the pods of page
+func redactPodPage(page k8s.PodPage) k8s.PodPage {
+	page.Pods = redactPods(page.Pods)
+	return page
+}
+
+// shiftTime moves t by d, leaving zero times unknown
+func shiftTime(t time.Time, d time.Duration) time.Time {
+	if t.IsZero() {
+		return t
+	}
+	return t.Add(d)
+}
+
+// shiftNamespaces moves the creation times of namespaces by d
+func shiftNamespaces(namespaces []k8s.Namespace, d time.Duration) []k8s.Namespace {
+	for i := range namespaces {
+		namespaces[i].CreatedAt = shiftTime(namespaces[i].CreatedAt, d)
+	}
+	return namespaces
+}
+
+// shiftPods moves the creation times of pods by d
+func shiftPods(pods []k8s.Pod, d time.Duration) []k8s.Pod {
+	for i := range pods {
+		pods[i].CreatedAt = shiftTime(pods[i].CreatedAt, d)
+	}
+	return pods
+}
+
+// shiftPodPage moves the creation times of the pods of page by d
+func shiftPodPage(page k8s.PodPage, d time.Duration) k8s.PodPage {
+	page.Pods = shiftPods(page.Pods, d)
+	return page
+}
+
+// shiftPodDetail moves the start time of a pod by d
+func shiftPodDetail(detail *k8s.PodDetail, d time.Duration) *k8s.PodDetail {
+	if detail != nil {
+		detail.StartedAt = shiftTime(detail.StartedAt, d)
+	}
+	return detail
+}
+
+// shiftCredential moves the expiry of a credential by d
+func shiftCredential(credential *k8s.Credential, d time.Duration) *k8s.Credential {
+	if credential != nil {
+		credential.ExpiresAt = shiftTime(credential.ExpiresAt, d)
+	}
+	return credential
+}
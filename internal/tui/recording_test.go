@@ -0,0 +1,105 @@
+package tui
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/maratkarimov/kubertino/internal/config"
+	"github.com/maratkarimov/kubertino/internal/k8s"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// recordSession feeds msgs through a recorder to a model of cfg, as tea.WithFilter does, and
+// returns the recording and the final model
+func recordSession(t *testing.T, cfg *config.Config, msgs ...tea.Msg) (*bytes.Buffer, AppModel) {
+	t.Helper()
+	var recording bytes.Buffer
+	recorder, err := NewRecorder(&recording, cfg, RenderMode{})
+	require.NoError(t, err)
+
+	model := NewAppModel(cfg, newMockAdapter())
+	model.Init()
+	for _, msg := range msgs {
+		updated, _ := model.Update(recorder.Filter(model, msg))
+		model = updated.(AppModel)
+	}
+	require.NoError(t, recorder.Err())
+	return &recording, model
+}
+
+func TestRecording_ReplayDrawsTheRecordedScreens(t *testing.T) {
+	cfg := &config.Config{Version: "1.0", Contexts: []config.Context{{Name: "test-context"}}}
+	created := time.Now().Add(-3 * time.Hour)
+	recording, live := recordSession(t, cfg,
+		tea.WindowSizeMsg{Width: 120, Height: 30},
+		namespaceFetchedMsg{value: []k8s.Namespace{{Name: "default", CreatedAt: created}, {Name: "payments", CreatedAt: created}}},
+		tea.KeyMsg{Type: tea.KeyDown},
+		tea.KeyMsg{Type: tea.KeyEnter},
+		podsFetchedMsg{value: []k8s.Pod{{Name: "api-1", Status: "Running", CreatedAt: created}}},
+		runeKey('/'),
+	)
+
+	var types []string
+	var final string
+	require.NoError(t, Replay(recording, func(msgType, view string) {
+		types = append(types, msgType)
+		final = view
+	}))
+	assert.Equal(t, []string{"window", "namespaces", "key", "key", "pods", "key"}, types)
+	assert.Equal(t, live.View(), final)
+	assert.Contains(t, final, "api-1")
+}
+
+func TestRecording_Redacts(t *testing.T) {
+	cfg := &config.Config{
+		Version:  "1.0",
+		Contexts: []config.Context{{Name: "test-context"}},
+		Audit:    &config.Audit{Sinks: []config.AuditSink{{Type: "http", URL: "https://audit.example.com/?token=hunter2"}}},
+	}
+	recording, _ := recordSession(t, cfg,
+		podsFetchedMsg{value: []k8s.Pod{{Name: "api-1", Annotations: map[string]string{"db-password": "hunter2"}}}},
+		podDescribedMsg{value: "Environment:\n  PASSWORD: hunter2"},
+	)
+
+	assert.NotContains(t, recording.String(), "hunter2")
+	assert.Contains(t, recording.String(), `"db-password":"[redacted]"`)
+}
+
+func TestRecording_SkipsSupersededResults(t *testing.T) {
+	cfg := &config.Config{Version: "1.0", Contexts: []config.Context{{Name: "test-context"}}}
+	var recording bytes.Buffer
+	recorder, err := NewRecorder(&recording, cfg, RenderMode{})
+	require.NoError(t, err)
+	model := NewAppModel(cfg, newMockAdapter())
+
+	_, stale := model.requests.start(asyncPods, "")
+	_, latest := model.requests.start(asyncPods, "")
+	recorder.Filter(model, podsFetchedMsg{asyncRequest: stale, value: []k8s.Pod{{Name: "stale"}}})
+	recorder.Filter(model, podsFetchedMsg{asyncRequest: latest, value: []k8s.Pod{{Name: "latest"}}})
+
+	assert.NotContains(t, recording.String(), "stale")
+	assert.Contains(t, recording.String(), "latest")
+	assert.True(t, model.requests.pending(latest), "recording does not mark requests done")
+}
+
+func TestRecording_ErrorsKeepTheirKind(t *testing.T) {
+	recorded := recordError(fmt.Errorf("get pods: %w", k8s.ErrTimeout))
+	assert.Equal(t, "get pods: operation timeout", recorded.Error())
+	assert.True(t, errors.Is(recorded, k8s.ErrTimeout))
+	assert.False(t, errors.Is(recordError(errors.New("boom")), k8s.ErrTimeout))
+}
+
+func TestReplay_RejectsUnknownMessages(t *testing.T) {
+	var recording bytes.Buffer
+	_, err := NewRecorder(&recording, &config.Config{Version: "1.0"}, RenderMode{})
+	require.NoError(t, err)
+	recording.WriteString(`{"at":1,"type":"teleport","msg":{}}` + "\n")
+
+	err = Replay(&recording, func(string, string) {})
+	assert.ErrorContains(t, err, `message 1: unknown type "teleport"`)
+}
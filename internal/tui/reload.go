@@ -0,0 +1,110 @@
+package tui
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"slices"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/maratkarimov/kubertino/internal/config"
+	"github.com/maratkarimov/kubertino/internal/tui/components"
+)
+
+// configReloadedMsg carries the configuration re-read with the reload key
+type configReloadedMsg = resultMsg[*config.Config]
+
+// SetConfigReload sets how the reload key re-reads the configuration, e.g. the config file
+// with its project overlay and plugin actions, validated. Without it the key explains that
+// the configuration cannot be reloaded.
+func (m *AppModel) SetConfigReload(load func() (*config.Config, error)) {
+	m.configReload = load
+}
+
+// handleReload re-reads the configuration in the background
+func (m AppModel) handleReload() (tea.Model, tea.Cmd) {
+	load := m.configReload
+	if load == nil {
+		return m, m.notify("This configuration cannot be reloaded, restart kubertino instead", components.ToastWarning)
+	}
+	slog.Info("reloading configuration")
+	return m, fetchCmd(m.requests, asyncReload, "", func(context.Context) (*config.Config, error) {
+		return load()
+	})
+}
+
+// handleConfigReloaded switches to the re-read configuration. An invalid configuration is
+// shown as an error and the current one is kept.
+func (m AppModel) handleConfigReloaded(msg configReloadedMsg) (tea.Model, tea.Cmd) {
+	if !m.requests.current(msg.asyncRequest) {
+		return m, nil
+	}
+	if msg.err != nil {
+		slog.Warn("configuration reload failed", "error", msg.err)
+		m.errorModal.Show(fmt.Sprintf("Failed to reload the configuration: %v", msg.err), "Reload Config", nil)
+		return m, nil
+	}
+
+	headerShown := m.showHeader()
+	m.applyConfig(msg.value)
+	slog.Info("configuration reloaded", "contexts", len(m.contexts), "actions", len(m.actions))
+	cmd := m.notify("Configuration reloaded", components.ToastSuccess)
+	if !headerShown {
+		// The header clock only ticks while the header is shown
+		cmd = tea.Batch(cmd, m.startHeaderCmd())
+	}
+	return m, cmd
+}
+
+// applyConfig switches to cfg without a restart: key bindings, contexts, actions, favorites
+// and appearance follow cfg, while fetched namespaces and pods, visited contexts, running
+// port-forwards and the action history are kept. Custom pod columns are filled in from the
+// next pod fetch; kubectl settings (timeouts, retries, limits) and audit sinks take effect on
+// the next start.
+func (m *AppModel) applyConfig(cfg *config.Config) {
+	m.config = cfg
+	if setter, ok := m.kubeAdapter.(CustomPodColumnSetter); ok {
+		if err := setter.SetCustomPodColumns(cfg.CustomPodColumns); err != nil {
+			slog.Warn("invalid custom pod columns", "error", err)
+		}
+	}
+	m.contexts = config.GroupContexts(cfg.Contexts)
+	m.keys = KeyMapFromConfig(cfg.Keymap)
+	m.configureSpinners()
+	m.selectedContextIndex = min(m.selectedContextIndex, max(len(m.contexts)-1, 0))
+
+	if m.currentContext == nil {
+		return
+	}
+	name := m.currentContext.Name
+	index := slices.IndexFunc(m.contexts, func(ctx config.Context) bool { return ctx.Name == name })
+	if index < 0 {
+		// The open context was removed: pick another one from the list
+		slog.Info("open context no longer configured", "context", name)
+		m.currentContext = nil
+		m.actions = nil
+		m.actionTag = ""
+		m.viewMode = viewModeContextSelection
+		return
+	}
+
+	m.currentContext = &m.contexts[index]
+	m.selectedContextIndex = index
+	m.actions = config.MergeActions(cfg.Actions, m.currentContext.Actions)
+	if !slices.Contains(m.actionTags(), m.actionTag) {
+		m.actionTag = ""
+	}
+
+	// Re-sort the namespaces for changed favorites, keeping the cursor on its namespace
+	favorites, err := config.GetFavorites(cfg, name)
+	if err != nil {
+		slog.Warn("failed to get favorites", "context", name, "error", err)
+		favorites = []string{}
+	}
+	selected, _ := m.highlightedNamespace()
+	m.favoriteNamespaces = favorites
+	m.namespaces = m.sortNamespacesWithFavorites(m.namespaces, favorites)
+	if !m.focusNamespace(selected.Name) {
+		m.adjustNamespaceViewport(len(m.namespaceRows()))
+	}
+}
@@ -0,0 +1,90 @@
+package tui
+
+import (
+	"errors"
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/maratkarimov/kubertino/internal/config"
+	"github.com/maratkarimov/kubertino/internal/k8s"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// reload presses the reload key and delivers the configuration load returns
+func reload(t *testing.T, model AppModel, load func() (*config.Config, error)) AppModel {
+	t.Helper()
+	model.SetConfigReload(load)
+	updated, cmd := model.Update(tea.KeyMsg{Type: tea.KeyCtrlR})
+	require.NotNil(t, cmd)
+	msg, ok := cmd().(configReloadedMsg)
+	require.True(t, ok)
+	updated, _ = updated.(AppModel).Update(msg)
+	return updated.(AppModel)
+}
+
+func TestReload_AppliesConfigAndKeepsFetchedState(t *testing.T) {
+	model := newPaletteTestModel(t)
+	model.selectedNamespaceIndex = 2 // staging
+
+	model = reload(t, model, func() (*config.Config, error) {
+		return &config.Config{
+			Contexts:  []config.Context{{Name: "dev"}, {Name: "prod"}},
+			Favorites: []interface{}{"payments"},
+			Actions:   []config.Action{{Name: "Shell", Shortcut: "s", Command: "sh"}},
+			Keymap:    &config.Keymap{History: []string{"H"}},
+		}, nil
+	})
+
+	require.Len(t, model.actions, 1)
+	assert.Equal(t, "Shell", model.actions[0].Name)
+	assert.Equal(t, []string{"H"}, model.keys.History)
+	assert.Equal(t, "dev", model.currentContext.Name)
+	assert.Same(t, &model.contexts[0], model.currentContext, "points into the reloaded contexts")
+
+	assert.Equal(t, []string{"payments", "default", "staging"}, k8s.NamespaceNames(model.namespaces), "favorites first")
+	assert.Equal(t, "staging", model.namespaces[model.selectedNamespaceIndex].Name, "cursor stays on its namespace")
+	assert.Equal(t, "default", model.currentNamespace)
+	assert.Len(t, model.pods, 2, "pods are not refetched")
+
+	toast, ok := model.statusBar.Current()
+	require.True(t, ok)
+	assert.Equal(t, "Configuration reloaded", toast.Text)
+}
+
+func TestReload_InvalidConfigKeepsCurrent(t *testing.T) {
+	model := newPaletteTestModel(t)
+	before := model.config
+
+	model = reload(t, model, func() (*config.Config, error) {
+		return nil, errors.New("invalid action[0]: command cannot be empty")
+	})
+
+	assert.Same(t, before, model.config)
+	assert.Equal(t, "Tail logs", model.actions[0].Name)
+	require.True(t, model.errorModal.IsVisible)
+	assert.Contains(t, model.errorModal.Message, "command cannot be empty")
+}
+
+func TestReload_RemovedContextOpensContextList(t *testing.T) {
+	model := newPaletteTestModel(t)
+
+	model = reload(t, model, func() (*config.Config, error) {
+		return &config.Config{Contexts: []config.Context{{Name: "prod"}, {Name: "staging"}}}, nil
+	})
+
+	assert.Nil(t, model.currentContext)
+	assert.Empty(t, model.actions)
+	assert.Equal(t, viewModeContextSelection, model.viewMode)
+	assert.Equal(t, "prod", model.contexts[0].Name)
+}
+
+func TestReload_WithoutReloader(t *testing.T) {
+	model := newPaletteTestModel(t)
+
+	model = sendKey(model, tea.KeyMsg{Type: tea.KeyCtrlR})
+
+	toast, ok := model.statusBar.Current()
+	require.True(t, ok)
+	assert.Contains(t, toast.Text, "cannot be reloaded")
+}
@@ -0,0 +1,78 @@
+package tui
+
+import (
+	"strings"
+
+	"github.com/charmbracelet/lipgloss"
+	"github.com/muesli/termenv"
+)
+
+// RenderMode is how the TUI draws on terminals that cannot show everything it uses, such as
+// constrained SSH sessions and serial consoles
+type RenderMode struct {
+	ASCII   bool // Borders, spinner frames and symbols are drawn with ASCII characters
+	NoColor bool // No colors; the cursor marker shows the selection
+}
+
+// asciiTerms are TERM values of terminals that draw only ASCII reliably
+var asciiTerms = map[string]bool{
+	"dumb": true, "vt52": true, "vt100": true, "vt102": true, "vt220": true,
+	"ansi": true, "linux": true, "cons25": true,
+}
+
+// DetectRenderMode returns the render mode for the terminal described by the environment
+// read with getenv: NO_COLOR or a dumb terminal turn colors off, and terminals known to
+// lack box-drawing characters (serial consoles, the Linux console) get ASCII
+func DetectRenderMode(getenv func(string) string) RenderMode {
+	term := getenv("TERM")
+	return RenderMode{
+		ASCII:   term == "" || asciiTerms[term],
+		NoColor: getenv("NO_COLOR") != "" || term == "" || term == "dumb",
+	}
+}
+
+// colorProfile returns the color profile for mode. ASCII terminals get the 8 ANSI colors
+// unless COLORTERM says they can do better; ok is false when the detected profile is kept.
+func (r RenderMode) colorProfile(getenv func(string) string) (profile termenv.Profile, ok bool) {
+	switch {
+	case r.NoColor:
+		return termenv.Ascii, true
+	case r.ASCII && getenv("COLORTERM") == "":
+		return termenv.ANSI, true
+	}
+	return 0, false
+}
+
+// SetRenderMode draws the TUI in mode. It sets the color profile of lipgloss, which is
+// shared by every style, so it is meant to be called once before the program starts.
+func (m *AppModel) SetRenderMode(mode RenderMode, getenv func(string) string) {
+	m.renderMode = mode
+	m.executor.SetASCII(mode.ASCII)
+	if profile, ok := mode.colorProfile(getenv); ok {
+		lipgloss.SetColorProfile(profile)
+	}
+}
+
+// asciiGlyphs maps the box-drawing characters, spinner frames and symbols the TUI draws to
+// ASCII characters of the same width, so layouts measured before replacing stay aligned
+var asciiGlyphs = strings.NewReplacer(
+	// Borders: rounded, normal, thick and double
+	"╭", "+", "╮", "+", "╰", "+", "╯", "+",
+	"┌", "+", "┐", "+", "└", "+", "┘", "+", "├", "+", "┤", "+", "┬", "+", "┴", "+", "┼", "+",
+	"┏", "+", "┓", "+", "┗", "+", "┛", "+",
+	"╔", "+", "╗", "+", "╚", "+", "╝", "+",
+	"─", "-", "━", "-", "═", "=", "│", "|", "┃", "|", "║", "|",
+	// Spinner frames
+	"⠋", "|", "⠙", "/", "⠹", "-", "⠸", "\\", "⠼", "|", "⠴", "/", "⠦", "-", "⠧", "\\", "⠇", "|", "⠏", "/",
+	// Symbols
+	"↑", "^", "↓", "v", "→", ">", "▸", ">", "▾", "v", "•", "*", "●", "*", "○", "o", "◌", "o",
+	"…", "~", "·", ".", "✓", "+", "✗", "x", "⏱", "T", "⚠", "!", "█", "#", "↻", "@", "🔒", "RO", "⚑", "^",
+)
+
+// asciiView returns view as drawn in the render mode: unchanged unless it is ASCII
+func (m AppModel) asciiView(view string) string {
+	if !m.renderMode.ASCII {
+		return view
+	}
+	return asciiGlyphs.Replace(view)
+}
@@ -0,0 +1,79 @@
+package tui
+
+import (
+	"testing"
+
+	"github.com/charmbracelet/lipgloss"
+	"github.com/maratkarimov/kubertino/internal/config"
+	"github.com/muesli/termenv"
+	"github.com/stretchr/testify/assert"
+)
+
+// envOf returns a getenv reading env
+func envOf(env map[string]string) func(string) string {
+	return func(key string) string { return env[key] }
+}
+
+func TestDetectRenderMode(t *testing.T) {
+	tests := []struct {
+		name string
+		env  map[string]string
+		want RenderMode
+	}{
+		{"xterm", map[string]string{"TERM": "xterm-256color"}, RenderMode{}},
+		{"no color", map[string]string{"TERM": "xterm-256color", "NO_COLOR": "1"}, RenderMode{NoColor: true}},
+		{"serial console", map[string]string{"TERM": "vt100"}, RenderMode{ASCII: true}},
+		{"linux console", map[string]string{"TERM": "linux"}, RenderMode{ASCII: true}},
+		{"dumb", map[string]string{"TERM": "dumb"}, RenderMode{ASCII: true, NoColor: true}},
+		{"no TERM", map[string]string{}, RenderMode{ASCII: true, NoColor: true}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, DetectRenderMode(envOf(tt.env)))
+		})
+	}
+}
+
+func TestRenderMode_ColorProfile(t *testing.T) {
+	profile, ok := RenderMode{NoColor: true}.colorProfile(envOf(nil))
+	assert.True(t, ok)
+	assert.Equal(t, termenv.Ascii, profile)
+
+	profile, ok = RenderMode{ASCII: true}.colorProfile(envOf(nil))
+	assert.True(t, ok)
+	assert.Equal(t, termenv.ANSI, profile, "8-color-safe styles")
+
+	_, ok = RenderMode{ASCII: true}.colorProfile(envOf(map[string]string{"COLORTERM": "truecolor"}))
+	assert.False(t, ok, "COLORTERM keeps the detected colors")
+	_, ok = RenderMode{}.colorProfile(envOf(nil))
+	assert.False(t, ok)
+}
+
+func TestRenderMode_ASCIIView(t *testing.T) {
+	profile := lipgloss.ColorProfile()
+	defer lipgloss.SetColorProfile(profile)
+
+	model := newAppearanceTestModel(nil)
+	model.SetRenderMode(RenderMode{ASCII: true}, envOf(nil))
+	model.actionSpinner.Start("Running Logs")
+
+	view := model.View()
+	for _, r := range view {
+		if r > 127 {
+			t.Fatalf("non-ASCII %q in view:\n%s", r, view)
+		}
+	}
+	assert.Contains(t, view, "+---")
+	assert.Equal(t, lipgloss.Width(newAppearanceTestModel(nil).View()), lipgloss.Width(view), "same layout")
+}
+
+func TestRenderMode_NoColorMarksFavorites(t *testing.T) {
+	model := newAppearanceTestModel(nil)
+	model.renderMode = RenderMode{NoColor: true}
+	assert.Equal(t, "* ", model.favoriteMarker(true))
+	assert.Equal(t, "  ", model.favoriteMarker(false))
+
+	model = newAppearanceTestModel(&config.Appearance{Favorite: "★ "})
+	model.renderMode = RenderMode{NoColor: true}
+	assert.Equal(t, "★ ", model.favoriteMarker(true), "a configured marker is kept")
+}
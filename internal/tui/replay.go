@@ -0,0 +1,73 @@
+package tui
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+	"time"
+
+	"github.com/maratkarimov/kubertino/internal/config"
+	"github.com/maratkarimov/kubertino/internal/k8s"
+)
+
+// errReplayOnly is returned by the adapter of a replayed session: data comes from the recording
+var errReplayOnly = errors.New("not available while replaying a recording")
+
+// replayAdapter is the cluster of a replayed session. Nothing is fetched; the recorded results
+// are fed to the model instead.
+type replayAdapter struct{}
+
+func (replayAdapter) GetNamespaces(string) ([]k8s.Namespace, error) { return nil, errReplayOnly }
+func (replayAdapter) GetPods(string, string) ([]k8s.Pod, error)     { return nil, errReplayOnly }
+func (replayAdapter) SwitchContext(string) error                    { return nil }
+
+// Replay builds a model from the recording read from r and drives it with the recorded
+// messages. The commands the model returns are not run, so nothing is fetched or executed and
+// the replay is deterministic. frame is called with the type of every message and the view
+// after it. The caller sets the color profile of lipgloss; ASCII gives plain text.
+func Replay(r io.Reader, frame func(msgType, view string)) error {
+	dec := json.NewDecoder(r)
+	var header recordingHeader
+	if err := dec.Decode(&header); err != nil {
+		return fmt.Errorf("failed to read recording header: %w", err)
+	}
+	if header.Version != recordingVersion {
+		return fmt.Errorf("unsupported recording version %d (expected %d)", header.Version, recordingVersion)
+	}
+	cfg, err := config.ParseBytes([]byte(header.Config))
+	if err != nil {
+		return fmt.Errorf("failed to read recorded config: %w", err)
+	}
+	slog.Info("replaying recording", "recorded_by", header.Kubertino, "started", header.Started)
+
+	model := NewAppModel(cfg, replayAdapter{})
+	model.renderMode = header.RenderMode
+	model.executor.SetASCII(header.RenderMode.ASCII)
+	model.Init()
+
+	// Recorded timestamps move by the time since recording, so ages render as they did then
+	shift := time.Since(header.Started)
+	for n := 1; ; n++ {
+		var recorded recordedMsg
+		if err := dec.Decode(&recorded); errors.Is(err, io.EOF) {
+			return nil
+		} else if err != nil {
+			return fmt.Errorf("failed to read message %d of the recording: %w", n, err)
+		}
+
+		codec, ok := msgCodecs[recorded.Type]
+		if !ok {
+			return fmt.Errorf("message %d: unknown type %q (recorded by a newer Kubertino?)", n, recorded.Type)
+		}
+		msg, err := codec.decode(recorded.Msg, shift)
+		if err != nil {
+			return fmt.Errorf("message %d: invalid %s: %w", n, recorded.Type, err)
+		}
+
+		updated, _ := model.Update(msg)
+		model = updated.(AppModel)
+		frame(recorded.Type, model.View())
+	}
+}
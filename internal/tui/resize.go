@@ -0,0 +1,50 @@
+package tui
+
+import (
+	"os"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"golang.org/x/term"
+)
+
+// terminalSize returns the current size of the terminal (replaced in tests)
+var terminalSize = func() (width, height int, err error) {
+	return term.GetSize(int(os.Stdout.Fd()))
+}
+
+// windowSizeCmd queries the size of the terminal again. An external action owns the terminal
+// while it runs, so a resize during it may not reach the model; the size from before would
+// then lay out the panels. Nothing is sent when the size cannot be read.
+func windowSizeCmd() tea.Msg {
+	width, height, err := terminalSize()
+	if err != nil || width <= 0 || height <= 0 {
+		return nil
+	}
+	return tea.WindowSizeMsg{Width: width, Height: height}
+}
+
+// resize lays out the model and its open dialogs for a terminal of width x height
+func (m *AppModel) resize(width, height int) {
+	m.width = width
+	m.height = height
+	m.termWidth = width
+	m.termHeight = height
+
+	// Story 6.3: Update error modal size for proper centering
+	m.errorModal.SetSize(width, height)
+	if m.confirm != nil {
+		m.confirm.SetSize(width, height)
+	}
+	if m.paramForm != nil {
+		m.paramForm.SetSize(width, height)
+	}
+	if m.namespaceForm != nil {
+		m.namespaceForm.SetSize(width, height)
+	}
+	if m.pager != nil {
+		m.pager.SetSize(width, height)
+	}
+
+	// Check minimum size
+	m.terminalTooSmall = width < MinTerminalWidth || height < MinTerminalHeight
+}
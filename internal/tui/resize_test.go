@@ -0,0 +1,143 @@
+package tui
+
+import (
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/maratkarimov/kubertino/internal/config"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// setTerminalSize makes the terminal report width x height for the test
+func setTerminalSize(t *testing.T, width, height int, err error) {
+	t.Helper()
+	original := terminalSize
+	terminalSize = func() (int, int, error) { return width, height, err }
+	t.Cleanup(func() { terminalSize = original })
+}
+
+// findWindowSize runs cmd and the commands it batches, and returns the size message among them
+func findWindowSize(cmd tea.Cmd) (tea.WindowSizeMsg, bool) {
+	if cmd == nil {
+		return tea.WindowSizeMsg{}, false
+	}
+	switch msg := cmd().(type) {
+	case tea.WindowSizeMsg:
+		return msg, true
+	case tea.BatchMsg:
+		for _, c := range msg {
+			if size, ok := findWindowSize(c); ok {
+				return size, true
+			}
+		}
+	}
+	return tea.WindowSizeMsg{}, false
+}
+
+func TestExecFinished_RequeriesSizeAfterResize(t *testing.T) {
+	model := newFollowUpTestModel("")
+	updated, _ := model.Update(tea.WindowSizeMsg{Width: 120, Height: 40})
+	model = updated.(AppModel)
+
+	// The terminal shrinks while the action owns it
+	setTerminalSize(t, 90, 30, nil)
+	updated, cmd := model.Update(finished(t, "Health", 0))
+	model = updated.(AppModel)
+	assert.Equal(t, 120, model.termWidth, "the stale size is kept until the query returns")
+
+	size, ok := findWindowSize(cmd)
+	require.True(t, ok, "the size is queried once the action returns")
+	assert.Equal(t, tea.WindowSizeMsg{Width: 90, Height: 30}, size)
+
+	updated, _ = model.Update(size)
+	model = updated.(AppModel)
+	assert.Equal(t, 90, model.termWidth)
+	assert.Equal(t, 30, model.termHeight)
+	assert.Equal(t, 90, model.width)
+	for _, line := range strings.Split(model.View(), "\n") {
+		assert.LessOrEqual(t, lipgloss.Width(line), 90, "the panels are laid out for the new width")
+	}
+}
+
+func TestExecFinished_RelayoutsOpenDialogs(t *testing.T) {
+	model := newFollowUpTestModel("")
+	updated, _ := model.Update(tea.WindowSizeMsg{Width: 120, Height: 40})
+	model = updated.(AppModel)
+
+	// A failure opens the follow-up dialog, sized for the terminal from before the action
+	setTerminalSize(t, 90, 30, nil)
+	updated, cmd := model.Update(finished(t, "Health", 2))
+	model = updated.(AppModel)
+	require.True(t, model.confirm.IsVisible)
+
+	size, ok := findWindowSize(cmd)
+	require.True(t, ok)
+	updated, _ = model.Update(size)
+	model = updated.(AppModel)
+	view := model.View()
+	assert.Contains(t, view, "Run show-logs on pod web-1?")
+	for _, line := range strings.Split(view, "\n") {
+		assert.LessOrEqual(t, lipgloss.Width(line), 90, "the dialog is centered in the new size")
+	}
+}
+
+func TestExecFinished_ResizeBelowMinimum(t *testing.T) {
+	model := newFollowUpTestModel("")
+	updated, _ := model.Update(tea.WindowSizeMsg{Width: 120, Height: 40})
+	model = updated.(AppModel)
+
+	setTerminalSize(t, MinTerminalWidth-1, MinTerminalHeight, nil)
+	_, cmd := model.Update(finished(t, "Health", 0))
+	size, ok := findWindowSize(cmd)
+	require.True(t, ok)
+	updated, _ = model.Update(size)
+	model = updated.(AppModel)
+	assert.True(t, model.terminalTooSmall)
+}
+
+func TestNodeShellExited_RequeriesSizeAfterResize(t *testing.T) {
+	for _, err := range []error{nil, errors.New("exit status 1")} {
+		model := newNodeShellTestModel(&nodeShellAdapter{}, withContexts(config.Context{Name: "dev"}))
+		model.statusBar.Duration = time.Millisecond // The toast tick is run with the batch
+
+		setTerminalSize(t, 90, 30, nil)
+		updated, cmd := model.Update(nodeShellExitedMsg{node: "worker-1", err: err})
+		model = updated.(AppModel)
+		size, ok := findWindowSize(cmd)
+		require.True(t, ok, "the size is queried once the node shell returns (error: %v)", err)
+
+		updated, _ = model.Update(size)
+		assert.Equal(t, 90, updated.(AppModel).termWidth)
+	}
+}
+
+func TestPodEdited_RequeriesSizeAfterResize(t *testing.T) {
+	adapter := &yamlAdapter{output: "kind: Pod\n"}
+	model := openYAMLViewer(t, newYAMLTestModel(adapter, false))
+
+	setTerminalSize(t, 90, 30, nil)
+	updated, cmd := model.Update(podEditedMsg{pod: model.yamlPod})
+	model = updated.(AppModel)
+	size, ok := findWindowSize(cmd)
+	require.True(t, ok, "the size is queried once kubectl edit returns")
+
+	updated, _ = model.Update(size)
+	model = updated.(AppModel)
+	assert.Equal(t, 90, model.termWidth)
+	for _, line := range strings.Split(model.View(), "\n") {
+		assert.LessOrEqual(t, lipgloss.Width(line), 90, "the viewer is laid out for the new width")
+	}
+}
+
+func TestWindowSizeCmd_UnreadableSize(t *testing.T) {
+	setTerminalSize(t, 0, 0, errors.New("not a terminal"))
+	assert.Nil(t, windowSizeCmd())
+
+	setTerminalSize(t, 0, 0, nil)
+	assert.Nil(t, windowSizeCmd(), "an empty size is not sent")
+}
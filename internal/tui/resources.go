@@ -0,0 +1,257 @@
+package tui
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"strings"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/maratkarimov/kubertino/internal/config"
+	"github.com/maratkarimov/kubertino/internal/k8s"
+	"github.com/maratkarimov/kubertino/internal/tui/styles"
+)
+
+// ResourceLister is implemented by adapters that can list workload resources other than pods
+type ResourceLister interface {
+	GetResources(context, namespace string, kind k8s.ResourceKind) ([]k8s.Resource, error)
+}
+
+// resourcesFetchedMsg is sent when resources of the current kind are fetched
+type resourcesFetchedMsg = resultMsg[[]k8s.Resource]
+
+// browsingResources reports whether the right panel shows a non-pod resource kind
+func (m AppModel) browsingResources() bool {
+	return m.resourceKind != "" && m.resourceKind != k8s.KindPod
+}
+
+// fetchResourcesCmd returns a command that fetches resources of the current kind asynchronously
+func (m AppModel) fetchResourcesCmd() tea.Cmd {
+	lister, _ := m.kubeAdapter.(ResourceLister)
+	kind := m.resourceKind
+	return fetchCmd(m.requests, asyncResources, "", func(context.Context) ([]k8s.Resource, error) {
+		if m.currentContext == nil || m.currentNamespace == "" {
+			return nil, fmt.Errorf("no namespace selected")
+		}
+
+		slog.Info("fetching resources", "context", m.currentContext.Name, "namespace", m.currentNamespace, "kind", kind)
+		resources, err := lister.GetResources(m.currentContext.Name, m.currentNamespace, kind)
+		if err != nil {
+			slog.Error("resource fetch failed", "kind", kind, "namespace", m.currentNamespace, "error", err)
+		}
+		return resources, err
+	})
+}
+
+// startResourceFetch begins loading resources of the current kind.
+// Returns nil when the pods view is active (pods are fetched separately).
+func (m *AppModel) startResourceFetch() tea.Cmd {
+	if !m.browsingResources() {
+		return nil
+	}
+
+	m.resources = nil
+	m.resourcesError = nil
+	m.resourcesLoading = true
+	m.selectedResourceIndex = 0
+	m.podsSpinner.Start(m.loadingMessage(config.LoadingResources))
+	return tea.Batch(m.fetchResourcesCmd(), m.spinnerTickCmd())
+}
+
+// handleCycleResourceKind switches the right panel to the next resource kind
+func (m AppModel) handleCycleResourceKind() (tea.Model, tea.Cmd) {
+	if _, ok := m.kubeAdapter.(ResourceLister); !ok {
+		slog.Debug("resource browsing not supported by adapter")
+		return m, nil
+	}
+
+	if m.resourceKind == "" {
+		m.resourceKind = k8s.KindPod
+	}
+	m.resourceKind = m.resourceKind.Next()
+	slog.Info("resource kind switched", "kind", m.resourceKind)
+	// Resources of the previous kind are no longer wanted
+	m.requests.cancel(asyncResources)
+
+	if m.currentNamespace == "" {
+		return m, nil
+	}
+	return m, m.startResourceFetch()
+}
+
+// handleResourcesFetched stores fetched resources, ignoring results for a kind no longer shown
+func (m AppModel) handleResourcesFetched(msg resourcesFetchedMsg) (tea.Model, tea.Cmd) {
+	if !m.requests.current(msg.asyncRequest) {
+		return m, nil
+	}
+
+	m.resourcesLoading = false
+	m.podsSpinner.Stop()
+
+	if msg.err != nil {
+		m.resourcesError = msg.err
+		m.showError(
+			msg.err.Error(),
+			msg.err,
+			"Fetch "+m.resourceKind.Title(),
+			"Check your network connection and cluster access",
+			func() tea.Cmd { return m.fetchResourcesCmd() },
+		)
+		return m, nil
+	}
+
+	m.resourcesError = nil
+	m.resources = msg.value
+	if m.selectedResourceIndex >= len(m.resources) {
+		m.selectedResourceIndex = 0
+	}
+	return m, nil
+}
+
+// moveResourceSelection moves the resource cursor by delta within bounds
+func (m *AppModel) moveResourceSelection(delta int) {
+	next := m.selectedResourceIndex + delta
+	if next >= 0 && next < len(m.resources) {
+		m.selectedResourceIndex = next
+	}
+}
+
+// handleResourceAction executes an action against the selected non-pod resource
+func (m AppModel) handleResourceAction(action config.Action) (tea.Model, tea.Cmd) {
+	if m.selectedResourceIndex < 0 || m.selectedResourceIndex >= len(m.resources) {
+		m.errorModal.Show(fmt.Sprintf("No %s selected", m.resourceKind), "Execute Action", nil)
+		return m, nil
+	}
+
+	// Pod-only actions cannot run against deployments, jobs, ...
+	if strings.Contains(action.Command, ".pod") {
+		m.errorModal.ShowWithSuggestion(
+			fmt.Sprintf("Action '%s' requires a pod", action.Name),
+			"Execute Action",
+			fmt.Sprintf("Press %s to switch back to pods, or use {{.resource}} and {{.kind}} in the command", firstKey(m.keys.ResourceType)),
+			nil,
+		)
+		return m, nil
+	}
+
+	resource := m.resources[m.selectedResourceIndex]
+	if !action.AppliesToTarget(resource.Name) {
+		return m.showNotApplicable(action, resource.Name)
+	}
+
+	// Actions with params ask for their values first and come back here with them
+	if len(action.Params) > 0 && m.paramValues == nil {
+		return m.openParamForm(action)
+	}
+
+	command, err := m.executor.Command(action, *m.currentContext, m.currentNamespace, resource, k8s.Pod{}, m.config.KubeconfigPath(m.currentContext.Name), m.paramValues)
+	if err != nil {
+		m.errorModal.Show(fmt.Sprintf("Action failed: %s", err.Error()), "Execute Action", nil)
+		return m, nil
+	}
+
+	return m.execAction(action, resource, command)
+}
+
+// renderResourcePanel renders the right-top panel for non-pod resource kinds
+func (m AppModel) renderResourcePanel(width, height int) string {
+	title := styles.PanelTitleStyle.Render(m.resourceKind.Title())
+
+	var content string
+	switch {
+	case m.resourcesLoading:
+		content = m.podsSpinner.View()
+		if content == "" {
+			content = styles.LoadingStyle.Render("Loading...")
+		}
+	case m.resourcesError != nil:
+		content = styles.ErrorStyle.Render(fmt.Sprintf("Error: %v", m.resourcesError))
+	case m.currentNamespace == "":
+		content = styles.PlaceholderStyle.Render("Select a namespace to view " + strings.ToLower(m.resourceKind.Title()))
+	case len(m.resources) == 0:
+		content = styles.PlaceholderStyle.Render(fmt.Sprintf("No %s in this namespace", strings.ToLower(m.resourceKind.Title())))
+	default:
+		// Reserve space for: border (2) + padding (2) + title (1) + blank (1) + help text (2) = 8 lines
+		visibleHeight := height - 8
+		if visibleHeight < 1 {
+			visibleHeight = 5
+		}
+
+		// Keep the cursor inside the visible window
+		offset := 0
+		if m.selectedResourceIndex >= visibleHeight {
+			offset = m.selectedResourceIndex - visibleHeight + 1
+		}
+		end := offset + visibleHeight
+		if end > len(m.resources) {
+			end = len(m.resources)
+		}
+
+		// Status and name columns sized over all resources so rows stay aligned while scrolling
+		statusWidth, nameWidth := 0, 0
+		for _, resource := range m.resources {
+			statusWidth = max(statusWidth, lipgloss.Width(resource.Status))
+			nameWidth = max(nameWidth, lipgloss.Width(resource.Name))
+		}
+		// Text width inside the border (2) and horizontal padding (2*2)
+		_, nameWidth = fitColumns([]int{statusWidth}, nameWidth, width-6-m.cursorWidth())
+
+		var lines []string
+		for i := offset; i < end; i++ {
+			resource := m.resources[i]
+			selected := i == m.selectedResourceIndex && m.focusedPanel == PanelPods
+			marker := m.cursorMarker(selected)
+			name := truncateName(resource.Name, nameWidth)
+			if selected {
+				name = m.selectionStyle(styles.SelectedPodStyle).Render(name)
+			}
+			status := m.getResourceStatusStyle(resource.Status).Render(padRight(resource.Status, statusWidth))
+			lines = append(lines, marker+status+" "+name)
+		}
+		content = lipgloss.JoinVertical(lipgloss.Left, lines...)
+
+		if end < len(m.resources) {
+			more := styles.HelpTextStyle.Render(fmt.Sprintf("↓ %d more", len(m.resources)-end))
+			content = lipgloss.JoinVertical(lipgloss.Left, content, more)
+		}
+	}
+
+	help := fmt.Sprintf("↑/↓: Navigate | %s: Next resource type | Tab: Switch panel", firstKey(m.keys.ResourceType))
+	if _, ok := m.kubeAdapter.(ConfigDataViewer); ok && m.browsingConfigData() {
+		help = "Enter: View keys | " + help
+	}
+	helpText := styles.HelpTextStyle.Copy().Width(width - 6).Render(help)
+	fullContent := lipgloss.JoinVertical(lipgloss.Left, title, "", content, "", helpText)
+
+	borderStyle := styles.UnfocusedPanelBorderStyle
+	if m.focusedPanel == PanelPods {
+		borderStyle = styles.FocusedPanelBorderStyle
+	}
+
+	return borderStyle.
+		Width(width - 2).
+		Height(height - 2).
+		Render(fullContent)
+}
+
+// getResourceStatusStyle returns the style for a resource status summary
+func (m AppModel) getResourceStatusStyle(status string) lipgloss.Style {
+	switch {
+	case status == "Complete":
+		return styles.RunningStyle
+	case status == "Running" || status == "Pending":
+		return styles.PendingStyle
+	case status == "Failed":
+		return styles.FailedStyle
+	case strings.HasSuffix(status, " ready"):
+		// "N/M ready": green when all replicas are ready
+		var ready, desired int
+		if _, err := fmt.Sscanf(status, "%d/%d ready", &ready, &desired); err == nil && ready >= desired {
+			return styles.RunningStyle
+		}
+		return styles.PendingStyle
+	default:
+		return styles.DimStyle
+	}
+}
@@ -0,0 +1,132 @@
+package tui
+
+import (
+	"strings"
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/maratkarimov/kubertino/internal/config"
+	"github.com/maratkarimov/kubertino/internal/k8s"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// resourceAdapter is a mock adapter that also lists workload resources
+type resourceAdapter struct {
+	*mockKubeAdapter
+	resources map[k8s.ResourceKind][]k8s.Resource
+}
+
+func (a *resourceAdapter) GetResources(context, namespace string, kind k8s.ResourceKind) ([]k8s.Resource, error) {
+	return a.resources[kind], nil
+}
+
+func newResourceTestModel() AppModel {
+	adapter := &resourceAdapter{
+		mockKubeAdapter: newMockAdapter(),
+		resources: map[k8s.ResourceKind][]k8s.Resource{
+			k8s.KindDeployment: {
+				{Kind: k8s.KindDeployment, Name: "web", Status: "2/2 ready"},
+				{Kind: k8s.KindDeployment, Name: "worker", Status: "0/1 ready"},
+			},
+		},
+	}
+	model := newTestModel(adapter, withContexts(config.Context{
+		Name: "test-context",
+		Actions: []config.Action{
+			{Name: "Logs", Shortcut: "l", Command: "kubectl logs {{.pod}}"},
+			{Name: "Restart", Shortcut: "x", Command: "kubectl rollout restart {{.kind}}/{{.resource}}"},
+		},
+	}))
+	model.currentNamespace = "default"
+	model.pods = []k8s.Pod{{Name: "web-1", Status: "Running"}}
+	model.focusedPanel = PanelPods
+	model.selectedPodIndex = 0
+	return model
+}
+
+// cycleResourceKind presses the resource switcher and delivers the fetch result
+func cycleResourceKind(t *testing.T, model AppModel) AppModel {
+	t.Helper()
+	updated, cmd := model.Update(runeKey('r'))
+	model = updated.(AppModel)
+	if model.browsingResources() {
+		require.True(t, model.resourcesLoading)
+		require.NotNil(t, cmd)
+		updated, _ = model.Update(model.fetchResourcesCmd()())
+		model = updated.(AppModel)
+	}
+	return model
+}
+
+func TestResourceBrowser_CyclesKinds(t *testing.T) {
+	model := newResourceTestModel()
+
+	want := []k8s.ResourceKind{k8s.KindDeployment, k8s.KindStatefulSet, k8s.KindJob, k8s.KindConfigMap, k8s.KindSecret, k8s.KindPod}
+	for _, kind := range want {
+		model = cycleResourceKind(t, model)
+		assert.Equal(t, kind, model.resourceKind)
+	}
+	assert.False(t, model.browsingResources())
+	assert.Contains(t, model.renderPodPanel(60, 20), "web-1", "pods are shown again after a full cycle")
+}
+
+func TestResourceBrowser_RendersAndNavigates(t *testing.T) {
+	model := cycleResourceKind(t, newResourceTestModel())
+
+	view := model.renderPodPanel(60, 20)
+	assert.Contains(t, view, "Deployments")
+	assert.Contains(t, view, "web")
+	assert.Contains(t, view, "2/2 ready")
+
+	model = sendKey(model, tea.KeyMsg{Type: tea.KeyDown})
+	assert.Equal(t, 1, model.selectedResourceIndex)
+	model = sendKey(model, tea.KeyMsg{Type: tea.KeyDown})
+	assert.Equal(t, 1, model.selectedResourceIndex, "cursor stops at the last resource")
+
+	_, ok := model.selectedPod()
+	assert.False(t, ok, "pod selection is unavailable while browsing other kinds")
+}
+
+func TestResourceBrowser_ColumnsAligned(t *testing.T) {
+	model := newResourceTestModel()
+	adapter := model.kubeAdapter.(*resourceAdapter)
+	adapter.resources[k8s.KindDeployment] = []k8s.Resource{
+		{Kind: k8s.KindDeployment, Name: "web", Status: "12/12 ready, 3 updating"},
+		{Kind: k8s.KindDeployment, Name: "worker", Status: "0/1 ready"},
+	}
+	model = cycleResourceKind(t, model)
+
+	view := model.renderPodPanel(80, 20)
+	web := podPanelLine(t, view, "updating")
+	worker := podPanelLine(t, view, "0/1 ready")
+	assert.Equal(t, strings.Index(web, "web"), strings.Index(worker, "worker"), "names start in the same column")
+}
+
+func TestResourceBrowser_Actions(t *testing.T) {
+	model := cycleResourceKind(t, newResourceTestModel())
+
+	// Pod-only actions are rejected
+	model = sendKey(model, runeKey('l'))
+	assert.True(t, model.errorModal.IsVisible)
+	assert.Contains(t, model.errorModal.Message, "requires a pod")
+
+	// Resource-aware actions run against the selected deployment
+	model.errorModal.Hide()
+	updated, cmd := model.Update(runeKey('x'))
+	model = updated.(AppModel)
+	assert.False(t, model.errorModal.IsVisible)
+	assert.NotNil(t, cmd)
+}
+
+func TestResourceBrowser_IgnoresStaleFetch(t *testing.T) {
+	model := cycleResourceKind(t, newResourceTestModel())
+	stale := model.fetchResourcesCmd()
+	model.fetchResourcesCmd()
+
+	msg := stale().(resourcesFetchedMsg)
+	msg.value = []k8s.Resource{{Name: "stale"}}
+	updated, _ := model.Update(msg)
+	model = updated.(AppModel)
+	assert.Len(t, model.resources, 2, "results superseded by a newer fetch are dropped")
+}
@@ -0,0 +1,76 @@
+package tui
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/maratkarimov/kubertino/internal/k8s"
+	"github.com/maratkarimov/kubertino/internal/tui/styles"
+)
+
+// RestartAnalyzer is implemented by adapters that can detect restart storms across a context
+type RestartAnalyzer interface {
+	GetRestartStorms(context string) (map[string]*k8s.RestartStorm, error)
+}
+
+// restartStormsMsg is sent when the restart analysis of a context has finished
+type restartStormsMsg = resultMsg[map[string]*k8s.RestartStorm]
+
+// analyzeRestartsCmd analyzes restarts of the current context in the background.
+// Returns nil when the adapter cannot analyze restarts.
+func (m AppModel) analyzeRestartsCmd() tea.Cmd {
+	analyzer, ok := m.kubeAdapter.(RestartAnalyzer)
+	if !ok || m.currentContext == nil {
+		return nil
+	}
+
+	contextName := m.currentContext.Name
+	return fetchCmd(m.requests, asyncRestarts, "", func(context.Context) (map[string]*k8s.RestartStorm, error) {
+		return analyzer.GetRestartStorms(contextName)
+	})
+}
+
+// handleRestartStorms stores the analysis if it is still for the current context
+func (m AppModel) handleRestartStorms(msg restartStormsMsg) (tea.Model, tea.Cmd) {
+	if !m.requests.current(msg.asyncRequest) || m.currentContext == nil {
+		return m, nil
+	}
+
+	if msg.err != nil {
+		// Cluster-wide listing is often forbidden; badges are best effort
+		slog.Warn("restart analysis failed", "context", m.currentContext.Name, "error", msg.err)
+		m.restartStorms = nil
+		return m, nil
+	}
+
+	for namespace, storm := range msg.value {
+		slog.Info("restart storm detected", "context", m.currentContext.Name, "namespace", namespace, "pods", len(storm.Pods), "recent_restarts", storm.Recent())
+	}
+	m.restartStorms = msg.value
+	return m, nil
+}
+
+// restartBadge renders the warning badge for a namespace with a restart storm.
+// The badge shows the recent restarts so the worst namespace stands out.
+func (m AppModel) restartBadge(namespace string) string {
+	storm, ok := m.restartStorms[namespace]
+	if !ok {
+		return ""
+	}
+	return styles.WarningStyle.Render(fmt.Sprintf(" ⚠ %d", storm.Recent()))
+}
+
+// podRestartBadge renders the restart marker for a pod flagged in the current namespace
+func (m AppModel) podRestartBadge(pod string) string {
+	storm, ok := m.restartStorms[m.currentNamespace]
+	if !ok {
+		return ""
+	}
+	restarts, ok := storm.Flagged(pod)
+	if !ok {
+		return ""
+	}
+	return styles.WarningStyle.Render(fmt.Sprintf(" ↻%d/1h", restarts.Recent))
+}
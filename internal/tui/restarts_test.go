@@ -0,0 +1,79 @@
+package tui
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/maratkarimov/kubertino/internal/k8s"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// restartAdapter is a mock adapter that also reports restart storms
+type restartAdapter struct {
+	*mockKubeAdapter
+	storms map[string]*k8s.RestartStorm
+	err    error
+}
+
+func (a *restartAdapter) GetRestartStorms(context string) (map[string]*k8s.RestartStorm, error) {
+	return a.storms, a.err
+}
+
+func newRestartTestModel(adapter *restartAdapter) AppModel {
+	return newTestModel(adapter)
+}
+
+func TestRestartStorms_BadgesNamespacesAndPods(t *testing.T) {
+	adapter := &restartAdapter{
+		mockKubeAdapter: newMockAdapter(),
+		storms: map[string]*k8s.RestartStorm{
+			"production": {Namespace: "production", Pods: []k8s.PodRestarts{{Pod: "test-pod-1", Restarts: 30, Recent: 12}}},
+			"staging":    {Namespace: "staging", Pods: []k8s.PodRestarts{{Pod: "api", Restarts: 3, Recent: 3}}},
+		},
+	}
+	model := newRestartTestModel(adapter)
+
+	// Namespace load triggers the analysis
+	updated, cmd := model.Update(namespaceFetchedMsg{value: k8s.NamespacesNamed(adapter.namespaces)})
+	model = updated.(AppModel)
+	require.NotNil(t, cmd)
+	updated, _ = model.Update(cmd())
+	model = updated.(AppModel)
+
+	view := model.View()
+	assert.Contains(t, view, "⚠ 12")
+	assert.Contains(t, view, "⚠ 3")
+
+	// Flagged pods carry their recent restart count
+	model.currentNamespace = "production"
+	model.pods = adapter.pods
+	assert.Contains(t, model.View(), "↻12/1h")
+	assert.Empty(t, model.podRestartBadge("test-pod-2"))
+}
+
+func TestRestartStorms_IgnoresFailuresAndStaleContexts(t *testing.T) {
+	adapter := &restartAdapter{mockKubeAdapter: newMockAdapter(), err: errors.New("forbidden")}
+	model := newRestartTestModel(adapter)
+	model.restartStorms = map[string]*k8s.RestartStorm{"old": {Namespace: "old"}}
+
+	updated, _ := model.Update(restartStormsMsg{err: adapter.err})
+	model = updated.(AppModel)
+	assert.Nil(t, model.restartStorms)
+	assert.Empty(t, model.restartBadge("old"))
+
+	// A result superseded by a newer analysis (e.g. after a context switch) is dropped
+	stale := model.analyzeRestartsCmd()
+	model.analyzeRestartsCmd()
+	msg := stale().(restartStormsMsg)
+	msg.value, msg.err = map[string]*k8s.RestartStorm{"x": {Namespace: "x"}}, nil
+	updated, _ = model.Update(msg)
+	model = updated.(AppModel)
+	assert.Nil(t, model.restartStorms)
+}
+
+func TestRestartStorms_NoAnalyzer(t *testing.T) {
+	model := newRestartTestModel(&restartAdapter{mockKubeAdapter: newMockAdapter()})
+	model.kubeAdapter = newMockAdapter()
+	assert.Nil(t, model.analyzeRestartsCmd())
+}
@@ -0,0 +1,39 @@
+package tui
+
+import (
+	"fmt"
+
+	"github.com/maratkarimov/kubertino/internal/config"
+	"github.com/maratkarimov/kubertino/internal/k8s"
+)
+
+// RetryReporter is implemented by adapters that retry failed kubectl calls
+type RetryReporter interface {
+	Retrying(context, operation string) (attempt, retries int)
+}
+
+// updateRetrySpinners appends the retry attempt of the namespace and pod fetches in flight
+// to their spinner messages, e.g. "Loading pods... retry 2/3"
+func (m *AppModel) updateRetrySpinners() {
+	reporter, ok := m.kubeAdapter.(RetryReporter)
+	if !ok || m.currentContext == nil {
+		return
+	}
+
+	if m.namespacesLoading && m.namespacesSpinner.IsActive {
+		attempt, retries := reporter.Retrying(m.currentContext.Name, k8s.RetryOperation("namespaces", ""))
+		m.namespacesSpinner.Message = retryMessage(m.loadingMessage(config.LoadingNamespaces), attempt, retries)
+	}
+	if m.podsLoading && m.podsSpinner.IsActive && m.currentNamespace != "" {
+		attempt, retries := reporter.Retrying(m.currentContext.Name, k8s.RetryOperation("pods", m.currentNamespace))
+		m.podsSpinner.Message = retryMessage(m.loadingMessage(config.LoadingPods), attempt, retries)
+	}
+}
+
+// retryMessage returns message with the retry attempt appended while a call is retried
+func retryMessage(message string, attempt, retries int) string {
+	if attempt == 0 {
+		return message
+	}
+	return fmt.Sprintf("%s retry %d/%d", message, attempt, retries)
+}
@@ -0,0 +1,42 @@
+package tui
+
+import (
+	"testing"
+
+	"github.com/maratkarimov/kubertino/internal/config"
+	"github.com/maratkarimov/kubertino/internal/tui/components"
+	"github.com/stretchr/testify/assert"
+)
+
+// retryAdapter is a mock adapter that reports kubectl calls being retried
+type retryAdapter struct {
+	*mockKubeAdapter
+	attempts map[string]int // Keyed by context|operation
+}
+
+func (a *retryAdapter) Retrying(context, operation string) (attempt, retries int) {
+	return a.attempts[context+"|"+operation], 3
+}
+
+func TestRetry_SpinnerShowsAttempt(t *testing.T) {
+	adapter := &retryAdapter{mockKubeAdapter: newMockAdapter(), attempts: map[string]int{"prod|pods/default": 2}}
+	model := newTestModel(adapter, withContexts(config.Context{Name: "prod"}))
+	model.currentContext = &model.config.Contexts[0]
+	model.currentNamespace = "default"
+	model.podsLoading = true
+	model.podsSpinner.Start("Loading pods...")
+
+	updated, _ := model.Update(components.SpinnerTickMsg{})
+	model = updated.(AppModel)
+	assert.Equal(t, "Loading pods... retry 2/3", model.podsSpinner.Message)
+
+	adapter.attempts = nil
+	updated, _ = model.Update(components.SpinnerTickMsg{})
+	model = updated.(AppModel)
+	assert.Equal(t, "Loading pods...", model.podsSpinner.Message, "cleared once the retry succeeded")
+}
+
+func TestRetryMessage(t *testing.T) {
+	assert.Equal(t, "Loading namespaces...", retryMessage("Loading namespaces...", 0, 3))
+	assert.Equal(t, "Loading namespaces... retry 1/3", retryMessage("Loading namespaces...", 1, 3))
+}
@@ -5,6 +5,7 @@ import (
 
 	tea "github.com/charmbracelet/bubbletea"
 	"github.com/maratkarimov/kubertino/internal/config"
+	"github.com/maratkarimov/kubertino/internal/k8s"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -19,7 +20,7 @@ func TestSearchMode_Activation(t *testing.T) {
 	}
 	model := NewAppModel(cfg, newMockAdapter())
 	model.viewMode = viewModeNamespaceView
-	model.namespaces = []string{"kube-system", "default", "production"}
+	model.namespaces = k8s.NamespacesNamed([]string{"kube-system", "default", "production"})
 
 	// Press '/' to activate search
 	msg := tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'/'}}
@@ -41,12 +42,12 @@ func TestSearchMode_Deactivation(t *testing.T) {
 	}
 	model := NewAppModel(cfg, newMockAdapter())
 	model.viewMode = viewModeNamespaceView
-	model.namespaces = []string{"kube-system", "default", "production"}
+	model.namespaces = k8s.NamespacesNamed([]string{"kube-system", "default", "production"})
 
 	// Activate search
 	model.searchMode = true
 	model.searchQuery = testSearchQuery
-	model.filteredNamespaces = []string{"kube-system"}
+	model.filteredNamespaces = k8s.NamespacesNamed([]string{"kube-system"})
 
 	// Press ESC to deactivate search
 	msg := tea.KeyMsg{Type: tea.KeyEsc}
@@ -118,7 +119,7 @@ func TestSearchMode_CharacterInput(t *testing.T) {
 			}
 			model := NewAppModel(cfg, newMockAdapter())
 			model.viewMode = viewModeNamespaceView
-			model.namespaces = []string{"kube-system", "default", "production"}
+			model.namespaces = k8s.NamespacesNamed([]string{"kube-system", "default", "production"})
 			model.searchMode = true
 			model.searchQuery = tt.initialQuery
 			model.filteredNamespaces = model.namespaces
@@ -143,10 +144,10 @@ func TestSearchMode_Backspace(t *testing.T) {
 	}
 	model := NewAppModel(cfg, newMockAdapter())
 	model.viewMode = viewModeNamespaceView
-	model.namespaces = []string{"kube-system", "default", "production"}
+	model.namespaces = k8s.NamespacesNamed([]string{"kube-system", "default", "production"})
 	model.searchMode = true
 	model.searchQuery = testSearchQuery
-	model.filteredNamespaces = []string{"kube-system"}
+	model.filteredNamespaces = k8s.NamespacesNamed([]string{"kube-system"})
 
 	// Press backspace
 	msg := tea.KeyMsg{Type: tea.KeyBackspace}
@@ -166,7 +167,7 @@ func TestSearchMode_BackspaceOnEmpty(t *testing.T) {
 	}
 	model := NewAppModel(cfg, newMockAdapter())
 	model.viewMode = viewModeNamespaceView
-	model.namespaces = []string{"kube-system", "default", "production"}
+	model.namespaces = k8s.NamespacesNamed([]string{"kube-system", "default", "production"})
 	model.searchMode = true
 	model.searchQuery = ""
 	model.filteredNamespaces = model.namespaces
@@ -188,10 +189,10 @@ func TestSearchMode_EnterSelectsNamespace(t *testing.T) {
 	}
 	model := NewAppModel(cfg, newMockAdapter())
 	model.viewMode = viewModeNamespaceView
-	model.namespaces = []string{"kube-system", "default", "production"}
+	model.namespaces = k8s.NamespacesNamed([]string{"kube-system", "default", "production"})
 	model.searchMode = true
 	model.searchQuery = testSearchQuery
-	model.filteredNamespaces = []string{"kube-system"}
+	model.filteredNamespaces = k8s.NamespacesNamed([]string{"kube-system"})
 	model.selectedNamespaceIndex = 0
 
 	// Press Enter
@@ -212,10 +213,10 @@ func TestSearchMode_Navigation(t *testing.T) {
 	}
 	model := NewAppModel(cfg, newMockAdapter())
 	model.viewMode = viewModeNamespaceView
-	model.namespaces = []string{"kube-system", "kube-public", "default"}
+	model.namespaces = k8s.NamespacesNamed([]string{"kube-system", "kube-public", "default"})
 	model.searchMode = true
 	model.searchQuery = testSearchQuery
-	model.filteredNamespaces = []string{"kube-system", "kube-public"}
+	model.filteredNamespaces = k8s.NamespacesNamed([]string{"kube-system", "kube-public"})
 	model.selectedNamespaceIndex = 0
 
 	// Navigate down in filtered list
@@ -242,10 +243,10 @@ func TestSearchMode_EmptyQuery(t *testing.T) {
 	}
 	model := NewAppModel(cfg, newMockAdapter())
 	model.viewMode = viewModeNamespaceView
-	model.namespaces = []string{"kube-system", "default", "production"}
+	model.namespaces = k8s.NamespacesNamed([]string{"kube-system", "default", "production"})
 	model.searchMode = true
 	model.searchQuery = "test"
-	model.filteredNamespaces = []string{}
+	model.filteredNamespaces = k8s.NamespacesNamed([]string{})
 
 	// Clear query by updating to empty
 	model.updateSearchQuery("")
@@ -263,14 +264,14 @@ func TestSearchMode_FuzzyMatching(t *testing.T) {
 	}
 	model := NewAppModel(cfg, newMockAdapter())
 	model.viewMode = viewModeNamespaceView
-	model.namespaces = []string{"kube-system", "default", "production", "kubertino-app"}
+	model.namespaces = k8s.NamespacesNamed([]string{"kube-system", "default", "production", "kubertino-app"})
 
 	// Test fuzzy matching with testSearchQuery
 	model.updateSearchQuery(testSearchQuery)
 
 	assert.Greater(t, len(model.filteredNamespaces), 0, "should have matches")
-	assert.Contains(t, model.filteredNamespaces, "kube-system", "should match kube-system")
-	assert.Contains(t, model.filteredNamespaces, "kubertino-app", "should match kubertino-app")
+	assert.Contains(t, k8s.NamespaceNames(model.filteredNamespaces), "kube-system", "should match kube-system")
+	assert.Contains(t, k8s.NamespaceNames(model.filteredNamespaces), "kubertino-app", "should match kubertino-app")
 }
 
 func TestSearchMode_NoMatches(t *testing.T) {
@@ -282,7 +283,7 @@ func TestSearchMode_NoMatches(t *testing.T) {
 	}
 	model := NewAppModel(cfg, newMockAdapter())
 	model.viewMode = viewModeNamespaceView
-	model.namespaces = []string{"kube-system", "default", "production"}
+	model.namespaces = k8s.NamespacesNamed([]string{"kube-system", "default", "production"})
 
 	// Search for something that doesn't match
 	model.updateSearchQuery("xyz")
@@ -300,11 +301,11 @@ func TestRenderNamespaceList_SearchMode(t *testing.T) {
 	model := NewAppModel(cfg, newMockAdapter())
 	model.viewMode = viewModeNamespaceView
 	model.currentContext = &cfg.Contexts[0]
-	model.namespaces = []string{"kube-system", "default"}
+	model.namespaces = k8s.NamespacesNamed([]string{"kube-system", "default"})
 	model.height = 20
 	model.searchMode = true
 	model.searchQuery = testSearchQuery
-	model.filteredNamespaces = []string{"kube-system"}
+	model.filteredNamespaces = k8s.NamespacesNamed([]string{"kube-system"})
 
 	output := model.renderNamespaceList(0)
 
@@ -326,11 +327,11 @@ func TestRenderNamespaceList_SearchModeNoMatches(t *testing.T) {
 	model := NewAppModel(cfg, newMockAdapter())
 	model.viewMode = viewModeNamespaceView
 	model.currentContext = &cfg.Contexts[0]
-	model.namespaces = []string{"kube-system", "default"}
+	model.namespaces = k8s.NamespacesNamed([]string{"kube-system", "default"})
 	model.height = 20
 	model.searchMode = true
 	model.searchQuery = "xyz"
-	model.filteredNamespaces = []string{}
+	model.filteredNamespaces = k8s.NamespacesNamed([]string{})
 
 	output := model.renderNamespaceList(0)
 
@@ -350,7 +351,7 @@ func TestRenderNamespaceList_NormalMode(t *testing.T) {
 	model := NewAppModel(cfg, newMockAdapter())
 	model.viewMode = viewModeNamespaceView
 	model.currentContext = &cfg.Contexts[0]
-	model.namespaces = []string{"kube-system", "default"}
+	model.namespaces = k8s.NamespacesNamed([]string{"kube-system", "default"})
 	model.height = 20
 	model.searchMode = false
 
@@ -370,7 +371,7 @@ func TestGetMatchIndices(t *testing.T) {
 		},
 	}
 	model := NewAppModel(cfg, newMockAdapter())
-	model.namespaces = []string{"kube-system"}
+	model.namespaces = k8s.NamespacesNamed([]string{"kube-system"})
 	model.searchMode = true
 	model.searchQuery = "ks"
 
@@ -388,7 +389,7 @@ func TestGetMatchIndices_NotInSearchMode(t *testing.T) {
 		},
 	}
 	model := NewAppModel(cfg, newMockAdapter())
-	model.namespaces = []string{"kube-system"}
+	model.namespaces = k8s.NamespacesNamed([]string{"kube-system"})
 	model.searchMode = false
 
 	indices := model.getMatchIndices("kube-system")
@@ -445,10 +446,10 @@ func TestSearchMode_DeactivationPreservesCursor(t *testing.T) {
 			}
 			model := NewAppModel(cfg, newMockAdapter())
 			model.viewMode = viewModeNamespaceView
-			model.namespaces = tt.namespaces
+			model.namespaces = k8s.NamespacesNamed(tt.namespaces)
 			model.searchMode = true
 			model.searchQuery = "kube"
-			model.filteredNamespaces = tt.filteredNamespaces
+			model.filteredNamespaces = k8s.NamespacesNamed(tt.filteredNamespaces)
 			model.selectedNamespaceIndex = tt.selectedIndexInFiltered
 			model.termHeight = 40 // Set height for viewport adjustment
 
@@ -463,3 +464,87 @@ func TestSearchMode_DeactivationPreservesCursor(t *testing.T) {
 		})
 	}
 }
+
+func TestTypeAhead_UnboundLetterStartsFiltering(t *testing.T) {
+	cfg := &config.Config{
+		Version: "1.0",
+		Contexts: []config.Context{
+			{Name: "test-context"},
+		},
+	}
+	model := NewAppModel(cfg, newMockAdapter())
+	model.viewMode = viewModeNamespaceView
+	model.focusedPanel = PanelNamespaces
+	model.namespaces = k8s.NamespacesNamed([]string{"kube-system", "default", "production"})
+
+	for _, r := range "prod" {
+		updatedModel, _ := model.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{r}})
+		model = updatedModel.(AppModel)
+	}
+
+	assert.True(t, model.searchMode, "typing should start filtering")
+	assert.Equal(t, "prod", model.searchQuery)
+	assert.Equal(t, []string{"production"}, k8s.NamespaceNames(model.filteredNamespaces))
+
+	// ESC clears the filter at once
+	updatedModel, _ := model.Update(tea.KeyMsg{Type: tea.KeyEsc})
+	model = updatedModel.(AppModel)
+
+	assert.False(t, model.searchMode)
+	assert.Equal(t, "", model.searchQuery)
+	assert.Nil(t, model.filteredNamespaces)
+}
+
+func TestTypeAhead_BoundKeysDoNotStartFiltering(t *testing.T) {
+	cfg := &config.Config{
+		Version: "1.0",
+		Contexts: []config.Context{
+			{Name: "test-context"},
+		},
+	}
+	model := NewAppModel(cfg, newMockAdapter())
+	model.viewMode = viewModeNamespaceView
+	model.focusedPanel = PanelNamespaces
+	model.namespaces = k8s.NamespacesNamed([]string{"kube-system", "default", "production"})
+
+	updatedModel, _ := model.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'j'}})
+	model = updatedModel.(AppModel)
+
+	assert.False(t, model.searchMode, "j moves the cursor")
+	assert.Equal(t, 1, model.selectedNamespaceIndex)
+}
+
+func TestSearchMode_LabelQuery(t *testing.T) {
+	cfg := &config.Config{
+		Version: "1.0",
+		Contexts: []config.Context{
+			{Name: "test-context"},
+		},
+	}
+	model := NewAppModel(cfg, newMockAdapter())
+	model.viewMode = viewModeNamespaceView
+	model.focusedPanel = PanelNamespaces
+	model.namespaces = []k8s.Namespace{
+		{Name: "payments-api", Labels: map[string]string{"team": "payments"}},
+		{Name: "payments-db", Labels: map[string]string{"team": "payments"}},
+		{Name: "search-api", Labels: map[string]string{"team": "search"}},
+	}
+	model.activateSearch()
+
+	for _, key := range []tea.KeyMsg{
+		{Type: tea.KeyRunes, Runes: []rune("team=payments")},
+		{Type: tea.KeySpace, Runes: []rune{' '}},
+		{Type: tea.KeyRunes, Runes: []rune("db")},
+	} {
+		for _, r := range key.Runes {
+			updatedModel, _ := model.Update(tea.KeyMsg{Type: key.Type, Runes: []rune{r}})
+			model = updatedModel.(AppModel)
+		}
+	}
+
+	assert.Equal(t, "team=payments db", model.searchQuery)
+	assert.Equal(t, []string{"payments-db"}, k8s.NamespaceNames(model.filteredNamespaces))
+
+	model.updateSearchQuery("team=payments")
+	assert.Equal(t, []string{"payments-api", "payments-db"}, k8s.NamespaceNames(model.filteredNamespaces))
+}
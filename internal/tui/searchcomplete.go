@@ -0,0 +1,69 @@
+package tui
+
+import (
+	"log/slog"
+	"strings"
+)
+
+// searchCycleMatches is how many of the best matches Tab cycles through in the namespace
+// search once there is nothing left to complete
+const searchCycleMatches = 5
+
+// completeSearch completes the namespace search query like shell completion: it is extended to
+// the longest common prefix of the filtered namespaces, or to the name of the only one left.
+// When there is nothing to complete, the highlight cycles through the best matches instead
+// (backwards for Shift+Tab).
+func (m *AppModel) completeSearch(back bool) {
+	names := make([]string, len(m.filteredNamespaces))
+	for i, ns := range m.filteredNamespaces {
+		names[i] = ns.Name
+	}
+
+	if !back {
+		if completion := searchCompletion(m.searchQuery, names); completion != "" {
+			slog.Debug("search query completed", "query", m.searchQuery, "completion", completion)
+			m.updateSearchQuery(completion)
+			return
+		}
+	}
+
+	n := min(len(names), searchCycleMatches)
+	if n == 0 {
+		return
+	}
+	switch {
+	case m.selectedNamespaceIndex >= n:
+		m.selectedNamespaceIndex = 0
+	case back:
+		m.selectedNamespaceIndex = (m.selectedNamespaceIndex + n - 1) % n
+	default:
+		m.selectedNamespaceIndex = (m.selectedNamespaceIndex + 1) % n
+	}
+	m.adjustNamespaceViewport(len(names))
+}
+
+// searchCompletion returns what query completes to among the matching names, or "" when it
+// cannot be extended
+func searchCompletion(query string, names []string) string {
+	if len(names) == 0 {
+		return ""
+	}
+	if len(names) == 1 {
+		if names[0] == query {
+			return ""
+		}
+		return names[0]
+	}
+
+	prefix := names[0]
+	for _, name := range names[1:] {
+		for !strings.HasPrefix(name, prefix) {
+			prefix = prefix[:len(prefix)-1]
+		}
+	}
+	// Namespace names are lower case; the query may not be
+	if len(prefix) > len(query) && strings.HasPrefix(prefix, strings.ToLower(query)) {
+		return prefix
+	}
+	return ""
+}
@@ -0,0 +1,66 @@
+package tui
+
+import (
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/maratkarimov/kubertino/internal/config"
+	"github.com/maratkarimov/kubertino/internal/k8s"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSearchCompletion(t *testing.T) {
+	tests := []struct {
+		query string
+		names []string
+		want  string
+	}{
+		{"pa", []string{"payments-api", "payments-worker"}, "payments-"},
+		{"PA", []string{"payments-api", "payments-worker"}, "payments-"},
+		{"payments-", []string{"payments-api", "payments-worker"}, ""},
+		{"pw", []string{"payments-worker"}, "payments-worker"},
+		{"payments-worker", []string{"payments-worker"}, ""},
+		{"pay", []string{"payments", "prod-payments"}, ""},
+		{"x", nil, ""},
+	}
+	for _, tt := range tests {
+		assert.Equal(t, tt.want, searchCompletion(tt.query, tt.names), "query %q", tt.query)
+	}
+}
+
+func TestSearch_TabCompletesAndCycles(t *testing.T) {
+	model := NewAppModel(&config.Config{Contexts: []config.Context{{Name: "dev"}}}, newMockAdapter())
+	model.viewMode = viewModeNamespaceView
+	model.focusedPanel = PanelNamespaces
+	model.namespaces = k8s.NamespacesNamed([]string{"default", "payments-api", "payments-worker", "payroll"})
+
+	model = sendKey(model, runeKey('/'))
+	model = sendKey(model, runeKey('p'))
+	model = sendKey(model, runeKey('a'))
+	model = sendKey(model, runeKey('y'))
+
+	// payments-api, payments-worker and payroll share "pay" only: nothing to complete
+	model = sendKey(model, tea.KeyMsg{Type: tea.KeyTab})
+	assert.Equal(t, "pay", model.searchQuery)
+	assert.Equal(t, 1, model.selectedNamespaceIndex, "cycles instead")
+	assert.Equal(t, PanelNamespaces, model.focusedPanel, "focus stays in the search")
+
+	model = sendKey(model, runeKey('m'))
+	model = sendKey(model, tea.KeyMsg{Type: tea.KeyTab})
+	assert.Equal(t, "payments-", model.searchQuery)
+	assert.Len(t, model.filteredNamespaces, 2)
+
+	// A second Tab cycles through the matches, Shift+Tab goes back
+	first := model.filteredNamespaces[0].Name
+	model = sendKey(model, tea.KeyMsg{Type: tea.KeyTab})
+	assert.Equal(t, 1, model.selectedNamespaceIndex)
+	model = sendKey(model, tea.KeyMsg{Type: tea.KeyTab})
+	assert.Equal(t, 0, model.selectedNamespaceIndex, "wraps around")
+	model = sendKey(model, tea.KeyMsg{Type: tea.KeyShiftTab})
+	assert.Equal(t, 1, model.selectedNamespaceIndex)
+	assert.NotEqual(t, first, model.filteredNamespaces[model.selectedNamespaceIndex].Name)
+
+	model = sendKey(model, runeKey('w'))
+	model = sendKey(model, tea.KeyMsg{Type: tea.KeyTab})
+	assert.Equal(t, "payments-worker", model.searchQuery)
+}
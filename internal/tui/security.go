@@ -0,0 +1,52 @@
+package tui
+
+import (
+	"strings"
+
+	"github.com/maratkarimov/kubertino/internal/k8s"
+	"github.com/maratkarimov/kubertino/internal/tui/styles"
+)
+
+// securityLabels are the short pod list labels of the pod security warnings
+var securityLabels = map[string]string{
+	k8s.SecurityPrivileged:  "priv",
+	k8s.SecurityHostNetwork: "hostnet",
+	k8s.SecurityRoot:        "root",
+}
+
+// podBadges renders the markers shown after a pod name: recent restarts and security warnings
+func (m AppModel) podBadges(pod k8s.Pod) string {
+	return m.podPinBadge(pod) + m.podRestartBadge(pod.Name) + podSecurityBadge(pod)
+}
+
+// podSecurityBadge renders the warning badge of a pod that runs privileged, on the host
+// network or as root, e.g. " ⚠ priv,root". Empty for pods without such settings.
+func podSecurityBadge(pod k8s.Pod) string {
+	warnings := pod.SecurityWarnings()
+	if len(warnings) == 0 {
+		return ""
+	}
+
+	labels := make([]string, len(warnings))
+	for i, warning := range warnings {
+		labels[i] = securityLabels[warning]
+	}
+	return styles.WarningStyle.Render(" ⚠ " + strings.Join(labels, ","))
+}
+
+// securitySummary describes the security warnings of a pod with the containers concerned,
+// e.g. "privileged: sysctl · hostNetwork · runs as root: sysctl, agent"
+func securitySummary(pod k8s.Pod) string {
+	var parts []string
+	for _, warning := range pod.SecurityWarnings() {
+		switch warning {
+		case k8s.SecurityPrivileged:
+			parts = append(parts, "privileged: "+strings.Join(pod.Privileged, ", "))
+		case k8s.SecurityHostNetwork:
+			parts = append(parts, "hostNetwork")
+		case k8s.SecurityRoot:
+			parts = append(parts, "runs as root: "+strings.Join(pod.Root, ", "))
+		}
+	}
+	return strings.Join(parts, " · ")
+}
@@ -0,0 +1,45 @@
+package tui
+
+import (
+	"testing"
+
+	"github.com/maratkarimov/kubertino/internal/k8s"
+	"github.com/stretchr/testify/assert"
+)
+
+// riskyPod runs privileged and as root on the host network
+var riskyPod = k8s.Pod{
+	Name:        "node-agent",
+	Status:      "Running",
+	HostNetwork: true,
+	Privileged:  []string{"sysctl"},
+	Root:        []string{"sysctl", "agent"},
+}
+
+func TestPodSecurityBadge(t *testing.T) {
+	assert.Empty(t, podSecurityBadge(k8s.Pod{Name: "web-1"}))
+	assert.Contains(t, podSecurityBadge(riskyPod), "⚠ priv,hostnet,root")
+	assert.Contains(t, podSecurityBadge(k8s.Pod{Root: []string{"app"}}), "⚠ root")
+}
+
+func TestSecuritySummary(t *testing.T) {
+	assert.Empty(t, securitySummary(k8s.Pod{Name: "web-1"}))
+	assert.Equal(t, "privileged: sysctl · hostNetwork · runs as root: sysctl, agent", securitySummary(riskyPod))
+}
+
+func TestSecurity_BadgeInPodList(t *testing.T) {
+	model := newDescribeTestModel(&describeAdapter{})
+	model.pods = []k8s.Pod{{Name: "web-1", Status: "Running"}, riskyPod}
+
+	view := model.View()
+	assert.Contains(t, view, "node-agent ⚠ priv,hostnet,root")
+	assert.NotContains(t, view, "web-1 ⚠")
+}
+
+func TestSecurity_SummaryInDescribeTitle(t *testing.T) {
+	model := newDescribeTestModel(&describeAdapter{output: "Name: node-agent"})
+	model.pods = []k8s.Pod{riskyPod}
+
+	model = openDescribePager(t, model)
+	assert.Contains(t, model.View(), "Describe: node-agent  ⚠ privileged: sysctl · hostNetwork · runs as root: sysctl, agent")
+}
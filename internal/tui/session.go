@@ -0,0 +1,124 @@
+package tui
+
+import (
+	"log/slog"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/maratkarimov/kubertino/internal/state"
+)
+
+// SetState enables session persistence: the last context, namespace, pod and scroll
+// positions recorded in st are restored, and changes are written back to path. After an
+// upgrade the release notes since the recorded version are shown once.
+func (m *AppModel) SetState(st *state.State, path string) {
+	m.state = st
+	m.statePath = path
+	m.checkWhatsNew()
+
+	// Panel sizes chosen in a previous session take precedence over layout.preset
+	if st != nil && st.Layout != nil {
+		m.panels = panelSplit{preset: st.Layout.Preset, namespaceWidth: st.Layout.NamespaceWidth, podsHeight: st.Layout.PodsHeight}
+	}
+
+	if st == nil || m.viewMode != viewModeContextSelection {
+		return
+	}
+
+	// Put the context cursor on the last used context
+	for i, ctx := range m.contexts {
+		if ctx.Name == st.LastContext {
+			m.selectedContextIndex = i
+			return
+		}
+	}
+}
+
+// restoreNamespace selects the namespace recorded for the current context once namespaces
+// are loaded and starts fetching its pods. Returns nil when there is nothing to restore.
+func (m *AppModel) restoreNamespace() tea.Cmd {
+	if m.state == nil || m.currentContext == nil || m.currentNamespace != "" {
+		return nil
+	}
+
+	saved := m.state.Context(m.currentContext.Name)
+	if saved.Namespace == "" {
+		return nil
+	}
+
+	for _, ns := range m.namespaces {
+		if ns.Name != saved.Namespace {
+			continue
+		}
+
+		slog.Info("restoring namespace from previous session", "context", m.currentContext.Name, "namespace", ns.Name)
+		m.namespaceViewportStart = saved.NamespaceScroll
+		m.focusNamespace(ns.Name)
+
+		m.currentNamespace = ns.Name
+		m.restorePod = saved.Pod
+		m.restorePodScroll = saved.PodScroll
+		m.pods = nil
+		m.selectedPodIndex = -1
+		m.podScrollOffset = 0
+		m.focusedPanel = PanelPods
+		return tea.Batch(m.loadPods(), m.startResourceFetch())
+	}
+
+	return nil
+}
+
+// restoreSelectedPod moves the pod cursor to the pod recorded in the previous session
+func (m *AppModel) restoreSelectedPod() {
+	if m.restorePod == "" {
+		return
+	}
+
+	for i, pod := range m.podList() {
+		if pod.Name == m.restorePod {
+			m.selectedPodIndex = i
+			m.podScrollOffset = m.restorePodScroll
+			if m.podScrollOffset > i {
+				m.podScrollOffset = i
+			}
+			m.adjustPodScrollOffset()
+			break
+		}
+	}
+
+	m.restorePod = ""
+	m.restorePodScroll = 0
+}
+
+// recordState captures the current context, namespace, pod and scroll positions
+func (m AppModel) recordState() {
+	if m.state == nil || m.currentContext == nil {
+		return
+	}
+
+	m.state.LastContext = m.currentContext.Name
+	if m.currentNamespace == "" {
+		return
+	}
+
+	saved := state.ContextState{
+		Namespace:       m.currentNamespace,
+		NamespaceScroll: m.namespaceViewportStart,
+		PodScroll:       m.podScrollOffset,
+	}
+	if pod, ok := m.selectedPod(); ok {
+		saved.Pod = pod.Name
+	}
+	m.state.SetContext(m.currentContext.Name, saved)
+}
+
+// saveState records the current position and writes the state file
+func (m AppModel) saveState() {
+	if m.state == nil || m.statePath == "" {
+		return
+	}
+
+	m.recordState()
+	if err := state.Save(m.state, m.statePath); err != nil {
+		slog.Warn("failed to save session state", "path", m.statePath, "error", err)
+	}
+}
@@ -0,0 +1,98 @@
+package tui
+
+import (
+	"path/filepath"
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/maratkarimov/kubertino/internal/config"
+	"github.com/maratkarimov/kubertino/internal/k8s"
+	"github.com/maratkarimov/kubertino/internal/state"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSetState_PreselectsLastContext(t *testing.T) {
+	cfg := &config.Config{
+		Version:  "1.0",
+		Contexts: []config.Context{{Name: "dev"}, {Name: "prod"}},
+	}
+	model := NewAppModel(cfg, newMockAdapter())
+
+	st := state.New()
+	st.LastContext = "prod"
+	model.SetState(st, filepath.Join(t.TempDir(), "state.json"))
+	assert.Equal(t, 1, model.selectedContextIndex)
+
+	// Unknown contexts leave the cursor alone
+	model = NewAppModel(cfg, newMockAdapter())
+	model.SetState(&state.State{LastContext: "gone"}, "")
+	assert.Equal(t, 0, model.selectedContextIndex)
+}
+
+func TestSessionState_RestoreAndSave(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "state.json")
+
+	st := state.New()
+	st.SetContext("test-context", state.ContextState{Namespace: "production", Pod: "test-pod-2"})
+	model := newTestModel(newMockAdapter())
+	model.SetState(st, path)
+
+	// Namespaces arrive: the saved namespace is reopened and its pods fetched
+	updated, cmd := model.Update(namespaceFetchedMsg{value: k8s.NamespacesNamed([]string{"default", "production", "staging"})})
+	model = updated.(AppModel)
+	require.NotNil(t, cmd)
+	assert.Equal(t, "production", model.currentNamespace)
+	assert.Equal(t, 1, model.selectedNamespaceIndex)
+	assert.Equal(t, PanelPods, model.focusedPanel)
+
+	// Pods arrive: the saved pod is reselected
+	updated, _ = model.Update(podsFetchedMsg{value: []k8s.Pod{{Name: "test-pod-1"}, {Name: "test-pod-2"}}})
+	model = updated.(AppModel)
+	assert.Equal(t, 1, model.selectedPodIndex)
+	assert.Empty(t, model.restorePod)
+
+	// Moving the cursor and closing writes the new position
+	model = sendKey(model, tea.KeyMsg{Type: tea.KeyUp})
+	model.Close()
+
+	saved, err := state.Load(path)
+	require.NoError(t, err)
+	assert.Equal(t, "test-context", saved.LastContext)
+	assert.Equal(t, state.ContextState{Namespace: "production", Pod: "test-pod-1"}, saved.Context("test-context"))
+}
+
+func TestSessionState_MissingNamespaceIsIgnored(t *testing.T) {
+	st := state.New()
+	st.SetContext("test-context", state.ContextState{Namespace: "deleted"})
+	model := newTestModel(newMockAdapter())
+	model.SetState(st, "")
+
+	updated, cmd := model.Update(namespaceFetchedMsg{value: k8s.NamespacesNamed([]string{"default"})})
+	model = updated.(AppModel)
+	assert.Nil(t, cmd)
+	assert.Empty(t, model.currentNamespace)
+	assert.Equal(t, PanelNamespaces, model.focusedPanel)
+}
+
+func TestSessionState_PanelSplitPersists(t *testing.T) {
+	cfg := &config.Config{
+		Version:  "1.0",
+		Layout:   &config.Layout{Preset: config.PresetWidePods},
+		Contexts: []config.Context{{Name: "dev"}},
+	}
+	st := state.New()
+	model := NewAppModel(cfg, newMockAdapter())
+	model.SetState(st, "")
+	model.viewMode = viewModeNamespaceView
+
+	model = sendKey(model, tea.KeyMsg{Type: tea.KeyCtrlRight})
+	require.NotNil(t, st.Layout)
+	assert.Equal(t, state.LayoutState{Preset: config.PresetWidePods, NamespaceWidth: 40}, *st.Layout)
+
+	// The recorded split wins over layout.preset on the next start
+	restored := NewAppModel(cfg, newMockAdapter())
+	restored.SetState(st, "")
+	restored.termWidth = 100
+	assert.Equal(t, 40, restored.splitLayout().namespaces.w)
+}
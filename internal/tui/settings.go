@@ -0,0 +1,272 @@
+package tui
+
+import (
+	"fmt"
+	"log/slog"
+	"strings"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/maratkarimov/kubertino/internal/config"
+	"github.com/maratkarimov/kubertino/internal/tui/styles"
+)
+
+// settingsItem is a single row of the key binding settings screen.
+// Navigation bindings have a binding name, action shortcuts an index into m.actions.
+type settingsItem struct {
+	label       string
+	binding     string
+	actionIndex int
+}
+
+// settingsItems returns the rebindable rows: navigation bindings first, then action shortcuts
+func (m AppModel) settingsItems() []settingsItem {
+	var items []settingsItem
+	for _, b := range m.keys.bindings() {
+		items = append(items, settingsItem{label: b.name, binding: b.name, actionIndex: -1})
+	}
+	for i, action := range m.actions {
+		items = append(items, settingsItem{label: action.Name, actionIndex: i})
+	}
+	return items
+}
+
+// openSettings switches to the key binding settings screen
+func (m *AppModel) openSettings() {
+	slog.Debug("settings screen opened")
+	m.settingsReturnMode = m.viewMode
+	m.viewMode = viewModeSettings
+	m.settingsIndex = 0
+	m.settingsCapturing = false
+	m.settingsMessage = ""
+}
+
+// closeSettings returns to the view the settings screen was opened from
+func (m *AppModel) closeSettings() {
+	slog.Debug("settings screen closed")
+	m.viewMode = m.settingsReturnMode
+	m.settingsCapturing = false
+	m.settingsMessage = ""
+}
+
+// handleSettingsKey processes key presses while the settings screen is shown
+func (m AppModel) handleSettingsKey(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	keyStr := msg.String()
+
+	// Ctrl+C always quits, even while capturing a key
+	if keyStr == "ctrl+c" {
+		return m, tea.Quit
+	}
+
+	items := m.settingsItems()
+
+	// Press-to-assign: the next key becomes the new binding
+	if m.settingsCapturing {
+		m.settingsCapturing = false
+		if keyStr == "esc" {
+			m.settingsMessage = "Rebinding cancelled"
+			return m, nil
+		}
+		if m.settingsIndex < len(items) {
+			m.assignKey(items[m.settingsIndex], keyStr)
+		}
+		return m, nil
+	}
+
+	switch {
+	case keyStr == "esc" || keyStr == "q":
+		m.closeSettings()
+	case KeyMatches(msg, m.keys.Up):
+		if m.settingsIndex > 0 {
+			m.settingsIndex--
+		}
+	case KeyMatches(msg, m.keys.Down):
+		if m.settingsIndex < len(items)-1 {
+			m.settingsIndex++
+		}
+	case KeyMatches(msg, m.keys.Enter):
+		m.settingsCapturing = true
+		m.settingsMessage = ""
+	}
+
+	return m, nil
+}
+
+// assignKey binds key to the given settings item after checking for conflicts,
+// then persists the change through the config writer
+func (m *AppModel) assignKey(item settingsItem, key string) {
+	if owner := m.keyOwner(key, item); owner != "" {
+		m.settingsMessage = fmt.Sprintf("Key '%s' is already bound to %s", key, owner)
+		return
+	}
+
+	// Only the changed entry is written, so comments in the config file are kept
+	var save func() error
+
+	if item.actionIndex >= 0 {
+		// Action shortcuts must stay single characters to pass config validation
+		if len(key) > 1 {
+			m.settingsMessage = fmt.Sprintf("Action shortcuts must be a single character, got '%s'", key)
+			return
+		}
+		if source, context := m.configActionFor(m.actions[item.actionIndex]); source != nil {
+			previous := *source
+			save = func() error { return config.SaveActionShortcut(m.configPath, context, previous, key) }
+			source.Shortcut = key
+		}
+		m.actions[item.actionIndex].Shortcut = key
+	} else {
+		save = func() error { return config.SaveKeymap(m.config, m.configPath) }
+		for _, b := range m.keys.bindings() {
+			if b.name != item.binding {
+				continue
+			}
+			*b.keys = []string{key}
+			if m.config != nil {
+				if m.config.Keymap == nil {
+					m.config.Keymap = &config.Keymap{}
+				}
+				*b.configKeys(m.config.Keymap) = []string{key}
+			}
+		}
+	}
+
+	slog.Info("key rebound", "binding", item.label, "key", key)
+	m.settingsMessage = fmt.Sprintf("%s bound to '%s'", item.label, key)
+
+	if m.configPath == "" {
+		m.settingsMessage += " (not saved: no config file)"
+		return
+	}
+	if save == nil {
+		return
+	}
+	if err := save(); err != nil {
+		slog.Error("failed to save config", "path", m.configPath, "error", err)
+		m.settingsMessage = fmt.Sprintf("Failed to save config: %s", err.Error())
+	}
+}
+
+// configActionFor returns the config entry backing the effective action run by the same keys
+// as action, with the name of the context defining it ("" for a global action). Per-context
+// actions take precedence over global ones, mirroring config.MergeActions.
+func (m AppModel) configActionFor(action config.Action) (*config.Action, string) {
+	if m.currentContext != nil {
+		for i := range m.currentContext.Actions {
+			if m.currentContext.Actions[i].SameKey(action) {
+				return &m.currentContext.Actions[i], m.currentContext.Name
+			}
+		}
+	}
+	if m.config != nil {
+		for i := range m.config.Actions {
+			if m.config.Actions[i].SameKey(action) {
+				return &m.config.Actions[i], ""
+			}
+		}
+	}
+	return nil, ""
+}
+
+// keyOwner returns the name of the binding or action that already uses key,
+// ignoring the item being rebound. Returns an empty string when key is free. An action in a
+// group only needs a key no other action of the group uses.
+func (m AppModel) keyOwner(key string, exclude settingsItem) string {
+	if exclude.actionIndex >= 0 {
+		if group, ok := m.actionGroupOf(m.actions[exclude.actionIndex]); ok {
+			for i, action := range m.actions {
+				if i != exclude.actionIndex && action.Group == group.Name && action.Shortcut == key {
+					return fmt.Sprintf("action '%s'", action.Name)
+				}
+			}
+			return ""
+		}
+	}
+	for _, b := range m.keys.bindings() {
+		if exclude.actionIndex < 0 && b.name == exclude.binding {
+			continue
+		}
+		for _, k := range *b.keys {
+			if k == key {
+				return b.name
+			}
+		}
+	}
+	for i, action := range m.actions {
+		if i == exclude.actionIndex {
+			continue
+		}
+		if _, grouped := m.actionGroupOf(action); !grouped && action.Shortcut == key {
+			return fmt.Sprintf("action '%s'", action.Name)
+		}
+	}
+	if m.config != nil {
+		for _, group := range m.config.ActionGroups {
+			if group.Shortcut == key {
+				return fmt.Sprintf("action group '%s'", group.Name)
+			}
+		}
+	}
+	return ""
+}
+
+// renderSettings renders the key binding settings screen as a centered dialog
+func (m AppModel) renderSettings() string {
+	var content string
+
+	content += styles.TitleStyle.Render("Key Bindings") + "\n\n"
+
+	items := m.settingsItems()
+	for i, item := range items {
+		var keys string
+		if item.actionIndex >= 0 {
+			keys = m.actionKeys(m.actions[item.actionIndex])
+		} else {
+			for _, b := range m.keys.bindings() {
+				if b.name == item.binding {
+					keys = strings.Join(*b.keys, ", ")
+				}
+			}
+		}
+
+		if i == m.settingsIndex && m.settingsCapturing {
+			keys = "press a key..."
+		}
+
+		line := fmt.Sprintf("%-24s %s", item.label, keys)
+		if i == m.settingsIndex {
+			content += m.selectionStyle(styles.SelectedStyle).Render(m.cursorMarker(true)+line) + "\n"
+		} else {
+			content += styles.NormalStyle.Render(m.cursorMarker(false)+line) + "\n"
+		}
+
+		// Separate navigation bindings from action shortcuts
+		if item.actionIndex < 0 && i+1 < len(items) && items[i+1].actionIndex >= 0 {
+			content += "\n" + styles.GroupHeaderStyle.Render("Actions") + "\n"
+		}
+	}
+
+	if m.settingsMessage != "" {
+		content += "\n" + styles.WarningStyle.Render(m.settingsMessage) + "\n"
+	}
+
+	content += "\n"
+	if m.settingsCapturing {
+		content += styles.DimStyle.Render("Press the new key | ESC: Cancel")
+	} else {
+		content += styles.DimStyle.Render("↑/↓ Navigate | Enter: Rebind | ESC/q: Back")
+	}
+
+	dialogStyle := lipgloss.NewStyle().
+		Border(lipgloss.RoundedBorder()).
+		BorderForeground(lipgloss.Color("39")). // Bright cyan
+		Padding(1, 2)
+
+	return lipgloss.Place(
+		m.termWidth,
+		m.termHeight,
+		lipgloss.Center,
+		lipgloss.Center,
+		dialogStyle.Render(content),
+	)
+}
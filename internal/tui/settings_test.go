@@ -0,0 +1,190 @@
+package tui
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/maratkarimov/kubertino/internal/config"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newSettingsTestModel() AppModel {
+	return newTestModel(newMockAdapter(), withContexts(config.Context{
+		Name:    "test-context",
+		Actions: []config.Action{{Name: "Logs", Shortcut: "l", Command: "kubectl logs {{.pod}}"}},
+	}))
+}
+
+// settingsConfigFile is the config file behind newSettingsTestModel, with a comment that
+// rebinding must keep
+const settingsConfigFile = `# Team config
+version: "1.0"
+contexts:
+  - name: test-context
+    actions:
+      - name: Logs
+        shortcut: l # follow the logs
+        command: kubectl logs {{.pod}}
+`
+
+// writeSettingsConfig writes settingsConfigFile to a temporary file and sets it as the config
+// path of model
+func writeSettingsConfig(t *testing.T, model *AppModel) {
+	path := filepath.Join(t.TempDir(), "kubertino.yml")
+	require.NoError(t, os.WriteFile(path, []byte(settingsConfigFile), 0644))
+	model.SetConfigPath(path)
+}
+
+// assertConfigComments checks that the comments of settingsConfigFile are still in the file
+func assertConfigComments(t *testing.T, path string) {
+	data, err := os.ReadFile(path)
+	require.NoError(t, err)
+	assert.Contains(t, string(data), "# Team config")
+	assert.Contains(t, string(data), "# follow the logs")
+}
+
+func TestSettings_OpenAndClose(t *testing.T) {
+	model := newSettingsTestModel()
+
+	model = sendKey(model, tea.KeyMsg{Type: tea.KeyCtrlS})
+	assert.Equal(t, viewModeSettings, model.viewMode, "ctrl+s should open settings")
+	assert.Contains(t, model.View(), "Key Bindings")
+
+	model = sendKey(model, tea.KeyMsg{Type: tea.KeyEsc})
+	assert.Equal(t, viewModeNamespaceView, model.viewMode, "ESC should return to previous view")
+}
+
+func TestSettings_RebindNavigationKey(t *testing.T) {
+	model := newSettingsTestModel()
+	writeSettingsConfig(t, &model)
+	model.openSettings()
+
+	// Move to "Up" (second row) and rebind it to "w"
+	model = sendKey(model, tea.KeyMsg{Type: tea.KeyDown})
+	model = sendKey(model, tea.KeyMsg{Type: tea.KeyEnter})
+	require.True(t, model.settingsCapturing, "Enter should start key capture")
+	model = sendKey(model, runeKey('w'))
+
+	assert.False(t, model.settingsCapturing)
+	assert.Equal(t, []string{"w"}, model.keys.Up)
+	require.NotNil(t, model.config.Keymap)
+	assert.Equal(t, []string{"w"}, model.config.Keymap.Up)
+
+	// Change must be persisted to the config file
+	saved, err := config.Parse(model.configPath)
+	require.NoError(t, err)
+	require.NotNil(t, saved.Keymap)
+	assert.Equal(t, []string{"w"}, saved.Keymap.Up)
+	assertConfigComments(t, model.configPath)
+}
+
+// firstActionRow returns the settings row of the first action (after all navigation bindings)
+func firstActionRow(model AppModel) int {
+	return len(model.keys.bindings())
+}
+
+func TestSettings_ConflictDetection(t *testing.T) {
+	actionRow := firstActionRow(newSettingsTestModel())
+	tests := []struct {
+		name      string
+		rowIndex  int
+		key       tea.KeyMsg
+		wantOwner string
+	}{
+		{"navigation key conflicts with another binding", 1, runeKey('j'), "Down"},
+		{"navigation key conflicts with action shortcut", 1, runeKey('l'), "action 'Logs'"},
+		{"action shortcut conflicts with navigation key", actionRow, runeKey('q'), "Quit"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			model := newSettingsTestModel()
+			model.openSettings()
+			model.settingsIndex = tt.rowIndex
+
+			before := model.keys
+			model = sendKey(model, tea.KeyMsg{Type: tea.KeyEnter})
+			model = sendKey(model, tt.key)
+
+			assert.Contains(t, model.settingsMessage, tt.wantOwner)
+			assert.Equal(t, before.Up, model.keys.Up, "conflicting key must not be assigned")
+			assert.Equal(t, "l", model.actions[0].Shortcut)
+		})
+	}
+}
+
+func TestSettings_RebindActionShortcut(t *testing.T) {
+	model := newSettingsTestModel()
+	writeSettingsConfig(t, &model)
+	model.openSettings()
+	model.settingsIndex = firstActionRow(model)
+
+	model = sendKey(model, tea.KeyMsg{Type: tea.KeyEnter})
+	model = sendKey(model, runeKey('x'))
+	assert.Equal(t, "x", model.actions[0].Shortcut)
+	assert.Equal(t, "x", model.config.Contexts[0].Actions[0].Shortcut, "config should reflect new shortcut")
+
+	saved, err := config.Parse(model.configPath)
+	require.NoError(t, err)
+	assert.Equal(t, "x", saved.Contexts[0].Actions[0].Shortcut)
+	assertConfigComments(t, model.configPath)
+
+	// Multi-character keys are rejected for actions
+	model = sendKey(model, tea.KeyMsg{Type: tea.KeyEnter})
+	model = sendKey(model, tea.KeyMsg{Type: tea.KeyCtrlY})
+	assert.Equal(t, "x", model.actions[0].Shortcut)
+	assert.Contains(t, model.settingsMessage, "single character")
+}
+
+func TestSettings_CancelCapture(t *testing.T) {
+	model := newSettingsTestModel()
+	model.openSettings()
+
+	model = sendKey(model, tea.KeyMsg{Type: tea.KeyEnter})
+	model = sendKey(model, tea.KeyMsg{Type: tea.KeyEsc})
+
+	assert.False(t, model.settingsCapturing)
+	assert.Equal(t, viewModeSettings, model.viewMode, "ESC during capture should stay in settings")
+	assert.Equal(t, DefaultKeyMap().Quit, model.keys.Quit)
+}
+
+func TestKeyMapFromConfig(t *testing.T) {
+	keys := KeyMapFromConfig(&config.Keymap{Down: []string{"s"}})
+	assert.Equal(t, []string{"s"}, keys.Down)
+	assert.Equal(t, DefaultKeyMap().Up, keys.Up, "unset bindings keep defaults")
+
+	assert.Equal(t, DefaultKeyMap(), KeyMapFromConfig(nil))
+}
+
+func TestShadowedKeys(t *testing.T) {
+	cfg := &config.Config{
+		Keymap:       &config.Keymap{Network: []string{"I"}},
+		ActionGroups: []config.ActionGroup{{Name: "db", Shortcut: "D"}},
+		Actions: []config.Action{
+			{Name: "Inspect", Shortcut: "i"},
+			{Name: "Psql", Shortcut: "d", Group: "db"},
+		},
+		Contexts: []config.Context{{Name: "prod", Actions: []config.Action{{Name: "Grafana", Shortcut: "g"}}}},
+	}
+
+	assert.Equal(t, []string{
+		`action group "db" on D hides the Delete Namespace key`,
+		`action "Grafana" of context prod on g hides the GitOps key`,
+	}, ShadowedKeys(cfg), "rebound keys and grouped actions do not clash")
+}
+
+func TestDefaultKeyMap_FreeForDefaultActions(t *testing.T) {
+	// Action shortcuts are handled before built-in keys, so a clash would hide the built-in key
+	keys := DefaultKeyMap().Keys()
+	// The templates of kubertino config init start with the default actions
+	for _, action := range config.ActionTemplates() {
+		assert.NotContains(t, keys, action.Shortcut, "built-in key shadowed by the %s action", action.Name)
+	}
+
+	example, err := config.Parse(filepath.Join("..", "..", "examples", "kubertino.yml.example"))
+	require.NoError(t, err)
+	assert.Empty(t, ShadowedKeys(example), "the example config must leave every built-in key free")
+}
@@ -0,0 +1,48 @@
+package tui
+
+import (
+	"fmt"
+	"log/slog"
+	"strings"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/maratkarimov/kubertino/internal/config"
+	"github.com/maratkarimov/kubertino/internal/tui/components"
+)
+
+// defaultLoadingMessages are the spinner texts of the fetches unless appearance.loading_messages
+// sets them
+var defaultLoadingMessages = map[string]string{
+	config.LoadingNamespaces: "Loading namespaces...",
+	config.LoadingPods:       "Loading pods...",
+}
+
+// loadingMessage returns the spinner text of the fetch of operation. Resource lists name
+// their kind by default, e.g. "Loading deployments..."
+func (m AppModel) loadingMessage(operation string) string {
+	def := defaultLoadingMessages[operation]
+	if operation == config.LoadingResources {
+		def = fmt.Sprintf("Loading %s...", strings.ToLower(m.resourceKind.Title()))
+	}
+	return m.config.LoadingMessage(operation, def)
+}
+
+// configureSpinners gives the spinners the frames and interval of appearance.spinner
+func (m *AppModel) configureSpinners() {
+	interval, err := m.config.SpinnerIntervalDuration()
+	if err != nil {
+		slog.Warn("invalid spinner_interval, using default", "error", err)
+		interval = config.DefaultSpinnerInterval
+	}
+	frames := m.config.SpinnerFrames()
+	for _, spinner := range []*components.Spinner{m.namespacesSpinner, m.podsSpinner, m.actionSpinner} {
+		spinner.Frames = frames
+		spinner.Interval = interval
+	}
+}
+
+// spinnerTickCmd schedules the next frame of the spinners, which share one tick at the
+// interval they are all configured with
+func (m AppModel) spinnerTickCmd() tea.Cmd {
+	return components.TickEvery(m.namespacesSpinner.Interval)
+}
@@ -0,0 +1,42 @@
+package tui
+
+import (
+	"testing"
+	"time"
+
+	"github.com/maratkarimov/kubertino/internal/config"
+	"github.com/maratkarimov/kubertino/internal/k8s"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSpinners_Configured(t *testing.T) {
+	model := newTestModel(newMockAdapter(), func(cfg *config.Config) {
+		cfg.Appearance = &config.Appearance{
+			Spinner:         config.SpinnerLine,
+			SpinnerInterval: "250ms",
+			LoadingMessages: map[string]string{config.LoadingPods: "Asking the API server...", config.LoadingResources: "Listing..."},
+		}
+	})
+	model.termHeight = 30
+
+	assert.Equal(t, []string{"|", "/", "-", "\\"}, model.podsSpinner.Frames)
+	assert.Equal(t, 250*time.Millisecond, model.namespacesSpinner.Interval)
+
+	model.currentNamespace = "default"
+	model.podsLoading = true
+	model.podsSpinner.Start(model.loadingMessage(config.LoadingPods))
+	assert.Contains(t, model.View(), "| Asking the API server...")
+
+	// Long fetches show for how long they have been running
+	model.podsSpinner.Started = time.Now().Add(-7 * time.Second)
+	assert.Contains(t, model.View(), "| Asking the API server... 7s")
+
+	assert.Equal(t, "Loading namespaces...", model.loadingMessage(config.LoadingNamespaces), "unset messages keep their default")
+	assert.Equal(t, "Listing...", model.loadingMessage(config.LoadingResources))
+}
+
+func TestSpinners_ResourceMessageNamesTheKind(t *testing.T) {
+	model := newTestModel(newMockAdapter())
+	model.resourceKind = k8s.KindDeployment
+	assert.Equal(t, "Loading deployments...", model.loadingMessage(config.LoadingResources))
+}
@@ -0,0 +1,74 @@
+package tui
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/maratkarimov/kubertino/internal/k8s"
+	"github.com/maratkarimov/kubertino/internal/tui/styles"
+)
+
+// startupCheckedMsg is sent when the deferred startup checks have finished
+type startupCheckedMsg = resultMsg[struct{}]
+
+// SetStartupCheck defers environment checks such as the kubectl lookup and kubeconfig
+// validation to a background command started by Init, so the TUI appears immediately.
+// A failing check is shown as a fatal error and returned by Err.
+func (m *AppModel) SetStartupCheck(check func() error) {
+	m.startupCheck = check
+	m.startupChecking = check != nil
+}
+
+// Err returns the fatal error the TUI is showing, or why kubectl is missing, if any
+func (m AppModel) Err() error {
+	if m.err == nil {
+		return m.kubectlMissing
+	}
+	return m.err
+}
+
+// startupCheckCmd runs the startup check in the background. Returns nil without a check.
+func (m AppModel) startupCheckCmd() tea.Cmd {
+	check := m.startupCheck
+	if check == nil {
+		return nil
+	}
+	return fetchCmd(m.requests, asyncStartup, "", func(context.Context) (struct{}, error) {
+		return struct{}{}, check()
+	})
+}
+
+// handleStartupChecked records the outcome of the startup check
+func (m AppModel) handleStartupChecked(msg startupCheckedMsg) (tea.Model, tea.Cmd) {
+	if !m.requests.current(msg.asyncRequest) {
+		return m, nil
+	}
+
+	m.startupChecking = false
+	if errors.Is(msg.err, k8s.ErrKubectlNotFound) {
+		// Guide through installing kubectl instead of failing every call
+		slog.Warn("kubectl not found", "error", msg.err)
+		m.kubectlMissing = msg.err
+		return m, nil
+	}
+	if msg.err != nil {
+		slog.Error("startup check failed", "error", msg.err)
+		m.err = msg.err
+		return m, nil
+	}
+	slog.Debug("startup check passed")
+	if m.kubectlMissing != nil {
+		return m.kubectlFound()
+	}
+	return m, nil
+}
+
+// startupProgress renders the indicator shown while the startup check runs
+func (m AppModel) startupProgress() string {
+	if !m.startupChecking {
+		return ""
+	}
+	return styles.LoadingStyle.Render("Checking kubectl and kubeconfig...")
+}
@@ -0,0 +1,64 @@
+package tui
+
+import (
+	"errors"
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/maratkarimov/kubertino/internal/config"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newStartupTestModel(check func() error) AppModel {
+	model := newTestModel(newMockAdapter(), withContexts(config.Context{Name: "dev"}, config.Context{Name: "prod"}))
+	model.SetStartupCheck(check)
+	return model
+}
+
+func TestStartupCheck_RunsInBackground(t *testing.T) {
+	called := false
+	model := newStartupTestModel(func() error {
+		called = true
+		return nil
+	})
+
+	cmd := model.Init()
+	require.NotNil(t, cmd)
+	assert.False(t, called, "the check does not run before the TUI starts")
+	assert.Contains(t, model.View(), "Checking kubectl and kubeconfig")
+
+	updated, _ := model.Update(model.startupCheckCmd()())
+	model = updated.(AppModel)
+	assert.True(t, called)
+	assert.NotContains(t, model.View(), "Checking kubectl")
+	assert.NoError(t, model.Err())
+}
+
+func TestStartupCheck_FailureIsFatal(t *testing.T) {
+	errNoContext := errors.New("none of the configured contexts exist in kubeconfig")
+	model := newStartupTestModel(func() error { return errNoContext })
+
+	// The TUI is usable while the check runs
+	model = sendKey(model, tea.KeyMsg{Type: tea.KeyDown})
+	assert.Equal(t, 1, model.selectedContextIndex)
+
+	updated, _ := model.Update(model.startupCheckCmd()())
+	model = updated.(AppModel)
+	assert.True(t, errors.Is(model.Err(), errNoContext))
+	assert.Contains(t, model.View(), "none of the configured contexts")
+
+	// Only quitting is possible afterwards
+	model = sendKey(model, tea.KeyMsg{Type: tea.KeyUp})
+	assert.Equal(t, 1, model.selectedContextIndex)
+	_, cmd := model.Update(runeKey('q'))
+	require.NotNil(t, cmd)
+	assert.Equal(t, tea.Quit(), cmd())
+}
+
+func TestStartupCheck_None(t *testing.T) {
+	model := newStartupTestModel(nil)
+
+	assert.Nil(t, model.startupCheckCmd())
+	assert.NotContains(t, model.View(), "Checking kubectl")
+}
@@ -0,0 +1,148 @@
+package tui
+
+import (
+	"fmt"
+	"log/slog"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/maratkarimov/kubertino/internal/executor"
+	"github.com/maratkarimov/kubertino/internal/tui/components"
+)
+
+// actionStderr is the stderr kept of an action that failed in the terminal
+type actionStderr struct {
+	title     string
+	text      string
+	truncated bool // Its start was dropped
+}
+
+// handleExecFinished reports how an action that had the terminal exited in a toast with its
+// exit code and duration. When it failed with output on stderr, the toast offers the stderr
+// key. A session that was cut off offers to reconnect, and a command that could not run at
+// all is explained in the error modal.
+func (m AppModel) handleExecFinished(msg execFinishedMsg) (tea.Model, tea.Cmd) {
+	m.actionSpinner.Stop()
+	m.running.finish()
+	m.lastCommand = msg.record.Command
+	result := msg.run.Result(msg.err)
+	record := msg.record.Finish(msg.err)
+	if msg.run != nil {
+		record.DurationMS = result.Duration.Milliseconds()
+	}
+	m.recordAction(record)
+
+	m.lastStderr = nil
+	if result.Cause != executor.ExitOK && result.Cause != executor.ExitNotRun && result.Stderr != "" {
+		m.lastStderr = &actionStderr{
+			title:     fmt.Sprintf("stderr of %s: %s (exit %d)", record.Action, record.Target, record.ExitCode),
+			text:      result.Stderr,
+			truncated: result.StderrTruncated,
+		}
+	}
+
+	// A captured variable is set before a follow-up, which may use it
+	captured := ""
+	if msg.capture != nil && result.Cause == executor.ExitOK {
+		captured = m.setCapturedVar(msg.capture)
+	}
+
+	if cmd, ok := m.startFollowUp(record, msg.err != nil); ok {
+		return m, cmd
+	}
+
+	duration := float64(record.DurationMS) / 1000
+	switch result.Cause {
+	case executor.ExitOK:
+		return m, m.notify(fmt.Sprintf("%s exited 0 in %.1fs%s", record.Action, duration, captured), components.ToastSuccess)
+	case executor.ExitNotRun:
+		m.errorModal.Show(
+			fmt.Sprintf("Command failed: %s", msg.err.Error()),
+			"Action Execution",
+			nil,
+		)
+		return m, nil
+	case executor.ExitDropped:
+		if msg.rerun != nil {
+			return m.offerReconnect(msg, result)
+		}
+	}
+
+	outcome := fmt.Sprintf("%s exited %d in %.1fs", record.Action, record.ExitCode, duration)
+	if result.Cause == executor.ExitSignaled {
+		outcome = fmt.Sprintf("%s ended by %s after %.1fs", record.Action, result.Reason, duration)
+	}
+	if m.lastStderr != nil {
+		outcome += fmt.Sprintf(" (%s: stderr)", firstKey(m.keys.LastStderr))
+	}
+	return m, m.notify(outcome, components.ToastError)
+}
+
+// setCapturedVar sets the session variable of capture to the stdout it kept, and returns a
+// note on it for the outcome toast
+func (m AppModel) setCapturedVar(capture *executor.Capture) string {
+	value, truncated := capture.Value()
+	m.executor.Vars().Set(capture.Var, value)
+	slog.Info("session variable captured", "var", capture.Var, "bytes", len(value), "truncated", truncated)
+	if truncated {
+		return fmt.Sprintf(", %s set to the first 64 KiB of its output", capture.Var)
+	}
+	return fmt.Sprintf(", %s set", capture.Var)
+}
+
+// offerReconnect asks whether to run the command of a session that was cut off again
+func (m AppModel) offerReconnect(msg execFinishedMsg, result executor.Result) (tea.Model, tea.Cmd) {
+	slog.Warn("action session dropped", "action", msg.record.Action, "exit_code", result.ExitCode, "reason", result.Reason)
+	m.confirm.Show(
+		fmt.Sprintf("Session ended unexpectedly (exit %d). Reconnect?", result.ExitCode),
+		fmt.Sprintf("%s on %s: %s.", msg.record.Action, msg.record.Target, result.Reason),
+		"y",
+		func() tea.Cmd {
+			slog.Info("reconnecting action session", "action", msg.record.Action, "target", msg.record.Target)
+			record := msg.record
+			record.Time = time.Now()
+			captureVar := ""
+			if msg.capture != nil {
+				captureVar = msg.capture.Var
+			}
+			return m.execInTerminal(msg.record.Action, msg.rerun, record, captureVar)
+		},
+	)
+	return m, nil
+}
+
+// openStderr shows the stderr of the last action that failed in the terminal in a pager
+func (m AppModel) openStderr() (tea.Model, tea.Cmd) {
+	if m.lastStderr == nil {
+		return m, m.notify("No failed action output to show", components.ToastWarning)
+	}
+
+	slog.Debug("action stderr opened", "title", m.lastStderr.title)
+	text := m.lastStderr.text
+	if m.lastStderr.truncated {
+		text = "… (earlier output dropped)\n" + text
+	}
+	m.viewMode = viewModeStderr
+	m.pager.SetSize(m.termWidth, m.termHeight)
+	m.pager.ShowLoading(m.lastStderr.title)
+	m.pager.SetContent(text)
+	return m, nil
+}
+
+// handleStderrKey handles key presses while the stderr pager is open
+func (m AppModel) handleStderrKey(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	if msg.Type == tea.KeyCtrlC {
+		return m, tea.Quit
+	}
+
+	if KeyMatches(msg, m.keys.LastStderr) {
+		m.pager.Hide()
+	} else {
+		m.pager.HandleKeyPress(msg)
+	}
+
+	if !m.pager.IsVisible {
+		m.viewMode = viewModeNamespaceView
+	}
+	return m, nil
+}
@@ -0,0 +1,98 @@
+package tui
+
+import (
+	"bytes"
+	"os/exec"
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/maratkarimov/kubertino/internal/audit"
+	"github.com/maratkarimov/kubertino/internal/executor"
+	"github.com/maratkarimov/kubertino/internal/tui/components"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// runInTerminal runs script the way an action runs in the terminal and returns its message
+func runInTerminal(t *testing.T, script string) execFinishedMsg {
+	t.Helper()
+	cmd := exec.Command("sh", "-c", script)
+	cmd.Stderr = &bytes.Buffer{}
+	run := executor.TrackRun(cmd)
+	err := cmd.Run()
+	run.Finish()
+	record := audit.Record{Action: "Logs", Target: "web-1", Time: run.Started}
+	return execFinishedMsg{err: err, record: record, run: run}
+}
+
+func TestStderr_FailedActionOffersStderr(t *testing.T) {
+	model := newAppliesToTestModel()
+	model.viewMode = viewModeNamespaceView
+
+	updated, _ := model.Update(runInTerminal(t, "echo 'Error from server (NotFound): pods \"web-1\" not found' >&2; exit 1"))
+	model = updated.(AppModel)
+	assert.False(t, model.errorModal.IsVisible, "a non-zero exit does not block")
+	toast, ok := model.statusBar.Current()
+	require.True(t, ok)
+	assert.Equal(t, components.ToastError, toast.Level)
+	assert.Regexp(t, `^Logs exited 1 in \d+\.\ds \(ctrl\+e: stderr\)$`, toast.Text)
+
+	model = sendKey(model, tea.KeyMsg{Type: tea.KeyCtrlE})
+	require.Equal(t, viewModeStderr, model.viewMode)
+	view := model.View()
+	assert.Contains(t, view, "stderr of Logs: web-1 (exit 1)")
+	assert.Contains(t, view, `pods "web-1" not found`)
+
+	model = sendKey(model, tea.KeyMsg{Type: tea.KeyCtrlE})
+	assert.Equal(t, viewModeNamespaceView, model.viewMode)
+}
+
+func TestStderr_NothingToShow(t *testing.T) {
+	model := newAppliesToTestModel()
+	model.viewMode = viewModeNamespaceView
+
+	// Failed without stderr: no offer
+	updated, _ := model.Update(runInTerminal(t, "exit 4"))
+	model = updated.(AppModel)
+	toast, _ := model.statusBar.Current()
+	assert.Regexp(t, `^Logs exited 4 in \d+\.\ds$`, toast.Text)
+
+	model = sendKey(model, tea.KeyMsg{Type: tea.KeyCtrlE})
+	assert.Equal(t, viewModeNamespaceView, model.viewMode)
+
+	// A later success forgets the stderr of an earlier failure
+	updated, _ = model.Update(runInTerminal(t, "echo oops >&2; exit 1"))
+	model = updated.(AppModel)
+	require.NotNil(t, model.lastStderr)
+	updated, _ = model.Update(runInTerminal(t, "echo warning >&2"))
+	model = updated.(AppModel)
+	assert.Nil(t, model.lastStderr)
+}
+
+func TestStderr_DroppedSessionOffersReconnect(t *testing.T) {
+	model := newAppliesToTestModel()
+	model.viewMode = viewModeNamespaceView
+
+	msg := runInTerminal(t, "echo 'error: lost connection to pod' >&2; exit 137")
+	msg.rerun = exec.Command("sh", "-c", "exit 0")
+	updated, _ := model.Update(msg)
+	model = updated.(AppModel)
+	require.True(t, model.confirm.IsVisible)
+	assert.Equal(t, "Session ended unexpectedly (exit 137). Reconnect?", model.confirm.Title)
+	assert.Contains(t, model.confirm.Message, "Logs on web-1: the connection was lost")
+
+	// Confirming runs the same command again
+	model = sendKey(model, runeKey('y'))
+	_, cmd := model.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	require.NotNil(t, cmd)
+	assert.Equal(t, "Logs", model.running.name)
+	assert.Equal(t, msg.rerun, model.running.cmd)
+
+	// A failure of the command itself is only reported
+	model = newAppliesToTestModel()
+	msg = runInTerminal(t, "echo 'Error from server (NotFound)' >&2; exit 1")
+	msg.rerun = exec.Command("sh", "-c", "exit 0")
+	updated, _ = model.Update(msg)
+	model = updated.(AppModel)
+	assert.False(t, model.confirm.IsVisible)
+}
@@ -148,6 +148,27 @@ var (
 				Foreground(lipgloss.Color("240")). // Gray
 				Italic(true)
 
+	// ProdEnvStyle is used for the environment badge of prod contexts
+	// White on red - hard to miss before running anything
+	ProdEnvStyle = lipgloss.NewStyle().
+			Foreground(lipgloss.Color("15")).  // White
+			Background(lipgloss.Color("160")). // Red
+			Bold(true)
+
+	// StagingEnvStyle is used for the environment badge of staging contexts
+	// Black on orange
+	StagingEnvStyle = lipgloss.NewStyle().
+			Foreground(lipgloss.Color("0")).   // Black
+			Background(lipgloss.Color("214")). // Orange
+			Bold(true)
+
+	// DevEnvStyle is used for the environment badge of dev contexts
+	// Black on green
+	DevEnvStyle = lipgloss.NewStyle().
+			Foreground(lipgloss.Color("0")).  // Black
+			Background(lipgloss.Color("35")). // Green
+			Bold(true)
+
 	// ShortcutStyle is used for shortcut key highlighting
 	// Orange/yellow color with bold
 	ShortcutStyle = lipgloss.NewStyle().
@@ -160,6 +181,12 @@ var (
 				Foreground(lipgloss.Color("226")). // Yellow/gold
 				Bold(true)
 
+	// TerminatingNamespaceStyle is used for namespaces being deleted
+	// Dim red - still listed, but nothing should be started there
+	TerminatingNamespaceStyle = lipgloss.NewStyle().
+					Foreground(lipgloss.Color("9")). // Bright red
+					Faint(true)
+
 	// SearchBoxStyle is used for the search input box (Story 7.2)
 	// Yellow border with rounded corners and padding
 	SearchBoxStyle = lipgloss.NewStyle().
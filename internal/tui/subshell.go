@@ -0,0 +1,60 @@
+package tui
+
+import (
+	"errors"
+	"fmt"
+	"log/slog"
+	"os/exec"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/maratkarimov/kubertino/internal/tui/components"
+)
+
+// shellExitedMsg is sent when the shell opened with the shell key has exited and the TUI is back
+type shellExitedMsg struct {
+	err error
+}
+
+// shellTarget returns the namespace and pod a shell is opened for: the open namespace, or the
+// highlighted one before a namespace is opened, and the selected pod, if any
+func (m AppModel) shellTarget() (namespace, pod string) {
+	namespace = m.currentNamespace
+	if namespace == "" {
+		if ns, ok := m.highlightedNamespace(); ok {
+			namespace = ns.Name
+		}
+	}
+	if namespace == m.currentNamespace && !m.browsingResources() {
+		if selected, ok := m.selectedPod(); ok {
+			pod = selected.Name
+		}
+	}
+	return namespace, pod
+}
+
+// openShell suspends the TUI for a shell with the current selection exported, for ad-hoc
+// kubectl commands. The model is left as it is, so exiting the shell returns to it.
+func (m AppModel) openShell() (tea.Model, tea.Cmd) {
+	if m.currentContext == nil {
+		return m, nil
+	}
+	namespace, pod := m.shellTarget()
+	cmd := m.executor.PrepareShell(*m.currentContext, namespace, pod, m.config.KubeconfigPath(m.currentContext.Name))
+
+	slog.Info("opening shell", "context", m.currentContext.Name, "namespace", namespace, "pod", pod)
+	return m, tea.ExecProcess(cmd, func(err error) tea.Msg {
+		return shellExitedMsg{err: err}
+	})
+}
+
+// handleShellExited reports a shell that could not start. The exit code of a shell is that of
+// its last command, so a non-zero one is not a failure.
+func (m AppModel) handleShellExited(msg shellExitedMsg) (tea.Model, tea.Cmd) {
+	// The terminal may have been resized while the shell had it
+	var exitErr *exec.ExitError
+	if msg.err == nil || errors.As(msg.err, &exitErr) {
+		return m, windowSizeCmd
+	}
+	slog.Error("shell failed", "error", msg.err)
+	return m, tea.Batch(m.notify(fmt.Sprintf("Shell failed: %v", msg.err), components.ToastError), windowSizeCmd)
+}
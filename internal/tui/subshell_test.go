@@ -0,0 +1,55 @@
+package tui
+
+import (
+	"errors"
+	"os/exec"
+	"testing"
+
+	"github.com/maratkarimov/kubertino/internal/config"
+	"github.com/maratkarimov/kubertino/internal/k8s"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestShell(t *testing.T) {
+	model := newNodeShellTestModel(&nodeShellAdapter{}, withContexts(config.Context{Name: "dev"}))
+	model.selectedPodIndex = 1
+
+	namespace, pod := model.shellTarget()
+	assert.Equal(t, "default", namespace)
+	assert.Equal(t, "web-2", pod)
+
+	updated, cmd := model.Update(runeKey('!'))
+	require.NotNil(t, cmd, "the shell gets the terminal")
+
+	// Exiting the shell returns to the same selection
+	updated, _ = updated.(AppModel).Update(shellExitedMsg{})
+	model = updated.(AppModel)
+	assert.Equal(t, "default", model.currentNamespace)
+	assert.Equal(t, 1, model.selectedPodIndex)
+	assert.Equal(t, PanelPods, model.focusedPanel)
+}
+
+func TestShellTarget_BeforeANamespaceIsOpen(t *testing.T) {
+	model := newNodeShellTestModel(&nodeShellAdapter{}, withContexts(config.Context{Name: "dev"}))
+	model.currentNamespace = ""
+	model.pods = nil
+	model.namespaces = []k8s.Namespace{{Name: "kube-system"}}
+	model.focusedPanel = PanelNamespaces
+
+	namespace, pod := model.shellTarget()
+	assert.Equal(t, "kube-system", namespace, "the highlighted namespace")
+	assert.Empty(t, pod)
+}
+
+func TestShellExited(t *testing.T) {
+	model := newNodeShellTestModel(&nodeShellAdapter{}, withContexts(config.Context{Name: "dev"}))
+
+	exitErr := exec.Command("sh", "-c", "exit 1").Run()
+	require.Error(t, exitErr)
+	updated, _ := model.Update(shellExitedMsg{err: exitErr})
+	assert.NotContains(t, updated.(AppModel).View(), "Shell failed", "the exit code of the last command in the shell")
+
+	updated, _ = model.Update(shellExitedMsg{err: errors.New(`exec: "zsh": executable file not found in $PATH`)})
+	assert.Contains(t, updated.(AppModel).View(), "Shell failed: exec: \"zsh\"")
+}
@@ -0,0 +1,279 @@
+package tui
+
+import (
+	"fmt"
+	"log/slog"
+	"slices"
+	"strings"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/maratkarimov/kubertino/internal/config"
+	"github.com/maratkarimov/kubertino/internal/k8s"
+	"github.com/maratkarimov/kubertino/internal/tui/components"
+	"github.com/maratkarimov/kubertino/internal/tui/styles"
+)
+
+// session is the context, namespace and pod panel state of a workspace tab. The state of the
+// active tab lives in AppModel; the others are kept here until switched to.
+type session struct {
+	viewMode               string
+	contextName            string // "" while no context is open
+	selectedContextIndex   int
+	namespaces             []k8s.Namespace
+	favoriteNamespaces     []string
+	selectedNamespaceIndex int
+	namespaceViewportStart int
+	namespacesLoading      bool
+	namespacesFallback     bool
+	namespacesError        error
+	currentNamespace       string
+	pods                   []k8s.Pod
+	podsLoading            bool
+	podsError              error
+	podsContinue           string
+	podSelector            string
+	resourceKind           k8s.ResourceKind
+	resources              []k8s.Resource
+	selectedResourceIndex  int
+	focusedPanel           PanelType
+	selectedPodIndex       int
+	podScrollOffset        int
+	podSort                PodSortMode
+	podFilter              PodStatusFilter
+	actions                []config.Action
+	actionTag              string
+	restartStorms          map[string]*k8s.RestartStorm
+	podCounts              map[string]int
+}
+
+// maxTabs is the number of tabs the default switch keys (alt+1 to alt+9) reach
+const maxTabs = 9
+
+// captureSession returns the state of the active tab
+func (m AppModel) captureSession() session {
+	s := session{
+		viewMode:               m.viewMode,
+		selectedContextIndex:   m.selectedContextIndex,
+		namespaces:             m.namespaces,
+		favoriteNamespaces:     m.favoriteNamespaces,
+		selectedNamespaceIndex: m.selectedNamespaceIndex,
+		namespaceViewportStart: m.namespaceViewportStart,
+		namespacesLoading:      m.namespacesLoading,
+		namespacesFallback:     m.namespacesFallback,
+		namespacesError:        m.namespacesError,
+		currentNamespace:       m.currentNamespace,
+		pods:                   m.pods,
+		podsLoading:            m.podsLoading,
+		podsError:              m.podsError,
+		podsContinue:           m.podsContinue,
+		podSelector:            m.podSelector,
+		resourceKind:           m.resourceKind,
+		resources:              m.resources,
+		selectedResourceIndex:  m.selectedResourceIndex,
+		focusedPanel:           m.focusedPanel,
+		selectedPodIndex:       m.selectedPodIndex,
+		podScrollOffset:        m.podScrollOffset,
+		podSort:                m.podSort,
+		podFilter:              m.podFilter,
+		actions:                m.actions,
+		actionTag:              m.actionTag,
+		restartStorms:          m.restartStorms,
+		podCounts:              m.podCounts,
+	}
+	if m.currentContext != nil {
+		s.contextName = m.currentContext.Name
+	}
+	return s
+}
+
+// restoreSession makes s the state of the active tab. A tab whose context is no longer
+// configured goes back to the context list. Returns the fetches the tab needs: those that
+// were in flight when it was left, and a refresh of its pods.
+func (m *AppModel) restoreSession(s session) tea.Cmd {
+	m.viewMode = s.viewMode
+	m.selectedContextIndex = min(s.selectedContextIndex, max(len(m.contexts)-1, 0))
+	m.namespaces = s.namespaces
+	m.favoriteNamespaces = s.favoriteNamespaces
+	m.selectedNamespaceIndex = s.selectedNamespaceIndex
+	m.namespaceViewportStart = s.namespaceViewportStart
+	m.namespacesLoading = s.namespacesLoading
+	m.namespacesFallback = s.namespacesFallback
+	m.namespacesError = s.namespacesError
+	m.currentNamespace = s.currentNamespace
+	m.pods = s.pods
+	m.podsLoading = s.podsLoading
+	m.podsError = s.podsError
+	m.podsContinue = s.podsContinue
+	m.podsLoadingMore = false
+	m.podSelector = s.podSelector
+	m.resourceKind = s.resourceKind
+	m.resources = s.resources
+	m.resourcesLoading = false
+	m.resourcesError = nil
+	m.selectedResourceIndex = s.selectedResourceIndex
+	m.focusedPanel = s.focusedPanel
+	m.selectedPodIndex = s.selectedPodIndex
+	m.podScrollOffset = s.podScrollOffset
+	m.podSort = s.podSort
+	m.podFilter = s.podFilter
+	m.actions = s.actions
+	m.actionTag = s.actionTag
+	m.restartStorms = s.restartStorms
+	m.podCounts = s.podCounts
+	m.searchMode, m.searchQuery, m.filteredNamespaces = false, "", nil
+	m.podSearchMode, m.podSearchQuery, m.filteredPods, m.podMatchIndices = false, "", nil, nil
+	m.jumpNamespace = ""
+
+	m.currentContext = nil
+	if s.contextName == "" {
+		return nil
+	}
+	index := slices.IndexFunc(m.contexts, func(ctx config.Context) bool { return ctx.Name == s.contextName })
+	if index < 0 {
+		slog.Info("context of tab no longer configured", "context", s.contextName)
+		m.viewMode = viewModeContextSelection
+		return nil
+	}
+	m.currentContext = &m.contexts[index]
+	if err := m.kubeAdapter.SwitchContext(s.contextName); err != nil {
+		slog.Warn("failed to switch kubectl context for tab", "context", s.contextName, "error", err)
+	}
+
+	switch {
+	case s.namespacesLoading:
+		return m.loadNamespaces()
+	case s.currentNamespace != "":
+		return tea.Batch(m.loadPods(), m.startResourceFetch())
+	}
+	return nil
+}
+
+// handleNewTab opens a tab on the context list, keeping the state of the current tab. With a
+// single context the tab opens on it directly.
+func (m AppModel) handleNewTab() (tea.Model, tea.Cmd) {
+	if len(m.tabs) >= maxTabs {
+		return m, m.notify(fmt.Sprintf("At most %d tabs can be open", maxTabs), components.ToastWarning)
+	}
+	m.leaveTab()
+	m.tabs = append(m.tabs, session{viewMode: viewModeContextSelection, selectedContextIndex: m.selectedContextIndex, selectedPodIndex: -1})
+	m.activeTab = len(m.tabs) - 1
+	slog.Info("tab opened", "tab", m.activeTab+1)
+
+	m.restoreSession(m.tabs[m.activeTab])
+	if len(m.contexts) == 1 {
+		return m.selectContext(0)
+	}
+	return m, m.probeContextsCmd()
+}
+
+// handleSwitchTab activates tab index (0-based), if it is open
+func (m AppModel) handleSwitchTab(index int) (tea.Model, tea.Cmd) {
+	if index == m.activeTab || index >= len(m.tabs) {
+		return m, nil
+	}
+	m.leaveTab()
+	m.activeTab = index
+	slog.Debug("tab switched", "tab", index+1)
+	cmd := m.restoreSession(m.tabs[index])
+	m.saveState()
+	return m, cmd
+}
+
+// handleCloseTab closes the active tab and activates the one before it. The last tab cannot
+// be closed.
+func (m AppModel) handleCloseTab() (tea.Model, tea.Cmd) {
+	if len(m.tabs) < 2 {
+		return m, nil
+	}
+	m.cacheContextState()
+	m.requests.cancel(tabRequests...)
+	m.tabs = slices.Delete(slices.Clone(m.tabs), m.activeTab, m.activeTab+1)
+	m.activeTab = max(m.activeTab-1, 0)
+	slog.Info("tab closed", "tabs", len(m.tabs))
+	cmd := m.restoreSession(m.tabs[m.activeTab])
+	if len(m.tabs) == 1 {
+		m.tabs = nil
+		m.activeTab = 0
+	}
+	m.saveState()
+	return m, cmd
+}
+
+// tabRequests are the background requests that belong to the active tab; results still in
+// flight when it is left would land in the next tab
+var tabRequests = []asyncKind{asyncNamespaces, asyncPods, asyncPodsMore, asyncResources, asyncRestarts, asyncMetrics}
+
+// leaveTab stores the state of the active tab, starting the tab list with it when the first
+// tab is opened
+func (m *AppModel) leaveTab() {
+	m.recordState()
+	m.cacheContextState()
+	m.requests.cancel(tabRequests...)
+	if m.tabs == nil {
+		m.tabs = []session{{}}
+		m.activeTab = 0
+	}
+	m.tabs = slices.Clone(m.tabs)
+	m.tabs[m.activeTab] = m.captureSession()
+}
+
+// handleTabKey handles the tab keys. ok is false for other keys.
+func (m AppModel) handleTabKey(msg tea.KeyMsg) (model tea.Model, cmd tea.Cmd, ok bool) {
+	switch {
+	case KeyMatches(msg, m.keys.NewTab):
+		model, cmd = m.handleNewTab()
+		return model, cmd, true
+	case KeyMatches(msg, m.keys.CloseTab):
+		model, cmd = m.handleCloseTab()
+		return model, cmd, true
+	}
+	if index := slices.Index(m.keys.SwitchTab, msg.String()); index >= 0 {
+		model, cmd = m.handleSwitchTab(index)
+		return model, cmd, true
+	}
+	return m, nil, false
+}
+
+// tabBarHeight returns the lines taken by the tab bar, shown while more than one tab is open
+func (m AppModel) tabBarHeight() int {
+	if len(m.tabs) > 1 {
+		return 1
+	}
+	return 0
+}
+
+// tabLabel names a tab by its context and namespace
+func (s session) tabLabel() string {
+	switch {
+	case s.contextName == "":
+		return "new"
+	case s.currentNamespace == "":
+		return s.contextName
+	}
+	return s.contextName + "/" + s.currentNamespace
+}
+
+// renderTabBar renders the open tabs with their switch keys, the active one highlighted
+func (m AppModel) renderTabBar() string {
+	var parts []string
+	for i, tab := range m.tabs {
+		if i == m.activeTab {
+			tab = m.captureSession()
+		}
+		label := tab.tabLabel()
+		if i < len(m.keys.SwitchTab) {
+			label = fmt.Sprintf("%d %s", i+1, label)
+		}
+		if i == m.activeTab {
+			parts = append(parts, styles.SelectedActionStyle.Render("▸"+label+" "))
+		} else {
+			parts = append(parts, styles.DimStyle.Render(" "+label+" "))
+		}
+	}
+	bar := strings.Join(parts, " ")
+	if m.termWidth > 0 {
+		bar = lipgloss.NewStyle().MaxWidth(m.termWidth).Render(bar)
+	}
+	return bar
+}
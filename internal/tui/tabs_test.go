@@ -0,0 +1,90 @@
+package tui
+
+import (
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/maratkarimov/kubertino/internal/k8s"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func altKey(r rune) tea.KeyMsg {
+	return tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{r}, Alt: true}
+}
+
+// openSecondTab opens a tab on "prod" next to the "dev" tab of newContextSwitchTestModel and
+// opens its "monitoring" namespace
+func openSecondTab(t *testing.T) AppModel {
+	t.Helper()
+	model := newContextSwitchTestModel(t)
+
+	model = sendKey(model, tea.KeyMsg{Type: tea.KeyCtrlT})
+	require.Equal(t, viewModeContextSelection, model.viewMode)
+	require.Nil(t, model.currentContext)
+	require.Len(t, model.tabs, 2)
+
+	model = sendKey(model, tea.KeyMsg{Type: tea.KeyDown})
+	model = sendKey(model, tea.KeyMsg{Type: tea.KeyEnter})
+	require.Equal(t, "prod", model.currentContext.Name)
+	updated, _ := model.Update(namespaceFetchedMsg{value: k8s.NamespacesNamed([]string{"monitoring"})})
+	model = updated.(AppModel)
+	updated, _ = model.selectNamespace("monitoring")
+	model = updated.(AppModel)
+	updated, _ = model.Update(podsFetchedMsg{value: []k8s.Pod{{Name: "prometheus-0"}}})
+	return updated.(AppModel)
+}
+
+func TestTabs_SwitchKeepsEachTabsState(t *testing.T) {
+	model := openSecondTab(t)
+
+	view := model.View()
+	assert.Contains(t, view, "1 dev/payments")
+	assert.Contains(t, view, "▸2 prod/monitoring")
+	assert.Contains(t, view, "prometheus-0")
+
+	model = sendKey(model, altKey('1'))
+	assert.Equal(t, "dev", model.currentContext.Name)
+	assert.Equal(t, "payments", model.currentNamespace)
+	assert.Equal(t, 1, model.selectedPodIndex)
+	assert.Equal(t, PanelNamespaces, model.focusedPanel)
+	assert.Contains(t, model.View(), "api-2")
+
+	model = sendKey(model, altKey('2'))
+	assert.Equal(t, "prod", model.currentContext.Name)
+	assert.Equal(t, "monitoring", model.currentNamespace)
+	assert.Equal(t, []k8s.Pod{{Name: "prometheus-0"}}, model.pods)
+
+	_, cmd := model.Update(altKey('5'))
+	assert.Nil(t, cmd, "no fifth tab")
+}
+
+func TestTabs_InFlightFetchesDoNotLeak(t *testing.T) {
+	model := newContextSwitchTestModel(t)
+	// Pods matching a selector are not cached, so they are fetched again
+	model.podSelector = "app=api"
+	model.podsLoading = true
+	_, request := model.requests.start(asyncPods, "")
+
+	model = sendKey(model, tea.KeyMsg{Type: tea.KeyCtrlT})
+	assert.False(t, model.requests.current(request), "the pods of the first tab land nowhere")
+
+	// The fetch of the first tab starts again when it is switched back to
+	updated, cmd := model.Update(altKey('1'))
+	model = updated.(AppModel)
+	assert.True(t, model.podsLoading)
+	assert.NotNil(t, cmd)
+}
+
+func TestTabs_Close(t *testing.T) {
+	model := openSecondTab(t)
+
+	model = sendKey(model, tea.KeyMsg{Type: tea.KeyCtrlW})
+	assert.Nil(t, model.tabs, "one tab left")
+	assert.Equal(t, "dev", model.currentContext.Name)
+	assert.Equal(t, "payments", model.currentNamespace)
+	assert.NotContains(t, model.View(), "1 dev/payments", "no tab bar for a single tab")
+
+	_, cmd := model.Update(tea.KeyMsg{Type: tea.KeyCtrlW})
+	assert.Nil(t, cmd, "the last tab stays open")
+}
@@ -0,0 +1,60 @@
+package tui
+
+import (
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/maratkarimov/kubertino/internal/tui/styles"
+)
+
+// throttleInterval is how often the throttled indicator is refreshed
+const throttleInterval = 500 * time.Millisecond
+
+// ThrottleReporter is implemented by adapters that rate-limit kubectl calls
+type ThrottleReporter interface {
+	Throttled(context string) bool
+}
+
+// throttleTickMsg polls the adapter for contexts whose kubectl calls are being delayed
+type throttleTickMsg time.Time
+
+// throttleTickCmd schedules the next throttle poll
+func throttleTickCmd() tea.Cmd {
+	return tea.Tick(throttleInterval, func(t time.Time) tea.Msg {
+		return throttleTickMsg(t)
+	})
+}
+
+// startThrottleCmd starts polling when kubectl_qps is configured and the adapter reports
+// throttling. Returns nil otherwise.
+func (m AppModel) startThrottleCmd() tea.Cmd {
+	if _, ok := m.kubeAdapter.(ThrottleReporter); !ok || m.config == nil || !m.config.Throttled() {
+		return nil
+	}
+	return throttleTickCmd()
+}
+
+// handleThrottleTick records which contexts are throttled and keeps polling
+func (m AppModel) handleThrottleTick() (tea.Model, tea.Cmd) {
+	reporter, ok := m.kubeAdapter.(ThrottleReporter)
+	if !ok {
+		return m, nil
+	}
+
+	throttled := make(map[string]bool)
+	for _, ctx := range m.contexts {
+		if reporter.Throttled(ctx.Name) {
+			throttled[ctx.Name] = true
+		}
+	}
+	m.throttled = throttled
+	return m, throttleTickCmd()
+}
+
+// throttleBadge marks a context whose kubectl calls are being delayed by kubectl_qps
+func (m AppModel) throttleBadge(context string) string {
+	if !m.throttled[context] {
+		return ""
+	}
+	return styles.WarningStyle.Render(" [throttled]")
+}
@@ -0,0 +1,48 @@
+package tui
+
+import (
+	"testing"
+	"time"
+
+	"github.com/maratkarimov/kubertino/internal/config"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// throttleAdapter is a mock adapter that reports throttled contexts
+type throttleAdapter struct {
+	*mockKubeAdapter
+	throttled map[string]bool
+}
+
+func (a *throttleAdapter) Throttled(context string) bool {
+	return a.throttled[context]
+}
+
+func newThrottleTestModel(adapter KubeAdapter, qps float64) AppModel {
+	return newTestModel(adapter, withContexts(config.Context{Name: "dev"}, config.Context{Name: "prod"}),
+		func(cfg *config.Config) { cfg.KubectlQPS = qps })
+}
+
+func TestThrottle_PollingNeedsQPSAndReporter(t *testing.T) {
+	adapter := &throttleAdapter{mockKubeAdapter: newMockAdapter()}
+	assert.NotNil(t, newThrottleTestModel(adapter, 5).startThrottleCmd())
+	assert.Nil(t, newThrottleTestModel(adapter, 0).startThrottleCmd(), "no polling without kubectl_qps")
+	assert.Nil(t, newThrottleTestModel(newMockAdapter(), 5).startThrottleCmd(), "no polling without a reporter")
+}
+
+func TestThrottle_BadgeFollowsAdapter(t *testing.T) {
+	adapter := &throttleAdapter{mockKubeAdapter: newMockAdapter(), throttled: map[string]bool{"prod": true}}
+	model := newThrottleTestModel(adapter, 5)
+
+	updated, cmd := model.Update(throttleTickMsg(time.Now()))
+	model = updated.(AppModel)
+	require.NotNil(t, cmd, "polling continues")
+	assert.Contains(t, model.View(), "prod [throttled]")
+	assert.NotContains(t, model.View(), "dev [throttled]")
+
+	adapter.throttled = nil
+	updated, _ = model.Update(throttleTickMsg(time.Now()))
+	model = updated.(AppModel)
+	assert.NotContains(t, model.View(), "[throttled]")
+}
@@ -0,0 +1,49 @@
+package tui
+
+import (
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/maratkarimov/kubertino/internal/tui/components"
+)
+
+// toastTickMsg expires the toast shown in the status bar
+type toastTickMsg struct{}
+
+// toastTickCmd schedules the next toast expiry check after d
+func toastTickCmd(d time.Duration) tea.Cmd {
+	return tea.Tick(d, func(time.Time) tea.Msg {
+		return toastTickMsg{}
+	})
+}
+
+// notify queues a transient notification in the status bar under the panels. Errors that
+// need attention go to the error modal instead.
+func (m AppModel) notify(text string, level components.ToastLevel) tea.Cmd {
+	if m.statusBar == nil {
+		return nil
+	}
+	if m.statusBar.Push(components.Toast{Text: text, Level: level}, time.Now()) {
+		return toastTickCmd(m.statusBar.Duration)
+	}
+	return nil
+}
+
+// handleToastTick drops the expired toast and keeps ticking while toasts are queued
+func (m AppModel) handleToastTick() (tea.Model, tea.Cmd) {
+	if m.statusBar == nil {
+		return m, nil
+	}
+	if next := m.statusBar.Expire(time.Now()); next > 0 {
+		return m, toastTickCmd(next)
+	}
+	return m, nil
+}
+
+// statusBarHeight returns the lines taken by the status bar: one while a toast is shown
+func (m AppModel) statusBarHeight() int {
+	if _, ok := m.statusBar.Current(); ok {
+		return 1
+	}
+	return 0
+}
@@ -0,0 +1,68 @@
+package tui
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/charmbracelet/lipgloss"
+	"github.com/maratkarimov/kubertino/internal/audit"
+	"github.com/maratkarimov/kubertino/internal/k8s"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestToast_ActionExitShownUnderPanels(t *testing.T) {
+	model := newAppliesToTestModel()
+	model.viewMode = viewModeNamespaceView
+	record := audit.Record{Action: "Logs", Time: time.Now().Add(-3200 * time.Millisecond)}
+
+	updated, cmd := model.Update(execFinishedMsg{record: record})
+	model = updated.(AppModel)
+	require.NotNil(t, cmd, "expiry is scheduled")
+
+	view := model.View()
+	assert.Contains(t, view, "Logs exited 0 in 3.2s")
+	assert.LessOrEqual(t, lipgloss.Height(view), model.termHeight, "panels shrink to make room")
+	assert.Equal(t, model.termHeight-1, model.splitLayout().namespaces.h)
+
+	// The toast expires once shown long enough
+	model.statusBar.Duration = 0
+	updated, cmd = model.Update(toastTickMsg{})
+	model = updated.(AppModel)
+	assert.Nil(t, cmd)
+	assert.NotContains(t, model.View(), "exited 0")
+	assert.Equal(t, model.termHeight, model.splitLayout().namespaces.h)
+}
+
+func TestToast_FailedActionUsesModal(t *testing.T) {
+	model := newAppliesToTestModel()
+
+	updated, _ := model.Update(execFinishedMsg{err: errors.New("exit status 2"), record: audit.Record{Action: "Logs", Time: time.Now()}})
+	model = updated.(AppModel)
+
+	assert.True(t, model.errorModal.IsVisible)
+	_, shown := model.statusBar.Current()
+	assert.False(t, shown)
+}
+
+func TestToast_ManualRefreshIsAnnounced(t *testing.T) {
+	model := newAppliesToTestModel()
+	model.currentContext = &model.contexts[0]
+
+	model = sendKey(model, runeKey('R'))
+	require.Equal(t, asyncPods, model.refreshing)
+
+	updated, _ := model.Update(podsFetchedMsg{value: []k8s.Pod{{Name: "web-1", Status: "Running"}}})
+	model = updated.(AppModel)
+	toast, ok := model.statusBar.Current()
+	require.True(t, ok)
+	assert.Equal(t, "Pods refreshed", toast.Text)
+	assert.Empty(t, model.refreshing)
+
+	// Background refreshes stay silent
+	model.statusBar.Expire(time.Now().Add(time.Minute))
+	updated, _ = model.Update(podsFetchedMsg{value: []k8s.Pod{{Name: "web-1", Status: "Running"}}})
+	_, ok = updated.(AppModel).statusBar.Current()
+	assert.False(t, ok)
+}
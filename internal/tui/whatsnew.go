@@ -0,0 +1,73 @@
+package tui
+
+import (
+	"log/slog"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/maratkarimov/kubertino/internal/changelog"
+	"github.com/maratkarimov/kubertino/internal/tui/styles"
+)
+
+// whatsNewMaxReleases caps the releases shown after skipping several upgrades
+const whatsNewMaxReleases = 3
+
+// checkWhatsNew compares the version recorded in the session state with the running version.
+// After an upgrade the releases since the recorded version are shown once; a first run only
+// records the version. The state file is written on exit.
+func (m *AppModel) checkWhatsNew() {
+	current := changelog.Current()
+	if m.state == nil || current == "" || m.state.LastVersion == current {
+		return
+	}
+
+	if m.state.LastVersion != "" {
+		m.whatsNew = changelog.Since(m.state.LastVersion)
+		if len(m.whatsNew) > whatsNewMaxReleases {
+			m.whatsNew = m.whatsNew[:whatsNewMaxReleases]
+		}
+		slog.Info("showing what's new", "previous_version", m.state.LastVersion, "version", current)
+	}
+	m.state.LastVersion = current
+}
+
+// handleWhatsNewKey dismisses the what's new screen on any key
+func (m AppModel) handleWhatsNewKey(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	if msg.Type == tea.KeyCtrlC {
+		return m, tea.Quit
+	}
+	m.whatsNew = nil
+	return m, nil
+}
+
+// renderWhatsNew renders the release notes since the last run as a centered dialog
+func (m AppModel) renderWhatsNew() string {
+	var content string
+	content += styles.TitleStyle.Render("What's new in Kubertino "+changelog.Current()) + "\n"
+
+	for _, release := range m.whatsNew {
+		content += "\n" + styles.PanelTitleStyle.Render(release.Version) + "\n"
+		for _, note := range release.Notes {
+			content += styles.NormalStyle.Render("• "+note) + "\n"
+		}
+	}
+
+	content += "\n" + styles.DimStyle.Render("Press any key to continue")
+
+	dialogStyle := lipgloss.NewStyle().
+		Border(lipgloss.RoundedBorder()).
+		BorderForeground(lipgloss.Color("39")). // Bright cyan
+		Padding(1, 2)
+	if m.termWidth > 0 {
+		// Wrap long notes instead of overflowing narrow terminals
+		dialogStyle = dialogStyle.MaxWidth(m.termWidth).Width(min(lipgloss.Width(content)+4, m.termWidth-2))
+	}
+
+	return lipgloss.Place(
+		m.termWidth,
+		m.termHeight,
+		lipgloss.Center,
+		lipgloss.Center,
+		dialogStyle.Render(content),
+	)
+}
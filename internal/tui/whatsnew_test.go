@@ -0,0 +1,65 @@
+package tui
+
+import (
+	"path/filepath"
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/maratkarimov/kubertino/internal/changelog"
+	"github.com/maratkarimov/kubertino/internal/config"
+	"github.com/maratkarimov/kubertino/internal/state"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newWhatsNewTestModel(t *testing.T, lastVersion string) (AppModel, string) {
+	t.Helper()
+	model := newTestModel(newMockAdapter(), withContexts(config.Context{Name: "dev"}, config.Context{Name: "prod"}))
+
+	path := filepath.Join(t.TempDir(), "state.json")
+	model.SetState(&state.State{LastVersion: lastVersion}, path)
+	return model, path
+}
+
+func TestWhatsNew_ShownAfterUpgrade(t *testing.T) {
+	releases := changelog.Releases()
+	require.GreaterOrEqual(t, len(releases), 2)
+
+	model, path := newWhatsNewTestModel(t, releases[1].Version)
+	require.Equal(t, releases[:1], model.whatsNew)
+
+	view := model.View()
+	assert.Contains(t, view, "What's new in Kubertino "+changelog.Current())
+	assert.Contains(t, view, releases[0].Notes[0])
+	assert.NotContains(t, view, releases[1].Notes[0], "notes of the previous version are not repeated")
+
+	// Keys dismiss the screen instead of reaching the context list
+	model = sendKey(model, tea.KeyMsg{Type: tea.KeyDown})
+	assert.Empty(t, model.whatsNew)
+	assert.Equal(t, 0, model.selectedContextIndex)
+	assert.Contains(t, model.View(), "prod")
+
+	// The new version is recorded so the screen is only shown once
+	model.Close()
+	saved, err := state.Load(path)
+	require.NoError(t, err)
+	assert.Equal(t, changelog.Current(), saved.LastVersion)
+}
+
+func TestWhatsNew_NotShownOnFirstRunOrSameVersion(t *testing.T) {
+	model, _ := newWhatsNewTestModel(t, "")
+	assert.Empty(t, model.whatsNew, "a fresh install has nothing to compare with")
+	assert.Equal(t, changelog.Current(), model.state.LastVersion)
+
+	model, _ = newWhatsNewTestModel(t, changelog.Current())
+	assert.Empty(t, model.whatsNew)
+}
+
+func TestWhatsNew_UnknownPreviousVersion(t *testing.T) {
+	model, _ := newWhatsNewTestModel(t, "0.0.1-dev")
+	assert.Equal(t, changelog.Releases()[:1], model.whatsNew, "only the current release is shown")
+
+	updated, cmd := model.Update(tea.KeyMsg{Type: tea.KeyCtrlC})
+	assert.NotNil(t, cmd, "ctrl+c still quits")
+	assert.NotEmpty(t, updated.(AppModel).whatsNew)
+}
@@ -0,0 +1,421 @@
+package tui
+
+import (
+	"fmt"
+	"log/slog"
+	"strings"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/maratkarimov/kubertino/internal/config"
+	"github.com/maratkarimov/kubertino/internal/k8s"
+	"github.com/maratkarimov/kubertino/internal/tui/styles"
+)
+
+// wizardMaxRows is how many list rows a wizard step shows at once
+const wizardMaxRows = 15
+
+// wizardStep is a page of the kubertino config init wizard
+type wizardStep int
+
+const (
+	wizardContexts wizardStep = iota
+	wizardFavorites
+	wizardActions
+	wizardReview
+)
+
+// NamespaceLister lists the namespaces of a context. The config init wizard uses it to offer
+// favorite namespaces.
+type NamespaceLister interface {
+	GetNamespaces(context string) ([]k8s.Namespace, error)
+}
+
+// wizardNamespacesMsg is sent when the namespaces of a context have been fetched for the
+// favorites step
+type wizardNamespacesMsg struct {
+	context    string
+	namespaces []string
+	err        error
+}
+
+// InitWizard is the interactive first-run setup of kubertino config init: it picks the
+// kubeconfig contexts to manage, favorite namespaces from a live-fetched list per context and
+// actions from a template library. Once confirmed, Config returns the configuration to write.
+type InitWizard struct {
+	lister    NamespaceLister
+	path      string // Config file the result is written to, shown on the review step
+	step      wizardStep
+	cursor    int
+	message   string // Why the wizard did not advance
+	confirmed bool
+
+	contexts       []string
+	pickedContexts []bool
+
+	favoriteIndex int                        // Position of the context whose favorites are edited, among the picked ones
+	namespaces    map[string][]string        // Fetched namespaces per context
+	namespaceErrs map[string]error           // Failed namespace fetches per context
+	favorites     map[string]map[string]bool // Picked favorites per context
+
+	templates     []config.Action
+	pickedActions []bool
+
+	termWidth  int
+	termHeight int
+}
+
+// NewInitWizard creates the wizard for the given kubeconfig contexts, all picked, and the
+// action templates, with the default action set picked
+func NewInitWizard(contexts []string, lister NamespaceLister, path string) InitWizard {
+	w := InitWizard{
+		lister:         lister,
+		path:           path,
+		contexts:       contexts,
+		pickedContexts: make([]bool, len(contexts)),
+		namespaces:     make(map[string][]string),
+		namespaceErrs:  make(map[string]error),
+		favorites:      make(map[string]map[string]bool),
+		templates:      config.ActionTemplates(),
+	}
+	for i := range w.pickedContexts {
+		w.pickedContexts[i] = true
+	}
+	w.pickedActions = make([]bool, len(w.templates))
+	for i := range config.DefaultActions() {
+		w.pickedActions[i] = true
+	}
+	return w
+}
+
+// Confirmed reports whether the wizard was completed rather than cancelled
+func (w InitWizard) Confirmed() bool {
+	return w.confirmed
+}
+
+// Config returns the configuration built from the picked contexts, favorites and actions
+func (w InitWizard) Config() *config.Config {
+	cfg := &config.Config{Version: "1.0"}
+	for i, action := range w.templates {
+		if w.pickedActions[i] {
+			cfg.Actions = append(cfg.Actions, action)
+		}
+	}
+
+	for _, name := range w.selectedContexts() {
+		cfg.Contexts = append(cfg.Contexts, config.Context{Name: name})
+		for _, ns := range w.namespaces[name] {
+			if w.favorites[name][ns] {
+				// Cannot fail: favorites start out empty and are stored per context
+				_, _ = config.ToggleFavorite(cfg, name, ns)
+			}
+		}
+	}
+	return cfg
+}
+
+// Init implements tea.Model
+func (w InitWizard) Init() tea.Cmd {
+	return nil
+}
+
+// selectedContexts returns the picked contexts in kubeconfig order
+func (w InitWizard) selectedContexts() []string {
+	var names []string
+	for i, name := range w.contexts {
+		if w.pickedContexts[i] {
+			names = append(names, name)
+		}
+	}
+	return names
+}
+
+// favoriteContext returns the context whose favorites are edited
+func (w InitWizard) favoriteContext() string {
+	selected := w.selectedContexts()
+	if w.favoriteIndex < len(selected) {
+		return selected[w.favoriteIndex]
+	}
+	return ""
+}
+
+// rows returns how many rows the list of the current step has
+func (w InitWizard) rows() int {
+	switch w.step {
+	case wizardContexts:
+		return len(w.contexts)
+	case wizardFavorites:
+		return len(w.namespaces[w.favoriteContext()])
+	case wizardActions:
+		return len(w.templates)
+	}
+	return 0
+}
+
+// fetchNamespacesCmd fetches the namespaces of context for the favorites step
+func (w InitWizard) fetchNamespacesCmd(context string) tea.Cmd {
+	lister := w.lister
+	return func() tea.Msg {
+		namespaces, err := lister.GetNamespaces(context)
+		if err != nil {
+			slog.Warn("wizard namespace fetch failed", "context", context, "error", err)
+			return wizardNamespacesMsg{context: context, err: err}
+		}
+		names := make([]string, 0, len(namespaces))
+		for _, ns := range namespaces {
+			names = append(names, ns.Name)
+		}
+		return wizardNamespacesMsg{context: context, namespaces: names}
+	}
+}
+
+// showFavorites moves to the favorites page of the picked context at index, fetching its
+// namespaces the first time
+func (w InitWizard) showFavorites(index int) (InitWizard, tea.Cmd) {
+	w.step = wizardFavorites
+	w.favoriteIndex = index
+	w.cursor = 0
+	context := w.favoriteContext()
+	if _, fetched := w.namespaces[context]; fetched || w.namespaceErrs[context] != nil {
+		return w, nil
+	}
+	return w, w.fetchNamespacesCmd(context)
+}
+
+// Update implements tea.Model
+func (w InitWizard) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	switch msg := msg.(type) {
+	case tea.WindowSizeMsg:
+		w.termWidth, w.termHeight = msg.Width, msg.Height
+	case wizardNamespacesMsg:
+		if msg.err != nil {
+			w.namespaceErrs[msg.context] = msg.err
+		} else {
+			w.namespaces[msg.context] = msg.namespaces
+		}
+	case tea.KeyMsg:
+		return w.handleKey(msg)
+	}
+	return w, nil
+}
+
+// handleKey moves through the list of the current step, toggles rows and moves between steps
+func (w InitWizard) handleKey(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	w.message = ""
+	switch msg.String() {
+	case "ctrl+c", "q":
+		return w, tea.Quit
+	case "up", "k":
+		if w.cursor > 0 {
+			w.cursor--
+		}
+	case "down", "j":
+		if w.cursor < w.rows()-1 {
+			w.cursor++
+		}
+	case " ":
+		w.toggle(w.cursor)
+	case "a":
+		// Toggle all rows: pick them all unless all are picked already
+		all := true
+		for i := 0; i < w.rows(); i++ {
+			all = all && w.picked(i)
+		}
+		for i := 0; i < w.rows(); i++ {
+			if w.picked(i) == all {
+				w.toggle(i)
+			}
+		}
+	case "enter":
+		return w.next()
+	case "esc":
+		return w.back()
+	}
+	return w, nil
+}
+
+// picked reports whether row i of the current step is picked
+func (w InitWizard) picked(i int) bool {
+	switch w.step {
+	case wizardContexts:
+		return w.pickedContexts[i]
+	case wizardFavorites:
+		context := w.favoriteContext()
+		return w.favorites[context][w.namespaces[context][i]]
+	case wizardActions:
+		return w.pickedActions[i]
+	}
+	return false
+}
+
+// toggle picks or unpicks row i of the current step
+func (w *InitWizard) toggle(i int) {
+	if i < 0 || i >= w.rows() {
+		return
+	}
+	switch w.step {
+	case wizardContexts:
+		w.pickedContexts[i] = !w.pickedContexts[i]
+	case wizardFavorites:
+		context := w.favoriteContext()
+		if w.favorites[context] == nil {
+			w.favorites[context] = make(map[string]bool)
+		}
+		ns := w.namespaces[context][i]
+		w.favorites[context][ns] = !w.favorites[context][ns]
+	case wizardActions:
+		w.pickedActions[i] = !w.pickedActions[i]
+	}
+}
+
+// next moves to the following step; the review step writes the configuration
+func (w InitWizard) next() (tea.Model, tea.Cmd) {
+	switch w.step {
+	case wizardContexts:
+		if len(w.selectedContexts()) == 0 {
+			w.message = "Pick at least one context"
+			return w, nil
+		}
+		return w.showFavorites(0)
+	case wizardFavorites:
+		if w.favoriteIndex+1 < len(w.selectedContexts()) {
+			return w.showFavorites(w.favoriteIndex + 1)
+		}
+		w.step, w.cursor = wizardActions, 0
+	case wizardActions:
+		w.step, w.cursor = wizardReview, 0
+	case wizardReview:
+		w.confirmed = true
+		return w, tea.Quit
+	}
+	return w, nil
+}
+
+// back returns to the previous step; the first step cancels the wizard
+func (w InitWizard) back() (tea.Model, tea.Cmd) {
+	switch w.step {
+	case wizardContexts:
+		return w, tea.Quit
+	case wizardFavorites:
+		if w.favoriteIndex > 0 {
+			return w.showFavorites(w.favoriteIndex - 1)
+		}
+		w.step, w.cursor = wizardContexts, 0
+	case wizardActions:
+		return w.showFavorites(len(w.selectedContexts()) - 1)
+	case wizardReview:
+		w.step, w.cursor = wizardActions, 0
+	}
+	return w, nil
+}
+
+// View implements tea.Model
+func (w InitWizard) View() string {
+	var content string
+	switch w.step {
+	case wizardContexts:
+		content = styles.TitleStyle.Render("Step 1/4: Contexts to manage") + "\n\n"
+		content += w.renderList(w.contexts)
+	case wizardFavorites:
+		context := w.favoriteContext()
+		content = styles.TitleStyle.Render(fmt.Sprintf("Step 2/4: Favorite namespaces of %s (%d/%d)",
+			context, w.favoriteIndex+1, len(w.selectedContexts()))) + "\n\n"
+		namespaces, fetched := w.namespaces[context]
+		switch {
+		case w.namespaceErrs[context] != nil:
+			content += styles.WarningStyle.Render("Could not list namespaces: "+w.namespaceErrs[context].Error()) + "\n"
+			content += styles.DimStyle.Render("Enter skips this context; favorites can be added later with f") + "\n"
+		case !fetched:
+			content += styles.LoadingStyle.Render("Loading namespaces...") + "\n"
+		case len(namespaces) == 0:
+			content += styles.PlaceholderStyle.Render("No namespaces") + "\n"
+		default:
+			content += w.renderList(namespaces)
+		}
+	case wizardActions:
+		content = styles.TitleStyle.Render("Step 3/4: Actions") + "\n\n"
+		rows := make([]string, len(w.templates))
+		for i, action := range w.templates {
+			rows[i] = fmt.Sprintf("[%s] %-14s %s", action.Shortcut, action.Name, styles.DimStyle.Render(action.Command))
+		}
+		content += w.renderList(rows)
+	case wizardReview:
+		content = styles.TitleStyle.Render("Step 4/4: Review") + "\n\n" + w.renderReview()
+	}
+
+	if w.message != "" {
+		content += "\n" + styles.WarningStyle.Render(w.message) + "\n"
+	}
+	help := "↑/↓: Navigate | Space: Toggle | a: Toggle all | Enter: Next | ESC: Back | q: Quit"
+	switch w.step {
+	case wizardContexts:
+		help = "↑/↓: Navigate | Space: Toggle | a: Toggle all | Enter: Next | ESC/q: Quit"
+	case wizardReview:
+		help = "Enter: Write config | ESC: Back | q: Quit without writing"
+	}
+	content += "\n" + styles.DimStyle.Render(help)
+
+	dialog := lipgloss.NewStyle().
+		Border(lipgloss.RoundedBorder()).
+		BorderForeground(lipgloss.Color("39")). // Bright cyan
+		Padding(1, 2).
+		Render(styles.HeaderStyle.Render("kubertino config init") + "\n\n" + content)
+	if w.termWidth > 0 && w.termHeight > 0 {
+		return lipgloss.Place(w.termWidth, w.termHeight, lipgloss.Center, lipgloss.Center, dialog)
+	}
+	return dialog
+}
+
+// renderList renders the rows of the current step with their checkboxes, keeping the cursor
+// inside the visible window
+func (w InitWizard) renderList(rows []string) string {
+	start := 0
+	if w.cursor >= wizardMaxRows {
+		start = w.cursor - wizardMaxRows + 1
+	}
+	end := min(start+wizardMaxRows, len(rows))
+
+	var content string
+	if start > 0 {
+		content += styles.HelpTextStyle.Render(fmt.Sprintf("↑ %d more", start)) + "\n"
+	}
+	for i := start; i < end; i++ {
+		box := "[ ] "
+		if w.picked(i) {
+			box = "[x] "
+		}
+		if i == w.cursor {
+			content += styles.SelectedStyle.Render("> "+box+rows[i]) + "\n"
+		} else {
+			content += styles.NormalStyle.Render("  "+box+rows[i]) + "\n"
+		}
+	}
+	if remaining := len(rows) - end; remaining > 0 {
+		content += styles.HelpTextStyle.Render(fmt.Sprintf("↓ %d more", remaining)) + "\n"
+	}
+	return content
+}
+
+// renderReview summarizes the configuration about to be written
+func (w InitWizard) renderReview() string {
+	cfg := w.Config()
+	lines := []string{"Contexts:"}
+	for _, ctx := range cfg.Contexts {
+		line := "  " + ctx.Name
+		if names, _ := config.GetFavorites(cfg, ctx.Name); len(names) > 0 {
+			line += styles.DimStyle.Render(" (favorites: " + strings.Join(names, ", ") + ")")
+		}
+		lines = append(lines, line)
+	}
+
+	lines = append(lines, "", "Actions:")
+	if len(cfg.Actions) == 0 {
+		lines = append(lines, styles.DimStyle.Render("  none (add them to the file later)"))
+	}
+	for _, action := range cfg.Actions {
+		lines = append(lines, fmt.Sprintf("  [%s] %s", action.Shortcut, action.Name))
+	}
+
+	lines = append(lines, "", "Writes "+w.path)
+	return strings.Join(lines, "\n") + "\n"
+}
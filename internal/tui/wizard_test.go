@@ -0,0 +1,109 @@
+package tui
+
+import (
+	"errors"
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/maratkarimov/kubertino/internal/config"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// sendWizard passes msg to the wizard and runs the returned command, feeding a fetched
+// namespace list back in
+func sendWizard(t *testing.T, w InitWizard, msg tea.Msg) (InitWizard, tea.Cmd) {
+	t.Helper()
+	updated, cmd := w.Update(msg)
+	w = updated.(InitWizard)
+	if cmd == nil {
+		return w, nil
+	}
+	if fetched, ok := cmd().(wizardNamespacesMsg); ok {
+		updated, _ = w.Update(fetched)
+		return updated.(InitWizard), nil
+	}
+	return w, cmd
+}
+
+func TestInitWizard_BuildsConfig(t *testing.T) {
+	w := NewInitWizard([]string{"prod", "staging", "kind-dev"}, newMockAdapter(), "~/.kubertino.yml")
+	assert.Contains(t, w.View(), "Step 1/4")
+
+	// Drop kind-dev
+	w, _ = sendWizard(t, w, runeKey('j'))
+	w, _ = sendWizard(t, w, runeKey('j'))
+	w, _ = sendWizard(t, w, tea.KeyMsg{Type: tea.KeySpace, Runes: []rune{' '}})
+
+	// Favorites of prod, fetched live: production
+	w, _ = sendWizard(t, w, tea.KeyMsg{Type: tea.KeyEnter})
+	assert.Contains(t, w.View(), "Favorite namespaces of prod (1/2)")
+	assert.Contains(t, w.View(), "kube-system")
+	w, _ = sendWizard(t, w, runeKey('j'))
+	w, _ = sendWizard(t, w, runeKey('j'))
+	w, _ = sendWizard(t, w, tea.KeyMsg{Type: tea.KeySpace, Runes: []rune{' '}})
+
+	// No favorites for staging
+	w, _ = sendWizard(t, w, tea.KeyMsg{Type: tea.KeyEnter})
+	assert.Contains(t, w.View(), "Favorite namespaces of staging (2/2)")
+	w, _ = sendWizard(t, w, tea.KeyMsg{Type: tea.KeyEnter})
+
+	// Actions: the defaults are picked; add psql
+	require.Contains(t, w.View(), "Step 3/4")
+	for range config.ActionTemplates() {
+		w, _ = sendWizard(t, w, runeKey('j'))
+	}
+	w, _ = sendWizard(t, w, tea.KeyMsg{Type: tea.KeySpace, Runes: []rune{' '}})
+	w, _ = sendWizard(t, w, tea.KeyMsg{Type: tea.KeyEnter})
+
+	view := w.View()
+	assert.Contains(t, view, "Step 4/4: Review")
+	assert.Contains(t, view, "favorites: production")
+	assert.Contains(t, view, "Writes ~/.kubertino.yml")
+
+	w, cmd := sendWizard(t, w, tea.KeyMsg{Type: tea.KeyEnter})
+	require.NotNil(t, cmd)
+	assert.True(t, w.Confirmed())
+
+	cfg := w.Config()
+	assert.Equal(t, []config.Context{{Name: "prod"}, {Name: "staging"}}, cfg.Contexts)
+	favorites, err := config.GetFavorites(cfg, "prod")
+	require.NoError(t, err)
+	assert.Equal(t, []string{"production"}, favorites)
+	var names []string
+	for _, action := range cfg.Actions {
+		names = append(names, action.Name)
+	}
+	assert.Equal(t, []string{"Logs", "Shell", "Previous Logs", "psql"}, names)
+	assert.NoError(t, config.Validate(cfg))
+}
+
+func TestInitWizard_NeedsAContext(t *testing.T) {
+	w := NewInitWizard([]string{"prod"}, newMockAdapter(), "kubertino.yml")
+	w, _ = sendWizard(t, w, runeKey('a'))
+	w, _ = sendWizard(t, w, tea.KeyMsg{Type: tea.KeyEnter})
+	assert.Contains(t, w.View(), "Pick at least one context")
+	assert.Contains(t, w.View(), "Step 1/4")
+
+	_, cmd := sendWizard(t, w, tea.KeyMsg{Type: tea.KeyEsc})
+	assert.NotNil(t, cmd, "ESC on the first step quits")
+	assert.False(t, w.Confirmed())
+}
+
+func TestInitWizard_NamespaceFetchFails(t *testing.T) {
+	adapter := newMockAdapter()
+	adapter.err = errors.New("forbidden")
+	w := NewInitWizard([]string{"prod"}, adapter, "kubertino.yml")
+
+	w, _ = sendWizard(t, w, tea.KeyMsg{Type: tea.KeyEnter})
+	assert.Contains(t, w.View(), "Could not list namespaces: forbidden")
+
+	// The context is kept without favorites
+	w, _ = sendWizard(t, w, tea.KeyMsg{Type: tea.KeyEnter})
+	w, _ = sendWizard(t, w, tea.KeyMsg{Type: tea.KeyEsc})
+	assert.Contains(t, w.View(), "Favorite namespaces of prod", "ESC goes back")
+	w, _ = sendWizard(t, w, tea.KeyMsg{Type: tea.KeyEnter})
+	w, _ = sendWizard(t, w, tea.KeyMsg{Type: tea.KeyEnter})
+	assert.Nil(t, w.Config().Favorites)
+	assert.Len(t, w.Config().Contexts, 1)
+}
@@ -15,8 +15,8 @@ import (
 // mockAdapter is a simple mock for smoke testing
 type mockAdapter struct{}
 
-func (m *mockAdapter) GetNamespaces(context string) ([]string, error) {
-	return []string{"default", "kube-system"}, nil
+func (m *mockAdapter) GetNamespaces(context string) ([]k8s.Namespace, error) {
+	return k8s.NamespacesNamed([]string{"default", "kube-system"}), nil
 }
 
 func (m *mockAdapter) GetPods(context, namespace string) ([]k8s.Pod, error) {
@@ -0,0 +1,117 @@
+//go:build e2e
+
+package e2e
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/maratkarimov/kubertino/internal/k8s"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAdapter_GetContexts(t *testing.T) {
+	contexts, err := k8s.NewKubectlAdapter(kubeconfig).GetContexts()
+	require.NoError(t, err)
+	assert.Contains(t, contexts, contextName)
+}
+
+func TestAdapter_GetNamespaces(t *testing.T) {
+	namespaces, err := k8s.NewKubectlAdapter(kubeconfig).GetNamespaces(contextName)
+	require.NoError(t, err)
+	names := k8s.NamespaceNames(namespaces)
+	assert.Contains(t, names, "default")
+	assert.Contains(t, names, "kube-system")
+	assert.Contains(t, names, shopNamespace)
+	assert.Contains(t, names, emptyNamespace)
+}
+
+func TestAdapter_GetPods(t *testing.T) {
+	adapter := k8s.NewKubectlAdapter(kubeconfig)
+
+	pods, err := adapter.GetPods(contextName, shopNamespace)
+	require.NoError(t, err)
+
+	var web []k8s.Pod
+	for _, pod := range pods {
+		if pod.Labels["app"] == "web" {
+			web = append(web, pod)
+		}
+	}
+	require.Len(t, web, 2)
+	for _, pod := range web {
+		assert.True(t, strings.HasPrefix(pod.Name, "web-"), pod.Name)
+		assert.Equal(t, "Running", pod.Status)
+		assert.Equal(t, "1/1", pod.Ready)
+		assert.Equal(t, []string{"web"}, pod.Containers)
+		assert.Equal(t, []int{8080}, pod.Ports)
+		assert.Equal(t, "ReplicaSet", pod.OwnerKind)
+		assert.NotEmpty(t, pod.Node)
+		assert.False(t, pod.CreatedAt.IsZero())
+	}
+
+	empty, err := adapter.GetPods(contextName, emptyNamespace)
+	require.NoError(t, err)
+	assert.Empty(t, empty)
+}
+
+func TestAdapter_GetResources(t *testing.T) {
+	adapter := k8s.NewKubectlAdapter(kubeconfig)
+	tests := []struct {
+		kind   k8s.ResourceKind
+		name   string
+		status string
+	}{
+		{k8s.KindDeployment, "web", "2/2 ready"},
+		{k8s.KindStatefulSet, "db", "1/1 ready"},
+		{k8s.KindJob, "migrate", "Complete"},
+	}
+	for _, tt := range tests {
+		t.Run(string(tt.kind), func(t *testing.T) {
+			resources, err := adapter.GetResources(contextName, shopNamespace, tt.kind)
+			require.NoError(t, err)
+			assert.Contains(t, resources, k8s.Resource{Kind: tt.kind, Name: tt.name, Status: tt.status})
+		})
+	}
+}
+
+func TestAdapter_DescribePod(t *testing.T) {
+	adapter := k8s.NewKubectlAdapter(kubeconfig)
+	pod := firstWebPod(t, adapter)
+
+	output, err := adapter.DescribePod(contextName, shopNamespace, pod.Name)
+	require.NoError(t, err)
+	assert.Contains(t, output, pod.Name)
+	assert.Contains(t, output, "Namespace:")
+}
+
+func TestAdapter_GetRestartStorms(t *testing.T) {
+	storms, err := k8s.NewKubectlAdapter(kubeconfig).GetRestartStorms(contextName)
+	require.NoError(t, err)
+	assert.NotContains(t, storms, shopNamespace, "the sample pods do not restart")
+}
+
+func TestAdapter_GetGitOpsInfo(t *testing.T) {
+	adapter := k8s.NewKubectlAdapter(kubeconfig)
+	pod := firstWebPod(t, adapter)
+
+	info, err := adapter.GetGitOpsInfo(contextName, shopNamespace, pod)
+	require.NoError(t, err)
+	assert.Equal(t, "Deployment", info.WorkloadKind, "the owner chain is followed past the ReplicaSet")
+	assert.Equal(t, "web", info.WorkloadName)
+}
+
+// firstWebPod returns a pod of the web deployment
+func firstWebPod(t *testing.T, adapter *k8s.KubectlAdapter) k8s.Pod {
+	t.Helper()
+	pods, err := adapter.GetPods(contextName, shopNamespace)
+	require.NoError(t, err)
+	for _, pod := range pods {
+		if pod.Labels["app"] == "web" {
+			return pod
+		}
+	}
+	t.Fatal("no web pod found")
+	return k8s.Pod{}
+}
@@ -0,0 +1,10 @@
+// Package e2e runs kubertino against a real cluster created with kind. The tests only build
+// with the e2e tag:
+//
+//	go test -tags e2e ./test/e2e/
+//
+// They need kind, kubectl and a container runtime for kind. KUBERTINO_E2E_CLUSTER reuses an
+// existing kind cluster instead of creating (and deleting) one, KUBERTINO_E2E_NODE_IMAGE picks
+// the node image, and so the Kubernetes version, of a created cluster, and KUBERTINO_E2E_KEEP=1
+// keeps a created cluster for debugging.
+package e2e
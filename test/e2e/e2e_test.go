@@ -0,0 +1,117 @@
+//go:build e2e
+
+package e2e
+
+import (
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+// Sample workloads from testdata/workloads.yaml
+const (
+	shopNamespace  = "kubertino-e2e-shop"
+	emptyNamespace = "kubertino-e2e-empty"
+)
+
+// defaultCluster is the name of the kind cluster created when KUBERTINO_E2E_CLUSTER is unset
+const defaultCluster = "kubertino-e2e"
+
+// Set up by TestMain
+var (
+	workDir     string // Temporary directory holding the kubeconfig, binary and configs
+	kubeconfig  string // Kubeconfig of the kind cluster only
+	contextName string // Context of the kind cluster ("kind-<cluster>")
+	binary      string // kubertino built from ./cmd/kubertino
+)
+
+func TestMain(m *testing.M) {
+	os.Exit(run(m))
+}
+
+// run creates the cluster, deploys the sample workloads and builds kubertino before running
+// the tests. Setup failures fail the whole run: the e2e tag asks for a cluster.
+func run(m *testing.M) int {
+	for _, tool := range []string{"kind", "kubectl", "go"} {
+		if _, err := exec.LookPath(tool); err != nil {
+			fmt.Fprintf(os.Stderr, "e2e: %s not found in PATH\n", tool)
+			return 1
+		}
+	}
+
+	var err error
+	workDir, err = os.MkdirTemp("", "kubertino-e2e-")
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "e2e: %v\n", err)
+		return 1
+	}
+	defer os.RemoveAll(workDir)
+	kubeconfig = filepath.Join(workDir, "kubeconfig")
+
+	cluster := os.Getenv("KUBERTINO_E2E_CLUSTER")
+	if cluster != "" {
+		err = runTool("kind", "export", "kubeconfig", "--name", cluster, "--kubeconfig", kubeconfig)
+	} else {
+		cluster = defaultCluster
+		args := []string{"create", "cluster", "--name", cluster, "--kubeconfig", kubeconfig, "--wait", "120s"}
+		if image := os.Getenv("KUBERTINO_E2E_NODE_IMAGE"); image != "" {
+			args = append(args, "--image", image)
+		}
+		err = runTool("kind", args...)
+		if os.Getenv("KUBERTINO_E2E_KEEP") != "1" {
+			defer runTool("kind", "delete", "cluster", "--name", cluster)
+		}
+	}
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "e2e: failed to set up kind cluster %s: %v\n", cluster, err)
+		return 1
+	}
+	contextName = "kind-" + cluster
+
+	if err := deployWorkloads(); err != nil {
+		fmt.Fprintf(os.Stderr, "e2e: failed to deploy sample workloads: %v\n", err)
+		return 1
+	}
+
+	binary = filepath.Join(workDir, "kubertino")
+	if err := runTool("go", "build", "-o", binary, "../../cmd/kubertino"); err != nil {
+		fmt.Fprintf(os.Stderr, "e2e: failed to build kubertino: %v\n", err)
+		return 1
+	}
+
+	return m.Run()
+}
+
+// deployWorkloads applies testdata/workloads.yaml and waits until the pods are ready and the
+// job has completed
+func deployWorkloads() error {
+	if err := runTool("kubectl", "--kubeconfig", kubeconfig, "apply", "-f", "testdata/workloads.yaml"); err != nil {
+		return err
+	}
+	waits := [][]string{
+		{"rollout", "status", "deployment/web"},
+		{"rollout", "status", "statefulset/db"},
+		{"wait", "--for=condition=complete", "job/migrate"},
+	}
+	for _, wait := range waits {
+		args := append([]string{"--kubeconfig", kubeconfig, "-n", shopNamespace}, wait...)
+		if err := runTool("kubectl", append(args, "--timeout=180s")...); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// runTool runs a setup command with its output on stderr
+func runTool(name string, args ...string) error {
+	cmd := exec.Command(name, args...)
+	cmd.Stdout = os.Stderr
+	cmd.Stderr = os.Stderr
+	if err := cmd.Run(); err != nil {
+		return fmt.Errorf("%s %s: %w", name, strings.Join(args, " "), err)
+	}
+	return nil
+}
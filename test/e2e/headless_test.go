@@ -0,0 +1,102 @@
+//go:build e2e
+
+package e2e
+
+import (
+	"bytes"
+	"encoding/json"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestHeadless_ListPods(t *testing.T) {
+	configPath := writeConfig(t)
+
+	out, err := runKubertino(t, configPath, "list", "pods", "--namespace", shopNamespace, "-o", "json")
+	require.NoError(t, err, out)
+
+	var pods []struct {
+		Name   string            `json:"name"`
+		Status string            `json:"status"`
+		Ready  string            `json:"ready"`
+		Labels map[string]string `json:"labels"`
+	}
+	require.NoError(t, json.Unmarshal([]byte(out), &pods))
+	web := 0
+	for _, pod := range pods {
+		if pod.Labels["app"] == "web" {
+			web++
+			assert.Equal(t, "Running", pod.Status)
+			assert.Equal(t, "1/1", pod.Ready)
+		}
+	}
+	assert.Equal(t, 2, web)
+
+	out, err = runKubertino(t, configPath, "list", "pods", "--namespace", emptyNamespace, "-o", "name")
+	require.NoError(t, err, out)
+	assert.Empty(t, out)
+}
+
+func TestHeadless_Exec(t *testing.T) {
+	configPath := writeConfig(t)
+
+	out, err := runKubertino(t, configPath, "exec", "--namespace", shopNamespace, "--pod-pattern", "^web-", "--action", "hostname")
+	require.NoError(t, err, out)
+	assert.Regexp(t, `^web-[a-z0-9]+-[a-z0-9]+$`, strings.TrimSpace(out), "the action ran inside the web pod")
+
+	_, err = runKubertino(t, configPath, "exec", "--namespace", shopNamespace, "--pod", "db-0", "--action", "fail")
+	var exitErr *exec.ExitError
+	require.ErrorAs(t, err, &exitErr)
+	assert.Equal(t, 4, exitErr.ExitCode(), "the exit code of the command is passed through")
+}
+
+func TestHeadless_ConfigShowEffective(t *testing.T) {
+	configPath := writeConfig(t)
+
+	out, err := runKubertino(t, configPath, "config", "show", "--effective")
+	require.NoError(t, err, out)
+	assert.Contains(t, out, "name: "+contextName)
+	assert.Contains(t, out, "name: Hostname")
+}
+
+// writeConfig writes a kubertino config for the kind cluster with a couple of actions
+func writeConfig(t *testing.T) string {
+	t.Helper()
+	path := filepath.Join(t.TempDir(), "kubertino.yml")
+	config := `version: "1.0"
+kubeconfig: ` + kubeconfig + `
+actions:
+  - name: Hostname
+    shortcut: h
+    command: kubectl --context {{.context}} -n {{.namespace}} exec {{.pod}} -c {{.container}} -- hostname
+  - name: Fail
+    shortcut: f
+    command: kubectl --context {{.context}} -n {{.namespace}} exec {{.pod}} -- sh -c 'exit 4'
+contexts:
+  - name: ` + contextName + `
+`
+	require.NoError(t, os.WriteFile(path, []byte(config), 0o600))
+	return path
+}
+
+// runKubertino runs the built binary with a private HOME (for its log file) and returns its
+// standard output
+func runKubertino(t *testing.T, configPath string, args ...string) (string, error) {
+	t.Helper()
+	cmd := exec.Command(binary, append([]string{"-config", configPath}, args...)...)
+	cmd.Env = append(os.Environ(), "HOME="+t.TempDir())
+	var stdout, stderr bytes.Buffer
+	cmd.Stdout = &stdout
+	cmd.Stderr = &stderr
+	err := cmd.Run()
+	if err != nil {
+		t.Logf("kubertino %s: %v\n%s", strings.Join(args, " "), err, stderr.String())
+	}
+	return stdout.String(), err
+}